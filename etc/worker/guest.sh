@@ -1,2 +1,4 @@
 #!/bin/sh
-/pach-bin/worker $1
+# exec, so that the worker gets the SIGTERM that Kubernetes sends when the
+# pod is deleted.
+exec /pach-bin/worker $1
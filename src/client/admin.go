@@ -0,0 +1,243 @@
+package client
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client/admin"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/pbutil"
+
+	"github.com/gogo/protobuf/types"
+)
+
+const (
+	// ReadOnlyKey is the etcd key that stores whether the cluster is in
+	// read-only mode.
+	ReadOnlyKey = "read-only"
+	// MetadataVersionKey is the etcd key that stores the version of Pachyderm
+	// that the cluster's metadata is compatible with.
+	MetadataVersionKey = "metadata-version"
+	// NotificationConfigKey is the etcd key that stores the cluster's
+	// notification config.
+	NotificationConfigKey = "notification-config"
+)
+
+// Extract all cluster state, call f with each operation.
+func (c APIClient) Extract(objects bool, f func(op *admin.Op) error) error {
+	extractClient, err := c.AdminAPIClient.Extract(c.ctx(), &admin.ExtractRequest{NoObjects: !objects})
+	if err != nil {
+		return sanitizeErr(err)
+	}
+	for {
+		op, err := extractClient.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return sanitizeErr(err)
+		}
+		if err := f(op); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExtractAll cluster state as a slice of operations.
+func (c APIClient) ExtractAll(objects bool) ([]*admin.Op, error) {
+	var result []*admin.Op
+	if err := c.Extract(objects, func(op *admin.Op) error {
+		result = append(result, op)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ExtractWriter extracts all cluster state and marshals it to w.
+func (c APIClient) ExtractWriter(objects bool, w io.Writer) error {
+	writer := pbutil.NewWriter(w)
+	return c.Extract(objects, func(op *admin.Op) error {
+		_, err := writer.Write(op)
+		return err
+	})
+}
+
+// ExtractURL extracts all cluster state and marshals it to object storage.
+func (c APIClient) ExtractURL(url string) error {
+	extractClient, err := c.AdminAPIClient.Extract(c.ctx(), &admin.ExtractRequest{URL: url})
+	if err != nil {
+		return sanitizeErr(err)
+	}
+	resp, err := extractClient.Recv()
+	if err == nil {
+		return fmt.Errorf("unexpected response from extract: %v", resp)
+	}
+	if err != io.EOF {
+		return sanitizeErr(err)
+	}
+	return nil
+}
+
+// Restore cluster state from an extract series of operations.
+func (c APIClient) Restore(ops []*admin.Op) (retErr error) {
+	restoreClient, err := c.AdminAPIClient.Restore(c.ctx())
+	if err != nil {
+		return sanitizeErr(err)
+	}
+	defer func() {
+		if _, err := restoreClient.CloseAndRecv(); err != nil && retErr == nil {
+			retErr = sanitizeErr(err)
+		}
+	}()
+	for _, op := range ops {
+		if err := restoreClient.Send(&admin.RestoreRequest{Op: op}); err != nil {
+			return sanitizeErr(err)
+		}
+	}
+	return nil
+}
+
+// RestoreReader restores cluster state from a reader containing marshaled ops.
+// Such a reader can be created with ExtractWriter.
+func (c APIClient) RestoreReader(r io.Reader) (retErr error) {
+	restoreClient, err := c.AdminAPIClient.Restore(c.ctx())
+	if err != nil {
+		return sanitizeErr(err)
+	}
+	defer func() {
+		if _, err := restoreClient.CloseAndRecv(); err != nil && retErr == nil {
+			retErr = sanitizeErr(err)
+		}
+	}()
+	reader := pbutil.NewReader(r)
+	op := &admin.Op{}
+	for {
+		if err := reader.Read(op); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return err
+		}
+		if err := restoreClient.Send(&admin.RestoreRequest{Op: op}); err != nil {
+			return sanitizeErr(err)
+		}
+	}
+	return nil
+}
+
+// RestoreURL restores cluster state from object storage.
+func (c APIClient) RestoreURL(url string) (retErr error) {
+	restoreClient, err := c.AdminAPIClient.Restore(c.ctx())
+	if err != nil {
+		return sanitizeErr(err)
+	}
+	defer func() {
+		if _, err := restoreClient.CloseAndRecv(); err != nil && retErr == nil {
+			retErr = sanitizeErr(err)
+		}
+	}()
+	return sanitizeErr(restoreClient.Send(&admin.RestoreRequest{URL: url}))
+}
+
+// MigrateStorage copies all of the objects under the storage root fromURL to
+// toURL, calling f with the progress of each object. If verify is true the
+// copies are checked against their source. If deleteSource is true objects
+// are removed from fromURL once they've been copied and checked, it requires
+// verify.
+func (c APIClient) MigrateStorage(fromURL string, toURL string, deleteSource bool, verify bool, f func(*admin.MigrateStorageProgress) error) error {
+	migrateClient, err := c.AdminAPIClient.MigrateStorage(c.ctx(), &admin.MigrateStorageRequest{
+		FromURL:      fromURL,
+		ToURL:        toURL,
+		DeleteSource: deleteSource,
+		Verify:       verify,
+	})
+	if err != nil {
+		return sanitizeErr(err)
+	}
+	for {
+		progress, err := migrateClient.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return sanitizeErr(err)
+		}
+		if err := f(progress); err != nil {
+			return err
+		}
+	}
+}
+
+// SetReadOnly puts the cluster into or out of read-only mode. While the
+// cluster is read-only, all requests that would modify it, including the
+// creation of new jobs, are rejected.
+func (c APIClient) SetReadOnly(readOnly bool) error {
+	_, err := c.AdminAPIClient.SetReadOnly(c.ctx(), &admin.SetReadOnlyRequest{ReadOnly: readOnly})
+	return sanitizeErr(err)
+}
+
+// InspectCluster returns the state of the cluster.
+func (c APIClient) InspectCluster() (*admin.ClusterInfo, error) {
+	clusterInfo, err := c.AdminAPIClient.InspectCluster(c.ctx(), &types.Empty{})
+	if err != nil {
+		return nil, sanitizeErr(err)
+	}
+	return clusterInfo, nil
+}
+
+// ListOrphanedObjects calls f with each object in the object store that isn't
+// referenced by any commit or tag and was written more than olderThan ago.
+func (c APIClient) ListOrphanedObjects(olderThan time.Duration, f func(*admin.OrphanedObject) error) error {
+	request := &admin.ListOrphanedObjectsRequest{}
+	if olderThan > 0 {
+		request.OlderThan = types.DurationProto(olderThan)
+	}
+	listClient, err := c.AdminAPIClient.ListOrphanedObjects(c.ctx(), request)
+	if err != nil {
+		return sanitizeErr(err)
+	}
+	for {
+		orphan, err := listClient.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return sanitizeErr(err)
+		}
+		if err := f(orphan); err != nil {
+			return err
+		}
+	}
+}
+
+// DeleteOrphanedObjects deletes the objects with the given hashes. It errors,
+// without deleting anything, if any of the objects are still referenced or
+// were written less than an hour ago.
+func (c APIClient) DeleteOrphanedObjects(hashes ...string) error {
+	request := &admin.DeleteOrphanedObjectsRequest{}
+	for _, hash := range hashes {
+		request.Objects = append(request.Objects, &pfs.Object{Hash: hash})
+	}
+	_, err := c.AdminAPIClient.DeleteOrphanedObjects(c.ctx(), request)
+	return sanitizeErr(err)
+}
+
+// SetNotificationConfig replaces the cluster's notification config, which
+// routes notifications about jobs to sinks such as Slack and PagerDuty.
+func (c APIClient) SetNotificationConfig(config *admin.NotificationConfig) error {
+	_, err := c.AdminAPIClient.SetNotificationConfig(c.ctx(), config)
+	return sanitizeErr(err)
+}
+
+// GetNotificationConfig returns the cluster's notification config.
+func (c APIClient) GetNotificationConfig() (*admin.NotificationConfig, error) {
+	config, err := c.AdminAPIClient.GetNotificationConfig(c.ctx(), &types.Empty{})
+	if err != nil {
+		return nil, sanitizeErr(err)
+	}
+	return config, nil
+}
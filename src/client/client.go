@@ -14,9 +14,11 @@ import (
 	log "github.com/Sirupsen/logrus"
 	types "github.com/gogo/protobuf/types"
 
+	"github.com/pachyderm/pachyderm/src/client/admin"
 	"github.com/pachyderm/pachyderm/src/client/health"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/config"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
 	"github.com/pachyderm/pachyderm/src/client/pps"
 )
 
@@ -29,11 +31,15 @@ type PpsAPIClient pps.APIClient
 // ObjectAPIClient is an alias for pfs.ObjectAPIClient
 type ObjectAPIClient pfs.ObjectAPIClient
 
-// An APIClient is a wrapper around pfs, pps and block APIClients.
+// AdminAPIClient is an alias for admin.APIClient.
+type AdminAPIClient admin.APIClient
+
+// An APIClient is a wrapper around pfs, pps, block and admin APIClients.
 type APIClient struct {
 	PfsAPIClient
 	PpsAPIClient
 	ObjectAPIClient
+	AdminAPIClient
 	addr              string
 	clientConn        *grpc.ClientConn
 	healthClient      health.HealthClient
@@ -77,6 +83,9 @@ func NewMetricsClientFromAddressWithConcurrency(addr string, metrics bool, prefi
 // NewFromAddressWithConcurrency constructs a new APIClient and sets the max
 // concurrency of streaming requests (GetFile / PutFile)
 func NewFromAddressWithConcurrency(addr string, maxConcurrentStreams uint) (*APIClient, error) {
+	if err := grpcutil.SetMaxMsgSizeFromEnv(); err != nil {
+		return nil, err
+	}
 	c := &APIClient{
 		addr:            addr,
 		streamSemaphore: make(chan struct{}, maxConcurrentStreams),
@@ -187,6 +196,7 @@ func (c *APIClient) connect() error {
 	c.PfsAPIClient = pfs.NewAPIClient(clientConn)
 	c.PpsAPIClient = pps.NewAPIClient(clientConn)
 	c.ObjectAPIClient = pfs.NewObjectAPIClient(clientConn)
+	c.AdminAPIClient = admin.NewAPIClient(clientConn)
 	c.clientConn = clientConn
 	c.healthClient = health.NewHealthClient(clientConn)
 	c._ctx = ctx
@@ -0,0 +1,326 @@
+// Package dataset iterates over the files of a commit as records, so that
+// Go programs can consume data in PFS without handling files themselves:
+//
+//	it, err := dataset.New(c, "logs", "master", &dataset.Options{
+//		Glob:   "/2017-*/*.json",
+//		Format: dataset.JSON,
+//	})
+//	if err != nil {
+//		return err
+//	}
+//	defer it.Close()
+//	for it.Next() {
+//		var event Event
+//		if err := it.Record().Decode(&event); err != nil {
+//			return err
+//		}
+//		...
+//	}
+//	return it.Err()
+//
+// Files are read in order of their paths, and the files after the one being
+// read are fetched in parallel in the background.
+package dataset
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+)
+
+// DefaultParallelism is how many files are fetched at once, if Options
+// doesn't say.
+const DefaultParallelism = 4
+
+// Format is how files are decoded into records.
+type Format int
+
+const (
+	// Lines decodes each line of a file as a record, without its line
+	// ending.
+	Lines Format = iota
+	// JSON decodes each JSON value in a file as a record, e.g. a file of
+	// newline delimited JSON objects.
+	JSON
+	// CSV decodes each row of a CSV file as a record.
+	CSV
+)
+
+// Options configure which files are read, and how.
+type Options struct {
+	// Glob, if set, selects the files that are read, otherwise every file in
+	// the commit is. Directories that match are read recursively.
+	Glob string
+	// Format is how files are decoded into records.
+	Format Format
+	// SkipHeader skips the first row of each file, for CSV files with a
+	// header.
+	SkipHeader bool
+	// Parallelism is how many files are fetched at once. Files that have been
+	// fetched are held in memory until they're read, so it bounds how much
+	// memory is used, as well as how far ahead the iterator reads.
+	Parallelism int
+}
+
+// Record is one record from a file.
+type Record struct {
+	// File is the file the record is from.
+	File *pfs.FileInfo
+	// Index is the record's position in File, starting at 0.
+	Index int
+	// Data is the record, i.e. the line or the JSON value. It's nil for CSV
+	// records.
+	Data []byte
+	// Fields are the CSV record's fields.
+	Fields []string
+}
+
+// Decode decodes a JSON record into v.
+func (r *Record) Decode(v interface{}) error {
+	return json.Unmarshal(r.Data, v)
+}
+
+// Iterator iterates over the records of a commit's files. It's used like a
+// bufio.Scanner, see the package's example.
+type Iterator struct {
+	opts    Options
+	pending chan chan *fetchedFile
+	done    chan struct{}
+
+	decoder decoder
+	file    *pfs.FileInfo
+	index   int
+	record  *Record
+	err     error
+}
+
+type fetchedFile struct {
+	fileInfo *pfs.FileInfo
+	data     []byte
+	err      error
+}
+
+// decoder returns the records of one file, next returns io.EOF after the
+// last.
+type decoder interface {
+	next(record *Record) error
+}
+
+// New returns an Iterator over the records of the files of commitID in repo.
+// If commitID is a branch, it's read as of its head when New is called.
+func New(c *client.APIClient, repo string, commitID string, opts *Options) (*Iterator, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+	commitInfo, err := c.InspectCommit(repo, commitID)
+	if err != nil {
+		return nil, err
+	}
+	fileInfos, err := listFiles(c, repo, commitInfo.Commit.ID, opts.Glob)
+	if err != nil {
+		return nil, err
+	}
+	parallelism := opts.Parallelism
+	if parallelism <= 0 {
+		parallelism = DefaultParallelism
+	}
+	it := &Iterator{
+		opts:    *opts,
+		pending: make(chan chan *fetchedFile, parallelism),
+		done:    make(chan struct{}),
+	}
+	go it.fetch(c, fileInfos)
+	return it, nil
+}
+
+// listFiles returns the files in commitID that match glob, or all of them if
+// it's empty, in order of their paths.
+func listFiles(c *client.APIClient, repo string, commitID string, glob string) ([]*pfs.FileInfo, error) {
+	if glob == "" {
+		glob = "/*"
+	}
+	matches, err := c.GlobFile(repo, commitID, glob)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool)
+	var result []*pfs.FileInfo
+	for _, match := range matches {
+		root := match.File.Path
+		if err := c.Walk(repo, commitID, root, func(fileInfo *pfs.FileInfo) error {
+			if fileInfo.FileType == pfs.FileType_FILE && !seen[fileInfo.File.Path] {
+				seen[fileInfo.File.Path] = true
+				result = append(result, fileInfo)
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].File.Path < result[j].File.Path
+	})
+	return result, nil
+}
+
+// fetch fetches fileInfos in the background, in parallel, and queues them in
+// order on it.pending, which holds at most Parallelism files that are being
+// fetched or haven't been read yet.
+func (it *Iterator) fetch(c *client.APIClient, fileInfos []*pfs.FileInfo) {
+	defer close(it.pending)
+	for _, fileInfo := range fileInfos {
+		fetched := make(chan *fetchedFile, 1)
+		select {
+		case it.pending <- fetched:
+		case <-it.done:
+			return
+		}
+		go func(fileInfo *pfs.FileInfo) {
+			var buf bytes.Buffer
+			file := fileInfo.File
+			err := c.GetFile(file.Commit.Repo.Name, file.Commit.ID, file.Path, 0, 0, &buf)
+			fetched <- &fetchedFile{
+				fileInfo: fileInfo,
+				data:     buf.Bytes(),
+				err:      err,
+			}
+		}(fileInfo)
+	}
+}
+
+// Next advances to the next record, which is then returned by Record. It
+// returns false when there are no more records or there's an error, which is
+// returned by Err.
+func (it *Iterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	for {
+		if it.decoder != nil {
+			record := &Record{
+				File:  it.file,
+				Index: it.index,
+			}
+			err := it.decoder.next(record)
+			if err == nil {
+				it.index++
+				it.record = record
+				return true
+			}
+			if err != io.EOF {
+				it.err = fmt.Errorf("error decoding %s: %v", it.file.File.Path, err)
+				return false
+			}
+			it.decoder = nil
+		}
+		fetched, ok := <-it.pending
+		if !ok {
+			it.record = nil
+			return false
+		}
+		file := <-fetched
+		if file.err != nil {
+			it.err = fmt.Errorf("error reading %s: %v", file.fileInfo.File.Path, file.err)
+			return false
+		}
+		decoder, err := it.newDecoder(file.data)
+		if err != nil {
+			it.err = fmt.Errorf("error decoding %s: %v", file.fileInfo.File.Path, err)
+			return false
+		}
+		it.decoder = decoder
+		it.file = file.fileInfo
+		it.index = 0
+	}
+}
+
+// Record returns the record that the last call to Next advanced to.
+func (it *Iterator) Record() *Record {
+	return it.record
+}
+
+// Err returns the error that stopped the iteration, if there was one.
+func (it *Iterator) Err() error {
+	return it.err
+}
+
+// Close stops fetching files. It must be called if the iterator isn't read
+// to the end.
+func (it *Iterator) Close() error {
+	select {
+	case <-it.done:
+	default:
+		close(it.done)
+	}
+	return nil
+}
+
+func (it *Iterator) newDecoder(data []byte) (decoder, error) {
+	switch it.opts.Format {
+	case Lines:
+		return &lineDecoder{r: bufio.NewReader(bytes.NewReader(data))}, nil
+	case JSON:
+		return &jsonDecoder{d: json.NewDecoder(bytes.NewReader(data))}, nil
+	case CSV:
+		r := csv.NewReader(bytes.NewReader(data))
+		r.FieldsPerRecord = -1
+		if it.opts.SkipHeader {
+			if _, err := r.Read(); err != nil && err != io.EOF {
+				return nil, err
+			}
+		}
+		return &csvDecoder{r: r}, nil
+	}
+	return nil, fmt.Errorf("unrecognized format %d", it.opts.Format)
+}
+
+type lineDecoder struct {
+	r *bufio.Reader
+}
+
+func (d *lineDecoder) next(record *Record) error {
+	line, err := d.r.ReadBytes('\n')
+	if err == io.EOF && len(line) > 0 {
+		// The last line doesn't have to end in a newline.
+		err = nil
+	}
+	if err != nil {
+		return err
+	}
+	line = bytes.TrimSuffix(line, []byte("\n"))
+	record.Data = bytes.TrimSuffix(line, []byte("\r"))
+	return nil
+}
+
+type jsonDecoder struct {
+	d *json.Decoder
+}
+
+func (d *jsonDecoder) next(record *Record) error {
+	var value json.RawMessage
+	if err := d.d.Decode(&value); err != nil {
+		return err
+	}
+	record.Data = value
+	return nil
+}
+
+type csvDecoder struct {
+	r *csv.Reader
+}
+
+func (d *csvDecoder) next(record *Record) error {
+	fields, err := d.r.Read()
+	if err != nil {
+		return err
+	}
+	record.Fields = fields
+	return nil
+}
@@ -3,12 +3,22 @@ package client
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"hash"
 	"io"
+	"net/url"
 	"path/filepath"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/pps"
 )
 
 // NewRepo creates a pfs.Repo.
@@ -39,6 +49,77 @@ func NewBlock(hash string) *pfs.Block {
 	}
 }
 
+// NewProject creates a pfs.Project.
+func NewProject(projectName string) *pfs.Project {
+	return &pfs.Project{Name: projectName}
+}
+
+// CreateProject creates a new project. The repos and pipelines in the
+// project are named <project>.<name>.
+func (c APIClient) CreateProject(projectName string, description string) error {
+	_, err := c.PfsAPIClient.CreateProject(
+		c.ctx(),
+		&pfs.CreateProjectRequest{
+			Project:     NewProject(projectName),
+			Description: description,
+		},
+	)
+	return sanitizeErr(err)
+}
+
+// InspectProject returns info about a project.
+func (c APIClient) InspectProject(projectName string) (*pfs.ProjectInfo, error) {
+	projectInfo, err := c.PfsAPIClient.InspectProject(
+		c.ctx(),
+		&pfs.InspectProjectRequest{
+			Project: NewProject(projectName),
+		},
+	)
+	if err != nil {
+		return nil, sanitizeErr(err)
+	}
+	return projectInfo, nil
+}
+
+// ListProject returns info about all projects.
+func (c APIClient) ListProject() ([]*pfs.ProjectInfo, error) {
+	projectInfos, err := c.PfsAPIClient.ListProject(
+		c.ctx(),
+		&pfs.ListProjectRequest{},
+	)
+	if err != nil {
+		return nil, sanitizeErr(err)
+	}
+	return projectInfos.ProjectInfo, nil
+}
+
+// DeleteProject deletes a project. If force is set the pipelines and repos in
+// the project are deleted too, otherwise the project has to be empty.
+func (c APIClient) DeleteProject(projectName string, force bool) error {
+	if force {
+		pipelineInfos, err := c.PpsAPIClient.ListPipeline(
+			c.ctx(),
+			&pps.ListPipelineRequest{Project: projectName},
+		)
+		if err != nil {
+			return sanitizeErr(err)
+		}
+		for _, pipelineInfo := range pipelineInfos.PipelineInfo {
+			if err := c.DeletePipeline(pipelineInfo.Pipeline.Name, true); err != nil {
+				return fmt.Errorf("error deleting pipeline %s: %v", pipelineInfo.Pipeline.Name, err)
+			}
+		}
+	}
+	_, err := c.PfsAPIClient.DeleteProject(
+		c.ctx(),
+		&pfs.DeleteProjectRequest{
+			Project: NewProject(projectName),
+			Force:   force,
+		},
+	)
+	return sanitizeErr(err)
+}
+
 // CreateRepo creates a new Repo object in pfs with the given name. Repos are
 // the top level data object in pfs and should be used to store data of a
 // similar type. For example rather than having a single Repo for an entire
@@ -87,10 +168,10 @@ func (c APIClient) ListRepo(provenance []string) ([]*pfs.RepoInfo, error) {
 }
 
 // DeleteRepo deletes a repo and reclaims the storage space it was using. Note
-// that as of 1.0 we do not reclaim the blocks that the Repo was referencing,
-// this is because they may also be referenced by other Repos and deleting them
-// would make those Repos inaccessible. This will be resolved in later
-// versions.
+// that the objects that the Repo was referencing aren't reclaimed, because
+// they may also be referenced by other Repos and deleting them would make
+// those Repos inaccessible. Set Prune in a DeleteRepoRequest to reclaim the
+// ones that nothing else references.
 // If "force" is set to true, the repo will be removed regardless of errors.
 // This argument should be used with care.
 func (c APIClient) DeleteRepo(repoName string, force bool) error {
@@ -164,6 +245,44 @@ func (c APIClient) StartCommitParent(repoName string, branch string, parentCommi
 	return commit, nil
 }
 
+// StartCommitWithMetadata is like StartCommitParent, and also sets the
+// commit's description and metadata.
+func (c APIClient) StartCommitWithMetadata(repoName string, branch string, parentCommit string, description string, metadata map[string]string) (*pfs.Commit, error) {
+	commit, err := c.PfsAPIClient.StartCommit(
+		c.ctx(),
+		&pfs.StartCommitRequest{
+			Parent:      NewCommit(repoName, parentCommit),
+			Branch:      branch,
+			Description: description,
+			Metadata:    metadata,
+		},
+	)
+	if err != nil {
+		return nil, sanitizeErr(err)
+	}
+	return commit, nil
+}
+
+// BuildCommit builds a commit in a single call from a HashTree that has
+// already been written to the object store, treeObject is the hash of that
+// object. If treeObject is "" the commit is left open. The commit gets the ID
+// commitID, or a new ID if commitID is "".
+func (c APIClient) BuildCommit(repoName string, branch string, parentCommit string, treeObject string, commitID string) (*pfs.Commit, error) {
+	request := &pfs.BuildCommitRequest{
+		Parent: NewCommit(repoName, parentCommit),
+		Branch: branch,
+		ID:     commitID,
+	}
+	if treeObject != "" {
+		request.Tree = &pfs.Object{Hash: treeObject}
+	}
+	commit, err := c.PfsAPIClient.BuildCommit(c.ctx(), request)
+	if err != nil {
+		return nil, sanitizeErr(err)
+	}
+	return commit, nil
+}
+
 // FinishCommit ends the process of committing data to a Repo and persists the
 // Commit. Once a Commit is finished the data becomes immutable and future
 // attempts to write to it with PutFile will error.
@@ -177,6 +296,21 @@ func (c APIClient) FinishCommit(repoName string, commitID string) error {
 	return sanitizeErr(err)
 }
 
+// FinishCommitWithMetadata is like FinishCommit, and also replaces the
+// commit's description, unless description is "", and adds metadata to its
+// metadata.
+func (c APIClient) FinishCommitWithMetadata(repoName string, commitID string, description string, metadata map[string]string) error {
+	_, err := c.PfsAPIClient.FinishCommit(
+		c.ctx(),
+		&pfs.FinishCommitRequest{
+			Commit:      NewCommit(repoName, commitID),
+			Description: description,
+			Metadata:    metadata,
+		},
+	)
+	return sanitizeErr(err)
+}
+
 // InspectCommit returns info about a specific Commit.
 func (c APIClient) InspectCommit(repoName string, commitID string) (*pfs.CommitInfo, error) {
 	commitInfo, err := c.PfsAPIClient.InspectCommit(
@@ -220,6 +354,180 @@ func (c APIClient) ListCommit(repoName string, to string, from string, number ui
 	return commitInfos.CommitInfo, nil
 }
 
+// ListCommitByTime is like ListCommit, except that only the commits started
+// between since and until are returned. Either of them may be the zero
+// time, which leaves that end of the range open.
+func (c APIClient) ListCommitByTime(repoName string, to string, from string, number uint64, since time.Time, until time.Time) ([]*pfs.CommitInfo, error) {
+	req := &pfs.ListCommitRequest{
+		Repo:   NewRepo(repoName),
+		Number: number,
+	}
+	if from != "" {
+		req.From = NewCommit(repoName, from)
+	}
+	if to != "" {
+		req.To = NewCommit(repoName, to)
+	}
+	var err error
+	if !since.IsZero() {
+		if req.Since, err = types.TimestampProto(since); err != nil {
+			return nil, err
+		}
+	}
+	if !until.IsZero() {
+		if req.Until, err = types.TimestampProto(until); err != nil {
+			return nil, err
+		}
+	}
+	commitInfos, err := c.PfsAPIClient.ListCommit(
+		c.ctx(),
+		req,
+	)
+	if err != nil {
+		return nil, sanitizeErr(err)
+	}
+	return commitInfos.CommitInfo, nil
+}
+
+// CommitNode is a commit in a provenance graph returned by CommitProvenance
+// or CommitSubvenance. Commits that are reachable along several paths are
+// represented by a single node, shared by all of the nodes that point to it.
+type CommitNode struct {
+	CommitInfo *pfs.CommitInfo
+	// Provenance holds the commits that this commit was directly derived
+	// from. It's only set by CommitProvenance.
+	Provenance []*CommitNode
+	// Subvenance holds the commits that were directly derived from this
+	// commit. It's only set by CommitSubvenance.
+	Subvenance []*CommitNode
+}
+
+// CommitProvenance returns the graph of commits that a commit was derived
+// from, rooted at the commit itself. Each node's Provenance holds only its
+// direct provenance, i.e. the commits in the input repos of the pipeline
+// that created it, rather than its full transitive provenance.
+func (c APIClient) CommitProvenance(repoName string, commitID string) (*CommitNode, error) {
+	commitInfo, err := c.InspectCommit(repoName, commitID)
+	if err != nil {
+		return nil, err
+	}
+	root := &CommitNode{CommitInfo: commitInfo}
+	nodes := map[string]*CommitNode{commitKey(commitInfo.Commit): root}
+	for _, commit := range commitInfo.Provenance {
+		provInfo, err := c.InspectCommit(commit.Repo.Name, commit.ID)
+		if err != nil {
+			return nil, err
+		}
+		nodes[commitKey(commit)] = &CommitNode{CommitInfo: provInfo}
+	}
+	for _, node := range nodes {
+		for _, key := range directProvenance(node.CommitInfo, nodes) {
+			node.Provenance = append(node.Provenance, nodes[key])
+		}
+	}
+	return root, nil
+}
+
+// CommitSubvenance returns the graph of commits that were derived from a
+// commit, rooted at the commit itself. Each node's Subvenance holds only the
+// commits that were directly derived from it, i.e. the output commits of the
+// pipelines that take its repo as input.
+func (c APIClient) CommitSubvenance(repoName string, commitID string) (*CommitNode, error) {
+	commitInfo, err := c.InspectCommit(repoName, commitID)
+	if err != nil {
+		return nil, err
+	}
+	root := &CommitNode{CommitInfo: commitInfo}
+	nodes := map[string]*CommitNode{commitKey(commitInfo.Commit): root}
+	repoInfos, err := c.ListRepo([]string{repoName})
+	if err != nil {
+		return nil, err
+	}
+	for _, repoInfo := range repoInfos {
+		commitInfos, err := c.PfsAPIClient.ListCommit(
+			c.ctx(),
+			&pfs.ListCommitRequest{
+				Repo:       repoInfo.Repo,
+				Provenance: commitInfo.Commit,
+			},
+		)
+		if err != nil {
+			return nil, sanitizeErr(err)
+		}
+		for _, subvInfo := range commitInfos.CommitInfo {
+			nodes[commitKey(subvInfo.Commit)] = &CommitNode{CommitInfo: subvInfo}
+		}
+	}
+	// Visit the nodes in a fixed order so that each node's Subvenance is too.
+	var keys []string
+	for key := range nodes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		node := nodes[key]
+		for _, provKey := range directProvenance(node.CommitInfo, nodes) {
+			nodes[provKey].Subvenance = append(nodes[provKey].Subvenance, node)
+		}
+	}
+	return root, nil
+}
+
+// directProvenance returns the keys of the commits in nodes that
+// commitInfo was directly derived from: those in its provenance that aren't
+// also in the provenance of another commit in its provenance.
+func directProvenance(commitInfo *pfs.CommitInfo, nodes map[string]*CommitNode) []string {
+	candidates := make(map[string]bool)
+	for _, commit := range commitInfo.Provenance {
+		if _, ok := nodes[commitKey(commit)]; ok {
+			candidates[commitKey(commit)] = true
+		}
+	}
+	var result []string
+	for key := range candidates {
+		direct := true
+		for other := range candidates {
+			if other == key {
+				continue
+			}
+			for _, commit := range nodes[other].CommitInfo.Provenance {
+				if commitKey(commit) == key {
+					direct = false
+				}
+			}
+		}
+		if direct {
+			result = append(result, key)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+func commitKey(commit *pfs.Commit) string {
+	return commit.Repo.Name + "/" + commit.ID
+}
+
+// FindCommits returns the commits in a repo, or in every repo if repoName is
+// "", whose description contains messageContains, ignoring case, and whose
+// metadata has all the pairs in metadata, newest first. If number isn't 0,
+// at most number commits are returned.
+func (c APIClient) FindCommits(repoName string, messageContains string, metadata map[string]string, number uint64) ([]*pfs.CommitInfo, error) {
+	request := &pfs.FindCommitsRequest{
+		MessageContains: messageContains,
+		Metadata:        metadata,
+		Number:          number,
+	}
+	if repoName != "" {
+		request.Repo = NewRepo(repoName)
+	}
+	commitInfos, err := c.PfsAPIClient.FindCommits(c.ctx(), request)
+	if err != nil {
+		return nil, sanitizeErr(err)
+	}
+	return commitInfos.CommitInfo, nil
+}
+
 // ListCommitByRepo lists all commits in a repo.
 func (c APIClient) ListCommitByRepo(repoName string) ([]*pfs.CommitInfo, error) {
 	return c.ListCommit(repoName, "", "", 0)
@@ -352,10 +660,22 @@ func (c APIClient) SubscribeCommit(repo string, branch string, from string) (Com
 
 // PutObject puts a value into the object store and tags it with 0 or more tags.
 func (c APIClient) PutObject(r io.Reader, tags ...string) (object *pfs.Object, _ int64, retErr error) {
+	return c.putObject(r, nil, tags...)
+}
+
+// PutObjectDelta is like PutObject, except that the object may be stored as
+// a delta against base, which should be similar to it, e.g. the previous
+// version of the same data.
+func (c APIClient) PutObjectDelta(r io.Reader, base *pfs.Object, tags ...string) (object *pfs.Object, _ int64, retErr error) {
+	return c.putObject(r, base, tags...)
+}
+
+func (c APIClient) putObject(r io.Reader, base *pfs.Object, tags ...string) (object *pfs.Object, _ int64, retErr error) {
 	w, err := c.newPutObjectWriteCloser(tags...)
 	if err != nil {
 		return nil, 0, sanitizeErr(err)
 	}
+	w.request.DeltaBase = base
 	defer func() {
 		if err := w.Close(); err != nil && retErr == nil {
 			retErr = sanitizeErr(err)
@@ -389,6 +709,40 @@ func (c APIClient) GetObject(hash string, writer io.Writer) error {
 	return nil
 }
 
+// DebugObject reads an object directly from object storage, bypassing
+// pachd's caches, and returns a report of how it's stored. If verify is
+// true the object's content is read and hashed, and if writer is non-nil
+// the content is written to it.
+func (c APIClient) DebugObject(hash string, verify bool, writer io.Writer) (*pfs.DebugObjectResponse, error) {
+	debugObjectClient, err := c.ObjectAPIClient.DebugObject(
+		c.ctx(),
+		&pfs.DebugObjectRequest{
+			Object:         &pfs.Object{Hash: hash},
+			Verify:         verify,
+			IncludeContent: writer != nil,
+		},
+	)
+	if err != nil {
+		return nil, sanitizeErr(err)
+	}
+	report, err := debugObjectClient.Recv()
+	if err != nil {
+		return nil, sanitizeErr(err)
+	}
+	for {
+		resp, err := debugObjectClient.Recv()
+		if err == io.EOF {
+			return report, nil
+		}
+		if err != nil {
+			return nil, sanitizeErr(err)
+		}
+		if _, err := writer.Write(resp.Value); err != nil {
+			return nil, err
+		}
+	}
+}
+
 // ReadObject gets an object by hash and returns it directly as []byte.
 func (c APIClient) ReadObject(hash string) ([]byte, error) {
 	var buffer bytes.Buffer
@@ -557,6 +911,24 @@ func (c APIClient) PutFileURL(repoName string, commitID string, path string, url
 	return nil
 }
 
+// PutFileQuery puts a file whose content is the result of running query
+// against a sql connection. connection is the name of a data source in
+// pachd's sql-connections secret, format is "csv" or "json". The query is
+// run by pachd, not the client.
+func (c APIClient) PutFileQuery(repoName string, commitID string, path string, connection string, query string, format string) error {
+	values := url.Values{}
+	values.Set("query", query)
+	if format != "" {
+		values.Set("format", format)
+	}
+	u := url.URL{
+		Scheme:   "sql",
+		Host:     connection,
+		RawQuery: values.Encode(),
+	}
+	return c.PutFileURL(repoName, commitID, path, u.String(), false)
+}
+
 // GetFile returns the contents of a file at a specific Commit.
 // offset specifies a number of bytes that should be skipped in the beginning of the file.
 // size limits the total amount of data returned, note you will get fewer bytes
@@ -577,6 +949,62 @@ func (c APIClient) GetFile(repoName string, commitID string, path string, offset
 	return nil
 }
 
+// GetFileVerified writes the contents of a file to writer, like GetFile, and
+// checks them against the hashes that pachd recorded when the file was
+// written: the SHA-512 of each of the objects that make up the file, and the
+// file's hash, which is computed from them. If they don't match, an error is
+// returned and what was written to writer shouldn't be trusted.
+func (c APIClient) GetFileVerified(repoName string, commitID string, path string, writer io.Writer) error {
+	if c.streamSemaphore != nil {
+		c.streamSemaphore <- struct{}{}
+		defer func() { <-c.streamSemaphore }()
+	}
+	fileInfo, err := c.inspectFile(repoName, commitID, path)
+	if err != nil {
+		return err
+	}
+	if fileInfo.FileType != pfs.FileType_FILE {
+		return fmt.Errorf("%s is not a file", path)
+	}
+	// A file's hash is the SHA-256 of the hashes of its objects, see
+	// hashtree.
+	fileHash := sha256.New()
+	for _, object := range fileInfo.Objects {
+		fileHash.Write([]byte(object.Hash))
+	}
+	if !bytes.Equal(fileHash.Sum(nil), fileInfo.Hash) {
+		return fmt.Errorf("the objects of %s don't match its hash", path)
+	}
+	var size uint64
+	for _, object := range fileInfo.Objects {
+		objectHash := sha512.New()
+		w := &countWriter{w: io.MultiWriter(writer, objectHash)}
+		if err := c.GetObject(object.Hash, w); err != nil {
+			return err
+		}
+		if actual := hex.EncodeToString(objectHash.Sum(nil)); actual != object.Hash {
+			return fmt.Errorf("object %s of %s was corrupted, its content has hash %s", object.Hash, path, actual)
+		}
+		size += w.n
+	}
+	if size != fileInfo.SizeBytes {
+		return fmt.Errorf("got %d bytes of %s but its size is %d", size, path, fileInfo.SizeBytes)
+	}
+	return nil
+}
+
+// countWriter counts the bytes written to w.
+type countWriter struct {
+	w io.Writer
+	n uint64
+}
+
+func (w *countWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	w.n += uint64(n)
+	return n, err
+}
+
 // GetFileReader returns a reader for the contents of a file at a specific Commit.
 // offset specifies a number of bytes that should be skipped in the beginning of the file.
 // size limits the total amount of data returned, note you will get fewer bytes
@@ -658,6 +1086,19 @@ func (c APIClient) GlobFile(repoName string, commitID string, pattern string) ([
 // paths and have identical content are omitted.
 func (c APIClient) DiffFile(newRepoName, newCommitID, newPath, oldRepoName,
 	oldCommitID, oldPath string) ([]*pfs.FileInfo, []*pfs.FileInfo, error) {
+	return c.diffFile(newRepoName, newCommitID, newPath, oldRepoName, oldCommitID, oldPath, false)
+}
+
+// DiffFileShallow is like DiffFile, except that only the children of the
+// paths are compared, and directories that differ are returned rather than
+// the files in them.
+func (c APIClient) DiffFileShallow(newRepoName, newCommitID, newPath, oldRepoName,
+	oldCommitID, oldPath string) ([]*pfs.FileInfo, []*pfs.FileInfo, error) {
+	return c.diffFile(newRepoName, newCommitID, newPath, oldRepoName, oldCommitID, oldPath, true)
+}
+
+func (c APIClient) diffFile(newRepoName, newCommitID, newPath, oldRepoName,
+	oldCommitID, oldPath string, shallow bool) ([]*pfs.FileInfo, []*pfs.FileInfo, error) {
 	var oldFile *pfs.File
 	if oldRepoName != "" {
 		oldFile = NewFile(oldRepoName, oldCommitID, oldPath)
@@ -667,6 +1108,7 @@ func (c APIClient) DiffFile(newRepoName, newCommitID, newPath, oldRepoName,
 		&pfs.DiffFileRequest{
 			NewFile: NewFile(newRepoName, newCommitID, newPath),
 			OldFile: oldFile,
+			Shallow: shallow,
 		},
 	)
 	if err != nil {
@@ -675,6 +1117,72 @@ func (c APIClient) DiffFile(newRepoName, newCommitID, newPath, oldRepoName,
 	return resp.NewFiles, resp.OldFiles, nil
 }
 
+// DiffFileF is like DiffFile, except that it calls f with each file that
+// differs between the 2 paths, paired up with the file at the same
+// position under the other path. Either of the pair is nil if the file
+// isn't present under that path, i.e. if it was added or deleted. The files
+// are visited in lexicographical order of their paths, relative to newPath
+// and oldPath. Returning a non-nil error from f aborts DiffFileF, which
+// returns the error.
+func (c APIClient) DiffFileF(newRepoName, newCommitID, newPath, oldRepoName,
+	oldCommitID, oldPath string, f func(newFile, oldFile *pfs.FileInfo) error) error {
+	return c.diffFileF(newRepoName, newCommitID, newPath, oldRepoName, oldCommitID, oldPath, false, f)
+}
+
+// DiffFileShallowF is like DiffFileF, but compares the paths like
+// DiffFileShallow.
+func (c APIClient) DiffFileShallowF(newRepoName, newCommitID, newPath, oldRepoName,
+	oldCommitID, oldPath string, f func(newFile, oldFile *pfs.FileInfo) error) error {
+	return c.diffFileF(newRepoName, newCommitID, newPath, oldRepoName, oldCommitID, oldPath, true, f)
+}
+
+func (c APIClient) diffFileF(newRepoName, newCommitID, newPath, oldRepoName,
+	oldCommitID, oldPath string, shallow bool, f func(newFile, oldFile *pfs.FileInfo) error) error {
+	newFiles, oldFiles, err := c.diffFile(newRepoName, newCommitID, newPath, oldRepoName, oldCommitID, oldPath, shallow)
+	if err != nil {
+		return err
+	}
+	if oldRepoName == "" {
+		oldPath = newPath
+	}
+	pairs := make(map[string][2]*pfs.FileInfo)
+	for _, fileInfo := range newFiles {
+		relPath := diffRelPath(newPath, fileInfo.File.Path)
+		pair := pairs[relPath]
+		pair[0] = fileInfo
+		pairs[relPath] = pair
+	}
+	for _, fileInfo := range oldFiles {
+		relPath := diffRelPath(oldPath, fileInfo.File.Path)
+		pair := pairs[relPath]
+		pair[1] = fileInfo
+		pairs[relPath] = pair
+	}
+	var relPaths []string
+	for relPath := range pairs {
+		relPaths = append(relPaths, relPath)
+	}
+	sort.Strings(relPaths)
+	for _, relPath := range relPaths {
+		pair := pairs[relPath]
+		if err := f(pair[0], pair[1]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// diffRelPath returns the path of file relative to root, the path that the
+// diff was taken at.
+func diffRelPath(root string, file string) string {
+	root = filepath.Join("/", root)
+	file = filepath.Join("/", file)
+	if root == "/" {
+		return file
+	}
+	return strings.TrimPrefix(file, root)
+}
+
 // WalkFn is the type of the function called for each file in Walk.
 // Returning a non-nil error from WalkFn will result in Walk aborting and
 // returning said error.
@@ -703,6 +1211,8 @@ func (c APIClient) Walk(repoName string, commitID string, path string, walkFn Wa
 // to later attempting to get the file from the finished commit will result in
 // not found error.
 // The file will of course remain intact in the Commit's parent.
+// Deleting a directory deletes everything under it. path can be a glob
+// pattern, in which case everything that matches it is deleted.
 func (c APIClient) DeleteFile(repoName string, commitID string, path string) error {
 	_, err := c.PfsAPIClient.DeleteFile(
 		c.ctx(),
@@ -713,10 +1223,97 @@ func (c APIClient) DeleteFile(repoName string, commitID string, path string) err
 	return err
 }
 
+// AcquireLease leases a path in an open commit to owner for ttl, or a minute
+// if ttl is 0. Leases are advisory: they let writers that cooperate on a
+// commit agree on who writes under a path, but they don't stop other writes.
+// It fails if the path, a directory above it or a path under it is leased by
+// another owner.
+func (c APIClient) AcquireLease(repoName string, commitID string, path string, owner string, ttl time.Duration) (*pfs.Lease, error) {
+	request := &pfs.AcquireLeaseRequest{
+		File:  NewFile(repoName, commitID, path),
+		Owner: owner,
+	}
+	if ttl != 0 {
+		request.TTL = types.DurationProto(ttl)
+	}
+	lease, err := c.PfsAPIClient.AcquireLease(c.ctx(), request)
+	return lease, sanitizeErr(err)
+}
+
+// RenewLease extends a lease that hasn't expired to last for ttl from now,
+// or a minute if ttl is 0.
+func (c APIClient) RenewLease(repoName string, commitID string, leaseID string, ttl time.Duration) (*pfs.Lease, error) {
+	request := &pfs.RenewLeaseRequest{
+		Lease: &pfs.Lease{
+			ID:   leaseID,
+			File: &pfs.File{Commit: NewCommit(repoName, commitID)},
+		},
+	}
+	if ttl != 0 {
+		request.TTL = types.DurationProto(ttl)
+	}
+	lease, err := c.PfsAPIClient.RenewLease(c.ctx(), request)
+	return lease, sanitizeErr(err)
+}
+
+// ReleaseLease releases a lease.
+func (c APIClient) ReleaseLease(repoName string, commitID string, leaseID string) error {
+	_, err := c.PfsAPIClient.ReleaseLease(
+		c.ctx(),
+		&pfs.Lease{
+			ID:   leaseID,
+			File: &pfs.File{Commit: NewCommit(repoName, commitID)},
+		},
+	)
+	return sanitizeErr(err)
+}
+
+// ListLease returns the leases in an open commit.
+func (c APIClient) ListLease(repoName string, commitID string) ([]*pfs.Lease, error) {
+	leases, err := c.PfsAPIClient.ListLease(
+		c.ctx(),
+		&pfs.ListLeaseRequest{
+			Commit: NewCommit(repoName, commitID),
+		},
+	)
+	if err != nil {
+		return nil, sanitizeErr(err)
+	}
+	return leases.Lease, nil
+}
+
+// Fsck checks the consistency of PFS's metadata and objects, calling f with
+// each inconsistency that it finds. If fix and removeDangling are true,
+// branches whose head doesn't exist are deleted and repos that don't exist
+// are removed from provenance.
+func (c APIClient) Fsck(fix bool, removeDangling bool, f func(*pfs.FsckResponse) error) error {
+	fsckClient, err := c.PfsAPIClient.Fsck(c.ctx(), &pfs.FsckRequest{
+		Fix:            fix,
+		RemoveDangling: removeDangling,
+	})
+	if err != nil {
+		return sanitizeErr(err)
+	}
+	for {
+		resp, err := fsckClient.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return sanitizeErr(err)
+		}
+		if err := f(resp); err != nil {
+			return err
+		}
+	}
+}
+
 type putFileWriteCloser struct {
 	request       *pfs.PutFileRequest
 	putFileClient pfs.API_PutFileClient
-	sent          bool
+	// hash is the checksum of what's been written, which pachd verifies
+	// once it has stored the file.
+	hash hash.Hash
 }
 
 func (c APIClient) newPutFileWriteCloser(repoName string, commitID string, path string, delimiter pfs.Delimiter, targetFileDatums int64, targetFileBytes int64) (*putFileWriteCloser, error) {
@@ -732,6 +1329,7 @@ func (c APIClient) newPutFileWriteCloser(repoName string, commitID string, path
 			TargetFileBytes:  targetFileBytes,
 		},
 		putFileClient: putFileClient,
+		hash:          sha256.New(),
 	}, nil
 }
 
@@ -749,11 +1347,11 @@ func (w *putFileWriteCloser) Write(p []byte) (int, error) {
 		if len(actualP) == 0 {
 			break
 		}
+		w.hash.Write(actualP)
 		w.request.Value = actualP
 		if err := w.putFileClient.Send(w.request); err != nil {
 			return 0, sanitizeErr(err)
 		}
-		w.sent = true
 		w.request.Value = nil
 		// File is only needed on the first request
 		w.request.File = nil
@@ -763,12 +1361,11 @@ func (w *putFileWriteCloser) Write(p []byte) (int, error) {
 }
 
 func (w *putFileWriteCloser) Close() error {
-	// we always send at least one request, otherwise it's impossible to create
-	// an empty file
-	if !w.sent {
-		if err := w.putFileClient.Send(w.request); err != nil {
-			return err
-		}
+	// The last request carries the checksum, we always send at least one
+	// request, otherwise it's impossible to create an empty file.
+	w.request.Checksum = hex.EncodeToString(w.hash.Sum(nil))
+	if err := w.putFileClient.Send(w.request); err != nil {
+		return sanitizeErr(err)
 	}
 	_, err := w.putFileClient.CloseAndRecv()
 	return sanitizeErr(err)
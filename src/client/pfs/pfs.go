@@ -1,6 +1,24 @@
 package pfs
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
+
+// ProjectSeparator separates the project from the name in the names of the
+// repos and pipelines in a project. It isn't "/" because that separates the
+// repo from the commit in "repo/commit".
+const ProjectSeparator = "."
+
+// SplitProject splits the name of a repo or pipeline into its project and
+// its name within the project. project is "" if it's not in a project.
+func SplitProject(fullName string) (project string, name string) {
+	i := strings.Index(fullName, ProjectSeparator)
+	if i < 0 {
+		return "", fullName
+	}
+	return fullName[:i], fullName[i+len(ProjectSeparator):]
+}
 
 // FullID prints repoName/CommitID
 func (c *Commit) FullID() string {
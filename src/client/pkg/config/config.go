@@ -26,6 +26,31 @@ func Read() (*Config, error) {
 	return c, err
 }
 
+// Write saves c as the pachyderm user config, replacing the existing one.
+func Write(c *Config) error {
+	rawConfig, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(configDirPath, 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(configPath, rawConfig, 0644)
+}
+
+// PachdAddress returns the address of pachd in the active context, or "" if
+// there isn't an active context.
+func (c *Config) PachdAddress() (string, error) {
+	if c.ActiveContext == "" {
+		return "", nil
+	}
+	context, ok := c.Contexts[c.ActiveContext]
+	if !ok {
+		return "", fmt.Errorf("the active context %q isn't in %s", c.ActiveContext, configPath)
+	}
+	return context.PachdAddress, nil
+}
+
 func createDefaults() (*Config, error) {
 	c := &Config{
 		UserID: uuid.NewWithoutDashes(),
@@ -0,0 +1,148 @@
+package grpcutil
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"golang.org/x/net/http2"
+	"google.golang.org/grpc"
+)
+
+const (
+	grpcContentType    = "application/grpc"
+	grpcWebContentType = "application/grpc-web"
+	// grpcWebTrailerFlag marks the frame that carries the trailers at the
+	// end of a grpc-web response body.
+	grpcWebTrailerFlag = 0x80
+)
+
+// NewGRPCWebHandler returns an http.Handler which serves the grpc-web
+// protocol by translating each request into a regular gRPC request to
+// grpcServer. This lets browsers, which can't speak gRPC because they don't
+// expose HTTP/2 trailers, call the API directly.
+//
+// Only the binary encoding (application/grpc-web+proto) is supported, not
+// the base64 one (application/grpc-web-text). Requests from any origin are
+// allowed.
+func NewGRPCWebHandler(grpcServer *grpc.Server) http.Handler {
+	return &grpcWebHandler{grpcServer: grpcServer}
+}
+
+type grpcWebHandler struct {
+	grpcServer *grpc.Server
+}
+
+func (h *grpcWebHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	setCORSHeaders(w, r)
+	if r.Method == "OPTIONS" {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	contentType := r.Header.Get("Content-Type")
+	if r.Method != "POST" || !strings.HasPrefix(contentType, grpcWebContentType) {
+		http.Error(w, fmt.Sprintf("grpc-web requests must be POSTs with content-type %s", grpcWebContentType), http.StatusUnsupportedMediaType)
+		return
+	}
+	if strings.HasPrefix(contentType, grpcWebContentType+"-text") {
+		http.Error(w, "grpc-web-text isn't supported, use application/grpc-web+proto", http.StatusUnsupportedMediaType)
+		return
+	}
+	// The framing of grpc-web requests is the same as gRPC's, so all we
+	// have to change is what the request says it is.
+	grpcRequest := new(http.Request)
+	*grpcRequest = *r
+	grpcRequest.ProtoMajor = 2
+	grpcRequest.ProtoMinor = 0
+	grpcRequest.Proto = "HTTP/2"
+	grpcRequest.Header = make(http.Header)
+	for k, v := range r.Header {
+		grpcRequest.Header[k] = v
+	}
+	grpcRequest.Header.Set("Content-Type", grpcContentType+strings.TrimPrefix(contentType, grpcWebContentType))
+	grpcRequest.Header.Del("Content-Length")
+
+	gw := &grpcWebResponseWriter{ResponseWriter: w}
+	h.grpcServer.ServeHTTP(gw, grpcRequest)
+	gw.writeTrailers()
+}
+
+func setCORSHeaders(w http.ResponseWriter, r *http.Request) {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		origin = "*"
+	}
+	w.Header().Set("Access-Control-Allow-Origin", origin)
+	w.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
+	w.Header().Set("Access-Control-Allow-Headers", "content-type, x-grpc-web, x-user-agent, grpc-timeout, authorization")
+	w.Header().Set("Access-Control-Expose-Headers", "grpc-status, grpc-message")
+}
+
+// grpcWebResponseWriter turns the response written by the gRPC server into
+// a grpc-web response, the trailers, which gRPC sends as HTTP/2 trailers,
+// are appended to the body instead.
+type grpcWebResponseWriter struct {
+	http.ResponseWriter
+	wroteHeader bool
+}
+
+func (w *grpcWebResponseWriter) WriteHeader(code int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	header := w.Header()
+	header.Set("Content-Type", grpcWebContentType+strings.TrimPrefix(header.Get("Content-Type"), grpcContentType))
+	// Everything declared as a trailer goes in the body.
+	header.Del("Trailer")
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *grpcWebResponseWriter) Write(data []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *grpcWebResponseWriter) Flush() {
+	w.WriteHeader(http.StatusOK)
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func (w *grpcWebResponseWriter) CloseNotify() <-chan bool {
+	if closeNotifier, ok := w.ResponseWriter.(http.CloseNotifier); ok {
+		return closeNotifier.CloseNotify()
+	}
+	return make(chan bool)
+}
+
+// writeTrailers writes the trailers that the gRPC server set, after it
+// wrote the headers, as the last frame of the body.
+func (w *grpcWebResponseWriter) writeTrailers() {
+	w.WriteHeader(http.StatusOK)
+	var trailers bytes.Buffer
+	header := w.Header()
+	for k, vs := range header {
+		name := k
+		switch {
+		case strings.HasPrefix(k, http2.TrailerPrefix):
+			name = strings.TrimPrefix(k, http2.TrailerPrefix)
+		case k == "Grpc-Status" || k == "Grpc-Message":
+		default:
+			continue
+		}
+		for _, v := range vs {
+			fmt.Fprintf(&trailers, "%s: %s\r\n", strings.ToLower(name), v)
+		}
+		header.Del(k)
+	}
+	frameHeader := make([]byte, 5)
+	frameHeader[0] = grpcWebTrailerFlag
+	binary.BigEndian.PutUint32(frameHeader[1:], uint32(trailers.Len()))
+	w.ResponseWriter.Write(frameHeader)
+	w.ResponseWriter.Write(trailers.Bytes())
+	w.Flush()
+}
@@ -0,0 +1,199 @@
+package grpcutil_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+// apiServer implements just enough of PFS for a unary and a streaming
+// method.
+type apiServer struct {
+	pfs.APIServer
+}
+
+func (a *apiServer) InspectRepo(ctx context.Context, request *pfs.InspectRepoRequest) (*pfs.RepoInfo, error) {
+	md, _ := metadata.FromContext(ctx)
+	if request.Repo.Name != "repo" {
+		return nil, grpc.Errorf(codes.NotFound, "repo %s not found", request.Repo.Name)
+	}
+	grpc.SetTrailer(ctx, metadata.Pairs("x-trailer", "trailer"))
+	return &pfs.RepoInfo{Repo: request.Repo, SizeBytes: uint64(len(md["x-header"]))}, nil
+}
+
+func (a *apiServer) GetFile(request *pfs.GetFileRequest, server pfs.API_GetFileServer) error {
+	for i := 0; i < 3; i++ {
+		if err := server.Send(&types.BytesValue{Value: []byte(fmt.Sprintf("chunk%d", i))}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newGRPCWebServer() *httptest.Server {
+	grpcServer := grpc.NewServer()
+	pfs.RegisterAPIServer(grpcServer, &apiServer{})
+	return httptest.NewServer(grpcutil.NewGRPCWebHandler(grpcServer))
+}
+
+// grpcWebCall makes a grpc-web request and returns the response, and the
+// frames in its body.
+func grpcWebCall(t *testing.T, s *httptest.Server, method string, request proto.Message, header map[string]string) (*http.Response, []frame) {
+	data, err := proto.Marshal(request)
+	require.NoError(t, err)
+	body := &bytes.Buffer{}
+	body.WriteByte(0)
+	binary.Write(body, binary.BigEndian, uint32(len(data)))
+	body.Write(data)
+	req, err := http.NewRequest("POST", s.URL+method, body)
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/grpc-web+proto")
+	req.Header.Set("X-Grpc-Web", "1")
+	for key, value := range header {
+		req.Header.Set(key, value)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	return resp, readFrames(t, resp.Body)
+}
+
+type frame struct {
+	flag byte
+	data []byte
+}
+
+func readFrames(t *testing.T, r io.Reader) []frame {
+	var frames []frame
+	for {
+		header := make([]byte, 5)
+		if _, err := io.ReadFull(r, header); err == io.EOF {
+			return frames
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		data := make([]byte, binary.BigEndian.Uint32(header[1:]))
+		_, err := io.ReadFull(r, data)
+		require.NoError(t, err)
+		frames = append(frames, frame{flag: header[0], data: data})
+	}
+}
+
+// parseTrailers parses the last frame, which must be the trailers.
+func parseTrailers(t *testing.T, frames []frame) map[string]string {
+	require.True(t, len(frames) > 0)
+	last := frames[len(frames)-1]
+	require.Equal(t, byte(0x80), last.flag)
+	result := make(map[string]string)
+	for _, line := range strings.Split(strings.TrimSuffix(string(last.data), "\r\n"), "\r\n") {
+		parts := strings.SplitN(line, ": ", 2)
+		require.Equal(t, 2, len(parts), line)
+		result[parts[0]] = parts[1]
+	}
+	return result
+}
+
+func TestGRPCWebUnary(t *testing.T) {
+	s := newGRPCWebServer()
+	defer s.Close()
+
+	resp, frames := grpcWebCall(t, s, "/pfs.API/InspectRepo", &pfs.InspectRepoRequest{Repo: &pfs.Repo{Name: "repo"}}, map[string]string{"X-Header": "value"})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "application/grpc-web", resp.Header.Get("Content-Type"))
+	require.Equal(t, "", resp.Header.Get("Trailer"))
+	require.Equal(t, 2, len(frames))
+	require.Equal(t, byte(0), frames[0].flag)
+	var repoInfo pfs.RepoInfo
+	require.NoError(t, proto.Unmarshal(frames[0].data, &repoInfo))
+	require.Equal(t, "repo", repoInfo.Repo.Name)
+	// Request headers are passed on as metadata.
+	require.Equal(t, uint64(1), repoInfo.SizeBytes)
+	trailer := parseTrailers(t, frames)
+	require.Equal(t, "0", trailer["grpc-status"])
+	require.Equal(t, "trailer", trailer["x-trailer"])
+}
+
+func TestGRPCWebError(t *testing.T) {
+	s := newGRPCWebServer()
+	defer s.Close()
+
+	resp, frames := grpcWebCall(t, s, "/pfs.API/InspectRepo", &pfs.InspectRepoRequest{Repo: &pfs.Repo{Name: "missing"}}, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, 1, len(frames))
+	trailer := parseTrailers(t, frames)
+	require.Equal(t, fmt.Sprintf("%d", codes.NotFound), trailer["grpc-status"])
+	require.Equal(t, "repo missing not found", trailer["grpc-message"])
+
+	_, frames = grpcWebCall(t, s, "/pfs.API/Missing", &pfs.InspectRepoRequest{}, nil)
+	require.Equal(t, fmt.Sprintf("%d", codes.Unimplemented), parseTrailers(t, frames)["grpc-status"])
+}
+
+func TestGRPCWebStreaming(t *testing.T) {
+	s := newGRPCWebServer()
+	defer s.Close()
+
+	resp, frames := grpcWebCall(t, s, "/pfs.API/GetFile", &pfs.GetFileRequest{}, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, 4, len(frames))
+	for i, frame := range frames[:3] {
+		require.Equal(t, byte(0), frame.flag)
+		var value types.BytesValue
+		require.NoError(t, proto.Unmarshal(frame.data, &value))
+		require.Equal(t, fmt.Sprintf("chunk%d", i), string(value.Value))
+	}
+	require.Equal(t, "0", parseTrailers(t, frames)["grpc-status"])
+}
+
+func TestGRPCWebInvalid(t *testing.T) {
+	s := newGRPCWebServer()
+	defer s.Close()
+
+	// CORS preflight requests are answered for any origin.
+	req, err := http.NewRequest("OPTIONS", s.URL+"/pfs.API/InspectRepo", nil)
+	require.NoError(t, err)
+	req.Header.Set("Origin", "https://dash.example.com")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+	require.Equal(t, "https://dash.example.com", resp.Header.Get("Access-Control-Allow-Origin"))
+	require.Equal(t, "POST, OPTIONS", resp.Header.Get("Access-Control-Allow-Methods"))
+
+	for _, r := range []struct {
+		method      string
+		contentType string
+	}{
+		{"GET", "application/grpc-web+proto"},
+		{"POST", "application/json"},
+		{"POST", "application/grpc"},
+		{"POST", "application/grpc-web-text"},
+	} {
+		req, err := http.NewRequest(r.method, s.URL+"/pfs.API/InspectRepo", nil)
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", r.contentType)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		_, err = ioutil.ReadAll(resp.Body)
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusUnsupportedMediaType, resp.StatusCode)
+		require.Equal(t, "*", resp.Header.Get("Access-Control-Allow-Origin"))
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"math"
 	"net"
+	"net/http"
+	"time"
 
 	"github.com/pachyderm/pachyderm/src/client/version"
 	"github.com/pachyderm/pachyderm/src/client/version/versionpb"
@@ -19,14 +21,25 @@ var (
 
 // ServeOptions represent optional fields for serving.
 type ServeOptions struct {
-	Version    *versionpb.Version
-	MaxMsgSize int
+	Version           *versionpb.Version
+	MaxMsgSize        int
+	UnaryInterceptor  grpc.UnaryServerInterceptor
+	StreamInterceptor grpc.StreamServerInterceptor
+	// Shutdown, if set, shuts the server down when it's closed. The server
+	// stops accepting connections and requests, and Serve returns once the
+	// requests in progress have finished, or ShutdownTimeout has passed,
+	// after which they're cancelled.
+	Shutdown        <-chan struct{}
+	ShutdownTimeout time.Duration
 }
 
 // ServeEnv are environment variables for serving.
 type ServeEnv struct {
 	// Default is 7070.
 	GRPCPort uint16 `env:"GRPC_PORT,default=7070"`
+	// GRPCWebPort, if set, is the port on which the server also speaks
+	// grpc-web, for browser clients.
+	GRPCWebPort uint16 `env:"GRPC_WEB_PORT,default=0"`
 }
 
 // Serve serves stuff.
@@ -41,10 +54,17 @@ func Serve(
 	if serveEnv.GRPCPort == 0 {
 		serveEnv.GRPCPort = 7070
 	}
-	grpcServer := grpc.NewServer(
+	serverOptions := []grpc.ServerOption{
 		grpc.MaxConcurrentStreams(math.MaxUint32),
 		grpc.MaxMsgSize(options.MaxMsgSize),
-	)
+	}
+	if options.UnaryInterceptor != nil {
+		serverOptions = append(serverOptions, grpc.UnaryInterceptor(options.UnaryInterceptor))
+	}
+	if options.StreamInterceptor != nil {
+		serverOptions = append(serverOptions, grpc.StreamInterceptor(options.StreamInterceptor))
+	}
+	grpcServer := grpc.NewServer(serverOptions...)
 	registerFunc(grpcServer)
 	if options.Version != nil {
 		versionpb.RegisterAPIServer(grpcServer, version.NewAPIServer(options.Version, version.APIServerOptions{}))
@@ -53,5 +73,38 @@ func Serve(
 	if err != nil {
 		return err
 	}
-	return grpcServer.Serve(listener)
+	errCh := make(chan error, 2)
+	go func() {
+		errCh <- grpcServer.Serve(listener)
+	}()
+	var webListener net.Listener
+	if serveEnv.GRPCWebPort != 0 {
+		webListener, err = net.Listen("tcp", fmt.Sprintf(":%d", serveEnv.GRPCWebPort))
+		if err != nil {
+			grpcServer.Stop()
+			return err
+		}
+		go func() {
+			errCh <- http.Serve(webListener, NewGRPCWebHandler(grpcServer))
+		}()
+	}
+	select {
+	case err := <-errCh:
+		return err
+	case <-options.Shutdown:
+	}
+	if webListener != nil {
+		webListener.Close()
+	}
+	stopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(options.ShutdownTimeout):
+		grpcServer.Stop()
+	}
+	return nil
 }
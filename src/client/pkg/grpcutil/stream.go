@@ -2,16 +2,43 @@ package grpcutil
 
 import (
 	"bytes"
+	"fmt"
 	"io"
+	"os"
 
+	"github.com/docker/go-units"
 	"github.com/gogo/protobuf/types"
 )
 
 var (
-	// MaxMsgSize is used to define the GRPC frame size
+	// MaxMsgSize is used to define the GRPC frame size, it's the largest
+	// message that servers accept, and clients split what they send into
+	// messages smaller than it. It can be set with MaxMsgSizeEnv.
 	MaxMsgSize = 20 * 1024 * 1024
 )
 
+// MaxMsgSizeEnv is the environment variable that sets MaxMsgSize, in bytes
+// with SI suffixes, e.g. "64M". Clients should use the same size as the
+// servers they talk to.
+const MaxMsgSizeEnv = "PACH_MAX_MSG_SIZE"
+
+// SetMaxMsgSizeFromEnv sets MaxMsgSize from MaxMsgSizeEnv, if it's set.
+func SetMaxMsgSizeFromEnv() error {
+	size := os.Getenv(MaxMsgSizeEnv)
+	if size == "" {
+		return nil
+	}
+	n, err := units.RAMInBytes(size)
+	if err != nil {
+		return fmt.Errorf("invalid %s %q: %v", MaxMsgSizeEnv, size, err)
+	}
+	if n <= 0 {
+		return fmt.Errorf("invalid %s %q: must be positive", MaxMsgSizeEnv, size)
+	}
+	MaxMsgSize = int(n)
+	return nil
+}
+
 // Chunk splits a piece of data up, this is useful for splitting up data that's
 // bigger than MaxMsgSize
 func Chunk(data []byte, chunkSize int) [][]byte {
@@ -0,0 +1,77 @@
+// Package pbutil reads and writes streams of length-delimited protobufs.
+package pbutil
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+
+	"github.com/gogo/protobuf/proto"
+)
+
+// Reader is io.Reader for proto.Message instead of []byte.
+type Reader interface {
+	Read(val proto.Message) error
+}
+
+// Writer is io.Writer for proto.Message instead of []byte.
+type Writer interface {
+	Write(val proto.Message) (int64, error)
+}
+
+type readWriter struct {
+	r   io.Reader
+	w   io.Writer
+	buf []byte
+}
+
+// NewReader returns a new Reader with r as its source.
+func NewReader(r io.Reader) Reader {
+	return &readWriter{r: r}
+}
+
+// NewWriter returns a new Writer with w as its sink.
+func NewWriter(w io.Writer) Writer {
+	return &readWriter{w: w}
+}
+
+// Read reads the next message from the underlying reader into val. It
+// returns io.EOF once the stream is exhausted. Messages can be at most
+// grpcutil.MaxMsgSize, since that's the most that could have been sent in
+// one.
+func (r *readWriter) Read(val proto.Message) error {
+	var l int64
+	if err := binary.Read(r.r, binary.LittleEndian, &l); err != nil {
+		return err
+	}
+	if l < 0 || l > int64(grpcutil.MaxMsgSize) {
+		return fmt.Errorf("invalid message length %d, it must be between 0 and %d", l, grpcutil.MaxMsgSize)
+	}
+	if int64(cap(r.buf)) < l {
+		r.buf = make([]byte, l)
+	}
+	buf := r.buf[:l]
+	if _, err := io.ReadFull(r.r, buf); err != nil {
+		if err == io.EOF {
+			return io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	return proto.Unmarshal(buf, val)
+}
+
+// Write writes val to the underlying writer, prefixed with its length. It
+// returns the number of bytes written.
+func (r *readWriter) Write(val proto.Message) (int64, error) {
+	bytes, err := proto.Marshal(val)
+	if err != nil {
+		return 0, err
+	}
+	if err := binary.Write(r.w, binary.LittleEndian, int64(len(bytes))); err != nil {
+		return 0, err
+	}
+	n, err := r.w.Write(bytes)
+	return int64(n) + 8, err
+}
@@ -0,0 +1,45 @@
+package pbutil
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+func TestRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	hashes := []string{"foo", "", "bar"}
+	for _, hash := range hashes {
+		_, err := w.Write(&pfs.Object{Hash: hash})
+		require.NoError(t, err)
+	}
+	r := NewReader(&buf)
+	for _, hash := range hashes {
+		object := &pfs.Object{}
+		require.NoError(t, r.Read(object))
+		require.Equal(t, hash, object.Hash)
+	}
+	require.Equal(t, io.EOF, r.Read(&pfs.Object{}))
+}
+
+func TestInvalidLength(t *testing.T) {
+	for _, l := range []int64{-1, int64(grpcutil.MaxMsgSize) + 1, 1 << 62} {
+		var buf bytes.Buffer
+		require.NoError(t, binary.Write(&buf, binary.LittleEndian, l))
+		require.YesError(t, NewReader(&buf).Read(&pfs.Object{}))
+	}
+}
+
+func TestTruncated(t *testing.T) {
+	var buf bytes.Buffer
+	_, err := NewWriter(&buf).Write(&pfs.Object{Hash: "foo"})
+	require.NoError(t, err)
+	truncated := bytes.NewReader(buf.Bytes()[:buf.Len()-1])
+	require.Equal(t, io.ErrUnexpectedEOF, NewReader(truncated).Read(&pfs.Object{}))
+}
@@ -1,13 +1,17 @@
 package client
 
 import (
+	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pps"
+
+	"github.com/gogo/protobuf/types"
 )
 
 // NewJob creates a pps.Job.
@@ -44,6 +48,34 @@ const (
 	PPSOutputPath = "/pfs/out"
 	// PPSWorkerPort is the port that workers use for their gRPC server
 	PPSWorkerPort = 80
+	// PPSWorkerShutdownGracePeriod is how long a worker whose pod is being
+	// deleted gives the datums it's processing to finish before cancelling
+	// them. Its sidecar keeps serving for as long.
+	PPSWorkerShutdownGracePeriod = 60 * time.Second
+	// PPSWorkerS3GatewayPort is the port that a worker's sidecar serves
+	// the S3 gateway on, for pipelines that enable it.
+	PPSWorkerS3GatewayPort = 654
+	// PPSS3EndpointEnv is the env var that tells user code where the S3
+	// gateway is.
+	PPSS3EndpointEnv = "S3_ENDPOINT"
+	// PPSDatumIDEnv is the env var that tells user code the ID of the datum
+	// that it's processing. A datum's ID is a hash of its inputs and of the
+	// pipeline's version, so it's the same in each job that processes the
+	// same inputs with the same version of the pipeline.
+	PPSDatumIDEnv = "PACH_DATUM_ID"
+	// PPSDatumManifestEnv is the env var that tells user code where the
+	// manifest of the datum that it's processing, a DatumManifest in JSON,
+	// is.
+	PPSDatumManifestEnv = "PACH_DATUM_MANIFEST"
+	// PPSDatumManifestPath is where the manifest of the datum that user code
+	// is processing is written.
+	PPSDatumManifestPath = "/pfs/.datum.json"
+	// PPSDatumStateEnv is the env var that tells a transform's after_datum
+	// hook whether the datum was processed, "success", or not, "failure".
+	PPSDatumStateEnv = "PACH_DATUM_STATE"
+	// PPSJobStateEnv is the env var that tells a transform's job_end hook
+	// the state that the job finished in, e.g. "JOB_SUCCESS".
+	PPSJobStateEnv = "PACH_JOB_STATE"
 	// PPSWorkerVolume is the name of the volume in which workers store
 	// data.
 	PPSWorkerVolume = "pachyderm-worker"
@@ -217,6 +249,99 @@ func (c APIClient) ListJob(pipelineName string, inputCommit []*pfs.Commit) ([]*p
 	return jobInfos.JobInfo, nil
 }
 
+// FlushJob calls f with each of the jobs triggered, directly or
+// transitively, by commits, as it finishes. If toPipelines is non-empty then
+// only jobs in those pipelines, and the pipelines upstream of them, are
+// waited for. Jobs downstream of a job that didn't succeed aren't triggered,
+// so they aren't waited for.
+func (c APIClient) FlushJob(commits []*pfs.Commit, toPipelines []string, f func(*pps.JobInfo) error) error {
+	var pipelines []*pps.Pipeline
+	for _, name := range toPipelines {
+		pipelines = append(pipelines, NewPipeline(name))
+	}
+	flushJobClient, err := c.PpsAPIClient.FlushJob(
+		c.ctx(),
+		&pps.FlushJobRequest{
+			Commits:     commits,
+			ToPipelines: pipelines,
+		},
+	)
+	if err != nil {
+		return sanitizeErr(err)
+	}
+	for {
+		jobInfo, err := flushJobClient.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return sanitizeErr(err)
+		}
+		if err := f(jobInfo); err != nil {
+			return err
+		}
+	}
+}
+
+// FlushJobAll returns all the jobs that FlushJob calls f with.
+func (c APIClient) FlushJobAll(commits []*pfs.Commit, toPipelines []string) ([]*pps.JobInfo, error) {
+	var result []*pps.JobInfo
+	if err := c.FlushJob(commits, toPipelines, func(jobInfo *pps.JobInfo) error {
+		result = append(result, jobInfo)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// JobInfoIterator wraps a stream of jobs and makes them easy to iterate.
+type JobInfoIterator interface {
+	Next() (*pps.JobInfo, error)
+	Close()
+}
+
+type jobInfoIterator struct {
+	stream pps.API_SubscribeJobClient
+	cancel context.CancelFunc
+}
+
+func (j *jobInfoIterator) Next() (*pps.JobInfo, error) {
+	return j.stream.Recv()
+}
+
+func (j *jobInfoIterator) Close() {
+	j.cancel()
+	// Drain the stream so that it's closed on the server's side too, see
+	// commitInfoIterator.Close.
+	for {
+		if _, err := j.stream.Recv(); err != nil {
+			break
+		}
+	}
+}
+
+// SubscribeJob returns an iterator over pipeline's jobs, which returns each
+// job when it's created and again every time it's updated, e.g. when its
+// state changes. It lets callers wait for jobs to finish without polling
+// InspectJob. If includeExisting is true the pipeline's existing jobs are
+// returned first. The iterator must be closed when it's no longer needed.
+func (c APIClient) SubscribeJob(pipeline string, includeExisting bool) (JobInfoIterator, error) {
+	ctx, cancel := context.WithCancel(c.ctx())
+	stream, err := c.PpsAPIClient.SubscribeJob(
+		ctx,
+		&pps.SubscribeJobRequest{
+			Pipeline:        NewPipeline(pipeline),
+			IncludeExisting: includeExisting,
+		},
+	)
+	if err != nil {
+		cancel()
+		return nil, sanitizeErr(err)
+	}
+	return &jobInfoIterator{stream, cancel}, nil
+}
+
 // DeleteJob deletes a job.
 func (c APIClient) DeleteJob(jobID string) error {
 	_, err := c.PpsAPIClient.DeleteJob(
@@ -253,6 +378,18 @@ func (c APIClient) RestartDatum(jobID string, datumFilter []string) error {
 	return sanitizeErr(err)
 }
 
+// FileProvenance returns the datums that output a file, if it's in a
+// pipeline's output repo, and the datums that took it as input.
+func (c APIClient) FileProvenance(repoName string, commitID string, path string) (*pps.FileProvenanceResponse, error) {
+	resp, err := c.PpsAPIClient.FileProvenance(
+		c.ctx(),
+		&pps.FileProvenanceRequest{
+			File: NewFile(repoName, commitID, path),
+		},
+	)
+	return resp, sanitizeErr(err)
+}
+
 // LogsIter iterates through log messages returned from pps.GetLogs. Logs can
 // be fetched with 'Next()'. The log message received can be examined with
 // 'Message()', and any errors can be examined with 'Err()'.
@@ -297,6 +434,25 @@ func (c APIClient) GetLogs(
 	pipelineName string,
 	jobID string,
 	data []string,
+) *LogsIter {
+	return c.getLogs(pipelineName, jobID, data, false)
+}
+
+// GetLogsFollow is like GetLogs, but new log lines are returned as they're
+// written, until the job finishes (or indefinitely, for a pipeline).
+func (c APIClient) GetLogsFollow(
+	pipelineName string,
+	jobID string,
+	data []string,
+) *LogsIter {
+	return c.getLogs(pipelineName, jobID, data, true)
+}
+
+func (c APIClient) getLogs(
+	pipelineName string,
+	jobID string,
+	data []string,
+	follow bool,
 ) *LogsIter {
 	request := pps.GetLogsRequest{}
 	resp := &LogsIter{}
@@ -307,6 +463,7 @@ func (c APIClient) GetLogs(
 		request.Job = &pps.Job{jobID}
 	}
 	request.DataFilters = data
+	request.Follow = follow
 	resp.logsClient, resp.err = c.PpsAPIClient.GetLogs(c.ctx(), &request)
 	return resp
 }
@@ -369,6 +526,19 @@ func (c APIClient) InspectPipeline(pipelineName string) (*pps.PipelineInfo, erro
 	return pipelineInfo, sanitizeErr(err)
 }
 
+// InspectPipelineVersion returns info about a specific version of a
+// pipeline. Version 0 is the pipeline's current version.
+func (c APIClient) InspectPipelineVersion(pipelineName string, version uint64) (*pps.PipelineInfo, error) {
+	pipelineInfo, err := c.PpsAPIClient.InspectPipeline(
+		c.ctx(),
+		&pps.InspectPipelineRequest{
+			Pipeline: NewPipeline(pipelineName),
+			Version:  version,
+		},
+	)
+	return pipelineInfo, sanitizeErr(err)
+}
+
 // ListPipeline returns info about all pipelines.
 func (c APIClient) ListPipeline() ([]*pps.PipelineInfo, error) {
 	pipelineInfos, err := c.PpsAPIClient.ListPipeline(
@@ -432,6 +602,24 @@ func (c APIClient) RerunPipeline(name string, include []*pfs.Commit, exclude []*
 	return sanitizeErr(err)
 }
 
+// Usage returns the storage used by each repo and the compute used by each
+// pipeline since the given time. A zero since counts all jobs.
+func (c APIClient) Usage(since time.Time) (*pps.UsageResponse, error) {
+	request := &pps.UsageRequest{}
+	if !since.IsZero() {
+		var err error
+		request.Since, err = types.TimestampProto(since)
+		if err != nil {
+			return nil, err
+		}
+	}
+	response, err := c.PpsAPIClient.Usage(c.ctx(), request)
+	if err != nil {
+		return nil, sanitizeErr(err)
+	}
+	return response, nil
+}
+
 // GarbageCollect garbage collects unused data.  Currently GC needs to be
 // run while no data is being added or removed (which, among other things,
 // implies that there shouldn't be jobs actively running).
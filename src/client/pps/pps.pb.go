@@ -10,17 +10,25 @@
 	It has these top-level messages:
 		Secret
 		Transform
+		Hooks
 		Egress
+		Webhook
 		Job
 		Service
 		AtomInput
+		GitInput
 		Input
 		JobInput
 		ParallelismSpec
 		Datum
+		DatumManifest
+		DatumManifestInput
 		WorkerStatus
+		WorkerPod
+		PodEvent
 		ResourceSpec
 		JobInfo
+		JobCost
 		Worker
 		JobInfos
 		Pipeline
@@ -30,12 +38,22 @@
 		CreateJobRequest
 		InspectJobRequest
 		ListJobRequest
+		FlushJobRequest
+		SubscribeJobRequest
 		DeleteJobRequest
 		StopJobRequest
 		GetLogsRequest
 		LogMessage
 		RestartDatumRequest
+		FileProvenanceRequest
+		DatumProvenance
+		FileProvenanceResponse
 		CreatePipelineRequest
+		SecondaryOutput
+		LogsSpec
+		SchedulingSpec
+		Sidecar
+		Toleration
 		InspectPipelineRequest
 		ListPipelineRequest
 		DeletePipelineRequest
@@ -44,6 +62,10 @@
 		RerunPipelineRequest
 		GarbageCollectRequest
 		GarbageCollectResponse
+		UsageRequest
+		RepoUsage
+		PipelineUsage
+		UsageResponse
 */
 package pps
 
@@ -82,6 +104,10 @@ const (
 	JobState_JOB_FAILURE  JobState = 2
 	JobState_JOB_SUCCESS  JobState = 3
 	JobState_JOB_STOPPED  JobState = 4
+	// JOB_WAITING_FOR_RESOURCES is reported by InspectJob and ListJob, in
+	// place of JOB_STARTING, for jobs whose worker pods can't be scheduled
+	// because the cluster is out of resources. It's never stored.
+	JobState_JOB_WAITING_FOR_RESOURCES JobState = 5
 )
 
 var JobState_name = map[int32]string{
@@ -90,13 +116,15 @@ var JobState_name = map[int32]string{
 	2: "JOB_FAILURE",
 	3: "JOB_SUCCESS",
 	4: "JOB_STOPPED",
+	5: "JOB_WAITING_FOR_RESOURCES",
 }
 var JobState_value = map[string]int32{
-	"JOB_STARTING": 0,
-	"JOB_RUNNING":  1,
-	"JOB_FAILURE":  2,
-	"JOB_SUCCESS":  3,
-	"JOB_STOPPED":  4,
+	"JOB_STARTING":              0,
+	"JOB_RUNNING":               1,
+	"JOB_FAILURE":               2,
+	"JOB_SUCCESS":               3,
+	"JOB_STOPPED":               4,
+	"JOB_WAITING_FOR_RESOURCES": 5,
 }
 
 func (x JobState) String() string {
@@ -191,7 +219,7 @@ func (x ParallelismSpec_Strategy) String() string {
 	return proto.EnumName(ParallelismSpec_Strategy_name, int32(x))
 }
 func (ParallelismSpec_Strategy) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptorPps, []int{8, 0}
+	return fileDescriptorPps, []int{11, 0}
 }
 
 type Secret struct {
@@ -228,6 +256,16 @@ type Transform struct {
 	Stdin            []string          `protobuf:"bytes,5,rep,name=stdin" json:"stdin,omitempty"`
 	AcceptReturnCode []int64           `protobuf:"varint,6,rep,packed,name=accept_return_code,json=acceptReturnCode" json:"accept_return_code,omitempty"`
 	Debug            bool              `protobuf:"varint,7,opt,name=debug,proto3" json:"debug,omitempty"`
+	// ImageDigest pins image to a digest, e.g. "sha256:...", so that the
+	// workers run the same image even if its tag is moved. It's set when the
+	// pipeline is created with pin_image.
+	ImageDigest string `protobuf:"bytes,10,opt,name=image_digest,json=imageDigest,proto3" json:"image_digest,omitempty"`
+	// Setup is a command that each worker runs once, in the user container,
+	// before it processes any datums, e.g. to download model weights or to
+	// check the pipeline's config. If it fails the worker exits, and is
+	// restarted.
+	Setup []string `protobuf:"bytes,11,rep,name=setup" json:"setup,omitempty"`
+	Hooks *Hooks   `protobuf:"bytes,12,opt,name=hooks" json:"hooks,omitempty"`
 }
 
 func (m *Transform) Reset()                    { *m = Transform{} }
@@ -291,6 +329,79 @@ func (m *Transform) GetDebug() bool {
 	return false
 }
 
+func (m *Transform) GetImageDigest() string {
+	if m != nil {
+		return m.ImageDigest
+	}
+	return ""
+}
+
+func (m *Transform) GetSetup() []string {
+	if m != nil {
+		return m.Setup
+	}
+	return nil
+}
+
+func (m *Transform) GetHooks() *Hooks {
+	if m != nil {
+		return m.Hooks
+	}
+	return nil
+}
+
+// Hooks are commands that a transform runs around its cmd, without
+// wrapping cmd in a script. Each runs in the user container, with the
+// transform's env and secrets.
+type Hooks struct {
+	// BeforeDatum runs before cmd for each datum, with the datum's inputs in
+	// /pfs. If it fails the datum fails, and cmd isn't run.
+	BeforeDatum []string `protobuf:"bytes,1,rep,name=before_datum,json=beforeDatum" json:"before_datum,omitempty"`
+	// AfterDatum runs after cmd for each datum, whether or not it failed,
+	// with PACH_DATUM_STATE set to "success" or "failure". If it fails the
+	// datum fails.
+	AfterDatum []string `protobuf:"bytes,2,rep,name=after_datum,json=afterDatum" json:"after_datum,omitempty"`
+	// JobStart runs once when a job starts, before its datums are processed.
+	// If it fails the job fails.
+	JobStart []string `protobuf:"bytes,3,rep,name=job_start,json=jobStart" json:"job_start,omitempty"`
+	// JobEnd runs once when a job finishes, with PACH_JOB_STATE set to the
+	// state it finished in. Its failures are only logged.
+	JobEnd []string `protobuf:"bytes,4,rep,name=job_end,json=jobEnd" json:"job_end,omitempty"`
+}
+
+func (m *Hooks) Reset()                    { *m = Hooks{} }
+func (m *Hooks) String() string            { return proto.CompactTextString(m) }
+func (*Hooks) ProtoMessage()               {}
+func (*Hooks) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{2} }
+
+func (m *Hooks) GetBeforeDatum() []string {
+	if m != nil {
+		return m.BeforeDatum
+	}
+	return nil
+}
+
+func (m *Hooks) GetAfterDatum() []string {
+	if m != nil {
+		return m.AfterDatum
+	}
+	return nil
+}
+
+func (m *Hooks) GetJobStart() []string {
+	if m != nil {
+		return m.JobStart
+	}
+	return nil
+}
+
+func (m *Hooks) GetJobEnd() []string {
+	if m != nil {
+		return m.JobEnd
+	}
+	return nil
+}
+
 type Egress struct {
 	URL string `protobuf:"bytes,1,opt,name=URL,proto3" json:"URL,omitempty"`
 }
@@ -298,7 +409,7 @@ type Egress struct {
 func (m *Egress) Reset()                    { *m = Egress{} }
 func (m *Egress) String() string            { return proto.CompactTextString(m) }
 func (*Egress) ProtoMessage()               {}
-func (*Egress) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{2} }
+func (*Egress) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{3} }
 
 func (m *Egress) GetURL() string {
 	if m != nil {
@@ -307,6 +418,33 @@ func (m *Egress) GetURL() string {
 	return ""
 }
 
+// Webhook is a URL that's sent a JSON description of a job, in a POST,
+// when the job finishes in one of states.
+type Webhook struct {
+	URL string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// JOB_SUCCESS and/or JOB_FAILURE, both if empty.
+	States []JobState `protobuf:"varint,2,rep,packed,name=states,enum=pps.JobState" json:"states,omitempty"`
+}
+
+func (m *Webhook) Reset()                    { *m = Webhook{} }
+func (m *Webhook) String() string            { return proto.CompactTextString(m) }
+func (*Webhook) ProtoMessage()               {}
+func (*Webhook) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{4} }
+
+func (m *Webhook) GetURL() string {
+	if m != nil {
+		return m.URL
+	}
+	return ""
+}
+
+func (m *Webhook) GetStates() []JobState {
+	if m != nil {
+		return m.States
+	}
+	return nil
+}
+
 type Job struct {
 	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
@@ -314,7 +452,7 @@ type Job struct {
 func (m *Job) Reset()                    { *m = Job{} }
 func (m *Job) String() string            { return proto.CompactTextString(m) }
 func (*Job) ProtoMessage()               {}
-func (*Job) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{3} }
+func (*Job) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{5} }
 
 func (m *Job) GetID() string {
 	if m != nil {
@@ -331,7 +469,7 @@ type Service struct {
 func (m *Service) Reset()                    { *m = Service{} }
 func (m *Service) String() string            { return proto.CompactTextString(m) }
 func (*Service) ProtoMessage()               {}
-func (*Service) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{4} }
+func (*Service) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{6} }
 
 func (m *Service) GetInternalPort() int32 {
 	if m != nil {
@@ -355,12 +493,21 @@ type AtomInput struct {
 	Glob       string `protobuf:"bytes,5,opt,name=glob,proto3" json:"glob,omitempty"`
 	Lazy       bool   `protobuf:"varint,6,opt,name=lazy,proto3" json:"lazy,omitempty"`
 	FromCommit string `protobuf:"bytes,7,opt,name=from_commit,json=fromCommit,proto3" json:"from_commit,omitempty"`
+	// mount, if true, mounts the input with FUSE rather than downloading it,
+	// each file is downloaded the first time it's opened.
+	Mount bool `protobuf:"varint,8,opt,name=mount,proto3" json:"mount,omitempty"`
+	// group_by, if set, is a regular expression that regroups the files that
+	// glob matches into datums by key, rather than each file being a datum.
+	// A file's key is the first group that the expression captures in its
+	// path, or the whole match if there are no groups. Files with the same key
+	// are processed together and files that don't match are skipped.
+	GroupBy string `protobuf:"bytes,9,opt,name=group_by,json=groupBy,proto3" json:"group_by,omitempty"`
 }
 
 func (m *AtomInput) Reset()                    { *m = AtomInput{} }
 func (m *AtomInput) String() string            { return proto.CompactTextString(m) }
 func (*AtomInput) ProtoMessage()               {}
-func (*AtomInput) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{5} }
+func (*AtomInput) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{7} }
 
 func (m *AtomInput) GetName() string {
 	if m != nil {
@@ -411,16 +558,78 @@ func (m *AtomInput) GetFromCommit() string {
 	return ""
 }
 
+func (m *AtomInput) GetMount() bool {
+	if m != nil {
+		return m.Mount
+	}
+	return false
+}
+
+func (m *AtomInput) GetGroupBy() string {
+	if m != nil {
+		return m.GroupBy
+	}
+	return ""
+}
+
+// GitInput is a git repository whose pushes are committed to a repo of the
+// same name, see the GitHub webhook served by pachd's HTTP API. Pipelines
+// treat it as an atom input of that repo with glob "/".
+type GitInput struct {
+	// name defaults to the name of the git repository.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	URL  string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// branch defaults to "master".
+	Branch string `protobuf:"bytes,3,opt,name=branch,proto3" json:"branch,omitempty"`
+	// secret is the secret of the repository's webhook, push events are only
+	// committed if they're signed with it.
+	Secret string `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
+}
+
+func (m *GitInput) Reset()                    { *m = GitInput{} }
+func (m *GitInput) String() string            { return proto.CompactTextString(m) }
+func (*GitInput) ProtoMessage()               {}
+func (*GitInput) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{8} }
+
+func (m *GitInput) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GitInput) GetURL() string {
+	if m != nil {
+		return m.URL
+	}
+	return ""
+}
+
+func (m *GitInput) GetBranch() string {
+	if m != nil {
+		return m.Branch
+	}
+	return ""
+}
+
+func (m *GitInput) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
 type Input struct {
 	Atom  *AtomInput `protobuf:"bytes,1,opt,name=atom" json:"atom,omitempty"`
 	Cross []*Input   `protobuf:"bytes,2,rep,name=cross" json:"cross,omitempty"`
 	Union []*Input   `protobuf:"bytes,3,rep,name=union" json:"union,omitempty"`
+	Git   *GitInput  `protobuf:"bytes,4,opt,name=git" json:"git,omitempty"`
 }
 
 func (m *Input) Reset()                    { *m = Input{} }
 func (m *Input) String() string            { return proto.CompactTextString(m) }
 func (*Input) ProtoMessage()               {}
-func (*Input) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{6} }
+func (*Input) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{9} }
 
 func (m *Input) GetAtom() *AtomInput {
 	if m != nil {
@@ -443,6 +652,13 @@ func (m *Input) GetUnion() []*Input {
 	return nil
 }
 
+func (m *Input) GetGit() *GitInput {
+	if m != nil {
+		return m.Git
+	}
+	return nil
+}
+
 type JobInput struct {
 	Name   string      `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
 	Commit *pfs.Commit `protobuf:"bytes,1,opt,name=commit" json:"commit,omitempty"`
@@ -453,7 +669,7 @@ type JobInput struct {
 func (m *JobInput) Reset()                    { *m = JobInput{} }
 func (m *JobInput) String() string            { return proto.CompactTextString(m) }
 func (*JobInput) ProtoMessage()               {}
-func (*JobInput) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{7} }
+func (*JobInput) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{10} }
 
 func (m *JobInput) GetName() string {
 	if m != nil {
@@ -508,7 +724,7 @@ type ParallelismSpec struct {
 func (m *ParallelismSpec) Reset()                    { *m = ParallelismSpec{} }
 func (m *ParallelismSpec) String() string            { return proto.CompactTextString(m) }
 func (*ParallelismSpec) ProtoMessage()               {}
-func (*ParallelismSpec) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{8} }
+func (*ParallelismSpec) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{11} }
 
 func (m *ParallelismSpec) GetStrategy() ParallelismSpec_Strategy {
 	if m != nil {
@@ -541,7 +757,7 @@ type Datum struct {
 func (m *Datum) Reset()                    { *m = Datum{} }
 func (m *Datum) String() string            { return proto.CompactTextString(m) }
 func (*Datum) ProtoMessage()               {}
-func (*Datum) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{9} }
+func (*Datum) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{12} }
 
 func (m *Datum) GetPath() string {
 	if m != nil {
@@ -557,6 +773,97 @@ func (m *Datum) GetHash() []byte {
 	return nil
 }
 
+// DatumManifest describes the datum that user code is processing. Workers
+// write it, as JSON, to /pfs/.datum.json, before running the user code.
+type DatumManifest struct {
+	// datum_id is the datum's ID, a hash of its inputs and of the pipeline's
+	// version, which is also in PACH_DATUM_ID.
+	DatumID string                `protobuf:"bytes,1,opt,name=datum_id,json=datumId,proto3" json:"datum_id,omitempty"`
+	JobID   string                `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Inputs  []*DatumManifestInput `protobuf:"bytes,3,rep,name=inputs" json:"inputs,omitempty"`
+}
+
+func (m *DatumManifest) Reset()                    { *m = DatumManifest{} }
+func (m *DatumManifest) String() string            { return proto.CompactTextString(m) }
+func (*DatumManifest) ProtoMessage()               {}
+func (*DatumManifest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{13} }
+
+func (m *DatumManifest) GetDatumID() string {
+	if m != nil {
+		return m.DatumID
+	}
+	return ""
+}
+
+func (m *DatumManifest) GetJobID() string {
+	if m != nil {
+		return m.JobID
+	}
+	return ""
+}
+
+func (m *DatumManifest) GetInputs() []*DatumManifestInput {
+	if m != nil {
+		return m.Inputs
+	}
+	return nil
+}
+
+// DatumManifestInput is one of a datum's input files.
+type DatumManifestInput struct {
+	// name is the name of the input, i.e. where in /pfs it's found.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// file is the input file, in the commit it was read from.
+	File *pfs.File `protobuf:"bytes,2,opt,name=file" json:"file,omitempty"`
+	// branch is the branch of the input repo that the commit was on.
+	Branch    string `protobuf:"bytes,3,opt,name=branch,proto3" json:"branch,omitempty"`
+	SizeBytes uint64 `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// group_key is the key that the file was grouped by, if the input has a
+	// group_by expression. All of a datum's files from the input have the same
+	// key.
+	GroupKey string `protobuf:"bytes,5,opt,name=group_key,json=groupKey,proto3" json:"group_key,omitempty"`
+}
+
+func (m *DatumManifestInput) Reset()                    { *m = DatumManifestInput{} }
+func (m *DatumManifestInput) String() string            { return proto.CompactTextString(m) }
+func (*DatumManifestInput) ProtoMessage()               {}
+func (*DatumManifestInput) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{14} }
+
+func (m *DatumManifestInput) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DatumManifestInput) GetFile() *pfs.File {
+	if m != nil {
+		return m.File
+	}
+	return nil
+}
+
+func (m *DatumManifestInput) GetBranch() string {
+	if m != nil {
+		return m.Branch
+	}
+	return ""
+}
+
+func (m *DatumManifestInput) GetSizeBytes() uint64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
+func (m *DatumManifestInput) GetGroupKey() string {
+	if m != nil {
+		return m.GroupKey
+	}
+	return ""
+}
+
 type WorkerStatus struct {
 	WorkerID string   `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
 	JobID    string   `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
@@ -568,7 +875,7 @@ type WorkerStatus struct {
 func (m *WorkerStatus) Reset()                    { *m = WorkerStatus{} }
 func (m *WorkerStatus) String() string            { return proto.CompactTextString(m) }
 func (*WorkerStatus) ProtoMessage()               {}
-func (*WorkerStatus) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{10} }
+func (*WorkerStatus) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{15} }
 
 func (m *WorkerStatus) GetWorkerID() string {
 	if m != nil {
@@ -598,6 +905,134 @@ func (m *WorkerStatus) GetStarted() *google_protobuf1.Timestamp {
 	return nil
 }
 
+// WorkerPod describes the state of one of a job's worker pods, as reported by
+// kubernetes.
+type WorkerPod struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Node string `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	// Phase is the pod's kubernetes phase (e.g. "Pending" or "Running").
+	Phase string `protobuf:"bytes,3,opt,name=phase,proto3" json:"phase,omitempty"`
+	// Reason explains why the pod (or one of its containers) isn't running, if
+	// kubernetes gave one (e.g. "ImagePullBackOff", "OOMKilled", "Evicted").
+	Reason   string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message  string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	Restarts int32  `protobuf:"varint,6,opt,name=restarts,proto3" json:"restarts,omitempty"`
+	// Zone is the zone of the pod's node, from its
+	// failure-domain.beta.kubernetes.io/zone label.
+	Zone string `protobuf:"bytes,7,opt,name=zone,proto3" json:"zone,omitempty"`
+}
+
+func (m *WorkerPod) Reset()                    { *m = WorkerPod{} }
+func (m *WorkerPod) String() string            { return proto.CompactTextString(m) }
+func (*WorkerPod) ProtoMessage()               {}
+func (*WorkerPod) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{16} }
+
+func (m *WorkerPod) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *WorkerPod) GetNode() string {
+	if m != nil {
+		return m.Node
+	}
+	return ""
+}
+
+func (m *WorkerPod) GetPhase() string {
+	if m != nil {
+		return m.Phase
+	}
+	return ""
+}
+
+func (m *WorkerPod) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *WorkerPod) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *WorkerPod) GetRestarts() int32 {
+	if m != nil {
+		return m.Restarts
+	}
+	return 0
+}
+
+func (m *WorkerPod) GetZone() string {
+	if m != nil {
+		return m.Zone
+	}
+	return ""
+}
+
+// PodEvent is a kubernetes event concerning one of a job's worker pods.
+type PodEvent struct {
+	Pod      string                      `protobuf:"bytes,1,opt,name=pod,proto3" json:"pod,omitempty"`
+	Type     string                      `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Reason   string                      `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message  string                      `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Count    int32                       `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	LastSeen *google_protobuf1.Timestamp `protobuf:"bytes,6,opt,name=last_seen,json=lastSeen" json:"last_seen,omitempty"`
+}
+
+func (m *PodEvent) Reset()                    { *m = PodEvent{} }
+func (m *PodEvent) String() string            { return proto.CompactTextString(m) }
+func (*PodEvent) ProtoMessage()               {}
+func (*PodEvent) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{17} }
+
+func (m *PodEvent) GetPod() string {
+	if m != nil {
+		return m.Pod
+	}
+	return ""
+}
+
+func (m *PodEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *PodEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *PodEvent) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *PodEvent) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *PodEvent) GetLastSeen() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.LastSeen
+	}
+	return nil
+}
+
 // ResourceSpec describes the amount of resources that pipeline pods should
 // request from kubernetes, for scheduling.
 type ResourceSpec struct {
@@ -614,7 +1049,7 @@ type ResourceSpec struct {
 func (m *ResourceSpec) Reset()                    { *m = ResourceSpec{} }
 func (m *ResourceSpec) String() string            { return proto.CompactTextString(m) }
 func (*ResourceSpec) ProtoMessage()               {}
-func (*ResourceSpec) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{11} }
+func (*ResourceSpec) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{18} }
 
 func (m *ResourceSpec) GetCpu() float32 {
 	if m != nil {
@@ -657,17 +1092,47 @@ type JobInfo struct {
 	Restart         uint64                      `protobuf:"varint,20,opt,name=restart,proto3" json:"restart,omitempty"`
 	DataProcessed   int64                       `protobuf:"varint,22,opt,name=data_processed,json=dataProcessed,proto3" json:"data_processed,omitempty"`
 	DataTotal       int64                       `protobuf:"varint,23,opt,name=data_total,json=dataTotal,proto3" json:"data_total,omitempty"`
-	WorkerStatus    []*WorkerStatus             `protobuf:"bytes,24,rep,name=worker_status,json=workerStatus" json:"worker_status,omitempty"`
-	ResourceSpec    *ResourceSpec               `protobuf:"bytes,25,opt,name=resource_spec,json=resourceSpec" json:"resource_spec,omitempty"`
-	Input           *Input                      `protobuf:"bytes,26,opt,name=input" json:"input,omitempty"`
-	NewBranch       *pfs.Branch                 `protobuf:"bytes,27,opt,name=new_branch,json=newBranch" json:"new_branch,omitempty"`
-	Incremental     bool                        `protobuf:"varint,28,opt,name=incremental,proto3" json:"incremental,omitempty"`
+	// The number of datums whose output had already been computed, so they
+	// weren't processed again. They're included in data_processed.
+	DataSkipped  int64           `protobuf:"varint,32,opt,name=data_skipped,json=dataSkipped,proto3" json:"data_skipped,omitempty"`
+	WorkerStatus []*WorkerStatus `protobuf:"bytes,24,rep,name=worker_status,json=workerStatus" json:"worker_status,omitempty"`
+	ResourceSpec *ResourceSpec   `protobuf:"bytes,25,opt,name=resource_spec,json=resourceSpec" json:"resource_spec,omitempty"`
+	Input        *Input          `protobuf:"bytes,26,opt,name=input" json:"input,omitempty"`
+	NewBranch    *pfs.Branch     `protobuf:"bytes,27,opt,name=new_branch,json=newBranch" json:"new_branch,omitempty"`
+	Incremental  bool            `protobuf:"varint,28,opt,name=incremental,proto3" json:"incremental,omitempty"`
+	// WorkerPods and PodEvents are filled in by InspectJob for jobs that are
+	// still running; they're never persisted.
+	WorkerPods []*WorkerPod `protobuf:"bytes,29,rep,name=worker_pods,json=workerPods" json:"worker_pods,omitempty"`
+	PodEvents  []*PodEvent  `protobuf:"bytes,30,rep,name=pod_events,json=podEvents" json:"pod_events,omitempty"`
+	Webhooks   []*Webhook   `protobuf:"bytes,31,rep,name=webhooks" json:"webhooks,omitempty"`
+	Cost       *JobCost     `protobuf:"bytes,33,opt,name=cost" json:"cost,omitempty"`
+	// secondary_output_commits are the commits of the job's secondary
+	// outputs, in the same order as the pipeline's secondary_outputs.
+	SecondaryOutputCommits []*pfs.Commit `protobuf:"bytes,34,rep,name=secondary_output_commits,json=secondaryOutputCommits" json:"secondary_output_commits,omitempty"`
+	// datum_checkpoint is the object recording which of the job's datums
+	// have been processed, so that the job resumes from it if it's restarted.
+	DatumCheckpoint *pfs.Object `protobuf:"bytes,35,opt,name=datum_checkpoint,json=datumCheckpoint" json:"datum_checkpoint,omitempty"`
+	// reason is why the job's worker pods are failing, e.g. because a
+	// container was OOMKilled or its image can't be pulled. It's filled in by
+	// InspectJob from the pods of jobs that haven't succeeded, and is never
+	// stored.
+	Reason string `protobuf:"bytes,36,opt,name=reason,proto3" json:"reason,omitempty"`
+	// setup_time is the time that the job's workers spent running the
+	// transform's setup command before they processed its datums. It's
+	// counted when a worker that ran setup processes its first datum, so a
+	// worker's setup is counted in the first job that it works on.
+	SetupTime *google_protobuf2.Duration `protobuf:"bytes,37,opt,name=setup_time,json=setupTime" json:"setup_time,omitempty"`
+	// download_bytes and upload_bytes are how much data the job's workers
+	// have downloaded from its inputs and uploaded as its output so far.
+	// Lazily loaded and mounted inputs aren't counted in download_bytes.
+	DownloadBytes int64 `protobuf:"varint,38,opt,name=download_bytes,json=downloadBytes,proto3" json:"download_bytes,omitempty"`
+	UploadBytes   int64 `protobuf:"varint,39,opt,name=upload_bytes,json=uploadBytes,proto3" json:"upload_bytes,omitempty"`
 }
 
 func (m *JobInfo) Reset()                    { *m = JobInfo{} }
 func (m *JobInfo) String() string            { return proto.CompactTextString(m) }
 func (*JobInfo) ProtoMessage()               {}
-func (*JobInfo) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{12} }
+func (*JobInfo) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{19} }
 
 func (m *JobInfo) GetJob() *Job {
 	if m != nil {
@@ -802,6 +1267,13 @@ func (m *JobInfo) GetDataTotal() int64 {
 	return 0
 }
 
+func (m *JobInfo) GetDataSkipped() int64 {
+	if m != nil {
+		return m.DataSkipped
+	}
+	return 0
+}
+
 func (m *JobInfo) GetWorkerStatus() []*WorkerStatus {
 	if m != nil {
 		return m.WorkerStatus
@@ -837,58 +1309,207 @@ func (m *JobInfo) GetIncremental() bool {
 	return false
 }
 
-type Worker struct {
-	Name  string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	State WorkerState `protobuf:"varint,2,opt,name=state,proto3,enum=pps.WorkerState" json:"state,omitempty"`
+func (m *JobInfo) GetWorkerPods() []*WorkerPod {
+	if m != nil {
+		return m.WorkerPods
+	}
+	return nil
 }
 
-func (m *Worker) Reset()                    { *m = Worker{} }
-func (m *Worker) String() string            { return proto.CompactTextString(m) }
-func (*Worker) ProtoMessage()               {}
-func (*Worker) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{13} }
-
-func (m *Worker) GetName() string {
+func (m *JobInfo) GetPodEvents() []*PodEvent {
 	if m != nil {
-		return m.Name
+		return m.PodEvents
 	}
-	return ""
+	return nil
 }
 
-func (m *Worker) GetState() WorkerState {
+func (m *JobInfo) GetWebhooks() []*Webhook {
 	if m != nil {
-		return m.State
+		return m.Webhooks
 	}
-	return WorkerState_POD_RUNNING
+	return nil
 }
 
-type JobInfos struct {
-	JobInfo []*JobInfo `protobuf:"bytes,1,rep,name=job_info,json=jobInfo" json:"job_info,omitempty"`
+func (m *JobInfo) GetCost() *JobCost {
+	if m != nil {
+		return m.Cost
+	}
+	return nil
 }
 
-func (m *JobInfos) Reset()                    { *m = JobInfos{} }
-func (m *JobInfos) String() string            { return proto.CompactTextString(m) }
-func (*JobInfos) ProtoMessage()               {}
-func (*JobInfos) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{14} }
-
-func (m *JobInfos) GetJobInfo() []*JobInfo {
+func (m *JobInfo) GetSecondaryOutputCommits() []*pfs.Commit {
 	if m != nil {
-		return m.JobInfo
+		return m.SecondaryOutputCommits
 	}
 	return nil
 }
 
-type Pipeline struct {
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+func (m *JobInfo) GetDatumCheckpoint() *pfs.Object {
+	if m != nil {
+		return m.DatumCheckpoint
+	}
+	return nil
 }
 
-func (m *Pipeline) Reset()                    { *m = Pipeline{} }
-func (m *Pipeline) String() string            { return proto.CompactTextString(m) }
-func (*Pipeline) ProtoMessage()               {}
-func (*Pipeline) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{15} }
-
-func (m *Pipeline) GetName() string {
+func (m *JobInfo) GetReason() string {
 	if m != nil {
-		return m.Name
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *JobInfo) GetSetupTime() *google_protobuf2.Duration {
+	if m != nil {
+		return m.SetupTime
+	}
+	return nil
+}
+
+func (m *JobInfo) GetDownloadBytes() int64 {
+	if m != nil {
+		return m.DownloadBytes
+	}
+	return 0
+}
+
+func (m *JobInfo) GetUploadBytes() int64 {
+	if m != nil {
+		return m.UploadBytes
+	}
+	return 0
+}
+
+// JobCost is what a job's worker pods used while it ran, so that the cost of
+// a pipeline's runs can be attributed to it. It's sampled by the job's
+// master, so it's approximate.
+type JobCost struct {
+	// node_types are the instance types of the nodes that the job's workers
+	// ran on, from the nodes' beta.kubernetes.io/instance-type labels.
+	NodeTypes []string `protobuf:"bytes,1,rep,name=node_types,json=nodeTypes" json:"node_types,omitempty"`
+	// cpu_seconds is the cpu that the job's worker pods used, in core-seconds,
+	// as reported by metrics-server.
+	CpuSeconds float64 `protobuf:"fixed64,2,opt,name=cpu_seconds,json=cpuSeconds,proto3" json:"cpu_seconds,omitempty"`
+	// memory_byte_seconds is the memory that the job's worker pods used,
+	// integrated over the time the job ran.
+	MemoryByteSeconds float64 `protobuf:"fixed64,3,opt,name=memory_byte_seconds,json=memoryByteSeconds,proto3" json:"memory_byte_seconds,omitempty"`
+	// peak_memory_bytes is the most memory that the job's worker pods used at
+	// once.
+	PeakMemoryBytes uint64 `protobuf:"varint,4,opt,name=peak_memory_bytes,json=peakMemoryBytes,proto3" json:"peak_memory_bytes,omitempty"`
+	// samples is the number of times usage was sampled, it's 0 if
+	// metrics-server isn't deployed.
+	Samples uint64 `protobuf:"varint,5,opt,name=samples,proto3" json:"samples,omitempty"`
+	// current_cpu_cores and current_memory_bytes are what the job's worker
+	// pods were using when usage was last sampled.
+	CurrentCpuCores    float64 `protobuf:"fixed64,6,opt,name=current_cpu_cores,json=currentCpuCores,proto3" json:"current_cpu_cores,omitempty"`
+	CurrentMemoryBytes uint64  `protobuf:"varint,7,opt,name=current_memory_bytes,json=currentMemoryBytes,proto3" json:"current_memory_bytes,omitempty"`
+}
+
+func (m *JobCost) Reset()                    { *m = JobCost{} }
+func (m *JobCost) String() string            { return proto.CompactTextString(m) }
+func (*JobCost) ProtoMessage()               {}
+func (*JobCost) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{20} }
+
+func (m *JobCost) GetNodeTypes() []string {
+	if m != nil {
+		return m.NodeTypes
+	}
+	return nil
+}
+
+func (m *JobCost) GetCpuSeconds() float64 {
+	if m != nil {
+		return m.CpuSeconds
+	}
+	return 0
+}
+
+func (m *JobCost) GetMemoryByteSeconds() float64 {
+	if m != nil {
+		return m.MemoryByteSeconds
+	}
+	return 0
+}
+
+func (m *JobCost) GetPeakMemoryBytes() uint64 {
+	if m != nil {
+		return m.PeakMemoryBytes
+	}
+	return 0
+}
+
+func (m *JobCost) GetSamples() uint64 {
+	if m != nil {
+		return m.Samples
+	}
+	return 0
+}
+
+func (m *JobCost) GetCurrentCpuCores() float64 {
+	if m != nil {
+		return m.CurrentCpuCores
+	}
+	return 0
+}
+
+func (m *JobCost) GetCurrentMemoryBytes() uint64 {
+	if m != nil {
+		return m.CurrentMemoryBytes
+	}
+	return 0
+}
+
+type Worker struct {
+	Name  string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	State WorkerState `protobuf:"varint,2,opt,name=state,proto3,enum=pps.WorkerState" json:"state,omitempty"`
+}
+
+func (m *Worker) Reset()                    { *m = Worker{} }
+func (m *Worker) String() string            { return proto.CompactTextString(m) }
+func (*Worker) ProtoMessage()               {}
+func (*Worker) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{21} }
+
+func (m *Worker) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Worker) GetState() WorkerState {
+	if m != nil {
+		return m.State
+	}
+	return WorkerState_POD_RUNNING
+}
+
+type JobInfos struct {
+	JobInfo []*JobInfo `protobuf:"bytes,1,rep,name=job_info,json=jobInfo" json:"job_info,omitempty"`
+}
+
+func (m *JobInfos) Reset()                    { *m = JobInfos{} }
+func (m *JobInfos) String() string            { return proto.CompactTextString(m) }
+func (*JobInfos) ProtoMessage()               {}
+func (*JobInfos) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{22} }
+
+func (m *JobInfos) GetJobInfo() []*JobInfo {
+	if m != nil {
+		return m.JobInfo
+	}
+	return nil
+}
+
+type Pipeline struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *Pipeline) Reset()                    { *m = Pipeline{} }
+func (m *Pipeline) String() string            { return proto.CompactTextString(m) }
+func (*Pipeline) ProtoMessage()               {}
+func (*Pipeline) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{23} }
+
+func (m *Pipeline) GetName() string {
+	if m != nil {
+		return m.Name
 	}
 	return ""
 }
@@ -905,7 +1526,7 @@ type PipelineInput struct {
 func (m *PipelineInput) Reset()                    { *m = PipelineInput{} }
 func (m *PipelineInput) String() string            { return proto.CompactTextString(m) }
 func (*PipelineInput) ProtoMessage()               {}
-func (*PipelineInput) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{16} }
+func (*PipelineInput) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{24} }
 
 func (m *PipelineInput) GetName() string {
 	if m != nil {
@@ -967,12 +1588,25 @@ type PipelineInfo struct {
 	Input              *Input                      `protobuf:"bytes,20,opt,name=input" json:"input,omitempty"`
 	Description        string                      `protobuf:"bytes,21,opt,name=description,proto3" json:"description,omitempty"`
 	Incremental        bool                        `protobuf:"varint,22,opt,name=incremental,proto3" json:"incremental,omitempty"`
+	Webhooks           []*Webhook                  `protobuf:"bytes,23,rep,name=webhooks" json:"webhooks,omitempty"`
+	S3Gateway          bool                        `protobuf:"varint,24,opt,name=s3_gateway,json=s3Gateway,proto3" json:"s3_gateway,omitempty"`
+	CacheSize          string                      `protobuf:"bytes,25,opt,name=cache_size,json=cacheSize,proto3" json:"cache_size,omitempty"`
+	DiskCacheSize      string                      `protobuf:"bytes,26,opt,name=disk_cache_size,json=diskCacheSize,proto3" json:"disk_cache_size,omitempty"`
+	DatumConcurrency   int64                       `protobuf:"varint,27,opt,name=datum_concurrency,json=datumConcurrency,proto3" json:"datum_concurrency,omitempty"`
+	PodLabels          map[string]string           `protobuf:"bytes,28,rep,name=pod_labels,json=podLabels" json:"pod_labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	PodAnnotations     map[string]string           `protobuf:"bytes,29,rep,name=pod_annotations,json=podAnnotations" json:"pod_annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	SecondaryOutputs   []*SecondaryOutput          `protobuf:"bytes,30,rep,name=secondary_outputs,json=secondaryOutputs" json:"secondary_outputs,omitempty"`
+	Logs               *LogsSpec                   `protobuf:"bytes,31,opt,name=logs" json:"logs,omitempty"`
+	Scheduling         *SchedulingSpec             `protobuf:"bytes,32,opt,name=scheduling" json:"scheduling,omitempty"`
+	ServiceAccount     string                      `protobuf:"bytes,33,opt,name=service_account,json=serviceAccount,proto3" json:"service_account,omitempty"`
+	Sidecars           []*Sidecar                  `protobuf:"bytes,34,rep,name=sidecars" json:"sidecars,omitempty"`
+	RuntimeClass       string                      `protobuf:"bytes,35,opt,name=runtime_class,json=runtimeClass,proto3" json:"runtime_class,omitempty"`
 }
 
 func (m *PipelineInfo) Reset()                    { *m = PipelineInfo{} }
 func (m *PipelineInfo) String() string            { return proto.CompactTextString(m) }
 func (*PipelineInfo) ProtoMessage()               {}
-func (*PipelineInfo) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{17} }
+func (*PipelineInfo) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{25} }
 
 func (m *PipelineInfo) GetID() string {
 	if m != nil {
@@ -1093,6 +1727,97 @@ func (m *PipelineInfo) GetIncremental() bool {
 	return false
 }
 
+func (m *PipelineInfo) GetWebhooks() []*Webhook {
+	if m != nil {
+		return m.Webhooks
+	}
+	return nil
+}
+
+func (m *PipelineInfo) GetS3Gateway() bool {
+	if m != nil {
+		return m.S3Gateway
+	}
+	return false
+}
+
+func (m *PipelineInfo) GetCacheSize() string {
+	if m != nil {
+		return m.CacheSize
+	}
+	return ""
+}
+
+func (m *PipelineInfo) GetDiskCacheSize() string {
+	if m != nil {
+		return m.DiskCacheSize
+	}
+	return ""
+}
+
+func (m *PipelineInfo) GetDatumConcurrency() int64 {
+	if m != nil {
+		return m.DatumConcurrency
+	}
+	return 0
+}
+
+func (m *PipelineInfo) GetPodLabels() map[string]string {
+	if m != nil {
+		return m.PodLabels
+	}
+	return nil
+}
+
+func (m *PipelineInfo) GetPodAnnotations() map[string]string {
+	if m != nil {
+		return m.PodAnnotations
+	}
+	return nil
+}
+
+func (m *PipelineInfo) GetSecondaryOutputs() []*SecondaryOutput {
+	if m != nil {
+		return m.SecondaryOutputs
+	}
+	return nil
+}
+
+func (m *PipelineInfo) GetLogs() *LogsSpec {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+func (m *PipelineInfo) GetScheduling() *SchedulingSpec {
+	if m != nil {
+		return m.Scheduling
+	}
+	return nil
+}
+
+func (m *PipelineInfo) GetServiceAccount() string {
+	if m != nil {
+		return m.ServiceAccount
+	}
+	return ""
+}
+
+func (m *PipelineInfo) GetSidecars() []*Sidecar {
+	if m != nil {
+		return m.Sidecars
+	}
+	return nil
+}
+
+func (m *PipelineInfo) GetRuntimeClass() string {
+	if m != nil {
+		return m.RuntimeClass
+	}
+	return ""
+}
+
 type PipelineInfos struct {
 	PipelineInfo []*PipelineInfo `protobuf:"bytes,1,rep,name=pipeline_info,json=pipelineInfo" json:"pipeline_info,omitempty"`
 }
@@ -1100,7 +1825,7 @@ type PipelineInfos struct {
 func (m *PipelineInfos) Reset()                    { *m = PipelineInfos{} }
 func (m *PipelineInfos) String() string            { return proto.CompactTextString(m) }
 func (*PipelineInfos) ProtoMessage()               {}
-func (*PipelineInfos) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{18} }
+func (*PipelineInfos) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{26} }
 
 func (m *PipelineInfos) GetPipelineInfo() []*PipelineInfo {
 	if m != nil {
@@ -1131,7 +1856,7 @@ type CreateJobRequest struct {
 func (m *CreateJobRequest) Reset()                    { *m = CreateJobRequest{} }
 func (m *CreateJobRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreateJobRequest) ProtoMessage()               {}
-func (*CreateJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{19} }
+func (*CreateJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{27} }
 
 func (m *CreateJobRequest) GetTransform() *Transform {
 	if m != nil {
@@ -1239,7 +1964,7 @@ type InspectJobRequest struct {
 func (m *InspectJobRequest) Reset()                    { *m = InspectJobRequest{} }
 func (m *InspectJobRequest) String() string            { return proto.CompactTextString(m) }
 func (*InspectJobRequest) ProtoMessage()               {}
-func (*InspectJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{20} }
+func (*InspectJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{28} }
 
 func (m *InspectJobRequest) GetJob() *Job {
 	if m != nil {
@@ -1263,7 +1988,7 @@ type ListJobRequest struct {
 func (m *ListJobRequest) Reset()                    { *m = ListJobRequest{} }
 func (m *ListJobRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListJobRequest) ProtoMessage()               {}
-func (*ListJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{21} }
+func (*ListJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{29} }
 
 func (m *ListJobRequest) GetPipeline() *Pipeline {
 	if m != nil {
@@ -1279,6 +2004,59 @@ func (m *ListJobRequest) GetInputCommit() []*pfs.Commit {
 	return nil
 }
 
+type FlushJobRequest struct {
+	Commits []*pfs.Commit `protobuf:"bytes,1,rep,name=commits" json:"commits,omitempty"`
+	// to_pipelines, if set, limits the jobs waited for to the ones in these
+	// pipelines, and the pipelines upstream of them.
+	ToPipelines []*Pipeline `protobuf:"bytes,2,rep,name=to_pipelines,json=toPipelines" json:"to_pipelines,omitempty"`
+}
+
+func (m *FlushJobRequest) Reset()                    { *m = FlushJobRequest{} }
+func (m *FlushJobRequest) String() string            { return proto.CompactTextString(m) }
+func (*FlushJobRequest) ProtoMessage()               {}
+func (*FlushJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{30} }
+
+func (m *FlushJobRequest) GetCommits() []*pfs.Commit {
+	if m != nil {
+		return m.Commits
+	}
+	return nil
+}
+
+func (m *FlushJobRequest) GetToPipelines() []*Pipeline {
+	if m != nil {
+		return m.ToPipelines
+	}
+	return nil
+}
+
+type SubscribeJobRequest struct {
+	Pipeline *Pipeline `protobuf:"bytes,1,opt,name=pipeline" json:"pipeline,omitempty"`
+	// IncludeExisting sends the pipeline's jobs as they are when the
+	// subscription starts, before any updates. Otherwise only jobs that are
+	// created or updated afterwards are sent.
+	IncludeExisting bool `protobuf:"varint,2,opt,name=include_existing,json=includeExisting,proto3" json:"include_existing,omitempty"`
+}
+
+func (m *SubscribeJobRequest) Reset()                    { *m = SubscribeJobRequest{} }
+func (m *SubscribeJobRequest) String() string            { return proto.CompactTextString(m) }
+func (*SubscribeJobRequest) ProtoMessage()               {}
+func (*SubscribeJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{31} }
+
+func (m *SubscribeJobRequest) GetPipeline() *Pipeline {
+	if m != nil {
+		return m.Pipeline
+	}
+	return nil
+}
+
+func (m *SubscribeJobRequest) GetIncludeExisting() bool {
+	if m != nil {
+		return m.IncludeExisting
+	}
+	return false
+}
+
 type DeleteJobRequest struct {
 	Job *Job `protobuf:"bytes,1,opt,name=job" json:"job,omitempty"`
 }
@@ -1286,7 +2064,7 @@ type DeleteJobRequest struct {
 func (m *DeleteJobRequest) Reset()                    { *m = DeleteJobRequest{} }
 func (m *DeleteJobRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteJobRequest) ProtoMessage()               {}
-func (*DeleteJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{22} }
+func (*DeleteJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{32} }
 
 func (m *DeleteJobRequest) GetJob() *Job {
 	if m != nil {
@@ -1302,7 +2080,7 @@ type StopJobRequest struct {
 func (m *StopJobRequest) Reset()                    { *m = StopJobRequest{} }
 func (m *StopJobRequest) String() string            { return proto.CompactTextString(m) }
 func (*StopJobRequest) ProtoMessage()               {}
-func (*StopJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{23} }
+func (*StopJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{33} }
 
 func (m *StopJobRequest) GetJob() *Job {
 	if m != nil {
@@ -1323,12 +2101,16 @@ type GetLogsRequest struct {
 	// filter may be an absolute path of a file within a pps repo, or it may be
 	// a hash for that file (to search for files at specific versions)
 	DataFilters []string `protobuf:"bytes,3,rep,name=data_filters,json=dataFilters" json:"data_filters,omitempty"`
+	// If true, keep streaming log lines from the worker pods as they're written,
+	// rather than returning the lines logged so far. When following a job, the
+	// stream ends once the job has finished.
+	Follow bool `protobuf:"varint,4,opt,name=follow,proto3" json:"follow,omitempty"`
 }
 
 func (m *GetLogsRequest) Reset()                    { *m = GetLogsRequest{} }
 func (m *GetLogsRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetLogsRequest) ProtoMessage()               {}
-func (*GetLogsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{24} }
+func (*GetLogsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{34} }
 
 func (m *GetLogsRequest) GetPipeline() *Pipeline {
 	if m != nil {
@@ -1351,6 +2133,13 @@ func (m *GetLogsRequest) GetDataFilters() []string {
 	return nil
 }
 
+func (m *GetLogsRequest) GetFollow() bool {
+	if m != nil {
+		return m.Follow
+	}
+	return false
+}
+
 // LogMessage is a log line from a PPS worker, annotated with metadata
 // indicating when and why the line was logged.
 type LogMessage struct {
@@ -1372,7 +2161,7 @@ type LogMessage struct {
 func (m *LogMessage) Reset()                    { *m = LogMessage{} }
 func (m *LogMessage) String() string            { return proto.CompactTextString(m) }
 func (*LogMessage) ProtoMessage()               {}
-func (*LogMessage) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{25} }
+func (*LogMessage) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{35} }
 
 func (m *LogMessage) GetPipelineName() string {
 	if m != nil {
@@ -1438,7 +2227,7 @@ type RestartDatumRequest struct {
 func (m *RestartDatumRequest) Reset()                    { *m = RestartDatumRequest{} }
 func (m *RestartDatumRequest) String() string            { return proto.CompactTextString(m) }
 func (*RestartDatumRequest) ProtoMessage()               {}
-func (*RestartDatumRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{26} }
+func (*RestartDatumRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{36} }
 
 func (m *RestartDatumRequest) GetJob() *Job {
 	if m != nil {
@@ -1454,6 +2243,84 @@ func (m *RestartDatumRequest) GetDataFilters() []string {
 	return nil
 }
 
+type FileProvenanceRequest struct {
+	File *pfs.File `protobuf:"bytes,1,opt,name=file" json:"file,omitempty"`
+}
+
+func (m *FileProvenanceRequest) Reset()                    { *m = FileProvenanceRequest{} }
+func (m *FileProvenanceRequest) String() string            { return proto.CompactTextString(m) }
+func (*FileProvenanceRequest) ProtoMessage()               {}
+func (*FileProvenanceRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{37} }
+
+func (m *FileProvenanceRequest) GetFile() *pfs.File {
+	if m != nil {
+		return m.File
+	}
+	return nil
+}
+
+// DatumProvenance is a datum that a job processed, and the files it output.
+type DatumProvenance struct {
+	Job *Job `protobuf:"bytes,1,opt,name=job" json:"job,omitempty"`
+	// inputs are the datum's input files.
+	Inputs []*pfs.File `protobuf:"bytes,2,rep,name=inputs" json:"inputs,omitempty"`
+	// outputs are the files that the datum output, in the job's output commit.
+	Outputs []*pfs.File `protobuf:"bytes,3,rep,name=outputs" json:"outputs,omitempty"`
+}
+
+func (m *DatumProvenance) Reset()                    { *m = DatumProvenance{} }
+func (m *DatumProvenance) String() string            { return proto.CompactTextString(m) }
+func (*DatumProvenance) ProtoMessage()               {}
+func (*DatumProvenance) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{38} }
+
+func (m *DatumProvenance) GetJob() *Job {
+	if m != nil {
+		return m.Job
+	}
+	return nil
+}
+
+func (m *DatumProvenance) GetInputs() []*pfs.File {
+	if m != nil {
+		return m.Inputs
+	}
+	return nil
+}
+
+func (m *DatumProvenance) GetOutputs() []*pfs.File {
+	if m != nil {
+		return m.Outputs
+	}
+	return nil
+}
+
+type FileProvenanceResponse struct {
+	// upstream are the datums that output the file, if it's in a pipeline's
+	// output repo. Their outputs are limited to the file.
+	Upstream []*DatumProvenance `protobuf:"bytes,1,rep,name=upstream" json:"upstream,omitempty"`
+	// downstream are the datums that took the file as input, in any job.
+	Downstream []*DatumProvenance `protobuf:"bytes,2,rep,name=downstream" json:"downstream,omitempty"`
+}
+
+func (m *FileProvenanceResponse) Reset()                    { *m = FileProvenanceResponse{} }
+func (m *FileProvenanceResponse) String() string            { return proto.CompactTextString(m) }
+func (*FileProvenanceResponse) ProtoMessage()               {}
+func (*FileProvenanceResponse) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{39} }
+
+func (m *FileProvenanceResponse) GetUpstream() []*DatumProvenance {
+	if m != nil {
+		return m.Upstream
+	}
+	return nil
+}
+
+func (m *FileProvenanceResponse) GetDownstream() []*DatumProvenance {
+	if m != nil {
+		return m.Downstream
+	}
+	return nil
+}
+
 type CreatePipelineRequest struct {
 	Pipeline           *Pipeline                  `protobuf:"bytes,1,opt,name=pipeline" json:"pipeline,omitempty"`
 	Transform          *Transform                 `protobuf:"bytes,2,opt,name=transform" json:"transform,omitempty"`
@@ -1467,12 +2334,65 @@ type CreatePipelineRequest struct {
 	Input              *Input                     `protobuf:"bytes,13,opt,name=input" json:"input,omitempty"`
 	Description        string                     `protobuf:"bytes,14,opt,name=description,proto3" json:"description,omitempty"`
 	Incremental        bool                       `protobuf:"varint,15,opt,name=incremental,proto3" json:"incremental,omitempty"`
+	Webhooks           []*Webhook                 `protobuf:"bytes,16,rep,name=webhooks" json:"webhooks,omitempty"`
+	// S3Gateway serves the inputs and output of each datum over S3 to the
+	// user code, at the endpoint in $S3_ENDPOINT.
+	S3Gateway bool `protobuf:"varint,17,opt,name=s3_gateway,json=s3Gateway,proto3" json:"s3_gateway,omitempty"`
+	// CacheSize is the size of the in-memory cache of objects in the
+	// pipeline's workers' sidecars, e.g. "64M", which is the default.
+	CacheSize string `protobuf:"bytes,18,opt,name=cache_size,json=cacheSize,proto3" json:"cache_size,omitempty"`
+	// DiskCacheSize is the size of the on-disk cache of objects in the
+	// pipeline's workers' sidecars, e.g. "10G". It's disabled if empty.
+	DiskCacheSize string `protobuf:"bytes,19,opt,name=disk_cache_size,json=diskCacheSize,proto3" json:"disk_cache_size,omitempty"`
+	// DatumConcurrency is the number of datums that each worker processes at
+	// once, each one in its own mount namespace, so that it sees its own
+	// /pfs. It defaults to 1.
+	DatumConcurrency int64 `protobuf:"varint,20,opt,name=datum_concurrency,json=datumConcurrency,proto3" json:"datum_concurrency,omitempty"`
+	// PodLabels are added to the labels of the pipeline's worker pods and of
+	// their replication controller and service, e.g. for cost allocation,
+	// network policies or monitoring. They can't replace the labels that
+	// pachyderm sets itself.
+	PodLabels map[string]string `protobuf:"bytes,21,rep,name=pod_labels,json=podLabels" json:"pod_labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// PodAnnotations are added to the annotations of the pipeline's worker
+	// pods and of their replication controller and service.
+	PodAnnotations map[string]string `protobuf:"bytes,22,rep,name=pod_annotations,json=podAnnotations" json:"pod_annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// SecondaryOutputs are outputs besides /pfs/out, which are committed to
+	// their own branches, e.g. for metrics that shouldn't be mixed in with
+	// the pipeline's output.
+	SecondaryOutputs []*SecondaryOutput `protobuf:"bytes,23,rep,name=secondary_outputs,json=secondaryOutputs" json:"secondary_outputs,omitempty"`
+	// PinImage resolves the tag of transform.image to a digest in the image's
+	// registry, and records it in transform.image_digest.
+	PinImage bool `protobuf:"varint,24,opt,name=pin_image,json=pinImage,proto3" json:"pin_image,omitempty"`
+	// Logs, if set, makes the pipeline's workers commit the logs of each
+	// datum to a branch of the output repo, so that get-logs can return them
+	// after the workers' pods are gone.
+	Logs *LogsSpec `protobuf:"bytes,25,opt,name=logs" json:"logs,omitempty"`
+	// Scheduling constrains the nodes that the pipeline's workers run on,
+	// e.g. to run them on preemptible or spot nodes.
+	Scheduling *SchedulingSpec `protobuf:"bytes,26,opt,name=scheduling" json:"scheduling,omitempty"`
+	// SkipCapacityCheck creates the pipeline even if its workers can't all
+	// be scheduled on the cluster's nodes as they are, e.g. because the
+	// cluster autoscales.
+	SkipCapacityCheck bool `protobuf:"varint,27,opt,name=skip_capacity_check,json=skipCapacityCheck,proto3" json:"skip_capacity_check,omitempty"`
+	// ServiceAccount is the Kubernetes service account that the pipeline's
+	// workers run as, e.g. so that they can call the Kubernetes API or use
+	// workload identity. It defaults to pachyderm-worker, which pachyderm
+	// creates without any permissions beyond what workers need.
+	ServiceAccount string `protobuf:"bytes,28,opt,name=service_account,json=serviceAccount,proto3" json:"service_account,omitempty"`
+	// Sidecars are extra containers, e.g. a local proxy or a metrics
+	// exporter, that run alongside the user container in each worker pod.
+	Sidecars []*Sidecar `protobuf:"bytes,29,rep,name=sidecars" json:"sidecars,omitempty"`
+	// RuntimeClass is the name of the Kubernetes RuntimeClass that the
+	// pipeline's worker pods run with, e.g. one for gVisor or Kata
+	// Containers, to sandbox untrusted code more strongly than a plain
+	// container does. It has to exist when the pipeline is created.
+	RuntimeClass string `protobuf:"bytes,30,opt,name=runtime_class,json=runtimeClass,proto3" json:"runtime_class,omitempty"`
 }
 
 func (m *CreatePipelineRequest) Reset()                    { *m = CreatePipelineRequest{} }
 func (m *CreatePipelineRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreatePipelineRequest) ProtoMessage()               {}
-func (*CreatePipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{27} }
+func (*CreatePipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{40} }
 
 func (m *CreatePipelineRequest) GetPipeline() *Pipeline {
 	if m != nil {
@@ -1558,41 +2478,395 @@ func (m *CreatePipelineRequest) GetIncremental() bool {
 	return false
 }
 
-type InspectPipelineRequest struct {
-	Pipeline *Pipeline `protobuf:"bytes,1,opt,name=pipeline" json:"pipeline,omitempty"`
+func (m *CreatePipelineRequest) GetWebhooks() []*Webhook {
+	if m != nil {
+		return m.Webhooks
+	}
+	return nil
 }
 
-func (m *InspectPipelineRequest) Reset()                    { *m = InspectPipelineRequest{} }
-func (m *InspectPipelineRequest) String() string            { return proto.CompactTextString(m) }
-func (*InspectPipelineRequest) ProtoMessage()               {}
-func (*InspectPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{28} }
-
-func (m *InspectPipelineRequest) GetPipeline() *Pipeline {
+func (m *CreatePipelineRequest) GetS3Gateway() bool {
 	if m != nil {
-		return m.Pipeline
+		return m.S3Gateway
 	}
-	return nil
+	return false
+}
+
+func (m *CreatePipelineRequest) GetCacheSize() string {
+	if m != nil {
+		return m.CacheSize
+	}
+	return ""
+}
+
+func (m *CreatePipelineRequest) GetDiskCacheSize() string {
+	if m != nil {
+		return m.DiskCacheSize
+	}
+	return ""
+}
+
+func (m *CreatePipelineRequest) GetDatumConcurrency() int64 {
+	if m != nil {
+		return m.DatumConcurrency
+	}
+	return 0
+}
+
+func (m *CreatePipelineRequest) GetPodLabels() map[string]string {
+	if m != nil {
+		return m.PodLabels
+	}
+	return nil
+}
+
+func (m *CreatePipelineRequest) GetPodAnnotations() map[string]string {
+	if m != nil {
+		return m.PodAnnotations
+	}
+	return nil
+}
+
+func (m *CreatePipelineRequest) GetSecondaryOutputs() []*SecondaryOutput {
+	if m != nil {
+		return m.SecondaryOutputs
+	}
+	return nil
+}
+
+func (m *CreatePipelineRequest) GetPinImage() bool {
+	if m != nil {
+		return m.PinImage
+	}
+	return false
+}
+
+func (m *CreatePipelineRequest) GetLogs() *LogsSpec {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+func (m *CreatePipelineRequest) GetScheduling() *SchedulingSpec {
+	if m != nil {
+		return m.Scheduling
+	}
+	return nil
+}
+
+func (m *CreatePipelineRequest) GetSkipCapacityCheck() bool {
+	if m != nil {
+		return m.SkipCapacityCheck
+	}
+	return false
+}
+
+func (m *CreatePipelineRequest) GetServiceAccount() string {
+	if m != nil {
+		return m.ServiceAccount
+	}
+	return ""
+}
+
+func (m *CreatePipelineRequest) GetSidecars() []*Sidecar {
+	if m != nil {
+		return m.Sidecars
+	}
+	return nil
+}
+
+func (m *CreatePipelineRequest) GetRuntimeClass() string {
+	if m != nil {
+		return m.RuntimeClass
+	}
+	return ""
+}
+
+// SecondaryOutput is an output of a pipeline besides /pfs/out.
+type SecondaryOutput struct {
+	// Name is the output's directory under /pfs, e.g. "metrics" for
+	// /pfs/metrics. It can't be "out" or the name of one of the pipeline's
+	// inputs.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Repo is the repo that the output is committed to, it defaults to the
+	// pipeline's output repo. It's created, with the same provenance as the
+	// pipeline's output repo, if it doesn't exist.
+	Repo string `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	// Branch is the branch that the output is committed to, it defaults to
+	// the output's name.
+	Branch string `protobuf:"bytes,3,opt,name=branch,proto3" json:"branch,omitempty"`
+}
+
+func (m *SecondaryOutput) Reset()                    { *m = SecondaryOutput{} }
+func (m *SecondaryOutput) String() string            { return proto.CompactTextString(m) }
+func (*SecondaryOutput) ProtoMessage()               {}
+func (*SecondaryOutput) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{41} }
+
+func (m *SecondaryOutput) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SecondaryOutput) GetRepo() string {
+	if m != nil {
+		return m.Repo
+	}
+	return ""
+}
+
+func (m *SecondaryOutput) GetBranch() string {
+	if m != nil {
+		return m.Branch
+	}
+	return ""
+}
+
+// LogsSpec configures how a pipeline's logs are kept. Each job's logs are
+// committed, once the job finishes, to /<job ID>/<datum hash> on a branch of
+// the pipeline's output repo, as the JSON log messages that get-logs
+// returns.
+type LogsSpec struct {
+	// Branch is the branch of the output repo that logs are committed to, it
+	// defaults to "logs".
+	Branch string `protobuf:"bytes,1,opt,name=branch,proto3" json:"branch,omitempty"`
+	// MaxBytesPerDatum is the most log bytes kept for each datum, e.g. "1M",
+	// later lines are dropped. It defaults to 1M.
+	MaxBytesPerDatum string `protobuf:"bytes,2,opt,name=max_bytes_per_datum,json=maxBytesPerDatum,proto3" json:"max_bytes_per_datum,omitempty"`
+	// Retention is how long jobs' logs are kept after the jobs start, e.g.
+	// "720h". If it's unset, they're kept until the pipeline is deleted.
+	Retention *google_protobuf2.Duration `protobuf:"bytes,3,opt,name=retention" json:"retention,omitempty"`
+}
+
+func (m *LogsSpec) Reset()                    { *m = LogsSpec{} }
+func (m *LogsSpec) String() string            { return proto.CompactTextString(m) }
+func (*LogsSpec) ProtoMessage()               {}
+func (*LogsSpec) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{42} }
+
+func (m *LogsSpec) GetBranch() string {
+	if m != nil {
+		return m.Branch
+	}
+	return ""
+}
+
+func (m *LogsSpec) GetMaxBytesPerDatum() string {
+	if m != nil {
+		return m.MaxBytesPerDatum
+	}
+	return ""
+}
+
+func (m *LogsSpec) GetRetention() *google_protobuf2.Duration {
+	if m != nil {
+		return m.Retention
+	}
+	return nil
+}
+
+// SchedulingSpec constrains the nodes that a pipeline's workers are
+// scheduled on. Workers can run on preemptible or spot nodes: the datums that
+// a worker has finished are kept when its node is reclaimed, and only the
+// ones it was processing are retried on other workers.
+type SchedulingSpec struct {
+	// NodeSelector is the labels that nodes need to have for workers to be
+	// scheduled on them, as in a pod's nodeSelector.
+	NodeSelector map[string]string `protobuf:"bytes,1,rep,name=node_selector,json=nodeSelector" json:"node_selector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Tolerations are the taints that workers tolerate, as in a pod's
+	// tolerations, e.g. the taint that keeps other pods off of spot nodes.
+	Tolerations []*Toleration `protobuf:"bytes,2,rep,name=tolerations" json:"tolerations,omitempty"`
+	// PreferredZone is the zone that the scheduler prefers to put workers in,
+	// e.g. the one their input data is cached in. It overrides the zone that
+	// the cluster was deployed with (see "pachctl deploy --zone").
+	PreferredZone string `protobuf:"bytes,3,opt,name=preferred_zone,json=preferredZone,proto3" json:"preferred_zone,omitempty"`
+}
+
+func (m *SchedulingSpec) Reset()                    { *m = SchedulingSpec{} }
+func (m *SchedulingSpec) String() string            { return proto.CompactTextString(m) }
+func (*SchedulingSpec) ProtoMessage()               {}
+func (*SchedulingSpec) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{43} }
+
+func (m *SchedulingSpec) GetNodeSelector() map[string]string {
+	if m != nil {
+		return m.NodeSelector
+	}
+	return nil
+}
+
+func (m *SchedulingSpec) GetTolerations() []*Toleration {
+	if m != nil {
+		return m.Tolerations
+	}
+	return nil
+}
+
+func (m *SchedulingSpec) GetPreferredZone() string {
+	if m != nil {
+		return m.PreferredZone
+	}
+	return ""
+}
+
+// Sidecar is a container that runs alongside a pipeline's user container in
+// each of its worker pods. It shares the user container's volumes, including
+// /pfs, and its network, so the user code can reach it on localhost.
+type Sidecar struct {
+	// Name is the container's name, which must be unique within the pod and
+	// can't be one of the names pachyderm uses: "init", "user" or "storage".
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Image string `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
+	// Cmd is the container's command. If it's empty the image's entrypoint is
+	// run.
+	Cmd []string          `protobuf:"bytes,3,rep,name=cmd" json:"cmd,omitempty"`
+	Env map[string]string `protobuf:"bytes,4,rep,name=env" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// ResourceSpec is the resources that the container requests, which are
+	// added to the worker's.
+	ResourceSpec *ResourceSpec `protobuf:"bytes,5,opt,name=resource_spec,json=resourceSpec" json:"resource_spec,omitempty"`
+}
+
+func (m *Sidecar) Reset()                    { *m = Sidecar{} }
+func (m *Sidecar) String() string            { return proto.CompactTextString(m) }
+func (*Sidecar) ProtoMessage()               {}
+func (*Sidecar) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{44} }
+
+func (m *Sidecar) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Sidecar) GetImage() string {
+	if m != nil {
+		return m.Image
+	}
+	return ""
+}
+
+func (m *Sidecar) GetCmd() []string {
+	if m != nil {
+		return m.Cmd
+	}
+	return nil
+}
+
+func (m *Sidecar) GetEnv() map[string]string {
+	if m != nil {
+		return m.Env
+	}
+	return nil
+}
+
+func (m *Sidecar) GetResourceSpec() *ResourceSpec {
+	if m != nil {
+		return m.ResourceSpec
+	}
+	return nil
+}
+
+// Toleration is a Kubernetes toleration, see
+// https://kubernetes.io/docs/concepts/configuration/taint-and-toleration/
+type Toleration struct {
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Operator is "Equal", the default, or "Exists".
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Value    string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// Effect is "NoSchedule" or "PreferNoSchedule", or empty to match every
+	// effect.
+	Effect string `protobuf:"bytes,4,opt,name=effect,proto3" json:"effect,omitempty"`
+}
+
+func (m *Toleration) Reset()                    { *m = Toleration{} }
+func (m *Toleration) String() string            { return proto.CompactTextString(m) }
+func (*Toleration) ProtoMessage()               {}
+func (*Toleration) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{45} }
+
+func (m *Toleration) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *Toleration) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *Toleration) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *Toleration) GetEffect() string {
+	if m != nil {
+		return m.Effect
+	}
+	return ""
+}
+
+type InspectPipelineRequest struct {
+	Pipeline *Pipeline `protobuf:"bytes,1,opt,name=pipeline" json:"pipeline,omitempty"`
+	// version, if set, is the version of the pipeline to inspect, rather than
+	// the current one.
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *InspectPipelineRequest) Reset()                    { *m = InspectPipelineRequest{} }
+func (m *InspectPipelineRequest) String() string            { return proto.CompactTextString(m) }
+func (*InspectPipelineRequest) ProtoMessage()               {}
+func (*InspectPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{46} }
+
+func (m *InspectPipelineRequest) GetPipeline() *Pipeline {
+	if m != nil {
+		return m.Pipeline
+	}
+	return nil
+}
+
+func (m *InspectPipelineRequest) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
 }
 
 type ListPipelineRequest struct {
+	// project, if set, limits the pipelines to the ones in the project.
+	Project string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
 }
 
 func (m *ListPipelineRequest) Reset()                    { *m = ListPipelineRequest{} }
 func (m *ListPipelineRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListPipelineRequest) ProtoMessage()               {}
-func (*ListPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{29} }
+func (*ListPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{47} }
+
+func (m *ListPipelineRequest) GetProject() string {
+	if m != nil {
+		return m.Project
+	}
+	return ""
+}
 
 type DeletePipelineRequest struct {
 	Pipeline   *Pipeline `protobuf:"bytes,1,opt,name=pipeline" json:"pipeline,omitempty"`
 	DeleteJobs bool      `protobuf:"varint,2,opt,name=delete_jobs,json=deleteJobs,proto3" json:"delete_jobs,omitempty"`
 	DeleteRepo bool      `protobuf:"varint,3,opt,name=delete_repo,json=deleteRepo,proto3" json:"delete_repo,omitempty"`
 	All        bool      `protobuf:"varint,4,opt,name=all,proto3" json:"all,omitempty"`
+	// cascade, if true, also deletes the pipelines downstream of the pipeline.
+	Cascade bool `protobuf:"varint,5,opt,name=cascade,proto3" json:"cascade,omitempty"`
 }
 
 func (m *DeletePipelineRequest) Reset()                    { *m = DeletePipelineRequest{} }
 func (m *DeletePipelineRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeletePipelineRequest) ProtoMessage()               {}
-func (*DeletePipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{30} }
+func (*DeletePipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{48} }
 
 func (m *DeletePipelineRequest) GetPipeline() *Pipeline {
 	if m != nil {
@@ -1622,6 +2896,13 @@ func (m *DeletePipelineRequest) GetAll() bool {
 	return false
 }
 
+func (m *DeletePipelineRequest) GetCascade() bool {
+	if m != nil {
+		return m.Cascade
+	}
+	return false
+}
+
 type StartPipelineRequest struct {
 	Pipeline *Pipeline `protobuf:"bytes,1,opt,name=pipeline" json:"pipeline,omitempty"`
 }
@@ -1629,7 +2910,7 @@ type StartPipelineRequest struct {
 func (m *StartPipelineRequest) Reset()                    { *m = StartPipelineRequest{} }
 func (m *StartPipelineRequest) String() string            { return proto.CompactTextString(m) }
 func (*StartPipelineRequest) ProtoMessage()               {}
-func (*StartPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{31} }
+func (*StartPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{49} }
 
 func (m *StartPipelineRequest) GetPipeline() *Pipeline {
 	if m != nil {
@@ -1645,7 +2926,7 @@ type StopPipelineRequest struct {
 func (m *StopPipelineRequest) Reset()                    { *m = StopPipelineRequest{} }
 func (m *StopPipelineRequest) String() string            { return proto.CompactTextString(m) }
 func (*StopPipelineRequest) ProtoMessage()               {}
-func (*StopPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{32} }
+func (*StopPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{50} }
 
 func (m *StopPipelineRequest) GetPipeline() *Pipeline {
 	if m != nil {
@@ -1663,7 +2944,7 @@ type RerunPipelineRequest struct {
 func (m *RerunPipelineRequest) Reset()                    { *m = RerunPipelineRequest{} }
 func (m *RerunPipelineRequest) String() string            { return proto.CompactTextString(m) }
 func (*RerunPipelineRequest) ProtoMessage()               {}
-func (*RerunPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{33} }
+func (*RerunPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{51} }
 
 func (m *RerunPipelineRequest) GetPipeline() *Pipeline {
 	if m != nil {
@@ -1692,7 +2973,7 @@ type GarbageCollectRequest struct {
 func (m *GarbageCollectRequest) Reset()                    { *m = GarbageCollectRequest{} }
 func (m *GarbageCollectRequest) String() string            { return proto.CompactTextString(m) }
 func (*GarbageCollectRequest) ProtoMessage()               {}
-func (*GarbageCollectRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{34} }
+func (*GarbageCollectRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{52} }
 
 type GarbageCollectResponse struct {
 }
@@ -1700,68 +2981,278 @@ type GarbageCollectResponse struct {
 func (m *GarbageCollectResponse) Reset()                    { *m = GarbageCollectResponse{} }
 func (m *GarbageCollectResponse) String() string            { return proto.CompactTextString(m) }
 func (*GarbageCollectResponse) ProtoMessage()               {}
-func (*GarbageCollectResponse) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{35} }
+func (*GarbageCollectResponse) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{53} }
 
-func init() {
-	proto.RegisterType((*Secret)(nil), "pps.Secret")
-	proto.RegisterType((*Transform)(nil), "pps.Transform")
-	proto.RegisterType((*Egress)(nil), "pps.Egress")
-	proto.RegisterType((*Job)(nil), "pps.Job")
-	proto.RegisterType((*Service)(nil), "pps.Service")
-	proto.RegisterType((*AtomInput)(nil), "pps.AtomInput")
-	proto.RegisterType((*Input)(nil), "pps.Input")
-	proto.RegisterType((*JobInput)(nil), "pps.JobInput")
-	proto.RegisterType((*ParallelismSpec)(nil), "pps.ParallelismSpec")
-	proto.RegisterType((*Datum)(nil), "pps.Datum")
-	proto.RegisterType((*WorkerStatus)(nil), "pps.WorkerStatus")
-	proto.RegisterType((*ResourceSpec)(nil), "pps.ResourceSpec")
-	proto.RegisterType((*JobInfo)(nil), "pps.JobInfo")
-	proto.RegisterType((*Worker)(nil), "pps.Worker")
-	proto.RegisterType((*JobInfos)(nil), "pps.JobInfos")
-	proto.RegisterType((*Pipeline)(nil), "pps.Pipeline")
-	proto.RegisterType((*PipelineInput)(nil), "pps.PipelineInput")
-	proto.RegisterType((*PipelineInfo)(nil), "pps.PipelineInfo")
-	proto.RegisterType((*PipelineInfos)(nil), "pps.PipelineInfos")
-	proto.RegisterType((*CreateJobRequest)(nil), "pps.CreateJobRequest")
-	proto.RegisterType((*InspectJobRequest)(nil), "pps.InspectJobRequest")
-	proto.RegisterType((*ListJobRequest)(nil), "pps.ListJobRequest")
-	proto.RegisterType((*DeleteJobRequest)(nil), "pps.DeleteJobRequest")
-	proto.RegisterType((*StopJobRequest)(nil), "pps.StopJobRequest")
-	proto.RegisterType((*GetLogsRequest)(nil), "pps.GetLogsRequest")
-	proto.RegisterType((*LogMessage)(nil), "pps.LogMessage")
-	proto.RegisterType((*RestartDatumRequest)(nil), "pps.RestartDatumRequest")
-	proto.RegisterType((*CreatePipelineRequest)(nil), "pps.CreatePipelineRequest")
-	proto.RegisterType((*InspectPipelineRequest)(nil), "pps.InspectPipelineRequest")
-	proto.RegisterType((*ListPipelineRequest)(nil), "pps.ListPipelineRequest")
-	proto.RegisterType((*DeletePipelineRequest)(nil), "pps.DeletePipelineRequest")
-	proto.RegisterType((*StartPipelineRequest)(nil), "pps.StartPipelineRequest")
-	proto.RegisterType((*StopPipelineRequest)(nil), "pps.StopPipelineRequest")
-	proto.RegisterType((*RerunPipelineRequest)(nil), "pps.RerunPipelineRequest")
-	proto.RegisterType((*GarbageCollectRequest)(nil), "pps.GarbageCollectRequest")
-	proto.RegisterType((*GarbageCollectResponse)(nil), "pps.GarbageCollectResponse")
-	proto.RegisterEnum("pps.JobState", JobState_name, JobState_value)
-	proto.RegisterEnum("pps.WorkerState", WorkerState_name, WorkerState_value)
-	proto.RegisterEnum("pps.PipelineState", PipelineState_name, PipelineState_value)
-	proto.RegisterEnum("pps.ParallelismSpec_Strategy", ParallelismSpec_Strategy_name, ParallelismSpec_Strategy_value)
+type UsageRequest struct {
+	// Only compute that happened after since is counted, if unset all jobs are
+	// counted.
+	Since *google_protobuf1.Timestamp `protobuf:"bytes,1,opt,name=since" json:"since,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *UsageRequest) Reset()                    { *m = UsageRequest{} }
+func (m *UsageRequest) String() string            { return proto.CompactTextString(m) }
+func (*UsageRequest) ProtoMessage()               {}
+func (*UsageRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{54} }
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *UsageRequest) GetSince() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.Since
+	}
+	return nil
+}
 
-// Client API for API service
+type RepoUsage struct {
+	Repo      *pfs.Repo `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
+	SizeBytes uint64    `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+}
 
-type APIClient interface {
+func (m *RepoUsage) Reset()                    { *m = RepoUsage{} }
+func (m *RepoUsage) String() string            { return proto.CompactTextString(m) }
+func (*RepoUsage) ProtoMessage()               {}
+func (*RepoUsage) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{55} }
+
+func (m *RepoUsage) GetRepo() *pfs.Repo {
+	if m != nil {
+		return m.Repo
+	}
+	return nil
+}
+
+func (m *RepoUsage) GetSizeBytes() uint64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
+type PipelineUsage struct {
+	Pipeline *Pipeline `protobuf:"bytes,1,opt,name=pipeline" json:"pipeline,omitempty"`
+	Jobs     uint64    `protobuf:"varint,2,opt,name=jobs,proto3" json:"jobs,omitempty"`
+	// pod_hours is the sum over jobs of the job's duration times the number of
+	// workers it ran on.
+	PodHours float64 `protobuf:"fixed64,3,opt,name=pod_hours,json=podHours,proto3" json:"pod_hours,omitempty"`
+	// cpu_hours is pod_hours weighted by the cpu each worker requested, it's 0
+	// for pipelines that don't set a resource_spec.
+	CpuHours      float64 `protobuf:"fixed64,4,opt,name=cpu_hours,json=cpuHours,proto3" json:"cpu_hours,omitempty"`
+	DataProcessed int64   `protobuf:"varint,5,opt,name=data_processed,json=dataProcessed,proto3" json:"data_processed,omitempty"`
+	// output_size_bytes is the size of the pipeline's output repo.
+	OutputSizeBytes uint64 `protobuf:"varint,6,opt,name=output_size_bytes,json=outputSizeBytes,proto3" json:"output_size_bytes,omitempty"`
+	// wall_clock_hours is the sum of the jobs' durations.
+	WallClockHours float64 `protobuf:"fixed64,7,opt,name=wall_clock_hours,json=wallClockHours,proto3" json:"wall_clock_hours,omitempty"`
+	// measured_cpu_hours and memory_gb_hours are the sums of the cpu and
+	// memory that the jobs' worker pods used, see JobCost.
+	MeasuredCpuHours float64 `protobuf:"fixed64,8,opt,name=measured_cpu_hours,json=measuredCpuHours,proto3" json:"measured_cpu_hours,omitempty"`
+	MemoryGbHours    float64 `protobuf:"fixed64,9,opt,name=memory_gb_hours,json=memoryGbHours,proto3" json:"memory_gb_hours,omitempty"`
+	// node_types are the instance types of the nodes that the jobs ran on.
+	NodeTypes []string `protobuf:"bytes,10,rep,name=node_types,json=nodeTypes" json:"node_types,omitempty"`
+}
+
+func (m *PipelineUsage) Reset()                    { *m = PipelineUsage{} }
+func (m *PipelineUsage) String() string            { return proto.CompactTextString(m) }
+func (*PipelineUsage) ProtoMessage()               {}
+func (*PipelineUsage) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{56} }
+
+func (m *PipelineUsage) GetPipeline() *Pipeline {
+	if m != nil {
+		return m.Pipeline
+	}
+	return nil
+}
+
+func (m *PipelineUsage) GetJobs() uint64 {
+	if m != nil {
+		return m.Jobs
+	}
+	return 0
+}
+
+func (m *PipelineUsage) GetPodHours() float64 {
+	if m != nil {
+		return m.PodHours
+	}
+	return 0
+}
+
+func (m *PipelineUsage) GetCpuHours() float64 {
+	if m != nil {
+		return m.CpuHours
+	}
+	return 0
+}
+
+func (m *PipelineUsage) GetDataProcessed() int64 {
+	if m != nil {
+		return m.DataProcessed
+	}
+	return 0
+}
+
+func (m *PipelineUsage) GetOutputSizeBytes() uint64 {
+	if m != nil {
+		return m.OutputSizeBytes
+	}
+	return 0
+}
+
+func (m *PipelineUsage) GetWallClockHours() float64 {
+	if m != nil {
+		return m.WallClockHours
+	}
+	return 0
+}
+
+func (m *PipelineUsage) GetMeasuredCpuHours() float64 {
+	if m != nil {
+		return m.MeasuredCpuHours
+	}
+	return 0
+}
+
+func (m *PipelineUsage) GetMemoryGbHours() float64 {
+	if m != nil {
+		return m.MemoryGbHours
+	}
+	return 0
+}
+
+func (m *PipelineUsage) GetNodeTypes() []string {
+	if m != nil {
+		return m.NodeTypes
+	}
+	return nil
+}
+
+type UsageResponse struct {
+	Since     *google_protobuf1.Timestamp `protobuf:"bytes,1,opt,name=since" json:"since,omitempty"`
+	Until     *google_protobuf1.Timestamp `protobuf:"bytes,2,opt,name=until" json:"until,omitempty"`
+	Repos     []*RepoUsage                `protobuf:"bytes,3,rep,name=repos" json:"repos,omitempty"`
+	Pipelines []*PipelineUsage            `protobuf:"bytes,4,rep,name=pipelines" json:"pipelines,omitempty"`
+}
+
+func (m *UsageResponse) Reset()                    { *m = UsageResponse{} }
+func (m *UsageResponse) String() string            { return proto.CompactTextString(m) }
+func (*UsageResponse) ProtoMessage()               {}
+func (*UsageResponse) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{57} }
+
+func (m *UsageResponse) GetSince() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.Since
+	}
+	return nil
+}
+
+func (m *UsageResponse) GetUntil() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.Until
+	}
+	return nil
+}
+
+func (m *UsageResponse) GetRepos() []*RepoUsage {
+	if m != nil {
+		return m.Repos
+	}
+	return nil
+}
+
+func (m *UsageResponse) GetPipelines() []*PipelineUsage {
+	if m != nil {
+		return m.Pipelines
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Secret)(nil), "pps.Secret")
+	proto.RegisterType((*Transform)(nil), "pps.Transform")
+	proto.RegisterType((*Hooks)(nil), "pps.Hooks")
+	proto.RegisterType((*Egress)(nil), "pps.Egress")
+	proto.RegisterType((*Webhook)(nil), "pps.Webhook")
+	proto.RegisterType((*Job)(nil), "pps.Job")
+	proto.RegisterType((*Service)(nil), "pps.Service")
+	proto.RegisterType((*AtomInput)(nil), "pps.AtomInput")
+	proto.RegisterType((*GitInput)(nil), "pps.GitInput")
+	proto.RegisterType((*Input)(nil), "pps.Input")
+	proto.RegisterType((*JobInput)(nil), "pps.JobInput")
+	proto.RegisterType((*ParallelismSpec)(nil), "pps.ParallelismSpec")
+	proto.RegisterType((*Datum)(nil), "pps.Datum")
+	proto.RegisterType((*DatumManifest)(nil), "pps.DatumManifest")
+	proto.RegisterType((*DatumManifestInput)(nil), "pps.DatumManifestInput")
+	proto.RegisterType((*WorkerStatus)(nil), "pps.WorkerStatus")
+	proto.RegisterType((*WorkerPod)(nil), "pps.WorkerPod")
+	proto.RegisterType((*PodEvent)(nil), "pps.PodEvent")
+	proto.RegisterType((*ResourceSpec)(nil), "pps.ResourceSpec")
+	proto.RegisterType((*JobInfo)(nil), "pps.JobInfo")
+	proto.RegisterType((*JobCost)(nil), "pps.JobCost")
+	proto.RegisterType((*Worker)(nil), "pps.Worker")
+	proto.RegisterType((*JobInfos)(nil), "pps.JobInfos")
+	proto.RegisterType((*Pipeline)(nil), "pps.Pipeline")
+	proto.RegisterType((*PipelineInput)(nil), "pps.PipelineInput")
+	proto.RegisterType((*PipelineInfo)(nil), "pps.PipelineInfo")
+	proto.RegisterType((*PipelineInfos)(nil), "pps.PipelineInfos")
+	proto.RegisterType((*CreateJobRequest)(nil), "pps.CreateJobRequest")
+	proto.RegisterType((*InspectJobRequest)(nil), "pps.InspectJobRequest")
+	proto.RegisterType((*ListJobRequest)(nil), "pps.ListJobRequest")
+	proto.RegisterType((*FlushJobRequest)(nil), "pps.FlushJobRequest")
+	proto.RegisterType((*SubscribeJobRequest)(nil), "pps.SubscribeJobRequest")
+	proto.RegisterType((*DeleteJobRequest)(nil), "pps.DeleteJobRequest")
+	proto.RegisterType((*StopJobRequest)(nil), "pps.StopJobRequest")
+	proto.RegisterType((*GetLogsRequest)(nil), "pps.GetLogsRequest")
+	proto.RegisterType((*LogMessage)(nil), "pps.LogMessage")
+	proto.RegisterType((*RestartDatumRequest)(nil), "pps.RestartDatumRequest")
+	proto.RegisterType((*FileProvenanceRequest)(nil), "pps.FileProvenanceRequest")
+	proto.RegisterType((*DatumProvenance)(nil), "pps.DatumProvenance")
+	proto.RegisterType((*FileProvenanceResponse)(nil), "pps.FileProvenanceResponse")
+	proto.RegisterType((*CreatePipelineRequest)(nil), "pps.CreatePipelineRequest")
+	proto.RegisterType((*SecondaryOutput)(nil), "pps.SecondaryOutput")
+	proto.RegisterType((*LogsSpec)(nil), "pps.LogsSpec")
+	proto.RegisterType((*SchedulingSpec)(nil), "pps.SchedulingSpec")
+	proto.RegisterType((*Sidecar)(nil), "pps.Sidecar")
+	proto.RegisterType((*Toleration)(nil), "pps.Toleration")
+	proto.RegisterType((*InspectPipelineRequest)(nil), "pps.InspectPipelineRequest")
+	proto.RegisterType((*ListPipelineRequest)(nil), "pps.ListPipelineRequest")
+	proto.RegisterType((*DeletePipelineRequest)(nil), "pps.DeletePipelineRequest")
+	proto.RegisterType((*StartPipelineRequest)(nil), "pps.StartPipelineRequest")
+	proto.RegisterType((*StopPipelineRequest)(nil), "pps.StopPipelineRequest")
+	proto.RegisterType((*RerunPipelineRequest)(nil), "pps.RerunPipelineRequest")
+	proto.RegisterType((*GarbageCollectRequest)(nil), "pps.GarbageCollectRequest")
+	proto.RegisterType((*GarbageCollectResponse)(nil), "pps.GarbageCollectResponse")
+	proto.RegisterType((*UsageRequest)(nil), "pps.UsageRequest")
+	proto.RegisterType((*RepoUsage)(nil), "pps.RepoUsage")
+	proto.RegisterType((*PipelineUsage)(nil), "pps.PipelineUsage")
+	proto.RegisterType((*UsageResponse)(nil), "pps.UsageResponse")
+	proto.RegisterEnum("pps.JobState", JobState_name, JobState_value)
+	proto.RegisterEnum("pps.WorkerState", WorkerState_name, WorkerState_value)
+	proto.RegisterEnum("pps.PipelineState", PipelineState_name, PipelineState_value)
+	proto.RegisterEnum("pps.ParallelismSpec_Strategy", ParallelismSpec_Strategy_name, ParallelismSpec_Strategy_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for API service
+
+type APIClient interface {
 	CreateJob(ctx context.Context, in *CreateJobRequest, opts ...grpc.CallOption) (*Job, error)
 	InspectJob(ctx context.Context, in *InspectJobRequest, opts ...grpc.CallOption) (*JobInfo, error)
 	ListJob(ctx context.Context, in *ListJobRequest, opts ...grpc.CallOption) (*JobInfos, error)
+	// FlushJob returns the jobs triggered, directly or transitively, by the
+	// commits, as each of them finishes.
+	FlushJob(ctx context.Context, in *FlushJobRequest, opts ...grpc.CallOption) (API_FlushJobClient, error)
+	// SubscribeJob returns a pipeline's jobs each time they're created or
+	// updated, until the caller cancels it.
+	SubscribeJob(ctx context.Context, in *SubscribeJobRequest, opts ...grpc.CallOption) (API_SubscribeJobClient, error)
 	DeleteJob(ctx context.Context, in *DeleteJobRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
 	StopJob(ctx context.Context, in *StopJobRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
 	RestartDatum(ctx context.Context, in *RestartDatumRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	// FileProvenance returns the datums, and the jobs that processed them,
+	// that a file was output by or was an input of.
+	FileProvenance(ctx context.Context, in *FileProvenanceRequest, opts ...grpc.CallOption) (*FileProvenanceResponse, error)
 	CreatePipeline(ctx context.Context, in *CreatePipelineRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
 	InspectPipeline(ctx context.Context, in *InspectPipelineRequest, opts ...grpc.CallOption) (*PipelineInfo, error)
 	ListPipeline(ctx context.Context, in *ListPipelineRequest, opts ...grpc.CallOption) (*PipelineInfos, error)
@@ -1774,6 +3265,8 @@ type APIClient interface {
 	GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (API_GetLogsClient, error)
 	// Garbage collection
 	GarbageCollect(ctx context.Context, in *GarbageCollectRequest, opts ...grpc.CallOption) (*GarbageCollectResponse, error)
+	// Usage attributes storage and compute to repos and pipelines
+	Usage(ctx context.Context, in *UsageRequest, opts ...grpc.CallOption) (*UsageResponse, error)
 }
 
 type aPIClient struct {
@@ -1811,6 +3304,70 @@ func (c *aPIClient) ListJob(ctx context.Context, in *ListJobRequest, opts ...grp
 	return out, nil
 }
 
+func (c *aPIClient) FlushJob(ctx context.Context, in *FlushJobRequest, opts ...grpc.CallOption) (API_FlushJobClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_API_serviceDesc.Streams[0], c.cc, "/pps.API/FlushJob", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIFlushJobClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_FlushJobClient interface {
+	Recv() (*JobInfo, error)
+	grpc.ClientStream
+}
+
+type aPIFlushJobClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIFlushJobClient) Recv() (*JobInfo, error) {
+	m := new(JobInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) SubscribeJob(ctx context.Context, in *SubscribeJobRequest, opts ...grpc.CallOption) (API_SubscribeJobClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_API_serviceDesc.Streams[1], c.cc, "/pps.API/SubscribeJob", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPISubscribeJobClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_SubscribeJobClient interface {
+	Recv() (*JobInfo, error)
+	grpc.ClientStream
+}
+
+type aPISubscribeJobClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPISubscribeJobClient) Recv() (*JobInfo, error) {
+	m := new(JobInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *aPIClient) DeleteJob(ctx context.Context, in *DeleteJobRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
 	out := new(google_protobuf.Empty)
 	err := grpc.Invoke(ctx, "/pps.API/DeleteJob", in, out, c.cc, opts...)
@@ -1838,6 +3395,15 @@ func (c *aPIClient) RestartDatum(ctx context.Context, in *RestartDatumRequest, o
 	return out, nil
 }
 
+func (c *aPIClient) FileProvenance(ctx context.Context, in *FileProvenanceRequest, opts ...grpc.CallOption) (*FileProvenanceResponse, error) {
+	out := new(FileProvenanceResponse)
+	err := grpc.Invoke(ctx, "/pps.API/FileProvenance", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) CreatePipeline(ctx context.Context, in *CreatePipelineRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
 	out := new(google_protobuf.Empty)
 	err := grpc.Invoke(ctx, "/pps.API/CreatePipeline", in, out, c.cc, opts...)
@@ -1911,7 +3477,7 @@ func (c *aPIClient) DeleteAll(ctx context.Context, in *google_protobuf.Empty, op
 }
 
 func (c *aPIClient) GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (API_GetLogsClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_API_serviceDesc.Streams[0], c.cc, "/pps.API/GetLogs", opts...)
+	stream, err := grpc.NewClientStream(ctx, &_API_serviceDesc.Streams[2], c.cc, "/pps.API/GetLogs", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1951,15 +3517,33 @@ func (c *aPIClient) GarbageCollect(ctx context.Context, in *GarbageCollectReques
 	return out, nil
 }
 
+func (c *aPIClient) Usage(ctx context.Context, in *UsageRequest, opts ...grpc.CallOption) (*UsageResponse, error) {
+	out := new(UsageResponse)
+	err := grpc.Invoke(ctx, "/pps.API/Usage", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for API service
 
 type APIServer interface {
 	CreateJob(context.Context, *CreateJobRequest) (*Job, error)
 	InspectJob(context.Context, *InspectJobRequest) (*JobInfo, error)
 	ListJob(context.Context, *ListJobRequest) (*JobInfos, error)
+	// FlushJob returns the jobs triggered, directly or transitively, by the
+	// commits, as each of them finishes.
+	FlushJob(*FlushJobRequest, API_FlushJobServer) error
+	// SubscribeJob returns a pipeline's jobs each time they're created or
+	// updated, until the caller cancels it.
+	SubscribeJob(*SubscribeJobRequest, API_SubscribeJobServer) error
 	DeleteJob(context.Context, *DeleteJobRequest) (*google_protobuf.Empty, error)
 	StopJob(context.Context, *StopJobRequest) (*google_protobuf.Empty, error)
 	RestartDatum(context.Context, *RestartDatumRequest) (*google_protobuf.Empty, error)
+	// FileProvenance returns the datums, and the jobs that processed them,
+	// that a file was output by or was an input of.
+	FileProvenance(context.Context, *FileProvenanceRequest) (*FileProvenanceResponse, error)
 	CreatePipeline(context.Context, *CreatePipelineRequest) (*google_protobuf.Empty, error)
 	InspectPipeline(context.Context, *InspectPipelineRequest) (*PipelineInfo, error)
 	ListPipeline(context.Context, *ListPipelineRequest) (*PipelineInfos, error)
@@ -1972,6 +3556,8 @@ type APIServer interface {
 	GetLogs(*GetLogsRequest, API_GetLogsServer) error
 	// Garbage collection
 	GarbageCollect(context.Context, *GarbageCollectRequest) (*GarbageCollectResponse, error)
+	// Usage attributes storage and compute to repos and pipelines
+	Usage(context.Context, *UsageRequest) (*UsageResponse, error)
 }
 
 func RegisterAPIServer(s *grpc.Server, srv APIServer) {
@@ -2032,6 +3618,48 @@ func _API_ListJob_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_FlushJob_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FlushJobRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).FlushJob(m, &aPIFlushJobServer{stream})
+}
+
+type API_FlushJobServer interface {
+	Send(*JobInfo) error
+	grpc.ServerStream
+}
+
+type aPIFlushJobServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIFlushJobServer) Send(m *JobInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_SubscribeJob_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeJobRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).SubscribeJob(m, &aPISubscribeJobServer{stream})
+}
+
+type API_SubscribeJobServer interface {
+	Send(*JobInfo) error
+	grpc.ServerStream
+}
+
+type aPISubscribeJobServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPISubscribeJobServer) Send(m *JobInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _API_DeleteJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteJobRequest)
 	if err := dec(in); err != nil {
@@ -2086,6 +3714,24 @@ func _API_RestartDatum_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_FileProvenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FileProvenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).FileProvenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps.API/FileProvenance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).FileProvenance(ctx, req.(*FileProvenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_CreatePipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreatePipelineRequest)
 	if err := dec(in); err != nil {
@@ -2269,6 +3915,24 @@ func _API_GarbageCollect_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_Usage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).Usage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps.API/Usage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).Usage(ctx, req.(*UsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _API_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pps.API",
 	HandlerType: (*APIServer)(nil),
@@ -2297,6 +3961,10 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RestartDatum",
 			Handler:    _API_RestartDatum_Handler,
 		},
+		{
+			MethodName: "FileProvenance",
+			Handler:    _API_FileProvenance_Handler,
+		},
 		{
 			MethodName: "CreatePipeline",
 			Handler:    _API_CreatePipeline_Handler,
@@ -2333,8 +4001,22 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GarbageCollect",
 			Handler:    _API_GarbageCollect_Handler,
 		},
+		{
+			MethodName: "Usage",
+			Handler:    _API_Usage_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "FlushJob",
+			Handler:       _API_FlushJob_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeJob",
+			Handler:       _API_SubscribeJob_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "GetLogs",
 			Handler:       _API_GetLogs_Handler,
@@ -2497,6 +4179,115 @@ func (m *Transform) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
+	if len(m.ImageDigest) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.ImageDigest)))
+		i += copy(dAtA[i:], m.ImageDigest)
+	}
+	if len(m.Setup) > 0 {
+		for _, s := range m.Setup {
+			dAtA[i] = 0x5a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Hooks != nil {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Hooks.Size()))
+		n3, err := m.Hooks.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	return i, nil
+}
+
+func (m *Hooks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Hooks) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.BeforeDatum) > 0 {
+		for _, s := range m.BeforeDatum {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.AfterDatum) > 0 {
+		for _, s := range m.AfterDatum {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.JobStart) > 0 {
+		for _, s := range m.JobStart {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.JobEnd) > 0 {
+		for _, s := range m.JobEnd {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
 	return i, nil
 }
 
@@ -2524,6 +4315,47 @@ func (m *Egress) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *Webhook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Webhook) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.URL) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.URL)))
+		i += copy(dAtA[i:], m.URL)
+	}
+	if len(m.States) > 0 {
+		dAtA5 := make([]byte, len(m.States)*10)
+		var j4 int
+		for _, num := range m.States {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(j4))
+		i += copy(dAtA[i:], dAtA5[:j4])
+	}
+	return i, nil
+}
+
 func (m *Job) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2637,6 +4469,64 @@ func (m *AtomInput) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintPps(dAtA, i, uint64(len(m.FromCommit)))
 		i += copy(dAtA[i:], m.FromCommit)
 	}
+	if m.Mount {
+		dAtA[i] = 0x40
+		i++
+		if m.Mount {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.GroupBy) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.GroupBy)))
+		i += copy(dAtA[i:], m.GroupBy)
+	}
+	return i, nil
+}
+
+func (m *GitInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GitInput) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.URL) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.URL)))
+		i += copy(dAtA[i:], m.URL)
+	}
+	if len(m.Branch) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Branch)))
+		i += copy(dAtA[i:], m.Branch)
+	}
+	if len(m.Secret) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Secret)))
+		i += copy(dAtA[i:], m.Secret)
+	}
 	return i, nil
 }
 
@@ -2659,11 +4549,11 @@ func (m *Input) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Atom.Size()))
-		n3, err := m.Atom.MarshalTo(dAtA[i:])
+		n6, err := m.Atom.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n3
+		i += n6
 	}
 	if len(m.Cross) > 0 {
 		for _, msg := range m.Cross {
@@ -2689,6 +4579,16 @@ func (m *Input) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.Git != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Git.Size()))
+		n7, err := m.Git.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
 	return i, nil
 }
 
@@ -2711,11 +4611,11 @@ func (m *JobInput) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Commit.Size()))
-		n4, err := m.Commit.MarshalTo(dAtA[i:])
+		n8, err := m.Commit.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n4
+		i += n8
 	}
 	if len(m.Glob) > 0 {
 		dAtA[i] = 0x12
@@ -2805,7 +4705,7 @@ func (m *Datum) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *WorkerStatus) Marshal() (dAtA []byte, err error) {
+func (m *DatumManifest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2815,16 +4715,16 @@ func (m *WorkerStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WorkerStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *DatumManifest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.WorkerID) > 0 {
+	if len(m.DatumID) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.WorkerID)))
-		i += copy(dAtA[i:], m.WorkerID)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.DatumID)))
+		i += copy(dAtA[i:], m.DatumID)
 	}
 	if len(m.JobID) > 0 {
 		dAtA[i] = 0x12
@@ -2832,8 +4732,8 @@ func (m *WorkerStatus) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintPps(dAtA, i, uint64(len(m.JobID)))
 		i += copy(dAtA[i:], m.JobID)
 	}
-	if len(m.Data) > 0 {
-		for _, msg := range m.Data {
+	if len(m.Inputs) > 0 {
+		for _, msg := range m.Inputs {
 			dAtA[i] = 0x1a
 			i++
 			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
@@ -2844,20 +4744,10 @@ func (m *WorkerStatus) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
-	if m.Started != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Started.Size()))
-		n5, err := m.Started.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n5
-	}
 	return i, nil
 }
 
-func (m *ResourceSpec) Marshal() (dAtA []byte, err error) {
+func (m *DatumManifestInput) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2867,31 +4757,48 @@ func (m *ResourceSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResourceSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *DatumManifestInput) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Cpu != 0 {
-		dAtA[i] = 0xd
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
 		i++
-		i = encodeFixed32Pps(dAtA, i, uint32(math.Float32bits(float32(m.Cpu))))
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	if len(m.Memory) > 0 {
+	if m.File != nil {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Memory)))
-		i += copy(dAtA[i:], m.Memory)
+		i = encodeVarintPps(dAtA, i, uint64(m.File.Size()))
+		n9, err := m.File.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
 	}
-	if m.Gpu != 0 {
-		dAtA[i] = 0x18
+	if len(m.Branch) > 0 {
+		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Gpu))
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Branch)))
+		i += copy(dAtA[i:], m.Branch)
+	}
+	if m.SizeBytes != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.SizeBytes))
+	}
+	if len(m.GroupKey) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.GroupKey)))
+		i += copy(dAtA[i:], m.GroupKey)
 	}
 	return i, nil
 }
 
-func (m *JobInfo) Marshal() (dAtA []byte, err error) {
+func (m *WorkerStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2901,40 +4808,242 @@ func (m *JobInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JobInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *WorkerStatus) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Job != nil {
+	if len(m.WorkerID) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
-		n6, err := m.Job.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n6
+		i = encodeVarintPps(dAtA, i, uint64(len(m.WorkerID)))
+		i += copy(dAtA[i:], m.WorkerID)
 	}
-	if m.Transform != nil {
+	if len(m.JobID) > 0 {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Transform.Size()))
-		n7, err := m.Transform.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n7
+		i = encodeVarintPps(dAtA, i, uint64(len(m.JobID)))
+		i += copy(dAtA[i:], m.JobID)
 	}
-	if m.Pipeline != nil {
+	if len(m.Data) > 0 {
+		for _, msg := range m.Data {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Started != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Started.Size()))
+		n10, err := m.Started.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	return i, nil
+}
+
+func (m *WorkerPod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkerPod) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Node) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Node)))
+		i += copy(dAtA[i:], m.Node)
+	}
+	if len(m.Phase) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Phase)))
+		i += copy(dAtA[i:], m.Phase)
+	}
+	if len(m.Reason) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Reason)))
+		i += copy(dAtA[i:], m.Reason)
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if m.Restarts != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Restarts))
+	}
+	if len(m.Zone) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Zone)))
+		i += copy(dAtA[i:], m.Zone)
+	}
+	return i, nil
+}
+
+func (m *PodEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PodEvent) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pod) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Pod)))
+		i += copy(dAtA[i:], m.Pod)
+	}
+	if len(m.Type) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
+	}
+	if len(m.Reason) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Reason)))
+		i += copy(dAtA[i:], m.Reason)
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if m.Count != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Count))
+	}
+	if m.LastSeen != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.LastSeen.Size()))
+		n11, err := m.LastSeen.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	return i, nil
+}
+
+func (m *ResourceSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceSpec) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Cpu != 0 {
+		dAtA[i] = 0xd
+		i++
+		i = encodeFixed32Pps(dAtA, i, uint32(math.Float32bits(float32(m.Cpu))))
+	}
+	if len(m.Memory) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Memory)))
+		i += copy(dAtA[i:], m.Memory)
+	}
+	if m.Gpu != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Gpu))
+	}
+	return i, nil
+}
+
+func (m *JobInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Job != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
+		n12, err := m.Job.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	if m.Transform != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Transform.Size()))
+		n13, err := m.Transform.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
+	if m.Pipeline != nil {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n8, err := m.Pipeline.MarshalTo(dAtA[i:])
+		n14, err := m.Pipeline.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n14
 	}
 	if len(m.Inputs) > 0 {
 		for _, msg := range m.Inputs {
@@ -2952,41 +5061,41 @@ func (m *JobInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x32
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.ParentJob.Size()))
-		n9, err := m.ParentJob.MarshalTo(dAtA[i:])
+		n15, err := m.ParentJob.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n9
+		i += n15
 	}
 	if m.Started != nil {
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Started.Size()))
-		n10, err := m.Started.MarshalTo(dAtA[i:])
+		n16, err := m.Started.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n10
+		i += n16
 	}
 	if m.Finished != nil {
 		dAtA[i] = 0x42
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Finished.Size()))
-		n11, err := m.Finished.MarshalTo(dAtA[i:])
+		n17, err := m.Finished.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n11
+		i += n17
 	}
 	if m.OutputCommit != nil {
 		dAtA[i] = 0x4a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.OutputCommit.Size()))
-		n12, err := m.OutputCommit.MarshalTo(dAtA[i:])
+		n18, err := m.OutputCommit.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n12
+		i += n18
 	}
 	if m.State != 0 {
 		dAtA[i] = 0x50
@@ -2997,11 +5106,11 @@ func (m *JobInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x62
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.ParallelismSpec.Size()))
-		n13, err := m.ParallelismSpec.MarshalTo(dAtA[i:])
+		n19, err := m.ParallelismSpec.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n13
+		i += n19
 	}
 	if m.PipelineVersion != 0 {
 		dAtA[i] = 0x68
@@ -3012,21 +5121,21 @@ func (m *JobInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x72
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Service.Size()))
-		n14, err := m.Service.MarshalTo(dAtA[i:])
+		n20, err := m.Service.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n14
+		i += n20
 	}
 	if m.Egress != nil {
 		dAtA[i] = 0x7a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Egress.Size()))
-		n15, err := m.Egress.MarshalTo(dAtA[i:])
+		n21, err := m.Egress.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n15
+		i += n21
 	}
 	if len(m.OutputBranch) > 0 {
 		dAtA[i] = 0x8a
@@ -3042,11 +5151,11 @@ func (m *JobInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.OutputRepo.Size()))
-		n16, err := m.OutputRepo.MarshalTo(dAtA[i:])
+		n22, err := m.OutputRepo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n16
+		i += n22
 	}
 	if len(m.PipelineID) > 0 {
 		dAtA[i] = 0x9a
@@ -3097,11 +5206,11 @@ func (m *JobInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.ResourceSpec.Size()))
-		n17, err := m.ResourceSpec.MarshalTo(dAtA[i:])
+		n23, err := m.ResourceSpec.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n17
+		i += n23
 	}
 	if m.Input != nil {
 		dAtA[i] = 0xd2
@@ -3109,11 +5218,11 @@ func (m *JobInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Input.Size()))
-		n18, err := m.Input.MarshalTo(dAtA[i:])
+		n24, err := m.Input.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n18
+		i += n24
 	}
 	if m.NewBranch != nil {
 		dAtA[i] = 0xda
@@ -3121,11 +5230,11 @@ func (m *JobInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.NewBranch.Size()))
-		n19, err := m.NewBranch.MarshalTo(dAtA[i:])
+		n25, err := m.NewBranch.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n19
+		i += n25
 	}
 	if m.Incremental {
 		dAtA[i] = 0xe0
@@ -3139,56 +5248,11 @@ func (m *JobInfo) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
-	return i, nil
-}
-
-func (m *Worker) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Worker) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
-	}
-	if m.State != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.State))
-	}
-	return i, nil
-}
-
-func (m *JobInfos) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *JobInfos) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.JobInfo) > 0 {
-		for _, msg := range m.JobInfo {
-			dAtA[i] = 0xa
+	if len(m.WorkerPods) > 0 {
+		for _, msg := range m.WorkerPods {
+			dAtA[i] = 0xea
+			i++
+			dAtA[i] = 0x1
 			i++
 			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
@@ -3198,8 +5262,237 @@ func (m *JobInfos) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
-	return i, nil
-}
+	if len(m.PodEvents) > 0 {
+		for _, msg := range m.PodEvents {
+			dAtA[i] = 0xf2
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Webhooks) > 0 {
+		for _, msg := range m.Webhooks {
+			dAtA[i] = 0xfa
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.DataSkipped != 0 {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DataSkipped))
+	}
+	if m.Cost != nil {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Cost.Size()))
+		n26, err := m.Cost.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n26
+	}
+	if len(m.SecondaryOutputCommits) > 0 {
+		for _, msg := range m.SecondaryOutputCommits {
+			dAtA[i] = 0x92
+			i++
+			dAtA[i] = 0x2
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.DatumCheckpoint != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DatumCheckpoint.Size()))
+		n27, err := m.DatumCheckpoint.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n27
+	}
+	if len(m.Reason) > 0 {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Reason)))
+		i += copy(dAtA[i:], m.Reason)
+	}
+	if m.SetupTime != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.SetupTime.Size()))
+		n28, err := m.SetupTime.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n28
+	}
+	if m.DownloadBytes != 0 {
+		dAtA[i] = 0xb0
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DownloadBytes))
+	}
+	if m.UploadBytes != 0 {
+		dAtA[i] = 0xb8
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.UploadBytes))
+	}
+	return i, nil
+}
+
+func (m *JobCost) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobCost) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.NodeTypes) > 0 {
+		for _, s := range m.NodeTypes {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.CpuSeconds != 0 {
+		dAtA[i] = 0x11
+		i++
+		i = encodeFixed64Pps(dAtA, i, uint64(math.Float64bits(float64(m.CpuSeconds))))
+	}
+	if m.MemoryByteSeconds != 0 {
+		dAtA[i] = 0x19
+		i++
+		i = encodeFixed64Pps(dAtA, i, uint64(math.Float64bits(float64(m.MemoryByteSeconds))))
+	}
+	if m.PeakMemoryBytes != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.PeakMemoryBytes))
+	}
+	if m.Samples != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Samples))
+	}
+	if m.CurrentCpuCores != 0 {
+		dAtA[i] = 0x31
+		i++
+		i = encodeFixed64Pps(dAtA, i, uint64(math.Float64bits(float64(m.CurrentCpuCores))))
+	}
+	if m.CurrentMemoryBytes != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.CurrentMemoryBytes))
+	}
+	return i, nil
+}
+
+func (m *Worker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Worker) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.State != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.State))
+	}
+	return i, nil
+}
+
+func (m *JobInfos) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobInfos) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.JobInfo) > 0 {
+		for _, msg := range m.JobInfo {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
 
 func (m *Pipeline) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -3244,11 +5537,11 @@ func (m *PipelineInput) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Repo.Size()))
-		n20, err := m.Repo.MarshalTo(dAtA[i:])
+		n29, err := m.Repo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n20
+		i += n29
 	}
 	if len(m.Branch) > 0 {
 		dAtA[i] = 0x12
@@ -3282,11 +5575,11 @@ func (m *PipelineInput) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x32
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.From.Size()))
-		n21, err := m.From.MarshalTo(dAtA[i:])
+		n30, err := m.From.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n21
+		i += n30
 	}
 	return i, nil
 }
@@ -3310,21 +5603,21 @@ func (m *PipelineInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n22, err := m.Pipeline.MarshalTo(dAtA[i:])
+		n31, err := m.Pipeline.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n22
+		i += n31
 	}
 	if m.Transform != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Transform.Size()))
-		n23, err := m.Transform.MarshalTo(dAtA[i:])
+		n32, err := m.Transform.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n23
+		i += n32
 	}
 	if len(m.Inputs) > 0 {
 		for _, msg := range m.Inputs {
@@ -3342,11 +5635,11 @@ func (m *PipelineInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x32
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.CreatedAt.Size()))
-		n24, err := m.CreatedAt.MarshalTo(dAtA[i:])
+		n33, err := m.CreatedAt.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n24
+		i += n33
 	}
 	if m.State != 0 {
 		dAtA[i] = 0x38
@@ -3378,11 +5671,11 @@ func (m *PipelineInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x52
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.ParallelismSpec.Size()))
-		n25, err := m.ParallelismSpec.MarshalTo(dAtA[i:])
+		n34, err := m.ParallelismSpec.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n25
+		i += n34
 	}
 	if m.Version != 0 {
 		dAtA[i] = 0x58
@@ -3393,11 +5686,11 @@ func (m *PipelineInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x7a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Egress.Size()))
-		n26, err := m.Egress.MarshalTo(dAtA[i:])
+		n35, err := m.Egress.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n26
+		i += n35
 	}
 	if len(m.OutputBranch) > 0 {
 		dAtA[i] = 0x82
@@ -3421,11 +5714,11 @@ func (m *PipelineInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.ScaleDownThreshold.Size()))
-		n27, err := m.ScaleDownThreshold.MarshalTo(dAtA[i:])
+		n36, err := m.ScaleDownThreshold.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n27
+		i += n36
 	}
 	if m.ResourceSpec != nil {
 		dAtA[i] = 0x9a
@@ -3433,11 +5726,11 @@ func (m *PipelineInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.ResourceSpec.Size()))
-		n28, err := m.ResourceSpec.MarshalTo(dAtA[i:])
+		n37, err := m.ResourceSpec.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n28
+		i += n37
 	}
 	if m.Input != nil {
 		dAtA[i] = 0xa2
@@ -3445,11 +5738,11 @@ func (m *PipelineInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Input.Size()))
-		n29, err := m.Input.MarshalTo(dAtA[i:])
+		n38, err := m.Input.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n29
+		i += n38
 	}
 	if len(m.Description) > 0 {
 		dAtA[i] = 0xaa
@@ -3471,27 +5764,11 @@ func (m *PipelineInfo) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
-	return i, nil
-}
-
-func (m *PipelineInfos) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *PipelineInfos) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.PipelineInfo) > 0 {
-		for _, msg := range m.PipelineInfo {
-			dAtA[i] = 0xa
+	if len(m.Webhooks) > 0 {
+		for _, msg := range m.Webhooks {
+			dAtA[i] = 0xba
+			i++
+			dAtA[i] = 0x1
 			i++
 			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
@@ -3501,43 +5778,214 @@ func (m *PipelineInfos) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
-	return i, nil
-}
-
-func (m *CreateJobRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *CreateJobRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Transform != nil {
-		dAtA[i] = 0xa
+	if m.S3Gateway {
+		dAtA[i] = 0xc0
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Transform.Size()))
-		n30, err := m.Transform.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+		dAtA[i] = 0x1
+		i++
+		if m.S3Gateway {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i += n30
+		i++
 	}
-	if m.Pipeline != nil {
-		dAtA[i] = 0x12
+	if len(m.CacheSize) > 0 {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.CacheSize)))
+		i += copy(dAtA[i:], m.CacheSize)
+	}
+	if len(m.DiskCacheSize) > 0 {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.DiskCacheSize)))
+		i += copy(dAtA[i:], m.DiskCacheSize)
+	}
+	if m.DatumConcurrency != 0 {
+		dAtA[i] = 0xd8
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DatumConcurrency))
+	}
+	if len(m.PodLabels) > 0 {
+		for k, _ := range m.PodLabels {
+			dAtA[i] = 0xe2
+			i++
+			dAtA[i] = 0x1
+			i++
+			v := m.PodLabels[k]
+			mapSize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
+			i = encodeVarintPps(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if len(m.PodAnnotations) > 0 {
+		for k, _ := range m.PodAnnotations {
+			dAtA[i] = 0xea
+			i++
+			dAtA[i] = 0x1
+			i++
+			v := m.PodAnnotations[k]
+			mapSize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
+			i = encodeVarintPps(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if len(m.SecondaryOutputs) > 0 {
+		for _, msg := range m.SecondaryOutputs {
+			dAtA[i] = 0xf2
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Logs != nil {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Logs.Size()))
+		n39, err := m.Logs.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n39
+	}
+	if m.Scheduling != nil {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Scheduling.Size()))
+		n40, err := m.Scheduling.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n40
+	}
+	if len(m.ServiceAccount) > 0 {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.ServiceAccount)))
+		i += copy(dAtA[i:], m.ServiceAccount)
+	}
+	if len(m.Sidecars) > 0 {
+		for _, msg := range m.Sidecars {
+			dAtA[i] = 0x92
+			i++
+			dAtA[i] = 0x2
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.RuntimeClass) > 0 {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.RuntimeClass)))
+		i += copy(dAtA[i:], m.RuntimeClass)
+	}
+	return i, nil
+}
+
+func (m *PipelineInfos) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PipelineInfos) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.PipelineInfo) > 0 {
+		for _, msg := range m.PipelineInfo {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *CreateJobRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateJobRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Transform != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Transform.Size()))
+		n41, err := m.Transform.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n41
+	}
+	if m.Pipeline != nil {
+		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n31, err := m.Pipeline.MarshalTo(dAtA[i:])
+		n42, err := m.Pipeline.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n31
+		i += n42
 	}
 	if len(m.Inputs) > 0 {
 		for _, msg := range m.Inputs {
@@ -3555,31 +6003,31 @@ func (m *CreateJobRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.ParallelismSpec.Size()))
-		n32, err := m.ParallelismSpec.MarshalTo(dAtA[i:])
+		n43, err := m.ParallelismSpec.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n32
+		i += n43
 	}
 	if m.Service != nil {
 		dAtA[i] = 0x42
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Service.Size()))
-		n33, err := m.Service.MarshalTo(dAtA[i:])
+		n44, err := m.Service.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n33
+		i += n44
 	}
 	if m.Egress != nil {
 		dAtA[i] = 0x4a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Egress.Size()))
-		n34, err := m.Egress.MarshalTo(dAtA[i:])
+		n45, err := m.Egress.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n34
+		i += n45
 	}
 	if m.PipelineVersion != 0 {
 		dAtA[i] = 0x50
@@ -3596,41 +6044,41 @@ func (m *CreateJobRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x62
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.OutputRepo.Size()))
-		n35, err := m.OutputRepo.MarshalTo(dAtA[i:])
+		n46, err := m.OutputRepo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n35
+		i += n46
 	}
 	if m.ParentJob != nil {
 		dAtA[i] = 0x6a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.ParentJob.Size()))
-		n36, err := m.ParentJob.MarshalTo(dAtA[i:])
+		n47, err := m.ParentJob.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n36
+		i += n47
 	}
 	if m.ResourceSpec != nil {
 		dAtA[i] = 0x72
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.ResourceSpec.Size()))
-		n37, err := m.ResourceSpec.MarshalTo(dAtA[i:])
+		n48, err := m.ResourceSpec.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n37
+		i += n48
 	}
 	if m.Input != nil {
 		dAtA[i] = 0x7a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Input.Size()))
-		n38, err := m.Input.MarshalTo(dAtA[i:])
+		n49, err := m.Input.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n38
+		i += n49
 	}
 	if m.NewBranch != nil {
 		dAtA[i] = 0x82
@@ -3638,11 +6086,11 @@ func (m *CreateJobRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.NewBranch.Size()))
-		n39, err := m.NewBranch.MarshalTo(dAtA[i:])
+		n50, err := m.NewBranch.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n39
+		i += n50
 	}
 	if m.Incremental {
 		dAtA[i] = 0x88
@@ -3678,11 +6126,11 @@ func (m *InspectJobRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
-		n40, err := m.Job.MarshalTo(dAtA[i:])
+		n51, err := m.Job.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n40
+		i += n51
 	}
 	if m.BlockState {
 		dAtA[i] = 0x10
@@ -3716,11 +6164,11 @@ func (m *ListJobRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n41, err := m.Pipeline.MarshalTo(dAtA[i:])
+		n52, err := m.Pipeline.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n41
+		i += n52
 	}
 	if len(m.InputCommit) > 0 {
 		for _, msg := range m.InputCommit {
@@ -3737,6 +6185,86 @@ func (m *ListJobRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *FlushJobRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FlushJobRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commits) > 0 {
+		for _, msg := range m.Commits {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.ToPipelines) > 0 {
+		for _, msg := range m.ToPipelines {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *SubscribeJobRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeJobRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n53, err := m.Pipeline.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n53
+	}
+	if m.IncludeExisting {
+		dAtA[i] = 0x10
+		i++
+		if m.IncludeExisting {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
 func (m *DeleteJobRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3756,11 +6284,11 @@ func (m *DeleteJobRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
-		n42, err := m.Job.MarshalTo(dAtA[i:])
+		n54, err := m.Job.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n42
+		i += n54
 	}
 	return i, nil
 }
@@ -3784,11 +6312,11 @@ func (m *StopJobRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
-		n43, err := m.Job.MarshalTo(dAtA[i:])
+		n55, err := m.Job.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n43
+		i += n55
 	}
 	return i, nil
 }
@@ -3812,21 +6340,21 @@ func (m *GetLogsRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
-		n44, err := m.Job.MarshalTo(dAtA[i:])
+		n56, err := m.Job.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n44
+		i += n56
 	}
 	if m.Pipeline != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n45, err := m.Pipeline.MarshalTo(dAtA[i:])
+		n57, err := m.Pipeline.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n45
+		i += n57
 	}
 	if len(m.DataFilters) > 0 {
 		for _, s := range m.DataFilters {
@@ -3843,12 +6371,22 @@ func (m *GetLogsRequest) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
-	return i, nil
-}
-
-func (m *LogMessage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
+	if m.Follow {
+		dAtA[i] = 0x20
+		i++
+		if m.Follow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *LogMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
 	if err != nil {
 		return nil, err
@@ -3895,11 +6433,11 @@ func (m *LogMessage) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Ts.Size()))
-		n46, err := m.Ts.MarshalTo(dAtA[i:])
+		n58, err := m.Ts.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n46
+		i += n58
 	}
 	if len(m.Message) > 0 {
 		dAtA[i] = 0x32
@@ -3945,11 +6483,11 @@ func (m *RestartDatumRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
-		n47, err := m.Job.MarshalTo(dAtA[i:])
+		n59, err := m.Job.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n47
+		i += n59
 	}
 	if len(m.DataFilters) > 0 {
 		for _, s := range m.DataFilters {
@@ -3969,6 +6507,128 @@ func (m *RestartDatumRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *FileProvenanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FileProvenanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.File != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.File.Size()))
+		n60, err := m.File.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n60
+	}
+	return i, nil
+}
+
+func (m *DatumProvenance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DatumProvenance) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Job != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
+		n61, err := m.Job.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n61
+	}
+	if len(m.Inputs) > 0 {
+		for _, msg := range m.Inputs {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Outputs) > 0 {
+		for _, msg := range m.Outputs {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *FileProvenanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FileProvenanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Upstream) > 0 {
+		for _, msg := range m.Upstream {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Downstream) > 0 {
+		for _, msg := range m.Downstream {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
 func (m *CreatePipelineRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3988,21 +6648,21 @@ func (m *CreatePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n48, err := m.Pipeline.MarshalTo(dAtA[i:])
+		n62, err := m.Pipeline.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n48
+		i += n62
 	}
 	if m.Transform != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Transform.Size()))
-		n49, err := m.Transform.MarshalTo(dAtA[i:])
+		n63, err := m.Transform.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n49
+		i += n63
 	}
 	if len(m.Inputs) > 0 {
 		for _, msg := range m.Inputs {
@@ -4030,21 +6690,21 @@ func (m *CreatePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.ParallelismSpec.Size()))
-		n50, err := m.ParallelismSpec.MarshalTo(dAtA[i:])
+		n64, err := m.ParallelismSpec.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n50
+		i += n64
 	}
 	if m.Egress != nil {
 		dAtA[i] = 0x4a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Egress.Size()))
-		n51, err := m.Egress.MarshalTo(dAtA[i:])
+		n65, err := m.Egress.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n51
+		i += n65
 	}
 	if len(m.OutputBranch) > 0 {
 		dAtA[i] = 0x52
@@ -4056,31 +6716,31 @@ func (m *CreatePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x5a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.ScaleDownThreshold.Size()))
-		n52, err := m.ScaleDownThreshold.MarshalTo(dAtA[i:])
+		n66, err := m.ScaleDownThreshold.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n52
+		i += n66
 	}
 	if m.ResourceSpec != nil {
 		dAtA[i] = 0x62
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.ResourceSpec.Size()))
-		n53, err := m.ResourceSpec.MarshalTo(dAtA[i:])
+		n67, err := m.ResourceSpec.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n53
+		i += n67
 	}
 	if m.Input != nil {
 		dAtA[i] = 0x6a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Input.Size()))
-		n54, err := m.Input.MarshalTo(dAtA[i:])
+		n68, err := m.Input.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n54
+		i += n68
 	}
 	if len(m.Description) > 0 {
 		dAtA[i] = 0x72
@@ -4098,142 +6758,189 @@ func (m *CreatePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
-	return i, nil
-}
-
-func (m *InspectPipelineRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *InspectPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Pipeline != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n55, err := m.Pipeline.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if len(m.Webhooks) > 0 {
+		for _, msg := range m.Webhooks {
+			dAtA[i] = 0x82
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
-		i += n55
-	}
-	return i, nil
-}
-
-func (m *ListPipelineRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *ListPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	return i, nil
-}
-
-func (m *DeletePipelineRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DeletePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Pipeline != nil {
-		dAtA[i] = 0xa
+	if m.S3Gateway {
+		dAtA[i] = 0x88
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n56, err := m.Pipeline.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n56
-	}
-	if m.DeleteJobs {
-		dAtA[i] = 0x10
+		dAtA[i] = 0x1
 		i++
-		if m.DeleteJobs {
+		if m.S3Gateway {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i++
 	}
-	if m.DeleteRepo {
-		dAtA[i] = 0x18
+	if len(m.CacheSize) > 0 {
+		dAtA[i] = 0x92
 		i++
-		if m.DeleteRepo {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x1
 		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.CacheSize)))
+		i += copy(dAtA[i:], m.CacheSize)
 	}
-	if m.All {
-		dAtA[i] = 0x20
+	if len(m.DiskCacheSize) > 0 {
+		dAtA[i] = 0x9a
 		i++
-		if m.All {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x1
 		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.DiskCacheSize)))
+		i += copy(dAtA[i:], m.DiskCacheSize)
 	}
-	return i, nil
-}
-
-func (m *StartPipelineRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *StartPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Pipeline != nil {
-		dAtA[i] = 0xa
+	if m.DatumConcurrency != 0 {
+		dAtA[i] = 0xa0
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n57, err := m.Pipeline.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n57
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DatumConcurrency))
+	}
+	if len(m.PodLabels) > 0 {
+		for k, _ := range m.PodLabels {
+			dAtA[i] = 0xaa
+			i++
+			dAtA[i] = 0x1
+			i++
+			v := m.PodLabels[k]
+			mapSize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
+			i = encodeVarintPps(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if len(m.PodAnnotations) > 0 {
+		for k, _ := range m.PodAnnotations {
+			dAtA[i] = 0xb2
+			i++
+			dAtA[i] = 0x1
+			i++
+			v := m.PodAnnotations[k]
+			mapSize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
+			i = encodeVarintPps(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if len(m.SecondaryOutputs) > 0 {
+		for _, msg := range m.SecondaryOutputs {
+			dAtA[i] = 0xba
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.PinImage {
+		dAtA[i] = 0xc0
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.PinImage {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Logs != nil {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Logs.Size()))
+		n69, err := m.Logs.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n69
+	}
+	if m.Scheduling != nil {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Scheduling.Size()))
+		n70, err := m.Scheduling.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n70
+	}
+	if m.SkipCapacityCheck {
+		dAtA[i] = 0xd8
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.SkipCapacityCheck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.ServiceAccount) > 0 {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.ServiceAccount)))
+		i += copy(dAtA[i:], m.ServiceAccount)
+	}
+	if len(m.Sidecars) > 0 {
+		for _, msg := range m.Sidecars {
+			dAtA[i] = 0xea
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.RuntimeClass) > 0 {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.RuntimeClass)))
+		i += copy(dAtA[i:], m.RuntimeClass)
 	}
 	return i, nil
 }
 
-func (m *StopPipelineRequest) Marshal() (dAtA []byte, err error) {
+func (m *SecondaryOutput) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4243,25 +6950,33 @@ func (m *StopPipelineRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StopPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SecondaryOutput) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Pipeline != nil {
+	if len(m.Name) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n58, err := m.Pipeline.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n58
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Repo) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Repo)))
+		i += copy(dAtA[i:], m.Repo)
+	}
+	if len(m.Branch) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Branch)))
+		i += copy(dAtA[i:], m.Branch)
 	}
 	return i, nil
 }
 
-func (m *RerunPipelineRequest) Marshal() (dAtA []byte, err error) {
+func (m *LogsSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4271,36 +6986,71 @@ func (m *RerunPipelineRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RerunPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *LogsSpec) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Pipeline != nil {
+	if len(m.Branch) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n59, err := m.Pipeline.MarshalTo(dAtA[i:])
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Branch)))
+		i += copy(dAtA[i:], m.Branch)
+	}
+	if len(m.MaxBytesPerDatum) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.MaxBytesPerDatum)))
+		i += copy(dAtA[i:], m.MaxBytesPerDatum)
+	}
+	if m.Retention != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Retention.Size()))
+		n71, err := m.Retention.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n59
+		i += n71
 	}
-	if len(m.Exclude) > 0 {
-		for _, msg := range m.Exclude {
+	return i, nil
+}
+
+func (m *SchedulingSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchedulingSpec) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.NodeSelector) > 0 {
+		for k, _ := range m.NodeSelector {
+			dAtA[i] = 0xa
+			i++
+			v := m.NodeSelector[k]
+			mapSize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
+			i = encodeVarintPps(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
 			dAtA[i] = 0x12
 			i++
-			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+			i = encodeVarintPps(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
 		}
 	}
-	if len(m.Include) > 0 {
-		for _, msg := range m.Include {
-			dAtA[i] = 0x1a
+	if len(m.Tolerations) > 0 {
+		for _, msg := range m.Tolerations {
+			dAtA[i] = 0x12
 			i++
 			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
@@ -4310,10 +7060,16 @@ func (m *RerunPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.PreferredZone) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.PreferredZone)))
+		i += copy(dAtA[i:], m.PreferredZone)
+	}
 	return i, nil
 }
 
-func (m *GarbageCollectRequest) Marshal() (dAtA []byte, err error) {
+func (m *Sidecar) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4323,15 +7079,69 @@ func (m *GarbageCollectRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GarbageCollectRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Sidecar) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Image) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Image)))
+		i += copy(dAtA[i:], m.Image)
+	}
+	if len(m.Cmd) > 0 {
+		for _, s := range m.Cmd {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Env) > 0 {
+		for k, _ := range m.Env {
+			dAtA[i] = 0x22
+			i++
+			v := m.Env[k]
+			mapSize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
+			i = encodeVarintPps(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if m.ResourceSpec != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.ResourceSpec.Size()))
+		n72, err := m.ResourceSpec.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n72
+	}
 	return i, nil
 }
 
-func (m *GarbageCollectResponse) Marshal() (dAtA []byte, err error) {
+func (m *Toleration) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4341,430 +7151,718 @@ func (m *GarbageCollectResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GarbageCollectResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *Toleration) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if len(m.Operator) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Operator)))
+		i += copy(dAtA[i:], m.Operator)
+	}
+	if len(m.Value) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
+	}
+	if len(m.Effect) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Effect)))
+		i += copy(dAtA[i:], m.Effect)
+	}
 	return i, nil
 }
 
-func encodeFixed64Pps(dAtA []byte, offset int, v uint64) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	dAtA[offset+4] = uint8(v >> 32)
-	dAtA[offset+5] = uint8(v >> 40)
-	dAtA[offset+6] = uint8(v >> 48)
-	dAtA[offset+7] = uint8(v >> 56)
-	return offset + 8
-}
-func encodeFixed32Pps(dAtA []byte, offset int, v uint32) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	return offset + 4
-}
-func encodeVarintPps(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *InspectPipelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *Secret) Size() (n int) {
+
+func (m *InspectPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.Pipeline != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n73, err := m.Pipeline.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n73
 	}
-	l = len(m.MountPath)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.Version != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Version))
 	}
-	return n
+	return i, nil
 }
 
-func (m *Transform) Size() (n int) {
+func (m *ListPipelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Image)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if len(m.Project) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Project)))
+		i += copy(dAtA[i:], m.Project)
 	}
-	if len(m.Cmd) > 0 {
-		for _, s := range m.Cmd {
-			l = len(s)
-			n += 1 + l + sovPps(uint64(l))
-		}
+	return i, nil
+}
+
+func (m *DeletePipelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Env) > 0 {
-		for k, v := range m.Env {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
-			n += mapEntrySize + 1 + sovPps(uint64(mapEntrySize))
+	return dAtA[:n], nil
+}
+
+func (m *DeletePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n74, err := m.Pipeline.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n74
 	}
-	if len(m.Secrets) > 0 {
-		for _, e := range m.Secrets {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
+	if m.DeleteJobs {
+		dAtA[i] = 0x10
+		i++
+		if m.DeleteJobs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i++
 	}
-	if len(m.Stdin) > 0 {
-		for _, s := range m.Stdin {
-			l = len(s)
-			n += 1 + l + sovPps(uint64(l))
+	if m.DeleteRepo {
+		dAtA[i] = 0x18
+		i++
+		if m.DeleteRepo {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i++
 	}
-	if len(m.AcceptReturnCode) > 0 {
-		l = 0
-		for _, e := range m.AcceptReturnCode {
-			l += sovPps(uint64(e))
+	if m.All {
+		dAtA[i] = 0x20
+		i++
+		if m.All {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		n += 1 + sovPps(uint64(l)) + l
-	}
-	if m.Debug {
-		n += 2
+		i++
 	}
-	if len(m.ImagePullSecrets) > 0 {
-		for _, s := range m.ImagePullSecrets {
-			l = len(s)
-			n += 1 + l + sovPps(uint64(l))
+	if m.Cascade {
+		dAtA[i] = 0x28
+		i++
+		if m.Cascade {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i++
 	}
-	return n
+	return i, nil
 }
 
-func (m *Egress) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.URL)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+func (m *StartPipelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Job) Size() (n int) {
+func (m *StartPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.Pipeline != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n75, err := m.Pipeline.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n75
 	}
-	return n
+	return i, nil
 }
 
-func (m *Service) Size() (n int) {
-	var l int
-	_ = l
-	if m.InternalPort != 0 {
-		n += 1 + sovPps(uint64(m.InternalPort))
-	}
-	if m.ExternalPort != 0 {
-		n += 1 + sovPps(uint64(m.ExternalPort))
+func (m *StopPipelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AtomInput) Size() (n int) {
+func (m *StopPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.Repo)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.Branch)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.Commit)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.Glob)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.Lazy {
-		n += 2
+	if m.Pipeline != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n76, err := m.Pipeline.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n76
 	}
-	l = len(m.FromCommit)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	return i, nil
+}
+
+func (m *RerunPipelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Input) Size() (n int) {
+func (m *RerunPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Atom != nil {
-		l = m.Atom.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.Pipeline != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n77, err := m.Pipeline.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n77
 	}
-	if len(m.Cross) > 0 {
-		for _, e := range m.Cross {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
+	if len(m.Exclude) > 0 {
+		for _, msg := range m.Exclude {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	if len(m.Union) > 0 {
-		for _, e := range m.Union {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
+	if len(m.Include) > 0 {
+		for _, msg := range m.Include {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	return n
+	return i, nil
 }
 
-func (m *JobInput) Size() (n int) {
-	var l int
-	_ = l
-	if m.Commit != nil {
-		l = m.Commit.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.Glob)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.Lazy {
-		n += 2
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+func (m *GarbageCollectRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ParallelismSpec) Size() (n int) {
+func (m *GarbageCollectRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Strategy != 0 {
-		n += 1 + sovPps(uint64(m.Strategy))
-	}
-	if m.Constant != 0 {
-		n += 1 + sovPps(uint64(m.Constant))
-	}
-	if m.Coefficient != 0 {
-		n += 9
+	return i, nil
+}
+
+func (m *GarbageCollectResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Datum) Size() (n int) {
+func (m *GarbageCollectResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	return i, nil
+}
+
+func (m *UsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *WorkerStatus) Size() (n int) {
+func (m *UsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.WorkerID)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	l = len(m.JobID)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if len(m.Data) > 0 {
-		for _, e := range m.Data {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
+	if m.Since != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Since.Size()))
+		n78, err := m.Since.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n78
 	}
-	if m.Started != nil {
-		l = m.Started.Size()
-		n += 1 + l + sovPps(uint64(l))
+	return i, nil
+}
+
+func (m *RepoUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ResourceSpec) Size() (n int) {
+func (m *RepoUsage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Cpu != 0 {
-		n += 5
+	if m.Repo != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Repo.Size()))
+		n79, err := m.Repo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n79
 	}
-	l = len(m.Memory)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.SizeBytes != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.SizeBytes))
 	}
-	if m.Gpu != 0 {
-		n += 1 + sovPps(uint64(m.Gpu))
+	return i, nil
+}
+
+func (m *PipelineUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *JobInfo) Size() (n int) {
+func (m *PipelineUsage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Job != nil {
-		l = m.Job.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.Transform != nil {
-		l = m.Transform.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
 	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if len(m.Inputs) > 0 {
-		for _, e := range m.Inputs {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n80, err := m.Pipeline.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n80
 	}
-	if m.ParentJob != nil {
-		l = m.ParentJob.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.Started != nil {
-		l = m.Started.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.Finished != nil {
-		l = m.Finished.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.OutputCommit != nil {
-		l = m.OutputCommit.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.Jobs != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Jobs))
 	}
-	if m.State != 0 {
-		n += 1 + sovPps(uint64(m.State))
+	if m.PodHours != 0 {
+		dAtA[i] = 0x19
+		i++
+		i = encodeFixed64Pps(dAtA, i, uint64(math.Float64bits(float64(m.PodHours))))
 	}
-	if m.ParallelismSpec != nil {
-		l = m.ParallelismSpec.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.CpuHours != 0 {
+		dAtA[i] = 0x21
+		i++
+		i = encodeFixed64Pps(dAtA, i, uint64(math.Float64bits(float64(m.CpuHours))))
 	}
-	if m.PipelineVersion != 0 {
-		n += 1 + sovPps(uint64(m.PipelineVersion))
+	if m.DataProcessed != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DataProcessed))
 	}
-	if m.Service != nil {
-		l = m.Service.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.OutputSizeBytes != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.OutputSizeBytes))
 	}
-	if m.Egress != nil {
-		l = m.Egress.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.WallClockHours != 0 {
+		dAtA[i] = 0x39
+		i++
+		i = encodeFixed64Pps(dAtA, i, uint64(math.Float64bits(float64(m.WallClockHours))))
 	}
-	l = len(m.OutputBranch)
-	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
+	if m.MeasuredCpuHours != 0 {
+		dAtA[i] = 0x41
+		i++
+		i = encodeFixed64Pps(dAtA, i, uint64(math.Float64bits(float64(m.MeasuredCpuHours))))
 	}
-	if m.OutputRepo != nil {
-		l = m.OutputRepo.Size()
-		n += 2 + l + sovPps(uint64(l))
+	if m.MemoryGbHours != 0 {
+		dAtA[i] = 0x49
+		i++
+		i = encodeFixed64Pps(dAtA, i, uint64(math.Float64bits(float64(m.MemoryGbHours))))
 	}
-	l = len(m.PipelineID)
-	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
+	if len(m.NodeTypes) > 0 {
+		for _, s := range m.NodeTypes {
+			dAtA[i] = 0x52
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
-	if m.Restart != 0 {
-		n += 2 + sovPps(uint64(m.Restart))
+	return i, nil
+}
+
+func (m *UsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.DataProcessed != 0 {
-		n += 2 + sovPps(uint64(m.DataProcessed))
+	return dAtA[:n], nil
+}
+
+func (m *UsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Since != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Since.Size()))
+		n81, err := m.Since.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n81
 	}
-	if m.DataTotal != 0 {
-		n += 2 + sovPps(uint64(m.DataTotal))
+	if m.Until != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Until.Size()))
+		n82, err := m.Until.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n82
 	}
-	if len(m.WorkerStatus) > 0 {
-		for _, e := range m.WorkerStatus {
-			l = e.Size()
-			n += 2 + l + sovPps(uint64(l))
+	if len(m.Repos) > 0 {
+		for _, msg := range m.Repos {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	if m.ResourceSpec != nil {
-		l = m.ResourceSpec.Size()
-		n += 2 + l + sovPps(uint64(l))
+	if len(m.Pipelines) > 0 {
+		for _, msg := range m.Pipelines {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	if m.Input != nil {
-		l = m.Input.Size()
-		n += 2 + l + sovPps(uint64(l))
+	return i, nil
+}
+
+func encodeFixed64Pps(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Pps(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintPps(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
 	}
-	if m.NewBranch != nil {
-		l = m.NewBranch.Size()
-		n += 2 + l + sovPps(uint64(l))
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Secret) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.Incremental {
-		n += 3
+	l = len(m.MountPath)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
 	}
 	return n
 }
 
-func (m *Worker) Size() (n int) {
+func (m *Transform) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Name)
+	l = len(m.Image)
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.State != 0 {
-		n += 1 + sovPps(uint64(m.State))
+	if len(m.Cmd) > 0 {
+		for _, s := range m.Cmd {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.Env) > 0 {
+		for k, v := range m.Env {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPps(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Secrets) > 0 {
+		for _, e := range m.Secrets {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.Stdin) > 0 {
+		for _, s := range m.Stdin {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.AcceptReturnCode) > 0 {
+		l = 0
+		for _, e := range m.AcceptReturnCode {
+			l += sovPps(uint64(e))
+		}
+		n += 1 + sovPps(uint64(l)) + l
+	}
+	if m.Debug {
+		n += 2
+	}
+	if len(m.ImagePullSecrets) > 0 {
+		for _, s := range m.ImagePullSecrets {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	l = len(m.ImageDigest)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.Setup) > 0 {
+		for _, s := range m.Setup {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.Hooks != nil {
+		l = m.Hooks.Size()
+		n += 1 + l + sovPps(uint64(l))
 	}
 	return n
 }
 
-func (m *JobInfos) Size() (n int) {
+func (m *Hooks) Size() (n int) {
 	var l int
 	_ = l
-	if len(m.JobInfo) > 0 {
-		for _, e := range m.JobInfo {
-			l = e.Size()
+	if len(m.BeforeDatum) > 0 {
+		for _, s := range m.BeforeDatum {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.AfterDatum) > 0 {
+		for _, s := range m.AfterDatum {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.JobStart) > 0 {
+		for _, s := range m.JobStart {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.JobEnd) > 0 {
+		for _, s := range m.JobEnd {
+			l = len(s)
 			n += 1 + l + sovPps(uint64(l))
 		}
 	}
 	return n
 }
 
-func (m *Pipeline) Size() (n int) {
+func (m *Egress) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Name)
+	l = len(m.URL)
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
 	return n
 }
 
-func (m *PipelineInput) Size() (n int) {
+func (m *Webhook) Size() (n int) {
 	var l int
 	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.States) > 0 {
+		l = 0
+		for _, e := range m.States {
+			l += sovPps(uint64(e))
+		}
+		n += 1 + sovPps(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *Job) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *Service) Size() (n int) {
+	var l int
+	_ = l
+	if m.InternalPort != 0 {
+		n += 1 + sovPps(uint64(m.InternalPort))
+	}
+	if m.ExternalPort != 0 {
+		n += 1 + sovPps(uint64(m.ExternalPort))
+	}
+	return n
+}
+
+func (m *AtomInput) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Repo)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
 	l = len(m.Branch)
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
+	l = len(m.Commit)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
 	l = len(m.Glob)
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
@@ -4772,115 +7870,127 @@ func (m *PipelineInput) Size() (n int) {
 	if m.Lazy {
 		n += 2
 	}
-	l = len(m.Name)
+	l = len(m.FromCommit)
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.From != nil {
-		l = m.From.Size()
+	if m.Mount {
+		n += 2
+	}
+	l = len(m.GroupBy)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
 	return n
 }
 
-func (m *PipelineInfo) Size() (n int) {
+func (m *GitInput) Size() (n int) {
 	var l int
 	_ = l
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
+	l = len(m.Name)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.Transform != nil {
-		l = m.Transform.Size()
+	l = len(m.URL)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if len(m.Inputs) > 0 {
-		for _, e := range m.Inputs {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
-		}
-	}
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
+	l = len(m.Branch)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.State != 0 {
-		n += 1 + sovPps(uint64(m.State))
-	}
-	l = len(m.RecentError)
+	l = len(m.Secret)
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if len(m.JobCounts) > 0 {
-		for k, v := range m.JobCounts {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + sovPps(uint64(k)) + 1 + sovPps(uint64(v))
-			n += mapEntrySize + 1 + sovPps(uint64(mapEntrySize))
-		}
-	}
-	if m.ParallelismSpec != nil {
-		l = m.ParallelismSpec.Size()
+	return n
+}
+
+func (m *Input) Size() (n int) {
+	var l int
+	_ = l
+	if m.Atom != nil {
+		l = m.Atom.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.Version != 0 {
-		n += 1 + sovPps(uint64(m.Version))
+	if len(m.Cross) > 0 {
+		for _, e := range m.Cross {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
 	}
-	if m.Egress != nil {
-		l = m.Egress.Size()
+	if len(m.Union) > 0 {
+		for _, e := range m.Union {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.Git != nil {
+		l = m.Git.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
-	l = len(m.OutputBranch)
-	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
+	return n
+}
+
+func (m *JobInput) Size() (n int) {
+	var l int
+	_ = l
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovPps(uint64(l))
 	}
-	l = len(m.ID)
+	l = len(m.Glob)
 	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
+		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.ScaleDownThreshold != nil {
-		l = m.ScaleDownThreshold.Size()
-		n += 2 + l + sovPps(uint64(l))
+	if m.Lazy {
+		n += 2
 	}
-	if m.ResourceSpec != nil {
-		l = m.ResourceSpec.Size()
-		n += 2 + l + sovPps(uint64(l))
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.Input != nil {
-		l = m.Input.Size()
-		n += 2 + l + sovPps(uint64(l))
+	return n
+}
+
+func (m *ParallelismSpec) Size() (n int) {
+	var l int
+	_ = l
+	if m.Strategy != 0 {
+		n += 1 + sovPps(uint64(m.Strategy))
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 2 + l + sovPps(uint64(l))
+	if m.Constant != 0 {
+		n += 1 + sovPps(uint64(m.Constant))
 	}
-	if m.Incremental {
-		n += 3
+	if m.Coefficient != 0 {
+		n += 9
 	}
 	return n
 }
 
-func (m *PipelineInfos) Size() (n int) {
+func (m *Datum) Size() (n int) {
 	var l int
 	_ = l
-	if len(m.PipelineInfo) > 0 {
-		for _, e := range m.PipelineInfo {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
-		}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
 	}
 	return n
 }
 
-func (m *CreateJobRequest) Size() (n int) {
+func (m *DatumManifest) Size() (n int) {
 	var l int
 	_ = l
-	if m.Transform != nil {
-		l = m.Transform.Size()
+	l = len(m.DatumID)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
+	l = len(m.JobID)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
 	if len(m.Inputs) > 0 {
@@ -4889,363 +7999,6593 @@ func (m *CreateJobRequest) Size() (n int) {
 			n += 1 + l + sovPps(uint64(l))
 		}
 	}
-	if m.ParallelismSpec != nil {
-		l = m.ParallelismSpec.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.Service != nil {
-		l = m.Service.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.Egress != nil {
-		l = m.Egress.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.PipelineVersion != 0 {
-		n += 1 + sovPps(uint64(m.PipelineVersion))
-	}
-	l = len(m.OutputBranch)
+	return n
+}
+
+func (m *DatumManifestInput) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.OutputRepo != nil {
-		l = m.OutputRepo.Size()
+	if m.File != nil {
+		l = m.File.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.ParentJob != nil {
-		l = m.ParentJob.Size()
+	l = len(m.Branch)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.ResourceSpec != nil {
-		l = m.ResourceSpec.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if m.SizeBytes != 0 {
+		n += 1 + sovPps(uint64(m.SizeBytes))
 	}
-	if m.Input != nil {
-		l = m.Input.Size()
+	l = len(m.GroupKey)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.NewBranch != nil {
-		l = m.NewBranch.Size()
-		n += 2 + l + sovPps(uint64(l))
-	}
-	if m.Incremental {
-		n += 3
-	}
 	return n
 }
 
-func (m *InspectJobRequest) Size() (n int) {
+func (m *WorkerStatus) Size() (n int) {
 	var l int
 	_ = l
-	if m.Job != nil {
-		l = m.Job.Size()
+	l = len(m.WorkerID)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.BlockState {
-		n += 2
-	}
-	return n
-}
-
-func (m *ListJobRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
+	l = len(m.JobID)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if len(m.InputCommit) > 0 {
-		for _, e := range m.InputCommit {
+	if len(m.Data) > 0 {
+		for _, e := range m.Data {
 			l = e.Size()
 			n += 1 + l + sovPps(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *DeleteJobRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Job != nil {
-		l = m.Job.Size()
+	if m.Started != nil {
+		l = m.Started.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
 	return n
 }
 
-func (m *StopJobRequest) Size() (n int) {
+func (m *WorkerPod) Size() (n int) {
 	var l int
 	_ = l
-	if m.Job != nil {
-		l = m.Job.Size()
+	l = len(m.Name)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	return n
-}
-
-func (m *GetLogsRequest) Size() (n int) {
-	var l int
-	_ = l
-	if m.Job != nil {
-		l = m.Job.Size()
+	l = len(m.Node)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
+	l = len(m.Phase)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if len(m.DataFilters) > 0 {
-		for _, s := range m.DataFilters {
-			l = len(s)
-			n += 1 + l + sovPps(uint64(l))
-		}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Restarts != 0 {
+		n += 1 + sovPps(uint64(m.Restarts))
+	}
+	l = len(m.Zone)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
 	}
 	return n
 }
 
-func (m *LogMessage) Size() (n int) {
+func (m *PodEvent) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.PipelineName)
+	l = len(m.Pod)
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	l = len(m.PipelineID)
+	l = len(m.Type)
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	l = len(m.JobID)
+	l = len(m.Reason)
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if len(m.Data) > 0 {
-		for _, e := range m.Data {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
-		}
-	}
-	if m.Ts != nil {
-		l = m.Ts.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
 	l = len(m.Message)
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	l = len(m.WorkerID)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.Count != 0 {
+		n += 1 + sovPps(uint64(m.Count))
 	}
-	if m.User {
-		n += 2
+	if m.LastSeen != nil {
+		l = m.LastSeen.Size()
+		n += 1 + l + sovPps(uint64(l))
 	}
 	return n
 }
 
-func (m *RestartDatumRequest) Size() (n int) {
+func (m *ResourceSpec) Size() (n int) {
 	var l int
 	_ = l
-	if m.Job != nil {
-		l = m.Job.Size()
+	if m.Cpu != 0 {
+		n += 5
+	}
+	l = len(m.Memory)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if len(m.DataFilters) > 0 {
-		for _, s := range m.DataFilters {
-			l = len(s)
-			n += 1 + l + sovPps(uint64(l))
-		}
+	if m.Gpu != 0 {
+		n += 1 + sovPps(uint64(m.Gpu))
 	}
 	return n
 }
 
-func (m *CreatePipelineRequest) Size() (n int) {
+func (m *JobInfo) Size() (n int) {
 	var l int
 	_ = l
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
+	if m.Job != nil {
+		l = m.Job.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
 	if m.Transform != nil {
 		l = m.Transform.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
 	if len(m.Inputs) > 0 {
 		for _, e := range m.Inputs {
 			l = e.Size()
 			n += 1 + l + sovPps(uint64(l))
 		}
 	}
-	if m.Update {
-		n += 2
-	}
-	if m.ParallelismSpec != nil {
-		l = m.ParallelismSpec.Size()
+	if m.ParentJob != nil {
+		l = m.ParentJob.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.Egress != nil {
-		l = m.Egress.Size()
+	if m.Started != nil {
+		l = m.Started.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
-	l = len(m.OutputBranch)
-	if l > 0 {
+	if m.Finished != nil {
+		l = m.Finished.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.ScaleDownThreshold != nil {
-		l = m.ScaleDownThreshold.Size()
+	if m.OutputCommit != nil {
+		l = m.OutputCommit.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovPps(uint64(m.State))
+	}
+	if m.ParallelismSpec != nil {
+		l = m.ParallelismSpec.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.PipelineVersion != 0 {
+		n += 1 + sovPps(uint64(m.PipelineVersion))
+	}
+	if m.Service != nil {
+		l = m.Service.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Egress != nil {
+		l = m.Egress.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
+	l = len(m.OutputBranch)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.OutputRepo != nil {
+		l = m.OutputRepo.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	l = len(m.PipelineID)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.Restart != 0 {
+		n += 2 + sovPps(uint64(m.Restart))
+	}
+	if m.DataProcessed != 0 {
+		n += 2 + sovPps(uint64(m.DataProcessed))
+	}
+	if m.DataTotal != 0 {
+		n += 2 + sovPps(uint64(m.DataTotal))
+	}
+	if len(m.WorkerStatus) > 0 {
+		for _, e := range m.WorkerStatus {
+			l = e.Size()
+			n += 2 + l + sovPps(uint64(l))
+		}
+	}
 	if m.ResourceSpec != nil {
 		l = m.ResourceSpec.Size()
-		n += 1 + l + sovPps(uint64(l))
+		n += 2 + l + sovPps(uint64(l))
 	}
 	if m.Input != nil {
 		l = m.Input.Size()
-		n += 1 + l + sovPps(uint64(l))
+		n += 2 + l + sovPps(uint64(l))
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.NewBranch != nil {
+		l = m.NewBranch.Size()
+		n += 2 + l + sovPps(uint64(l))
 	}
 	if m.Incremental {
-		n += 2
+		n += 3
+	}
+	if len(m.WorkerPods) > 0 {
+		for _, e := range m.WorkerPods {
+			l = e.Size()
+			n += 2 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.PodEvents) > 0 {
+		for _, e := range m.PodEvents {
+			l = e.Size()
+			n += 2 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.Webhooks) > 0 {
+		for _, e := range m.Webhooks {
+			l = e.Size()
+			n += 2 + l + sovPps(uint64(l))
+		}
+	}
+	if m.DataSkipped != 0 {
+		n += 2 + sovPps(uint64(m.DataSkipped))
+	}
+	if m.Cost != nil {
+		l = m.Cost.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if len(m.SecondaryOutputCommits) > 0 {
+		for _, e := range m.SecondaryOutputCommits {
+			l = e.Size()
+			n += 2 + l + sovPps(uint64(l))
+		}
+	}
+	if m.DatumCheckpoint != nil {
+		l = m.DatumCheckpoint.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.SetupTime != nil {
+		l = m.SetupTime.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.DownloadBytes != 0 {
+		n += 2 + sovPps(uint64(m.DownloadBytes))
+	}
+	if m.UploadBytes != 0 {
+		n += 2 + sovPps(uint64(m.UploadBytes))
 	}
 	return n
 }
 
-func (m *InspectPipelineRequest) Size() (n int) {
+func (m *JobCost) Size() (n int) {
 	var l int
 	_ = l
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
-		n += 1 + l + sovPps(uint64(l))
+	if len(m.NodeTypes) > 0 {
+		for _, s := range m.NodeTypes {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.CpuSeconds != 0 {
+		n += 9
+	}
+	if m.MemoryByteSeconds != 0 {
+		n += 9
+	}
+	if m.PeakMemoryBytes != 0 {
+		n += 1 + sovPps(uint64(m.PeakMemoryBytes))
+	}
+	if m.Samples != 0 {
+		n += 1 + sovPps(uint64(m.Samples))
+	}
+	if m.CurrentCpuCores != 0 {
+		n += 9
+	}
+	if m.CurrentMemoryBytes != 0 {
+		n += 1 + sovPps(uint64(m.CurrentMemoryBytes))
 	}
 	return n
 }
 
-func (m *ListPipelineRequest) Size() (n int) {
+func (m *Worker) Size() (n int) {
 	var l int
 	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovPps(uint64(m.State))
+	}
 	return n
 }
 
-func (m *DeletePipelineRequest) Size() (n int) {
+func (m *JobInfos) Size() (n int) {
 	var l int
 	_ = l
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
-		n += 1 + l + sovPps(uint64(l))
-	}
-	if m.DeleteJobs {
-		n += 2
-	}
-	if m.DeleteRepo {
-		n += 2
-	}
-	if m.All {
-		n += 2
+	if len(m.JobInfo) > 0 {
+		for _, e := range m.JobInfo {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *StartPipelineRequest) Size() (n int) {
+func (m *Pipeline) Size() (n int) {
 	var l int
 	_ = l
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
+	l = len(m.Name)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
 	return n
 }
 
-func (m *StopPipelineRequest) Size() (n int) {
+func (m *PipelineInput) Size() (n int) {
 	var l int
 	_ = l
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Branch)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Glob)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Lazy {
+		n += 2
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.From != nil {
+		l = m.From.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
 	return n
 }
 
-func (m *RerunPipelineRequest) Size() (n int) {
+func (m *PipelineInfo) Size() (n int) {
 	var l int
 	_ = l
 	if m.Pipeline != nil {
 		l = m.Pipeline.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if len(m.Exclude) > 0 {
-		for _, e := range m.Exclude {
-			l = e.Size()
-			n += 1 + l + sovPps(uint64(l))
-		}
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 1 + l + sovPps(uint64(l))
 	}
-	if len(m.Include) > 0 {
-		for _, e := range m.Include {
+	if len(m.Inputs) > 0 {
+		for _, e := range m.Inputs {
 			l = e.Size()
 			n += 1 + l + sovPps(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *GarbageCollectRequest) Size() (n int) {
-	var l int
-	_ = l
-	return n
-}
-
-func (m *GarbageCollectResponse) Size() (n int) {
-	var l int
-	_ = l
-	return n
-}
-
-func sovPps(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovPps(uint64(m.State))
+	}
+	l = len(m.RecentError)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.JobCounts) > 0 {
+		for k, v := range m.JobCounts {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovPps(uint64(k)) + 1 + sovPps(uint64(v))
+			n += mapEntrySize + 1 + sovPps(uint64(mapEntrySize))
 		}
 	}
-	return n
-}
-func sozPps(x uint64) (n int) {
-	return sovPps(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *Secret) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+	if m.ParallelismSpec != nil {
+		l = m.ParallelismSpec.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovPps(uint64(m.Version))
+	}
+	if m.Egress != nil {
+		l = m.Egress.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.OutputBranch)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.ScaleDownThreshold != nil {
+		l = m.ScaleDownThreshold.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.ResourceSpec != nil {
+		l = m.ResourceSpec.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.Incremental {
+		n += 3
+	}
+	if len(m.Webhooks) > 0 {
+		for _, e := range m.Webhooks {
+			l = e.Size()
+			n += 2 + l + sovPps(uint64(l))
+		}
+	}
+	if m.S3Gateway {
+		n += 3
+	}
+	l = len(m.CacheSize)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	l = len(m.DiskCacheSize)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.DatumConcurrency != 0 {
+		n += 2 + sovPps(uint64(m.DatumConcurrency))
+	}
+	if len(m.PodLabels) > 0 {
+		for k, v := range m.PodLabels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
+			n += mapEntrySize + 2 + sovPps(uint64(mapEntrySize))
+		}
+	}
+	if len(m.PodAnnotations) > 0 {
+		for k, v := range m.PodAnnotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
+			n += mapEntrySize + 2 + sovPps(uint64(mapEntrySize))
+		}
+	}
+	if len(m.SecondaryOutputs) > 0 {
+		for _, e := range m.SecondaryOutputs {
+			l = e.Size()
+			n += 2 + l + sovPps(uint64(l))
+		}
+	}
+	if m.Logs != nil {
+		l = m.Logs.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.Scheduling != nil {
+		l = m.Scheduling.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	l = len(m.ServiceAccount)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if len(m.Sidecars) > 0 {
+		for _, e := range m.Sidecars {
+			l = e.Size()
+			n += 2 + l + sovPps(uint64(l))
+		}
+	}
+	l = len(m.RuntimeClass)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *PipelineInfos) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.PipelineInfo) > 0 {
+		for _, e := range m.PipelineInfo {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CreateJobRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.Inputs) > 0 {
+		for _, e := range m.Inputs {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.ParallelismSpec != nil {
+		l = m.ParallelismSpec.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Service != nil {
+		l = m.Service.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Egress != nil {
+		l = m.Egress.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.PipelineVersion != 0 {
+		n += 1 + sovPps(uint64(m.PipelineVersion))
+	}
+	l = len(m.OutputBranch)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.OutputRepo != nil {
+		l = m.OutputRepo.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.ParentJob != nil {
+		l = m.ParentJob.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.ResourceSpec != nil {
+		l = m.ResourceSpec.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.NewBranch != nil {
+		l = m.NewBranch.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.Incremental {
+		n += 3
+	}
+	return n
+}
+
+func (m *InspectJobRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.BlockState {
+		n += 2
+	}
+	return n
+}
+
+func (m *ListJobRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.InputCommit) > 0 {
+		for _, e := range m.InputCommit {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FlushJobRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Commits) > 0 {
+		for _, e := range m.Commits {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.ToPipelines) > 0 {
+		for _, e := range m.ToPipelines {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SubscribeJobRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.IncludeExisting {
+		n += 2
+	}
+	return n
+}
+
+func (m *DeleteJobRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *StopJobRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *GetLogsRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.DataFilters) > 0 {
+		for _, s := range m.DataFilters {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.Follow {
+		n += 2
+	}
+	return n
+}
+
+func (m *LogMessage) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.PipelineName)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.PipelineID)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.JobID)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.Data) > 0 {
+		for _, e := range m.Data {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.Ts != nil {
+		l = m.Ts.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.WorkerID)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.User {
+		n += 2
+	}
+	return n
+}
+
+func (m *RestartDatumRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.DataFilters) > 0 {
+		for _, s := range m.DataFilters {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FileProvenanceRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.File != nil {
+		l = m.File.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *DatumProvenance) Size() (n int) {
+	var l int
+	_ = l
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.Inputs) > 0 {
+		for _, e := range m.Inputs {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.Outputs) > 0 {
+		for _, e := range m.Outputs {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FileProvenanceResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Upstream) > 0 {
+		for _, e := range m.Upstream {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.Downstream) > 0 {
+		for _, e := range m.Downstream {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CreatePipelineRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Transform != nil {
+		l = m.Transform.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.Inputs) > 0 {
+		for _, e := range m.Inputs {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.Update {
+		n += 2
+	}
+	if m.ParallelismSpec != nil {
+		l = m.ParallelismSpec.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Egress != nil {
+		l = m.Egress.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.OutputBranch)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.ScaleDownThreshold != nil {
+		l = m.ScaleDownThreshold.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.ResourceSpec != nil {
+		l = m.ResourceSpec.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Incremental {
+		n += 2
+	}
+	if len(m.Webhooks) > 0 {
+		for _, e := range m.Webhooks {
+			l = e.Size()
+			n += 2 + l + sovPps(uint64(l))
+		}
+	}
+	if m.S3Gateway {
+		n += 3
+	}
+	l = len(m.CacheSize)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	l = len(m.DiskCacheSize)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.DatumConcurrency != 0 {
+		n += 2 + sovPps(uint64(m.DatumConcurrency))
+	}
+	if len(m.PodLabels) > 0 {
+		for k, v := range m.PodLabels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
+			n += mapEntrySize + 2 + sovPps(uint64(mapEntrySize))
+		}
+	}
+	if len(m.PodAnnotations) > 0 {
+		for k, v := range m.PodAnnotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
+			n += mapEntrySize + 2 + sovPps(uint64(mapEntrySize))
+		}
+	}
+	if len(m.SecondaryOutputs) > 0 {
+		for _, e := range m.SecondaryOutputs {
+			l = e.Size()
+			n += 2 + l + sovPps(uint64(l))
+		}
+	}
+	if m.PinImage {
+		n += 3
+	}
+	if m.Logs != nil {
+		l = m.Logs.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.Scheduling != nil {
+		l = m.Scheduling.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.SkipCapacityCheck {
+		n += 3
+	}
+	l = len(m.ServiceAccount)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if len(m.Sidecars) > 0 {
+		for _, e := range m.Sidecars {
+			l = e.Size()
+			n += 2 + l + sovPps(uint64(l))
+		}
+	}
+	l = len(m.RuntimeClass)
+	if l > 0 {
+		n += 2 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *SecondaryOutput) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Repo)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Branch)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *LogsSpec) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Branch)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.MaxBytesPerDatum)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Retention != nil {
+		l = m.Retention.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *SchedulingSpec) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.NodeSelector) > 0 {
+		for k, v := range m.NodeSelector {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPps(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Tolerations) > 0 {
+		for _, e := range m.Tolerations {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	l = len(m.PreferredZone)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *Sidecar) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Image)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.Cmd) > 0 {
+		for _, s := range m.Cmd {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.Env) > 0 {
+		for k, v := range m.Env {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPps(uint64(mapEntrySize))
+		}
+	}
+	if m.ResourceSpec != nil {
+		l = m.ResourceSpec.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *Toleration) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Effect)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *InspectPipelineRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovPps(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *ListPipelineRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Project)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *DeletePipelineRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.DeleteJobs {
+		n += 2
+	}
+	if m.DeleteRepo {
+		n += 2
+	}
+	if m.All {
+		n += 2
+	}
+	if m.Cascade {
+		n += 2
+	}
+	return n
+}
+
+func (m *StartPipelineRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *StopPipelineRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *RerunPipelineRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.Exclude) > 0 {
+		for _, e := range m.Exclude {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.Include) > 0 {
+		for _, e := range m.Include {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GarbageCollectRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GarbageCollectResponse) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *UsageRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Since != nil {
+		l = m.Since.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *RepoUsage) Size() (n int) {
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovPps(uint64(m.SizeBytes))
+	}
+	return n
+}
+
+func (m *PipelineUsage) Size() (n int) {
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Jobs != 0 {
+		n += 1 + sovPps(uint64(m.Jobs))
+	}
+	if m.PodHours != 0 {
+		n += 9
+	}
+	if m.CpuHours != 0 {
+		n += 9
+	}
+	if m.DataProcessed != 0 {
+		n += 1 + sovPps(uint64(m.DataProcessed))
+	}
+	if m.OutputSizeBytes != 0 {
+		n += 1 + sovPps(uint64(m.OutputSizeBytes))
+	}
+	if m.WallClockHours != 0 {
+		n += 9
+	}
+	if m.MeasuredCpuHours != 0 {
+		n += 9
+	}
+	if m.MemoryGbHours != 0 {
+		n += 9
+	}
+	if len(m.NodeTypes) > 0 {
+		for _, s := range m.NodeTypes {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UsageResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Since != nil {
+		l = m.Since.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Until != nil {
+		l = m.Until.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.Repos) > 0 {
+		for _, e := range m.Repos {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if len(m.Pipelines) > 0 {
+		for _, e := range m.Pipelines {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovPps(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozPps(x uint64) (n int) {
+	return sovPps(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Secret) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Secret: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Secret: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MountPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MountPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Transform) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Transform: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Transform: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cmd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cmd = append(m.Cmd, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthPps
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Env == nil {
+				m.Env = make(map[string]string)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var stringLenmapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLenmapvalue := int(stringLenmapvalue)
+				if intStringLenmapvalue < 0 {
+					return ErrInvalidLengthPps
+				}
+				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+				if postStringIndexmapvalue > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
+				iNdEx = postStringIndexmapvalue
+				m.Env[mapkey] = mapvalue
+			} else {
+				var mapvalue string
+				m.Env[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secrets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Secrets = append(m.Secrets, &Secret{})
+			if err := m.Secrets[len(m.Secrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stdin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stdin = append(m.Stdin, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AcceptReturnCode = append(m.AcceptReturnCode, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPps
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AcceptReturnCode = append(m.AcceptReturnCode, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptReturnCode", wireType)
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Debug", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Debug = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullSecrets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImagePullSecrets = append(m.ImagePullSecrets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageDigest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImageDigest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Setup", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Setup = append(m.Setup, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hooks == nil {
+				m.Hooks = &Hooks{}
+			}
+			if err := m.Hooks.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Hooks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Hooks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Hooks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeDatum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeforeDatum = append(m.BeforeDatum, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterDatum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AfterDatum = append(m.AfterDatum, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobStart", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobStart = append(m.JobStart, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobEnd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobEnd = append(m.JobEnd, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Egress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Egress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Egress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Webhook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Webhook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Webhook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v JobState
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (JobState(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.States = append(m.States, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPps
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v JobState
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (JobState(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.States = append(m.States, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field States", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Job) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Job: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Job: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Service) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Service: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Service: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalPort", wireType)
+			}
+			m.InternalPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InternalPort |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalPort", wireType)
+			}
+			m.ExternalPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExternalPort |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AtomInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AtomInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AtomInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Glob = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Lazy = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromCommit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromCommit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mount", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Mount = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GitInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GitInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GitInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Secret = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Input) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Input: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Atom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Atom == nil {
+				m.Atom = &AtomInput{}
+			}
+			if err := m.Atom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cross", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cross = append(m.Cross, &Input{})
+			if err := m.Cross[len(m.Cross)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Union", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Union = append(m.Union, &Input{})
+			if err := m.Union[len(m.Union)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Git == nil {
+				m.Git = &GitInput{}
+			}
+			if err := m.Git.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &pfs.Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Glob = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Lazy = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParallelismSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParallelismSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParallelismSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			m.Strategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Strategy |= (ParallelismSpec_Strategy(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constant", wireType)
+			}
+			m.Constant = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Constant |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coefficient", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.Coefficient = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Datum) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Datum: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Datum: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DatumManifest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DatumManifest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DatumManifest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatumID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inputs = append(m.Inputs, &DatumManifestInput{})
+			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DatumManifestInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DatumManifestInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DatumManifestInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.File == nil {
+				m.File = &pfs.File{}
+			}
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkerStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkerStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkerID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, &Datum{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Started == nil {
+				m.Started = &google_protobuf1.Timestamp{}
+			}
+			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkerPod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkerPod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkerPod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Node = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restarts", wireType)
+			}
+			m.Restarts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Restarts |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PodEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PodEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PodEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pod = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSeen", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastSeen == nil {
+				m.LastSeen = &google_protobuf1.Timestamp{}
+			}
+			if err := m.LastSeen.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cpu", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(dAtA[iNdEx-4])
+			v |= uint32(dAtA[iNdEx-3]) << 8
+			v |= uint32(dAtA[iNdEx-2]) << 16
+			v |= uint32(dAtA[iNdEx-1]) << 24
+			m.Cpu = float32(math.Float32frombits(v))
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memory = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gpu", wireType)
+			}
+			m.Gpu = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gpu |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Job == nil {
+				m.Job = &Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Transform == nil {
+				m.Transform = &Transform{}
+			}
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inputs = append(m.Inputs, &JobInput{})
+			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentJob", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParentJob == nil {
+				m.ParentJob = &Job{}
+			}
+			if err := m.ParentJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Started == nil {
+				m.Started = &google_protobuf1.Timestamp{}
+			}
+			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Finished == nil {
+				m.Finished = &google_protobuf1.Timestamp{}
+			}
+			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OutputCommit == nil {
+				m.OutputCommit = &pfs.Commit{}
+			}
+			if err := m.OutputCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= (JobState(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParallelismSpec == nil {
+				m.ParallelismSpec = &ParallelismSpec{}
+			}
+			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineVersion", wireType)
+			}
+			m.PipelineVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PipelineVersion |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Service == nil {
+				m.Service = &Service{}
+			}
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Egress == nil {
+				m.Egress = &Egress{}
+			}
+			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutputBranch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputRepo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OutputRepo == nil {
+				m.OutputRepo = &pfs.Repo{}
+			}
+			if err := m.OutputRepo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PipelineID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restart", wireType)
+			}
+			m.Restart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Restart |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataProcessed", wireType)
+			}
+			m.DataProcessed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataProcessed |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataTotal", wireType)
+			}
+			m.DataTotal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataTotal |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkerStatus = append(m.WorkerStatus, &WorkerStatus{})
+			if err := m.WorkerStatus[len(m.WorkerStatus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResourceSpec == nil {
+				m.ResourceSpec = &ResourceSpec{}
+			}
+			if err := m.ResourceSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &Input{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewBranch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewBranch == nil {
+				m.NewBranch = &pfs.Branch{}
+			}
+			if err := m.NewBranch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Incremental", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Incremental = bool(v != 0)
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerPods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkerPods = append(m.WorkerPods, &WorkerPod{})
+			if err := m.WorkerPods[len(m.WorkerPods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodEvents = append(m.PodEvents, &PodEvent{})
+			if err := m.PodEvents[len(m.PodEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Webhooks = append(m.Webhooks, &Webhook{})
+			if err := m.Webhooks[len(m.Webhooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSkipped", wireType)
+			}
+			m.DataSkipped = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataSkipped |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Cost == nil {
+				m.Cost = &JobCost{}
+			}
+			if err := m.Cost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondaryOutputCommits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecondaryOutputCommits = append(m.SecondaryOutputCommits, &pfs.Commit{})
+			if err := m.SecondaryOutputCommits[len(m.SecondaryOutputCommits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumCheckpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DatumCheckpoint == nil {
+				m.DatumCheckpoint = &pfs.Object{}
+			}
+			if err := m.DatumCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetupTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SetupTime == nil {
+				m.SetupTime = &google_protobuf2.Duration{}
+			}
+			if err := m.SetupTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 38:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadBytes", wireType)
+			}
+			m.DownloadBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DownloadBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 39:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadBytes", wireType)
+			}
+			m.UploadBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobCost) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobCost: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobCost: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeTypes = append(m.NodeTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CpuSeconds", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.CpuSeconds = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryByteSeconds", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.MemoryByteSeconds = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeakMemoryBytes", wireType)
+			}
+			m.PeakMemoryBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeakMemoryBytes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Samples", wireType)
+			}
+			m.Samples = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Samples |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentCpuCores", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.CurrentCpuCores = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentMemoryBytes", wireType)
+			}
+			m.CurrentMemoryBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentMemoryBytes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Worker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Worker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Worker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= (WorkerState(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobInfos) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobInfos: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobInfos: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobInfo = append(m.JobInfo, &JobInfo{})
+			if err := m.JobInfo[len(m.JobInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pipeline) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pipeline: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pipeline: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PipelineInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PipelineInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PipelineInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &pfs.Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Glob = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Lazy = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.From == nil {
+				m.From = &pfs.Commit{}
+			}
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PipelineInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PipelineInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Transform == nil {
+				m.Transform = &Transform{}
+			}
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inputs = append(m.Inputs, &PipelineInput{})
+			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &google_protobuf1.Timestamp{}
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= (PipelineState(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecentError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobCounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var mapkey int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				mapkey |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if m.JobCounts == nil {
+				m.JobCounts = make(map[int32]int32)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var mapvalue int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					mapvalue |= (int32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.JobCounts[mapkey] = mapvalue
+			} else {
+				var mapvalue int32
+				m.JobCounts[mapkey] = mapvalue
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.ParallelismSpec == nil {
+				m.ParallelismSpec = &ParallelismSpec{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Secret: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Secret: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Egress == nil {
+				m.Egress = &Egress{}
+			}
+			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5270,11 +14610,11 @@ func (m *Secret) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.OutputBranch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MountPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5299,63 +14639,46 @@ func (m *Secret) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MountPath = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownThreshold", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Transform) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.ScaleDownThreshold == nil {
+				m.ScaleDownThreshold = &google_protobuf2.Duration{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.ScaleDownThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Transform: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Transform: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceSpec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -5365,26 +14688,30 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Image = string(dAtA[iNdEx:postIndex])
+			if m.ResourceSpec == nil {
+				m.ResourceSpec = &ResourceSpec{}
+			}
+			if err := m.ResourceSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cmd", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -5394,26 +14721,30 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cmd = append(m.Cmd, string(dAtA[iNdEx:postIndex]))
+			if m.Input == nil {
+				m.Input = &Input{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -5423,19 +14754,26 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var keykey uint64
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Incremental", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -5445,12 +14783,17 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				keykey |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			var stringLenmapkey uint64
+			m.Incremental = bool(v != 0)
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhooks", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -5460,76 +14803,48 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLenmapkey := int(stringLenmapkey)
-			if intStringLenmapkey < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postStringIndexmapkey := iNdEx + intStringLenmapkey
-			if postStringIndexmapkey > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
-			iNdEx = postStringIndexmapkey
-			if m.Env == nil {
-				m.Env = make(map[string]string)
+			m.Webhooks = append(m.Webhooks, &Webhook{})
+			if err := m.Webhooks[len(m.Webhooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx < postIndex {
-				var valuekey uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPps
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					valuekey |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				var stringLenmapvalue uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPps
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLenmapvalue := int(stringLenmapvalue)
-				if intStringLenmapvalue < 0 {
-					return ErrInvalidLengthPps
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S3Gateway", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
 				}
-				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-				if postStringIndexmapvalue > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
-				iNdEx = postStringIndexmapvalue
-				m.Env[mapkey] = mapvalue
-			} else {
-				var mapvalue string
-				m.Env[mapkey] = mapvalue
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 4:
+			m.S3Gateway = bool(v != 0)
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Secrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheSize", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -5539,26 +14854,24 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Secrets = append(m.Secrets, &Secret{})
-			if err := m.Secrets[len(m.Secrets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CacheSize = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stdin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskCacheSize", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5583,75 +14896,13 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stdin = append(m.Stdin, string(dAtA[iNdEx:postIndex]))
+			m.DiskCacheSize = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPps
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (int64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.AcceptReturnCode = append(m.AcceptReturnCode, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPps
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPps
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPps
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (int64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.AcceptReturnCode = append(m.AcceptReturnCode, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field AcceptReturnCode", wireType)
-			}
-		case 7:
+		case 27:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Debug", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumConcurrency", wireType)
 			}
-			var v int
+			m.DatumConcurrency = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -5661,17 +14912,16 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.DatumConcurrency |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Debug = bool(v != 0)
-		case 9:
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImagePullSecrets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodLabels", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -5681,76 +14931,34 @@ func (m *Transform) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImagePullSecrets = append(m.ImagePullSecrets, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Egress) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Egress: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Egress: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var stringLen uint64
+			var stringLenmapkey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -5760,76 +14968,76 @@ func (m *Egress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.URL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Job) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.PodLabels == nil {
+				m.PodLabels = make(map[string]string)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var stringLenmapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLenmapvalue := int(stringLenmapvalue)
+				if intStringLenmapvalue < 0 {
+					return ErrInvalidLengthPps
+				}
+				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+				if postStringIndexmapvalue > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
+				iNdEx = postStringIndexmapvalue
+				m.PodLabels[mapkey] = mapvalue
+			} else {
+				var mapvalue string
+				m.PodLabels[mapkey] = mapvalue
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Job: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Job: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodAnnotations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -5839,76 +15047,19 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Service) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Service: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Service: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InternalPort", wireType)
-			}
-			m.InternalPort = 0
+			var keykey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -5918,16 +15069,12 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InternalPort |= (int32(b) & 0x7F) << shift
+				keykey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalPort", wireType)
-			}
-			m.ExternalPort = 0
+			var stringLenmapkey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -5937,66 +15084,76 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExternalPort |= (int32(b) & 0x7F) << shift
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AtomInput) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.PodAnnotations == nil {
+				m.PodAnnotations = make(map[string]string)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var stringLenmapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLenmapvalue := int(stringLenmapvalue)
+				if intStringLenmapvalue < 0 {
+					return ErrInvalidLengthPps
+				}
+				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+				if postStringIndexmapvalue > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
+				iNdEx = postStringIndexmapvalue
+				m.PodAnnotations[mapkey] = mapvalue
+			} else {
+				var mapvalue string
+				m.PodAnnotations[mapkey] = mapvalue
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AtomInput: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AtomInput: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 30:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondaryOutputs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -6006,26 +15163,28 @@ func (m *AtomInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.SecondaryOutputs = append(m.SecondaryOutputs, &SecondaryOutput{})
+			if err := m.SecondaryOutputs[len(m.SecondaryOutputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 31:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -6035,26 +15194,30 @@ func (m *AtomInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repo = string(dAtA[iNdEx:postIndex])
+			if m.Logs == nil {
+				m.Logs = &LogsSpec{}
+			}
+			if err := m.Logs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 32:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheduling", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -6064,24 +15227,28 @@ func (m *AtomInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
+			if m.Scheduling == nil {
+				m.Scheduling = &SchedulingSpec{}
+			}
+			if err := m.Scheduling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 33:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6106,13 +15273,13 @@ func (m *AtomInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commit = string(dAtA[iNdEx:postIndex])
+			m.ServiceAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 34:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sidecars", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -6122,44 +15289,26 @@ func (m *AtomInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Glob = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Sidecars = append(m.Sidecars, &Sidecar{})
+			if err := m.Sidecars[len(m.Sidecars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Lazy = bool(v != 0)
-		case 7:
+			iNdEx = postIndex
+		case 35:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeClass", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6184,7 +15333,7 @@ func (m *AtomInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FromCommit = string(dAtA[iNdEx:postIndex])
+			m.RuntimeClass = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6207,7 +15356,7 @@ func (m *AtomInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Input) Unmarshal(dAtA []byte) error {
+func (m *PipelineInfos) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6230,79 +15379,15 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Input: wiretype end group for non-group")
+			return fmt.Errorf("proto: PipelineInfos: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PipelineInfos: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Atom", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Atom == nil {
-				m.Atom = &AtomInput{}
-			}
-			if err := m.Atom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cross", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Cross = append(m.Cross, &Input{})
-			if err := m.Cross[len(m.Cross)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Union", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6326,8 +15411,8 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Union = append(m.Union, &Input{})
-			if err := m.Union[len(m.Union)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PipelineInfo = append(m.PipelineInfo, &PipelineInfo{})
+			if err := m.PipelineInfo[len(m.PipelineInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6352,7 +15437,7 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobInput) Unmarshal(dAtA []byte) error {
+func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6375,15 +15460,15 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateJobRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6407,18 +15492,18 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &pfs.Commit{}
+			if m.Transform == nil {
+				m.Transform = &Transform{}
 			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -6428,26 +15513,61 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Glob = string(dAtA[iNdEx:postIndex])
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inputs = append(m.Inputs, &JobInput{})
+			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -6457,17 +15577,30 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Lazy = bool(v != 0)
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParallelismSpec == nil {
+				m.ParallelismSpec = &ParallelismSpec{}
+			}
+			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -6477,76 +15610,30 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ParallelismSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Service == nil {
+				m.Service = &Service{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ParallelismSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParallelismSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
 			}
-			m.Strategy = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -6556,16 +15643,30 @@ func (m *ParallelismSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Strategy |= (ParallelismSpec_Strategy(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Egress == nil {
+				m.Egress = &Egress{}
+			}
+			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Constant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineVersion", wireType)
 			}
-			m.Constant = 0
+			m.PipelineVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -6575,82 +15676,14 @@ func (m *ParallelismSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Constant |= (uint64(b) & 0x7F) << shift
+				m.PipelineVersion |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coefficient", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
-			m.Coefficient = float64(math.Float64frombits(v))
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Datum) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Datum: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Datum: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 4:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6675,13 +15708,13 @@ func (m *Datum) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.OutputBranch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputRepo", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -6691,78 +15724,30 @@ func (m *Datum) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
-			if m.Hash == nil {
-				m.Hash = []byte{}
+			if m.OutputRepo == nil {
+				m.OutputRepo = &pfs.Repo{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.OutputRepo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: WorkerStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkerStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentJob", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -6772,26 +15757,30 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkerID = string(dAtA[iNdEx:postIndex])
+			if m.ParentJob == nil {
+				m.ParentJob = &Job{}
+			}
+			if err := m.ParentJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceSpec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -6801,24 +15790,28 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobID = string(dAtA[iNdEx:postIndex])
+			if m.ResourceSpec == nil {
+				m.ResourceSpec = &ResourceSpec{}
+			}
+			if err := m.ResourceSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6842,14 +15835,16 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data, &Datum{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Input == nil {
+				m.Input = &Input{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewBranch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6873,13 +15868,33 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Started == nil {
-				m.Started = &google_protobuf1.Timestamp{}
+			if m.NewBranch == nil {
+				m.NewBranch = &pfs.Branch{}
 			}
-			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NewBranch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Incremental", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Incremental = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -6901,7 +15916,7 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
+func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6924,31 +15939,17 @@ func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: InspectJobRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InspectJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cpu", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += 4
-			v = uint32(dAtA[iNdEx-4])
-			v |= uint32(dAtA[iNdEx-3]) << 8
-			v |= uint32(dAtA[iNdEx-2]) << 16
-			v |= uint32(dAtA[iNdEx-1]) << 24
-			m.Cpu = float32(math.Float32frombits(v))
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -6958,26 +15959,30 @@ func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Memory = string(dAtA[iNdEx:postIndex])
+			if m.Job == nil {
+				m.Job = &Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gpu", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockState", wireType)
 			}
-			m.Gpu = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -6987,11 +15992,12 @@ func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Gpu |= (int64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.BlockState = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -7013,7 +16019,7 @@ func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobInfo) Unmarshal(dAtA []byte) error {
+func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7036,15 +16042,15 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListJobRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7068,16 +16074,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InputCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7101,16 +16107,64 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Transform == nil {
-				m.Transform = &Transform{}
-			}
-			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.InputCommit = append(m.InputCommit, &pfs.Commit{})
+			if err := m.InputCommit[len(m.InputCommit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FlushJobRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FlushJobRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FlushJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7134,16 +16188,14 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
-			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Commits = append(m.Commits, &pfs.Commit{})
+			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToPipelines", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7167,14 +16219,64 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Inputs = append(m.Inputs, &JobInput{})
-			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ToPipelines = append(m.ToPipelines, &Pipeline{})
+			if err := m.ToPipelines[len(m.ToPipelines)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 6:
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscribeJobRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeJobRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentJob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7198,18 +16300,18 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParentJob == nil {
-				m.ParentJob = &Job{}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			if err := m.ParentJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeExisting", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -7219,28 +16321,65 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			m.IncludeExisting = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Started == nil {
-				m.Started = &google_protobuf1.Timestamp{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteJobRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 8:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteJobRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7264,68 +16403,66 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Finished == nil {
-				m.Finished = &google_protobuf1.Timestamp{}
+			if m.Job == nil {
+				m.Job = &Job{}
 			}
-			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputCommit", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OutputCommit == nil {
-				m.OutputCommit = &pfs.Commit{}
-			}
-			if err := m.OutputCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StopJobRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.State = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.State |= (JobState(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 12:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StopJobRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StopJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7349,35 +16486,66 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParallelismSpec == nil {
-				m.ParallelismSpec = &ParallelismSpec{}
+			if m.Job == nil {
+				m.Job = &Job{}
 			}
-			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PipelineVersion", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.PipelineVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PipelineVersion |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
 			}
-		case 14:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetLogsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7401,16 +16569,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Service == nil {
-				m.Service = &Service{}
+			if m.Job == nil {
+				m.Job = &Job{}
 			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7434,16 +16602,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Egress == nil {
-				m.Egress = &Egress{}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataFilters", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7468,13 +16636,13 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutputBranch = string(dAtA[iNdEx:postIndex])
+			m.DataFilters = append(m.DataFilters, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 18:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputRepo", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Follow", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -7484,28 +16652,65 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			m.Follow = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OutputRepo == nil {
-				m.OutputRepo = &pfs.Repo{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LogMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			if err := m.OutputRepo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 19:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PipelineID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7530,70 +16735,13 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PipelineID = string(dAtA[iNdEx:postIndex])
+			m.PipelineName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 20:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restart", wireType)
-			}
-			m.Restart = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Restart |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 22:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataProcessed", wireType)
-			}
-			m.DataProcessed = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DataProcessed |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 23:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataTotal", wireType)
-			}
-			m.DataTotal = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DataTotal |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 24:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -7603,28 +16751,26 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkerStatus = append(m.WorkerStatus, &WorkerStatus{})
-			if err := m.WorkerStatus[len(m.WorkerStatus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PipelineID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 25:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -7634,28 +16780,24 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceSpec == nil {
-				m.ResourceSpec = &ResourceSpec{}
-			}
-			if err := m.ResourceSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.JobID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 26:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7679,16 +16821,14 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Input == nil {
-				m.Input = &Input{}
-			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Data = append(m.Data, &Datum{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 27:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewBranch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7712,18 +16852,18 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NewBranch == nil {
-				m.NewBranch = &pfs.Branch{}
+			if m.Ts == nil {
+				m.Ts = &google_protobuf1.Timestamp{}
 			}
-			if err := m.NewBranch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Ts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 28:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Incremental", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -7733,65 +16873,24 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Incremental = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Worker) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Worker: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Worker: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7816,13 +16915,13 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.WorkerID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
-			m.State = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -7832,11 +16931,12 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= (WorkerState(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.User = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -7858,7 +16958,7 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobInfos) Unmarshal(dAtA []byte) error {
+func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7881,15 +16981,15 @@ func (m *JobInfos) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobInfos: wiretype end group for non-group")
+			return fmt.Errorf("proto: RestartDatumRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobInfos: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RestartDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7913,64 +17013,16 @@ func (m *JobInfos) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobInfo = append(m.JobInfo, &JobInfo{})
-			if err := m.JobInfo[len(m.JobInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Job == nil {
+				m.Job = &Job{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Pipeline) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Pipeline: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pipeline: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataFilters", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7995,7 +17047,7 @@ func (m *Pipeline) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.DataFilters = append(m.DataFilters, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8018,7 +17070,7 @@ func (m *Pipeline) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PipelineInput) Unmarshal(dAtA []byte) error {
+func (m *FileProvenanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8028,168 +17080,28 @@ func (m *PipelineInput) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowPps
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PipelineInput: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PipelineInput: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Repo == nil {
-				m.Repo = &pfs.Repo{}
-			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Glob = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Lazy = bool(v != 0)
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FileProvenanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FileProvenanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8213,10 +17125,10 @@ func (m *PipelineInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.From == nil {
-				m.From = &pfs.Commit{}
+			if m.File == nil {
+				m.File = &pfs.File{}
 			}
-			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8241,7 +17153,7 @@ func (m *PipelineInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
+func (m *DatumProvenance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8264,15 +17176,15 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PipelineInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: DatumProvenance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PipelineInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DatumProvenance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8296,16 +17208,16 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
+			if m.Job == nil {
+				m.Job = &Job{}
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8329,16 +17241,14 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Transform == nil {
-				m.Transform = &Transform{}
-			}
-			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Inputs = append(m.Inputs, &pfs.File{})
+			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8362,14 +17272,64 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Inputs = append(m.Inputs, &PipelineInput{})
-			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Outputs = append(m.Outputs, &pfs.File{})
+			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FileProvenanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FileProvenanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FileProvenanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Upstream", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8393,37 +17353,16 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &google_protobuf1.Timestamp{}
-			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Upstream = append(m.Upstream, &DatumProvenance{})
+			if err := m.Upstream[len(m.Upstream)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
-			}
-			m.State = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.State |= (PipelineState(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecentError", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Downstream", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -8433,24 +17372,76 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecentError = string(dAtA[iNdEx:postIndex])
+			m.Downstream = append(m.Downstream, &DatumProvenance{})
+			if err := m.Downstream[len(m.Downstream)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreatePipelineRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreatePipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobCounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8474,79 +17465,49 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var keykey uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				keykey |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			var mapkey int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				mapkey |= (int32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if m.JobCounts == nil {
-				m.JobCounts = make(map[int32]int32)
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
 			}
-			if iNdEx < postIndex {
-				var valuekey uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPps
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					valuekey |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				var mapvalue int32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPps
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					mapvalue |= (int32(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
 				}
-				m.JobCounts[mapkey] = mapvalue
-			} else {
-				var mapvalue int32
-				m.JobCounts[mapkey] = mapvalue
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Transform == nil {
+				m.Transform = &Transform{}
+			}
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8570,18 +17531,16 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParallelismSpec == nil {
-				m.ParallelismSpec = &ParallelismSpec{}
-			}
-			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Inputs = append(m.Inputs, &PipelineInput{})
+			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
 			}
-			m.Version = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -8591,14 +17550,15 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
+			m.Update = bool(v != 0)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8622,18 +17582,18 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Egress == nil {
-				m.Egress = &Egress{}
+			if m.ParallelismSpec == nil {
+				m.ParallelismSpec = &ParallelismSpec{}
 			}
-			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -8643,24 +17603,28 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutputBranch = string(dAtA[iNdEx:postIndex])
+			if m.Egress == nil {
+				m.Egress = &Egress{}
+			}
+			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 17:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8685,9 +17649,9 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.OutputBranch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
+		case 11:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownThreshold", wireType)
 			}
@@ -8720,7 +17684,7 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 19:
+		case 12:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ResourceSpec", wireType)
 			}
@@ -8753,7 +17717,7 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 20:
+		case 13:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
@@ -8786,7 +17750,7 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 21:
+		case 14:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
@@ -8815,7 +17779,7 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 22:
+		case 15:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Incremental", wireType)
 			}
@@ -8835,59 +17799,9 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Incremental = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PipelineInfos) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PipelineInfos: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PipelineInfos: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PipelineInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhooks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8911,66 +17825,36 @@ func (m *PipelineInfos) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PipelineInfo = append(m.PipelineInfo, &PipelineInfo{})
-			if err := m.PipelineInfo[len(m.PipelineInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Webhooks = append(m.Webhooks, &Webhook{})
+			if err := m.Webhooks[len(m.Webhooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S3Gateway", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CreateJobRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.S3Gateway = bool(v != 0)
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheSize", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -8980,30 +17864,26 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Transform == nil {
-				m.Transform = &Transform{}
-			}
-			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CacheSize = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskCacheSize", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -9013,30 +17893,26 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
-			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DiskCacheSize = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumConcurrency", wireType)
 			}
-			var msglen int
+			m.DatumConcurrency = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -9046,26 +17922,14 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.DatumConcurrency |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Inputs = append(m.Inputs, &JobInput{})
-			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodLabels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9089,18 +17953,22 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParallelismSpec == nil {
-				m.ParallelismSpec = &ParallelismSpec{}
-			}
-			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var msglen int
+			var stringLenmapkey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -9110,28 +17978,74 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Service == nil {
-				m.Service = &Service{}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.PodLabels == nil {
+				m.PodLabels = make(map[string]string)
 			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var stringLenmapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLenmapvalue := int(stringLenmapvalue)
+				if intStringLenmapvalue < 0 {
+					return ErrInvalidLengthPps
+				}
+				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+				if postStringIndexmapvalue > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
+				iNdEx = postStringIndexmapvalue
+				m.PodLabels[mapkey] = mapvalue
+			} else {
+				var mapvalue string
+				m.PodLabels[mapkey] = mapvalue
 			}
 			iNdEx = postIndex
-		case 9:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodAnnotations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9155,18 +18069,7 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Egress == nil {
-				m.Egress = &Egress{}
-			}
-			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PipelineVersion", wireType)
-			}
-			m.PipelineVersion = 0
+			var keykey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -9176,16 +18079,12 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PipelineVersion |= (uint64(b) & 0x7F) << shift
+				keykey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
-			}
-			var stringLen uint64
+			var stringLenmapkey uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -9195,24 +18094,74 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutputBranch = string(dAtA[iNdEx:postIndex])
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.PodAnnotations == nil {
+				m.PodAnnotations = make(map[string]string)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var stringLenmapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLenmapvalue := int(stringLenmapvalue)
+				if intStringLenmapvalue < 0 {
+					return ErrInvalidLengthPps
+				}
+				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+				if postStringIndexmapvalue > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
+				iNdEx = postStringIndexmapvalue
+				m.PodAnnotations[mapkey] = mapvalue
+			} else {
+				var mapvalue string
+				m.PodAnnotations[mapkey] = mapvalue
+			}
 			iNdEx = postIndex
-		case 12:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputRepo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondaryOutputs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9236,16 +18185,34 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OutputRepo == nil {
-				m.OutputRepo = &pfs.Repo{}
-			}
-			if err := m.OutputRepo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SecondaryOutputs = append(m.SecondaryOutputs, &SecondaryOutput{})
+			if err := m.SecondaryOutputs[len(m.SecondaryOutputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PinImage", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PinImage = bool(v != 0)
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentJob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9269,16 +18236,16 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParentJob == nil {
-				m.ParentJob = &Job{}
+			if m.Logs == nil {
+				m.Logs = &LogsSpec{}
 			}
-			if err := m.ParentJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Logs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheduling", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9302,18 +18269,38 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceSpec == nil {
-				m.ResourceSpec = &ResourceSpec{}
+			if m.Scheduling == nil {
+				m.Scheduling = &SchedulingSpec{}
 			}
-			if err := m.ResourceSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Scheduling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipCapacityCheck", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SkipCapacityCheck = bool(v != 0)
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -9323,28 +18310,24 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Input == nil {
-				m.Input = &Input{}
-			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ServiceAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewBranch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sidecars", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9368,18 +18351,16 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NewBranch == nil {
-				m.NewBranch = &pfs.Branch{}
-			}
-			if err := m.NewBranch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Sidecars = append(m.Sidecars, &Sidecar{})
+			if err := m.Sidecars[len(m.Sidecars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Incremental", wireType)
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeClass", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -9389,12 +18370,21 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Incremental = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuntimeClass = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -9416,7 +18406,7 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
+func (m *SecondaryOutput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9439,17 +18429,17 @@ func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InspectJobRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SecondaryOutput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SecondaryOutput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -9459,30 +18449,55 @@ func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockState", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -9492,12 +18507,21 @@ func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.BlockState = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -9519,7 +18543,7 @@ func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
+func (m *LogsSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9542,17 +18566,17 @@ func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListJobRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogsSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogsSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -9562,28 +18586,53 @@ func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytesPerDatum", wireType)
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.MaxBytesPerDatum = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InputCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Retention", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9607,8 +18656,10 @@ func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InputCommit = append(m.InputCommit, &pfs.Commit{})
-			if err := m.InputCommit[len(m.InputCommit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Retention == nil {
+				m.Retention = &google_protobuf2.Duration{}
+			}
+			if err := m.Retention.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9633,7 +18684,7 @@ func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteJobRequest) Unmarshal(dAtA []byte) error {
+func (m *SchedulingSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9652,19 +18703,135 @@ func (m *DeleteJobRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteJobRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SchedulingSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SchedulingSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthPps
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.NodeSelector == nil {
+				m.NodeSelector = make(map[string]string)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var stringLenmapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLenmapvalue := int(stringLenmapvalue)
+				if intStringLenmapvalue < 0 {
+					return ErrInvalidLengthPps
+				}
+				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+				if postStringIndexmapvalue > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
+				iNdEx = postStringIndexmapvalue
+				m.NodeSelector[mapkey] = mapvalue
+			} else {
+				var mapvalue string
+				m.NodeSelector[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9688,13 +18855,40 @@ func (m *DeleteJobRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tolerations = append(m.Tolerations, &Toleration{})
+			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreferredZone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreferredZone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -9716,7 +18910,7 @@ func (m *DeleteJobRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StopJobRequest) Unmarshal(dAtA []byte) error {
+func (m *Sidecar) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9739,17 +18933,17 @@ func (m *StopJobRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StopJobRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Sidecar: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StopJobRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Sidecar: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -9759,80 +18953,55 @@ func (m *StopJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetLogsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Image = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cmd", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -9842,28 +19011,24 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Cmd = append(m.Cmd, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9887,18 +19052,101 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthPps
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Env == nil {
+				m.Env = make(map[string]string)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var stringLenmapvalue uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLenmapvalue := int(stringLenmapvalue)
+				if intStringLenmapvalue < 0 {
+					return ErrInvalidLengthPps
+				}
+				postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+				if postStringIndexmapvalue > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := string(dAtA[iNdEx:postStringIndexmapvalue])
+				iNdEx = postStringIndexmapvalue
+				m.Env[mapkey] = mapvalue
+			} else {
+				var mapvalue string
+				m.Env[mapkey] = mapvalue
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataFilters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceSpec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -9908,20 +19156,24 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataFilters = append(m.DataFilters, string(dAtA[iNdEx:postIndex]))
+			if m.ResourceSpec == nil {
+				m.ResourceSpec = &ResourceSpec{}
+			}
+			if err := m.ResourceSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9944,7 +19196,7 @@ func (m *GetLogsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LogMessage) Unmarshal(dAtA []byte) error {
+func (m *Toleration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9967,15 +19219,15 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LogMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: Toleration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Toleration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PipelineName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10000,11 +19252,11 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PipelineName = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PipelineID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10029,11 +19281,11 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PipelineID = string(dAtA[iNdEx:postIndex])
+			m.Operator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10058,13 +19310,13 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobID = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Effect", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -10074,61 +19326,76 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data, &Datum{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Effect = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ts", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Ts == nil {
-				m.Ts = &google_protobuf1.Timestamp{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InspectPipelineRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			if err := m.Ts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InspectPipelineRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InspectPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -10138,55 +19405,30 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.WorkerID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var v int
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -10196,12 +19438,11 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.Version |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.User = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -10223,7 +19464,7 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
+func (m *ListPipelineRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10246,48 +19487,15 @@ func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RestartDatumRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListPipelineRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RestartDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Job == nil {
-				m.Job = &Job{}
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataFilters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10312,7 +19520,7 @@ func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataFilters = append(m.DataFilters, string(dAtA[iNdEx:postIndex]))
+			m.Project = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10335,7 +19543,7 @@ func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
+func (m *DeletePipelineRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10358,10 +19566,10 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreatePipelineRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeletePipelineRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreatePipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeletePipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10398,10 +19606,10 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteJobs", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -10411,30 +19619,17 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Transform == nil {
-				m.Transform = &Transform{}
-			}
-			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+			m.DeleteJobs = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteRepo", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -10444,26 +19639,15 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Inputs = append(m.Inputs, &PipelineInput{})
-			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 5:
+			m.DeleteRepo = bool(v != 0)
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -10480,12 +19664,12 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Update = bool(v != 0)
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
+			m.All = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cascade", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -10495,28 +19679,65 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			m.Cascade = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParallelismSpec == nil {
-				m.ParallelismSpec = &ParallelismSpec{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartPipelineRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 9:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartPipelineRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10540,45 +19761,66 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Egress == nil {
-				m.Egress = &Egress{}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutputBranch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StopPipelineRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StopPipelineRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StopPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10602,49 +19844,66 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScaleDownThreshold == nil {
-				m.ScaleDownThreshold = &google_protobuf2.Duration{}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			if err := m.ScaleDownThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceSpec", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceSpec == nil {
-				m.ResourceSpec = &ResourceSpec{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RerunPipelineRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			if err := m.ResourceSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 13:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RerunPipelineRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RerunPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10668,18 +19927,18 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Input == nil {
-				m.Input = &Input{}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -10689,26 +19948,28 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Exclude = append(m.Exclude, &pfs.Commit{})
+			if err := m.Exclude[len(m.Exclude)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Incremental", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Include", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -10718,12 +19979,23 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Incremental = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Include = append(m.Include, &pfs.Commit{})
+			if err := m.Include[len(m.Include)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -10745,7 +20017,7 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InspectPipelineRequest) Unmarshal(dAtA []byte) error {
+func (m *GarbageCollectRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10768,15 +20040,115 @@ func (m *InspectPipelineRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InspectPipelineRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GarbageCollectRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GarbageCollectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GarbageCollectResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GarbageCollectResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GarbageCollectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UsageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UsageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10800,10 +20172,10 @@ func (m *InspectPipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
+			if m.Since == nil {
+				m.Since = &google_protobuf1.Timestamp{}
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Since.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10828,7 +20200,7 @@ func (m *InspectPipelineRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListPipelineRequest) Unmarshal(dAtA []byte) error {
+func (m *RepoUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10851,12 +20223,64 @@ func (m *ListPipelineRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListPipelineRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RepoUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RepoUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &pfs.Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -10878,7 +20302,7 @@ func (m *ListPipelineRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeletePipelineRequest) Unmarshal(dAtA []byte) error {
+func (m *PipelineUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10901,10 +20325,10 @@ func (m *DeletePipelineRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeletePipelineRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PipelineUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeletePipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PipelineUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10942,9 +20366,9 @@ func (m *DeletePipelineRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteJobs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Jobs", wireType)
 			}
-			var v int
+			m.Jobs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -10954,17 +20378,52 @@ func (m *DeletePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.Jobs |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DeleteJobs = bool(v != 0)
 		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodHours", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.PodHours = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CpuHours", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.CpuHours = float64(math.Float64frombits(v))
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteRepo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataProcessed", wireType)
 			}
-			var v int
+			m.DataProcessed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -10974,17 +20433,16 @@ func (m *DeletePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.DataProcessed |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DeleteRepo = bool(v != 0)
-		case 4:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputSizeBytes", wireType)
 			}
-			var v int
+			m.OutputSizeBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -10994,67 +20452,70 @@ func (m *DeletePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.OutputSizeBytes |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.All = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WallClockHours", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.WallClockHours = float64(math.Float64frombits(v))
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MeasuredCpuHours", wireType)
 			}
-			if (iNdEx + skippy) > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StartPipelineRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.MeasuredCpuHours = float64(math.Float64frombits(v))
+		case 9:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryGbHours", wireType)
 			}
-			if iNdEx >= l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StartPipelineRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.MemoryGbHours = float64(math.Float64frombits(v))
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeTypes", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -11064,24 +20525,20 @@ func (m *StartPipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
-			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NodeTypes = append(m.NodeTypes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11104,7 +20561,7 @@ func (m *StartPipelineRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StopPipelineRequest) Unmarshal(dAtA []byte) error {
+func (m *UsageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11127,15 +20584,15 @@ func (m *StopPipelineRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StopPipelineRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UsageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StopPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11159,66 +20616,16 @@ func (m *StopPipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
+			if m.Since == nil {
+				m.Since = &google_protobuf1.Timestamp{}
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Since.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RerunPipelineRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RerunPipelineRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RerunPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Until", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11242,16 +20649,16 @@ func (m *RerunPipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
+			if m.Until == nil {
+				m.Until = &google_protobuf1.Timestamp{}
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Until.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repos", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11275,14 +20682,14 @@ func (m *RerunPipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Exclude = append(m.Exclude, &pfs.Commit{})
-			if err := m.Exclude[len(m.Exclude)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Repos = append(m.Repos, &RepoUsage{})
+			if err := m.Repos[len(m.Repos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Include", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipelines", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11306,8 +20713,8 @@ func (m *RerunPipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Include = append(m.Include, &pfs.Commit{})
-			if err := m.Include[len(m.Include)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Pipelines = append(m.Pipelines, &PipelineUsage{})
+			if err := m.Pipelines[len(m.Pipelines)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11332,106 +20739,6 @@ func (m *RerunPipelineRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GarbageCollectRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GarbageCollectRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GarbageCollectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GarbageCollectResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GarbageCollectResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GarbageCollectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
 func skipPps(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -11540,166 +20847,284 @@ var (
 func init() { proto.RegisterFile("client/pps/pps.proto", fileDescriptorPps) }
 
 var fileDescriptorPps = []byte{
-	// 2563 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6e, 0x1b, 0xc9,
-	0xb5, 0x16, 0xff, 0xc9, 0xc3, 0x1f, 0x51, 0xa5, 0x1f, 0xf7, 0xd0, 0xd7, 0x92, 0xa6, 0x07, 0x9e,
-	0x6b, 0x0b, 0x03, 0x69, 0xa0, 0x19, 0x18, 0x33, 0xf7, 0xce, 0xbd, 0x13, 0x59, 0xa4, 0x0d, 0x6a,
-	0x14, 0x59, 0x68, 0xca, 0x09, 0x90, 0x0d, 0xd3, 0x6c, 0x16, 0x29, 0xda, 0xcd, 0xae, 0x4e, 0x77,
-	0xd1, 0x1e, 0xcf, 0x2e, 0x4f, 0x90, 0x4d, 0x10, 0x64, 0x9f, 0x55, 0x80, 0x2c, 0x92, 0x45, 0x1e,
-	0x21, 0xc0, 0x2c, 0xf3, 0x04, 0x46, 0xa0, 0x3c, 0x42, 0xf6, 0x41, 0x50, 0xa7, 0xaa, 0x9a, 0xdd,
-	0x24, 0x45, 0x4b, 0xe3, 0x64, 0x41, 0xa0, 0xea, 0x9c, 0xd3, 0x55, 0xe7, 0xf7, 0x3b, 0xa7, 0x08,
-	0x1b, 0x8e, 0x3b, 0xa2, 0x1e, 0x3f, 0xf0, 0xfd, 0x50, 0xfc, 0xf6, 0xfd, 0x80, 0x71, 0x46, 0x32,
-	0xbe, 0x1f, 0x36, 0xee, 0x0e, 0x19, 0x1b, 0xba, 0xf4, 0x00, 0x49, 0xbd, 0xc9, 0xe0, 0x80, 0x8e,
-	0x7d, 0xfe, 0x46, 0x4a, 0x34, 0x76, 0x66, 0x99, 0x7c, 0x34, 0xa6, 0x21, 0xb7, 0xc7, 0xbe, 0x12,
-	0xd8, 0x9e, 0x15, 0xe8, 0x4f, 0x02, 0x9b, 0x8f, 0x98, 0xa7, 0xf8, 0x1b, 0x43, 0x36, 0x64, 0xb8,
-	0x3c, 0x10, 0x2b, 0x4d, 0xd5, 0xea, 0x0c, 0x42, 0xf1, 0x93, 0x54, 0xf3, 0x7f, 0x21, 0xdf, 0xa1,
-	0x4e, 0x40, 0x39, 0x21, 0x90, 0xf5, 0xec, 0x31, 0x35, 0x52, 0xbb, 0xa9, 0x07, 0x25, 0x0b, 0xd7,
-	0xe4, 0x1e, 0xc0, 0x98, 0x4d, 0x3c, 0xde, 0xf5, 0x6d, 0x7e, 0x69, 0xa4, 0x91, 0x53, 0x42, 0xca,
-	0xb9, 0xcd, 0x2f, 0xcd, 0xbf, 0xa4, 0xa1, 0x74, 0x11, 0xd8, 0x5e, 0x38, 0x60, 0xc1, 0x98, 0x6c,
-	0x40, 0x6e, 0x34, 0xb6, 0x87, 0xfa, 0x04, 0xb9, 0x21, 0x75, 0xc8, 0x38, 0xe3, 0xbe, 0x91, 0xde,
-	0xcd, 0x3c, 0x28, 0x59, 0x62, 0x49, 0x1e, 0x42, 0x86, 0x7a, 0xaf, 0x8c, 0xcc, 0x6e, 0xe6, 0x41,
-	0xf9, 0xf0, 0xce, 0xbe, 0x70, 0x4d, 0x74, 0xc8, 0x7e, 0xcb, 0x7b, 0xd5, 0xf2, 0x78, 0xf0, 0xc6,
-	0x12, 0x32, 0xe4, 0x3e, 0x14, 0x42, 0xd4, 0x2e, 0x34, 0xb2, 0x28, 0x5e, 0x46, 0x71, 0xa9, 0xb1,
-	0xa5, 0x79, 0xe2, 0xe6, 0x90, 0xf7, 0x47, 0x9e, 0x91, 0xc3, 0x5b, 0xe4, 0x86, 0x7c, 0x02, 0xc4,
-	0x76, 0x1c, 0xea, 0xf3, 0x6e, 0x40, 0xf9, 0x24, 0xf0, 0xba, 0x0e, 0xeb, 0x53, 0x23, 0xbf, 0x9b,
-	0x79, 0x90, 0xb1, 0xea, 0x92, 0x63, 0x21, 0xe3, 0x98, 0xf5, 0xa9, 0x38, 0xa3, 0x4f, 0x7b, 0x93,
-	0xa1, 0x51, 0xd8, 0x4d, 0x3d, 0x28, 0x5a, 0x72, 0x23, 0xce, 0x40, 0x33, 0xba, 0xfe, 0xc4, 0x75,
-	0xbb, 0x5a, 0x97, 0x12, 0x5e, 0x53, 0x47, 0xce, 0xf9, 0xc4, 0x75, 0xa5, 0x3e, 0x61, 0xe3, 0x11,
-	0x14, 0xb5, 0xfe, 0xc2, 0xee, 0x97, 0xf4, 0x8d, 0xf2, 0x85, 0x58, 0x8a, 0x1b, 0x5e, 0xd9, 0xee,
-	0x84, 0x2a, 0x3f, 0xca, 0xcd, 0xff, 0xa4, 0xbf, 0x48, 0x99, 0x0d, 0xc8, 0xb7, 0x86, 0x01, 0x0d,
-	0x43, 0xf1, 0xd5, 0x73, 0xeb, 0x54, 0x7f, 0xf5, 0xdc, 0x3a, 0x35, 0xef, 0x41, 0xe6, 0x84, 0xf5,
-	0xc8, 0x16, 0xa4, 0x47, 0x7d, 0x49, 0x7f, 0x9c, 0xbf, 0x7a, 0xbb, 0x93, 0x6e, 0x37, 0xad, 0xf4,
-	0xa8, 0x6f, 0x76, 0xa0, 0xd0, 0xa1, 0xc1, 0xab, 0x91, 0x43, 0xc9, 0x47, 0x50, 0x1d, 0x79, 0x9c,
-	0x06, 0x9e, 0xed, 0x76, 0x7d, 0x16, 0x70, 0x94, 0xce, 0x59, 0x15, 0x4d, 0x3c, 0x67, 0x01, 0x17,
-	0x42, 0xf4, 0xdb, 0xb8, 0x50, 0x5a, 0x0a, 0x69, 0xa2, 0x10, 0x32, 0xff, 0x90, 0x82, 0xd2, 0x11,
-	0x67, 0xe3, 0xb6, 0xe7, 0x4f, 0x16, 0x27, 0x06, 0x81, 0x6c, 0x40, 0x7d, 0xa6, 0x4c, 0xc1, 0x35,
-	0xd9, 0x82, 0x7c, 0x2f, 0xb0, 0x3d, 0xe7, 0xd2, 0xc8, 0x20, 0x55, 0xed, 0x04, 0xdd, 0x61, 0xe3,
-	0xf1, 0x88, 0x1b, 0x59, 0x49, 0x97, 0x3b, 0x71, 0xc6, 0xd0, 0x65, 0x3d, 0x23, 0x27, 0xcf, 0x10,
-	0x6b, 0x41, 0x73, 0xed, 0xef, 0xde, 0x18, 0x79, 0x0c, 0x02, 0xae, 0xc9, 0x0e, 0x94, 0x07, 0x01,
-	0x1b, 0x77, 0xd5, 0x21, 0x05, 0x14, 0x07, 0x41, 0x3a, 0x46, 0x8a, 0xc9, 0x20, 0x27, 0x35, 0x35,
-	0x21, 0x6b, 0x73, 0x36, 0x46, 0x4d, 0xcb, 0x87, 0x35, 0xcc, 0x95, 0xc8, 0x0e, 0x0b, 0x79, 0x64,
-	0x17, 0x72, 0x4e, 0xc0, 0xc2, 0x10, 0x33, 0xb2, 0x7c, 0x08, 0x28, 0x24, 0x05, 0x24, 0x43, 0x48,
-	0x4c, 0xbc, 0x11, 0xf3, 0x54, 0x86, 0x26, 0x24, 0x90, 0x61, 0xbe, 0x84, 0xe2, 0x09, 0xeb, 0xc9,
-	0x3b, 0x3f, 0x8a, 0xac, 0x93, 0xb7, 0x96, 0xf7, 0x45, 0x71, 0x49, 0xcd, 0xe6, 0x4c, 0x4d, 0x2f,
-	0x30, 0x35, 0x13, 0x33, 0x55, 0xbb, 0x3a, 0x3b, 0x75, 0xb5, 0xf9, 0xe7, 0x14, 0xac, 0x9e, 0xdb,
-	0x81, 0xed, 0xba, 0xd4, 0x1d, 0x85, 0xe3, 0x8e, 0x4f, 0x1d, 0xf2, 0x25, 0x14, 0x43, 0x1e, 0xd8,
-	0x9c, 0x0e, 0x65, 0x86, 0xd5, 0x0e, 0xef, 0xa1, 0x96, 0x33, 0x72, 0xfb, 0x1d, 0x25, 0x64, 0x45,
-	0xe2, 0xa4, 0x01, 0x45, 0x87, 0x79, 0x21, 0xb7, 0x3d, 0x19, 0xfb, 0xac, 0x15, 0xed, 0xc9, 0x2e,
-	0x94, 0x1d, 0x46, 0x07, 0x83, 0x91, 0x23, 0x90, 0x02, 0x35, 0x4b, 0x59, 0x71, 0x92, 0xf9, 0x10,
-	0x8a, 0xfa, 0x4c, 0x52, 0x81, 0xe2, 0xf1, 0xb3, 0xb3, 0xce, 0xc5, 0xd1, 0xd9, 0x45, 0x7d, 0x85,
-	0xac, 0x42, 0xf9, 0xf8, 0x59, 0xeb, 0xc9, 0x93, 0xf6, 0x71, 0xbb, 0x75, 0x76, 0x51, 0x4f, 0x99,
-	0x07, 0x90, 0x6b, 0xda, 0x7c, 0x32, 0x16, 0x46, 0x21, 0x7c, 0x28, 0xa3, 0xc4, 0x5a, 0xd0, 0x2e,
-	0xed, 0xf0, 0x12, 0x63, 0x5f, 0xb1, 0x70, 0x6d, 0xfe, 0x29, 0x05, 0x95, 0x9f, 0xb2, 0xe0, 0x25,
-	0x0d, 0x3a, 0xdc, 0xe6, 0x93, 0x90, 0x3c, 0x84, 0xd2, 0x6b, 0xdc, 0x77, 0xa3, 0xd4, 0xaf, 0x5c,
-	0xbd, 0xdd, 0x29, 0x4a, 0xa1, 0x76, 0xd3, 0x2a, 0x4a, 0x76, 0xbb, 0x4f, 0x76, 0x21, 0xff, 0x82,
-	0xf5, 0x84, 0x1c, 0xba, 0xf8, 0x71, 0xe9, 0xea, 0xed, 0x4e, 0x4e, 0xc4, 0xa8, 0x69, 0xe5, 0x5e,
-	0xb0, 0x5e, 0xbb, 0x4f, 0xb6, 0x21, 0xdb, 0xb7, 0xb9, 0x9d, 0x08, 0x2a, 0xea, 0x67, 0x21, 0x9d,
-	0x7c, 0x0e, 0x85, 0x90, 0xdb, 0x01, 0xa7, 0x7d, 0x54, 0xb4, 0x7c, 0xd8, 0xd8, 0x97, 0x30, 0xbb,
-	0xaf, 0x61, 0x76, 0xff, 0x42, 0xe3, 0xb0, 0xa5, 0x45, 0xcd, 0x13, 0xa8, 0x58, 0x34, 0x64, 0x93,
-	0xc0, 0xa1, 0x18, 0x18, 0x81, 0x76, 0xfe, 0x04, 0x95, 0x4d, 0x5b, 0x62, 0x29, 0xb2, 0x7f, 0x4c,
-	0xc7, 0x2c, 0x78, 0xa3, 0x82, 0xaf, 0x76, 0x42, 0x72, 0xe8, 0x4f, 0xd0, 0xc7, 0x19, 0x4b, 0x2c,
-	0xcd, 0x5f, 0x17, 0xa1, 0x80, 0x69, 0x35, 0x60, 0xa4, 0x01, 0x99, 0x17, 0xac, 0xa7, 0x52, 0xaa,
-	0x88, 0xca, 0x9e, 0xb0, 0x9e, 0x25, 0x88, 0xe4, 0x13, 0x28, 0x71, 0x8d, 0x97, 0x78, 0xa8, 0x4e,
-	0xf5, 0x08, 0x45, 0xad, 0xa9, 0x00, 0x79, 0x08, 0x45, 0x7f, 0xe4, 0x53, 0x77, 0xe4, 0x51, 0xbc,
-	0xac, 0x7c, 0x58, 0x95, 0xa9, 0xa2, 0x88, 0x56, 0xc4, 0x26, 0xf7, 0x21, 0x3f, 0x12, 0x39, 0x1d,
-	0x22, 0x8e, 0x6a, 0x41, 0x9d, 0xe9, 0x96, 0x62, 0x92, 0xff, 0x06, 0xf0, 0xed, 0x80, 0x7a, 0xbc,
-	0x2b, 0x54, 0xcc, 0xcf, 0xa8, 0x58, 0x92, 0x3c, 0x81, 0x59, 0x31, 0x97, 0x16, 0x6e, 0xec, 0x52,
-	0xf2, 0x08, 0x8a, 0x83, 0x91, 0x37, 0x0a, 0x2f, 0x69, 0xdf, 0x28, 0xbe, 0xf3, 0xb3, 0x48, 0x96,
-	0x7c, 0x0a, 0x55, 0x36, 0xe1, 0xfe, 0x84, 0x6b, 0xa0, 0x28, 0xcd, 0xd7, 0x63, 0x45, 0x4a, 0xc8,
-	0x1d, 0xf9, 0x48, 0xb4, 0x0d, 0x9b, 0x53, 0x03, 0xb0, 0x84, 0x22, 0x73, 0x45, 0xfa, 0x51, 0x4b,
-	0xf2, 0xc8, 0xd7, 0x50, 0xf7, 0xa7, 0x55, 0xd5, 0x0d, 0x7d, 0xea, 0x18, 0x15, 0x3c, 0x79, 0x63,
-	0x51, 0xc9, 0x59, 0xab, 0xfe, 0x4c, 0xad, 0x3e, 0x84, 0xba, 0xf6, 0x70, 0xf7, 0x15, 0x0d, 0x42,
-	0x81, 0x2c, 0x55, 0x2c, 0xbc, 0x55, 0x4d, 0xff, 0x89, 0x24, 0x93, 0x8f, 0x45, 0xbb, 0x43, 0x30,
-	0x37, 0x6a, 0x78, 0x45, 0x45, 0xb5, 0x3b, 0xa4, 0x59, 0x9a, 0x29, 0x30, 0x87, 0x62, 0xbf, 0x30,
-	0x56, 0xb5, 0x8d, 0x7e, 0xb8, 0x2f, 0x5b, 0x88, 0xa5, 0x58, 0x02, 0xe9, 0x95, 0x3f, 0x14, 0x2a,
-	0xaf, 0x61, 0xfe, 0x29, 0x17, 0x3c, 0x96, 0xd8, 0xbc, 0x07, 0x65, 0x25, 0x84, 0x70, 0x4e, 0xf0,
-	0xb8, 0x12, 0xba, 0xcc, 0xa2, 0x3e, 0xb3, 0x40, 0x72, 0xc5, 0x9a, 0x1c, 0x40, 0x39, 0x32, 0x64,
-	0xd4, 0x37, 0xd6, 0xb1, 0xd0, 0x6a, 0x57, 0x6f, 0x77, 0x40, 0xe7, 0x52, 0xbb, 0x69, 0x81, 0x16,
-	0x69, 0xf7, 0x89, 0x01, 0x85, 0x80, 0x62, 0x58, 0x8d, 0x0d, 0x34, 0x58, 0x6f, 0xc9, 0x7d, 0xa8,
-	0x89, 0xa2, 0xeb, 0xfa, 0x01, 0x73, 0x68, 0x18, 0xd2, 0xbe, 0xb1, 0x85, 0x75, 0x50, 0x15, 0xd4,
-	0x73, 0x4d, 0x14, 0xe3, 0x07, 0x8a, 0x71, 0xc6, 0x6d, 0xd7, 0xb8, 0x83, 0x22, 0x25, 0x41, 0xb9,
-	0x10, 0x04, 0xf2, 0x08, 0xaa, 0x0a, 0x1f, 0x42, 0x04, 0x0c, 0xc3, 0xc0, 0xb4, 0x5d, 0x43, 0x6f,
-	0xc4, 0x91, 0xc4, 0xaa, 0xbc, 0x8e, 0xe3, 0xca, 0x23, 0xa8, 0x06, 0xaa, 0x68, 0x65, 0x3c, 0x3f,
-	0x40, 0xb3, 0xe5, 0x77, 0xf1, 0x72, 0xb6, 0x2a, 0x41, 0xbc, 0xb8, 0x77, 0x21, 0x87, 0x25, 0x60,
-	0x34, 0x50, 0x3e, 0xd1, 0x18, 0x90, 0x41, 0xf6, 0x00, 0x3c, 0xfa, 0x5a, 0x3b, 0xfc, 0x6e, 0x2c,
-	0x01, 0xa5, 0xbf, 0xad, 0x92, 0x47, 0x5f, 0x2b, 0xd7, 0xef, 0x42, 0x79, 0xe4, 0x39, 0x01, 0x1d,
-	0x53, 0x4f, 0x58, 0xf7, 0x5f, 0xd8, 0x06, 0xe2, 0xa4, 0x93, 0x6c, 0x31, 0x5b, 0xcf, 0x99, 0x4d,
-	0xc8, 0x4b, 0x5b, 0x16, 0x36, 0xe2, 0x8f, 0x75, 0x0e, 0xa7, 0x31, 0x87, 0xeb, 0x33, 0xb6, 0xeb,
-	0x34, 0x36, 0x3f, 0x53, 0x2d, 0x6b, 0xc0, 0x44, 0x01, 0x17, 0x11, 0x2c, 0xbd, 0x01, 0x33, 0x52,
-	0xe8, 0xb2, 0xca, 0xb4, 0xd2, 0x07, 0xcc, 0x2a, 0xbc, 0x90, 0x0b, 0x73, 0x1b, 0x8a, 0x3a, 0xb4,
-	0x8b, 0x2e, 0x37, 0x7f, 0x97, 0x82, 0x6a, 0x14, 0x7b, 0x74, 0xc0, 0x3d, 0x35, 0x17, 0xa4, 0x66,
-	0x13, 0x69, 0x76, 0x44, 0x48, 0x27, 0x46, 0x04, 0xdd, 0x1f, 0x33, 0x0b, 0xfa, 0x63, 0x76, 0x41,
-	0x7f, 0xcc, 0xc5, 0x3c, 0xb0, 0x03, 0x59, 0x31, 0x0b, 0x28, 0x20, 0x4a, 0x94, 0x3b, 0x32, 0xcc,
-	0xef, 0xf3, 0x50, 0x99, 0x6a, 0x39, 0x60, 0x09, 0x48, 0x4c, 0x2d, 0x87, 0xc4, 0xdb, 0x61, 0xed,
-	0x5e, 0x04, 0xa0, 0x72, 0x5a, 0x25, 0x89, 0x63, 0x93, 0x28, 0xfa, 0x25, 0x80, 0x13, 0x50, 0x9b,
-	0xd3, 0x7e, 0xd7, 0xe6, 0x4a, 0xf9, 0x65, 0x40, 0x57, 0x52, 0xd2, 0x47, 0x9c, 0x3c, 0xd0, 0x31,
-	0x2f, 0x60, 0xcc, 0x93, 0xb7, 0x24, 0xc0, 0xeb, 0x43, 0xa8, 0x04, 0xd4, 0x11, 0x50, 0x4d, 0x83,
-	0x80, 0x05, 0x88, 0xa7, 0x25, 0xab, 0x2c, 0x69, 0x2d, 0x41, 0x22, 0x5f, 0x03, 0x88, 0x64, 0x70,
-	0xc4, 0x50, 0x2f, 0x27, 0xdb, 0xf2, 0xe1, 0xee, 0x8c, 0xde, 0x03, 0x26, 0x72, 0xe3, 0x18, 0x45,
-	0xe4, 0x74, 0x5e, 0x7a, 0xa1, 0xf7, 0x0b, 0x01, 0x12, 0x6e, 0x03, 0x90, 0x06, 0x14, 0x34, 0x2e,
-	0x96, 0x25, 0x4c, 0xa8, 0xed, 0x0f, 0xc4, 0xb9, 0xfa, 0x02, 0x9c, 0x93, 0xe3, 0xf3, 0xda, 0xec,
-	0xf8, 0x4c, 0xbe, 0x81, 0x8d, 0xd0, 0xb1, 0x5d, 0xda, 0xed, 0xb3, 0xd7, 0x5e, 0x97, 0x5f, 0x06,
-	0x34, 0xbc, 0x64, 0x6e, 0x5f, 0x01, 0xe1, 0x07, 0x73, 0xf1, 0x68, 0xaa, 0x97, 0x96, 0x45, 0xf0,
-	0xb3, 0x26, 0x7b, 0xed, 0x5d, 0xe8, 0x8f, 0xe6, 0x71, 0x65, 0xfd, 0x96, 0xb8, 0xb2, 0x71, 0x1d,
-	0xae, 0xec, 0x42, 0xb9, 0x4f, 0x43, 0x27, 0x18, 0xf9, 0xe2, 0x72, 0x63, 0x53, 0x86, 0x31, 0x46,
-	0x9a, 0x45, 0x93, 0xad, 0x39, 0x34, 0x69, 0x7c, 0x05, 0xb5, 0x64, 0x10, 0xe3, 0x4f, 0x94, 0xdc,
-	0x82, 0x27, 0x4a, 0x2e, 0xf6, 0x44, 0x39, 0xc9, 0x16, 0x33, 0xf5, 0xac, 0xf9, 0x34, 0x5e, 0xef,
-	0x02, 0x4a, 0x1e, 0x41, 0x75, 0xda, 0x13, 0xa6, 0x78, 0xb2, 0x36, 0x97, 0x40, 0x56, 0xc5, 0x8f,
-	0xed, 0xcc, 0x7f, 0x64, 0xa1, 0x7e, 0x8c, 0x09, 0x2d, 0x66, 0x06, 0xfa, 0x8b, 0x09, 0x0d, 0x79,
-	0xb2, 0xd8, 0x52, 0xb7, 0x19, 0x6c, 0xd2, 0x37, 0x1d, 0x6c, 0xb2, 0xcb, 0x06, 0x9b, 0x45, 0x99,
-	0x5c, 0xb8, 0x4d, 0x26, 0xc7, 0xfa, 0x77, 0xf1, 0x66, 0xfd, 0xbb, 0x74, 0x7d, 0x5e, 0x2f, 0x9a,
-	0x1b, 0x60, 0xf1, 0xdc, 0x30, 0x57, 0x02, 0xe5, 0x77, 0xb7, 0xfa, 0xca, 0xb2, 0x56, 0x9f, 0x1c,
-	0xf1, 0xaa, 0xd7, 0x8f, 0x78, 0x73, 0x29, 0x5f, 0xbb, 0x65, 0xca, 0xaf, 0xde, 0xac, 0x95, 0xd6,
-	0x6f, 0xd3, 0x4a, 0xd7, 0x16, 0xb5, 0x52, 0x91, 0xbe, 0xe7, 0xb0, 0xd6, 0xf6, 0x84, 0x9a, 0x3c,
-	0x96, 0x75, 0xcb, 0x46, 0xed, 0x1d, 0x28, 0xf7, 0x5c, 0xe6, 0xbc, 0xec, 0x4e, 0x7b, 0x6c, 0xd1,
-	0x02, 0x24, 0x21, 0xce, 0x9a, 0x2f, 0xa1, 0x76, 0x3a, 0x0a, 0xe3, 0xc7, 0xdd, 0xa2, 0xb9, 0xec,
-	0x43, 0x05, 0x6d, 0xd5, 0x03, 0x6b, 0x5a, 0xff, 0xc5, 0x31, 0xed, 0x60, 0x65, 0x14, 0x50, 0xef,
-	0xdc, 0x7d, 0xa8, 0x37, 0xa9, 0x4b, 0x13, 0x35, 0xb3, 0x44, 0x7b, 0xf3, 0x13, 0xa8, 0x75, 0x38,
-	0xf3, 0x6f, 0x28, 0xfd, 0x1d, 0xd4, 0x9e, 0x52, 0x7e, 0xca, 0x86, 0xe1, 0x4d, 0x3c, 0x73, 0x8b,
-	0xea, 0xfb, 0x10, 0x2a, 0x38, 0xc5, 0x0d, 0x46, 0x2e, 0xa7, 0x41, 0x88, 0x2f, 0x30, 0x81, 0x5e,
-	0x36, 0xb7, 0x9f, 0x48, 0x92, 0xf9, 0xfb, 0x34, 0xc0, 0x29, 0x1b, 0xfe, 0x98, 0x86, 0xa1, 0x3d,
-	0xc4, 0x7f, 0x32, 0xa2, 0xd4, 0x8f, 0x0d, 0x1d, 0x11, 0x84, 0x9c, 0x89, 0xbe, 0x3f, 0x33, 0x8e,
-	0xa6, 0xdf, 0x39, 0x8e, 0x4e, 0xdf, 0x88, 0x99, 0x77, 0xbc, 0x11, 0xb3, 0xd7, 0xbc, 0x11, 0xf7,
-	0x20, 0x8d, 0x8f, 0xa3, 0x77, 0xf5, 0xea, 0x34, 0x0f, 0x45, 0x57, 0x1b, 0x4b, 0x73, 0xb0, 0xb9,
-	0x97, 0x2c, 0xbd, 0x4d, 0x3e, 0x6b, 0x0b, 0x4b, 0x9f, 0xb5, 0x04, 0xb2, 0x93, 0x90, 0xca, 0xbe,
-	0x5d, 0xb4, 0x70, 0x6d, 0x5e, 0xc0, 0xba, 0x25, 0xc7, 0x68, 0xa9, 0xda, 0x0d, 0x82, 0x35, 0x1b,
-	0x81, 0xf4, 0x7c, 0x04, 0xfe, 0x98, 0x85, 0x4d, 0x09, 0xc8, 0x51, 0x04, 0x6f, 0x9f, 0xd0, 0xff,
-	0xb9, 0x69, 0x69, 0x0b, 0xf2, 0x13, 0xbf, 0x2f, 0x6a, 0x30, 0x87, 0xae, 0x50, 0xbb, 0xf7, 0x87,
-	0xec, 0x1b, 0x41, 0xf1, 0x1c, 0xbe, 0xc2, 0x02, 0x7c, 0xbd, 0x6e, 0x94, 0x28, 0xff, 0x5b, 0x46,
-	0x89, 0xca, 0x2d, 0x71, 0xb5, 0x7a, 0xc3, 0x51, 0xa2, 0xf6, 0xce, 0x51, 0x62, 0xf5, 0x3a, 0x34,
-	0x3d, 0x86, 0x2d, 0x85, 0xa6, 0x3f, 0x3c, 0x65, 0xcc, 0x4d, 0x58, 0x17, 0x00, 0x3a, 0x73, 0x82,
-	0xf9, 0x9b, 0x14, 0x6c, 0x4a, 0xac, 0x7b, 0x8f, 0x74, 0xdc, 0x11, 0xa6, 0x8a, 0x33, 0x44, 0x17,
-	0x0b, 0x35, 0x7a, 0xf7, 0x35, 0x84, 0x86, 0x31, 0x01, 0x6c, 0x89, 0x99, 0xb8, 0x00, 0xf6, 0xc1,
-	0x3a, 0x64, 0x6c, 0xd7, 0x55, 0x4f, 0x10, 0xb1, 0x34, 0x8f, 0x60, 0xa3, 0x23, 0x6a, 0xef, 0x3d,
-	0x4c, 0xfe, 0x11, 0xac, 0x0b, 0x58, 0x7e, 0x8f, 0x13, 0x7e, 0x95, 0x82, 0x0d, 0x8b, 0x06, 0x13,
-	0xef, 0x3d, 0x9c, 0x73, 0x1f, 0x0a, 0xf4, 0x5b, 0xc7, 0x9d, 0xf4, 0xe9, 0xa2, 0xbe, 0xa3, 0x79,
-	0x42, 0x6c, 0xe4, 0x49, 0xb1, 0xcc, 0x02, 0x31, 0xc5, 0x33, 0xef, 0xc0, 0xe6, 0x53, 0x3b, 0xe8,
-	0xd9, 0x43, 0x7a, 0xcc, 0x5c, 0x97, 0x3a, 0x5c, 0x07, 0xd2, 0x80, 0xad, 0x59, 0x46, 0xe8, 0x33,
-	0x2f, 0xa4, 0x7b, 0x3f, 0xc7, 0x17, 0x29, 0xb6, 0x51, 0x52, 0x87, 0xca, 0xc9, 0xb3, 0xc7, 0xdd,
-	0xce, 0xc5, 0x91, 0x75, 0xd1, 0x3e, 0x7b, 0x2a, 0xff, 0x4e, 0x14, 0x14, 0xeb, 0xf9, 0xd9, 0x99,
-	0x20, 0xa4, 0x34, 0xe1, 0xc9, 0x51, 0xfb, 0xf4, 0xb9, 0xd5, 0xaa, 0xa7, 0x35, 0xa1, 0xf3, 0xfc,
-	0xf8, 0xb8, 0xd5, 0xe9, 0xd4, 0x33, 0x11, 0xe1, 0xe2, 0xd9, 0xf9, 0x79, 0xab, 0x59, 0xcf, 0xee,
-	0x7d, 0x0d, 0xe5, 0xd8, 0x4b, 0x58, 0xf0, 0xcf, 0x9f, 0x35, 0xa3, 0x23, 0x57, 0x34, 0x41, 0x9f,
-	0x90, 0x22, 0x35, 0x00, 0x41, 0x10, 0x77, 0xb4, 0x9a, 0xf5, 0xf4, 0xde, 0x2f, 0x63, 0xef, 0x5b,
-	0x79, 0xc6, 0x26, 0xac, 0x9d, 0xb7, 0xcf, 0x5b, 0xa7, 0xed, 0xb3, 0x56, 0x5c, 0xdb, 0x0d, 0xa8,
-	0x47, 0xe4, 0xa9, 0xca, 0x77, 0x60, 0x7d, 0x4a, 0x6d, 0x45, 0xe2, 0xe9, 0x84, 0xb8, 0x36, 0x28,
-	0x93, 0xa0, 0x46, 0x46, 0x1c, 0xfe, 0xb3, 0x00, 0x99, 0xa3, 0xf3, 0x36, 0xd9, 0x87, 0x52, 0x34,
-	0x30, 0x93, 0x4d, 0x8c, 0xea, 0xec, 0x00, 0xdd, 0x88, 0x60, 0xdf, 0x5c, 0x21, 0x9f, 0x03, 0x4c,
-	0x67, 0x1d, 0xb2, 0xa5, 0x80, 0x60, 0x66, 0xf8, 0x69, 0x24, 0x1e, 0xfe, 0xe6, 0x0a, 0x39, 0x80,
-	0x82, 0x9a, 0x67, 0xc8, 0x3a, 0xb2, 0x92, 0xd3, 0x4d, 0xa3, 0x1a, 0x97, 0x0f, 0xcd, 0x15, 0xf2,
-	0x15, 0x94, 0xa2, 0x99, 0x44, 0xa9, 0x35, 0x3b, 0xa3, 0x34, 0xb6, 0xe6, 0xb0, 0xaf, 0x35, 0xf6,
-	0xf9, 0x1b, 0x73, 0x85, 0x7c, 0x01, 0x05, 0x35, 0xa1, 0xa8, 0xeb, 0x92, 0xf3, 0xca, 0x92, 0x2f,
-	0x1f, 0xe3, 0x1f, 0xaf, 0x51, 0x17, 0x24, 0x86, 0x46, 0xc6, 0xd9, 0xc6, 0xb8, 0xe4, 0x8c, 0x27,
-	0x50, 0x4b, 0xb6, 0x3c, 0xd2, 0x88, 0xf9, 0x75, 0xa6, 0xb6, 0x96, 0x9c, 0x73, 0x0c, 0xab, 0x33,
-	0x40, 0x48, 0xee, 0xc6, 0xfd, 0x3d, 0x7b, 0xd2, 0xfc, 0xeb, 0xc8, 0x5c, 0x21, 0xff, 0x0f, 0x95,
-	0x38, 0x10, 0x2a, 0x83, 0x16, 0x60, 0x63, 0x83, 0xcc, 0x7d, 0x1e, 0x4a, 0x63, 0x92, 0x80, 0xa9,
-	0x8c, 0x59, 0x88, 0xa2, 0x4b, 0x8c, 0x69, 0x42, 0x35, 0x01, 0x70, 0xe4, 0x03, 0x15, 0x98, 0x79,
-	0xd0, 0x5b, 0x1e, 0x9e, 0x38, 0xc6, 0x29, 0x6b, 0x16, 0xc0, 0xde, 0x72, 0x4d, 0x12, 0x20, 0xa7,
-	0x34, 0x59, 0x04, 0x7c, 0x4b, 0x4e, 0xf9, 0x3f, 0x9d, 0xa0, 0x47, 0xae, 0x4b, 0xae, 0x11, 0x5b,
-	0xf2, 0xf9, 0x67, 0x50, 0x50, 0x53, 0xb1, 0xca, 0xd0, 0xe4, 0x8c, 0xdc, 0x58, 0x95, 0x61, 0x8a,
-	0x66, 0x57, 0x73, 0xe5, 0xd3, 0x14, 0xf9, 0x06, 0x6a, 0x49, 0xd0, 0x53, 0xb1, 0x58, 0x08, 0x91,
-	0x8d, 0xbb, 0x0b, 0x79, 0x12, 0x25, 0xcd, 0x95, 0xc7, 0x9b, 0xdf, 0x5f, 0x6d, 0xa7, 0xfe, 0x7a,
-	0xb5, 0x9d, 0xfa, 0xdb, 0xd5, 0x76, 0xea, 0xb7, 0x7f, 0xdf, 0x5e, 0xf9, 0x59, 0xc6, 0xf7, 0xc3,
-	0x5e, 0x1e, 0x55, 0xfd, 0xec, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x81, 0x46, 0x95, 0x3d, 0x66,
-	0x1e, 0x00, 0x00,
+	// 4461 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x7b, 0xcf, 0x6f, 0x1b, 0xc9,
+	0x72, 0xbf, 0xf9, 0x4b, 0x24, 0x8b, 0x14, 0x49, 0xb5, 0x64, 0x79, 0x4c, 0xaf, 0x2d, 0xed, 0xf8,
+	0x79, 0xd7, 0xf6, 0x77, 0xbf, 0xb2, 0x63, 0x3f, 0xec, 0xdb, 0xf7, 0xf2, 0x92, 0x7d, 0x32, 0x25,
+	0x7b, 0xe5, 0xf5, 0xca, 0xca, 0x50, 0xce, 0x02, 0x0f, 0x08, 0x06, 0xc3, 0x99, 0x26, 0x35, 0xd6,
+	0x70, 0x7a, 0x32, 0x3d, 0xb4, 0xac, 0xbd, 0x24, 0xef, 0x9a, 0x4b, 0x82, 0x9c, 0x02, 0x04, 0x01,
+	0x02, 0xe4, 0x94, 0xe4, 0x92, 0x1c, 0xf2, 0x3f, 0x04, 0xc8, 0x25, 0xff, 0x40, 0x8c, 0xc0, 0x39,
+	0xe7, 0x10, 0xe4, 0x10, 0x20, 0x40, 0x80, 0xa0, 0xfa, 0xc7, 0x70, 0xf8, 0x43, 0x94, 0x64, 0x27,
+	0x40, 0x0e, 0x02, 0xba, 0xab, 0xaa, 0xbb, 0xab, 0xbb, 0xab, 0xab, 0xea, 0x53, 0x43, 0xc1, 0x9a,
+	0x1b, 0xf8, 0x34, 0x4c, 0x1e, 0x44, 0x11, 0xc7, 0xbf, 0xad, 0x28, 0x66, 0x09, 0x23, 0x85, 0x28,
+	0xe2, 0xed, 0x1b, 0x03, 0xc6, 0x06, 0x01, 0x7d, 0x20, 0x48, 0xbd, 0x51, 0xff, 0x01, 0x1d, 0x46,
+	0xc9, 0xa9, 0x94, 0x68, 0x6f, 0x4c, 0x33, 0x13, 0x7f, 0x48, 0x79, 0xe2, 0x0c, 0x23, 0x25, 0x70,
+	0x6b, 0x5a, 0xc0, 0x1b, 0xc5, 0x4e, 0xe2, 0xb3, 0x50, 0xf1, 0xd7, 0x06, 0x6c, 0xc0, 0x44, 0xf3,
+	0x01, 0xb6, 0x34, 0x55, 0xab, 0xd3, 0xe7, 0xf8, 0x27, 0xa9, 0xe6, 0xaf, 0xc3, 0x52, 0x97, 0xba,
+	0x31, 0x4d, 0x08, 0x81, 0x62, 0xe8, 0x0c, 0xa9, 0x91, 0xdb, 0xcc, 0xdd, 0xad, 0x5a, 0xa2, 0x4d,
+	0x6e, 0x02, 0x0c, 0xd9, 0x28, 0x4c, 0xec, 0xc8, 0x49, 0x8e, 0x8c, 0xbc, 0xe0, 0x54, 0x05, 0xe5,
+	0xc0, 0x49, 0x8e, 0xcc, 0x3f, 0x2f, 0x40, 0xf5, 0x30, 0x76, 0x42, 0xde, 0x67, 0xf1, 0x90, 0xac,
+	0x41, 0xc9, 0x1f, 0x3a, 0x03, 0x3d, 0x83, 0xec, 0x90, 0x16, 0x14, 0xdc, 0xa1, 0x67, 0xe4, 0x37,
+	0x0b, 0x77, 0xab, 0x16, 0x36, 0xc9, 0x3d, 0x28, 0xd0, 0xf0, 0x8d, 0x51, 0xd8, 0x2c, 0xdc, 0xad,
+	0x3d, 0xba, 0xb6, 0x85, 0x47, 0x93, 0x4e, 0xb2, 0xb5, 0x1b, 0xbe, 0xd9, 0x0d, 0x93, 0xf8, 0xd4,
+	0x42, 0x19, 0x72, 0x07, 0xca, 0x5c, 0x68, 0xc7, 0x8d, 0xa2, 0x10, 0xaf, 0x09, 0x71, 0xa9, 0xb1,
+	0xa5, 0x79, 0xb8, 0x32, 0x4f, 0x3c, 0x3f, 0x34, 0x4a, 0x62, 0x15, 0xd9, 0x21, 0x5f, 0x00, 0x71,
+	0x5c, 0x97, 0x46, 0x89, 0x1d, 0xd3, 0x64, 0x14, 0x87, 0xb6, 0xcb, 0x3c, 0x6a, 0x2c, 0x6d, 0x16,
+	0xee, 0x16, 0xac, 0x96, 0xe4, 0x58, 0x82, 0xd1, 0x61, 0x1e, 0xc5, 0x39, 0x3c, 0xda, 0x1b, 0x0d,
+	0x8c, 0xf2, 0x66, 0xee, 0x6e, 0xc5, 0x92, 0x1d, 0x9c, 0x43, 0x6c, 0xc3, 0x8e, 0x46, 0x41, 0x60,
+	0x6b, 0x5d, 0xaa, 0x62, 0x99, 0x96, 0xe0, 0x1c, 0x8c, 0x82, 0xa0, 0xab, 0xf4, 0xf8, 0x14, 0xea,
+	0x52, 0xda, 0xf3, 0x07, 0x94, 0x27, 0x06, 0x88, 0x83, 0xa8, 0x09, 0xda, 0x8e, 0x20, 0x09, 0x55,
+	0x69, 0x32, 0x8a, 0x8c, 0x9a, 0x52, 0x15, 0x3b, 0x64, 0x13, 0x4a, 0x47, 0x8c, 0x1d, 0x73, 0xa3,
+	0xbe, 0x99, 0xbb, 0x5b, 0x7b, 0x04, 0x62, 0x97, 0xdf, 0x20, 0xc5, 0x92, 0x8c, 0xf6, 0x97, 0x50,
+	0xd1, 0x47, 0x83, 0x47, 0x7a, 0x4c, 0x4f, 0xd5, 0x31, 0x63, 0x13, 0x67, 0x7d, 0xe3, 0x04, 0x23,
+	0xaa, 0xae, 0x48, 0x76, 0x7e, 0x96, 0xff, 0x2a, 0x67, 0xfe, 0x2a, 0x07, 0x25, 0x31, 0x11, 0x2a,
+	0xd7, 0xa3, 0x7d, 0x16, 0x53, 0xdb, 0x73, 0x92, 0xd1, 0xd0, 0xc8, 0x09, 0x05, 0x6a, 0x92, 0xb6,
+	0x83, 0x24, 0xb2, 0x01, 0x35, 0xa7, 0x9f, 0xd0, 0x58, 0x49, 0xc8, 0x3b, 0x03, 0x41, 0x92, 0x02,
+	0x37, 0xa0, 0xfa, 0x9a, 0xf5, 0x6c, 0x9e, 0x38, 0x71, 0x22, 0x2e, 0xb0, 0x6a, 0x55, 0x5e, 0xb3,
+	0x5e, 0x17, 0xfb, 0xe4, 0x1a, 0x94, 0x91, 0x49, 0x43, 0x4f, 0x5c, 0x56, 0xd5, 0x5a, 0x7a, 0xcd,
+	0x7a, 0xbb, 0xa1, 0x67, 0xb6, 0x61, 0x69, 0x77, 0x10, 0x53, 0xce, 0x51, 0xf3, 0x57, 0xd6, 0x0b,
+	0xad, 0xf9, 0x2b, 0xeb, 0x85, 0xf9, 0x2d, 0x94, 0xbf, 0xa7, 0x3d, 0xdc, 0x23, 0xb9, 0x0e, 0x85,
+	0x51, 0x1c, 0x48, 0xe6, 0x93, 0xf2, 0xfb, 0x77, 0x1b, 0x28, 0x60, 0x21, 0x8d, 0xdc, 0x81, 0x25,
+	0x9e, 0x38, 0x09, 0xe5, 0x42, 0xa7, 0xc6, 0xa3, 0x65, 0x71, 0x40, 0xcf, 0xc5, 0xca, 0x09, 0xb5,
+	0x14, 0xd3, 0xbc, 0x09, 0x85, 0xe7, 0xac, 0x47, 0xd6, 0x21, 0xef, 0x7b, 0x6a, 0x9e, 0xa5, 0xf7,
+	0xef, 0x36, 0xf2, 0x7b, 0x3b, 0x56, 0xde, 0xf7, 0xcc, 0x2e, 0x94, 0xbb, 0x34, 0x7e, 0xe3, 0xbb,
+	0x94, 0xdc, 0x86, 0x65, 0x3f, 0x4c, 0x68, 0x1c, 0x3a, 0x81, 0x1d, 0xb1, 0x38, 0x11, 0xd2, 0x25,
+	0xab, 0xae, 0x89, 0x07, 0x2c, 0x4e, 0x50, 0x88, 0xbe, 0xcd, 0x0a, 0xe5, 0xa5, 0x10, 0x7d, 0x3b,
+	0x16, 0x32, 0xff, 0x29, 0x07, 0xd5, 0xed, 0x84, 0x0d, 0xf7, 0xc2, 0x68, 0x34, 0xff, 0x11, 0x11,
+	0x28, 0xc6, 0x34, 0x62, 0xea, 0x6e, 0x44, 0x9b, 0xac, 0xc3, 0x52, 0x2f, 0x76, 0x42, 0xf7, 0xc8,
+	0x28, 0x08, 0xaa, 0xea, 0x21, 0xdd, 0x65, 0xc3, 0xa1, 0x9f, 0x18, 0x45, 0x49, 0x97, 0x3d, 0x9c,
+	0x63, 0x10, 0xb0, 0x9e, 0x51, 0x92, 0x73, 0x60, 0x1b, 0x69, 0x81, 0xf3, 0xc3, 0xa9, 0xb1, 0x24,
+	0x0c, 0x56, 0xb4, 0xf1, 0x06, 0xfb, 0x31, 0x1b, 0xda, 0x6a, 0x92, 0xb2, 0x10, 0x07, 0x24, 0x75,
+	0xe4, 0x44, 0x6b, 0x50, 0x12, 0xef, 0xd7, 0xa8, 0x48, 0x33, 0x17, 0x1d, 0x72, 0x1d, 0x2a, 0x83,
+	0x98, 0x8d, 0x22, 0xbb, 0x77, 0x6a, 0x54, 0xc5, 0x98, 0xb2, 0xe8, 0x3f, 0x39, 0x35, 0x7d, 0xa8,
+	0x3c, 0xf3, 0x93, 0xb3, 0x77, 0xa7, 0x6e, 0x2d, 0x3f, 0xe7, 0xd6, 0x16, 0x6c, 0x52, 0xbe, 0x24,
+	0xbd, 0x49, 0xd9, 0x33, 0xff, 0x28, 0x07, 0x25, 0xb9, 0x90, 0x09, 0x45, 0x27, 0x61, 0x43, 0xb1,
+	0x50, 0xed, 0x51, 0x43, 0xdc, 0x76, 0x7a, 0xc8, 0x96, 0xe0, 0xe1, 0x9b, 0x71, 0x63, 0xc6, 0xa5,
+	0x49, 0xe8, 0x37, 0x23, 0x05, 0x24, 0x03, 0x25, 0x46, 0xa1, 0xcf, 0x42, 0xa3, 0x30, 0x2b, 0x21,
+	0x18, 0x64, 0x03, 0x0a, 0x03, 0x75, 0xd6, 0x35, 0x65, 0x54, 0x7a, 0xb3, 0x16, 0x72, 0xcc, 0x63,
+	0xa8, 0x3c, 0x67, 0x3d, 0xa9, 0xd4, 0xed, 0xf4, 0x6e, 0xa4, 0x5a, 0xb5, 0x2d, 0x74, 0xa3, 0xf2,
+	0x5c, 0x67, 0x2e, 0x2a, 0x3f, 0xe7, 0xa2, 0x0a, 0x99, 0x8b, 0xd2, 0x47, 0x59, 0x1c, 0x1f, 0xa5,
+	0xf9, 0x77, 0x39, 0x68, 0x1e, 0x38, 0xb1, 0x13, 0x04, 0x34, 0xf0, 0xf9, 0xb0, 0x1b, 0x51, 0x97,
+	0xfc, 0x14, 0x2a, 0x3c, 0x89, 0x9d, 0x84, 0x0e, 0xe4, 0x83, 0x6f, 0x3c, 0xba, 0x29, 0xd4, 0x9c,
+	0x92, 0xdb, 0xea, 0x2a, 0x21, 0x2b, 0x15, 0x27, 0x6d, 0xa8, 0xb8, 0x2c, 0xe4, 0x89, 0x13, 0x4a,
+	0xcb, 0x2d, 0x5a, 0x69, 0x9f, 0x6c, 0x42, 0xcd, 0x65, 0xb4, 0xdf, 0xf7, 0x5d, 0x8c, 0x09, 0x42,
+	0xb3, 0x9c, 0x95, 0x25, 0x99, 0xf7, 0xa0, 0xa2, 0xe7, 0x24, 0x75, 0xa8, 0x74, 0x5e, 0xee, 0x77,
+	0x0f, 0xb7, 0xf7, 0x0f, 0x5b, 0x57, 0x48, 0x13, 0x6a, 0x9d, 0x97, 0xbb, 0x4f, 0x9f, 0xee, 0x75,
+	0xf6, 0x76, 0xf7, 0x0f, 0x5b, 0x39, 0xf3, 0x01, 0x94, 0xa4, 0x7b, 0x20, 0x50, 0x14, 0x81, 0x42,
+	0x6d, 0x0a, 0xdb, 0x48, 0x3b, 0x72, 0xf8, 0x91, 0xb0, 0xdc, 0xba, 0x25, 0xda, 0xe6, 0x1f, 0xe4,
+	0x60, 0x59, 0x8c, 0xf8, 0xce, 0x09, 0xfd, 0x3e, 0xba, 0xc5, 0xcf, 0xa0, 0x22, 0x7c, 0x8e, 0x9d,
+	0x3e, 0xdc, 0xda, 0xfb, 0x77, 0x1b, 0x65, 0x21, 0xb4, 0xb7, 0x63, 0x95, 0x05, 0x73, 0xcf, 0x23,
+	0x9b, 0x80, 0x4e, 0x05, 0xa5, 0xa4, 0xc1, 0x55, 0xdf, 0xbf, 0xdb, 0x28, 0xe1, 0x0d, 0xed, 0x58,
+	0xa5, 0xd7, 0xac, 0xb7, 0xe7, 0x91, 0x07, 0xb0, 0xe4, 0xe3, 0x75, 0xf1, 0x89, 0x00, 0x33, 0xb1,
+	0x9a, 0xbc, 0x5f, 0x25, 0x66, 0xfe, 0x69, 0x0e, 0xc8, 0x2c, 0xfb, 0x8c, 0x70, 0x58, 0xec, 0xfb,
+	0x81, 0xf4, 0xb2, 0xb5, 0x47, 0x55, 0x71, 0xff, 0x4f, 0xfd, 0x80, 0x5a, 0x82, 0x7c, 0xa6, 0xbd,
+	0xdf, 0x04, 0xe0, 0xfe, 0x0f, 0xd4, 0xee, 0x9d, 0xa2, 0x07, 0x2b, 0x8a, 0xab, 0xa8, 0x22, 0xe5,
+	0x09, 0x12, 0xd0, 0xa9, 0xca, 0xc7, 0x87, 0x4e, 0x5d, 0x3e, 0x70, 0xf9, 0x1a, 0xbf, 0xa5, 0xa7,
+	0xe6, 0xdf, 0xe6, 0xa0, 0xfe, 0x3d, 0x8b, 0x8f, 0x69, 0x8c, 0xae, 0x6e, 0xc4, 0xc9, 0x3d, 0xa8,
+	0x9e, 0x88, 0xfe, 0xf8, 0xa8, 0xea, 0xef, 0xdf, 0x6d, 0x54, 0xa4, 0xd0, 0xde, 0x8e, 0x55, 0x91,
+	0xec, 0x0b, 0x1d, 0xd6, 0x2d, 0x28, 0x7a, 0x4e, 0xe2, 0x4c, 0x3c, 0x10, 0x71, 0x16, 0x96, 0xa0,
+	0x93, 0x1f, 0x43, 0x59, 0xf8, 0x7a, 0xea, 0xa9, 0x37, 0xd2, 0xde, 0x92, 0xb9, 0xc7, 0x96, 0xce,
+	0x3d, 0xb6, 0x0e, 0x75, 0x72, 0x62, 0x69, 0x51, 0xf3, 0xaf, 0x73, 0x50, 0x95, 0xea, 0x1c, 0x30,
+	0xef, 0x2c, 0x97, 0x18, 0x62, 0x30, 0x56, 0xaf, 0x24, 0x54, 0x01, 0x38, 0x3a, 0x72, 0x38, 0x55,
+	0x87, 0x27, 0x3b, 0x78, 0xa6, 0x31, 0x75, 0x38, 0x0b, 0xb5, 0xaf, 0x90, 0x3d, 0x62, 0x40, 0x79,
+	0x48, 0x39, 0xc7, 0x74, 0x43, 0x1e, 0x99, 0xee, 0xa2, 0xd9, 0xc7, 0x54, 0xa8, 0xc2, 0x85, 0x6b,
+	0x2c, 0x59, 0x69, 0x1f, 0xd7, 0xfd, 0x81, 0x85, 0x54, 0xf9, 0x45, 0xd1, 0xc6, 0x13, 0xae, 0x1c,
+	0x30, 0x6f, 0xf7, 0x0d, 0x0d, 0x13, 0x0c, 0x50, 0x11, 0xf3, 0x74, 0x80, 0x8a, 0xa4, 0xfa, 0xc9,
+	0x69, 0x94, 0xaa, 0x8a, 0xed, 0x8c, 0x52, 0x85, 0xb3, 0x94, 0x2a, 0x4e, 0x2a, 0xb5, 0x06, 0x25,
+	0x57, 0xb8, 0xdd, 0x92, 0xd0, 0x48, 0x76, 0xc8, 0x4f, 0xa0, 0x1a, 0x38, 0x3c, 0xb1, 0x39, 0xa5,
+	0xa1, 0xb1, 0x74, 0xee, 0x01, 0x57, 0x50, 0xb8, 0x4b, 0x69, 0x68, 0x3e, 0x87, 0xba, 0x45, 0x39,
+	0x1b, 0xc5, 0x2e, 0x15, 0x5e, 0x02, 0x93, 0xac, 0x68, 0x24, 0xd4, 0xce, 0x5b, 0xd8, 0x44, 0x15,
+	0x87, 0x74, 0xc8, 0xe2, 0x53, 0xa5, 0xb8, 0xea, 0xa1, 0xe4, 0x20, 0x1a, 0x09, 0xbd, 0x0b, 0x16,
+	0x36, 0xcd, 0xff, 0xaa, 0x41, 0x59, 0xf8, 0xb8, 0x3e, 0x23, 0x6d, 0x28, 0xbc, 0x66, 0x3d, 0xe5,
+	0xdf, 0x2a, 0x3a, 0xc8, 0x5a, 0x48, 0x24, 0x5f, 0x40, 0x35, 0xd1, 0x69, 0x9a, 0x91, 0xcf, 0x38,
+	0xe6, 0x34, 0x79, 0xb3, 0xc6, 0x02, 0xe4, 0x1e, 0x54, 0x22, 0x3f, 0xa2, 0x81, 0x1f, 0xca, 0x0b,
+	0xd5, 0xee, 0xf5, 0x40, 0x11, 0xad, 0x94, 0x8d, 0xc1, 0x5d, 0xbd, 0xd8, 0xd2, 0x66, 0x21, 0x15,
+	0xd4, 0x6e, 0x57, 0xbf, 0x53, 0xf2, 0x39, 0x40, 0xe4, 0xc4, 0x34, 0x4c, 0x6c, 0x54, 0x71, 0x69,
+	0x4a, 0xc5, 0xaa, 0xe4, 0x61, 0xf8, 0xcf, 0x18, 0x6d, 0xf9, 0xc2, 0x46, 0x4b, 0xbe, 0x84, 0x4a,
+	0xdf, 0x0f, 0x7d, 0x7e, 0x44, 0x3d, 0xa3, 0x72, 0xee, 0xb0, 0x54, 0x96, 0x3c, 0x84, 0x65, 0x36,
+	0x4a, 0xa2, 0x51, 0xa2, 0x63, 0x6e, 0x75, 0x36, 0x38, 0xd4, 0xa5, 0x84, 0xec, 0x91, 0xdb, 0x98,
+	0xad, 0x3a, 0x09, 0x15, 0xe9, 0xe1, 0x4c, 0x2e, 0x23, 0x79, 0xe4, 0x6b, 0x68, 0x45, 0x63, 0x17,
+	0x6f, 0xf3, 0x88, 0xba, 0x2a, 0x39, 0x5c, 0x9b, 0xe7, 0xff, 0xad, 0x66, 0x34, 0x49, 0x20, 0xf7,
+	0xa0, 0xa5, 0x4f, 0xd8, 0x7e, 0x43, 0x63, 0x8e, 0x71, 0x70, 0x59, 0xb8, 0x9e, 0xa6, 0xa6, 0xff,
+	0xb6, 0x24, 0x93, 0xcf, 0x30, 0xcb, 0x16, 0x79, 0x91, 0xd1, 0x10, 0x4b, 0xd4, 0x55, 0x96, 0x2d,
+	0x68, 0x96, 0x66, 0x62, 0x00, 0xa4, 0x22, 0x8f, 0x33, 0x9a, 0x7a, 0x8f, 0x11, 0xdf, 0x92, 0xa9,
+	0x9d, 0xa5, 0x58, 0x98, 0x34, 0xa9, 0xf3, 0x50, 0xbe, 0x70, 0x45, 0xd8, 0x9f, 0x3a, 0x82, 0x27,
+	0x82, 0x46, 0xee, 0x43, 0x4d, 0x09, 0x89, 0xcc, 0x88, 0x64, 0xfc, 0xa9, 0x45, 0x23, 0x66, 0x81,
+	0xe4, 0x62, 0x9b, 0x3c, 0x80, 0x5a, 0xba, 0x11, 0xdf, 0x33, 0x56, 0x85, 0x2b, 0x6b, 0xbc, 0x7f,
+	0xb7, 0x01, 0xda, 0x96, 0xf6, 0x76, 0x2c, 0xd0, 0x22, 0x7b, 0x1e, 0xbe, 0x42, 0xf5, 0xe0, 0x8d,
+	0x35, 0xb1, 0x61, 0xdd, 0x25, 0x77, 0xa0, 0x81, 0x6e, 0xcd, 0x8e, 0x62, 0xe6, 0x52, 0xce, 0xa9,
+	0x67, 0xac, 0x8b, 0x77, 0xb0, 0x8c, 0xd4, 0x03, 0x4d, 0x44, 0x7f, 0x2d, 0xc4, 0x12, 0x96, 0x38,
+	0x81, 0x71, 0x4d, 0x88, 0x54, 0x91, 0x72, 0x88, 0x04, 0xf2, 0x25, 0x2c, 0x2b, 0x0f, 0xcc, 0x85,
+	0x4b, 0x36, 0x0c, 0x61, 0xb6, 0x2b, 0xe2, 0x34, 0xb2, 0xbe, 0xda, 0xaa, 0x9f, 0x64, 0x7a, 0x38,
+	0x2e, 0x56, 0x8f, 0x56, 0xde, 0xe7, 0xf5, 0xcd, 0x5c, 0x3a, 0x2e, 0xfb, 0x9c, 0xad, 0x7a, 0x9c,
+	0xe9, 0x61, 0x1a, 0x23, 0x9e, 0x80, 0xd1, 0xce, 0x80, 0x03, 0x95, 0xc6, 0x08, 0x06, 0xb9, 0x0f,
+	0x10, 0xd2, 0x13, 0x7d, 0xe0, 0x37, 0x32, 0x06, 0x28, 0xcf, 0xdb, 0xaa, 0x86, 0xf4, 0x44, 0x36,
+	0x31, 0xf2, 0xfb, 0xa1, 0x1b, 0xd3, 0x21, 0x0d, 0x71, 0x77, 0x9f, 0x88, 0x9c, 0x24, 0x4b, 0xc2,
+	0x03, 0x57, 0xfb, 0x8b, 0x98, 0xc7, 0x8d, 0x9b, 0x9b, 0x85, 0xf4, 0xa9, 0xa7, 0x5e, 0xdd, 0x82,
+	0x13, 0xdd, 0xe4, 0xe4, 0x0b, 0x80, 0x88, 0x79, 0x36, 0x45, 0x0f, 0xca, 0x8d, 0x5b, 0x99, 0x47,
+	0xac, 0xfd, 0xaa, 0x55, 0x8d, 0x54, 0x8b, 0x93, 0xbb, 0x50, 0x39, 0x91, 0x19, 0x3f, 0x37, 0x36,
+	0x36, 0x0b, 0xa9, 0xb9, 0x29, 0x18, 0x60, 0xa5, 0x5c, 0x44, 0x2c, 0xe2, 0x1e, 0xf8, 0xb1, 0x1f,
+	0x45, 0xd4, 0x33, 0x36, 0xc5, 0x4d, 0xd4, 0x90, 0xd6, 0x95, 0x24, 0xb2, 0x09, 0x45, 0x97, 0xf1,
+	0xc4, 0xf8, 0x34, 0x63, 0xb7, 0xcf, 0x59, 0xaf, 0xc3, 0x78, 0x62, 0x09, 0x0e, 0xd9, 0x05, 0x83,
+	0x53, 0x97, 0x85, 0x9e, 0x13, 0x9f, 0xda, 0x13, 0x2f, 0x95, 0x1b, 0xe6, 0x66, 0x61, 0xfa, 0xa9,
+	0xae, 0xa7, 0xc2, 0x2f, 0x33, 0x6f, 0x16, 0x2f, 0xaf, 0x25, 0x13, 0x14, 0xf7, 0x88, 0xba, 0xc7,
+	0x11, 0xf3, 0xc3, 0xc4, 0xb8, 0x9d, 0x39, 0xe8, 0x97, 0xbd, 0xd7, 0xd4, 0x4d, 0xac, 0xa6, 0x10,
+	0xea, 0xa4, 0x32, 0x99, 0x50, 0xf1, 0xa3, 0x89, 0x50, 0xf1, 0x15, 0x80, 0x80, 0x7e, 0x36, 0x82,
+	0x7b, 0xe3, 0x8e, 0x98, 0xe9, 0xfa, 0x8c, 0xc3, 0xd9, 0x51, 0xc0, 0xde, 0xaa, 0x0a, 0x61, 0xf4,
+	0x3f, 0xc2, 0x88, 0xd9, 0x49, 0x18, 0x30, 0xc7, 0x53, 0x19, 0xc5, 0x67, 0xca, 0x88, 0x15, 0x55,
+	0x66, 0x15, 0x9f, 0x42, 0x7d, 0x14, 0x65, 0x84, 0x3e, 0x97, 0x87, 0x37, 0x8a, 0x52, 0x91, 0xe7,
+	0xc5, 0x4a, 0xb1, 0x55, 0x32, 0xff, 0x2c, 0x0f, 0x65, 0x75, 0x64, 0x68, 0xf9, 0x18, 0x8b, 0x6d,
+	0x8c, 0x72, 0x5c, 0x21, 0xc4, 0x2a, 0x52, 0x0e, 0x91, 0x80, 0xe8, 0xc2, 0x8d, 0x46, 0xb6, 0x3c,
+	0x22, 0x2e, 0x82, 0x40, 0xce, 0x02, 0x37, 0x1a, 0x75, 0x25, 0x85, 0x6c, 0xc1, 0xaa, 0x8c, 0x33,
+	0x62, 0xd1, 0x54, 0x50, 0xa6, 0x97, 0x2b, 0x92, 0x85, 0x6b, 0x6b, 0xf9, 0xfb, 0xb0, 0x12, 0x51,
+	0xe7, 0xd8, 0xce, 0x0c, 0xd2, 0x09, 0x52, 0x13, 0x19, 0xdf, 0xa5, 0x23, 0x38, 0x3e, 0x6b, 0xee,
+	0x0c, 0xa3, 0x80, 0x72, 0x11, 0x44, 0x8b, 0x96, 0xee, 0xe2, 0x2c, 0xee, 0x28, 0x16, 0xa1, 0x01,
+	0xd5, 0x73, 0x59, 0x4c, 0x65, 0xe8, 0xcf, 0x59, 0x4d, 0xc5, 0xe8, 0x44, 0xa3, 0x0e, 0x92, 0xc9,
+	0x43, 0x58, 0xd3, 0xb2, 0x13, 0x8b, 0x96, 0xc5, 0x94, 0x44, 0xf1, 0x32, 0xeb, 0x9a, 0x3b, 0xb0,
+	0x24, 0xcd, 0x7e, 0x6e, 0x26, 0xf3, 0x99, 0x76, 0xe6, 0x79, 0xe1, 0xcc, 0x5b, 0x53, 0x4e, 0x40,
+	0xfb, 0x73, 0xf3, 0xb1, 0x02, 0x12, 0x7d, 0x86, 0x91, 0xac, 0x22, 0xf2, 0xb2, 0xb0, 0xcf, 0xc4,
+	0x19, 0x67, 0x0c, 0x17, 0x05, 0xac, 0xf2, 0x6b, 0xd9, 0x30, 0x6f, 0x41, 0x45, 0xfb, 0xb8, 0x79,
+	0x8b, 0x9b, 0x7f, 0x91, 0x83, 0xe5, 0xd4, 0x09, 0x0a, 0x4f, 0x70, 0x53, 0x61, 0xcd, 0xdc, 0xb4,
+	0x47, 0x9d, 0x86, 0x9d, 0xf9, 0x89, 0x0c, 0x55, 0xa3, 0x96, 0xc2, 0x1c, 0xd4, 0x52, 0x9c, 0x83,
+	0x5a, 0x4a, 0x99, 0x13, 0xd8, 0x80, 0x22, 0xe2, 0x4b, 0x63, 0x29, 0xf3, 0x1a, 0xd4, 0x63, 0x12,
+	0x0c, 0xf3, 0xaf, 0xea, 0x50, 0x1f, 0x6b, 0xd9, 0x67, 0x13, 0xb9, 0x41, 0x6e, 0x71, 0x6e, 0x70,
+	0xb9, 0xa4, 0xe3, 0x7e, 0x9a, 0x49, 0xc8, 0x6a, 0x11, 0x99, 0x98, 0x76, 0x32, 0x9d, 0xf8, 0x29,
+	0x80, 0x1b, 0x53, 0x27, 0xa1, 0x9e, 0xed, 0x24, 0x17, 0x48, 0xbe, 0xaa, 0x4a, 0x7a, 0x3b, 0x21,
+	0x77, 0xf5, 0x9d, 0x97, 0xc5, 0x9d, 0x4f, 0xae, 0x32, 0x11, 0xc5, 0x3f, 0x85, 0x7a, 0x4c, 0x5d,
+	0x34, 0x36, 0x1a, 0xc7, 0x2c, 0x16, 0x89, 0x45, 0xd5, 0xaa, 0x49, 0xda, 0x2e, 0x92, 0xc8, 0xd7,
+	0x00, 0x68, 0x0c, 0x22, 0x21, 0x94, 0x95, 0xa5, 0xda, 0xa3, 0xcd, 0x29, 0xbd, 0xfb, 0x4c, 0x3a,
+	0x35, 0x14, 0x91, 0xd5, 0xb1, 0xea, 0x6b, 0xdd, 0x9f, 0x9b, 0x29, 0xc0, 0x65, 0x32, 0x05, 0x03,
+	0xca, 0x3a, 0x41, 0xa8, 0xc9, 0x87, 0xa5, 0xba, 0x1f, 0x18, 0xf0, 0x5b, 0x73, 0x02, 0xbe, 0x2c,
+	0xc9, 0xac, 0x4c, 0x97, 0x64, 0xc8, 0xb7, 0xb0, 0xc6, 0x5d, 0x27, 0xa0, 0x36, 0x3a, 0x2f, 0x3b,
+	0x39, 0x8a, 0x29, 0x3f, 0x62, 0x81, 0x67, 0x90, 0xf3, 0x1c, 0x22, 0x11, 0xc3, 0x76, 0xd8, 0x49,
+	0x78, 0xa8, 0x07, 0xcd, 0x06, 0xd8, 0xd5, 0x4b, 0x06, 0xd8, 0xb5, 0xb3, 0x02, 0xec, 0x26, 0xd4,
+	0x3c, 0xca, 0xdd, 0xd8, 0x8f, 0x70, 0x71, 0xe3, 0xaa, 0xbc, 0xc6, 0x0c, 0x69, 0x3a, 0xac, 0xae,
+	0xcf, 0x86, 0xd5, 0x6c, 0xdc, 0xbb, 0xb6, 0x30, 0xee, 0x21, 0x5e, 0x7c, 0x6c, 0x0f, 0x9c, 0x84,
+	0x9e, 0x38, 0xa7, 0x86, 0x21, 0xa6, 0xaa, 0xf2, 0xc7, 0xcf, 0x24, 0x01, 0xd9, 0xae, 0xe3, 0x1e,
+	0x51, 0x1b, 0x21, 0xa4, 0x48, 0x22, 0xaa, 0x56, 0x55, 0x50, 0xba, 0xfe, 0x0f, 0xe8, 0x91, 0x9a,
+	0x9e, 0xcf, 0x8f, 0xed, 0x8c, 0x4c, 0x5b, 0xc8, 0x2c, 0x23, 0xb9, 0x93, 0xca, 0xfd, 0x3f, 0x58,
+	0x51, 0x11, 0x8d, 0x85, 0xd2, 0xed, 0xb9, 0xa7, 0x22, 0x77, 0x28, 0x58, 0x32, 0xd4, 0x75, 0xc6,
+	0x74, 0xf2, 0xb5, 0x0c, 0xf1, 0x81, 0xd3, 0xa3, 0x01, 0x37, 0x3e, 0x39, 0xcb, 0x4a, 0x0f, 0x98,
+	0xf7, 0x42, 0x88, 0x28, 0x2b, 0x8d, 0x74, 0x9f, 0xec, 0x43, 0x13, 0x27, 0x70, 0xc2, 0x90, 0x25,
+	0xe2, 0x06, 0x75, 0x62, 0x71, 0x67, 0xee, 0x2c, 0xdb, 0x63, 0x39, 0x39, 0x55, 0x23, 0x9a, 0x20,
+	0x92, 0x6d, 0x58, 0x99, 0x0e, 0xeb, 0x3a, 0xf5, 0x58, 0xd3, 0x35, 0xe2, 0x6c, 0x1c, 0xb7, 0x5a,
+	0x53, 0x81, 0x1d, 0x23, 0x64, 0x31, 0x60, 0x03, 0x4c, 0x42, 0xc6, 0x2e, 0xe8, 0x05, 0x1b, 0x70,
+	0x61, 0x21, 0x82, 0x45, 0x1e, 0x03, 0x70, 0xf7, 0x88, 0x7a, 0xa3, 0xc0, 0x0f, 0x07, 0x22, 0xff,
+	0xa8, 0x3d, 0x5a, 0x95, 0xd3, 0xa7, 0x64, 0x21, 0x9e, 0x11, 0x23, 0x9f, 0x43, 0x53, 0x65, 0xcc,
+	0xb6, 0xe3, 0x4a, 0xd4, 0xf7, 0xa9, 0xb8, 0x80, 0x86, 0x22, 0x6f, 0x4b, 0x2a, 0x5a, 0x04, 0xf7,
+	0x3d, 0xea, 0x3a, 0xb1, 0x4e, 0x45, 0x54, 0xe2, 0x2d, 0x89, 0x56, 0xca, 0xc5, 0x37, 0x16, 0x8f,
+	0x42, 0x4c, 0x15, 0x6c, 0x37, 0x70, 0x38, 0x17, 0xa9, 0x47, 0xd5, 0xaa, 0x2b, 0x62, 0x07, 0x69,
+	0xed, 0x9f, 0x43, 0x63, 0xd2, 0x4b, 0x64, 0x0b, 0xc5, 0xa5, 0x39, 0x85, 0xe2, 0x52, 0xa6, 0x50,
+	0x8c, 0xa3, 0x27, 0x6f, 0xef, 0x32, 0x65, 0xe6, 0xf6, 0x36, 0xac, 0xce, 0xb9, 0xb5, 0xcb, 0x4c,
+	0xf1, 0xbc, 0x58, 0x29, 0xb4, 0x8a, 0xe6, 0xb3, 0x6c, 0x44, 0xc3, 0x60, 0xf9, 0x25, 0x2c, 0x8f,
+	0xd3, 0xff, 0x71, 0xc4, 0x5c, 0x99, 0x31, 0x1b, 0xab, 0x1e, 0x65, 0x7a, 0xe6, 0xbf, 0x17, 0xa1,
+	0xd5, 0x11, 0x2e, 0x1b, 0xe1, 0x21, 0xfd, 0xdd, 0x11, 0xe5, 0xc9, 0x64, 0x38, 0xc9, 0x5d, 0x06,
+	0xc3, 0xe6, 0x2f, 0x8a, 0x61, 0x8b, 0x8b, 0x30, 0xec, 0x3c, 0x5f, 0x5d, 0xbe, 0x8c, 0xaf, 0xce,
+	0x40, 0xb5, 0xca, 0xc5, 0xa0, 0x5a, 0xf5, 0x6c, 0xcf, 0x3d, 0x0f, 0x22, 0xc2, 0x7c, 0x88, 0x38,
+	0xe3, 0xe4, 0x6b, 0xe7, 0xa3, 0xba, 0xfa, 0x22, 0x54, 0x37, 0x89, 0xe6, 0x97, 0xcf, 0x46, 0xf3,
+	0x33, 0x4e, 0xbd, 0x71, 0x49, 0xa7, 0xde, 0xbc, 0x18, 0x6a, 0x6a, 0x5d, 0x06, 0x35, 0xad, 0xcc,
+	0xb8, 0x77, 0x65, 0xbe, 0x07, 0xb0, 0xb2, 0x17, 0xa2, 0x9a, 0x49, 0xc6, 0xea, 0x16, 0x55, 0x55,
+	0x36, 0xa0, 0xd6, 0x0b, 0x98, 0x7b, 0x6c, 0x8f, 0xb3, 0xc8, 0x8a, 0x05, 0x82, 0x24, 0x32, 0x09,
+	0xf3, 0x18, 0x1a, 0x2f, 0x7c, 0x9e, 0x9d, 0xee, 0x12, 0xe9, 0xd3, 0x16, 0xd4, 0xfd, 0x70, 0x8c,
+	0x78, 0x54, 0xa9, 0x7c, 0x22, 0x47, 0xab, 0x09, 0x01, 0xd9, 0x31, 0x5f, 0x43, 0xf3, 0x69, 0x30,
+	0xe2, 0x47, 0x99, 0xd5, 0xee, 0x40, 0x59, 0xc3, 0xa5, 0xdc, 0xec, 0x68, 0xcd, 0x23, 0x0f, 0xa1,
+	0x9e, 0x30, 0x5b, 0x2f, 0xac, 0x8b, 0xf2, 0x53, 0x8a, 0xd5, 0x12, 0xa6, 0xdb, 0xdc, 0x3c, 0x86,
+	0xd5, 0xee, 0xa8, 0x87, 0x11, 0xb4, 0x47, 0x3f, 0x6c, 0x77, 0xf7, 0xa0, 0xe5, 0x87, 0x6e, 0x30,
+	0xf2, 0xa8, 0x4d, 0xdf, 0xfa, 0x3c, 0x41, 0x1f, 0x2d, 0x0f, 0xb0, 0xa9, 0xe8, 0xbb, 0x8a, 0x6c,
+	0x6e, 0x41, 0x6b, 0x87, 0x06, 0x34, 0xa1, 0x17, 0xbb, 0x16, 0xf3, 0x0b, 0x68, 0x74, 0x13, 0x16,
+	0x5d, 0x50, 0xfa, 0x8f, 0x73, 0xd0, 0x78, 0x46, 0x13, 0x0c, 0x1e, 0x17, 0xb9, 0xf3, 0x4b, 0xf8,
+	0x15, 0x0d, 0x81, 0xfb, 0x7e, 0x90, 0xd0, 0x98, 0xab, 0x6f, 0x6e, 0x02, 0x02, 0x3f, 0x95, 0x24,
+	0xcc, 0xe9, 0xfb, 0x2c, 0x08, 0xd8, 0x89, 0xca, 0xd4, 0x55, 0xcf, 0xfc, 0xcb, 0x3c, 0xc0, 0x0b,
+	0x36, 0xf8, 0x4e, 0x55, 0x20, 0x6f, 0x67, 0xfc, 0x68, 0x06, 0x48, 0xa4, 0x4e, 0x73, 0x1f, 0x73,
+	0xf9, 0xa9, 0x5a, 0x4b, 0xfe, 0xdc, 0x5a, 0xcb, 0xb8, 0xc4, 0x5c, 0x38, 0xa7, 0xc4, 0x5c, 0x3c,
+	0xa3, 0xc4, 0x7c, 0x1f, 0xf2, 0x89, 0x44, 0x74, 0x8b, 0xf3, 0xef, 0x7c, 0xc2, 0xb3, 0xf5, 0xd5,
+	0xa5, 0xc9, 0xfa, 0xea, 0x44, 0x55, 0xbc, 0xbc, 0xb0, 0x2a, 0x4e, 0xa0, 0x38, 0xe2, 0x34, 0x56,
+	0x1f, 0xc0, 0x44, 0xdb, 0x3c, 0x84, 0x55, 0x4b, 0xd6, 0x88, 0xa4, 0x6a, 0x17, 0xb8, 0xc4, 0xe9,
+	0x9b, 0xc9, 0xcf, 0xdc, 0x8c, 0xf9, 0x25, 0x5c, 0xc5, 0xaf, 0x03, 0x07, 0x31, 0x7b, 0x43, 0x43,
+	0x27, 0x74, 0xa9, 0x9e, 0x57, 0x7f, 0x47, 0xc8, 0xcd, 0xfd, 0x8e, 0x60, 0x8e, 0xa0, 0x29, 0xd4,
+	0x18, 0x0f, 0x3c, 0x47, 0x13, 0x1d, 0x7b, 0xe4, 0xa3, 0xcb, 0xcc, 0xa7, 0x18, 0xe4, 0x36, 0x94,
+	0x75, 0x8e, 0x54, 0x98, 0x96, 0xd1, 0x1c, 0xf3, 0xf7, 0x73, 0xb0, 0x3e, 0xad, 0x2f, 0x8f, 0x58,
+	0xc8, 0x29, 0x79, 0x08, 0x95, 0x51, 0xc4, 0x93, 0x98, 0x3a, 0x43, 0xe5, 0x05, 0xd6, 0xc6, 0x17,
+	0x99, 0x91, 0x4f, 0xa5, 0xc8, 0x8f, 0x01, 0x30, 0xa5, 0x57, 0x63, 0xf2, 0x0b, 0xc6, 0x64, 0xe4,
+	0xcc, 0x7f, 0x03, 0xb8, 0x2a, 0x83, 0x76, 0xfa, 0x16, 0x2e, 0xef, 0x16, 0xfe, 0xf7, 0x30, 0xe3,
+	0x3a, 0x2c, 0x8d, 0x22, 0x0f, 0xfd, 0x74, 0x49, 0x3e, 0x35, 0xd9, 0xfb, 0xf8, 0xb0, 0x7e, 0xa1,
+	0x70, 0x3d, 0x13, 0x83, 0x61, 0x4e, 0x0c, 0x3e, 0x0b, 0x50, 0xd5, 0xfe, 0x47, 0x00, 0x55, 0xfd,
+	0x92, 0xb1, 0x77, 0xf9, 0x82, 0x80, 0xaa, 0x71, 0x2e, 0xa0, 0x6a, 0x2e, 0x06, 0x54, 0xad, 0x4b,
+	0x00, 0xaa, 0x95, 0xc5, 0x80, 0x8a, 0x5c, 0x00, 0x50, 0xad, 0x5e, 0x18, 0x50, 0xad, 0x9d, 0x01,
+	0xa8, 0xbe, 0x99, 0x00, 0x54, 0x57, 0x85, 0xfa, 0xf7, 0x84, 0xfa, 0x73, 0xed, 0x7f, 0x01, 0xb2,
+	0xfa, 0x7e, 0x16, 0x59, 0xad, 0x8b, 0xe9, 0xb6, 0x16, 0x4f, 0xf7, 0x61, 0x10, 0xeb, 0xda, 0xa5,
+	0x20, 0xd6, 0x0d, 0xa8, 0x46, 0x7e, 0x68, 0xcb, 0x9f, 0x05, 0x49, 0x20, 0x5b, 0x89, 0xfc, 0x70,
+	0x0f, 0xfb, 0x29, 0xfe, 0xba, 0x7e, 0x51, 0xfc, 0xd5, 0xbe, 0x18, 0xfe, 0xda, 0x82, 0x55, 0xac,
+	0x18, 0xdb, 0xae, 0x13, 0x39, 0xae, 0x9f, 0x9c, 0xca, 0x92, 0xad, 0x80, 0xb6, 0x15, 0x6b, 0x05,
+	0x59, 0x1d, 0xc5, 0x11, 0x75, 0xda, 0x79, 0x78, 0xed, 0x93, 0x73, 0xf1, 0xda, 0xcd, 0xcb, 0xe1,
+	0xb5, 0x5b, 0xf3, 0xf1, 0xda, 0xff, 0x05, 0xc4, 0xf5, 0x5b, 0xd0, 0x9c, 0xba, 0xc8, 0x8f, 0xfd,
+	0x15, 0x0b, 0x7e, 0xdf, 0xaf, 0xe8, 0x9b, 0xcc, 0x08, 0xe5, 0xb2, 0x42, 0xe4, 0xff, 0xc3, 0xea,
+	0xd0, 0x79, 0x2b, 0xcb, 0xaf, 0x76, 0x94, 0xf9, 0xd1, 0x11, 0x0a, 0xb5, 0x86, 0xce, 0x5b, 0x51,
+	0x7e, 0x3d, 0xd0, 0x3f, 0x3d, 0xfa, 0x09, 0x54, 0x63, 0x9a, 0xd0, 0x30, 0xf1, 0xd5, 0x67, 0xd7,
+	0xc5, 0xf5, 0xf2, 0x54, 0xd6, 0xfc, 0x8f, 0x1c, 0x34, 0x26, 0xad, 0x85, 0x3c, 0x87, 0x65, 0x51,
+	0xe6, 0xe6, 0x34, 0xa0, 0x6e, 0xc2, 0x62, 0x23, 0x97, 0x29, 0x45, 0x4c, 0xca, 0x6e, 0xed, 0x33,
+	0x8f, 0x76, 0x95, 0x9c, 0x7c, 0x27, 0xf5, 0x30, 0x43, 0x22, 0xbf, 0x06, 0xb5, 0x84, 0x05, 0x34,
+	0x56, 0x4f, 0x4f, 0x46, 0xba, 0xa6, 0x8c, 0x37, 0x29, 0xdd, 0xca, 0xca, 0x60, 0x05, 0x3f, 0x8a,
+	0x69, 0x9f, 0xc6, 0x31, 0xf5, 0x6c, 0xf1, 0x3d, 0x5a, 0x1e, 0xdf, 0x72, 0x4a, 0xfd, 0x25, 0x0b,
+	0x69, 0xfb, 0x6b, 0x58, 0x99, 0x59, 0xfc, 0x52, 0xbf, 0xfd, 0x7a, 0x97, 0x83, 0xb2, 0xb2, 0xcd,
+	0xb9, 0x57, 0x9a, 0xfe, 0x60, 0x2f, 0x3f, 0xe7, 0x07, 0x7b, 0x85, 0xf1, 0x0f, 0xf6, 0x3e, 0x97,
+	0x3f, 0xd8, 0x93, 0xf1, 0xf1, 0x6a, 0xd6, 0xe4, 0xa7, 0x7e, 0xae, 0x37, 0x13, 0x2f, 0x4a, 0x17,
+	0x8a, 0x17, 0x1f, 0xfc, 0xe3, 0xb6, 0x23, 0x80, 0xf1, 0x19, 0xcf, 0x19, 0xd9, 0x86, 0x0a, 0x8b,
+	0x90, 0xcd, 0x62, 0x35, 0x38, 0xed, 0x8f, 0x67, 0x2d, 0x64, 0x66, 0x45, 0x63, 0xa5, 0xfd, 0x3e,
+	0x75, 0xd3, 0x9f, 0x26, 0xc9, 0x9e, 0xf9, 0x3b, 0xb0, 0xae, 0x70, 0xdd, 0x47, 0x24, 0x26, 0x99,
+	0x42, 0x6b, 0x7e, 0xa2, 0xd0, 0x6a, 0x3e, 0x80, 0x55, 0x04, 0x79, 0xd3, 0x73, 0x1b, 0x50, 0x8e,
+	0x62, 0x86, 0x1f, 0x96, 0xd4, 0xae, 0x74, 0xd7, 0xfc, 0x9b, 0x1c, 0x5c, 0x95, 0x80, 0xe6, 0x23,
+	0xf4, 0xd9, 0xc0, 0x20, 0x8c, 0x73, 0x20, 0x06, 0xe7, 0x1a, 0x7b, 0x7a, 0x1a, 0x27, 0xf1, 0x8c,
+	0x80, 0x78, 0xfa, 0x85, 0xac, 0x80, 0x40, 0xf1, 0x2d, 0x28, 0x38, 0x41, 0xa0, 0x80, 0x07, 0x36,
+	0x51, 0x65, 0xd7, 0xe1, 0xae, 0xe3, 0xe9, 0x1c, 0x49, 0x77, 0xcd, 0x6d, 0x58, 0x13, 0xbf, 0x13,
+	0xfc, 0x70, 0x85, 0xcd, 0x5f, 0xc0, 0x2a, 0xa2, 0xb2, 0x8f, 0x98, 0xe1, 0x0f, 0x73, 0xb0, 0x66,
+	0xd1, 0x78, 0x14, 0x7e, 0xc4, 0xb1, 0xdd, 0x81, 0x32, 0x7d, 0x2b, 0xe0, 0xe5, 0x3c, 0x3c, 0xad,
+	0x79, 0x28, 0xa6, 0x50, 0xa8, 0x51, 0x98, 0x23, 0xa6, 0x78, 0xe6, 0x35, 0xb8, 0xfa, 0xcc, 0x89,
+	0x7b, 0xce, 0x80, 0x76, 0x58, 0x80, 0x2f, 0x5d, 0x69, 0x64, 0x1a, 0xb0, 0x3e, 0xcd, 0x90, 0xd9,
+	0xb8, 0xf9, 0x0b, 0xa8, 0xbf, 0x42, 0xd4, 0xa3, 0x75, 0x7f, 0x08, 0x25, 0xee, 0x87, 0xae, 0x56,
+	0x7c, 0x11, 0x8a, 0x92, 0x82, 0xe6, 0x1e, 0x54, 0xf1, 0xfe, 0xc4, 0x2c, 0xe7, 0x7d, 0x33, 0x9a,
+	0xfc, 0xf5, 0x52, 0x7e, 0xea, 0xd7, 0x4b, 0xe6, 0x7f, 0xe6, 0xc7, 0x15, 0xbb, 0x57, 0x0a, 0x8b,
+	0x5d, 0xf8, 0x28, 0x09, 0x14, 0x53, 0xd3, 0x2b, 0x5a, 0xa2, 0x2d, 0x72, 0x06, 0xe6, 0xd9, 0x47,
+	0x6c, 0x14, 0xeb, 0x2f, 0x87, 0x95, 0x88, 0x79, 0xdf, 0x60, 0x1f, 0x99, 0xf8, 0x89, 0x4f, 0x32,
+	0x8b, 0x92, 0xe9, 0x46, 0x23, 0xc9, 0x9c, 0xfd, 0xbc, 0x5f, 0x9a, 0xf7, 0x79, 0xff, 0x3e, 0xac,
+	0xa8, 0x3c, 0x3a, 0xb3, 0xaf, 0x25, 0x59, 0xf7, 0x92, 0x8c, 0xae, 0xde, 0x1d, 0xb9, 0x0b, 0xad,
+	0x13, 0x27, 0x08, 0x6c, 0x57, 0xd4, 0x68, 0xe4, 0xb2, 0x65, 0xb1, 0x6c, 0x03, 0xe9, 0x1d, 0x24,
+	0xcb, 0xc5, 0xbf, 0x00, 0x32, 0xa4, 0x0e, 0x1f, 0xa1, 0x4f, 0x1f, 0xab, 0x58, 0x11, 0xb2, 0x2d,
+	0xcd, 0xe9, 0x68, 0x55, 0x3f, 0x83, 0xa6, 0xfa, 0xfc, 0x38, 0xe8, 0x29, 0xd1, 0xaa, 0x10, 0x5d,
+	0x96, 0xe4, 0x67, 0x3d, 0x29, 0x37, 0xf9, 0x41, 0x16, 0xa6, 0x3e, 0xc8, 0x9a, 0xff, 0x90, 0x83,
+	0x65, 0x65, 0x0a, 0x29, 0x52, 0xbb, 0xa4, 0x2d, 0xe0, 0x08, 0xcc, 0x4a, 0x02, 0x23, 0x7f, 0xfe,
+	0x08, 0x21, 0x48, 0x7e, 0x04, 0x25, 0xb4, 0x0c, 0x8d, 0x25, 0x1b, 0xca, 0xbd, 0x2b, 0x7b, 0xb2,
+	0x24, 0x93, 0x3c, 0x84, 0xaa, 0xbe, 0xe7, 0xf9, 0xd8, 0x4a, 0x4a, 0x8f, 0x85, 0xee, 0xff, 0x9e,
+	0xf8, 0x46, 0x2a, 0xca, 0x5e, 0xa4, 0x05, 0xf5, 0xe7, 0x2f, 0x9f, 0xd8, 0xdd, 0xc3, 0x6d, 0xeb,
+	0x70, 0x6f, 0xff, 0x99, 0xfc, 0xd9, 0x21, 0x52, 0xac, 0x57, 0xfb, 0xfb, 0x48, 0xc8, 0x69, 0xc2,
+	0xd3, 0xed, 0xbd, 0x17, 0xaf, 0xac, 0xdd, 0x56, 0x5e, 0x13, 0xba, 0xaf, 0x3a, 0x9d, 0xdd, 0x6e,
+	0xb7, 0x55, 0x48, 0x09, 0x87, 0x2f, 0x0f, 0x0e, 0x76, 0x77, 0x5a, 0x45, 0x72, 0x13, 0xae, 0x23,
+	0xe1, 0xfb, 0xed, 0x3d, 0x9c, 0xd4, 0x7e, 0xfa, 0xd2, 0xb2, 0xad, 0xdd, 0xee, 0xcb, 0x57, 0x56,
+	0x67, 0xb7, 0xdb, 0x2a, 0xdd, 0xff, 0x1a, 0x6a, 0x99, 0x4f, 0xb7, 0x38, 0xfc, 0xe0, 0xe5, 0x4e,
+	0xba, 0xe2, 0x15, 0x4d, 0xd0, 0x0b, 0xe4, 0x48, 0x03, 0x00, 0x09, 0xa8, 0xc2, 0xee, 0x4e, 0x2b,
+	0x7f, 0xff, 0x57, 0x99, 0x0f, 0xb2, 0x72, 0x8e, 0xab, 0xb0, 0x72, 0xb0, 0x77, 0xb0, 0xfb, 0x62,
+	0x6f, 0x7f, 0x37, 0xbb, 0x99, 0x35, 0x68, 0xa5, 0xe4, 0xf1, 0x8e, 0xae, 0xc1, 0xea, 0x98, 0xba,
+	0x9b, 0x8a, 0xe7, 0x27, 0xc4, 0xf5, 0x7e, 0x0b, 0x13, 0xd4, 0x74, 0x8f, 0x8f, 0xfe, 0xb5, 0x0a,
+	0x85, 0xed, 0x83, 0x3d, 0xb2, 0x05, 0xd5, 0xb4, 0xfe, 0x4d, 0xae, 0x66, 0xb0, 0xc0, 0xb8, 0xa8,
+	0xd5, 0x4e, 0x2b, 0x09, 0xe6, 0x15, 0x44, 0xec, 0xe3, 0xd2, 0x25, 0x59, 0x57, 0x98, 0x6d, 0xaa,
+	0x96, 0xd9, 0x9e, 0xf8, 0x52, 0x6d, 0x5e, 0x21, 0x0f, 0xa0, 0xac, 0xca, 0x93, 0x44, 0x26, 0xe6,
+	0x93, 0xc5, 0xca, 0xf6, 0x72, 0x56, 0x9e, 0x9b, 0x57, 0xc8, 0x23, 0xa8, 0xe8, 0x12, 0x23, 0x91,
+	0x30, 0x62, 0xaa, 0xe2, 0x38, 0xbd, 0xc4, 0xc3, 0x1c, 0xf9, 0x19, 0xd4, 0xb3, 0xa5, 0x42, 0x62,
+	0xc8, 0x1c, 0x64, 0xb6, 0x7a, 0x38, 0x67, 0xec, 0xcf, 0xa1, 0x9a, 0x56, 0xfe, 0xd4, 0x31, 0x4c,
+	0x57, 0x02, 0xdb, 0xeb, 0x33, 0x36, 0xbf, 0x8b, 0xff, 0x8f, 0x61, 0x5e, 0x21, 0x5f, 0x41, 0x59,
+	0xd5, 0x01, 0xd5, 0xf6, 0x26, 0xab, 0x82, 0x0b, 0x46, 0x3e, 0x11, 0x3f, 0xd1, 0x4b, 0x4b, 0x4a,
+	0x4a, 0xe7, 0x39, 0x55, 0xa6, 0x05, 0x73, 0x7c, 0x0b, 0x8d, 0xc9, 0x82, 0x0c, 0x69, 0xcb, 0x13,
+	0x9b, 0x57, 0x55, 0x6a, 0xdf, 0x98, 0xcb, 0x53, 0x31, 0xe3, 0x0a, 0x79, 0x0a, 0x8d, 0x49, 0x2c,
+	0xa8, 0x26, 0x9b, 0x0b, 0x10, 0x17, 0x28, 0xd5, 0x81, 0xe6, 0x54, 0x2a, 0x44, 0x6e, 0x64, 0x8d,
+	0x65, 0x7a, 0xa6, 0xd9, 0x2f, 0x35, 0xe6, 0x15, 0xf2, 0x9b, 0x50, 0xcf, 0x26, 0x3c, 0xea, 0x74,
+	0xe6, 0xe4, 0x40, 0x6d, 0x32, 0x33, 0x9c, 0xcb, 0xcd, 0x4c, 0xa6, 0x3f, 0x6a, 0x33, 0x73, 0x73,
+	0xa2, 0x05, 0x9b, 0xd9, 0x81, 0xe5, 0x89, 0xa4, 0x84, 0x5c, 0x57, 0xb7, 0x3c, 0x9b, 0xa8, 0x2c,
+	0xbe, 0xeb, 0x6c, 0x5e, 0xa2, 0xed, 0x73, 0x36, 0x55, 0x59, 0xac, 0xc9, 0x44, 0x62, 0xa2, 0x34,
+	0x99, 0x97, 0xac, 0x2c, 0x98, 0xe5, 0x37, 0xb4, 0xb5, 0x6f, 0x07, 0x01, 0x39, 0x43, 0x6c, 0xc1,
+	0xf0, 0xc7, 0x50, 0x56, 0x75, 0x6c, 0x65, 0xee, 0x93, 0x55, 0xed, 0x76, 0x53, 0x83, 0x74, 0x55,
+	0x55, 0x16, 0x2f, 0xec, 0x5b, 0x68, 0x4c, 0x26, 0x2a, 0xea, 0x2e, 0xe6, 0xa6, 0x35, 0xed, 0x1b,
+	0x73, 0x79, 0xa9, 0x95, 0x3e, 0x84, 0x92, 0xcc, 0x22, 0xa4, 0xd9, 0x64, 0xf3, 0x9c, 0x36, 0xc9,
+	0x92, 0xf4, 0x88, 0x27, 0x57, 0xff, 0xfe, 0xfd, 0xad, 0xdc, 0x3f, 0xbe, 0xbf, 0x95, 0xfb, 0xe7,
+	0xf7, 0xb7, 0x72, 0x7f, 0xf2, 0x2f, 0xb7, 0xae, 0xfc, 0xb2, 0x10, 0x45, 0xbc, 0xb7, 0x24, 0x36,
+	0xf7, 0xf8, 0xbf, 0x07, 0x00, 0xe3, 0x0f, 0xc0, 0x61, 0x86, 0x35, 0x00, 0x00,
 }
@@ -1,6 +1,8 @@
 package pps
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"math"
 	"sort"
@@ -27,6 +29,75 @@ func VisitInput(input *Input, f func(*Input)) {
 	f(input)
 }
 
+// InputRepos returns the repos that input takes data from.
+func InputRepos(input *Input) []string {
+	if input == nil {
+		return nil
+	}
+	var result []string
+	VisitInput(input, func(input *Input) {
+		switch {
+		case input.Atom != nil:
+			result = append(result, input.Atom.Repo)
+		case input.Git != nil:
+			result = append(result, input.Git.Name)
+		}
+	})
+	return result
+}
+
+// DownstreamPipelines returns the pipelines in pipelineInfos that are
+// downstream of pipeline, directly or transitively. They're ordered so that
+// each pipeline comes before the pipelines it takes input from, which is the
+// order they can be deleted in.
+func DownstreamPipelines(pipeline string, pipelineInfos []*PipelineInfo) []string {
+	inputs := make(map[string][]string)
+	for _, pipelineInfo := range pipelineInfos {
+		inputs[pipelineInfo.Pipeline.Name] = InputRepos(pipelineInfo.Input)
+	}
+	downstream := map[string]bool{pipeline: true}
+	for changed := true; changed; {
+		changed = false
+		for name, repos := range inputs {
+			if downstream[name] {
+				continue
+			}
+			for _, repo := range repos {
+				if downstream[repo] {
+					downstream[name] = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+	delete(downstream, pipeline)
+	var result []string
+	for len(downstream) > 0 {
+		// Take the pipelines that none of the remaining ones take input from
+		var ready []string
+		for name := range downstream {
+			isReady := true
+			for other := range downstream {
+				for _, repo := range inputs[other] {
+					if repo == name {
+						isReady = false
+					}
+				}
+			}
+			if isReady {
+				ready = append(ready, name)
+			}
+		}
+		sort.Strings(ready)
+		for _, name := range ready {
+			delete(downstream, name)
+		}
+		result = append(result, ready...)
+	}
+	return result
+}
+
 // InputName computes the name of an Input.
 func InputName(input *Input) string {
 	switch {
@@ -44,6 +115,36 @@ func InputName(input *Input) string {
 	return ""
 }
 
+// PipelineReqFromInfo returns the CreatePipelineRequest that recreates the
+// pipeline in pipelineInfo.
+func PipelineReqFromInfo(pipelineInfo *PipelineInfo) *CreatePipelineRequest {
+	return &CreatePipelineRequest{
+		Pipeline:           pipelineInfo.Pipeline,
+		Transform:          pipelineInfo.Transform,
+		ParallelismSpec:    pipelineInfo.ParallelismSpec,
+		Egress:             pipelineInfo.Egress,
+		OutputBranch:       pipelineInfo.OutputBranch,
+		ScaleDownThreshold: pipelineInfo.ScaleDownThreshold,
+		ResourceSpec:       pipelineInfo.ResourceSpec,
+		Input:              pipelineInfo.Input,
+		Description:        pipelineInfo.Description,
+		Incremental:        pipelineInfo.Incremental,
+		Webhooks:           pipelineInfo.Webhooks,
+		S3Gateway:          pipelineInfo.S3Gateway,
+		CacheSize:          pipelineInfo.CacheSize,
+		DiskCacheSize:      pipelineInfo.DiskCacheSize,
+		DatumConcurrency:   pipelineInfo.DatumConcurrency,
+		PodLabels:          pipelineInfo.PodLabels,
+		PodAnnotations:     pipelineInfo.PodAnnotations,
+		SecondaryOutputs:   pipelineInfo.SecondaryOutputs,
+		Logs:               pipelineInfo.Logs,
+		Scheduling:         pipelineInfo.Scheduling,
+		ServiceAccount:     pipelineInfo.ServiceAccount,
+		Sidecars:           pipelineInfo.Sidecars,
+		RuntimeClass:       pipelineInfo.RuntimeClass,
+	}
+}
+
 // SortInput sorts an Input.
 func SortInput(input *Input) {
 	VisitInput(input, func(input *Input) {
@@ -73,14 +174,39 @@ func InputCommits(input *Input) []*pfs.Commit {
 	return result
 }
 
+// NormalizeGitURL returns a form of a git repository's URL that's the same
+// for its https and ssh URLs, e.g. "github.com/pachyderm/pachyderm" for
+// both https://github.com/pachyderm/pachyderm.git and
+// git@github.com:pachyderm/pachyderm.git.
+func NormalizeGitURL(url string) string {
+	url = strings.ToLower(strings.TrimSpace(url))
+	for _, prefix := range []string{"https://", "http://", "ssh://", "git://"} {
+		url = strings.TrimPrefix(url, prefix)
+	}
+	if strings.HasPrefix(url, "git@") {
+		url = strings.Replace(strings.TrimPrefix(url, "git@"), ":", "/", 1)
+	}
+	url = strings.TrimSuffix(url, "/")
+	return strings.TrimSuffix(url, ".git")
+}
+
+// GitRepoName returns the name of the git repository at url.
+func GitRepoName(url string) string {
+	url = NormalizeGitURL(url)
+	return url[strings.LastIndex(url, "/")+1:]
+}
+
 // PipelineRcName generates the name of the k8s replication controller that
 // manages a pipeline's workers
 func PipelineRcName(name string, version uint64) string {
-	// k8s won't allow RC names that contain upper-case letters
-	// or underscores
-	// TODO: deal with name collision
-	name = strings.Replace(name, "_", "-", -1)
-	return fmt.Sprintf("pipeline-%s-v%d", strings.ToLower(name), version)
+	// k8s won't allow RC names that contain upper-case letters,
+	// underscores or (because the name is also a service's) dots, so those
+	// are replaced, and a hash of the full name keeps pipelines whose names
+	// only differ in those characters, e.g. "a_b" and "a.b", apart.
+	hash := sha256.Sum256([]byte(name))
+	rcName := strings.Replace(name, "_", "-", -1)
+	rcName = strings.Replace(rcName, pfs.ProjectSeparator, "-", -1)
+	return fmt.Sprintf("pipeline-%s-%s-v%d", strings.ToLower(rcName), hex.EncodeToString(hash[:4]), version)
 }
 
 // GetExpectedNumWorkers computes the expected number of workers that
@@ -0,0 +1,20 @@
+package pps
+
+import (
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+func TestPipelineRcName(t *testing.T) {
+	names := []string{"a-b", "a_b", "a.b", "A-b", "a.b_c", "a_b.c"}
+	rcNames := make(map[string]bool)
+	for _, name := range names {
+		rcName := PipelineRcName(name, 1)
+		require.Equal(t, rcName, PipelineRcName(name, 1))
+		require.NotEqual(t, rcName, PipelineRcName(name, 2))
+		require.Matches(t, "^pipeline-[a-z0-9-]+-v1$", rcName)
+		rcNames[rcName] = true
+	}
+	require.Equal(t, len(names), len(rcNames))
+}
@@ -0,0 +1,355 @@
+package cmds
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	units "github.com/docker/go-units"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/admin"
+	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/pretty"
+	"github.com/spf13/cobra"
+)
+
+// Cmds returns a slice containing admin commands.
+func Cmds(address string, noMetrics *bool, outputFormat *string) []*cobra.Command {
+	metrics := !*noMetrics
+
+	var noObjects bool
+	var url string
+	var output string
+	extract := &cobra.Command{
+		Use:   "extract",
+		Short: "Extract Pachyderm state to stdout or an object store bucket.",
+		Long: `Extract Pachyderm state to stdout or an object store bucket.
+
+The extract contains everything needed to rebuild the cluster with "pachctl
+restore": repos, commits, branches and pipelines, as well as the objects that
+hold the data (unless --no-objects is passed).
+
+Examples:
+
+` + "```sh" + `
+# Extract into a local file:
+$ pachctl extract > backup
+
+# Extract to s3:
+$ pachctl extract -u s3://bucket/backup
+` + "```",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) (retErr error) {
+			c, err := client.NewMetricsClientFromAddress(address, metrics, "user")
+			if err != nil {
+				return err
+			}
+			if url != "" {
+				return c.ExtractURL(url)
+			}
+			w := os.Stdout
+			if output != "" {
+				f, err := os.Create(output)
+				if err != nil {
+					return err
+				}
+				defer func() {
+					if err := f.Close(); err != nil && retErr == nil {
+						retErr = err
+					}
+				}()
+				w = f
+			}
+			bufW := bufio.NewWriter(w)
+			if err := c.ExtractWriter(!noObjects, bufW); err != nil {
+				return err
+			}
+			return bufW.Flush()
+		}),
+	}
+	extract.Flags().BoolVar(&noObjects, "no-objects", false, "don't extract from object storage, only extract data from etcd")
+	extract.Flags().StringVarP(&url, "url", "u", "", "An object storage url (i.e. s3://...) to extract to.")
+	extract.Flags().StringVarP(&output, "output", "o", "", "A file to write the extract to, instead of stdout.")
+
+	var input string
+	restore := &cobra.Command{
+		Use:   "restore",
+		Short: "Restore Pachyderm state from stdin or an object store.",
+		Long: `Restore Pachyderm state from stdin or an object store.
+
+Restore should be run against an empty cluster, it recreates the repos,
+commits, branches and pipelines of the cluster the extract was taken from.
+
+Examples:
+
+` + "```sh" + `
+# Restore from a local file:
+$ pachctl restore < backup
+
+# Restore from s3:
+$ pachctl restore -u s3://bucket/backup
+` + "```",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) (retErr error) {
+			c, err := client.NewMetricsClientFromAddress(address, metrics, "user")
+			if err != nil {
+				return err
+			}
+			if url != "" {
+				return c.RestoreURL(url)
+			}
+			r := os.Stdin
+			if input != "" {
+				f, err := os.Open(input)
+				if err != nil {
+					return err
+				}
+				defer func() {
+					if err := f.Close(); err != nil && retErr == nil {
+						retErr = err
+					}
+				}()
+				r = f
+			}
+			return c.RestoreReader(bufio.NewReader(r))
+		}),
+	}
+	restore.Flags().StringVarP(&url, "url", "u", "", "An object storage url (i.e. s3://...) to restore from.")
+	restore.Flags().StringVarP(&input, "input", "i", "", "A file to read the extract from, instead of stdin.")
+
+	var deleteSource bool
+	var verify bool
+	migrateStorage := &cobra.Command{
+		Use:   "migrate-storage from-url to-url",
+		Short: "Copy Pachyderm's objects from one object store to another.",
+		Long: `Copy Pachyderm's objects from one object store to another.
+
+Every object under from-url (the storage root pachd was deployed with) is
+copied to to-url. Objects that already exist in to-url are skipped, so an
+interrupted migration can be resumed by running the same command again. With
+--verify, they're compared to their source first, and copied again if an
+interrupted copy left them incomplete. --delete-source requires --verify, so
+that no object is deleted before its copy is known to be intact. Once the
+migration has finished, redeploy pachd pointing at the new storage root, no
+metadata needs to be changed.
+
+Examples:
+
+` + "```sh" + `
+# Move objects to a new bucket, checking each copy:
+$ pachctl migrate-storage s3://old-bucket/pach s3://new-bucket/pach --verify --delete-source
+` + "```",
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			c, err := client.NewMetricsClientFromAddress(address, metrics, "user")
+			if err != nil {
+				return err
+			}
+			var copied, skipped int
+			var bytes int64
+			if err := c.MigrateStorage(args[0], args[1], deleteSource, verify, func(progress *admin.MigrateStorageProgress) error {
+				if progress.Skipped {
+					skipped++
+				} else {
+					copied++
+					bytes += progress.SizeBytes
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+			fmt.Printf("copied %d objects (%s), skipped %d objects that were already present\n", copied, units.BytesSize(float64(bytes)), skipped)
+			return nil
+		}),
+	}
+	migrateStorage.Flags().BoolVar(&deleteSource, "delete-source", false, "Delete objects from the source once they've been copied and verified, requires --verify.")
+	migrateStorage.Flags().BoolVar(&verify, "verify", false, "Check that every object in the destination matches its source.")
+
+	setReadOnly := &cobra.Command{
+		Use:   "set-read-only true|false",
+		Short: "Put the cluster into or out of read-only mode.",
+		Long: `Put the cluster into or out of read-only mode.
+
+While the cluster is read-only, reads are served as usual but every request
+that would modify the cluster (e.g. put-file, create-pipeline) is rejected,
+and no new jobs are started. This is useful for freezing the cluster's state
+during upgrades or while responding to an incident.`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			readOnly, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return fmt.Errorf("expected true or false, got %q", args[0])
+			}
+			c, err := client.NewMetricsClientFromAddress(address, metrics, "user")
+			if err != nil {
+				return err
+			}
+			return c.SetReadOnly(readOnly)
+		}),
+	}
+
+	inspectCluster := &cobra.Command{
+		Use:   "inspect-cluster",
+		Short: "Return info about the cluster.",
+		Long:  "Return info about the cluster.",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewMetricsClientFromAddress(address, metrics, "user")
+			if err != nil {
+				return err
+			}
+			clusterInfo, err := c.InspectCluster()
+			if err != nil {
+				return err
+			}
+			if pretty.Structured(*outputFormat) {
+				return pretty.PrintStructured(os.Stdout, *outputFormat, clusterInfo)
+			}
+			fmt.Printf("Read only: %t\n", clusterInfo.ReadOnly)
+			if clusterInfo.MetadataVersion != "" {
+				fmt.Printf("Metadata version: %s\n", clusterInfo.MetadataVersion)
+			}
+			return nil
+		}),
+	}
+
+	var configFile string
+	setNotificationConfig := &cobra.Command{
+		Use:   "set-notification-config -f config.json",
+		Short: "Set where notifications about jobs are sent.",
+		Long: `Set where notifications about jobs are sent.
+
+The config has a list of sinks, which are places that notifications can be
+sent (Slack incoming webhooks, PagerDuty and email), and a list of rules,
+which route jobs to sinks by their pipeline and state. Rules match failed
+jobs unless they list other states. For example:
+
+{
+  "sinks": [
+    {"name": "ops", "slack": {"webhookUrl": "https://hooks.slack.com/services/..."}},
+    {"name": "oncall", "pagerDuty": {"routingKey": "..."}}
+  ],
+  "rules": [
+    {"sinks": ["ops"]},
+    {"pipeline": "prod-*", "states": ["JOB_FAILURE", "JOB_SUCCESS"], "sinks": ["oncall"]}
+  ]
+}
+
+PagerDuty sinks trigger an alert when a pipeline's job fails and resolve it
+when one succeeds, so rules for them should usually match both states.
+The config replaces the previous one, an empty config turns notifications
+off.`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) (retErr error) {
+			var r io.Reader = os.Stdin
+			if configFile != "-" {
+				f, err := os.Open(configFile)
+				if err != nil {
+					return err
+				}
+				defer func() {
+					if err := f.Close(); err != nil && retErr == nil {
+						retErr = err
+					}
+				}()
+				r = f
+			}
+			config := &admin.NotificationConfig{}
+			if err := jsonpb.Unmarshal(r, config); err != nil {
+				return fmt.Errorf("error parsing notification config: %v", err)
+			}
+			c, err := client.NewMetricsClientFromAddress(address, metrics, "user")
+			if err != nil {
+				return err
+			}
+			return c.SetNotificationConfig(config)
+		}),
+	}
+	setNotificationConfig.Flags().StringVarP(&configFile, "file", "f", "-", "The file containing the config, - reads from stdin.")
+
+	getNotificationConfig := &cobra.Command{
+		Use:   "get-notification-config",
+		Short: "Print the cluster's notification config.",
+		Long:  "Print the cluster's notification config.",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewMetricsClientFromAddress(address, metrics, "user")
+			if err != nil {
+				return err
+			}
+			config, err := c.GetNotificationConfig()
+			if err != nil {
+				return err
+			}
+			marshaller := &jsonpb.Marshaler{Indent: "  "}
+			if err := marshaller.Marshal(os.Stdout, config); err != nil {
+				return err
+			}
+			fmt.Println()
+			return nil
+		}),
+	}
+
+	var olderThan time.Duration
+	listOrphanedObjects := &cobra.Command{
+		Use:   "list-orphaned-objects",
+		Short: "List objects that aren't referenced by any commit.",
+		Long: `List objects that aren't referenced by any commit.
+
+Objects become orphaned when the commits that reference them are deleted, or
+when a write fails part way through. Objects that were only just written may
+not be referenced yet, --older-than can be used to leave them out. Orphaned
+objects can be deleted selectively with delete-orphaned-objects.`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewMetricsClientFromAddress(address, metrics, "user")
+			if err != nil {
+				return err
+			}
+			if pretty.Structured(*outputFormat) {
+				var orphans []*admin.OrphanedObject
+				if err := c.ListOrphanedObjects(olderThan, func(orphan *admin.OrphanedObject) error {
+					orphans = append(orphans, orphan)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return pretty.PrintStructured(os.Stdout, *outputFormat, orphans)
+			}
+			writer := tabwriter.NewWriter(os.Stdout, 20, 1, 3, ' ', 0)
+			fmt.Fprint(writer, "HASH\tSIZE\tMODIFIED\t\n")
+			if err := c.ListOrphanedObjects(olderThan, func(orphan *admin.OrphanedObject) error {
+				modified := "-"
+				if orphan.Modified != nil {
+					modified = pretty.Ago(orphan.Modified)
+				}
+				fmt.Fprintf(writer, "%s\t%s\t%s\t\n", orphan.Object.Hash, pretty.Size(orphan.SizeBytes), modified)
+				return nil
+			}); err != nil {
+				return err
+			}
+			return writer.Flush()
+		}),
+	}
+	listOrphanedObjects.Flags().DurationVar(&olderThan, "older-than", 0, "Only list objects written longer ago than this.")
+
+	deleteOrphanedObjects := &cobra.Command{
+		Use:   "delete-orphaned-objects hash...",
+		Short: "Delete objects that aren't referenced by any commit.",
+		Long: `Delete objects that aren't referenced by any commit.
+
+Unlike garbage-collect, which deletes every unreferenced object, only the
+objects given are deleted. If any of them are still referenced, including by
+open commits and by objects stored as deltas against them, or were written
+less than an hour ago, nothing is deleted.`,
+		Run: cmdutil.Run(func(args []string) error {
+			if len(args) == 0 {
+				return fmt.Errorf("at least one object hash must be given")
+			}
+			c, err := client.NewMetricsClientFromAddress(address, metrics, "user")
+			if err != nil {
+				return err
+			}
+			return c.DeleteOrphanedObjects(args...)
+		}),
+	}
+
+	return []*cobra.Command{extract, restore, migrateStorage, setReadOnly, inspectCluster, setNotificationConfig, getNotificationConfig, listOrphanedObjects, deleteOrphanedObjects, debugCmd(address, metrics)}
+}
@@ -0,0 +1,201 @@
+package cmds
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
+	"github.com/pachyderm/pachyderm/src/server/pkg/pretty"
+	"github.com/spf13/cobra"
+)
+
+// debugCmd returns the debug command, whose subcommands read objects and
+// blocks directly from object storage, bypassing pachd's caches.
+func debugCmd(address string, metrics bool) *cobra.Command {
+	var output string
+	getObject := &cobra.Command{
+		Use:   "get-object hash",
+		Short: "Check how an object is stored, reading it directly from object storage.",
+		Long: `Check how an object is stored, reading it directly from object storage.
+
+The object's index entry and content are read from object storage rather than
+through pachd's caches, and the content is hashed. If the index entry or the
+block it points to is missing the metadata is to blame, if the content's hash
+doesn't match the object's the stored bytes are corrupt.`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) (retErr error) {
+			c, err := client.NewMetricsClientFromAddress(address, metrics, "user")
+			if err != nil {
+				return err
+			}
+			var w io.Writer
+			if output != "" {
+				f, err := os.Create(output)
+				if err != nil {
+					return err
+				}
+				defer func() {
+					if err := f.Close(); err != nil && retErr == nil {
+						retErr = err
+					}
+				}()
+				w = f
+			}
+			report, err := c.DebugObject(args[0], true, w)
+			if err != nil {
+				return err
+			}
+			printDebugObject(args[0], report)
+			if status := debugObjectStatus(args[0], report, true); status != "ok" {
+				return fmt.Errorf("object %s is %s", args[0], status)
+			}
+			return nil
+		}),
+	}
+	getObject.Flags().StringVarP(&output, "output", "o", "", "Write the object's content, as read from object storage, to this file.")
+
+	var commit string
+	var verify bool
+	listBlocks := &cobra.Command{
+		Use:   "list-blocks --commit repo-name/commit-id",
+		Short: "List the blocks that a commit's files are stored in.",
+		Long: `List the blocks that a commit's files are stored in.
+
+Each object that the commit's files reference is looked up directly in object
+storage, bypassing pachd's caches, along with the block it's stored in. With
+--verify every object is also read and hashed, which finds corrupt bytes but
+reads all of the commit's data.`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			parts := strings.SplitN(commit, "/", 2)
+			if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+				return fmt.Errorf("--commit must be of the form repo-name/commit-id, got %q", commit)
+			}
+			c, err := client.NewMetricsClientFromAddress(address, metrics, "user")
+			if err != nil {
+				return err
+			}
+			commitInfo, err := c.InspectCommit(parts[0], parts[1])
+			if err != nil {
+				return err
+			}
+			if commitInfo.Tree == nil {
+				return fmt.Errorf("commit %s/%s has no files, or hasn't finished", parts[0], parts[1])
+			}
+			var buf bytes.Buffer
+			report, err := c.DebugObject(commitInfo.Tree.Hash, true, &buf)
+			if err != nil {
+				return err
+			}
+			if status := debugObjectStatus(commitInfo.Tree.Hash, report, true); status != "ok" {
+				return fmt.Errorf("the commit's tree, object %s, is %s", commitInfo.Tree.Hash, status)
+			}
+			tree, err := hashtree.Deserialize(buf.Bytes())
+			if err != nil {
+				return fmt.Errorf("error deserializing the commit's tree: %v", err)
+			}
+			var paths []string
+			files := make(map[string][]*pfs.Object)
+			if err := tree.Walk(func(path string, node *hashtree.NodeProto) error {
+				if node.FileNode != nil {
+					paths = append(paths, path)
+					files[path] = node.FileNode.Objects
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+			sort.Strings(paths)
+			reports := make(map[string]*pfs.DebugObjectResponse)
+			writer := tabwriter.NewWriter(os.Stdout, 20, 1, 3, ' ', 0)
+			fmt.Fprint(writer, "PATH\tOBJECT\tBLOCK\tRANGE\tSIZE\tSTATUS\t\n")
+			var problems int
+			for _, path := range paths {
+				for _, object := range files[path] {
+					report, ok := reports[object.Hash]
+					if !ok {
+						report, err = c.DebugObject(object.Hash, verify, nil)
+						if err != nil {
+							return err
+						}
+						reports[object.Hash] = report
+					}
+					block, byteRange, size := "-", "-", "-"
+					if blockRef := report.BlockRef; blockRef != nil {
+						block = blockRef.Block.Hash
+						byteRange = fmt.Sprintf("%d-%d", blockRef.Range.Lower, blockRef.Range.Upper)
+						size = pretty.Size(blockRef.Range.Upper - blockRef.Range.Lower)
+					}
+					status := debugObjectStatus(object.Hash, report, verify)
+					if status != "ok" {
+						problems++
+					}
+					fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\t\n", path, object.Hash, block, byteRange, size, status)
+				}
+			}
+			if err := writer.Flush(); err != nil {
+				return err
+			}
+			if problems > 0 {
+				return fmt.Errorf("found %d objects that aren't stored intact", problems)
+			}
+			return nil
+		}),
+	}
+	listBlocks.Flags().StringVar(&commit, "commit", "", "The commit whose blocks are listed, as repo-name/commit-id.")
+	listBlocks.Flags().BoolVar(&verify, "verify", false, "Read and hash every object, to check that its stored bytes are intact.")
+
+	debug := &cobra.Command{
+		Use:   "debug",
+		Short: "Low-level commands for diagnosing problems with stored data.",
+		Long: `Low-level commands for diagnosing problems with stored data.
+
+These commands read from object storage directly, so they can tell whether a
+corrupt file is due to PFS's metadata or to the bytes in object storage.`,
+	}
+	debug.AddCommand(getObject)
+	debug.AddCommand(listBlocks)
+	return debug
+}
+
+// debugObjectStatus summarizes a DebugObject report as "ok" or a description
+// of what's wrong with the object.
+func debugObjectStatus(hash string, report *pfs.DebugObjectResponse, verified bool) string {
+	switch {
+	case report.BlockRef == nil:
+		return "missing: no index entry"
+	case !report.BlockExists:
+		return "missing: block not found"
+	case report.Error != "":
+		return "unreadable: " + report.Error
+	case verified && report.Hash != hash:
+		return "corrupt: content hash doesn't match"
+	}
+	return "ok"
+}
+
+func printDebugObject(hash string, report *pfs.DebugObjectResponse) {
+	fmt.Printf("Object: %s\n", hash)
+	if blockRef := report.BlockRef; blockRef != nil {
+		fmt.Printf("Block: %s\n", blockRef.Block.Hash)
+		fmt.Printf("Range: %d-%d\n", blockRef.Range.Lower, blockRef.Range.Upper)
+		fmt.Printf("Block Exists: %t\n", report.BlockExists)
+		if blockRef.DeltaBase != nil {
+			fmt.Printf("Delta Base: %s (depth %d)\n", blockRef.DeltaBase.Hash, blockRef.DeltaDepth)
+		}
+	}
+	if report.Hash != "" {
+		fmt.Printf("Content Hash: %s\n", report.Hash)
+		fmt.Printf("Size: %s\n", pretty.Size(report.SizeBytes))
+	}
+	if report.Error != "" {
+		fmt.Printf("Error: %s\n", report.Error)
+	}
+	fmt.Printf("Status: %s\n", debugObjectStatus(hash, report, true))
+}
@@ -0,0 +1,455 @@
+package server
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/admin"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/pbutil"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/notify"
+	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
+
+	etcd "github.com/coreos/etcd/clientv3"
+	"go.pedge.io/proto/rpclog"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+type apiServer struct {
+	protorpclog.Logger
+	address      string
+	etcdClient   *etcd.Client
+	pachConn     *grpc.ClientConn
+	pachConnErr  error
+	pachConnOnce sync.Once
+}
+
+func (a *apiServer) Extract(request *admin.ExtractRequest, extractServer admin.API_ExtractServer) (retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
+	ctx := extractServer.Context()
+	pachConn, err := a.getPachConn()
+	if err != nil {
+		return err
+	}
+	pfsClient := pfs.NewAPIClient(pachConn)
+	objectClient := pfs.NewObjectAPIClient(pachConn)
+	ppsClient := pps.NewAPIClient(pachConn)
+
+	writeOp := extractServer.Send
+	if request.URL != "" {
+		objClient, path, err := objClientAndPath(ctx, request.URL)
+		if err != nil {
+			return err
+		}
+		objW, err := objClient.Writer(path)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := objW.Close(); err != nil && retErr == nil {
+				retErr = err
+			}
+		}()
+		w := pbutil.NewWriter(objW)
+		writeOp = func(op *admin.Op) error {
+			_, err := w.Write(op)
+			return err
+		}
+	}
+
+	if !request.NoObjects {
+		objects, err := objectClient.ListObjects(ctx, &pfs.ListObjectsRequest{})
+		if err != nil {
+			return err
+		}
+		for {
+			object, err := objects.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			if err := extractObject(ctx, objectClient, object, writeOp); err != nil {
+				return err
+			}
+		}
+		tags, err := objectClient.ListTags(ctx, &pfs.ListTagsRequest{IncludeObject: true})
+		if err != nil {
+			return err
+		}
+		for {
+			resp, err := tags.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			// Every tag also gets a response without its object, skip
+			// those so that each tag is only restored once.
+			if resp.Object == nil {
+				continue
+			}
+			if err := writeOp(&admin.Op{
+				Tag: &pfs.TagObjectRequest{
+					Object: resp.Object,
+					Tags:   []*pfs.Tag{{Name: resp.Tag}},
+				},
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	projectInfos, err := pfsClient.ListProject(ctx, &pfs.ListProjectRequest{})
+	if err != nil {
+		return err
+	}
+	for _, projectInfo := range projectInfos.ProjectInfo {
+		if err := writeOp(&admin.Op{
+			Project: &pfs.CreateProjectRequest{
+				Project:     projectInfo.Project,
+				Description: projectInfo.Description,
+			},
+		}); err != nil {
+			return err
+		}
+	}
+
+	repoInfos, err := pfsClient.ListRepo(ctx, &pfs.ListRepoRequest{})
+	if err != nil {
+		return err
+	}
+	// Repo provenance is transitive, so a repo always has more provenance
+	// than the repos it depends on, sorting by it puts upstream repos first.
+	sort.SliceStable(repoInfos.RepoInfo, func(i, j int) bool {
+		return len(repoInfos.RepoInfo[i].Provenance) < len(repoInfos.RepoInfo[j].Provenance)
+	})
+	for _, repoInfo := range repoInfos.RepoInfo {
+		if err := writeOp(&admin.Op{
+			Repo: &pfs.CreateRepoRequest{
+				Repo:        repoInfo.Repo,
+				Provenance:  repoInfo.Provenance,
+				Description: repoInfo.Description,
+				Retention:   repoInfo.Retention,
+				Labels:      repoInfo.Labels,
+			},
+		}); err != nil {
+			return err
+		}
+	}
+	for _, repoInfo := range repoInfos.RepoInfo {
+		if err := extractCommits(ctx, pfsClient, repoInfo.Repo, writeOp); err != nil {
+			return err
+		}
+	}
+
+	pipelineInfos, err := ppsClient.ListPipeline(ctx, &pps.ListPipelineRequest{})
+	if err != nil {
+		return err
+	}
+	for _, pipelineInfo := range pipelineInfos.PipelineInfo {
+		if err := writeOp(&admin.Op{
+			Pipeline: pps.PipelineReqFromInfo(pipelineInfo),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// extractObject writes the content of object as a series of object ops.
+func extractObject(ctx context.Context, objectClient pfs.ObjectAPIClient, object *pfs.Object, writeOp func(*admin.Op) error) error {
+	getObjectClient, err := objectClient.GetObject(ctx, object)
+	if err != nil {
+		return err
+	}
+	for {
+		value, err := getObjectClient.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if len(value.Value) == 0 {
+			continue
+		}
+		if err := writeOp(&admin.Op{Object: &pfs.PutObjectRequest{Value: value.Value}}); err != nil {
+			return err
+		}
+	}
+	// An empty value marks the end of the object
+	return writeOp(&admin.Op{Object: &pfs.PutObjectRequest{}})
+}
+
+// extractCommits writes the finished commits in repo, parents first, followed
+// by the repo's branches.
+func extractCommits(ctx context.Context, pfsClient pfs.APIClient, repo *pfs.Repo, writeOp func(*admin.Op) error) error {
+	commitInfos, err := pfsClient.ListCommit(ctx, &pfs.ListCommitRequest{Repo: repo})
+	if err != nil {
+		return err
+	}
+	sort.SliceStable(commitInfos.CommitInfo, func(i, j int) bool {
+		return commitInfos.CommitInfo[i].Started.Compare(commitInfos.CommitInfo[j].Started) < 0
+	})
+	extracted := make(map[string]bool)
+	for _, commitInfo := range commitInfos.CommitInfo {
+		// Open commits are left out, they can't be rebuilt from a tree.
+		if commitInfo.Finished == nil {
+			continue
+		}
+		parent := &pfs.Commit{Repo: repo}
+		if commitInfo.ParentCommit != nil {
+			parent.ID = commitInfo.ParentCommit.ID
+		}
+		if err := writeOp(&admin.Op{
+			Commit: &pfs.BuildCommitRequest{
+				Parent:      parent,
+				Provenance:  commitInfo.Provenance,
+				Tree:        commitInfo.Tree,
+				ID:          commitInfo.Commit.ID,
+				Description: commitInfo.Description,
+				Metadata:    commitInfo.Metadata,
+			},
+		}); err != nil {
+			return err
+		}
+		extracted[commitInfo.Commit.ID] = true
+	}
+	branches, err := pfsClient.ListBranch(ctx, &pfs.ListBranchRequest{Repo: repo})
+	if err != nil {
+		return err
+	}
+	for _, branch := range branches.Branches {
+		if !extracted[branch.Head.ID] {
+			continue
+		}
+		if err := writeOp(&admin.Op{
+			Branch: &pfs.SetBranchRequest{
+				Commit: &pfs.Commit{Repo: repo, ID: branch.Head.ID},
+				Branch: branch.Name,
+			},
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *apiServer) Restore(restoreServer admin.API_RestoreServer) (retErr error) {
+	ctx := restoreServer.Context()
+	func() { a.Log(nil, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(nil, nil, retErr, time.Since(start)) }(time.Now())
+	defer func() {
+		for {
+			if _, err := restoreServer.Recv(); err != nil {
+				break
+			}
+		}
+		if retErr == nil {
+			retErr = restoreServer.SendAndClose(&types.Empty{})
+		}
+	}()
+	pachConn, err := a.getPachConn()
+	if err != nil {
+		return err
+	}
+	r := &restorer{
+		pfsClient:    pfs.NewAPIClient(pachConn),
+		objectClient: pfs.NewObjectAPIClient(pachConn),
+		ppsClient:    pps.NewAPIClient(pachConn),
+	}
+	defer func() {
+		if r.putObjectClient != nil {
+			r.putObjectClient.CloseSend()
+		}
+	}()
+
+	req, err := restoreServer.Recv()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if req.URL != "" {
+		objClient, path, err := objClientAndPath(ctx, req.URL)
+		if err != nil {
+			return err
+		}
+		objR, err := objClient.Reader(path, 0, 0)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := objR.Close(); err != nil && retErr == nil {
+				retErr = err
+			}
+		}()
+		pbr := pbutil.NewReader(objR)
+		for {
+			op := &admin.Op{}
+			if err := pbr.Read(op); err != nil {
+				if err == io.EOF {
+					return nil
+				}
+				return err
+			}
+			if err := r.applyOp(ctx, op); err != nil {
+				return err
+			}
+		}
+	}
+	for {
+		if err := r.applyOp(ctx, req.Op); err != nil {
+			return err
+		}
+		req, err = restoreServer.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// restorer applies the ops of an extract, it's needed because objects span
+// several ops.
+type restorer struct {
+	pfsClient       pfs.APIClient
+	objectClient    pfs.ObjectAPIClient
+	ppsClient       pps.APIClient
+	putObjectClient pfs.ObjectAPI_PutObjectClient
+}
+
+func (r *restorer) applyOp(ctx context.Context, op *admin.Op) error {
+	if op == nil {
+		return fmt.Errorf("restore request is missing an op")
+	}
+	switch {
+	case op.Object != nil:
+		if r.putObjectClient == nil {
+			putObjectClient, err := r.objectClient.PutObject(ctx)
+			if err != nil {
+				return err
+			}
+			r.putObjectClient = putObjectClient
+		}
+		if len(op.Object.Value) > 0 {
+			return r.putObjectClient.Send(op.Object)
+		}
+		_, err := r.putObjectClient.CloseAndRecv()
+		r.putObjectClient = nil
+		return err
+	case op.Tag != nil:
+		_, err := r.objectClient.TagObject(ctx, op.Tag)
+		return err
+	case op.Project != nil:
+		_, err := r.pfsClient.CreateProject(ctx, op.Project)
+		return err
+	case op.Repo != nil:
+		_, err := r.pfsClient.CreateRepo(ctx, op.Repo)
+		return err
+	case op.Commit != nil:
+		commit, err := r.pfsClient.BuildCommit(ctx, op.Commit)
+		if err != nil {
+			return err
+		}
+		// Commits with empty trees are extracted without a tree, BuildCommit
+		// leaves them open so we need to finish them.
+		if op.Commit.Tree == nil {
+			_, err = r.pfsClient.FinishCommit(ctx, &pfs.FinishCommitRequest{Commit: commit})
+		}
+		return err
+	case op.Branch != nil:
+		_, err := r.pfsClient.SetBranch(ctx, op.Branch)
+		return err
+	case op.Pipeline != nil:
+		_, err := r.ppsClient.CreatePipeline(ctx, op.Pipeline)
+		return err
+	}
+	return fmt.Errorf("unrecognized op: %v", op)
+}
+
+func (a *apiServer) SetReadOnly(ctx context.Context, request *admin.SetReadOnlyRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	if _, err := a.etcdClient.Put(ctx, client.ReadOnlyKey, strconv.FormatBool(request.ReadOnly)); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+func (a *apiServer) SetNotificationConfig(ctx context.Context, request *admin.NotificationConfig) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	if err := notify.SetConfig(ctx, a.etcdClient, request); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+func (a *apiServer) GetNotificationConfig(ctx context.Context, request *types.Empty) (response *admin.NotificationConfig, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	return notify.GetConfig(ctx, a.etcdClient)
+}
+
+func (a *apiServer) InspectCluster(ctx context.Context, request *types.Empty) (response *admin.ClusterInfo, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	resp, err := a.etcdClient.Get(ctx, client.ReadOnlyKey)
+	if err != nil {
+		return nil, err
+	}
+	clusterInfo := &admin.ClusterInfo{}
+	if resp.Count > 0 {
+		clusterInfo.ReadOnly, err = strconv.ParseBool(string(resp.Kvs[0].Value))
+		if err != nil {
+			return nil, err
+		}
+	}
+	resp, err = a.etcdClient.Get(ctx, client.MetadataVersionKey)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Count > 0 {
+		clusterInfo.MetadataVersion = string(resp.Kvs[0].Value)
+	}
+	return clusterInfo, nil
+}
+
+func (a *apiServer) getPachConn() (*grpc.ClientConn, error) {
+	a.pachConnOnce.Do(func() {
+		a.pachConn, a.pachConnErr = grpc.Dial(a.address, client.PachDialOptions()...)
+	})
+	return a.pachConn, a.pachConnErr
+}
+
+// objClientAndPath returns an obj.Client for the bucket in URL along with the
+// path of the object that URL refers to.
+func objClientAndPath(ctx context.Context, URL string) (obj.Client, string, error) {
+	u, err := url.Parse(URL)
+	if err != nil {
+		return nil, "", err
+	}
+	objClient, err := obj.NewClientFromURLAndSecret(ctx, URL)
+	if err != nil {
+		return nil, "", err
+	}
+	return objClient, strings.TrimPrefix(u.Path, "/"), nil
+}
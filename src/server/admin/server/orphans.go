@@ -0,0 +1,146 @@
+package server
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client/admin"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+
+	"github.com/gogo/protobuf/types"
+	"golang.org/x/net/context"
+)
+
+// orphanGracePeriod is how long ago an object must have been written for
+// DeleteOrphanedObjects to delete it. Objects are written before anything
+// refers to them, so recent objects may only look orphaned.
+const orphanGracePeriod = time.Hour
+
+// ListOrphanedObjects lists the objects that fsck finds aren't referenced by
+// any commit or tag, along with their size and when they were written.
+// Objects that were only just written may not be referenced yet,
+// request.OlderThan can be used to leave them out.
+func (a *apiServer) ListOrphanedObjects(request *admin.ListOrphanedObjectsRequest, listServer admin.API_ListOrphanedObjectsServer) (retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
+	ctx := listServer.Context()
+	var olderThan time.Duration
+	if request.OlderThan != nil {
+		var err error
+		olderThan, err = types.DurationFromProto(request.OlderThan)
+		if err != nil {
+			return err
+		}
+	}
+	pachConn, err := a.getPachConn()
+	if err != nil {
+		return err
+	}
+	pfsClient := pfs.NewAPIClient(pachConn)
+	objectClient := pfs.NewObjectAPIClient(pachConn)
+	now := time.Now()
+	return orphanedObjects(ctx, pfsClient, func(object *pfs.Object) error {
+		resp, err := objectClient.CheckObject(ctx, &pfs.CheckObjectRequest{
+			Object:          object,
+			IncludeModified: true,
+		})
+		if err != nil {
+			return err
+		}
+		if !resp.Exists {
+			// The object was deleted after it was listed.
+			return nil
+		}
+		if resp.Modified != nil && olderThan > 0 {
+			modified, err := types.TimestampFromProto(resp.Modified)
+			if err != nil {
+				return err
+			}
+			if now.Sub(modified) < olderThan {
+				return nil
+			}
+		}
+		orphan := &admin.OrphanedObject{
+			Object:   object,
+			Modified: resp.Modified,
+		}
+		objectInfo, err := objectClient.InspectObject(ctx, object)
+		if err != nil {
+			return err
+		}
+		if objectInfo.BlockRef != nil && objectInfo.BlockRef.Range != nil {
+			orphan.SizeBytes = objectInfo.BlockRef.Range.Upper - objectInfo.BlockRef.Range.Lower
+		}
+		return listServer.Send(orphan)
+	})
+}
+
+// DeleteOrphanedObjects deletes the objects in request. Every object is
+// checked to be orphaned and to have been written more than
+// orphanGracePeriod ago first, if any of them aren't nothing is deleted.
+func (a *apiServer) DeleteOrphanedObjects(ctx context.Context, request *admin.DeleteOrphanedObjectsRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	pachConn, err := a.getPachConn()
+	if err != nil {
+		return nil, err
+	}
+	pfsClient := pfs.NewAPIClient(pachConn)
+	objectClient := pfs.NewObjectAPIClient(pachConn)
+	orphaned := make(map[string]bool)
+	if err := orphanedObjects(ctx, pfsClient, func(object *pfs.Object) error {
+		orphaned[object.Hash] = true
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	for _, object := range request.Objects {
+		if !orphaned[object.Hash] {
+			return nil, fmt.Errorf("object %s isn't orphaned, refusing to delete it", object.Hash)
+		}
+		resp, err := objectClient.CheckObject(ctx, &pfs.CheckObjectRequest{
+			Object:          object,
+			IncludeModified: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if resp.Modified == nil {
+			continue
+		}
+		modified, err := types.TimestampFromProto(resp.Modified)
+		if err != nil {
+			return nil, err
+		}
+		if time.Since(modified) < orphanGracePeriod {
+			return nil, fmt.Errorf("object %s was written less than %v ago and may not be referenced yet, refusing to delete it", object.Hash, orphanGracePeriod)
+		}
+	}
+	if _, err := objectClient.DeleteObjects(ctx, &pfs.DeleteObjectsRequest{Objects: request.Objects}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// orphanedObjects calls f with each object that fsck reports as orphaned.
+func orphanedObjects(ctx context.Context, pfsClient pfs.APIClient, f func(*pfs.Object) error) error {
+	fsckClient, err := pfsClient.Fsck(ctx, &pfs.FsckRequest{})
+	if err != nil {
+		return err
+	}
+	for {
+		resp, err := fsckClient.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if resp.OrphanedObject != nil {
+			if err := f(resp.OrphanedObject); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package server
+
+import (
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/admin"
+
+	etcd "github.com/coreos/etcd/clientv3"
+	"go.pedge.io/proto/rpclog"
+)
+
+// APIServer represents an admin api server.
+type APIServer interface {
+	admin.APIServer
+}
+
+// NewAPIServer returns a new admin.APIServer, address is the address of the
+// pachd that it's served from, it's used to access the other APIs.
+func NewAPIServer(address string, etcdAddress string) (APIServer, error) {
+	etcdClient, err := etcd.New(etcd.Config{
+		Endpoints:   []string{etcdAddress},
+		DialOptions: client.EtcdDialOptions(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &apiServer{
+		Logger:     protorpclog.NewLogger("admin.API"),
+		address:    address,
+		etcdClient: etcdClient,
+	}, nil
+}
@@ -0,0 +1,153 @@
+package server
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client/admin"
+	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
+
+	"go.pedge.io/lion/proto"
+)
+
+// MigrateStorage copies every object under request.FromURL to request.ToURL.
+// PFS refers to objects by their path relative to the storage root, so no
+// metadata needs to be rewritten, once the migration is done pachd can be
+// redeployed pointing at the new storage root. Objects that already exist in
+// the destination are skipped, which makes it safe to rerun an interrupted
+// migration, unless request.Verify is set, in which case they're compared to
+// their source first, since an interrupted copy may have left a partial
+// object behind. Objects are only deleted from the source, if
+// request.DeleteSource is set, once they've been verified.
+func (a *apiServer) MigrateStorage(request *admin.MigrateStorageRequest, migrateServer admin.API_MigrateStorageServer) (retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
+	ctx := migrateServer.Context()
+	if request.FromURL == "" || request.ToURL == "" {
+		return fmt.Errorf("both a source and a destination url must be given")
+	}
+	if request.FromURL == request.ToURL {
+		return fmt.Errorf("source and destination are the same: %s", request.FromURL)
+	}
+	if request.DeleteSource && !request.Verify {
+		return fmt.Errorf("deleting the source requires verifying the copies, so that no object is deleted before it's known to have been copied intact")
+	}
+	from, fromPrefix, err := objClientAndPath(ctx, request.FromURL)
+	if err != nil {
+		return err
+	}
+	to, toPrefix, err := objClientAndPath(ctx, request.ToURL)
+	if err != nil {
+		return err
+	}
+	return migrateObjects(from, fromPrefix, to, toPrefix, request.DeleteSource, request.Verify, migrateServer.Send)
+}
+
+// migrateObjects copies the objects under fromPrefix in from to toPrefix in
+// to, calling send with the progress of each.
+func migrateObjects(from obj.Client, fromPrefix string, to obj.Client, toPrefix string, deleteSource bool, verify bool, send func(*admin.MigrateStorageProgress) error) error {
+	return from.Walk(fromPrefix, func(name string) error {
+		if strings.HasSuffix(name, "/") {
+			return nil
+		}
+		relPath := strings.TrimPrefix(strings.TrimPrefix(name, fromPrefix), "/")
+		toName := path.Join(toPrefix, relPath)
+		progress := &admin.MigrateStorageProgress{Object: relPath}
+		if to.Exists(toName) {
+			progress.Skipped = true
+			if verify {
+				match, err := objectsMatch(from, name, to, toName)
+				if err != nil {
+					return err
+				}
+				if !match {
+					protolion.Infof("object %s doesn't match its source, copying it again", relPath)
+					if err := to.Delete(toName); err != nil {
+						return err
+					}
+					progress.Skipped = false
+				}
+			}
+		}
+		if !progress.Skipped {
+			size, err := copyObject(from, name, to, toName)
+			if err != nil {
+				return err
+			}
+			progress.SizeBytes = size
+			if verify {
+				match, err := objectsMatch(from, name, to, toName)
+				if err != nil {
+					return err
+				}
+				if !match {
+					return fmt.Errorf("object %s doesn't match its source after copying", relPath)
+				}
+			}
+		}
+		if deleteSource {
+			// Only reached once the copy has been verified, which
+			// MigrateStorage requires.
+			if err := from.Delete(name); err != nil {
+				return err
+			}
+		}
+		return send(progress)
+	})
+}
+
+func copyObject(from obj.Client, fromName string, to obj.Client, toName string) (_ int64, retErr error) {
+	r, err := from.Reader(fromName, 0, 0)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err := r.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	w, err := to.Writer(toName)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err := w.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	return io.Copy(w, r)
+}
+
+func objectsMatch(a obj.Client, aName string, b obj.Client, bName string) (bool, error) {
+	aHash, err := hashObject(a, aName)
+	if err != nil {
+		return false, err
+	}
+	bHash, err := hashObject(b, bName)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(aHash, bHash), nil
+}
+
+func hashObject(client obj.Client, name string) (_ []byte, retErr error) {
+	r, err := client.Reader(name, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := r.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, r); err != nil {
+		return nil, err
+	}
+	return hash.Sum(nil), nil
+}
@@ -0,0 +1,77 @@
+package server
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/admin"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
+)
+
+func newLocalClient(t *testing.T) obj.Client {
+	dir, err := ioutil.TempDir("", "migrate")
+	require.NoError(t, err)
+	c, err := obj.NewLocalClient(dir)
+	require.NoError(t, err)
+	return c
+}
+
+func putObject(t *testing.T, c obj.Client, name string, data string) {
+	w, err := c.Writer(name)
+	require.NoError(t, err)
+	_, err = w.Write([]byte(data))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+}
+
+func getObject(t *testing.T, c obj.Client, name string) string {
+	r, err := c.Reader(name, 0, 0)
+	require.NoError(t, err)
+	defer r.Close()
+	data, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestMigrateObjects(t *testing.T) {
+	from := newLocalClient(t)
+	to := newLocalClient(t)
+	putObject(t, from, "pach/object/a", "foo")
+	putObject(t, from, "pach/object/b", "bar")
+	// A partial copy left behind by an interrupted migration.
+	putObject(t, to, "new/object/b", "ba")
+
+	progress := make(map[string]*admin.MigrateStorageProgress)
+	send := func(p *admin.MigrateStorageProgress) error {
+		progress[p.Object] = p
+		return nil
+	}
+	require.NoError(t, migrateObjects(from, "pach", to, "new", true, true, send))
+	require.Equal(t, 2, len(progress))
+	require.False(t, progress["object/a"].Skipped)
+	require.False(t, progress["object/b"].Skipped)
+	require.Equal(t, "foo", getObject(t, to, "new/object/a"))
+	require.Equal(t, "bar", getObject(t, to, "new/object/b"))
+	require.False(t, from.Exists("pach/object/a"))
+	require.False(t, from.Exists("pach/object/b"))
+}
+
+func TestMigrateObjectsSkip(t *testing.T) {
+	from := newLocalClient(t)
+	to := newLocalClient(t)
+	putObject(t, from, "object/a", "foo")
+	putObject(t, to, "object/a", "fo")
+
+	// Without verify existing objects are trusted and the source is kept.
+	var progress []*admin.MigrateStorageProgress
+	send := func(p *admin.MigrateStorageProgress) error {
+		progress = append(progress, p)
+		return nil
+	}
+	require.NoError(t, migrateObjects(from, "", to, "", false, false, send))
+	require.Equal(t, 1, len(progress))
+	require.True(t, progress[0].Skipped)
+	require.Equal(t, "fo", getObject(t, to, "object/a"))
+	require.True(t, from.Exists("object/a"))
+}
@@ -7,9 +7,9 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net"
 	"os"
 	"os/exec"
-	"strings"
 	"text/tabwriter"
 	"time"
 
@@ -19,12 +19,16 @@ import (
 
 	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/admin"
 	"github.com/pachyderm/pachyderm/src/client/version"
 	"github.com/pachyderm/pachyderm/src/client/version/versionpb"
+	admincmds "github.com/pachyderm/pachyderm/src/server/admin/cmds"
 	pfscmds "github.com/pachyderm/pachyderm/src/server/pfs/cmds"
 	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
 	deploycmds "github.com/pachyderm/pachyderm/src/server/pkg/deploy/cmds"
 	"github.com/pachyderm/pachyderm/src/server/pkg/metrics"
+	"github.com/pachyderm/pachyderm/src/server/pkg/portforward"
+	"github.com/pachyderm/pachyderm/src/server/pkg/pretty"
 	ppscmds "github.com/pachyderm/pachyderm/src/server/pps/cmds"
 
 	log "github.com/Sirupsen/logrus"
@@ -37,34 +41,54 @@ import (
 )
 
 // PachctlCmd takes a pachd host-address and creates a cobra.Command
-// which may interact with the host.
+// which may interact with the host. If address is empty, the address of the
+// active context in pachctl's config is used.
 func PachctlCmd(address string) (*cobra.Command, error) {
+	address = pachdAddress(address)
 	var verbose bool
 	var noMetrics bool
+	var force bool
+	var output string
 	rootCmd := &cobra.Command{
 		Use: os.Args[0],
 		Long: `Access the Pachyderm API.
 
 Environment variables:
   ADDRESS=<host>:<port>, the pachd server to connect to (e.g. 127.0.0.1:30650).
+  It takes precedence over the active context, see pachctl config.
 `,
-		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 			if !verbose {
 				// Silence any grpc logs
 				l := log.New()
 				l.Level = log.FatalLevel
 				grpclog.SetLogger(l)
 			}
+			if err := pretty.ValidateOutput(output); err != nil {
+				return err
+			}
+			if skipCompatibilityCheck[cmd.Name()] || (cmd.HasParent() && skipCompatibilityCheck[cmd.Parent().Name()]) {
+				return nil
+			}
+			if err := checkCompatibility(address); err != nil {
+				if !force {
+					return fmt.Errorf("%v\nrerun with --force to run the command anyway", err)
+				}
+				fmt.Fprintf(os.Stderr, "WARNING: %v\n", err)
+			}
+			return nil
 		},
 	}
 	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "Output verbose logs")
 	rootCmd.PersistentFlags().BoolVarP(&noMetrics, "no-metrics", "", false, "Don't report user metrics for this command")
+	rootCmd.PersistentFlags().BoolVar(&force, "force", false, "Run the command even if pachctl, pachd and the cluster's metadata are at incompatible versions.")
+	rootCmd.PersistentFlags().StringVarP(&output, "output", "o", "", "Output format of list and inspect commands: \"table\", \"wide\" (a table of every column), \"json\" or \"yaml\".")
 
-	pfsCmds := pfscmds.Cmds(address, &noMetrics)
+	pfsCmds := pfscmds.Cmds(address, &noMetrics, &output)
 	for _, cmd := range pfsCmds {
 		rootCmd.AddCommand(cmd)
 	}
-	ppsCmds, err := ppscmds.Cmds(address, &noMetrics)
+	ppsCmds, err := ppscmds.Cmds(address, &noMetrics, &output)
 	if err != nil {
 		return nil, sanitizeErr(err)
 	}
@@ -75,6 +99,10 @@ Environment variables:
 	for _, cmd := range deployCmds {
 		rootCmd.AddCommand(cmd)
 	}
+	adminCmds := admincmds.Cmds(address, &noMetrics, &output)
+	for _, cmd := range adminCmds {
+		rootCmd.AddCommand(cmd)
+	}
 
 	versionCmd := &cobra.Command{
 		Use:   "version",
@@ -138,60 +166,87 @@ This resets the cluster to its initial state.`,
 		}),
 	}
 	var port int
+	var httpPort int
 	var uiPort int
 	var uiWebsocketPort int
 	var kubeCtlFlags string
+	var kubeconfig string
+	var kubeContext string
+	var kubeNamespace string
 	portForward := &cobra.Command{
 		Use:   "port-forward",
 		Short: "Forward a port on the local machine to pachd. This command blocks.",
-		Long:  "Forward a port on the local machine to pachd. This command blocks.",
-		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+		Long: `Forward a port on the local machine to pachd. This command blocks.
 
+It talks to Kubernetes directly, so kubectl doesn't need to be installed, and
+finds the cluster in the same kubeconfig files as kubectl (--kubeconfig, or
+$KUBECONFIG, or ~/.kube/config). If pachd's pod is restarted, new connections
+are forwarded to its replacement once it's running.`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			if kubeCtlFlags != "" {
+				return fmt.Errorf("--kubectlflags is no longer supported, as port-forward doesn't run kubectl, use --kubeconfig, --context and --namespace instead")
+			}
+			config, defaultNamespace, err := portforward.KubeConfig(kubeconfig, kubeContext)
+			if err != nil {
+				return err
+			}
+			if kubeNamespace == "" {
+				kubeNamespace = defaultNamespace
+			}
+			forwarder, err := portforward.New(config, kubeNamespace)
+			if err != nil {
+				return err
+			}
+			pachd := map[string]string{"app": "pachd"}
+			dash := map[string]string{"app": "dash"}
+			forwards := []struct {
+				name       string
+				selector   map[string]string
+				localPort  int
+				remotePort int
+			}{
+				{"Pachd", pachd, port, 650},
+				{"Pachd HTTP", pachd, httpPort, 652},
+				{"Dash UI", dash, uiPort, 8080},
+				{"Dash websocket", dash, uiWebsocketPort, 8081},
+			}
+			dashRunning, err := forwarder.Running(dash)
+			if err != nil {
+				return err
+			}
 			var eg errgroup.Group
-
-			eg.Go(func() error {
-				stdin := strings.NewReader(fmt.Sprintf(`
-pod=$(kubectl %v get pod -l app=pachd | awk '{if (NR!=1) { print $1; exit 0 }}')
-kubectl %v port-forward "$pod" %d:650
-`, kubeCtlFlags, kubeCtlFlags, port))
-				return cmdutil.RunIO(cmdutil.IO{
-					Stdin:  stdin,
-					Stderr: os.Stderr,
-				}, "sh")
-			})
-
-			eg.Go(func() error {
-				stdin := strings.NewReader(fmt.Sprintf(`
-pod=$(kubectl %v get pod -l app=dash | awk '{if (NR!=1) { print $1; exit 0 }}')
-kubectl %v port-forward "$pod" %d:8080
-`, kubeCtlFlags, kubeCtlFlags, uiPort))
-				if err := cmdutil.RunIO(cmdutil.IO{
-					Stdin: stdin,
-				}, "sh"); err != nil {
-					return fmt.Errorf("UI not enabled, deploy with --dashboard")
+			for _, forward := range forwards {
+				forward := forward
+				if !dashRunning && forward.selector["app"] == "dash" {
+					continue
 				}
-				return nil
-			})
-
-			eg.Go(func() error {
-				stdin := strings.NewReader(fmt.Sprintf(`
-pod=$(kubectl %v get pod -l app=dash | awk '{if (NR!=1) { print $1; exit 0 }}')
-kubectl %v port-forward "$pod" %d:8081
-`, kubeCtlFlags, kubeCtlFlags, uiWebsocketPort))
-				cmdutil.RunIO(cmdutil.IO{
-					Stdin: stdin,
-				}, "sh")
-				return nil
-			})
-
-			fmt.Printf("Pachd port forwarded\nDash websocket port forwarded\nDash UI port forwarded, navigate to localhost:%v\nCTRL-C to exit", uiPort)
+				listener, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", forward.localPort))
+				if err != nil {
+					return err
+				}
+				fmt.Printf("%s port forwarded from localhost:%d\n", forward.name, forward.localPort)
+				eg.Go(func() error {
+					return forwarder.Forward(listener, forward.selector, forward.remotePort)
+				})
+			}
+			if dashRunning {
+				fmt.Printf("Navigate to localhost:%d to use the dashboard\n", uiPort)
+			} else {
+				fmt.Println("The dashboard isn't deployed, deploy it with `pachctl deploy --dashboard-only`")
+			}
+			fmt.Println("CTRL-C to exit")
 			return eg.Wait()
 		}),
 	}
 	portForward.Flags().IntVarP(&port, "port", "p", 30650, "The local port to bind to.")
+	portForward.Flags().IntVar(&httpPort, "http-port", 30652, "The local port to bind pachd's HTTP API to.")
 	portForward.Flags().IntVarP(&uiPort, "ui-port", "u", 38080, "The local port to bind to.")
 	portForward.Flags().IntVarP(&uiWebsocketPort, "proxy-port", "x", 38081, "The local port to bind to.")
+	portForward.Flags().StringVar(&kubeconfig, "kubeconfig", "", "The kubeconfig file to find the cluster in, rather than $KUBECONFIG or ~/.kube/config.")
+	portForward.Flags().StringVar(&kubeContext, "context", "", "The kubeconfig context to use, rather than the current one.")
+	portForward.Flags().StringVarP(&kubeNamespace, "namespace", "n", "", "The namespace that Pachyderm is deployed in, rather than the context's.")
 	portForward.Flags().StringVarP(&kubeCtlFlags, "kubectlflags", "k", "", "Any kubectl flags to proxy, e.g. --kubectlflags='--kubeconfig /some/path/kubeconfig'")
+	portForward.Flags().MarkDeprecated("kubectlflags", "use --kubeconfig, --context and --namespace instead")
 
 	garbageCollect := &cobra.Command{
 		Use:   "garbage-collect",
@@ -214,6 +269,26 @@ Currently "pachctl garbage-collect" can only be started when there are no active
 		}),
 	}
 
+	compact := &cobra.Command{
+		Use:   "compact",
+		Short: "Compact small objects into larger blocks.",
+		Long: `Compact small objects into larger blocks.
+
+Workloads that make many small commits leave a block in object storage for
+every small object, which makes listing and reading them slow and expensive.
+Compaction copies small objects into larger blocks and updates the metadata
+that refers to them. Compaction can also be run periodically in the
+background by deploying with "--compaction-interval".
+`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			client, err := client.NewMetricsClientFromAddress(address, !noMetrics, "user")
+			if err != nil {
+				return err
+			}
+			return client.Compact()
+		}),
+	}
+
 	var from, to, namespace string
 	migrate := &cobra.Command{
 		Use:   "migrate",
@@ -321,7 +396,14 @@ $ pachctl migrate --from 1.4.8 --to 1.5.0
 	rootCmd.AddCommand(deleteAll)
 	rootCmd.AddCommand(portForward)
 	rootCmd.AddCommand(garbageCollect)
+	rootCmd.AddCommand(compact)
 	rootCmd.AddCommand(migrate)
+	for _, cmd := range configCmds() {
+		rootCmd.AddCommand(cmd)
+	}
+	for _, cmd := range completionCmds(rootCmd, address) {
+		rootCmd.AddCommand(cmd)
+	}
 	return rootCmd, nil
 }
 
@@ -333,6 +415,63 @@ func getVersionAPIClient(address string) (versionpb.APIClient, error) {
 	return versionpb.NewAPIClient(clientConn), nil
 }
 
+// skipCompatibilityCheck is the set of commands that don't check that
+// pachctl is compatible with pachd before they run, because they don't talk
+// to pachd or are meant to fix incompatibilities.
+var skipCompatibilityCheck = map[string]bool{
+	"help":         true,
+	"version":      true,
+	"deploy":       true,
+	"undeploy":     true,
+	"port-forward": true,
+	"migrate":      true,
+	"completion":   true,
+	"__complete":   true,
+	"config":       true,
+}
+
+// checkCompatibility returns an error if pachctl and the pachd at address
+// have different major versions, or if pachd's metadata needs to be migrated
+// before it can be used. If pachd can't be reached no error is returned,
+// the command itself will report that.
+func checkCompatibility(address string) error {
+	clientConn, err := grpc.Dial(address, grpc.WithInsecure())
+	if err != nil {
+		return nil
+	}
+	defer clientConn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	pachdVersion, err := versionpb.NewAPIClient(clientConn).GetVersion(ctx, &types.Empty{})
+	if err != nil {
+		return nil
+	}
+	if pachdVersion.Major != version.Version.Major {
+		return fmt.Errorf("pachctl %s is incompatible with pachd %s, install pachctl %d.x", version.PrettyPrintVersion(version.Version), version.PrettyPrintVersion(pachdVersion), pachdVersion.Major)
+	}
+	if pachdVersion.Minor != version.Version.Minor {
+		fmt.Fprintf(os.Stderr, "WARNING: pachctl %s and pachd %s are different minor versions, some commands may not work\n", version.PrettyPrintVersion(version.Version), version.PrettyPrintVersion(pachdVersion))
+	}
+	clusterInfo, err := admin.NewAPIClient(clientConn).InspectCluster(ctx, &types.Empty{})
+	if err != nil {
+		// pachds that predate the admin API can't report their metadata
+		// version.
+		return nil
+	}
+	if clusterInfo.MetadataVersion == "" {
+		return nil
+	}
+	var major, minor, micro uint32
+	if _, err := fmt.Sscanf(clusterInfo.MetadataVersion, "%d.%d.%d", &major, &minor, &micro); err != nil {
+		return fmt.Errorf("unable to parse the cluster's metadata version %q: %v", clusterInfo.MetadataVersion, err)
+	}
+	if major != pachdVersion.Major || minor != pachdVersion.Minor {
+		return fmt.Errorf("the cluster's metadata is from version %s but pachd is version %s, the metadata needs to be migrated first with:\n$ pachctl migrate --from %s --to %s",
+			clusterInfo.MetadataVersion, version.PrettyPrintVersion(pachdVersion), clusterInfo.MetadataVersion, version.PrettyPrintVersionNoAdditional(pachdVersion))
+	}
+	return nil
+}
+
 func printVersionHeader(w io.Writer) {
 	fmt.Fprintf(w, "COMPONENT\tVERSION\t\n")
 }
@@ -0,0 +1,397 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	pfsclient "github.com/pachyderm/pachyderm/src/client/pfs"
+	ppsclient "github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// completeTimeout is how long completing a resource name waits for pachd, so
+// that tab doesn't hang when it can't be reached.
+const completeTimeout = 2 * time.Second
+
+// completionArgs are the kinds of resources that commands' positional
+// arguments name, in order, which are completed with the names of the
+// resources in the cluster. "" is an argument that isn't completed. A branch
+// is completed with the branches of the repo in the argument before it.
+var completionArgs = map[string][]string{
+	"update-repo":      {"repo"},
+	"inspect-repo":     {"repo"},
+	"delete-repo":      {"repo"},
+	"start-commit":     {"repo", "branch"},
+	"finish-commit":    {"repo", "branch"},
+	"inspect-commit":   {"repo", "branch"},
+	"list-commit":      {"repo", "branch"},
+	"find-commit":      {"repo"},
+	"subscribe-commit": {"repo", "branch"},
+	"delete-commit":    {"repo", "branch"},
+	"list-branch":      {"repo"},
+	"set-branch":       {"repo", "branch"},
+	"delete-branch":    {"repo", "branch"},
+	"put-file":         {"repo", "branch"},
+	"get-file":         {"repo", "branch"},
+	"inspect-file":     {"repo", "branch"},
+	"list-file":        {"repo", "branch"},
+	"glob-file":        {"repo", "branch"},
+	"diff-file":        {"repo", "branch", "", "repo", "branch"},
+	"delete-file":      {"repo", "branch"},
+	"acquire-lease":    {"repo", "branch"},
+	"renew-lease":      {"repo", "branch"},
+	"release-lease":    {"repo", "branch"},
+	"list-lease":       {"repo", "branch"},
+	"replicate":        {"repo", "branch"},
+	"edit-pipeline":    {"pipeline"},
+	"diff-pipeline":    {"pipeline"},
+	"inspect-pipeline": {"pipeline"},
+	"delete-pipeline":  {"pipeline"},
+	"start-pipeline":   {"pipeline"},
+	"stop-pipeline":    {"pipeline"},
+	"run-pipeline":     {"pipeline"},
+}
+
+// completionFlags are the flags, by command, whose values are pipeline
+// names.
+var completionFlags = map[string][]string{
+	"list-job":  {"pipeline"},
+	"flush-job": {"pipeline"},
+	"get-logs":  {"pipeline"},
+}
+
+// completionFunctions are the bash functions that complete resource names,
+// which the generated completions call. They list the names with pachctl
+// __complete, and nouns, cur and COMPREPLY are the variables that cobra's
+// completions use for the arguments so far, the word being completed and
+// the completions.
+const completionFunctions = `__pachctl_complete()
+{
+    local names
+    names=$(pachctl __complete "$@" 2>/dev/null) || return
+    COMPREPLY=( $(compgen -W "${names}" -- "$cur") )
+}
+
+__pachctl_get_pipelines()
+{
+    __pachctl_complete pipelines
+}
+
+__pachctl_complete_args()
+{
+    local kinds=("$@")
+    local n=${#nouns[@]}
+    case "${kinds[$n]}" in
+        repo)
+            __pachctl_complete repos
+            ;;
+        branch)
+            __pachctl_complete branches "${nouns[$((n-1))]}"
+            ;;
+        pipeline)
+            __pachctl_complete pipelines
+            ;;
+    esac
+}
+`
+
+// bashCompletionFunction returns the bash that cobra's completions call when
+// they have nothing to complete, which completes the arguments in
+// completionArgs.
+func bashCompletionFunction() string {
+	var names []string
+	for name := range completionArgs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var buf bytes.Buffer
+	buf.WriteString(completionFunctions)
+	buf.WriteString("\n__custom_func()\n{\n    case ${last_command} in\n")
+	for _, name := range names {
+		kinds := make([]string, len(completionArgs[name]))
+		for i, kind := range completionArgs[name] {
+			kinds[i] = fmt.Sprintf("%q", kind)
+		}
+		fmt.Fprintf(&buf, "        pachctl_%s)\n            __pachctl_complete_args %s\n            ;;\n", name, strings.Join(kinds, " "))
+	}
+	buf.WriteString("    esac\n}\n")
+	return buf.String()
+}
+
+// zshHead and zshTail wrap the bash completions so that zsh can run them with
+// bashcompinit. The shims stand in for the parts of bash and bash-completion
+// that bashcompinit doesn't emulate, and the script is rewritten to use them
+// as it's sourced.
+const zshHead = `#compdef pachctl
+
+__pachctl_bash_source() {
+	alias shopt=':'
+	alias _expand=_bash_expand
+	alias _complete=_bash_comp
+	emulate -L sh
+	setopt kshglob noshglob braceexpand
+
+	source "$@"
+}
+
+__pachctl_type() {
+	# -t isn't supported by zsh
+	if [ "$1" == "-t" ]; then
+		shift
+		# Pretend compopt is a builtin, so that "complete -o nospace" isn't
+		# used, trailing spaces are always left on.
+		if [ "$1" = "__pachctl_compopt" ]; then
+			echo builtin
+			return 0
+		fi
+	fi
+	type "$@"
+}
+
+__pachctl_compgen() {
+	local completions w
+	completions=( $(compgen "$@") ) || return $?
+	# Filter by the word being completed, which compgen doesn't do in zsh.
+	while [[ "$1" = -* && "$1" != -- ]]; do
+		shift
+		shift
+	done
+	if [[ "$1" == -- ]]; then
+		shift
+	fi
+	for w in "${completions[@]}"; do
+		if [[ "${w}" = "$1"* ]]; then
+			echo "${w}"
+		fi
+	done
+}
+
+__pachctl_compopt() {
+	true # bashcompinit doesn't support compopt
+}
+
+__pachctl_declare() {
+	if [ "$1" == "-F" ]; then
+		whence -w "$@"
+	else
+		builtin declare "$@"
+	fi
+}
+
+__pachctl_ltrim_colon_completions()
+{
+	if [[ "$1" == *:* && "$COMP_WORDBREAKS" == *:* ]]; then
+		# Remove the colon-word prefix from COMPREPLY's items
+		local colon_word=${1%${1##*:}}
+		local i=${#COMPREPLY[*]}
+		while [[ $((--i)) -ge 0 ]]; do
+			COMPREPLY[$i]=${COMPREPLY[$i]#"$colon_word"}
+		done
+	fi
+}
+
+__pachctl_get_comp_words_by_ref() {
+	cur="${COMP_WORDS[COMP_CWORD]}"
+	prev="${COMP_WORDS[${COMP_CWORD}-1]}"
+	words=("${COMP_WORDS[@]}")
+	cword=("${COMP_CWORD[@]}")
+}
+
+__pachctl_filedir() {
+	local RET OLD_IFS w qw
+	OLD_IFS="$IFS"
+	IFS=$'\n'
+	if [ "$1" = "-d" ]; then
+		shift
+		RET=( $(compgen -d) )
+	else
+		RET=( $(compgen -f) )
+	fi
+	IFS="$OLD_IFS"
+	for w in ${RET[@]}; do
+		if [[ ! "${w}" = "${cur}"* ]]; then
+			continue
+		fi
+		if eval "[[ \"\${w}\" = *.$1 || -d \"\${w}\" ]]"; then
+			qw="$(printf %q "${w}")"
+			if [ -d "${w}" ]; then
+				COMPREPLY+=("${qw}/")
+			else
+				COMPREPLY+=("${qw}")
+			fi
+		fi
+	done
+}
+
+autoload -U +X bashcompinit && bashcompinit
+
+# Word boundary patterns for BSD or GNU sed.
+LWORD='[[:<:]]'
+RWORD='[[:>:]]'
+if sed --help 2>&1 | grep -q GNU; then
+	LWORD='\<'
+	RWORD='\>'
+fi
+
+__pachctl_convert_bash_to_zsh() {
+	sed \
+	-e 's/declare -F/whence -w/' \
+	-e 's/_get_comp_words_by_ref "\$@"/_get_comp_words_by_ref "\$*"/' \
+	-e 's/local \([a-zA-Z0-9_]*\)=/local \1; \1=/' \
+	-e 's/flags+=("\(--.*\)=")/flags+=("\1"); two_word_flags+=("\1")/' \
+	-e 's/must_have_one_flag+=("\(--.*\)=")/must_have_one_flag+=("\1")/' \
+	-e "s/${LWORD}_filedir${RWORD}/__pachctl_filedir/g" \
+	-e "s/${LWORD}_get_comp_words_by_ref${RWORD}/__pachctl_get_comp_words_by_ref/g" \
+	-e "s/${LWORD}__ltrim_colon_completions${RWORD}/__pachctl_ltrim_colon_completions/g" \
+	-e "s/${LWORD}compgen${RWORD}/__pachctl_compgen/g" \
+	-e "s/${LWORD}compopt${RWORD}/__pachctl_compopt/g" \
+	-e "s/${LWORD}declare${RWORD}/__pachctl_declare/g" \
+	-e "s/\\\$(type${RWORD}/\$(__pachctl_type/g" \
+	<<'BASH_COMPLETION_EOF'
+`
+
+const zshTail = `
+BASH_COMPLETION_EOF
+}
+
+__pachctl_bash_source <(__pachctl_convert_bash_to_zsh)
+`
+
+// completionCmds returns the completion command, which prints completions
+// for rootCmd, and the hidden command that they call to list the names of
+// resources in the cluster.
+func completionCmds(rootCmd *cobra.Command, address string) []*cobra.Command {
+	completion := &cobra.Command{
+		Use:       "completion bash|zsh",
+		Short:     "Print shell completions for pachctl.",
+		ValidArgs: []string{"bash", "zsh"},
+		Long: `Print shell completions for pachctl.
+
+Besides pachctl's commands and flags, the names of repos, branches and
+pipelines in the cluster are completed, by asking pachd for them.
+
+Examples:
+
+` + "```" + `sh
+# load completions in bash, which needs the bash-completion package
+$ source <(pachctl completion bash)
+
+# or load them every time bash starts
+$ pachctl completion bash > /etc/bash_completion.d/pachctl
+
+# load completions in zsh
+$ source <(pachctl completion zsh)
+` + "```",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			switch args[0] {
+			case "bash":
+				return writeBashCompletion(rootCmd, os.Stdout)
+			case "zsh":
+				return writeZshCompletion(rootCmd, os.Stdout)
+			}
+			return fmt.Errorf("unsupported shell %q, the supported shells are bash and zsh", args[0])
+		}),
+	}
+
+	complete := &cobra.Command{
+		Use:    "__complete repos|branches <repo>|pipelines",
+		Short:  "List the names of resources, for shell completions.",
+		Hidden: true,
+		Run: cmdutil.RunBoundedArgs(1, 2, func(args []string) error {
+			names, err := completeNames(address, args[0], args[1:])
+			if err != nil {
+				return err
+			}
+			for _, name := range names {
+				fmt.Println(name)
+			}
+			return nil
+		}),
+	}
+	return []*cobra.Command{completion, complete}
+}
+
+func writeBashCompletion(rootCmd *cobra.Command, w io.Writer) error {
+	// The completions are named after the root command, whose name is how
+	// pachctl was run, e.g. ./pachctl, but they complete "pachctl".
+	rootCmd.Use = "pachctl"
+	rootCmd.BashCompletionFunction = bashCompletionFunction()
+	for _, cmd := range rootCmd.Commands() {
+		for _, flag := range completionFlags[cmd.Name()] {
+			if err := cmd.MarkFlagCustom(flag, "__pachctl_get_pipelines"); err != nil {
+				return err
+			}
+		}
+	}
+	return rootCmd.GenBashCompletion(w)
+}
+
+func writeZshCompletion(rootCmd *cobra.Command, w io.Writer) error {
+	if _, err := io.WriteString(w, zshHead); err != nil {
+		return err
+	}
+	if err := writeBashCompletion(rootCmd, w); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, zshTail)
+	return err
+}
+
+// completeNames returns the names of the resources of kind in the cluster,
+// args is the repo that branches are in.
+func completeNames(address string, kind string, args []string) ([]string, error) {
+	// The dial doesn't block, the calls below time out instead.
+	clientConn, err := grpc.Dial(address, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	defer clientConn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), completeTimeout)
+	defer cancel()
+	var names []string
+	switch kind {
+	case "repos":
+		resp, err := pfsclient.NewAPIClient(clientConn).ListRepo(ctx, &pfsclient.ListRepoRequest{})
+		if err != nil {
+			return nil, err
+		}
+		for _, repoInfo := range resp.RepoInfo {
+			names = append(names, repoInfo.Repo.Name)
+		}
+	case "branches":
+		if len(args) != 1 {
+			return nil, fmt.Errorf("branches are completed for a repo")
+		}
+		resp, err := pfsclient.NewAPIClient(clientConn).ListBranch(ctx, &pfsclient.ListBranchRequest{
+			Repo: client.NewRepo(args[0]),
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, branch := range resp.Branches {
+			names = append(names, branch.Name)
+		}
+	case "pipelines":
+		resp, err := ppsclient.NewAPIClient(clientConn).ListPipeline(ctx, &ppsclient.ListPipelineRequest{})
+		if err != nil {
+			return nil, err
+		}
+		for _, pipelineInfo := range resp.PipelineInfo {
+			names = append(names, pipelineInfo.Pipeline.Name)
+		}
+	default:
+		return nil, fmt.Errorf("unrecognized kind of resource %q", kind)
+	}
+	sort.Strings(names)
+	return names, nil
+}
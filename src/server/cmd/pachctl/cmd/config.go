@@ -0,0 +1,165 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/config"
+	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
+
+	"github.com/spf13/cobra"
+)
+
+// defaultPachdAddress is the address of pachd if neither ADDRESS nor a
+// context says otherwise, which port-forward forwards to.
+const defaultPachdAddress = "0.0.0.0:30650"
+
+// pachdAddress returns the address of pachd that pachctl talks to: address,
+// the value of ADDRESS, if it's set, otherwise the active context's address,
+// otherwise defaultPachdAddress.
+func pachdAddress(address string) string {
+	if address != "" {
+		return address
+	}
+	cfg, err := config.Read()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "WARNING: error reading the pachctl config: %v\n", err)
+		return defaultPachdAddress
+	}
+	contextAddress, err := cfg.PachdAddress()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "WARNING: %v\n", err)
+		return defaultPachdAddress
+	}
+	if contextAddress != "" {
+		return contextAddress
+	}
+	return defaultPachdAddress
+}
+
+// configCmds returns the config command, which manages the contexts in
+// pachctl's config, and its subcommands.
+func configCmds() []*cobra.Command {
+	configCmd := &cobra.Command{
+		Use:   "config",
+		Short: "Manage the pachd clusters that pachctl talks to.",
+		Long: `Manage the pachd clusters that pachctl talks to.
+
+Clusters are saved as contexts in ~/.pachyderm/config.json. pachctl talks to
+the pachd of the active context, unless ADDRESS is set, which takes
+precedence. Without either, pachctl talks to ` + defaultPachdAddress + `.`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			return nil
+		}),
+	}
+
+	getContexts := &cobra.Command{
+		Use:   "get-contexts",
+		Short: "List the contexts.",
+		Long:  "List the contexts, the active one is marked with a *.",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			cfg, err := config.Read()
+			if err != nil {
+				return err
+			}
+			var names []string
+			for name := range cfg.Contexts {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			writer := tabwriter.NewWriter(os.Stdout, 20, 1, 3, ' ', 0)
+			fmt.Fprint(writer, "ACTIVE\tNAME\tPACHD ADDRESS\t\n")
+			for _, name := range names {
+				active := ""
+				if name == cfg.ActiveContext {
+					active = "*"
+				}
+				fmt.Fprintf(writer, "%s\t%s\t%s\t\n", active, name, cfg.Contexts[name].PachdAddress)
+			}
+			return writer.Flush()
+		}),
+	}
+
+	var pachdAddressFlag string
+	setContext := &cobra.Command{
+		Use:   "set-context context-name --pachd-address host:port",
+		Short: "Create or update a context.",
+		Long: `Create or update a context.
+
+Examples:
+
+` + "```" + `sh
+# save a cluster as the context "prod", and talk to it
+$ pachctl config set-context prod --pachd-address 10.0.0.5:650
+$ pachctl config use-context prod
+` + "```",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			if pachdAddressFlag == "" {
+				return fmt.Errorf("--pachd-address must be set")
+			}
+			cfg, err := config.Read()
+			if err != nil {
+				return err
+			}
+			if cfg.Contexts == nil {
+				cfg.Contexts = make(map[string]*config.Context)
+			}
+			cfg.Contexts[args[0]] = &config.Context{
+				PachdAddress: pachdAddressFlag,
+			}
+			return config.Write(cfg)
+		}),
+	}
+	setContext.Flags().StringVar(&pachdAddressFlag, "pachd-address", "", "The address of the context's pachd, as host:port.")
+
+	useContext := &cobra.Command{
+		Use:   "use-context context-name",
+		Short: "Make a context the active one.",
+		Long:  "Make a context the active one, which pachctl talks to unless ADDRESS is set.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			cfg, err := config.Read()
+			if err != nil {
+				return err
+			}
+			if _, ok := cfg.Contexts[args[0]]; !ok {
+				return fmt.Errorf("context %q not found, create it with set-context", args[0])
+			}
+			cfg.ActiveContext = args[0]
+			if err := config.Write(cfg); err != nil {
+				return err
+			}
+			if os.Getenv("ADDRESS") != "" {
+				fmt.Fprintf(os.Stderr, "WARNING: ADDRESS is set, which takes precedence over the active context\n")
+			}
+			return nil
+		}),
+	}
+
+	deleteContext := &cobra.Command{
+		Use:   "delete-context context-name",
+		Short: "Delete a context.",
+		Long:  "Delete a context. If it's the active one, no context is active afterwards.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			cfg, err := config.Read()
+			if err != nil {
+				return err
+			}
+			if _, ok := cfg.Contexts[args[0]]; !ok {
+				return fmt.Errorf("context %q not found", args[0])
+			}
+			delete(cfg.Contexts, args[0])
+			if cfg.ActiveContext == args[0] {
+				cfg.ActiveContext = ""
+			}
+			return config.Write(cfg)
+		}),
+	}
+
+	configCmd.AddCommand(getContexts)
+	configCmd.AddCommand(setContext)
+	configCmd.AddCommand(useContext)
+	configCmd.AddCommand(deleteContext)
+	return []*cobra.Command{configCmd}
+}
@@ -3,13 +3,19 @@ package main
 import (
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	_ "net/http/pprof"
 	"os"
+	"os/signal"
+	"path"
 	"strings"
+	"syscall"
+	"time"
 
 	units "github.com/docker/go-units"
 	"github.com/pachyderm/pachyderm/src/client"
+	adminclient "github.com/pachyderm/pachyderm/src/client/admin"
 	healthclient "github.com/pachyderm/pachyderm/src/client/health"
 	pfsclient "github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/discovery"
@@ -18,16 +24,24 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/pkg/uuid"
 	ppsclient "github.com/pachyderm/pachyderm/src/client/pps"
 	"github.com/pachyderm/pachyderm/src/client/version"
+	admin_server "github.com/pachyderm/pachyderm/src/server/admin/server"
+	"github.com/pachyderm/pachyderm/src/server/gateway"
 	"github.com/pachyderm/pachyderm/src/server/health"
 	pfs_server "github.com/pachyderm/pachyderm/src/server/pfs/server"
+	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
 	cache_pb "github.com/pachyderm/pachyderm/src/server/pkg/cache/groupcachepb"
 	cache_server "github.com/pachyderm/pachyderm/src/server/pkg/cache/server"
 	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/diskcache"
 	"github.com/pachyderm/pachyderm/src/server/pkg/metrics"
 	"github.com/pachyderm/pachyderm/src/server/pkg/migration"
 	"github.com/pachyderm/pachyderm/src/server/pkg/netutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
+	"github.com/pachyderm/pachyderm/src/server/pkg/readonly"
+	"github.com/pachyderm/pachyderm/src/server/pkg/s3gateway"
 	pps_server "github.com/pachyderm/pachyderm/src/server/pps/server"
 
+	"github.com/prometheus/client_golang/prometheus"
 	flag "github.com/spf13/pflag"
 	"go.pedge.io/lion"
 	"go.pedge.io/lion/proto"
@@ -37,6 +51,11 @@ import (
 	kube "k8s.io/kubernetes/pkg/client/unversioned"
 )
 
+// sidecarShutdownMargin is how much longer than the worker's grace period a
+// sidecar keeps serving for, when both are shutting down, so that the worker
+// can checkpoint its jobs after cancelling its datums.
+const sidecarShutdownMargin = 15 * time.Second
+
 var mode string
 var readinessCheck bool
 var migrate string
@@ -50,12 +69,16 @@ func init() {
 
 type appEnv struct {
 	Port                  uint16 `env:"PORT,default=650"`
+	HTTPPort              uint16 `env:"HTTP_PORT,default=652"`
+	GRPCWebPort           uint16 `env:"GRPC_WEB_PORT,default=653"`
+	S3GatewayPort         uint16 `env:"S3_GATEWAY_PORT,default=0"`
 	NumShards             uint64 `env:"NUM_SHARDS,default=32"`
 	StorageRoot           string `env:"PACH_ROOT,default=/pach"`
 	StorageBackend        string `env:"STORAGE_BACKEND,default="`
 	StorageHostPath       string `env:"STORAGE_HOST_PATH,default="`
 	PPSEtcdPrefix         string `env:"PPS_ETCD_PREFIX,default=pachyderm_pps"`
 	PFSEtcdPrefix         string `env:"PFS_ETCD_PREFIX,default=pachyderm_pfs"`
+	PFSMetadataStore      string `env:"PFS_METADATA_STORE,default="`
 	KubeAddress           string `env:"KUBERNETES_PORT_443_TCP_ADDR,required"`
 	EtcdAddress           string `env:"ETCD_PORT_2379_TCP_ADDR,required"`
 	Namespace             string `env:"NAMESPACE,default=default"`
@@ -63,10 +86,34 @@ type appEnv struct {
 	Init                  bool   `env:"INIT,default=false"`
 	BlockCacheBytes       string `env:"BLOCK_CACHE_BYTES,default=1G"`
 	PFSCacheBytes         string `env:"PFS_CACHE_BYTES,default=500M"`
+	BlockSize             string `env:"BLOCK_SIZE,default=8M"`
 	WorkerImage           string `env:"WORKER_IMAGE,default="`
 	WorkerSidecarImage    string `env:"WORKER_SIDECAR_IMAGE,default="`
 	WorkerImagePullPolicy string `env:"WORKER_IMAGE_PULL_POLICY,default="`
 	LogLevel              string `env:"LOG_LEVEL,default=info"`
+	// CompactionInterval, if set, makes pachd compact small objects in the
+	// background this often (e.g. "1h").
+	CompactionInterval string `env:"COMPACTION_INTERVAL,default="`
+	// HistoryTrimmingInterval is how often pachd drops the commits that are
+	// older than their repo's retention. If empty, they're never dropped.
+	HistoryTrimmingInterval string `env:"HISTORY_TRIMMING_INTERVAL,default=10m"`
+	// StorageUploadConcurrency is the number of parts of an object that are
+	// uploaded to object storage at once.
+	StorageUploadConcurrency int `env:"STORAGE_UPLOAD_CONCURRENCY,default=0"`
+	// DiskCacheBytes, if set, is the size of a cache of objects on local
+	// disk, in DiskCacheDir.
+	DiskCacheBytes string `env:"DISK_CACHE_BYTES,default="`
+	DiskCacheDir   string `env:"DISK_CACHE_DIR,default=/tmp/pach-disk-cache"`
+	// MetricsExport, if set, is a file or an http(s) URL that pachd writes
+	// the usage events it reports to, whether or not METRICS is on.
+	MetricsExport string `env:"METRICS_EXPORT,default="`
+	// PreferredZone, if set, is the zone that pipelines' workers prefer to
+	// be scheduled in.
+	PreferredZone string `env:"PREFERRED_ZONE,default="`
+	// ShutdownTimeout is how long pachd waits for the requests in progress
+	// to finish when it's shutting down. It should be less than the pod's
+	// termination grace period, which is 30s by default.
+	ShutdownTimeout string `env:"SHUTDOWN_TIMEOUT,default=25s"`
 }
 
 func main() {
@@ -81,6 +128,7 @@ func main() {
 }
 
 func doSidecarMode(appEnvObj interface{}) error {
+	http.Handle("/metrics", prometheus.Handler())
 	go func() {
 		lion.Println(http.ListenAndServe(":651", nil))
 	}()
@@ -96,6 +144,9 @@ func doSidecarMode(appEnvObj interface{}) error {
 		lion.Errorf("Unrecognized log level %s, falling back to default of \"info\"", appEnv.LogLevel)
 		lion.SetLevel(lion.LevelInfo)
 	}
+	if err := grpcutil.SetMaxMsgSizeFromEnv(); err != nil {
+		return err
+	}
 
 	etcdAddress := fmt.Sprintf("http://%s:2379", appEnv.EtcdAddress)
 	etcdClient := getEtcdClient(etcdAddress)
@@ -109,7 +160,12 @@ func doSidecarMode(appEnvObj interface{}) error {
 		return err
 	}
 	var reporter *metrics.Reporter
-	if appEnv.Metrics {
+	if appEnv.MetricsExport != "" {
+		reporter, err = metrics.NewExportingReporter(clusterID, kubeClient, appEnv.Metrics, appEnv.MetricsExport)
+		if err != nil {
+			return err
+		}
+	} else if appEnv.Metrics {
 		reporter = metrics.NewReporter(clusterID, kubeClient)
 	}
 	address, err := netutil.ExternalIP()
@@ -121,7 +177,11 @@ func doSidecarMode(appEnvObj interface{}) error {
 	if err != nil {
 		return err
 	}
-	pfsAPIServer, err := pfs_server.NewAPIServer(address, []string{etcdAddress}, appEnv.PFSEtcdPrefix, pfsCacheBytes)
+	blockSize, err := units.RAMInBytes(appEnv.BlockSize)
+	if err != nil {
+		return err
+	}
+	pfsAPIServer, err := pfs_server.NewAPIServer(address, []string{etcdAddress}, appEnv.PFSEtcdPrefix, appEnv.PFSMetadataStore, pfsCacheBytes, blockSize, nil, 0)
 	if err != nil {
 		return err
 	}
@@ -138,11 +198,67 @@ func doSidecarMode(appEnvObj interface{}) error {
 	if err != nil {
 		return err
 	}
-	blockAPIServer, err := pfs_server.NewBlockAPIServer(appEnv.StorageRoot, blockCacheBytes, appEnv.StorageBackend, etcdAddress)
+	if appEnv.StorageUploadConcurrency > 0 {
+		obj.SetUploadConcurrency(appEnv.StorageUploadConcurrency)
+	}
+	diskCache, err := newDiskCache(appEnv)
+	if err != nil {
+		return err
+	}
+	blockAPIServer, err := pfs_server.NewBlockAPIServer(appEnv.StorageRoot, blockCacheBytes, diskCache, appEnv.StorageBackend, etcdAddress)
 	if err != nil {
 		return err
 	}
 	healthServer := health.NewHealthServer()
+	readOnlyGate := readonly.NewGate(etcdAddress)
+	go func() {
+		// The gateway talks to pachd over gRPC, so it can't connect until
+		// grpcutil.Serve below is up.
+		var httpServer http.Handler
+		if err := backoff.RetryNotify(func() error {
+			var err error
+			httpServer, err = gateway.NewHTTPServer(fmt.Sprintf("localhost:%d", appEnv.Port))
+			return err
+		}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
+			protolion.Errorf("error creating HTTP gateway: %v; retrying in %s", err, d)
+			return nil
+		}); err != nil {
+			protolion.Errorf("error creating HTTP gateway: %v", err)
+			return
+		}
+		protolion.Errorf("error from HTTP gateway: %v", http.ListenAndServe(fmt.Sprintf(":%d", appEnv.HTTPPort), httpServer))
+	}()
+	if appEnv.S3GatewayPort != 0 {
+		// Serve the worker's datums, which are in the volume that it
+		// shares with us, to the user code in the same pod.
+		s3Server, err := s3gateway.NewServer(client.PPSInputPrefix, []string{path.Base(client.PPSOutputPath)})
+		if err != nil {
+			return err
+		}
+		go func() {
+			protolion.Errorf("error from S3 gateway: %v", http.ListenAndServe(fmt.Sprintf("localhost:%d", appEnv.S3GatewayPort), s3Server))
+		}()
+	}
+	shutdownTimeout, err := time.ParseDuration(appEnv.ShutdownTimeout)
+	if err != nil {
+		return err
+	}
+	// When the pod is deleted, Kubernetes sends SIGTERM to the worker and to
+	// us at once. The worker needs us to upload the outputs of the datums
+	// that it's finishing, so keep serving until it's exited, and closed its
+	// port, or its grace period is up.
+	shutdown := make(chan struct{})
+	terminated := make(chan os.Signal, 1)
+	signal.Notify(terminated, syscall.SIGTERM)
+	go func() {
+		<-terminated
+		deadline := time.Now().Add(client.PPSWorkerShutdownGracePeriod + sidecarShutdownMargin)
+		for time.Now().Before(deadline) && workerRunning() {
+			time.Sleep(time.Second)
+		}
+		protolion.Infof("sidecar is shutting down, waiting up to %v for requests in progress", shutdownTimeout)
+		close(shutdown)
+	}()
 	return grpcutil.Serve(
 		func(s *grpc.Server) {
 			pfsclient.RegisterAPIServer(s, pfsAPIServer)
@@ -151,8 +267,12 @@ func doSidecarMode(appEnvObj interface{}) error {
 			healthclient.RegisterHealthServer(s, healthServer)
 		},
 		grpcutil.ServeOptions{
-			Version:    version.Version,
-			MaxMsgSize: grpcutil.MaxMsgSize,
+			Version:           version.Version,
+			MaxMsgSize:        grpcutil.MaxMsgSize,
+			UnaryInterceptor:  readOnlyGate.UnaryInterceptor,
+			StreamInterceptor: readOnlyGate.StreamInterceptor,
+			Shutdown:          shutdown,
+			ShutdownTimeout:   shutdownTimeout,
 		},
 		grpcutil.ServeEnv{
 			GRPCPort: appEnv.Port,
@@ -174,6 +294,7 @@ func doFullMode(appEnvObj interface{}) error {
 		return nil
 	}
 
+	http.Handle("/metrics", prometheus.Handler())
 	go func() {
 		lion.Println(http.ListenAndServe(":651", nil))
 	}()
@@ -188,6 +309,9 @@ func doFullMode(appEnvObj interface{}) error {
 		lion.Errorf("Unrecognized log level %s, falling back to default of \"info\"", appEnv.LogLevel)
 		lion.SetLevel(lion.LevelInfo)
 	}
+	if err := grpcutil.SetMaxMsgSizeFromEnv(); err != nil {
+		return err
+	}
 	etcdAddress := fmt.Sprintf("http://%s:2379", appEnv.EtcdAddress)
 	etcdClient := getEtcdClient(etcdAddress)
 	if readinessCheck {
@@ -217,12 +341,20 @@ func doFullMode(appEnvObj interface{}) error {
 	if err != nil {
 		return err
 	}
+	if err := migration.InitMetadataVersion(appEnv.EtcdAddress, appEnv.PFSEtcdPrefix, version.PrettyPrintVersionNoAdditional(version.Version)); err != nil {
+		return err
+	}
 	kubeClient, err := getKubeClient(appEnv)
 	if err != nil {
 		return err
 	}
 	var reporter *metrics.Reporter
-	if appEnv.Metrics {
+	if appEnv.MetricsExport != "" {
+		reporter, err = metrics.NewExportingReporter(clusterID, kubeClient, appEnv.Metrics, appEnv.MetricsExport)
+		if err != nil {
+			return err
+		}
+	} else if appEnv.Metrics {
 		reporter = metrics.NewReporter(clusterID, kubeClient)
 	}
 	address, err := netutil.ExternalIP()
@@ -252,7 +384,11 @@ func doFullMode(appEnvObj interface{}) error {
 		address,
 	)
 	cacheServer := cache_server.NewCacheServer(router, appEnv.NumShards)
-	pfsAPIServer, err := pfs_server.NewAPIServer(address, []string{etcdAddress}, appEnv.PFSEtcdPrefix, pfsCacheBytes)
+	blockSize, err := units.RAMInBytes(appEnv.BlockSize)
+	if err != nil {
+		return err
+	}
+	pfsAPIServer, err := pfs_server.NewAPIServer(address, []string{etcdAddress}, appEnv.PFSEtcdPrefix, appEnv.PFSMetadataStore, pfsCacheBytes, blockSize, router, appEnv.NumShards)
 	if err != nil {
 		return err
 	}
@@ -268,18 +404,20 @@ func doFullMode(appEnvObj interface{}) error {
 		appEnv.StorageRoot,
 		appEnv.StorageBackend,
 		appEnv.StorageHostPath,
+		appEnv.PFSMetadataStore != "",
+		appEnv.PreferredZone,
 		reporter,
 	)
 	if err != nil {
 		return err
 	}
 	go func() {
-		if err := sharder.RegisterFrontends(nil, address, []shard.Frontend{cacheServer}); err != nil {
+		if err := sharder.RegisterFrontends(nil, address, []shard.Frontend{cacheServer, pfsAPIServer}); err != nil {
 			protolion.Printf("error from sharder.RegisterFrontend %s", sanitizeErr(err))
 		}
 	}()
 	go func() {
-		if err := sharder.Register(nil, address, []shard.Server{cacheServer}); err != nil {
+		if err := sharder.Register(nil, address, []shard.Server{cacheServer, pfsAPIServer}); err != nil {
 			protolion.Printf("error from sharder.Register %s", sanitizeErr(err))
 		}
 	}()
@@ -287,29 +425,90 @@ func doFullMode(appEnvObj interface{}) error {
 	if err != nil {
 		return err
 	}
-	blockAPIServer, err := pfs_server.NewBlockAPIServer(appEnv.StorageRoot, blockCacheBytes, appEnv.StorageBackend, etcdAddress)
+	if appEnv.StorageUploadConcurrency > 0 {
+		obj.SetUploadConcurrency(appEnv.StorageUploadConcurrency)
+	}
+	diskCache, err := newDiskCache(appEnv)
+	if err != nil {
+		return err
+	}
+	blockAPIServer, err := pfs_server.NewBlockAPIServer(appEnv.StorageRoot, blockCacheBytes, diskCache, appEnv.StorageBackend, etcdAddress)
+	if err != nil {
+		return err
+	}
+	if appEnv.CompactionInterval != "" {
+		compactionInterval, err := time.ParseDuration(appEnv.CompactionInterval)
+		if err != nil {
+			return err
+		}
+		go pfs_server.RunCompaction(etcdAddress, appEnv.PFSEtcdPrefix, compactionInterval, blockAPIServer)
+	}
+	if appEnv.HistoryTrimmingInterval != "" {
+		historyTrimmingInterval, err := time.ParseDuration(appEnv.HistoryTrimmingInterval)
+		if err != nil {
+			return err
+		}
+		go pfs_server.RunHistoryTrimming(etcdAddress, appEnv.PFSEtcdPrefix, historyTrimmingInterval, pfsAPIServer)
+	}
+	adminAPIServer, err := admin_server.NewAPIServer(address, etcdAddress)
 	if err != nil {
 		return err
 	}
 	healthServer := health.NewHealthServer()
+	readOnlyGate := readonly.NewGate(etcdAddress)
+	shutdownTimeout, err := time.ParseDuration(appEnv.ShutdownTimeout)
+	if err != nil {
+		return err
+	}
+	// When the pod is deleted, e.g. in a rolling restart or because its node
+	// is being drained, Kubernetes sends SIGTERM. Stop taking writes, hand
+	// the PPS master over to another pachd, and let the requests in progress
+	// finish before exiting, so that none of them are left half done.
+	shutdown := make(chan struct{})
+	terminated := make(chan os.Signal, 1)
+	signal.Notify(terminated, syscall.SIGTERM)
+	go func() {
+		<-terminated
+		protolion.Infof("pachd is shutting down, waiting up to %v for requests in progress", shutdownTimeout)
+		readOnlyGate.Drain()
+		ppsAPIServer.Shutdown()
+		close(shutdown)
+	}()
 	return grpcutil.Serve(
 		func(s *grpc.Server) {
 			pfsclient.RegisterAPIServer(s, pfsAPIServer)
 			pfsclient.RegisterObjectAPIServer(s, blockAPIServer)
 			ppsclient.RegisterAPIServer(s, ppsAPIServer)
+			adminclient.RegisterAPIServer(s, adminAPIServer)
 			cache_pb.RegisterGroupCacheServer(s, cacheServer)
 			healthclient.RegisterHealthServer(s, healthServer)
 		},
 		grpcutil.ServeOptions{
-			Version:    version.Version,
-			MaxMsgSize: grpcutil.MaxMsgSize,
+			Version:           version.Version,
+			MaxMsgSize:        grpcutil.MaxMsgSize,
+			UnaryInterceptor:  readOnlyGate.UnaryInterceptor,
+			StreamInterceptor: readOnlyGate.StreamInterceptor,
+			Shutdown:          shutdown,
+			ShutdownTimeout:   shutdownTimeout,
 		},
 		grpcutil.ServeEnv{
-			GRPCPort: appEnv.Port,
+			GRPCPort:    appEnv.Port,
+			GRPCWebPort: appEnv.GRPCWebPort,
 		},
 	)
 }
 
+// workerRunning returns true if the worker in the sidecar's pod is still
+// serving on its port.
+func workerRunning() bool {
+	conn, err := net.DialTimeout("tcp", fmt.Sprintf("localhost:%d", client.PPSWorkerPort), time.Second)
+	if err != nil {
+		return false
+	}
+	conn.Close()
+	return true
+}
+
 func getEtcdClient(etcdAddress string) discovery.Client {
 	return discovery.NewEtcdClient(etcdAddress)
 }
@@ -361,3 +560,19 @@ func sanitizeErr(err error) error {
 
 	return errors.New(grpc.ErrorDesc(err))
 }
+
+// newDiskCache returns the disk cache described by appEnv, or nil if there
+// shouldn't be one.
+func newDiskCache(appEnv *appEnv) (*diskcache.Cache, error) {
+	if appEnv.DiskCacheBytes == "" {
+		return nil, nil
+	}
+	diskCacheBytes, err := units.RAMInBytes(appEnv.DiskCacheBytes)
+	if err != nil {
+		return nil, err
+	}
+	if diskCacheBytes == 0 {
+		return nil, nil
+	}
+	return diskcache.New(appEnv.DiskCacheDir, diskCacheBytes)
+}
@@ -6,7 +6,10 @@ import (
 	"log"
 	"net/http"
 	_ "net/http/pprof"
+	"os"
+	"os/signal"
 	"path"
+	"syscall"
 	"time"
 
 	"go.pedge.io/lion"
@@ -49,6 +52,14 @@ type appEnv struct {
 }
 
 func main() {
+	// The worker binary is also run to set up the mount namespaces of
+	// datums that are processed concurrently.
+	if len(os.Args) > 1 && os.Args[1] == worker.NamespaceArg {
+		if err := worker.RunNamespace(os.Args[2:]); err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+	}
 	cmdutil.Main(do, &appEnv{})
 }
 
@@ -152,9 +163,25 @@ func do(appEnvObj interface{}) error {
 		return fmt.Errorf("error putting IP address: %v", err)
 	}
 
-	// If server ever exits, return error
-	if err := eg.Wait(); err != nil {
+	// When the pod is deleted, e.g. because its node is being reclaimed,
+	// Kubernetes sends SIGTERM. Stop taking datums, give the ones in
+	// progress a chance to finish, so that the master retries as few as
+	// possible on other workers, and exit.
+	terminated := make(chan os.Signal, 1)
+	signal.Notify(terminated, syscall.SIGTERM)
+	served := make(chan error, 1)
+	go func() { served <- eg.Wait() }()
+	select {
+	case err := <-served:
+		// If server ever exits, return error
 		return err
+	case <-terminated:
+		ctx, cancel = context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if _, err := etcdClient.Revoke(ctx, resp.ID); err != nil {
+			lion.Printf("error removing worker's address from etcd: %v", err)
+		}
+		apiServer.Shutdown(client.PPSWorkerShutdownGracePeriod)
+		return nil
 	}
-	return nil
 }
@@ -0,0 +1,320 @@
+// Package gateway serves a subset of the PFS and PPS APIs as HTTP/JSON, for
+// clients that can't speak gRPC. Requests are forwarded to pachd's gRPC API,
+// so they're subject to the same checks as any other request.
+//
+// Routes:
+//
+//	GET    /v1/pfs/repos
+//	GET    /v1/pfs/repos/{repo}
+//	POST   /v1/pfs/repos/{repo}
+//	DELETE /v1/pfs/repos/{repo}
+//	GET    /v1/pfs/repos/{repo}/branches
+//	GET    /v1/pfs/repos/{repo}/commits
+//	POST   /v1/pfs/repos/{repo}/commits?branch={branch}&parent={commit}
+//	GET    /v1/pfs/repos/{repo}/commits/{commit}
+//	POST   /v1/pfs/repos/{repo}/commits/{commit}/finish
+//	GET    /v1/pfs/repos/{repo}/commits/{commit}/files/{path}
+//	PUT    /v1/pfs/repos/{repo}/commits/{commit}/files/{path}
+//	DELETE /v1/pfs/repos/{repo}/commits/{commit}/files/{path}
+//	GET    /v1/pps/jobs?pipeline={pipeline}
+//	GET    /v1/pps/jobs/{job}
+//	GET    /v1/pps/pipelines
+//	GET    /v1/pps/pipelines/{pipeline}
+//	POST   /v1/webhooks/github
+//
+// GET on a directory returns its FileInfos, GET on a regular file returns
+// its content. /v1/webhooks/github receives GitHub push events for
+// pipelines' git inputs.
+package gateway
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"go.pedge.io/lion/proto"
+)
+
+const (
+	pfsPrefix = "/v1/pfs/"
+	ppsPrefix = "/v1/pps/"
+)
+
+type server struct {
+	pachClient *client.APIClient
+	marshaler  *jsonpb.Marshaler
+}
+
+// NewHTTPServer returns an http.Handler which serves the HTTP/JSON API by
+// making requests to the pachd at pachdAddress.
+func NewHTTPServer(pachdAddress string) (http.Handler, error) {
+	pachClient, err := client.NewFromAddress(pachdAddress)
+	if err != nil {
+		return nil, err
+	}
+	s := &server{
+		pachClient: pachClient,
+		marshaler:  &jsonpb.Marshaler{Indent: "  "},
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc(pfsPrefix, s.handlePFS)
+	mux.HandleFunc(ppsPrefix, s.handlePPS)
+	mux.HandleFunc(githubWebhookPath, s.githubWebhook)
+	return mux, nil
+}
+
+func (s *server) handlePFS(w http.ResponseWriter, r *http.Request) {
+	rest := strings.TrimPrefix(r.URL.Path, pfsPrefix)
+	if rest == "repos" || rest == "repos/" {
+		s.listRepo(w, r)
+		return
+	}
+	if !strings.HasPrefix(rest, "repos/") {
+		http.NotFound(w, r)
+		return
+	}
+	// repo, "commits", commit, "files", path
+	parts := strings.SplitN(strings.TrimPrefix(rest, "repos/"), "/", 5)
+	repo := parts[0]
+	switch {
+	case len(parts) == 1:
+		s.repo(w, r, repo)
+	case len(parts) == 2 && parts[1] == "branches":
+		s.listBranch(w, r, repo)
+	case len(parts) == 2 && parts[1] == "commits":
+		s.commits(w, r, repo)
+	case len(parts) == 3 && parts[1] == "commits":
+		s.inspectCommit(w, r, repo, parts[2])
+	case len(parts) == 4 && parts[1] == "commits" && parts[3] == "finish":
+		s.finishCommit(w, r, repo, parts[2])
+	case len(parts) >= 4 && parts[1] == "commits" && parts[3] == "files":
+		var path string
+		if len(parts) == 5 {
+			path = parts[4]
+		}
+		s.file(w, r, repo, parts[2], path)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *server) listRepo(w http.ResponseWriter, r *http.Request) {
+	if !checkMethod(w, r, "GET") {
+		return
+	}
+	repoInfos, err := s.pachClient.ListRepo(nil)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	s.write(w, &pfs.RepoInfos{RepoInfo: repoInfos})
+}
+
+func (s *server) repo(w http.ResponseWriter, r *http.Request, repo string) {
+	switch r.Method {
+	case "GET":
+		repoInfo, err := s.pachClient.InspectRepo(repo)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		s.write(w, repoInfo)
+	case "POST":
+		if err := s.pachClient.CreateRepo(repo); err != nil {
+			writeError(w, err)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	case "DELETE":
+		if err := s.pachClient.DeleteRepo(repo, r.URL.Query().Get("force") == "true"); err != nil {
+			writeError(w, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w, "GET", "POST", "DELETE")
+	}
+}
+
+func (s *server) listBranch(w http.ResponseWriter, r *http.Request, repo string) {
+	if !checkMethod(w, r, "GET") {
+		return
+	}
+	branches, err := s.pachClient.ListBranch(repo)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	s.write(w, &pfs.Branches{Branches: branches})
+}
+
+func (s *server) commits(w http.ResponseWriter, r *http.Request, repo string) {
+	switch r.Method {
+	case "GET":
+		commitInfos, err := s.pachClient.ListCommit(repo, r.URL.Query().Get("to"), r.URL.Query().Get("from"), 0)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		s.write(w, &pfs.CommitInfos{CommitInfo: commitInfos})
+	case "POST":
+		commit, err := s.pachClient.StartCommitParent(repo, r.URL.Query().Get("branch"), r.URL.Query().Get("parent"))
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		s.write(w, commit)
+	default:
+		methodNotAllowed(w, "GET", "POST")
+	}
+}
+
+func (s *server) inspectCommit(w http.ResponseWriter, r *http.Request, repo string, commit string) {
+	if !checkMethod(w, r, "GET") {
+		return
+	}
+	commitInfo, err := s.pachClient.InspectCommit(repo, commit)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	s.write(w, commitInfo)
+}
+
+func (s *server) finishCommit(w http.ResponseWriter, r *http.Request, repo string, commit string) {
+	if !checkMethod(w, r, "POST") {
+		return
+	}
+	if err := s.pachClient.FinishCommit(repo, commit); err != nil {
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *server) file(w http.ResponseWriter, r *http.Request, repo string, commit string, path string) {
+	switch r.Method {
+	case "GET":
+		fileInfo, err := s.pachClient.InspectFile(repo, commit, path)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		if fileInfo.FileType == pfs.FileType_DIR {
+			fileInfos, err := s.pachClient.ListFile(repo, commit, path)
+			if err != nil {
+				writeError(w, err)
+				return
+			}
+			s.write(w, &pfs.FileInfos{FileInfo: fileInfos})
+			return
+		}
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Length", fmt.Sprintf("%d", fileInfo.SizeBytes))
+		if err := s.pachClient.GetFile(repo, commit, path, 0, 0, w); err != nil {
+			// The headers have been sent, all we can do is log the error,
+			// the client sees a short body.
+			protolion.Errorf("error getting file %s@%s:%s: %v", repo, commit, path, err)
+		}
+	case "PUT", "POST":
+		if path == "" {
+			http.Error(w, "a file path is required", http.StatusBadRequest)
+			return
+		}
+		if _, err := s.pachClient.PutFile(repo, commit, path, r.Body); err != nil {
+			writeError(w, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case "DELETE":
+		if err := s.pachClient.DeleteFile(repo, commit, path); err != nil {
+			writeError(w, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		methodNotAllowed(w, "GET", "PUT", "POST", "DELETE")
+	}
+}
+
+func (s *server) handlePPS(w http.ResponseWriter, r *http.Request) {
+	if !checkMethod(w, r, "GET") {
+		return
+	}
+	parts := strings.Split(strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, ppsPrefix), "/"), "/")
+	switch {
+	case len(parts) == 1 && parts[0] == "jobs":
+		jobInfos, err := s.pachClient.ListJob(r.URL.Query().Get("pipeline"), nil)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		s.write(w, &pps.JobInfos{JobInfo: jobInfos})
+	case len(parts) == 2 && parts[0] == "jobs":
+		jobInfo, err := s.pachClient.InspectJob(parts[1], r.URL.Query().Get("block") == "true")
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		s.write(w, jobInfo)
+	case len(parts) == 1 && parts[0] == "pipelines":
+		pipelineInfos, err := s.pachClient.ListPipeline()
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		s.write(w, &pps.PipelineInfos{PipelineInfo: pipelineInfos})
+	case len(parts) == 2 && parts[0] == "pipelines":
+		pipelineInfo, err := s.pachClient.InspectPipeline(parts[1])
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		s.write(w, pipelineInfo)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *server) write(w http.ResponseWriter, message proto.Message) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := s.marshaler.Marshal(w, message); err != nil {
+		writeError(w, err)
+	}
+}
+
+func checkMethod(w http.ResponseWriter, r *http.Request, method string) bool {
+	if r.Method != method {
+		methodNotAllowed(w, method)
+		return false
+	}
+	return true
+}
+
+func methodNotAllowed(w http.ResponseWriter, methods ...string) {
+	w.Header().Set("Allow", strings.Join(methods, ", "))
+	http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+}
+
+// writeError writes err as a JSON object, with a status code derived from
+// the error. The client sanitizes errors, so the code has to be inferred
+// from the message.
+func writeError(w http.ResponseWriter, err error) {
+	status := http.StatusInternalServerError
+	switch {
+	case strings.Contains(err.Error(), "not found"):
+		status = http.StatusNotFound
+	case strings.Contains(err.Error(), "read-only mode"):
+		status = http.StatusConflict
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,216 @@
+package gateway
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pkg/uuid"
+	"github.com/pachyderm/pachyderm/src/client/version"
+	pfsserver "github.com/pachyderm/pachyderm/src/server/pfs/server"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+)
+
+var port int32 = 31751
+
+// getPachdAddress runs PFS against a local block store and the etcd at
+// localhost:32379, and returns its address.
+func getPachdAddress(t *testing.T) string {
+	root, err := ioutil.TempDir("", "pachyderm-gateway-test-")
+	require.NoError(t, err)
+	port := atomic.AddInt32(&port, 1)
+	address := fmt.Sprintf("localhost:%d", port)
+	blockAPIServer, err := pfsserver.NewLocalBlockAPIServer(root)
+	require.NoError(t, err)
+	apiServer, err := pfsserver.NewAPIServer(address, []string{"localhost:32379"}, uuid.NewWithoutDashes(), "", 1<<20, pfsserver.DefaultBlockSize, nil, 0)
+	require.NoError(t, err)
+	ready := make(chan bool)
+	go func() {
+		err := grpcutil.Serve(
+			func(s *grpc.Server) {
+				pfs.RegisterAPIServer(s, apiServer)
+				pfs.RegisterObjectAPIServer(s, blockAPIServer)
+				close(ready)
+			},
+			grpcutil.ServeOptions{
+				Version:    version.Version,
+				MaxMsgSize: grpcutil.MaxMsgSize,
+			},
+			grpcutil.ServeEnv{GRPCPort: uint16(port)},
+		)
+		require.NoError(t, err)
+	}()
+	<-ready
+	return address
+}
+
+func do(t *testing.T, s *httptest.Server, method string, path string, body string) (*http.Response, string) {
+	req, err := http.NewRequest(method, s.URL+path, strings.NewReader(body))
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp, string(data)
+}
+
+// get makes a GET request that should succeed, and unmarshals its response
+// into message. The response is decoded with golang's jsonpb, because the
+// PFS and PPS enums are only registered with golang's proto package.
+func get(t *testing.T, s *httptest.Server, path string, message proto.Message) {
+	resp, body := do(t, s, "GET", path, "")
+	require.Equal(t, http.StatusOK, resp.StatusCode, body)
+	require.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+	require.NoError(t, jsonpb.UnmarshalString(body, message))
+}
+
+func requireStatus(t *testing.T, s *httptest.Server, method string, path string, body string, status int) string {
+	resp, respBody := do(t, s, method, path, body)
+	require.Equal(t, status, resp.StatusCode, respBody)
+	return respBody
+}
+
+func TestPFS(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipped because of short mode")
+	}
+	handler, err := NewHTTPServer(getPachdAddress(t))
+	require.NoError(t, err)
+	s := httptest.NewServer(handler)
+	defer s.Close()
+
+	requireStatus(t, s, "POST", "/v1/pfs/repos/images", "", http.StatusCreated)
+	var repoInfos pfs.RepoInfos
+	get(t, s, "/v1/pfs/repos", &repoInfos)
+	require.Equal(t, 1, len(repoInfos.RepoInfo))
+	require.Equal(t, "images", repoInfos.RepoInfo[0].Repo.Name)
+	var repoInfo pfs.RepoInfo
+	get(t, s, "/v1/pfs/repos/images", &repoInfo)
+	require.Equal(t, "images", repoInfo.Repo.Name)
+
+	var commit pfs.Commit
+	body := requireStatus(t, s, "POST", "/v1/pfs/repos/images/commits?branch=master", "", http.StatusOK)
+	require.NoError(t, jsonpb.UnmarshalString(body, &commit))
+	commitPath := "/v1/pfs/repos/images/commits/" + commit.ID
+	requireStatus(t, s, "PUT", commitPath+"/files/dir/a.txt", "hello\n", http.StatusNoContent)
+	requireStatus(t, s, "POST", commitPath+"/files/b.txt", "b", http.StatusNoContent)
+	requireStatus(t, s, "DELETE", commitPath+"/files/b.txt", "", http.StatusNoContent)
+	requireStatus(t, s, "PUT", commitPath+"/files/", "data", http.StatusBadRequest)
+	requireStatus(t, s, "POST", commitPath+"/finish", "", http.StatusNoContent)
+
+	var commitInfo pfs.CommitInfo
+	get(t, s, commitPath, &commitInfo)
+	require.Equal(t, commit.ID, commitInfo.Commit.ID)
+	require.True(t, commitInfo.Finished != nil)
+	var commitInfos pfs.CommitInfos
+	get(t, s, "/v1/pfs/repos/images/commits", &commitInfos)
+	require.Equal(t, 1, len(commitInfos.CommitInfo))
+	var branches pfs.Branches
+	get(t, s, "/v1/pfs/repos/images/branches", &branches)
+	require.Equal(t, 1, len(branches.Branches))
+	require.Equal(t, "master", branches.Branches[0].Name)
+
+	// Regular files are returned as is, directories as their FileInfos.
+	resp, body := do(t, s, "GET", "/v1/pfs/repos/images/commits/master/files/dir/a.txt", "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "application/octet-stream", resp.Header.Get("Content-Type"))
+	require.Equal(t, "6", resp.Header.Get("Content-Length"))
+	require.Equal(t, "hello\n", body)
+	var fileInfos pfs.FileInfos
+	get(t, s, "/v1/pfs/repos/images/commits/master/files/dir", &fileInfos)
+	require.Equal(t, 1, len(fileInfos.FileInfo))
+	require.Equal(t, "dir/a.txt", fileInfos.FileInfo[0].File.Path)
+	require.Equal(t, pfs.FileType_FILE, fileInfos.FileInfo[0].FileType)
+	require.Equal(t, uint64(6), fileInfos.FileInfo[0].SizeBytes)
+	get(t, s, "/v1/pfs/repos/images/commits/master/files/", &fileInfos)
+	require.Equal(t, 1, len(fileInfos.FileInfo))
+	require.Equal(t, "dir", fileInfos.FileInfo[0].File.Path)
+	require.Equal(t, pfs.FileType_DIR, fileInfos.FileInfo[0].FileType)
+
+	// Errors from pachd are returned as JSON, with a status for the ones
+	// we can recognize.
+	body = requireStatus(t, s, "GET", "/v1/pfs/repos/missing", "", http.StatusNotFound)
+	var e map[string]string
+	require.NoError(t, json.Unmarshal([]byte(body), &e))
+	require.True(t, strings.Contains(e["error"], "missing"))
+	requireStatus(t, s, "GET", "/v1/pfs/repos/images/commits/master/files/missing", "", http.StatusNotFound)
+
+	requireStatus(t, s, "DELETE", "/v1/pfs/repos/images", "", http.StatusNoContent)
+	requireStatus(t, s, "GET", "/v1/pfs/repos/images", "", http.StatusNotFound)
+}
+
+func TestRoutes(t *testing.T) {
+	// Requests that don't match a route are rejected without calling
+	// pachd, so it's enough to serve a gRPC server without any services.
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	grpcServer := grpc.NewServer()
+	go grpcServer.Serve(listener)
+	defer grpcServer.Stop()
+	handler, err := NewHTTPServer(listener.Addr().String())
+	require.NoError(t, err)
+	s := httptest.NewServer(handler)
+	defer s.Close()
+
+	for _, path := range []string{
+		"/v1/pfs/",
+		"/v1/pfs/other",
+		"/v1/pfs/repos/images/other",
+		"/v1/pfs/repos/images/commits/master/other",
+		"/v1/pps/",
+		"/v1/pps/other",
+		"/v1/pps/jobs/id/other",
+		"/v2/pfs/repos",
+	} {
+		requireStatus(t, s, "GET", path, "", http.StatusNotFound)
+	}
+	for _, route := range []struct {
+		method string
+		path   string
+		allow  string
+	}{
+		{"POST", "/v1/pfs/repos", "GET"},
+		{"PATCH", "/v1/pfs/repos/images", "GET, POST, DELETE"},
+		{"POST", "/v1/pfs/repos/images/branches", "GET"},
+		{"PUT", "/v1/pfs/repos/images/commits", "GET, POST"},
+		{"DELETE", "/v1/pfs/repos/images/commits/master", "GET"},
+		{"GET", "/v1/pfs/repos/images/commits/master/finish", "POST"},
+		{"PATCH", "/v1/pfs/repos/images/commits/master/files/a", "GET, PUT, POST, DELETE"},
+		{"POST", "/v1/pps/jobs", "GET"},
+		{"DELETE", "/v1/pps/pipelines/p", "GET"},
+	} {
+		resp, _ := do(t, s, route.method, route.path, "")
+		require.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+		require.Equal(t, route.allow, resp.Header.Get("Allow"))
+	}
+}
+
+func TestWriteError(t *testing.T) {
+	for err, status := range map[error]int{
+		fmt.Errorf("repo images not found"):                           http.StatusNotFound,
+		fmt.Errorf("pachd is in read-only mode"):                      http.StatusConflict,
+		fmt.Errorf("commit 0123 has already been finished"):           http.StatusInternalServerError,
+		fmt.Errorf("rpc error: code = Unavailable desc = connection"): http.StatusInternalServerError,
+	} {
+		w := httptest.NewRecorder()
+		writeError(w, err)
+		require.Equal(t, status, w.Code)
+		require.Equal(t, "application/json", w.Header().Get("Content-Type"))
+		var e map[string]string
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &e))
+		require.Equal(t, err.Error(), e["error"])
+	}
+}
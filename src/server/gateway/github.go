@@ -0,0 +1,272 @@
+package gateway
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client/pps"
+
+	"go.pedge.io/lion/proto"
+)
+
+const (
+	githubWebhookPath = "/v1/webhooks/github"
+	archiveTimeout    = 10 * time.Minute
+	// maxPushEventBytes is the size of the biggest push event that's read,
+	// GitHub doesn't send bigger ones.
+	maxPushEventBytes = 25 * 1024 * 1024
+)
+
+// githubPushEvent is the part of a GitHub push event that we use.
+type githubPushEvent struct {
+	Ref        string `json:"ref"`
+	After      string `json:"after"`
+	Deleted    bool   `json:"deleted"`
+	Repository struct {
+		HTMLURL string `json:"html_url"`
+	} `json:"repository"`
+}
+
+// githubWebhook handles GitHub push events. When a branch that's used by a
+// pipeline's git input is pushed to, and the event is signed with the
+// input's secret, the content of the pushed commit is committed to the
+// input's repo, which triggers the pipeline.
+func (s *server) githubWebhook(w http.ResponseWriter, r *http.Request) {
+	if !checkMethod(w, r, "POST") {
+		return
+	}
+	switch r.Header.Get("X-GitHub-Event") {
+	case "ping":
+		w.WriteHeader(http.StatusOK)
+		return
+	case "push":
+	default:
+		http.Error(w, "only push events are supported", http.StatusBadRequest)
+		return
+	}
+	body, err := ioutil.ReadAll(io.LimitReader(r.Body, maxPushEventBytes+1))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("error reading push event: %v", err), http.StatusBadRequest)
+		return
+	}
+	if len(body) > maxPushEventBytes {
+		http.Error(w, "push event is too big", http.StatusRequestEntityTooLarge)
+		return
+	}
+	var event githubPushEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		http.Error(w, fmt.Sprintf("error parsing push event: %v", err), http.StatusBadRequest)
+		return
+	}
+	if event.Deleted || !strings.HasPrefix(event.Ref, "refs/heads/") {
+		// Nothing to commit for deleted branches or tags.
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	if !gitCommitRE.MatchString(event.After) {
+		http.Error(w, fmt.Sprintf("invalid commit %q", event.After), http.StatusBadRequest)
+		return
+	}
+	branch := strings.TrimPrefix(event.Ref, "refs/heads/")
+	pipelineInfos, err := s.pachClient.ListPipeline()
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	push, matched := matchGitInputs(pipelineInfos, event.Repository.HTMLURL, branch, body, r.Header.Get("X-Hub-Signature"))
+	if !matched {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	if push == nil {
+		http.Error(w, "push event isn't signed with the secret of any git input that it's for", http.StatusUnauthorized)
+		return
+	}
+	// Downloading the archive can take longer than GitHub waits for a
+	// response.
+	go func() {
+		if err := s.commitGitHubPush(push, event.After, branch); err != nil {
+			protolion.Errorf("error committing push of %s to %s: %v", event.After, push.url, err)
+		}
+	}()
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// gitCommitRE matches full git commit IDs.
+var gitCommitRE = regexp.MustCompile("^[0-9a-f]{40}$")
+
+// gitPush is a push to a repository that's used by git inputs.
+type gitPush struct {
+	// url is the repository's URL, as it's configured in the git inputs.
+	url string
+	// repos are the repos of the git inputs that the push is committed to.
+	repos map[string]bool
+}
+
+// matchGitInputs returns the git inputs, among pipelineInfos' inputs, that
+// a push to branch of the repository at url is for, and whose secret body
+// is signed with. matched is false if the push isn't for any git input, the
+// push is nil if it's only for inputs whose secret it isn't signed with.
+func matchGitInputs(pipelineInfos []*pps.PipelineInfo, url string, branch string, body []byte, signature string) (_ *gitPush, matched bool) {
+	url = pps.NormalizeGitURL(url)
+	var push *gitPush
+	for _, pipelineInfo := range pipelineInfos {
+		if pipelineInfo.Input == nil {
+			continue
+		}
+		pps.VisitInput(pipelineInfo.Input, func(input *pps.Input) {
+			if input.Git == nil || pps.NormalizeGitURL(input.Git.URL) != url || input.Git.Branch != branch {
+				return
+			}
+			matched = true
+			if !validSignature(body, signature, input.Git.Secret) {
+				protolion.Errorf("push to %s isn't signed with the secret of pipeline %s's git input", url, pipelineInfo.Pipeline.Name)
+				return
+			}
+			if push == nil {
+				push = &gitPush{url: input.Git.URL, repos: make(map[string]bool)}
+			}
+			push.repos[input.Git.Name] = true
+		})
+	}
+	return push, matched
+}
+
+// validSignature returns true if signature, the X-Hub-Signature header of a
+// webhook request, is the HMAC of body with secret. Nothing is valid for an
+// empty secret.
+func validSignature(body []byte, signature string, secret string) bool {
+	if secret == "" || !strings.HasPrefix(signature, "sha1=") {
+		return false
+	}
+	sum, err := hex.DecodeString(strings.TrimPrefix(signature, "sha1="))
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha1.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(sum, mac.Sum(nil))
+}
+
+// githubArchiveURL returns the URL of the tarball of commit in the GitHub
+// repository at repoURL, which may be an https or ssh URL.
+func githubArchiveURL(repoURL string, commit string) string {
+	return fmt.Sprintf("https://%s/archive/%s.tar.gz", pps.NormalizeGitURL(repoURL), commit)
+}
+
+// commitGitHubPush downloads the pushed commit and commits its content to
+// branch in each of the push's repos, replacing what was there.
+func (s *server) commitGitHubPush(push *gitPush, commit string, branch string) (retErr error) {
+	archive, err := downloadGitHubArchive(githubArchiveURL(push.url, commit))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := os.Remove(archive.Name()); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	defer archive.Close()
+	for repo := range push.repos {
+		if _, err := archive.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		if err := s.commitArchive(repo, branch, archive); err != nil {
+			return fmt.Errorf("error committing to %s: %v", repo, err)
+		}
+	}
+	return nil
+}
+
+// downloadGitHubArchive downloads the tarball at url to a temporary file.
+func downloadGitHubArchive(url string) (_ *os.File, retErr error) {
+	httpClient := &http.Client{Timeout: archiveTimeout}
+	resp, err := httpClient.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error downloading %s: %s", url, resp.Status)
+	}
+	f, err := ioutil.TempFile("", "pachyderm-git-archive")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if retErr != nil {
+			f.Close()
+			os.Remove(f.Name())
+		}
+	}()
+	if _, err := io.Copy(f, resp.Body); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// commitArchive commits the content of a GitHub tarball to branch in repo.
+func (s *server) commitArchive(repo string, branch string, archive io.Reader) (retErr error) {
+	commit, err := s.pachClient.StartCommit(repo, branch)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if retErr != nil {
+			if err := s.pachClient.DeleteCommit(repo, commit.ID); err != nil {
+				protolion.Errorf("error deleting commit %s/%s: %v", repo, commit.ID, err)
+			}
+		}
+	}()
+	// The commit replaces the content of the parent commit, rather than
+	// adding to it.
+	fileInfos, err := s.pachClient.ListFile(repo, commit.ID, "/")
+	if err != nil {
+		return err
+	}
+	for _, fileInfo := range fileInfos {
+		if err := s.pachClient.DeleteFile(repo, commit.ID, fileInfo.File.Path); err != nil {
+			return err
+		}
+	}
+	gz, err := gzip.NewReader(archive)
+	if err != nil {
+		return err
+	}
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if header.Typeflag != tar.TypeReg && header.Typeflag != tar.TypeRegA {
+			continue
+		}
+		// GitHub puts everything in a directory named after the repo
+		// and commit.
+		parts := strings.SplitN(header.Name, "/", 2)
+		if len(parts) < 2 || parts[1] == "" {
+			continue
+		}
+		if _, err := s.pachClient.PutFile(repo, commit.ID, path.Join("/", parts[1]), tr); err != nil {
+			return err
+		}
+	}
+	return s.pachClient.FinishCommit(repo, commit.ID)
+}
@@ -0,0 +1,89 @@
+package gateway
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/hex"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+)
+
+func sign(body []byte, secret string) string {
+	mac := hmac.New(sha1.New, []byte(secret))
+	mac.Write(body)
+	return "sha1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestValidSignature(t *testing.T) {
+	body := []byte(`{"ref":"refs/heads/master"}`)
+	require.True(t, validSignature(body, sign(body, "secret"), "secret"))
+	require.False(t, validSignature(body, sign(body, "other"), "secret"))
+	require.False(t, validSignature([]byte(`{}`), sign(body, "secret"), "secret"))
+	require.False(t, validSignature(body, "", "secret"))
+	require.False(t, validSignature(body, "sha1=zz", "secret"))
+	// Without a secret nothing is valid, not even an unsigned body.
+	require.False(t, validSignature(body, sign(body, ""), ""))
+}
+
+func gitPipeline(name string, url string, branch string, secret string) *pps.PipelineInfo {
+	return &pps.PipelineInfo{
+		Pipeline: &pps.Pipeline{Name: name},
+		Input: &pps.Input{
+			Cross: []*pps.Input{
+				{Atom: &pps.AtomInput{Repo: "other"}},
+				{Git: &pps.GitInput{Name: name, URL: url, Branch: branch, Secret: secret}},
+			},
+		},
+	}
+}
+
+func TestMatchGitInputs(t *testing.T) {
+	pipelineInfos := []*pps.PipelineInfo{
+		gitPipeline("a", "https://github.com/pachyderm/pachyderm.git", "master", "secret"),
+		gitPipeline("b", "git@github.com:pachyderm/pachyderm.git", "master", "secret"),
+		gitPipeline("c", "https://github.com/pachyderm/pachyderm", "master", "other"),
+		gitPipeline("d", "https://github.com/pachyderm/pachyderm", "dev", "secret"),
+		gitPipeline("e", "https://github.com/pachyderm/other", "master", "secret"),
+		{Pipeline: &pps.Pipeline{Name: "f"}},
+	}
+	body := []byte(`{"ref":"refs/heads/master"}`)
+	url := "https://github.com/pachyderm/pachyderm"
+
+	push, matched := matchGitInputs(pipelineInfos, url, "master", body, sign(body, "secret"))
+	require.True(t, matched)
+	require.Equal(t, map[string]bool{"a": true, "b": true}, push.repos)
+	require.Equal(t, "https://github.com/pachyderm/pachyderm/archive/0123.tar.gz", githubArchiveURL(push.url, "0123"))
+
+	push, matched = matchGitInputs(pipelineInfos, url, "master", body, sign(body, "other"))
+	require.True(t, matched)
+	require.Equal(t, map[string]bool{"c": true}, push.repos)
+
+	// Pushes for git inputs that aren't signed with their secret.
+	push, matched = matchGitInputs(pipelineInfos, url, "master", body, sign(body, "wrong"))
+	require.True(t, matched)
+	require.True(t, push == nil)
+	push, matched = matchGitInputs(pipelineInfos, url, "master", body, "")
+	require.True(t, matched)
+	require.True(t, push == nil)
+
+	// Pushes that no git input is for.
+	_, matched = matchGitInputs(pipelineInfos, url, "other", body, sign(body, "secret"))
+	require.False(t, matched)
+	_, matched = matchGitInputs(pipelineInfos, "https://evil.example.com/pachyderm/pachyderm", "master", body, sign(body, "secret"))
+	require.False(t, matched)
+}
+
+func TestGitHubArchiveURL(t *testing.T) {
+	// The archive is always downloaded over https from the configured
+	// repository, whatever form its URL is in.
+	for _, url := range []string{
+		"https://github.com/pachyderm/pachyderm",
+		"https://github.com/pachyderm/pachyderm.git",
+		"http://github.com/pachyderm/pachyderm/",
+		"git@github.com:pachyderm/pachyderm.git",
+	} {
+		require.Equal(t, "https://github.com/pachyderm/pachyderm/archive/0123.tar.gz", githubArchiveURL(url, "0123"))
+	}
+}
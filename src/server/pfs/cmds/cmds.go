@@ -3,27 +3,39 @@ package cmds
 import (
 	"bufio"
 	"bytes"
+	"compress/gzip"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/url"
 	"os"
 	"path"
 	"path/filepath"
+	"sort"
 	"strings"
 	"syscall"
 	"text/tabwriter"
+	"time"
 
 	"golang.org/x/sync/errgroup"
 
+	units "github.com/docker/go-units"
 	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/limit"
 	pfsclient "github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/server/pfs/fuse"
+	ingestpkg "github.com/pachyderm/pachyderm/src/server/pfs/ingest"
+	"github.com/pachyderm/pachyderm/src/server/pfs/ninep"
 	"github.com/pachyderm/pachyderm/src/server/pfs/pretty"
+	"github.com/pachyderm/pachyderm/src/server/pfs/replicate"
+	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
 	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
+	pkgpretty "github.com/pachyderm/pachyderm/src/server/pkg/pretty"
 	"github.com/pachyderm/pachyderm/src/server/pkg/sync"
 
 	"github.com/spf13/cobra"
@@ -39,12 +51,16 @@ const (
 )
 
 // Cmds returns a slice containing pfs commands.
-func Cmds(address string, noMetrics *bool) []*cobra.Command {
+func Cmds(address string, noMetrics *bool, output *string) []*cobra.Command {
 	metrics := !*noMetrics
 	raw := false
 	rawFlag := func(cmd *cobra.Command) {
 		cmd.Flags().BoolVar(&raw, "raw", false, "disable pretty printing, print raw json")
 	}
+	var columnNames string
+	columnsFlag := func(cmd *cobra.Command) {
+		cmd.Flags().StringVar(&columnNames, "columns", "", "comma-separated list of the columns to print")
+	}
 	marshaller := &jsonpb.Marshaler{Indent: "  "}
 
 	repo := &cobra.Command{
@@ -59,27 +75,89 @@ func Cmds(address string, noMetrics *bool) []*cobra.Command {
 	}
 
 	var description string
+	var retention time.Duration
+	var repoLabels cmdutil.RepeatedStringArg
+	var removeLabels cmdutil.RepeatedStringArg
 	createRepo := &cobra.Command{
 		Use:   "create-repo repo-name",
 		Short: "Create a new repo.",
-		Long:  "Create a new repo.",
+		Long: `Create a new repo.
+
+If --retention is set, commits to the repo are dropped once they've been
+finished for longer than it, unless they're the head of a branch or the
+provenance of a commit in another repo, and the data that only they
+referenced is reclaimed.
+
+Labels are key/value pairs, set with --label key=value, that repos can be
+listed by with list-repo --selector.`,
 		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
 			c, err := client.NewMetricsClientFromAddress(address, metrics, "user")
 			if err != nil {
 				return err
 			}
+			labels, err := parseKeyValues(repoLabels, "label")
+			if err != nil {
+				return err
+			}
 
-			_, err = c.PfsAPIClient.CreateRepo(
-				context.Background(),
-				&pfsclient.CreateRepoRequest{
-					Repo:        client.NewRepo(args[0]),
-					Description: description,
-				},
-			)
+			request := &pfsclient.CreateRepoRequest{
+				Repo:        client.NewRepo(args[0]),
+				Description: description,
+				Labels:      labels,
+			}
+			if retention != 0 {
+				request.Retention = types.DurationProto(retention)
+			}
+			_, err = c.PfsAPIClient.CreateRepo(context.Background(), request)
 			return err
 		}),
 	}
 	createRepo.Flags().StringVarP(&description, "description", "d", "", "A description of the repo.")
+	createRepo.Flags().DurationVar(&retention, "retention", 0, "How long commits to the repo are kept after they're finished, e.g. 720h.")
+	createRepo.Flags().Var(&repoLabels, "label", "A label of the repo, as key=value, can be repeated.")
+
+	updateRepo := &cobra.Command{
+		Use:   "update-repo repo-name",
+		Short: "Update a repo's description, retention or labels.",
+		Long: `Update a repo's description, retention or labels, the flags that aren't
+set are left as they are. Setting --retention to 0 removes the repo's
+retention. --label adds a label, or changes its value if the repo already
+has it, and --remove-label removes one.`,
+	}
+	updateRepo.Flags().StringVarP(&description, "description", "d", "", "A description of the repo.")
+	updateRepo.Flags().DurationVar(&retention, "retention", 0, "How long commits to the repo are kept after they're finished, e.g. 720h.")
+	updateRepo.Flags().Var(&repoLabels, "label", "A label to set on the repo, as key=value, can be repeated.")
+	updateRepo.Flags().Var(&removeLabels, "remove-label", "The key of a label to remove from the repo, can be repeated.")
+	updateRepo.Run = cmdutil.RunFixedArgs(1, func(args []string) error {
+		c, err := client.NewMetricsClientFromAddress(address, metrics, "user")
+		if err != nil {
+			return err
+		}
+		repoInfo, err := c.InspectRepo(args[0])
+		if err != nil {
+			return err
+		}
+		labels, err := parseKeyValues(repoLabels, "label")
+		if err != nil {
+			return err
+		}
+		request := &pfsclient.CreateRepoRequest{
+			Repo:         repoInfo.Repo,
+			Provenance:   repoInfo.Provenance,
+			Description:  repoInfo.Description,
+			Labels:       labels,
+			RemoveLabels: removeLabels,
+			Update:       true,
+		}
+		if updateRepo.Flags().Changed("description") {
+			request.Description = description
+		}
+		if updateRepo.Flags().Changed("retention") {
+			request.Retention = types.DurationProto(retention)
+		}
+		_, err = c.PfsAPIClient.CreateRepo(context.Background(), request)
+		return err
+	})
 
 	inspectRepo := &cobra.Command{
 		Use:   "inspect-repo repo-name",
@@ -100,25 +178,66 @@ func Cmds(address string, noMetrics *bool) []*cobra.Command {
 			if raw {
 				return marshaller.Marshal(os.Stdout, repoInfo)
 			}
+			if pkgpretty.Structured(*output) {
+				return pkgpretty.PrintStructured(os.Stdout, *output, repoInfo)
+			}
 			return pretty.PrintDetailedRepoInfo(repoInfo)
 		}),
 	}
 	rawFlag(inspectRepo)
 
 	var listRepoProvenance cmdutil.RepeatedStringArg
+	var sortBy string
+	var reverse bool
+	var prefix string
+	var listRepoProject string
+	var selector string
 	listRepo := &cobra.Command{
 		Use:   "list-repo",
 		Short: "Return all repos.",
-		Long:  "Reutrn all repos.",
+		Long: `Return all repos.
+
+Examples:
+
+` + codestart + `# return all repos, largest first
+$ pachctl list-repo --sort-by size --reverse
+
+# return the repos whose names start with "raw-", newest first
+$ pachctl list-repo --prefix raw- --sort-by created --reverse
+
+# return the repos labelled team=vision that aren't labelled stage=raw
+$ pachctl list-repo --selector team=vision,stage!=raw
+` + codeend,
 		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
 			c, err := client.NewMetricsClientFromAddress(address, metrics, "user")
 			if err != nil {
 				return err
 			}
-			repoInfos, err := c.ListRepo(listRepoProvenance)
+			var provenance []*pfsclient.Repo
+			for _, repoName := range listRepoProvenance {
+				provenance = append(provenance, client.NewRepo(repoName))
+			}
+			resp, err := c.PfsAPIClient.ListRepo(context.Background(), &pfsclient.ListRepoRequest{
+				Provenance:    provenance,
+				Project:       listRepoProject,
+				LabelSelector: selector,
+			})
 			if err != nil {
 				return err
 			}
+			repoInfos := resp.RepoInfo
+			if prefix != "" {
+				var filtered []*pfsclient.RepoInfo
+				for _, repoInfo := range repoInfos {
+					if strings.HasPrefix(repoInfo.Repo.Name, prefix) {
+						filtered = append(filtered, repoInfo)
+					}
+				}
+				repoInfos = filtered
+			}
+			if err := sortRepoInfos(repoInfos, sortBy, reverse); err != nil {
+				return err
+			}
 			if raw {
 				for _, repoInfo := range repoInfos {
 					if err := marshaller.Marshal(os.Stdout, repoInfo); err != nil {
@@ -127,6 +246,9 @@ func Cmds(address string, noMetrics *bool) []*cobra.Command {
 				}
 				return nil
 			}
+			if pkgpretty.Structured(*output) {
+				return pkgpretty.PrintStructured(os.Stdout, *output, repoInfos)
+			}
 			writer := tabwriter.NewWriter(os.Stdout, 20, 1, 3, ' ', 0)
 			pretty.PrintRepoHeader(writer)
 			for _, repoInfo := range repoInfos {
@@ -136,14 +258,25 @@ func Cmds(address string, noMetrics *bool) []*cobra.Command {
 		}),
 	}
 	listRepo.Flags().VarP(&listRepoProvenance, "provenance", "p", "list only repos with the specified repos provenance")
+	listRepo.Flags().StringVar(&sortBy, "sort-by", "", "sort the repos by \"name\", \"size\" or \"created\"; by default they're in the order pachd returns them")
+	listRepo.Flags().BoolVar(&reverse, "reverse", false, "reverse the order of the repos")
+	listRepo.Flags().StringVar(&prefix, "prefix", "", "list only repos whose names start with prefix")
+	listRepo.Flags().StringVar(&listRepoProject, "project", "", "list only the repos in project")
+	listRepo.Flags().StringVarP(&selector, "selector", "l", "", "list only the repos whose labels match the selector, e.g. team=vision,stage!=raw")
 	rawFlag(listRepo)
 
 	var force bool
 	var all bool
+	var prune bool
 	deleteRepo := &cobra.Command{
 		Use:   "delete-repo repo-name",
 		Short: "Delete a repo.",
-		Long:  "Delete a repo.",
+		Long: `Delete a repo.
+
+If --prune is set, the storage that only the repo's commits were using is
+reclaimed as part of the delete, instead of by the next garbage collection.
+Data that's also in other repos is kept, as are objects that were written in
+the last hour, in case they're being written again concurrently.`,
 		Run: cmdutil.RunBoundedArgs(0, 1, func(args []string) error {
 			client, err := client.NewMetricsClientFromAddress(address, metrics, "user")
 			if err != nil {
@@ -155,11 +288,16 @@ func Cmds(address string, noMetrics *bool) []*cobra.Command {
 			if len(args) == 0 && !all {
 				return fmt.Errorf("either a repo name or the --all flag needs to be provided")
 			}
-			if all {
-				_, err = client.PfsAPIClient.DeleteRepo(context.Background(), &pfsclient.DeleteRepoRequest{
+			if all || prune {
+				request := &pfsclient.DeleteRepoRequest{
 					Force: force,
 					All:   all,
-				})
+					Prune: prune,
+				}
+				if !all {
+					request.Repo = &pfsclient.Repo{Name: args[0]}
+				}
+				_, err = client.PfsAPIClient.DeleteRepo(context.Background(), request)
 			} else {
 				err = client.DeleteRepo(args[0], force)
 			}
@@ -171,6 +309,113 @@ func Cmds(address string, noMetrics *bool) []*cobra.Command {
 	}
 	deleteRepo.Flags().BoolVarP(&force, "force", "f", false, "remove the repo regardless of errors; use with care")
 	deleteRepo.Flags().BoolVar(&all, "all", false, "remove all repos")
+	deleteRepo.Flags().BoolVar(&prune, "prune", false, "reclaim the storage that only the deleted repos were using")
+
+	project := &cobra.Command{
+		Use:   "project",
+		Short: "Docs for projects.",
+		Long: `Projects scope the names of repos and pipelines, so that several teams can
+share a cluster without their names colliding.
+
+	Projects are created with create-project. The repos and pipelines in a
+	project are named <project>.<name>, e.g. the repo "images" in project
+	"vision" is "vision.images".`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			return nil
+		}),
+	}
+
+	var projectDescription string
+	createProject := &cobra.Command{
+		Use:   "create-project project-name",
+		Short: "Create a new project.",
+		Long:  "Create a new project.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := client.NewMetricsClientFromAddress(address, metrics, "user")
+			if err != nil {
+				return err
+			}
+			return c.CreateProject(args[0], projectDescription)
+		}),
+	}
+	createProject.Flags().StringVarP(&projectDescription, "description", "d", "", "A description of the project.")
+
+	inspectProject := &cobra.Command{
+		Use:   "inspect-project project-name",
+		Short: "Return info about a project.",
+		Long:  "Return info about a project.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := client.NewMetricsClientFromAddress(address, metrics, "user")
+			if err != nil {
+				return err
+			}
+			projectInfo, err := c.InspectProject(args[0])
+			if err != nil {
+				return err
+			}
+			if raw {
+				return marshaller.Marshal(os.Stdout, projectInfo)
+			}
+			if pkgpretty.Structured(*output) {
+				return pkgpretty.PrintStructured(os.Stdout, *output, projectInfo)
+			}
+			writer := tabwriter.NewWriter(os.Stdout, 20, 1, 3, ' ', 0)
+			pretty.PrintProjectHeader(writer)
+			pretty.PrintProjectInfo(writer, projectInfo)
+			return writer.Flush()
+		}),
+	}
+	rawFlag(inspectProject)
+
+	listProject := &cobra.Command{
+		Use:   "list-project",
+		Short: "Return all projects.",
+		Long:  "Return all projects.",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewMetricsClientFromAddress(address, metrics, "user")
+			if err != nil {
+				return err
+			}
+			projectInfos, err := c.ListProject()
+			if err != nil {
+				return err
+			}
+			if raw {
+				for _, projectInfo := range projectInfos {
+					if err := marshaller.Marshal(os.Stdout, projectInfo); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+			if pkgpretty.Structured(*output) {
+				return pkgpretty.PrintStructured(os.Stdout, *output, projectInfos)
+			}
+			writer := tabwriter.NewWriter(os.Stdout, 20, 1, 3, ' ', 0)
+			pretty.PrintProjectHeader(writer)
+			for _, projectInfo := range projectInfos {
+				pretty.PrintProjectInfo(writer, projectInfo)
+			}
+			return writer.Flush()
+		}),
+	}
+	rawFlag(listProject)
+
+	var deleteProjectForce bool
+	deleteProject := &cobra.Command{
+		Use:   "delete-project project-name",
+		Short: "Delete a project.",
+		Long: `Delete a project. The project has to be empty, unless --force is set, in
+which case the project's pipelines and repos are deleted too.`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := client.NewMetricsClientFromAddress(address, metrics, "user")
+			if err != nil {
+				return err
+			}
+			return c.DeleteProject(args[0], deleteProjectForce)
+		}),
+	}
+	deleteProject.Flags().BoolVarP(&deleteProjectForce, "force", "f", false, "delete the project's pipelines and repos too")
 
 	commit := &cobra.Command{
 		Use:   "commit",
@@ -194,6 +439,8 @@ This layers the data in the commit over the data in the parent.
 	}
 
 	var parent string
+	var message string
+	var commitMetadata cmdutil.RepeatedStringArg
 	startCommit := &cobra.Command{
 		Use:   "start-commit repo-name [branch]",
 		Short: "Start a new commit.",
@@ -212,6 +459,9 @@ $ pachctl start-commit test patch -p master
 
 # Start a commit with XXX as the parent in repo "test", not on any branch
 $ pachctl start-commit test -p XXX
+
+# Start a commit in repo "test" on branch "master" with a description and metadata
+$ pachctl start-commit test master -m "nightly import" --meta source=s3 --meta batch=1234
 ` + codeend,
 		Run: cmdutil.RunBoundedArgs(1, 2, func(args []string) error {
 			client, err := client.NewMetricsClientFromAddress(address, metrics, "user")
@@ -222,7 +472,11 @@ $ pachctl start-commit test -p XXX
 			if len(args) == 2 {
 				branch = args[1]
 			}
-			commit, err := client.StartCommitParent(args[0], branch, parent)
+			metadata, err := parseKeyValues(commitMetadata, "meta")
+			if err != nil {
+				return err
+			}
+			commit, err := client.StartCommitWithMetadata(args[0], branch, parent, message, metadata)
 			if err != nil {
 				return err
 			}
@@ -231,29 +485,57 @@ $ pachctl start-commit test -p XXX
 		}),
 	}
 	startCommit.Flags().StringVarP(&parent, "parent", "p", "", "The parent of the new commit, unneeded if branch is specified and you want to use the previous head of the branch as the parent.")
+	startCommit.Flags().StringVarP(&message, "message", "m", "", "A description of the commit.")
+	startCommit.Flags().Var(&commitMetadata, "meta", "Metadata of the commit, as key=value, can be repeated.")
 
 	finishCommit := &cobra.Command{
 		Use:   "finish-commit repo-name commit-id",
 		Short: "Finish a started commit.",
-		Long:  "Finish a started commit. Commit-id must be a writeable commit.",
+		Long: `Finish a started commit. Commit-id must be a writeable commit.
+
+--message replaces the commit's description, and --meta adds to its
+metadata.`,
 		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
 			client, err := client.NewMetricsClientFromAddress(address, metrics, "user")
 			if err != nil {
 				return err
 			}
-			return client.FinishCommit(args[0], args[1])
+			metadata, err := parseKeyValues(commitMetadata, "meta")
+			if err != nil {
+				return err
+			}
+			return client.FinishCommitWithMetadata(args[0], args[1], message, metadata)
 		}),
 	}
+	finishCommit.Flags().StringVarP(&message, "message", "m", "", "A description of the commit, which replaces the one it was started with.")
+	finishCommit.Flags().Var(&commitMetadata, "meta", "Metadata to add to the commit, as key=value, can be repeated.")
 
+	var provenance string
 	inspectCommit := &cobra.Command{
 		Use:   "inspect-commit repo-name commit-id",
 		Short: "Return info about a commit.",
-		Long:  "Return info about a commit.",
+		Long: `Return info about a commit.
+
+Examples:
+
+` + codestart + `# return info about commit XXX in repo "foo"
+$ pachctl inspect-commit foo XXX
+
+# also show the commits upstream and downstream of it, and the jobs that
+# link them, as trees
+$ pachctl inspect-commit foo XXX --provenance tree
+
+# render the provenance of commit XXX as an image with graphviz
+$ pachctl inspect-commit foo XXX --provenance dot | dot -Tpng > provenance.png
+` + codeend,
 		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
 			client, err := client.NewMetricsClientFromAddress(address, metrics, "user")
 			if err != nil {
 				return err
 			}
+			if provenance != "" && provenance != "tree" && provenance != "dot" {
+				return fmt.Errorf("unrecognized provenance format %q, must be \"tree\" or \"dot\"", provenance)
+			}
 			commitInfo, err := client.InspectCommit(args[0], args[1])
 			if err != nil {
 				return err
@@ -264,13 +546,45 @@ $ pachctl start-commit test -p XXX
 			if raw {
 				return marshaller.Marshal(os.Stdout, commitInfo)
 			}
-			return pretty.PrintDetailedCommitInfo(commitInfo)
+			if pkgpretty.Structured(*output) {
+				return pkgpretty.PrintStructured(os.Stdout, *output, commitInfo)
+			}
+			if provenance == "" {
+				return pretty.PrintDetailedCommitInfo(commitInfo)
+			}
+			graph, err := newProvenanceGraph(client)
+			if err != nil {
+				return err
+			}
+			upstream, err := graph.upstream(commitInfo)
+			if err != nil {
+				return err
+			}
+			downstream, err := graph.downstream(commitInfo.Commit)
+			if err != nil {
+				return err
+			}
+			if provenance == "dot" {
+				printProvenanceDot(os.Stdout, upstream, downstream)
+				return nil
+			}
+			if err := pretty.PrintDetailedCommitInfo(commitInfo); err != nil {
+				return err
+			}
+			fmt.Println("Upstream:")
+			upstream.printTree(os.Stdout, 1)
+			fmt.Println("Downstream:")
+			downstream.printTree(os.Stdout, 1)
+			return nil
 		}),
 	}
 	rawFlag(inspectCommit)
+	inspectCommit.Flags().StringVar(&provenance, "provenance", "", "Also print the commits upstream and downstream of the commit, and the jobs linking them, as indented trees with \"tree\", or as a DOT graph, instead of the commit's info, with \"dot\".")
 
 	var from string
 	var number int
+	var since string
+	var until string
 	listCommit := &cobra.Command{
 		Use:   "list-commit repo-name",
 		Short: "Return all commits on a set of repos.",
@@ -292,6 +606,12 @@ $ pachctl list-commit foo XXX
 
 # return commits in repo "foo" since commit XXX
 $ pachctl list-commit foo master --from XXX
+
+# return commits on branch "master" in repo "foo" started in the last 12 hours
+$ pachctl list-commit foo master --since 12h
+
+# return commits in repo "foo" started in a time range
+$ pachctl list-commit foo --since 2017-06-20T09:00:00Z --until 2017-06-20T17:00:00Z
 ` + codeend,
 		Run: cmdutil.RunBoundedArgs(1, 2, func(args []string) (retErr error) {
 			c, err := client.NewMetricsClientFromAddress(address, metrics, "user")
@@ -303,8 +623,24 @@ $ pachctl list-commit foo master --from XXX
 			if len(args) == 2 {
 				to = args[1]
 			}
+			columns, err := pretty.CommitInfoColumns(columnNames, *output)
+			if err != nil {
+				return err
+			}
+
+			var sinceTime, untilTime time.Time
+			if since != "" {
+				if sinceTime, err = cmdutil.ParseTime(since); err != nil {
+					return err
+				}
+			}
+			if until != "" {
+				if untilTime, err = cmdutil.ParseTime(until); err != nil {
+					return err
+				}
+			}
 
-			commitInfos, err := c.ListCommit(args[0], to, from, uint64(number))
+			commitInfos, err := c.ListCommitByTime(args[0], to, from, uint64(number), sinceTime, untilTime)
 			if err != nil {
 				return err
 			}
@@ -317,17 +653,89 @@ $ pachctl list-commit foo master --from XXX
 				}
 				return nil
 			}
+			if pkgpretty.Structured(*output) {
+				return pkgpretty.PrintStructured(os.Stdout, *output, commitInfos)
+			}
 			writer := tabwriter.NewWriter(os.Stdout, 20, 1, 3, ' ', 0)
-			pretty.PrintCommitInfoHeader(writer)
+			pretty.PrintCommitInfoHeader(writer, columns)
 			for _, commitInfo := range commitInfos {
-				pretty.PrintCommitInfo(writer, commitInfo)
+				pretty.PrintCommitInfo(writer, commitInfo, columns)
 			}
 			return writer.Flush()
 		}),
 	}
 	listCommit.Flags().StringVarP(&from, "from", "f", "", "list all commits since this commit")
 	listCommit.Flags().IntVarP(&number, "number", "n", 0, "list only this many commits; if set to zero, list all commits")
+	listCommit.Flags().StringVar(&since, "since", "", "list only commits started after this time, either a duration ago (\"30d\", \"12h\") or an RFC3339 timestamp")
+	listCommit.Flags().StringVar(&until, "until", "", "list only commits started before this time, either a duration ago (\"30d\", \"12h\") or an RFC3339 timestamp")
 	rawFlag(listCommit)
+	columnsFlag(listCommit)
+
+	var messageContains string
+	var findMetadata cmdutil.RepeatedStringArg
+	findCommit := &cobra.Command{
+		Use:   "find-commit [repo-name]",
+		Short: "Find commits by their description and metadata.",
+		Long: `Find the commits in a repo, or in every repo if none is given, whose
+description contains --message-contains, ignoring case, and whose metadata
+has every --meta pair, newest first.
+
+Examples:
+
+` + codestart + `# find the commits in repo "foo" whose description mentions "sensor-7"
+$ pachctl find-commit foo --message-contains sensor-7
+
+# find the commits in any repo from a particular ingest
+$ pachctl find-commit --meta source=kafka --meta batch=1234
+` + codeend,
+		Run: cmdutil.RunBoundedArgs(0, 1, func(args []string) error {
+			c, err := client.NewMetricsClientFromAddress(address, metrics, "user")
+			if err != nil {
+				return err
+			}
+			var repoName string
+			if len(args) == 1 {
+				repoName = args[0]
+			}
+			metadata, err := parseKeyValues(findMetadata, "meta")
+			if err != nil {
+				return err
+			}
+			if messageContains == "" && len(metadata) == 0 {
+				return fmt.Errorf("at least one of --message-contains and --meta must be set")
+			}
+			columns, err := pretty.CommitInfoColumns(columnNames, *output)
+			if err != nil {
+				return err
+			}
+			commitInfos, err := c.FindCommits(repoName, messageContains, metadata, uint64(number))
+			if err != nil {
+				return err
+			}
+			if raw {
+				for _, commitInfo := range commitInfos {
+					if err := marshaller.Marshal(os.Stdout, commitInfo); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+			if pkgpretty.Structured(*output) {
+				return pkgpretty.PrintStructured(os.Stdout, *output, commitInfos)
+			}
+			writer := tabwriter.NewWriter(os.Stdout, 20, 1, 3, ' ', 0)
+			pretty.PrintCommitInfoHeader(writer, columns)
+			for _, commitInfo := range commitInfos {
+				pretty.PrintCommitInfo(writer, commitInfo, columns)
+			}
+			return writer.Flush()
+		}),
+	}
+	findCommit.Flags().StringVar(&messageContains, "message-contains", "", "find commits whose description contains this")
+	findCommit.Flags().Var(&findMetadata, "meta", "find commits with this metadata, as key=value, can be repeated")
+	findCommit.Flags().IntVarP(&number, "number", "n", 0, "find only this many commits; if set to zero, find all of them")
+	rawFlag(findCommit)
+	columnsFlag(findCommit)
 
 	printCommitIter := func(commitIter client.CommitInfoIterator) error {
 		if raw {
@@ -344,6 +752,24 @@ $ pachctl list-commit foo master --from XXX
 				}
 			}
 		}
+		if pkgpretty.Structured(*output) {
+			for {
+				commitInfo, err := commitIter.Next()
+				if err == io.EOF {
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+				if err := pkgpretty.PrintStructuredStream(os.Stdout, *output, commitInfo); err != nil {
+					return err
+				}
+			}
+		}
+		columns, err := pretty.CommitInfoColumns("", "")
+		if err != nil {
+			return err
+		}
 		writer := tabwriter.NewWriter(os.Stdout, 20, 1, 3, ' ', 0)
 		for {
 			commitInfo, err := commitIter.Next()
@@ -353,8 +779,8 @@ $ pachctl list-commit foo master --from XXX
 			if err != nil {
 				return err
 			}
-			pretty.PrintCommitInfoHeader(writer)
-			pretty.PrintCommitInfo(writer, commitInfo)
+			pretty.PrintCommitInfoHeader(writer, columns)
+			pretty.PrintCommitInfo(writer, commitInfo, columns)
 			if err := writer.Flush(); err != nil {
 				return err
 			}
@@ -454,15 +880,32 @@ $ pachctl subscribe-commit test master --new
 	deleteCommit := &cobra.Command{
 		Use:   "delete-commit repo-name commit-id",
 		Short: "Delete an unfinished commit.",
-		Long:  "Delete an unfinished commit.",
+		Long: `Delete an unfinished commit.
+
+If --prune is set, the storage used by the data that was written to the
+commit is reclaimed as part of the delete. Data that's also in other commits
+is kept, as are objects that were written in the last hour, in case they're
+being written again concurrently, those are reclaimed by a later garbage
+collection.`,
 		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
 			client, err := client.NewMetricsClientFromAddress(address, metrics, "user")
 			if err != nil {
 				return err
 			}
+			if prune {
+				_, err := client.PfsAPIClient.DeleteCommit(context.Background(), &pfsclient.DeleteCommitRequest{
+					Commit: &pfsclient.Commit{
+						Repo: &pfsclient.Repo{Name: args[0]},
+						ID:   args[1],
+					},
+					Prune: true,
+				})
+				return err
+			}
 			return client.DeleteCommit(args[0], args[1])
 		}),
 	}
+	deleteCommit.Flags().BoolVar(&prune, "prune", false, "reclaim the storage used by the data written to the commit")
 
 	listBranch := &cobra.Command{
 		Use:   "list-branch <repo-name>",
@@ -485,6 +928,9 @@ $ pachctl subscribe-commit test master --new
 				}
 				return nil
 			}
+			if pkgpretty.Structured(*output) {
+				return pkgpretty.PrintStructured(os.Stdout, *output, branches)
+			}
 			writer := tabwriter.NewWriter(os.Stdout, 20, 1, 3, ' ', 0)
 			pretty.PrintBranchHeader(writer)
 			for _, branch := range branches {
@@ -500,6 +946,11 @@ $ pachctl subscribe-commit test master --new
 		Short: "Set a commit and its ancestors to a branch",
 		Long: `Set a commit and its ancestors to a branch.
 
+The commit can be any existing commit, so this can move a branch forward, or
+back to revert bad commits. Pipelines that take the branch as input follow it
+back: their output branch is moved back to the output of the job that
+processed the commit, and so on downstream.
+
 Examples:
 
 ` + codestart + `# Set commit XXX and its ancestors as branch master in repo foo.
@@ -508,7 +959,11 @@ $ pachctl set-branch foo XXX master
 # Set the head of branch test as branch master in repo foo.
 # After running this command, "test" and "master" both point to the
 # same commit.
-$ pachctl set-branch foo test master` + codeend,
+$ pachctl set-branch foo test master
+
+# Revert the commits to branch master in repo foo after commit XXX by
+# moving master back to XXX.
+$ pachctl set-branch foo XXX master` + codeend,
 		Run: cmdutil.RunFixedArgs(3, func(args []string) error {
 			client, err := client.NewMetricsClientFromAddress(address, metrics, "user")
 			if err != nil {
@@ -551,6 +1006,10 @@ $ pachctl set-branch foo test master` + codeend,
 	var targetFileDatums uint
 	var targetFileBytes uint
 	var putFileCommit bool
+	var untar bool
+	var fromQuery string
+	var connection string
+	var queryFormat string
 	putFile := &cobra.Command{
 		Use:   "put-file repo-name branch path/to/file/in/pfs",
 		Short: "Put a file into the filesystem.",
@@ -573,9 +1032,15 @@ pachctl put-file -r repo branch path -f dir
 # Put the contents of a directory as repo/branch/dir/file:
 pachctl put-file -r repo branch -f dir
 
+# Put the contents of a directory, uploading up to 50 files at once:
+pachctl put-file -r -p 50 repo branch path -f dir
+
 # Put the data from a URL as repo/branch/path:
 pachctl put-file repo branch path -f http://host/path
 
+# Put the files in a tar stream, with the same paths as in the stream:
+tar c dir | pachctl put-file repo branch --untar
+
 # Put the data from a URL as repo/branch/path:
 pachctl put-file repo branch -f http://host/path
 
@@ -587,6 +1052,10 @@ pachctl put-file repo branch -i file
 # NOTE this URL can reference local files, so it could cause you to put sensitive
 # files into your Pachyderm cluster.
 pachctl put-file repo branch -i http://host/path
+
+# Put the result of a SQL query, run by pachd against the "warehouse"
+# connection in its sql-connections secret, as CSV at repo/branch/path:
+pachctl put-file repo branch path --from-query "SELECT * FROM orders" --connection warehouse
 ` + codeend,
 		Run: cmdutil.RunBoundedArgs(2, 3, func(args []string) (retErr error) {
 			client, err := client.NewMetricsClientFromAddressWithConcurrency(address, metrics, "user", parallelism)
@@ -609,6 +1078,22 @@ pachctl put-file repo branch -i http://host/path
 					}
 				}()
 			}
+			if fromQuery != "" {
+				if path == "" {
+					return fmt.Errorf("a path must be given with --from-query")
+				}
+				if connection == "" {
+					return fmt.Errorf("--connection must be given with --from-query")
+				}
+				return client.PutFileQuery(repoName, branch, path, connection, fromQuery, queryFormat)
+			}
+
+			if untar {
+				if split != "" {
+					return fmt.Errorf("--untar can't be used with --split")
+				}
+				split = "tar"
+			}
 
 			limiter := limit.New(int(parallelism))
 			var sources []string
@@ -653,6 +1138,13 @@ pachctl put-file repo branch -i http://host/path
 			for _, source := range sources {
 				source := source
 				if len(args) == 2 {
+					if untar {
+						// The files are put at their paths in the stream.
+						eg.Go(func() error {
+							return putFileHelper(client, repoName, branch, "", source, false, limiter, split, targetFileDatums, targetFileBytes)
+						})
+						continue
+					}
 					// The user has not specified a path so we use source as path.
 					if source == "-" {
 						return fmt.Errorf("no filename specified")
@@ -680,25 +1172,90 @@ pachctl put-file repo branch -i http://host/path
 	putFile.Flags().StringSliceVarP(&filePaths, "file", "f", []string{"-"}, "The file to be put, it can be a local file or a URL.")
 	putFile.Flags().StringVarP(&inputFile, "input-file", "i", "", "Read filepaths or URLs from a file.  If - is used, paths are read from the standard input.")
 	putFile.Flags().BoolVarP(&recursive, "recursive", "r", false, "Recursively put the files in a directory.")
-	putFile.Flags().UintVarP(&parallelism, "parallelism", "p", DefaultParallelism, "The maximum number of files that can be uploaded in parallel.")
-	putFile.Flags().StringVar(&split, "split", "", "Split the input file into smaller files, subject to the constraints of --target-file-datums and --target-file-bytes. Permissible values are `json` and `line`.")
+	putFile.Flags().UintVarP(&parallelism, "parallelism", "p", DefaultParallelism, "The maximum number of files that can be uploaded in parallel, each over its own stream.")
+	putFile.Flags().StringVar(&split, "split", "", "Split the input file into smaller files, subject to the constraints of --target-file-datums and --target-file-bytes. Permissible values are `json`, `line`, `avro` and `parquet`. Avro files are split along blocks and Parquet files along row groups, so each file is valid and datums are counted in records.")
 	putFile.Flags().UintVar(&targetFileDatums, "target-file-datums", 0, "The upper bound of the number of datums that each file contains, the last file will contain fewer if the datums don't divide evenly; needs to be used with --split.")
 	putFile.Flags().UintVar(&targetFileBytes, "target-file-bytes", 0, "The target upper bound of the number of bytes that each file contains; needs to be used with --split.")
 	putFile.Flags().BoolVarP(&putFileCommit, "commit", "c", false, "Put file(s) in a new commit.")
+	putFile.Flags().BoolVar(&untar, "untar", false, "Expand the input, a tar stream, into the files in it, under path if it's given.")
+	putFile.Flags().StringVar(&fromQuery, "from-query", "", "Put the result of a SQL query, which pachd runs against --connection.")
+	putFile.Flags().StringVar(&connection, "connection", "", "The name of the connection, in pachd's sql-connections secret, that --from-query is run against.")
+	putFile.Flags().StringVar(&queryFormat, "format", "csv", "The format to write the result of --from-query in, `csv` or `json` (one object per line).")
 
 	var outputPath string
+	var decompress bool
+	var verify bool
+	var unfinished bool
+	var offset string
+	var size string
 	getFile := &cobra.Command{
 		Use:   "get-file repo-name commit-id path/to/file",
 		Short: "Return the contents of a file.",
-		Long:  "Return the contents of a file.",
+		Long: `Return the contents of a file.
+
+Examples:
+
+` + codestart + `# get file "XXX" on branch "master" in repo "foo"
+$ pachctl get-file foo master XXX
+
+# get file "XXX.gz" on branch "master" in repo "foo" and decompress it
+$ pachctl get-file foo master XXX.gz --decompress
+
+# download directory "dir" on branch "master" in repo "foo" to "local-dir"
+$ pachctl get-file foo master dir -r -o local-dir
+
+# download directory "dir" as a tar stream and extract it
+$ pachctl get-file foo master dir -r | tar x
+
+# download file "XXX" to "local-file", checking it against the hashes that
+# pachd recorded when it was written
+$ pachctl get-file foo master XXX --verify -o local-file
+
+# get what's been written to file "XXX" so far in the open commit on branch
+# "master"
+$ pachctl get-file foo master XXX --unfinished
+
+# get the first megabyte of file "XXX"
+$ pachctl get-file foo master XXX --size 1MB
+
+# get the 100 bytes of file "XXX" starting at byte 1000
+$ pachctl get-file foo master XXX --offset 1000 --size 100
+` + codeend,
 		Run: cmdutil.RunFixedArgs(3, func(args []string) error {
 			client, err := client.NewMetricsClientFromAddress(address, metrics, "user")
 			if err != nil {
 				return err
 			}
+			if err := checkFinished(client, args[0], args[1], unfinished); err != nil {
+				return err
+			}
+			if verify && (recursive || decompress) {
+				return fmt.Errorf("--verify can't be used with the --recursive or --decompress flags")
+			}
+			offsetBytes, err := units.RAMInBytes(offset)
+			if err != nil {
+				return fmt.Errorf("invalid --offset: %v", err)
+			}
+			sizeBytes, err := units.RAMInBytes(size)
+			if err != nil {
+				return fmt.Errorf("invalid --size: %v", err)
+			}
+			if offsetBytes < 0 || sizeBytes < 0 {
+				return fmt.Errorf("--offset and --size can't be negative")
+			}
+			if (offsetBytes != 0 || sizeBytes != 0) && (recursive || decompress || verify) {
+				// They're byte ranges of a single file's stored content, which
+				// a directory or the decompressed content doesn't have, and
+				// which can't be checked against the file's hashes.
+				return fmt.Errorf("--offset and --size can't be used with the --recursive, --decompress or --verify flags")
+			}
 			if recursive {
+				if decompress {
+					return fmt.Errorf("--decompress can't be used with the --recursive flag")
+				}
 				if outputPath == "" {
-					return fmt.Errorf("an output path needs to be specified when using the --recursive flag")
+					// Write the directory to stdout as a tar stream
+					return sync.PullTar(client, os.Stdout, args[0], args[1], args[2])
 				}
 				puller := sync.NewPuller()
 				return puller.Pull(client, outputPath, args[0], args[1], args[2], false, int(parallelism))
@@ -715,22 +1272,72 @@ pachctl put-file repo branch -i http://host/path
 				defer f.Close()
 				w = f
 			}
-			return client.GetFile(args[0], args[1], args[2], 0, 0, w)
+			if decompress {
+				r, err := client.GetFileReader(args[0], args[1], args[2], 0, 0)
+				if err != nil {
+					return err
+				}
+				r, err = decompressReader(r)
+				if err != nil {
+					return err
+				}
+				_, err = io.Copy(w, r)
+				return err
+			}
+			if verify {
+				if err := client.GetFileVerified(args[0], args[1], args[2], w); err != nil {
+					if outputPath != "" {
+						// Don't leave a corrupted file behind.
+						os.Remove(outputPath)
+					}
+					return err
+				}
+				return nil
+			}
+			return client.GetFile(args[0], args[1], args[2], offsetBytes, sizeBytes, w)
 		}),
 	}
-	getFile.Flags().BoolVarP(&recursive, "recursive", "r", false, "Recursively download a directory.")
+	getFile.Flags().BoolVarP(&recursive, "recursive", "r", false, "Recursively download a directory, to --output if it's set, otherwise to stdout as a tar stream.")
+	getFile.Flags().BoolVar(&decompress, "decompress", false, "Decompress the file if it's compressed with gzip, files that aren't compressed are returned unchanged.")
+	getFile.Flags().BoolVar(&verify, "verify", false, "Check the file's content against the hashes that pachd recorded when it was written, and fail if it was corrupted.")
 	getFile.Flags().StringVarP(&outputPath, "output", "o", "", "The path where data will be downloaded.")
 	getFile.Flags().UintVarP(&parallelism, "parallelism", "p", DefaultParallelism, "The maximum number of files that can be downloaded in parallel")
+	getFile.Flags().StringVar(&offset, "offset", "0", "The byte of the file to start reading at, e.g. 1000 or 10MB.")
+	getFile.Flags().StringVar(&size, "size", "0", "The most bytes of the file to read, e.g. 1MB; 0 reads to the end of the file.")
+	unfinishedFlag(getFile, &unfinished)
 
+	var fileProvenance bool
 	inspectFile := &cobra.Command{
 		Use:   "inspect-file repo-name commit-id path/to/file",
 		Short: "Return info about a file.",
-		Long:  "Return info about a file.",
+		Long: `Return info about a file.
+
+With --provenance, the datums that the file is linked to are returned
+instead: if it's in a pipeline's output repo, the input files of the datum
+that output it and the job that processed it, and the output files of each
+datum that took it as input.`,
 		Run: cmdutil.RunFixedArgs(3, func(args []string) error {
 			client, err := client.NewMetricsClientFromAddress(address, metrics, "user")
 			if err != nil {
 				return err
 			}
+			if err := checkFinished(client, args[0], args[1], unfinished); err != nil {
+				return err
+			}
+			if fileProvenance {
+				resp, err := client.FileProvenance(args[0], args[1], args[2])
+				if err != nil {
+					return err
+				}
+				if raw {
+					return marshaller.Marshal(os.Stdout, resp)
+				}
+				if pkgpretty.Structured(*output) {
+					return pkgpretty.PrintStructured(os.Stdout, *output, resp)
+				}
+				printFileProvenance(os.Stdout, resp)
+				return nil
+			}
 			fileInfo, err := client.InspectFile(args[0], args[1], args[2])
 			if err != nil {
 				return err
@@ -741,20 +1348,38 @@ pachctl put-file repo branch -i http://host/path
 			if raw {
 				return marshaller.Marshal(os.Stdout, fileInfo)
 			}
+			if pkgpretty.Structured(*output) {
+				return pkgpretty.PrintStructured(os.Stdout, *output, fileInfo)
+			}
 			return pretty.PrintDetailedFileInfo(fileInfo)
 		}),
 	}
 	rawFlag(inspectFile)
+	inspectFile.Flags().BoolVar(&fileProvenance, "provenance", false, "Return the datums that output the file and that took it as input.")
+	unfinishedFlag(inspectFile, &unfinished)
 
 	listFile := &cobra.Command{
 		Use:   "list-file repo-name commit-id path/to/dir",
 		Short: "Return the files in a directory.",
-		Long:  "Return the files in a directory.",
+		Long: `Return the files in a directory.
+
+Examples:
+
+` + codestart + `# list the files in directory "dir" on branch "master" in repo "foo"
+$ pachctl list-file foo master dir
+
+# list what's been written to directory "dir" so far in the open commit on
+# branch "master"
+$ pachctl list-file foo master dir --unfinished
+` + codeend,
 		Run: cmdutil.RunBoundedArgs(2, 3, func(args []string) error {
 			client, err := client.NewMetricsClientFromAddress(address, metrics, "user")
 			if err != nil {
 				return err
 			}
+			if err := checkFinished(client, args[0], args[1], unfinished); err != nil {
+				return err
+			}
 			var path string
 			if len(args) == 3 {
 				path = args[2]
@@ -770,6 +1395,9 @@ pachctl put-file repo branch -i http://host/path
 					}
 				}
 			}
+			if pkgpretty.Structured(*output) {
+				return pkgpretty.PrintStructured(os.Stdout, *output, fileInfos)
+			}
 			writer := tabwriter.NewWriter(os.Stdout, 20, 1, 3, ' ', 0)
 			pretty.PrintFileInfoHeader(writer)
 			for _, fileInfo := range fileInfos {
@@ -779,6 +1407,7 @@ pachctl put-file repo branch -i http://host/path
 		}),
 	}
 	rawFlag(listFile)
+	unfinishedFlag(listFile, &unfinished)
 
 	globFile := &cobra.Command{
 		Use:   "glob-file repo-name commit-id pattern",
@@ -813,6 +1442,9 @@ $ pachctl glob-file foo master "data/*"
 					}
 				}
 			}
+			if pkgpretty.Structured(*output) {
+				return pkgpretty.PrintStructured(os.Stdout, *output, fileInfos)
+			}
 			writer := tabwriter.NewWriter(os.Stdout, 20, 1, 3, ' ', 0)
 			pretty.PrintFileInfoHeader(writer)
 			for _, fileInfo := range fileInfos {
@@ -823,11 +1455,17 @@ $ pachctl glob-file foo master "data/*"
 	}
 	rawFlag(globFile)
 
+	var shallow bool
 	diffFile := &cobra.Command{
 		Use:   "diff-file new-repo-name new-commit-id new-path [old-repo-name old-commit-id old-path]",
 		Short: "Return a diff of two file trees.",
 		Long: `Return a diff of two file trees.
 
+The files that were added, removed or modified are listed with their sizes
+in both trees and the change in size. With --shallow, only the children of
+the paths are compared, and directories that differ are listed rather than
+the files in them.
+
 Examples:
 
 ` + codestart + `# Return the diff between foo master path and its parent.
@@ -835,63 +1473,202 @@ $ pachctl diff-file foo master path
 
 # Return the diff between foo master path1 and bar master path2.
 $ pachctl diff-file foo master path1 bar master path2
+
+# Return the diff between commits XXX and YYY of foo, i.e. what changed
+# from YYY to XXX.
+$ pachctl diff-file foo XXX / foo YYY /
+
+# Return which top-level directories changed between master and its parent.
+$ pachctl diff-file foo master / --shallow
 ` + codeend,
 		Run: cmdutil.RunBoundedArgs(3, 6, func(args []string) error {
 			client, err := client.NewMetricsClientFromAddress(address, metrics, "user")
 			if err != nil {
 				return err
 			}
-			var newFiles []*pfsclient.FileInfo
-			var oldFiles []*pfsclient.FileInfo
+			var oldRepo, oldCommit, oldPath string
 			switch {
 			case len(args) == 3:
-				newFiles, oldFiles, err = client.DiffFile(args[0], args[1], args[2], "", "", "")
 			case len(args) == 6:
-				newFiles, oldFiles, err = client.DiffFile(args[0], args[1], args[2], args[3], args[4], args[5])
+				oldRepo, oldCommit, oldPath = args[3], args[4], args[5]
 			default:
 				return fmt.Errorf("diff-file expects either 3 or 6 args, got %d", len(args))
 			}
+			if pkgpretty.Structured(*output) {
+				diffFile := client.DiffFile
+				if shallow {
+					diffFile = client.DiffFileShallow
+				}
+				newFiles, oldFiles, err := diffFile(args[0], args[1], args[2], oldRepo, oldCommit, oldPath)
+				if err != nil {
+					return err
+				}
+				return pkgpretty.PrintStructured(os.Stdout, *output, &pfsclient.DiffFileResponse{
+					NewFiles: newFiles,
+					OldFiles: oldFiles,
+				})
+			}
+			writer := tabwriter.NewWriter(os.Stdout, 20, 1, 3, ' ', 0)
+			pretty.PrintDiffFileHeader(writer)
+			diffFileF := client.DiffFileF
+			if shallow {
+				diffFileF = client.DiffFileShallowF
+			}
+			if err := diffFileF(args[0], args[1], args[2], oldRepo, oldCommit, oldPath, func(newFile, oldFile *pfsclient.FileInfo) error {
+				pretty.PrintDiffFile(writer, newFile, oldFile)
+				return nil
+			}); err != nil {
+				return err
+			}
+			return writer.Flush()
+		}),
+	}
+	diffFile.Flags().BoolVar(&shallow, "shallow", false, "Only compare the children of the paths, listing directories that differ rather than the files in them.")
+
+	var recursiveDelete bool
+	deleteFile := &cobra.Command{
+		Use:   "delete-file repo-name commit-id path/to/file",
+		Short: "Delete a file.",
+		Long: `Delete a file.
+
+The path can be a glob pattern, in which case everything that matches it is
+deleted. Directories are only deleted, along with everything under them,
+with --recursive. The deletion is a single operation in pachd however many
+files it deletes.
+
+Examples:
+
+` + codestart + `# delete file "XXX" on branch "master" in repo "foo"
+$ pachctl delete-file foo master XXX
+
+# delete directory "dir" and everything under it
+$ pachctl delete-file foo master dir -r
+
+# delete all the .tmp files in directory "dir"
+$ pachctl delete-file foo master "dir/*.tmp"
+` + codeend,
+		Run: cmdutil.RunFixedArgs(3, func(args []string) error {
+			client, err := client.NewMetricsClientFromAddress(address, metrics, "user")
 			if err != nil {
 				return err
 			}
-			if len(newFiles) > 0 {
-				fmt.Println("New Files:")
-				writer := tabwriter.NewWriter(os.Stdout, 20, 1, 3, ' ', 0)
-				pretty.PrintFileInfoHeader(writer)
-				for _, fileInfo := range newFiles {
-					pretty.PrintFileInfo(writer, fileInfo)
-				}
-				if err := writer.Flush(); err != nil {
+			if !recursiveDelete {
+				fileInfos, err := client.GlobFile(args[0], args[1], args[2])
+				if err != nil {
 					return err
 				}
-			}
-			if len(oldFiles) > 0 {
-				fmt.Println("Old Files:")
-				writer := tabwriter.NewWriter(os.Stdout, 20, 1, 3, ' ', 0)
-				pretty.PrintFileInfoHeader(writer)
-				for _, fileInfo := range oldFiles {
-					pretty.PrintFileInfo(writer, fileInfo)
+				for _, fileInfo := range fileInfos {
+					if fileInfo.FileType == pfsclient.FileType_DIR {
+						return fmt.Errorf("%s is a directory, use --recursive to delete it", fileInfo.File.Path)
+					}
 				}
-				if err := writer.Flush(); err != nil {
+			}
+			return client.DeleteFile(args[0], args[1], args[2])
+		}),
+	}
+	deleteFile.Flags().BoolVarP(&recursiveDelete, "recursive", "r", false, "Delete directories along with everything under them.")
+
+	var leaseOwner string
+	var leaseTTL time.Duration
+	acquireLease := &cobra.Command{
+		Use:   "acquire-lease repo-name commit-id path/to/dir",
+		Short: "Lease a path in an open commit.",
+		Long: `Lease a path in an open commit, and print the lease's ID.
+
+Leases are advisory: they let processes that write to the same commit agree
+on who owns a directory, but pachd doesn't stop writes to leased paths. A
+lease fails if the path, a directory above it or a path under it is leased
+by another owner. Leases expire after --ttl unless they're renewed with
+renew-lease, and are dropped when the commit is finished.
+
+Examples:
+
+` + codestart + `# lease directory "logs/host1" on branch "master" in repo "foo" for 5 minutes
+$ pachctl acquire-lease foo master logs/host1 --owner host1 --ttl 5m
+` + codeend,
+		Run: cmdutil.RunFixedArgs(3, func(args []string) error {
+			client, err := client.NewMetricsClientFromAddress(address, metrics, "user")
+			if err != nil {
+				return err
+			}
+			if leaseOwner == "" {
+				leaseOwner, err = os.Hostname()
+				if err != nil {
 					return err
 				}
 			}
+			lease, err := client.AcquireLease(args[0], args[1], args[2], leaseOwner, leaseTTL)
+			if err != nil {
+				return err
+			}
+			fmt.Println(lease.ID)
 			return nil
 		}),
 	}
+	acquireLease.Flags().StringVar(&leaseOwner, "owner", "", "The owner of the lease, leases with the same owner don't conflict; defaults to the hostname.")
+	acquireLease.Flags().DurationVar(&leaseTTL, "ttl", time.Minute, "How long the lease lasts unless it's renewed.")
 
-	deleteFile := &cobra.Command{
-		Use:   "delete-file repo-name commit-id path/to/file",
-		Short: "Delete a file.",
-		Long:  "Delete a file.",
+	renewLease := &cobra.Command{
+		Use:   "renew-lease repo-name commit-id lease-id",
+		Short: "Renew a lease.",
+		Long:  "Renew a lease that hasn't expired, so that it lasts for --ttl from now.",
 		Run: cmdutil.RunFixedArgs(3, func(args []string) error {
 			client, err := client.NewMetricsClientFromAddress(address, metrics, "user")
 			if err != nil {
 				return err
 			}
-			return client.DeleteFile(args[0], args[1], args[2])
+			_, err = client.RenewLease(args[0], args[1], args[2], leaseTTL)
+			return err
 		}),
 	}
+	renewLease.Flags().DurationVar(&leaseTTL, "ttl", time.Minute, "How long the lease lasts unless it's renewed again.")
+
+	releaseLease := &cobra.Command{
+		Use:   "release-lease repo-name commit-id lease-id",
+		Short: "Release a lease.",
+		Long:  "Release a lease.",
+		Run: cmdutil.RunFixedArgs(3, func(args []string) error {
+			client, err := client.NewMetricsClientFromAddress(address, metrics, "user")
+			if err != nil {
+				return err
+			}
+			return client.ReleaseLease(args[0], args[1], args[2])
+		}),
+	}
+
+	listLease := &cobra.Command{
+		Use:   "list-lease repo-name commit-id",
+		Short: "Return the leases in an open commit.",
+		Long:  "Return the leases in an open commit.",
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			client, err := client.NewMetricsClientFromAddress(address, metrics, "user")
+			if err != nil {
+				return err
+			}
+			leases, err := client.ListLease(args[0], args[1])
+			if err != nil {
+				return err
+			}
+			if raw {
+				for _, lease := range leases {
+					if err := marshaller.Marshal(os.Stdout, lease); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+			if pkgpretty.Structured(*output) {
+				return pkgpretty.PrintStructured(os.Stdout, *output, leases)
+			}
+			writer := tabwriter.NewWriter(os.Stdout, 20, 1, 3, ' ', 0)
+			pretty.PrintLeaseHeader(writer)
+			for _, lease := range leases {
+				pretty.PrintLease(writer, lease)
+			}
+			return writer.Flush()
+		}),
+	}
+	rawFlag(listLease)
 
 	getObject := &cobra.Command{
 		Use:   "get-object hash",
@@ -919,19 +1696,130 @@ $ pachctl diff-file foo master path1 bar master path2
 		}),
 	}
 
+	var fix bool
+	var removeDangling bool
+	fsck := &cobra.Command{
+		Use:   "fsck",
+		Short: "Check the consistency of PFS's metadata and objects.",
+		Long: `Check the consistency of PFS's metadata and objects.
+
+fsck verifies that every file references objects that exist, that branch
+heads, commit parents and provenance resolve, and reports objects that aren't
+referenced by anything. Repairing the inconsistencies it finds removes
+metadata: branches whose head doesn't exist are deleted, and repos that don't
+exist are removed from provenance. To do so, run it with both --fix and
+--remove-dangling.`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			client, err := client.NewMetricsClientFromAddress(address, metrics, "user")
+			if err != nil {
+				return err
+			}
+			var errors int
+			if err := client.Fsck(fix, removeDangling, func(resp *pfsclient.FsckResponse) error {
+				switch {
+				case resp.Error != "":
+					errors++
+					fmt.Printf("Error: %s\n", resp.Error)
+				case resp.Fix != "" && fix && removeDangling:
+					fmt.Printf("Fixed: %s\n", resp.Fix)
+				case resp.Fix != "":
+					fmt.Printf("Fixable (run with --fix --remove-dangling): %s\n", resp.Fix)
+				case resp.OrphanedObject != nil:
+					fmt.Printf("Orphaned object: %s\n", resp.OrphanedObject.Hash)
+				}
+				return nil
+			}); err != nil {
+				return err
+			}
+			if errors > 0 {
+				return fmt.Errorf("found %d inconsistencies that couldn't be fixed", errors)
+			}
+			return nil
+		}),
+	}
+	fsck.Flags().BoolVarP(&fix, "fix", "f", false, "Attempt to fix as many issues as possible.")
+	fsck.Flags().BoolVar(&removeDangling, "remove-dangling", false, "Together with --fix, delete branches whose head doesn't exist and remove repos that don't exist from provenance.")
+
+	var to string
+	replicateCmd := &cobra.Command{
+		Use:   "replicate repo-name branch --to host:port",
+		Short: "Continuously mirror a branch to another cluster.",
+		Long: `Continuously mirror a branch to another cluster.
+
+Commits on the branch are copied to the same repo and branch on the cluster
+given by --to as soon as they're finished, keeping their IDs. Replication is
+asynchronous and resumes from the destination's head of the branch, so it can
+be stopped and restarted at any time. This is useful for disaster recovery and
+for serving data close to compute in another region.
+
+Examples:
+
+` + codestart + `# mirror the master branch of repo foo to another cluster
+$ pachctl replicate foo master --to 10.0.0.2:30650` + codeend,
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			if to == "" {
+				return fmt.Errorf("--to must be set")
+			}
+			src, err := client.NewMetricsClientFromAddress(address, metrics, "user")
+			if err != nil {
+				return err
+			}
+			dst, err := client.NewMetricsClientFromAddress(to, metrics, "user")
+			if err != nil {
+				return err
+			}
+			return replicate.Replicate(src, dst, args[0], args[1], func(commit *pfsclient.Commit) {
+				fmt.Printf("Replicated %s/%s\n", commit.Repo.Name, commit.ID)
+			})
+		}),
+	}
+	replicateCmd.Flags().StringVar(&to, "to", "", "The address of the cluster to replicate to.")
+
 	var debug bool
 	var allCommits bool
+	var cacheSize string
+	var cacheTTL time.Duration
+	var readAhead string
 	mount := &cobra.Command{
 		Use:   "mount path/to/mount/point",
 		Short: "Mount pfs locally. This command blocks.",
-		Long:  "Mount pfs locally. This command blocks.",
+		Long: `Mount pfs locally. This command blocks.
+
+By default every read goes to pachd. With --cache-size, the content of files
+in finished commits is cached on local disk, so that reading it again is fast,
+and with --read-ahead the data after each sequential read is fetched into the
+cache in the background. --cache-ttl caches files' metadata, which also sets
+how long a file read through a branch can take to show new content. Files in
+open commits are never cached. The cache's statistics are printed when the
+filesystem is unmounted, and when pachctl receives SIGUSR1.
+
+Examples:
+
+` + codestart + `# mount pfs at /pfs, caching up to 10GB of file content and reading
+# 8MB ahead of sequential reads
+$ pachctl mount /pfs --cache-size 10GB --cache-ttl 1m --read-ahead 8MB
+
+# print the mount's cache statistics
+$ pkill -USR1 -f "pachctl mount"
+` + codeend,
 		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			opts := &fuse.Options{CacheTTL: cacheTTL}
+			var err error
+			if opts.CacheBytes, err = units.RAMInBytes(cacheSize); err != nil {
+				return fmt.Errorf("invalid --cache-size: %v", err)
+			}
+			if opts.ReadAhead, err = units.RAMInBytes(readAhead); err != nil {
+				return fmt.Errorf("invalid --read-ahead: %v", err)
+			}
+			if opts.ReadAhead > 0 && opts.CacheBytes == 0 {
+				return fmt.Errorf("--read-ahead reads into the cache, so it needs --cache-size to be set")
+			}
 			client, err := client.NewMetricsClientFromAddress(address, metrics, "fuse")
 			if err != nil {
 				return err
 			}
 			go func() { client.KeepConnected(nil) }()
-			mounter := fuse.NewMounter(address, client)
+			mounter := fuse.NewMounterWithOptions(address, client, opts)
 			mountPoint := args[0]
 			ready := make(chan bool)
 			go func() {
@@ -947,6 +1835,9 @@ $ pachctl diff-file foo master path1 bar master path2
 	}
 	mount.Flags().BoolVarP(&debug, "debug", "d", false, "Turn on debug messages.")
 	mount.Flags().BoolVarP(&allCommits, "all-commits", "a", false, "Show archived and cancelled commits.")
+	mount.Flags().StringVar(&cacheSize, "cache-size", "0", "The most file content to cache on local disk, e.g. 10GB; 0 disables the cache.")
+	mount.Flags().DurationVar(&cacheTTL, "cache-ttl", 0, "How long to cache files' metadata; 0 looks it up on every access.")
+	mount.Flags().StringVar(&readAhead, "read-ahead", "0", "How much data to fetch into the cache past each sequential read, e.g. 8MB.")
 
 	unmount := &cobra.Command{
 		Use:   "unmount path/to/mount/point",
@@ -1000,9 +1891,140 @@ $ pachctl diff-file foo master path1 bar master path2
 	}
 	unmount.Flags().BoolVarP(&all, "all", "a", false, "unmount all pfs mounts")
 
+	var ninePPort int
+	export9p := &cobra.Command{
+		Use:   "export-9p repo[/branch]...",
+		Short: "Export repos read-only over 9p. This command blocks.",
+		Long: `Export repos read-only over 9p (9P2000), so that machines without FUSE can read them. This command blocks.
+
+Each argument exports either a whole repo or a single branch of it. The export contains a directory per repo, with a directory per branch, containing the files in the branch's head commit. To mount it on Linux:
+
+	mount -t 9p -o trans=tcp,port=564,version=9p2000 <host> /pfs`,
+		Run: cmdutil.Run(func(args []string) error {
+			if len(args) == 0 {
+				return fmt.Errorf("at least one repo must be exported")
+			}
+			client, err := client.NewMetricsClientFromAddress(address, metrics, "user")
+			if err != nil {
+				return err
+			}
+			go func() { client.KeepConnected(nil) }()
+			server, err := ninep.NewServer(client, args)
+			if err != nil {
+				return err
+			}
+			listener, err := net.Listen("tcp", fmt.Sprintf(":%d", ninePPort))
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Exporting on port %d, CTRL-C to exit.\n", ninePPort)
+			return server.Serve(listener)
+		}),
+	}
+	export9p.Flags().IntVarP(&ninePPort, "port", "p", 564, "The port to serve 9p on.")
+
+	ingest := &cobra.Command{
+		Use:   "ingest",
+		Short: "Continuously commit data from an external system to a repo.",
+		Long:  "Continuously commit data from an external system to a repo.",
+	}
+	var kafkaSpecFile string
+	ingestKafka := &cobra.Command{
+		Use:   "kafka -f spec.json",
+		Short: "Commit the records of a Kafka topic to a repo. This command blocks.",
+		Long: `Commit the records of a Kafka topic to a repo. This command blocks.
+
+The spec looks like:
+
+{
+  "brokers": ["kafka:9092"],
+  "topic": "events",
+  "repo": "events",
+  "branch": "master",
+  "start": "oldest",
+  "max_records": 10000,
+  "max_bytes": 67108864,
+  "interval": "1m"
+}
+
+Each commit adds a file per partition at /<topic>/<partition>/<first offset> with a record per line. The offsets read up to are committed with the records, so restarting the connector picks up where it left off and doesn't commit any record twice.`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			specFile, err := os.Open(kafkaSpecFile)
+			if err != nil {
+				return err
+			}
+			defer specFile.Close()
+			var spec ingestpkg.KafkaSpec
+			if err := json.NewDecoder(specFile).Decode(&spec); err != nil {
+				return fmt.Errorf("error parsing spec: %v", err)
+			}
+			client, err := client.NewMetricsClientFromAddress(address, metrics, "user")
+			if err != nil {
+				return err
+			}
+			return backoff.RetryNotify(func() error {
+				return ingestpkg.RunKafka(client, &spec)
+			}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
+				fmt.Fprintf(os.Stderr, "error ingesting from kafka: %v; retrying in %s\n", err, d)
+				return nil
+			})
+		}),
+	}
+	ingestKafka.Flags().StringVarP(&kafkaSpecFile, "file", "f", "", "The file containing the connector's spec.")
+	ingest.AddCommand(ingestKafka)
+	var sqlSpecFile string
+	ingestSQL := &cobra.Command{
+		Use:   "sql -f spec.json",
+		Short: "Periodically commit the result of a SQL query to a repo. This command blocks.",
+		Long: `Periodically commit the result of a SQL query to a repo. This command blocks.
+
+The spec looks like:
+
+{
+  "connection": "warehouse",
+  "query": "SELECT * FROM orders",
+  "format": "csv",
+  "repo": "orders",
+  "branch": "master",
+  "path": "/orders.csv",
+  "interval": "1h"
+}
+
+The query is run by pachd against the named connection in its sql-connections secret. Each commit replaces the file at path with a new snapshot. If interval isn't set a single snapshot is committed.`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			specFile, err := os.Open(sqlSpecFile)
+			if err != nil {
+				return err
+			}
+			defer specFile.Close()
+			var spec ingestpkg.SQLSpec
+			if err := json.NewDecoder(specFile).Decode(&spec); err != nil {
+				return fmt.Errorf("error parsing spec: %v", err)
+			}
+			client, err := client.NewMetricsClientFromAddress(address, metrics, "user")
+			if err != nil {
+				return err
+			}
+			return backoff.RetryNotify(func() error {
+				return ingestpkg.RunSQL(client, &spec)
+			}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
+				fmt.Fprintf(os.Stderr, "error ingesting from sql: %v; retrying in %s\n", err, d)
+				return nil
+			})
+		}),
+	}
+	ingestSQL.Flags().StringVarP(&sqlSpecFile, "file", "f", "", "The file containing the connector's spec.")
+	ingest.AddCommand(ingestSQL)
+
 	var result []*cobra.Command
+	result = append(result, project)
+	result = append(result, createProject)
+	result = append(result, inspectProject)
+	result = append(result, listProject)
+	result = append(result, deleteProject)
 	result = append(result, repo)
 	result = append(result, createRepo)
+	result = append(result, updateRepo)
 	result = append(result, inspectRepo)
 	result = append(result, listRepo)
 	result = append(result, deleteRepo)
@@ -1011,6 +2033,7 @@ $ pachctl diff-file foo master path1 bar master path2
 	result = append(result, finishCommit)
 	result = append(result, inspectCommit)
 	result = append(result, listCommit)
+	result = append(result, findCommit)
 	result = append(result, flushCommit)
 	result = append(result, subscribeCommit)
 	result = append(result, deleteCommit)
@@ -1025,10 +2048,18 @@ $ pachctl diff-file foo master path1 bar master path2
 	result = append(result, globFile)
 	result = append(result, diffFile)
 	result = append(result, deleteFile)
+	result = append(result, acquireLease)
+	result = append(result, renewLease)
+	result = append(result, releaseLease)
+	result = append(result, listLease)
 	result = append(result, getObject)
 	result = append(result, getTag)
+	result = append(result, fsck)
+	result = append(result, replicateCmd)
 	result = append(result, mount)
 	result = append(result, unmount)
+	result = append(result, export9p)
+	result = append(result, ingest)
 	return result
 }
 
@@ -1051,7 +2082,7 @@ func parseCommitMounts(args []string) []*fuse.CommitMount {
 }
 
 func putFileHelper(client *client.APIClient, repo, commit, path, source string, recursive bool, limiter limit.ConcurrencyLimiter, split string, targetFileDatums uint, targetFileBytes uint) (retErr error) {
-	putFile := func(reader io.Reader) error {
+	putFile := func(path string, reader io.Reader) error {
 		if split == "" {
 			_, err := client.PutFile(repo, commit, path, reader)
 			return err
@@ -1063,8 +2094,14 @@ func putFileHelper(client *client.APIClient, repo, commit, path, source string,
 			delimiter = pfsclient.Delimiter_LINE
 		case "json":
 			delimiter = pfsclient.Delimiter_JSON
+		case "avro":
+			delimiter = pfsclient.Delimiter_AVRO
+		case "parquet":
+			delimiter = pfsclient.Delimiter_PARQUET
+		case "tar":
+			delimiter = pfsclient.Delimiter_TAR
 		default:
-			return fmt.Errorf("unrecognized delimiter '%s'; only accepts 'json' or 'line'", split)
+			return fmt.Errorf("unrecognized delimiter '%s'; only accepts 'json', 'line', 'avro' or 'parquet'", split)
 		}
 		_, err := client.PutFileSplit(repo, commit, path, delimiter, int64(targetFileDatums), int64(targetFileBytes), reader)
 		return err
@@ -1074,7 +2111,7 @@ func putFileHelper(client *client.APIClient, repo, commit, path, source string,
 		limiter.Acquire()
 		defer limiter.Release()
 		fmt.Println("Reading from stdin.")
-		return putFile(os.Stdin)
+		return putFile(path, os.Stdin)
 	}
 	// try parsing the filename as a url, if it is one do a PutFileURL
 	if url, err := url.Parse(source); err == nil && url.Scheme != "" {
@@ -1082,39 +2119,149 @@ func putFileHelper(client *client.APIClient, repo, commit, path, source string,
 		defer limiter.Release()
 		return client.PutFileURL(repo, commit, path, url.String(), recursive)
 	}
+	// putLocalFile puts the local file at filePath as path, each one over
+	// its own PutFile stream.
+	putLocalFile := func(path, filePath string) (retErr error) {
+		f, err := os.Open(filePath)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := f.Close(); err != nil && retErr == nil {
+				retErr = err
+			}
+		}()
+		return putFile(path, f)
+	}
 	if recursive {
 		var eg errgroup.Group
-		if err := filepath.Walk(source, func(filePath string, info os.FileInfo, err error) error {
+		walkErr := filepath.Walk(source, func(filePath string, info os.FileInfo, err error) error {
 			// file doesn't exist
 			if info == nil {
 				return fmt.Errorf("%s doesn't exist", filePath)
 			}
+			if err != nil {
+				return err
+			}
 			if info.IsDir() {
 				return nil
 			}
+			// Acquiring here, rather than in the goroutine, keeps the walk
+			// from starting a goroutine per file in a large tree, at most
+			// parallelism files are open and uploading at once.
+			limiter.Acquire()
 			eg.Go(func() error {
-				return putFileHelper(client, repo, commit, filepath.Join(path, strings.TrimPrefix(filePath, source)), filePath, false, limiter, split, targetFileDatums, targetFileBytes)
+				defer limiter.Release()
+				return putLocalFile(filepath.Join(path, strings.TrimPrefix(filePath, source)), filePath)
 			})
 			return nil
-		}); err != nil {
+		})
+		// Wait for the uploads that were started even if the walk failed.
+		if err := eg.Wait(); err != nil {
 			return err
 		}
-		return eg.Wait()
+		return walkErr
+	}
+	if info, err := os.Stat(source); err == nil && info.IsDir() {
+		return fmt.Errorf("%s is a directory, use -r to put the files in it", source)
 	}
 	limiter.Acquire()
 	defer limiter.Release()
-	f, err := os.Open(source)
+	return putLocalFile(path, source)
+}
+
+// parseKeyValues parses the values of a repeated flag, such as --label,
+// each of which is key=value.
+func parseKeyValues(args []string, flag string) (map[string]string, error) {
+	if len(args) == 0 {
+		return nil, nil
+	}
+	result := make(map[string]string)
+	for _, arg := range args {
+		split := strings.SplitN(arg, "=", 2)
+		if len(split) != 2 {
+			return nil, fmt.Errorf("invalid --%s %q, must be key=value", flag, arg)
+		}
+		result[split[0]] = split[1]
+	}
+	return result, nil
+}
+
+// sortRepoInfos sorts repoInfos by sortBy, which is "name", "size",
+// "created" or "" for no sorting, and then reverses them if reverse is set.
+func sortRepoInfos(repoInfos []*pfsclient.RepoInfo, sortBy string, reverse bool) error {
+	var less func(i, j int) bool
+	switch sortBy {
+	case "":
+	case "name":
+		less = func(i, j int) bool { return repoInfos[i].Repo.Name < repoInfos[j].Repo.Name }
+	case "size":
+		less = func(i, j int) bool { return repoInfos[i].SizeBytes < repoInfos[j].SizeBytes }
+	case "created":
+		less = func(i, j int) bool {
+			a, b := repoInfos[i].Created, repoInfos[j].Created
+			if a == nil || b == nil {
+				return a == nil && b != nil
+			}
+			return a.Seconds < b.Seconds || (a.Seconds == b.Seconds && a.Nanos < b.Nanos)
+		}
+	default:
+		return fmt.Errorf("unrecognized sort key %q, must be \"name\", \"size\" or \"created\"", sortBy)
+	}
+	if less != nil {
+		sort.SliceStable(repoInfos, less)
+	}
+	if reverse {
+		for i, j := 0, len(repoInfos)-1; i < j; i, j = i+1, j-1 {
+			repoInfos[i], repoInfos[j] = repoInfos[j], repoInfos[i]
+		}
+	}
+	return nil
+}
+
+// decompressReader returns a reader of r's content decompressed, if it's
+// compressed, which is detected from its first bytes.
+func decompressReader(r io.Reader) (io.Reader, error) {
+	br := bufio.NewReader(r)
+	magic, err := br.Peek(4)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	switch {
+	case bytes.HasPrefix(magic, gzipMagic):
+		return gzip.NewReader(br)
+	case bytes.HasPrefix(magic, zstdMagic):
+		return nil, fmt.Errorf("the file is compressed with zstd, which isn't supported yet")
+	}
+	return br, nil
+}
+
+func unfinishedFlag(cmd *cobra.Command, unfinished *bool) {
+	cmd.Flags().BoolVar(unfinished, "unfinished", false, "Read from the commit even if it's still open, seeing what's been written to it so far.")
+}
+
+// checkFinished returns an error if commit is open, unless unfinished is set.
+// Files in an open commit can still be changed, so reading them needs to be
+// asked for explicitly, rather than being mistaken for the commit's contents.
+func checkFinished(c *client.APIClient, repo string, commit string, unfinished bool) error {
+	if unfinished {
+		return nil
+	}
+	commitInfo, err := c.InspectCommit(repo, commit)
 	if err != nil {
 		return err
 	}
-	defer func() {
-		if err := f.Close(); err != nil && retErr == nil {
-			retErr = err
-		}
-	}()
-	return putFile(f)
+	if commitInfo.Finished == nil {
+		return fmt.Errorf("commit %s/%s is still open, so its files may change; pass --unfinished to read what's been written to it so far", repo, commitInfo.Commit.ID)
+	}
+	return nil
 }
 
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
 func joinPaths(prefix, filePath string) string {
 	if url, err := url.Parse(filePath); err == nil && url.Scheme != "" {
 		if url.Scheme == "pfs" {
@@ -0,0 +1,208 @@
+package cmds
+
+import (
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	pfsclient "github.com/pachyderm/pachyderm/src/client/pfs"
+	ppsclient "github.com/pachyderm/pachyderm/src/client/pps"
+)
+
+// provenanceNode is a commit in a provenance tree. Its children are the
+// commits directly upstream of it in an upstream tree, and directly
+// downstream of it in a downstream tree.
+type provenanceNode struct {
+	commit *pfsclient.Commit
+	// job is the ID of the job whose output is the commit, "" if the
+	// commit isn't in a pipeline's output repo.
+	job      string
+	children []*provenanceNode
+}
+
+// provenanceGraph has what's needed to find the commits linked to a commit.
+type provenanceGraph struct {
+	c *client.APIClient
+	// inputs maps each pipeline to the repos it takes as input.
+	inputs map[string][]string
+	// jobs maps the ID of each output commit to the job that made it.
+	jobs map[string]string
+}
+
+func newProvenanceGraph(c *client.APIClient) (*provenanceGraph, error) {
+	pipelineInfos, err := c.ListPipeline()
+	if err != nil {
+		return nil, err
+	}
+	inputs := make(map[string][]string)
+	for _, pipelineInfo := range pipelineInfos {
+		name := pipelineInfo.Pipeline.Name
+		inputs[name] = ppsclient.InputRepos(pipelineInfo.Input)
+		for _, input := range pipelineInfo.Inputs {
+			inputs[name] = append(inputs[name], input.Repo.Name)
+		}
+	}
+	jobInfos, err := c.ListJob("", nil)
+	if err != nil {
+		return nil, err
+	}
+	jobs := make(map[string]string)
+	for _, jobInfo := range jobInfos {
+		if jobInfo.OutputCommit != nil {
+			jobs[jobInfo.OutputCommit.ID] = jobInfo.Job.ID
+		}
+	}
+	return &provenanceGraph{
+		c:      c,
+		inputs: inputs,
+		jobs:   jobs,
+	}, nil
+}
+
+// upstream returns the tree of the commits upstream of commitInfo.
+func (g *provenanceGraph) upstream(commitInfo *pfsclient.CommitInfo) (*provenanceNode, error) {
+	node := g.newNode(commitInfo.Commit)
+	for _, repo := range g.inputs[commitInfo.Commit.Repo.Name] {
+		for _, commit := range commitInfo.Provenance {
+			if commit.Repo.Name != repo {
+				continue
+			}
+			parentInfo, err := g.c.InspectCommit(commit.Repo.Name, commit.ID)
+			if err != nil {
+				return nil, err
+			}
+			child, err := g.upstream(parentInfo)
+			if err != nil {
+				return nil, err
+			}
+			node.children = append(node.children, child)
+		}
+	}
+	return node, nil
+}
+
+// downstream returns the tree of the commits downstream of commit.
+func (g *provenanceGraph) downstream(commit *pfsclient.Commit) (*provenanceNode, error) {
+	node := g.newNode(commit)
+	var pipelines []string
+	for pipeline, repos := range g.inputs {
+		if contains(repos, commit.Repo.Name) {
+			pipelines = append(pipelines, pipeline)
+		}
+	}
+	sort.Strings(pipelines)
+	for _, pipeline := range pipelines {
+		commitInfos, err := g.c.ListCommit(pipeline, "", "", 0)
+		if err != nil {
+			return nil, err
+		}
+		for _, commitInfo := range commitInfos {
+			for _, provenance := range commitInfo.Provenance {
+				if provenance.Repo.Name == commit.Repo.Name && provenance.ID == commit.ID {
+					child, err := g.downstream(commitInfo.Commit)
+					if err != nil {
+						return nil, err
+					}
+					node.children = append(node.children, child)
+					break
+				}
+			}
+		}
+	}
+	return node, nil
+}
+
+func (g *provenanceGraph) newNode(commit *pfsclient.Commit) *provenanceNode {
+	return &provenanceNode{
+		commit: commit,
+		job:    g.jobs[commit.ID],
+	}
+}
+
+// printTree prints node and its children, indented under it.
+func (node *provenanceNode) printTree(w io.Writer, depth int) {
+	fmt.Fprintf(w, "%s%s", strings.Repeat("  ", depth), commitName(node.commit))
+	if node.job != "" {
+		fmt.Fprintf(w, " (job %s)", node.job)
+	}
+	fmt.Fprint(w, "\n")
+	for _, child := range node.children {
+		child.printTree(w, depth+1)
+	}
+}
+
+// printDotEdges prints the edges of the tree in DOT, each from a commit to
+// the commit downstream of it, labelled with the job that linked them.
+func (node *provenanceNode) printDotEdges(w io.Writer, upstream bool, printed map[string]bool) {
+	for _, child := range node.children {
+		from, to := child, node
+		if !upstream {
+			from, to = node, child
+		}
+		edge := fmt.Sprintf("  %q -> %q", commitName(from.commit), commitName(to.commit))
+		if to.job != "" {
+			edge += fmt.Sprintf(" [label=%q]", "job "+to.job)
+		}
+		if !printed[edge] {
+			fmt.Fprintf(w, "%s;\n", edge)
+			printed[edge] = true
+		}
+		child.printDotEdges(w, upstream, printed)
+	}
+}
+
+func printProvenanceDot(w io.Writer, upstream *provenanceNode, downstream *provenanceNode) {
+	fmt.Fprint(w, "digraph provenance {\n")
+	fmt.Fprintf(w, "  %q [style=bold];\n", commitName(upstream.commit))
+	printed := make(map[string]bool)
+	upstream.printDotEdges(w, true, printed)
+	downstream.printDotEdges(w, false, printed)
+	fmt.Fprint(w, "}\n")
+}
+
+func commitName(commit *pfsclient.Commit) string {
+	return fmt.Sprintf("%s/%s", commit.Repo.Name, commit.ID)
+}
+
+func contains(ss []string, s string) bool {
+	for _, s2 := range ss {
+		if s == s2 {
+			return true
+		}
+	}
+	return false
+}
+
+// printFileProvenance prints the datums that output a file, and the datums
+// that took it as input.
+func printFileProvenance(w io.Writer, resp *ppsclient.FileProvenanceResponse) {
+	if len(resp.Upstream) == 0 && len(resp.Downstream) == 0 {
+		fmt.Fprint(w, "No datums output the file or took it as input.\n")
+		return
+	}
+	for _, datum := range resp.Upstream {
+		fmt.Fprintf(w, "Output by job %s from:\n", datum.Job.ID)
+		for _, input := range datum.Inputs {
+			fmt.Fprintf(w, "  %s\n", fileName(input))
+		}
+	}
+	for _, datum := range resp.Downstream {
+		fmt.Fprintf(w, "Input of job %s, with:\n", datum.Job.ID)
+		for _, input := range datum.Inputs {
+			fmt.Fprintf(w, "  %s\n", fileName(input))
+		}
+		if len(datum.Outputs) > 0 {
+			fmt.Fprint(w, "which output:\n")
+		}
+		for _, output := range datum.Outputs {
+			fmt.Fprintf(w, "  %s\n", fileName(output))
+		}
+	}
+}
+
+func fileName(file *pfsclient.File) string {
+	return fmt.Sprintf("%s%s", commitName(file.Commit), path.Join("/", file.Path))
+}
@@ -0,0 +1,274 @@
+package fuse
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/pachyderm/pachyderm/src/client"
+	pfsclient "github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/server/pkg/diskcache"
+	"github.com/pachyderm/pachyderm/src/server/pkg/pretty"
+)
+
+// cacheBlockSize is the size of the blocks that files' content is read and
+// cached in.
+const cacheBlockSize = 1024 * 1024
+
+// maxCachedInfos is how many files' metadata is cached before expired
+// entries are swept out.
+const maxCachedInfos = 100000
+
+// Options configure how a mount caches what it reads. The zero value caches
+// nothing, every read goes to pachd.
+type Options struct {
+	// CacheBytes is the most file content that's cached on local disk, in a
+	// temporary directory that's removed when the filesystem is unmounted.
+	// If it's 0 file content isn't cached.
+	CacheBytes int64
+	// CacheTTL is how long files' metadata (their size and hash) is cached.
+	// Content is cached by hash, so this is also how long a file that's read
+	// through a branch can go before its new content is seen.
+	CacheTTL time.Duration
+	// ReadAhead is how many bytes past a sequential read are fetched into
+	// the cache in the background. It needs CacheBytes to be set.
+	ReadAhead int64
+}
+
+// CacheStats are a mount's cache counters.
+type CacheStats struct {
+	// Content is the content cache's counters, which are all 0 if content
+	// isn't cached.
+	Content diskcache.Stats
+	// InfoHits and InfoMisses count lookups of files' metadata.
+	InfoHits   int64
+	InfoMisses int64
+	// ReadAheadBlocks is how many blocks were fetched ahead of reads.
+	ReadAheadBlocks int64
+}
+
+func (s CacheStats) String() string {
+	return fmt.Sprintf("content cache: %s in %d blocks, %d hits, %d misses, %d evictions; metadata cache: %d hits, %d misses; read ahead: %d blocks",
+		pretty.Size(uint64(s.Content.Bytes)), s.Content.Items, s.Content.Hits, s.Content.Misses, s.Content.Evictions,
+		s.InfoHits, s.InfoMisses, s.ReadAheadBlocks)
+}
+
+// cache caches files' metadata and content for a mount. Files in open
+// commits can change at any time, so they're never cached.
+type cache struct {
+	apiClient *client.APIClient
+	ttl       time.Duration
+	readAhead int64
+	dir       string
+	data      *diskcache.Cache // nil if content isn't cached
+
+	mu              sync.Mutex
+	infos           map[string]*cachedInfo
+	fetching        map[string]bool // blocks being read ahead
+	infoHits        int64
+	infoMisses      int64
+	readAheadBlocks int64
+}
+
+type cachedInfo struct {
+	fileInfo *pfsclient.FileInfo
+	expires  time.Time
+}
+
+func newCache(apiClient *client.APIClient, opts *Options) (*cache, error) {
+	c := &cache{
+		apiClient: apiClient,
+		ttl:       opts.CacheTTL,
+		readAhead: opts.ReadAhead,
+		infos:     make(map[string]*cachedInfo),
+		fetching:  make(map[string]bool),
+	}
+	if opts.CacheBytes > 0 {
+		dir, err := ioutil.TempDir("", "pfs-mount-cache-")
+		if err != nil {
+			return nil, err
+		}
+		data, err := diskcache.New(dir, opts.CacheBytes)
+		if err != nil {
+			os.RemoveAll(dir)
+			return nil, err
+		}
+		c.dir = dir
+		c.data = data
+	}
+	return c, nil
+}
+
+// enabled returns whether anything is cached.
+func (c *cache) enabled() bool {
+	return c.ttl > 0 || c.data != nil
+}
+
+// close removes the cached content.
+func (c *cache) close() error {
+	if c.dir == "" {
+		return nil
+	}
+	return os.RemoveAll(c.dir)
+}
+
+func (c *cache) stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var stats CacheStats
+	if c.data != nil {
+		stats.Content = c.data.Stats()
+	}
+	stats.InfoHits = c.infoHits
+	stats.InfoMisses = c.infoMisses
+	stats.ReadAheadBlocks = c.readAheadBlocks
+	return stats
+}
+
+// inspectFile returns file's metadata, from the cache if it hasn't expired.
+// write is set if file is in an open commit.
+func (c *cache) inspectFile(file *pfsclient.File, write bool) (*pfsclient.FileInfo, error) {
+	if c.ttl <= 0 || write {
+		return c.apiClient.InspectFile(file.Commit.Repo.Name, file.Commit.ID, file.Path)
+	}
+	k := key(file)
+	c.mu.Lock()
+	if cached, ok := c.infos[k]; ok && time.Now().Before(cached.expires) {
+		c.infoHits++
+		c.mu.Unlock()
+		return cached.fileInfo, nil
+	}
+	c.infoMisses++
+	c.mu.Unlock()
+	fileInfo, err := c.apiClient.InspectFile(file.Commit.Repo.Name, file.Commit.ID, file.Path)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.infos) >= maxCachedInfos {
+		now := time.Now()
+		for k, cached := range c.infos {
+			if now.After(cached.expires) {
+				delete(c.infos, k)
+			}
+		}
+	}
+	c.infos[k] = &cachedInfo{
+		fileInfo: fileInfo,
+		expires:  time.Now().Add(c.ttl),
+	}
+	return fileInfo, nil
+}
+
+// read returns size bytes of file from offset. write is set if file is in an
+// open commit, and sequential if the read follows on from the last one, in
+// which case the blocks after it are read ahead.
+func (c *cache) read(file *pfsclient.File, write bool, offset int64, size int64, sequential bool) ([]byte, error) {
+	if c.data == nil || write {
+		return c.readUncached(file, offset, size)
+	}
+	fileInfo, err := c.inspectFile(file, write)
+	if err != nil {
+		return nil, err
+	}
+	if len(fileInfo.Hash) == 0 {
+		// Without a hash there's nothing to cache the content by.
+		return c.readUncached(file, offset, size)
+	}
+	end := offset + size
+	if end > int64(fileInfo.SizeBytes) {
+		end = int64(fileInfo.SizeBytes)
+	}
+	var result []byte
+	for offset < end {
+		block := offset / cacheBlockSize
+		n := (block+1)*cacheBlockSize - offset
+		if n > end-offset {
+			n = end - offset
+		}
+		f, err := c.block(fileInfo, block)
+		if err != nil {
+			return nil, err
+		}
+		buf := make([]byte, n)
+		_, err = f.ReadAt(buf, offset-block*cacheBlockSize)
+		f.Close()
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		result = append(result, buf...)
+		offset += n
+	}
+	if sequential {
+		c.readAheadFrom(fileInfo, end)
+	}
+	return result, nil
+}
+
+func (c *cache) readUncached(file *pfsclient.File, offset int64, size int64) ([]byte, error) {
+	var buffer bytes.Buffer
+	if err := c.apiClient.GetFile(file.Commit.Repo.Name, file.Commit.ID, file.Path, offset, size, &buffer); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// block returns one block of the file described by fileInfo, which is read
+// from the commit that fileInfo was read from, so that it matches
+// fileInfo's hash even if the file was read through a branch that's moved
+// since.
+func (c *cache) block(fileInfo *pfsclient.FileInfo, block int64) (*os.File, error) {
+	file := fileInfo.File
+	return c.data.Get(blockKey(fileInfo, block), func(w io.Writer) error {
+		return c.apiClient.GetFile(file.Commit.Repo.Name, file.Commit.ID, file.Path, block*cacheBlockSize, cacheBlockSize, w)
+	})
+}
+
+// readAheadFrom fetches the blocks of the file described by fileInfo from
+// offset up to the read ahead size into the cache, in the background.
+func (c *cache) readAheadFrom(fileInfo *pfsclient.FileInfo, offset int64) {
+	if c.readAhead <= 0 {
+		return
+	}
+	end := offset + c.readAhead
+	if end > int64(fileInfo.SizeBytes) {
+		end = int64(fileInfo.SizeBytes)
+	}
+	for block := offset / cacheBlockSize; block*cacheBlockSize < end; block++ {
+		k := blockKey(fileInfo, block)
+		c.mu.Lock()
+		if c.fetching[k] || c.data.Contains(k) {
+			c.mu.Unlock()
+			continue
+		}
+		c.fetching[k] = true
+		c.readAheadBlocks++
+		c.mu.Unlock()
+		go func(block int64, k string) {
+			defer func() {
+				c.mu.Lock()
+				delete(c.fetching, k)
+				c.mu.Unlock()
+			}()
+			f, err := c.block(fileInfo, block)
+			if err != nil {
+				log.Errorf("error reading ahead %s: %v", fileInfo.File.Path, err)
+				return
+			}
+			f.Close()
+		}(block, k)
+	}
+}
+
+// blockKey is the key that a block of a file's content is cached under.
+// Files' hashes change whenever their content does, so blocks never go
+// stale.
+func blockKey(fileInfo *pfsclient.FileInfo, block int64) string {
+	return fmt.Sprintf("%x/%d", fileInfo.Hash, block)
+}
@@ -1,7 +1,6 @@
 package fuse
 
 import (
-	"bytes"
 	"fmt"
 	"io"
 	"os"
@@ -27,6 +26,7 @@ import (
 
 type filesystem struct {
 	apiClient *client.APIClient
+	cache     *cache
 	Filesystem
 	inodes map[string]uint64
 	lock   sync.RWMutex
@@ -34,10 +34,12 @@ type filesystem struct {
 
 func newFilesystem(
 	apiClient *client.APIClient,
+	cache *cache,
 	commitMounts []*CommitMount,
 ) *filesystem {
 	return &filesystem{
 		apiClient: apiClient,
+		cache:     cache,
 		Filesystem: Filesystem{
 			commitMounts,
 		},
@@ -53,10 +55,12 @@ type repoFilesystem struct {
 
 func newRepoFilesystem(
 	apiClient *client.APIClient,
+	cache *cache,
 	commitMount *CommitMount,
 ) *repoFilesystem {
 	return &repoFilesystem{&filesystem{
 		apiClient: apiClient,
+		cache:     cache,
 		Filesystem: Filesystem{
 			[]*CommitMount{commitMount},
 		},
@@ -243,17 +247,16 @@ func (f *file) Attr(ctx context.Context, a *fuse.Attr) (retErr error) {
 			log.Error(&FileAttr{&f.Node, &Attr{uint32(a.Mode)}, errorToString(retErr)})
 		}
 	}()
-	fileInfo, err := f.fs.apiClient.InspectFile(
-		f.File.Commit.Repo.Name,
-		f.File.Commit.ID,
-		f.File.Path,
-	)
+	fileInfo, err := f.fs.cache.inspectFile(f.File, f.Write)
 	if err != nil {
 		return err
 	}
 	if fileInfo != nil {
 		a.Size = fileInfo.SizeBytes
 	}
+	if !f.Write {
+		a.Valid = f.fs.cache.ttl
+	}
 	a.Mode = 0666
 	a.Inode = f.fs.inode(f.File)
 	return nil
@@ -294,11 +297,7 @@ func (f *file) Open(ctx context.Context, request *fuse.OpenRequest, response *fu
 		}
 	}()
 	response.Flags |= fuse.OpenDirectIO | fuse.OpenNonSeekable
-	fileInfo, err := f.fs.apiClient.InspectFile(
-		f.File.Commit.Repo.Name,
-		f.File.Commit.ID,
-		f.File.Path,
-	)
+	fileInfo, err := f.fs.cache.inspectFile(f.File, f.Write)
 	if err != nil {
 		return nil, err
 	}
@@ -380,7 +379,10 @@ type handle struct {
 	f      *file
 	w      io.WriteCloser
 	cursor int
-	lock   sync.Mutex
+	// readEnd is where the last read ended, a read that starts there is
+	// sequential.
+	readEnd int64
+	lock    sync.Mutex
 }
 
 func (h *handle) Read(ctx context.Context, request *fuse.ReadRequest, response *fuse.ReadResponse) (retErr error) {
@@ -391,15 +393,12 @@ func (h *handle) Read(ctx context.Context, request *fuse.ReadRequest, response *
 			log.Error(&FileRead{&h.f.Node, string(response.Data), errorToString(retErr)})
 		}
 	}()
-	var buffer bytes.Buffer
-	if err := h.f.fs.apiClient.GetFile(
-		h.f.File.Commit.Repo.Name,
-		h.f.File.Commit.ID,
-		h.f.File.Path,
-		request.Offset,
-		int64(request.Size),
-		&buffer,
-	); err != nil {
+	h.lock.Lock()
+	sequential := request.Offset == h.readEnd
+	h.readEnd = request.Offset + int64(request.Size)
+	h.lock.Unlock()
+	data, err := h.f.fs.cache.read(h.f.File, h.f.Write, request.Offset, int64(request.Size), sequential)
+	if err != nil {
 		if grpc.Code(err) == codes.NotFound {
 			// ENOENT from read(2) is weird, let's call this EINVAL
 			// instead.
@@ -407,7 +406,7 @@ func (h *handle) Read(ctx context.Context, request *fuse.ReadRequest, response *
 		}
 		return err
 	}
-	response.Data = buffer.Bytes()
+	response.Data = data
 	return nil
 }
 
@@ -589,16 +588,15 @@ func (d *directory) lookUpFile(ctx context.Context, name string) (fs.Node, error
 	var fileInfo *pfsclient.FileInfo
 	var err error
 
-	fileInfo, err = d.fs.apiClient.InspectFile(
-		d.File.Commit.Repo.Name,
-		d.File.Commit.ID,
-		path.Join(d.File.Path, name),
-	)
+	lookUp := d.copy()
+	lookUp.File.Path = path.Join(d.File.Path, name)
+	fileInfo, err = d.fs.cache.inspectFile(lookUp.File, d.Node.Write)
 	if err != nil {
 		return nil, fuse.ENOENT
 	}
+	size := int64(fileInfo.SizeBytes)
 	if d.Node.Write {
-		fileInfo.SizeBytes = 0
+		size = 0
 	}
 
 	// We want to inherit the metadata other than the path, which should be the
@@ -610,7 +608,7 @@ func (d *directory) lookUpFile(ctx context.Context, name string) (fs.Node, error
 	case pfsclient.FileType_FILE:
 		return &file{
 			directory: *directory,
-			size:      int64(fileInfo.SizeBytes),
+			size:      size,
 		}, nil
 	case pfsclient.FileType_DIR:
 		return directory, nil
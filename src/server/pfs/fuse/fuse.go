@@ -30,5 +30,13 @@ type Mounter interface {
 // NewMounter creates a new Mounter.
 // Address can be left blank, it's used only for aesthetic purposes.
 func NewMounter(address string, apiClient *client.APIClient) Mounter {
-	return newMounter(address, apiClient)
+	return newMounter(address, apiClient, &Options{})
+}
+
+// NewMounterWithOptions creates a new Mounter whose filesystems cache what
+// they read as opts configure. While a filesystem is mounted its cache's
+// statistics are printed to stderr when the process receives SIGUSR1, and
+// when it's unmounted.
+func NewMounterWithOptions(address string, apiClient *client.APIClient, opts *Options) Mounter {
+	return newMounter(address, apiClient, opts)
 }
@@ -5,6 +5,7 @@ import (
 	"os"
 	"os/signal"
 	"sync"
+	"syscall"
 
 	"bazil.org/fuse"
 	"bazil.org/fuse/fs"
@@ -20,12 +21,14 @@ const (
 type mounter struct {
 	address   string
 	apiClient *client.APIClient
+	opts      *Options
 }
 
-func newMounter(address string, apiClient *client.APIClient) Mounter {
+func newMounter(address string, apiClient *client.APIClient, opts *Options) Mounter {
 	return &mounter{
 		address,
 		apiClient,
+		opts,
 	}
 }
 
@@ -55,6 +58,18 @@ func (m *mounter) Mount(
 			close(ready)
 		}
 	})
+	cache, err := newCache(m.apiClient, m.opts)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cache.enabled() {
+			fmt.Fprintln(os.Stderr, cache.stats())
+		}
+		if err := cache.close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
 	name := namePrefix + m.address
 	conn, err := fuse.Mount(
 		mountPoint,
@@ -80,6 +95,14 @@ func (m *mounter) Mount(
 		<-sigChan
 		m.Unmount(mountPoint)
 	}()
+	statsChan := make(chan os.Signal, 1)
+	signal.Notify(statsChan, syscall.SIGUSR1)
+	defer signal.Stop(statsChan)
+	go func() {
+		for range statsChan {
+			fmt.Fprintln(os.Stderr, cache.stats())
+		}
+	}()
 
 	once.Do(func() {
 		if ready != nil {
@@ -97,9 +120,9 @@ func (m *mounter) Mount(
 		if len(commitMounts) != 1 {
 			return fmt.Errorf("expect 1 CommitMount, got %d", len(commitMounts))
 		}
-		filesystem = newRepoFilesystem(m.apiClient, commitMounts[0])
+		filesystem = newRepoFilesystem(m.apiClient, cache, commitMounts[0])
 	} else {
-		filesystem = newFilesystem(m.apiClient, commitMounts)
+		filesystem = newFilesystem(m.apiClient, cache, commitMounts)
 	}
 	if err := fs.New(conn, config).Serve(filesystem); err != nil {
 		return err
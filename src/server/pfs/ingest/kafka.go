@@ -0,0 +1,242 @@
+// Package ingest implements connectors which continuously commit data from
+// external systems into a repo.
+package ingest
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/server/pkg/kafka"
+
+	"go.pedge.io/lion/proto"
+)
+
+const (
+	defaultKafkaMaxRecords = 10000
+	defaultKafkaMaxBytes   = 64 * 1024 * 1024
+	defaultKafkaInterval   = time.Minute
+	kafkaFetchBytes        = 1024 * 1024
+	kafkaFetchWait         = 200 * time.Millisecond
+	// kafkaOffsetsDir holds a file per partition with the offset of the next
+	// record to consume from it. It's committed along with the records, so
+	// each record is committed exactly once.
+	kafkaOffsetsDir = "/.kafka"
+)
+
+// KafkaSpec configures a connector that commits the records of a Kafka
+// topic to a repo.
+type KafkaSpec struct {
+	// Brokers are host:port addresses of brokers to discover the cluster
+	// through.
+	Brokers []string `json:"brokers"`
+	Topic   string   `json:"topic"`
+	// Repo is created if it doesn't exist.
+	Repo   string `json:"repo"`
+	Branch string `json:"branch"`
+	// Start is where to start reading partitions that have never been
+	// committed: "oldest" (the default) or "newest".
+	Start string `json:"start"`
+	// A commit is made as soon as MaxRecords records or MaxBytes bytes are
+	// waiting to be committed, or when the oldest waiting record has been
+	// waiting for Interval (e.g. "30s").
+	MaxRecords int    `json:"max_records"`
+	MaxBytes   int64  `json:"max_bytes"`
+	Interval   string `json:"interval"`
+}
+
+// kafkaPartition is the records read from a partition that haven't been
+// committed yet.
+type kafkaPartition struct {
+	partition int32
+	// offset is the offset of the next record to read.
+	offset int64
+	// first is the offset of the first uncommitted record.
+	first   int64
+	records bytes.Buffer
+	count   int
+}
+
+// RunKafka commits the records in spec.Topic to spec.Repo until it
+// encounters an error. Each commit adds a file per partition, at
+// /<topic>/<partition>/<offset of first record>, with one record value per
+// line. RunKafka can be stopped and restarted at any time, it picks up
+// where the last commit left off.
+func RunKafka(pachClient *client.APIClient, spec *KafkaSpec) error {
+	if len(spec.Brokers) == 0 || spec.Topic == "" || spec.Repo == "" {
+		return fmt.Errorf("brokers, topic and repo must be set")
+	}
+	branch := spec.Branch
+	if branch == "" {
+		branch = "master"
+	}
+	start := kafka.OffsetOldest
+	switch spec.Start {
+	case "", "oldest":
+	case "newest":
+		start = kafka.OffsetNewest
+	default:
+		return fmt.Errorf("invalid start %q, must be \"oldest\" or \"newest\"", spec.Start)
+	}
+	maxRecords := spec.MaxRecords
+	if maxRecords <= 0 {
+		maxRecords = defaultKafkaMaxRecords
+	}
+	maxBytes := spec.MaxBytes
+	if maxBytes <= 0 {
+		maxBytes = defaultKafkaMaxBytes
+	}
+	interval := defaultKafkaInterval
+	if spec.Interval != "" {
+		var err error
+		interval, err = time.ParseDuration(spec.Interval)
+		if err != nil {
+			return err
+		}
+	}
+	if _, err := pachClient.InspectRepo(spec.Repo); err != nil {
+		if !isNotFoundErr(err) {
+			return err
+		}
+		if err := pachClient.CreateRepo(spec.Repo); err != nil {
+			return err
+		}
+	}
+
+	kafkaClient := kafka.NewClient(spec.Brokers)
+	defer kafkaClient.Close()
+	partitionIDs, err := kafkaClient.Partitions(spec.Topic)
+	if err != nil {
+		return err
+	}
+	var partitions []*kafkaPartition
+	for _, id := range partitionIDs {
+		offset, err := committedOffset(pachClient, spec.Repo, branch, spec.Topic, id)
+		if err != nil {
+			return err
+		}
+		if offset < 0 {
+			offset, err = kafkaClient.Offset(spec.Topic, id, start)
+			if err != nil {
+				return err
+			}
+		}
+		partitions = append(partitions, &kafkaPartition{partition: id, offset: offset, first: offset})
+	}
+
+	var waiting int
+	var waitingBytes int64
+	var waitingSince time.Time
+	for {
+		for _, p := range partitions {
+			messages, err := kafkaClient.Fetch(spec.Topic, p.partition, p.offset, kafkaFetchBytes, kafkaFetchWait)
+			if err == kafka.ErrOffsetOutOfRange {
+				// The records we wanted were deleted by retention before
+				// we got to them.
+				oldest, err := kafkaClient.Offset(spec.Topic, p.partition, kafka.OffsetOldest)
+				if err != nil {
+					return err
+				}
+				protolion.Errorf("offset %d of %s/%d is out of range, skipping to %d", p.offset, spec.Topic, p.partition, oldest)
+				if p.count == 0 {
+					p.first = oldest
+				}
+				p.offset = oldest
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			for _, message := range messages {
+				if waiting == 0 {
+					waitingSince = time.Now()
+				}
+				p.records.Write(message.Value)
+				p.records.WriteByte('\n')
+				p.count++
+				p.offset = message.Offset + 1
+				waiting++
+				waitingBytes += int64(len(message.Value)) + 1
+			}
+		}
+		if waiting == 0 {
+			continue
+		}
+		if waiting < maxRecords && waitingBytes < maxBytes && time.Since(waitingSince) < interval {
+			continue
+		}
+		if err := commitKafkaRecords(pachClient, spec.Repo, branch, spec.Topic, partitions); err != nil {
+			return err
+		}
+		waiting = 0
+		waitingBytes = 0
+	}
+}
+
+// commitKafkaRecords commits the waiting records of partitions, along with
+// the offsets that they were read up to.
+func commitKafkaRecords(pachClient *client.APIClient, repo string, branch string, topic string, partitions []*kafkaPartition) (retErr error) {
+	commit, err := pachClient.StartCommit(repo, branch)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if retErr != nil {
+			// Don't leave a half written commit on the branch, the records
+			// will be read again when we're restarted.
+			if err := pachClient.DeleteCommit(repo, commit.ID); err != nil {
+				protolion.Errorf("error deleting commit %s/%s: %v", repo, commit.ID, err)
+			}
+		}
+	}()
+	for _, p := range partitions {
+		if p.count == 0 {
+			continue
+		}
+		file := path.Join("/", topic, fmt.Sprint(p.partition), fmt.Sprintf("%020d", p.first))
+		if _, err := pachClient.PutFile(repo, commit.ID, file, bytes.NewReader(p.records.Bytes())); err != nil {
+			return err
+		}
+		offsetFile := kafkaOffsetFile(topic, p.partition)
+		if err := pachClient.DeleteFile(repo, commit.ID, offsetFile); err != nil {
+			return err
+		}
+		if _, err := pachClient.PutFile(repo, commit.ID, offsetFile, strings.NewReader(fmt.Sprint(p.offset))); err != nil {
+			return err
+		}
+	}
+	if err := pachClient.FinishCommit(repo, commit.ID); err != nil {
+		return err
+	}
+	for _, p := range partitions {
+		p.records.Reset()
+		p.count = 0
+		p.first = p.offset
+	}
+	return nil
+}
+
+// committedOffset returns the offset that has been committed for
+// partition on branch, or -1 if it's never been committed.
+func committedOffset(pachClient *client.APIClient, repo string, branch string, topic string, partition int32) (int64, error) {
+	var buf bytes.Buffer
+	if err := pachClient.GetFile(repo, branch, kafkaOffsetFile(topic, partition), 0, 0, &buf); err != nil {
+		if isNotFoundErr(err) {
+			return -1, nil
+		}
+		return 0, err
+	}
+	return strconv.ParseInt(strings.TrimSpace(buf.String()), 10, 64)
+}
+
+func kafkaOffsetFile(topic string, partition int32) string {
+	return path.Join(kafkaOffsetsDir, topic, fmt.Sprint(partition))
+}
+
+func isNotFoundErr(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "not found")
+}
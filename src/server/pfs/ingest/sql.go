@@ -0,0 +1,90 @@
+package ingest
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client"
+
+	"go.pedge.io/lion/proto"
+)
+
+// SQLSpec configures a connector that periodically commits a snapshot of
+// the result of a SQL query to a repo.
+type SQLSpec struct {
+	// Connection is the name of a data source in pachd's sql-connections
+	// secret, the query is run by pachd.
+	Connection string `json:"connection"`
+	Query      string `json:"query"`
+	// Format is "csv" (the default) or "json".
+	Format string `json:"format"`
+	// Repo is created if it doesn't exist.
+	Repo   string `json:"repo"`
+	Branch string `json:"branch"`
+	// Path is the file that each snapshot replaces.
+	Path string `json:"path"`
+	// Interval is how often to take a snapshot (e.g. "1h"), if it's unset a
+	// single snapshot is taken.
+	Interval string `json:"interval"`
+}
+
+// RunSQL commits a snapshot of the result of spec.Query to spec.Path every
+// spec.Interval, until it encounters an error.
+func RunSQL(pachClient *client.APIClient, spec *SQLSpec) error {
+	if spec.Connection == "" || spec.Query == "" || spec.Repo == "" || spec.Path == "" {
+		return fmt.Errorf("connection, query, repo and path must be set")
+	}
+	branch := spec.Branch
+	if branch == "" {
+		branch = "master"
+	}
+	var interval time.Duration
+	if spec.Interval != "" {
+		var err error
+		interval, err = time.ParseDuration(spec.Interval)
+		if err != nil {
+			return err
+		}
+	}
+	if _, err := pachClient.InspectRepo(spec.Repo); err != nil {
+		if !isNotFoundErr(err) {
+			return err
+		}
+		if err := pachClient.CreateRepo(spec.Repo); err != nil {
+			return err
+		}
+	}
+	for {
+		start := time.Now()
+		if err := commitSQLSnapshot(pachClient, spec, branch); err != nil {
+			return err
+		}
+		if interval == 0 {
+			return nil
+		}
+		time.Sleep(interval - time.Since(start))
+	}
+}
+
+// commitSQLSnapshot commits the result of spec's query in place of the
+// previous snapshot.
+func commitSQLSnapshot(pachClient *client.APIClient, spec *SQLSpec, branch string) (retErr error) {
+	commit, err := pachClient.StartCommit(spec.Repo, branch)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if retErr != nil {
+			if err := pachClient.DeleteCommit(spec.Repo, commit.ID); err != nil {
+				protolion.Errorf("error deleting commit %s/%s: %v", spec.Repo, commit.ID, err)
+			}
+		}
+	}()
+	if err := pachClient.DeleteFile(spec.Repo, commit.ID, spec.Path); err != nil {
+		return err
+	}
+	if err := pachClient.PutFileQuery(spec.Repo, commit.ID, spec.Path, spec.Connection, spec.Query, spec.Format); err != nil {
+		return err
+	}
+	return pachClient.FinishCommit(spec.Repo, commit.ID)
+}
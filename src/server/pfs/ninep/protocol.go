@@ -0,0 +1,206 @@
+package ninep
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// Message types, from the 9P2000 spec (intro(5) in Plan 9's manual).
+const (
+	tversion = 100
+	rversion = 101
+	tauth    = 102
+	tattach  = 104
+	rattach  = 105
+	rerror   = 107
+	tflush   = 108
+	rflush   = 109
+	twalk    = 110
+	rwalk    = 111
+	topen    = 112
+	ropen    = 113
+	tcreate  = 114
+	tread    = 116
+	rread    = 117
+	twrite   = 118
+	tclunk   = 120
+	rclunk   = 121
+	tremove  = 122
+	tstat    = 124
+	rstat    = 125
+	twstat   = 126
+)
+
+const (
+	qtDir  = 0x80
+	qtFile = 0x00
+
+	dmDir = 0x80000000
+
+	// open modes
+	oRead   = 0
+	oExec   = 3
+	oTrunc  = 0x10
+	oRClose = 0x40
+
+	noFid = ^uint32(0)
+
+	version9P = "9P2000"
+	// headerSize is size[4] type[1] tag[2]
+	headerSize = 7
+	// ioHeaderSize is the overhead of an Rread on top of its data.
+	ioHeaderSize = headerSize + 4
+)
+
+type qid struct {
+	typ     uint8
+	version uint32
+	path    uint64
+}
+
+type stat struct {
+	qid    qid
+	mode   uint32
+	atime  uint32
+	mtime  uint32
+	length uint64
+	name   string
+}
+
+// decoder reads the fields of a message body. Errors are sticky, the
+// message is invalid if err is set once all the fields have been read.
+type decoder struct {
+	buf []byte
+	err error
+}
+
+func (d *decoder) next(n int) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if len(d.buf) < n {
+		d.err = fmt.Errorf("message too short")
+		return nil
+	}
+	b := d.buf[:n]
+	d.buf = d.buf[n:]
+	return b
+}
+
+func (d *decoder) uint8() uint8 {
+	if b := d.next(1); b != nil {
+		return b[0]
+	}
+	return 0
+}
+
+func (d *decoder) uint16() uint16 {
+	if b := d.next(2); b != nil {
+		return binary.LittleEndian.Uint16(b)
+	}
+	return 0
+}
+
+func (d *decoder) uint32() uint32 {
+	if b := d.next(4); b != nil {
+		return binary.LittleEndian.Uint32(b)
+	}
+	return 0
+}
+
+func (d *decoder) uint64() uint64 {
+	if b := d.next(8); b != nil {
+		return binary.LittleEndian.Uint64(b)
+	}
+	return 0
+}
+
+func (d *decoder) string() string {
+	n := d.uint16()
+	return string(d.next(int(n)))
+}
+
+// encoder builds a message. The size field is filled in by bytes().
+type encoder struct {
+	buf []byte
+}
+
+func newEncoder(typ uint8, tag uint16) *encoder {
+	e := &encoder{buf: make([]byte, 4, 64)}
+	e.uint8(typ)
+	e.uint16(tag)
+	return e
+}
+
+func (e *encoder) uint8(v uint8) {
+	e.buf = append(e.buf, v)
+}
+
+func (e *encoder) uint16(v uint16) {
+	e.buf = append(e.buf, byte(v), byte(v>>8))
+}
+
+func (e *encoder) uint32(v uint32) {
+	e.buf = append(e.buf, byte(v), byte(v>>8), byte(v>>16), byte(v>>24))
+}
+
+func (e *encoder) uint64(v uint64) {
+	e.uint32(uint32(v))
+	e.uint32(uint32(v >> 32))
+}
+
+func (e *encoder) string(s string) {
+	e.uint16(uint16(len(s)))
+	e.buf = append(e.buf, s...)
+}
+
+func (e *encoder) qid(q qid) {
+	e.uint8(q.typ)
+	e.uint32(q.version)
+	e.uint64(q.path)
+}
+
+func (e *encoder) stat(s *stat) {
+	e.buf = append(e.buf, marshalStat(s)...)
+}
+
+func (e *encoder) bytes() []byte {
+	binary.LittleEndian.PutUint32(e.buf, uint32(len(e.buf)))
+	return e.buf
+}
+
+// marshalStat encodes s the way it appears in directory reads and Rstat.
+func marshalStat(s *stat) []byte {
+	e := &encoder{buf: make([]byte, 2, 64)}
+	e.uint16(0) // type
+	e.uint32(0) // dev
+	e.qid(s.qid)
+	e.uint32(s.mode)
+	e.uint32(s.atime)
+	e.uint32(s.mtime)
+	e.uint64(s.length)
+	e.string(s.name)
+	e.string("pachyderm") // uid
+	e.string("pachyderm") // gid
+	e.string("")          // muid
+	binary.LittleEndian.PutUint16(e.buf, uint16(len(e.buf)-2))
+	return e.buf
+}
+
+// readMessage reads one message from r, msize bounds its size.
+func readMessage(r io.Reader, msize uint32) (typ uint8, tag uint16, body []byte, retErr error) {
+	var header [headerSize]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return 0, 0, nil, err
+	}
+	size := binary.LittleEndian.Uint32(header[:4])
+	if size < headerSize || size > msize {
+		return 0, 0, nil, fmt.Errorf("invalid message size %d", size)
+	}
+	body = make([]byte, size-headerSize)
+	if _, err := io.ReadFull(r, body); err != nil {
+		return 0, 0, nil, err
+	}
+	return header[4], binary.LittleEndian.Uint16(header[5:]), body, nil
+}
@@ -0,0 +1,137 @@
+package ninep
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+// unmarshalStat decodes a stat encoded by marshalStat.
+func unmarshalStat(d *decoder) *stat {
+	size := int(d.uint16())
+	start := len(d.buf)
+	s := &stat{}
+	d.uint16() // type
+	d.uint32() // dev
+	s.qid = decodeQid(d)
+	s.mode = d.uint32()
+	s.atime = d.uint32()
+	s.mtime = d.uint32()
+	s.length = d.uint64()
+	s.name = d.string()
+	d.string() // uid
+	d.string() // gid
+	d.string() // muid
+	if d.err == nil && start-len(d.buf) != size {
+		d.err = io.ErrUnexpectedEOF
+	}
+	return s
+}
+
+func decodeQid(d *decoder) qid {
+	return qid{typ: d.uint8(), version: d.uint32(), path: d.uint64()}
+}
+
+func TestMessageRoundTrip(t *testing.T) {
+	e := newEncoder(twalk, 0x1234)
+	e.uint8(0xab)
+	e.uint16(0xabcd)
+	e.uint32(0xdeadbeef)
+	e.uint64(0x0123456789abcdef)
+	e.string("name")
+	e.qid(qid{typ: qtDir, version: 7, path: 42})
+	msg := e.bytes()
+	// Integers are little endian, strings are prefixed by a 2 byte length.
+	require.Equal(t, []byte{
+		41, 0, 0, 0, twalk, 0x34, 0x12,
+		0xab,
+		0xcd, 0xab,
+		0xef, 0xbe, 0xad, 0xde,
+		0xef, 0xcd, 0xab, 0x89, 0x67, 0x45, 0x23, 0x01,
+		4, 0, 'n', 'a', 'm', 'e',
+		qtDir, 7, 0, 0, 0, 42, 0, 0, 0, 0, 0, 0, 0,
+	}, msg)
+
+	typ, tag, body, err := readMessage(bytes.NewReader(msg), maxMsize)
+	require.NoError(t, err)
+	require.Equal(t, uint8(twalk), typ)
+	require.Equal(t, uint16(0x1234), tag)
+	d := &decoder{buf: body}
+	require.Equal(t, uint8(0xab), d.uint8())
+	require.Equal(t, uint16(0xabcd), d.uint16())
+	require.Equal(t, uint32(0xdeadbeef), d.uint32())
+	require.Equal(t, uint64(0x0123456789abcdef), d.uint64())
+	require.Equal(t, "name", d.string())
+	require.Equal(t, qid{typ: qtDir, version: 7, path: 42}, decodeQid(d))
+	require.NoError(t, d.err)
+	require.Equal(t, 0, len(d.buf))
+}
+
+func TestStatRoundTrip(t *testing.T) {
+	s := &stat{
+		qid:    qid{typ: qtFile, version: 3, path: 99},
+		mode:   0444,
+		atime:  1500000000,
+		mtime:  1500000001,
+		length: 1 << 40,
+		name:   "file.txt",
+	}
+	d := &decoder{buf: marshalStat(s)}
+	require.Equal(t, s, unmarshalStat(d))
+	require.NoError(t, d.err)
+	require.Equal(t, 0, len(d.buf))
+}
+
+func TestReadMessageInvalid(t *testing.T) {
+	msg := newEncoder(tclunk, 1).bytes()
+	_, _, _, err := readMessage(bytes.NewReader(msg[:headerSize-1]), maxMsize)
+	require.Equal(t, io.ErrUnexpectedEOF, err)
+
+	// Sizes smaller than the header, or bigger than msize.
+	for _, size := range []byte{0, headerSize - 1} {
+		invalid := append([]byte{}, msg...)
+		invalid[0] = size
+		_, _, _, err = readMessage(bytes.NewReader(invalid), maxMsize)
+		require.YesError(t, err)
+	}
+	e := newEncoder(twrite, 1)
+	e.buf = append(e.buf, make([]byte, 100)...)
+	_, _, _, err = readMessage(bytes.NewReader(e.bytes()), 100)
+	require.YesError(t, err)
+
+	// A body cut short.
+	e = newEncoder(tclunk, 1)
+	e.uint32(5)
+	msg = e.bytes()
+	_, _, _, err = readMessage(bytes.NewReader(msg[:len(msg)-1]), maxMsize)
+	require.Equal(t, io.ErrUnexpectedEOF, err)
+}
+
+func TestDecoderShort(t *testing.T) {
+	e := &encoder{}
+	e.string("name")
+	d := &decoder{buf: e.buf[:4]}
+	require.Equal(t, "", d.string())
+	require.YesError(t, d.err)
+	// Errors are sticky.
+	d.buf = []byte{1, 2, 3, 4}
+	require.Equal(t, uint32(0), d.uint32())
+	require.YesError(t, d.err)
+}
+
+func TestHandleMalformed(t *testing.T) {
+	c := &conn{msize: maxMsize, fids: make(map[uint32]*fid)}
+	// Every request with its body cut short is answered with an Rerror
+	// with the same tag.
+	for _, typ := range []uint8{tversion, tattach, twalk, topen, tread, tstat, tclunk} {
+		typ, tag, body, err := readMessage(bytes.NewReader(c.handle(typ, 7, []byte{1})), maxMsize)
+		require.NoError(t, err)
+		require.Equal(t, uint8(rerror), typ)
+		require.Equal(t, uint16(7), tag)
+		d := &decoder{buf: body}
+		require.NotEqual(t, "", d.string())
+		require.NoError(t, d.err)
+	}
+}
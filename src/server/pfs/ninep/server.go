@@ -0,0 +1,557 @@
+// Package ninep exports repos over 9P2000, read-only, so that machines that
+// can't run a FUSE mount can still read PFS through their kernel's 9p
+// client, e.g.:
+//
+//	mount -t 9p -o trans=tcp,port=564,version=9p2000 <host> /pfs
+//
+// The export's root has a directory for each exported repo, each of which
+// has a directory for each exported branch, which contains the files in the
+// head commit of the branch. A branch's head is looked up when it's walked
+// to, so files that are open keep reading from the same commit while the
+// branch moves on.
+package ninep
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"net"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+
+	"github.com/gogo/protobuf/types"
+	"go.pedge.io/lion/proto"
+)
+
+const (
+	// maxMsize is the largest message size we'll negotiate.
+	maxMsize = 1 << 20
+
+	errNotExist = "file does not exist"
+	errReadOnly = "Read-only file system"
+)
+
+// Server serves a read-only 9P2000 export of some repos.
+type Server struct {
+	pachClient *client.APIClient
+	// exports maps the exported repos to their exported branches, a nil
+	// map means every branch is exported.
+	exports map[string]map[string]bool
+}
+
+// NewServer returns a Server that exports the repos and branches in
+// exports, each of which is either "repo", to export all of the repo's
+// branches, or "repo/branch".
+func NewServer(pachClient *client.APIClient, exports []string) (*Server, error) {
+	s := &Server{
+		pachClient: pachClient,
+		exports:    make(map[string]map[string]bool),
+	}
+	for _, export := range exports {
+		parts := strings.SplitN(export, "/", 2)
+		repo := parts[0]
+		if repo == "" {
+			return nil, fmt.Errorf("invalid export %q, must be repo or repo/branch", export)
+		}
+		if _, err := pachClient.InspectRepo(repo); err != nil {
+			return nil, err
+		}
+		if len(parts) == 1 {
+			s.exports[repo] = nil
+			continue
+		}
+		branches, ok := s.exports[repo]
+		if ok && branches == nil {
+			// The whole repo is already exported.
+			continue
+		}
+		if branches == nil {
+			branches = make(map[string]bool)
+			s.exports[repo] = branches
+		}
+		branches[parts[1]] = true
+	}
+	return s, nil
+}
+
+// Serve accepts 9P connections on listener until it fails.
+func (s *Server) Serve(listener net.Listener) error {
+	for {
+		netConn, err := listener.Accept()
+		if err != nil {
+			return err
+		}
+		go func() {
+			c := &conn{
+				s:     s,
+				msize: maxMsize,
+				fids:  make(map[uint32]*fid),
+			}
+			if err := c.serve(netConn); err != nil && err != io.EOF {
+				protolion.Errorf("error serving 9p connection from %s: %v", netConn.RemoteAddr(), err)
+			}
+		}()
+	}
+}
+
+// fid is a reference, held by the client, to a file in the export.
+type fid struct {
+	// path is [repo, branch, file path components...]
+	path []string
+	// commitID is the commit that branch pointed to when it was walked.
+	commitID string
+	mtime    uint32
+	isDir    bool
+	size     uint64
+	open     bool
+	// dirEntries and dirOffset are the marshalled entries of an open
+	// directory and how far into them the client has read.
+	dirEntries [][]byte
+	dirIndex   int
+	dirOffset  uint64
+}
+
+func (f *fid) repo() string {
+	return f.path[0]
+}
+
+func (f *fid) filePath() string {
+	return path.Join(f.path[2:]...)
+}
+
+func (f *fid) qid() qid {
+	q := qid{typ: qtFile, path: hash(strings.Join(f.path, "/"))}
+	if f.isDir {
+		q.typ = qtDir
+	}
+	if f.commitID != "" {
+		// The same path in a new commit may have new content.
+		q.version = uint32(hash(f.commitID))
+	}
+	return q
+}
+
+func (f *fid) stat() *stat {
+	s := &stat{
+		qid:   f.qid(),
+		mode:  0444,
+		atime: f.mtime,
+		mtime: f.mtime,
+		name:  "/",
+	}
+	if len(f.path) > 0 {
+		s.name = f.path[len(f.path)-1]
+	}
+	if f.isDir {
+		s.mode = dmDir | 0555
+	} else {
+		s.length = f.size
+	}
+	return s
+}
+
+// conn serves a single client. Requests are handled one at a time, in the
+// order they arrive, which makes Tflush trivial.
+type conn struct {
+	s     *Server
+	msize uint32
+	fids  map[uint32]*fid
+}
+
+func (c *conn) serve(netConn net.Conn) error {
+	defer netConn.Close()
+	r := bufio.NewReader(netConn)
+	for {
+		typ, tag, body, err := readMessage(r, c.msize)
+		if err != nil {
+			return err
+		}
+		resp := c.handle(typ, tag, body)
+		if _, err := netConn.Write(resp); err != nil {
+			return err
+		}
+	}
+}
+
+func (c *conn) handle(typ uint8, tag uint16, body []byte) []byte {
+	d := &decoder{buf: body}
+	switch typ {
+	case tversion:
+		msize := d.uint32()
+		version := d.string()
+		if d.err != nil {
+			return errorMessage(tag, d.err.Error())
+		}
+		if msize < c.msize {
+			c.msize = msize
+		}
+		// A new version starts a new session.
+		c.fids = make(map[uint32]*fid)
+		e := newEncoder(rversion, tag)
+		e.uint32(c.msize)
+		// Clients that ask for an extension of 9P2000 (e.g. Linux's
+		// 9P2000.L) fall back to plain 9P2000.
+		if strings.HasPrefix(version, version9P) {
+			e.string(version9P)
+		} else {
+			e.string("unknown")
+		}
+		return e.bytes()
+	case tauth:
+		return errorMessage(tag, "authentication not required")
+	case tattach:
+		fidNum := d.uint32()
+		d.uint32() // afid
+		d.string() // uname
+		d.string() // aname
+		if d.err != nil {
+			return errorMessage(tag, d.err.Error())
+		}
+		if _, ok := c.fids[fidNum]; ok {
+			return errorMessage(tag, "fid in use")
+		}
+		root := &fid{isDir: true}
+		c.fids[fidNum] = root
+		e := newEncoder(rattach, tag)
+		e.qid(root.qid())
+		return e.bytes()
+	case tflush:
+		// Requests are handled in order, so the flushed one has already
+		// been answered.
+		return newEncoder(rflush, tag).bytes()
+	case twalk:
+		return c.walk(tag, d)
+	case topen:
+		return c.openFid(tag, d)
+	case tread:
+		return c.read(tag, d)
+	case tstat:
+		f, errMsg := c.fid(d)
+		if f == nil {
+			return errorMessage(tag, errMsg)
+		}
+		statBytes := marshalStat(f.stat())
+		e := newEncoder(rstat, tag)
+		e.uint16(uint16(len(statBytes)))
+		e.buf = append(e.buf, statBytes...)
+		return e.bytes()
+	case tclunk:
+		fidNum := d.uint32()
+		if _, ok := c.fids[fidNum]; !ok {
+			return errorMessage(tag, "unknown fid")
+		}
+		delete(c.fids, fidNum)
+		return newEncoder(rclunk, tag).bytes()
+	case tremove:
+		// Tremove clunks the fid even when it fails.
+		delete(c.fids, d.uint32())
+		return errorMessage(tag, errReadOnly)
+	case tcreate, twrite, twstat:
+		return errorMessage(tag, errReadOnly)
+	default:
+		return errorMessage(tag, fmt.Sprintf("unsupported message type %d", typ))
+	}
+}
+
+func (c *conn) fid(d *decoder) (*fid, string) {
+	fidNum := d.uint32()
+	if d.err != nil {
+		return nil, d.err.Error()
+	}
+	f, ok := c.fids[fidNum]
+	if !ok {
+		return nil, "unknown fid"
+	}
+	return f, ""
+}
+
+func (c *conn) walk(tag uint16, d *decoder) []byte {
+	f, errMsg := c.fid(d)
+	if f == nil {
+		return errorMessage(tag, errMsg)
+	}
+	newFidNum := d.uint32()
+	names := make([]string, d.uint16())
+	for i := range names {
+		names[i] = d.string()
+	}
+	if d.err != nil {
+		return errorMessage(tag, d.err.Error())
+	}
+	if f.open {
+		return errorMessage(tag, "cannot walk from an open fid")
+	}
+	if other, ok := c.fids[newFidNum]; ok && other != f {
+		return errorMessage(tag, "fid in use")
+	}
+	var qids []qid
+	cur := f
+	for _, name := range names {
+		next, err := c.s.lookup(cur, name)
+		if err != nil {
+			if len(qids) == 0 {
+				return errorMessage(tag, err.Error())
+			}
+			// A partial walk succeeds, but doesn't bind newfid.
+			break
+		}
+		qids = append(qids, next.qid())
+		cur = next
+	}
+	if len(qids) == len(names) {
+		clone := *cur
+		clone.open = false
+		clone.dirEntries = nil
+		c.fids[newFidNum] = &clone
+	}
+	e := newEncoder(rwalk, tag)
+	e.uint16(uint16(len(qids)))
+	for _, q := range qids {
+		e.qid(q)
+	}
+	return e.bytes()
+}
+
+func (c *conn) openFid(tag uint16, d *decoder) []byte {
+	f, errMsg := c.fid(d)
+	if f == nil {
+		return errorMessage(tag, errMsg)
+	}
+	mode := d.uint8()
+	if d.err != nil {
+		return errorMessage(tag, d.err.Error())
+	}
+	if mode&3 != oRead && mode&3 != oExec || mode&(oTrunc|oRClose) != 0 {
+		return errorMessage(tag, errReadOnly)
+	}
+	f.open = true
+	f.dirEntries = nil
+	e := newEncoder(ropen, tag)
+	e.qid(f.qid())
+	e.uint32(c.msize - ioHeaderSize)
+	return e.bytes()
+}
+
+func (c *conn) read(tag uint16, d *decoder) []byte {
+	f, errMsg := c.fid(d)
+	if f == nil {
+		return errorMessage(tag, errMsg)
+	}
+	offset := d.uint64()
+	count := d.uint32()
+	if d.err != nil {
+		return errorMessage(tag, d.err.Error())
+	}
+	if !f.open {
+		return errorMessage(tag, "fid not open")
+	}
+	if max := c.msize - ioHeaderSize; count > max {
+		count = max
+	}
+	var data []byte
+	if f.isDir {
+		if offset == 0 || f.dirEntries == nil {
+			entries, err := c.s.readDir(f)
+			if err != nil {
+				return errorMessage(tag, err.Error())
+			}
+			f.dirEntries = entries
+			f.dirIndex = 0
+			f.dirOffset = 0
+		}
+		if offset != f.dirOffset {
+			return errorMessage(tag, "bad offset in directory read")
+		}
+		// Directory reads return whole entries only.
+		for f.dirIndex < len(f.dirEntries) && len(data)+len(f.dirEntries[f.dirIndex]) <= int(count) {
+			data = append(data, f.dirEntries[f.dirIndex]...)
+			f.dirIndex++
+		}
+		f.dirOffset += uint64(len(data))
+	} else if offset < f.size && count > 0 {
+		if remaining := f.size - offset; uint64(count) > remaining {
+			count = uint32(remaining)
+		}
+		buf := &buffer{b: make([]byte, 0, count)}
+		if err := c.s.pachClient.GetFile(f.repo(), f.commitID, f.filePath(), int64(offset), int64(count), buf); err != nil {
+			return errorMessage(tag, err.Error())
+		}
+		data = buf.b
+	}
+	e := newEncoder(rread, tag)
+	e.uint32(uint32(len(data)))
+	e.buf = append(e.buf, data...)
+	return e.bytes()
+}
+
+// lookup returns a fid for the child of parent called name.
+func (s *Server) lookup(parent *fid, name string) (*fid, error) {
+	if name == ".." {
+		up := *parent
+		if len(up.path) > 0 {
+			up.path = up.path[:len(up.path)-1]
+		}
+		up.isDir = true
+		if len(up.path) < 2 {
+			up.commitID = ""
+		}
+		return &up, nil
+	}
+	if !parent.isDir {
+		return nil, errors.New("not a directory")
+	}
+	if name == "." {
+		return parent, nil
+	}
+	child := &fid{
+		path:     append(append([]string{}, parent.path...), name),
+		commitID: parent.commitID,
+		mtime:    parent.mtime,
+	}
+	switch len(parent.path) {
+	case 0:
+		if _, ok := s.exports[name]; !ok {
+			return nil, errors.New(errNotExist)
+		}
+		repoInfo, err := s.pachClient.InspectRepo(name)
+		if err != nil {
+			return nil, notExist(err)
+		}
+		child.isDir = true
+		child.mtime = timestamp(repoInfo.Created)
+	case 1:
+		if !s.exported(parent.repo(), name) {
+			return nil, errors.New(errNotExist)
+		}
+		commitInfo, err := s.pachClient.InspectCommit(parent.repo(), name)
+		if err != nil {
+			return nil, notExist(err)
+		}
+		child.isDir = true
+		child.commitID = commitInfo.Commit.ID
+		child.mtime = commitTime(commitInfo)
+	default:
+		fileInfo, err := s.pachClient.InspectFile(child.repo(), child.commitID, child.filePath())
+		if err != nil {
+			return nil, notExist(err)
+		}
+		child.isDir = fileInfo.FileType == pfs.FileType_DIR
+		child.size = fileInfo.SizeBytes
+	}
+	return child, nil
+}
+
+// readDir returns the marshalled stats of the children of f.
+func (s *Server) readDir(f *fid) ([][]byte, error) {
+	var children []*fid
+	switch len(f.path) {
+	case 0:
+		for repo := range s.exports {
+			child, err := s.lookup(f, repo)
+			if err != nil {
+				// The repo may have been deleted since we started.
+				continue
+			}
+			children = append(children, child)
+		}
+	case 1:
+		branches, err := s.pachClient.ListBranch(f.repo())
+		if err != nil {
+			return nil, err
+		}
+		for _, branch := range branches {
+			if !s.exported(f.repo(), branch.Name) {
+				continue
+			}
+			child, err := s.lookup(f, branch.Name)
+			if err != nil {
+				continue
+			}
+			children = append(children, child)
+		}
+	default:
+		fileInfos, err := s.pachClient.ListFile(f.repo(), f.commitID, f.filePath())
+		if err != nil {
+			return nil, err
+		}
+		for _, fileInfo := range fileInfos {
+			children = append(children, &fid{
+				path:     append(append([]string{}, f.path...), path.Base(fileInfo.File.Path)),
+				commitID: f.commitID,
+				mtime:    f.mtime,
+				isDir:    fileInfo.FileType == pfs.FileType_DIR,
+				size:     fileInfo.SizeBytes,
+			})
+		}
+	}
+	sort.Slice(children, func(i, j int) bool {
+		return children[i].path[len(children[i].path)-1] < children[j].path[len(children[j].path)-1]
+	})
+	var entries [][]byte
+	for _, child := range children {
+		entries = append(entries, marshalStat(child.stat()))
+	}
+	return entries, nil
+}
+
+func (s *Server) exported(repo string, branch string) bool {
+	branches, ok := s.exports[repo]
+	return ok && (branches == nil || branches[branch])
+}
+
+func errorMessage(tag uint16, msg string) []byte {
+	e := newEncoder(rerror, tag)
+	e.string(msg)
+	return e.bytes()
+}
+
+func notExist(err error) error {
+	if strings.Contains(err.Error(), "not found") {
+		return errors.New(errNotExist)
+	}
+	return err
+}
+
+func hash(s string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(s))
+	return h.Sum64()
+}
+
+func timestamp(t *types.Timestamp) uint32 {
+	if t == nil {
+		return 0
+	}
+	goTime, err := types.TimestampFromProto(t)
+	if err != nil {
+		return 0
+	}
+	return uint32(goTime.Unix())
+}
+
+func commitTime(commitInfo *pfs.CommitInfo) uint32 {
+	if commitInfo.Finished != nil {
+		return timestamp(commitInfo.Finished)
+	}
+	if commitInfo.Started != nil {
+		return timestamp(commitInfo.Started)
+	}
+	return uint32(time.Now().Unix())
+}
+
+// buffer is an io.Writer that appends to b.
+type buffer struct {
+	b []byte
+}
+
+func (b *buffer) Write(p []byte) (int, error) {
+	b.b = append(b.b, p...)
+	return len(p), nil
+}
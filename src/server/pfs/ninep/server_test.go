@@ -0,0 +1,360 @@
+package ninep
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"net"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pkg/uuid"
+	"github.com/pachyderm/pachyderm/src/client/version"
+	"github.com/pachyderm/pachyderm/src/server/pfs/server"
+	"google.golang.org/grpc"
+)
+
+const (
+	// testMsize is small enough that files span several reads.
+	testMsize = 8192
+	testFid   = 1
+)
+
+var port int32 = 31651
+
+// getPachClient runs PFS against a local block store and the etcd at
+// localhost:32379, and returns a client for it.
+func getPachClient(t *testing.T) *client.APIClient {
+	root, err := ioutil.TempDir("", "pachyderm-ninep-test-")
+	require.NoError(t, err)
+	port := atomic.AddInt32(&port, 1)
+	address := fmt.Sprintf("localhost:%d", port)
+	blockAPIServer, err := server.NewLocalBlockAPIServer(root)
+	require.NoError(t, err)
+	apiServer, err := server.NewAPIServer(address, []string{"localhost:32379"}, uuid.NewWithoutDashes(), "", 1<<20, server.DefaultBlockSize, nil, 0)
+	require.NoError(t, err)
+	ready := make(chan bool)
+	go func() {
+		err := grpcutil.Serve(
+			func(s *grpc.Server) {
+				pfs.RegisterAPIServer(s, apiServer)
+				pfs.RegisterObjectAPIServer(s, blockAPIServer)
+				close(ready)
+			},
+			grpcutil.ServeOptions{
+				Version:    version.Version,
+				MaxMsgSize: grpcutil.MaxMsgSize,
+			},
+			grpcutil.ServeEnv{GRPCPort: uint16(port)},
+		)
+		require.NoError(t, err)
+	}()
+	<-ready
+	c, err := client.NewFromAddress(address)
+	require.NoError(t, err)
+	return c
+}
+
+// testClient is a minimal 9P client.
+type testClient struct {
+	t    *testing.T
+	conn net.Conn
+	r    *bufio.Reader
+	tag  uint16
+}
+
+func newTestClient(t *testing.T, s *Server) *testClient {
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	go s.Serve(listener)
+	conn, err := net.Dial("tcp", listener.Addr().String())
+	require.NoError(t, err)
+	c := &testClient{t: t, conn: conn, r: bufio.NewReader(conn)}
+
+	d := c.call(tversion, func(e *encoder) {
+		e.uint32(testMsize)
+		e.string("9P2000.L")
+	})
+	require.Equal(t, uint32(testMsize), d.uint32())
+	require.Equal(t, version9P, d.string())
+	d = c.call(tattach, func(e *encoder) {
+		e.uint32(testFid)
+		e.uint32(noFid)
+		e.string("user")
+		e.string("")
+	})
+	require.Equal(t, uint8(qtDir), decodeQid(d).typ)
+	return c
+}
+
+// rpc sends a request and returns the type and body of the response.
+func (c *testClient) rpc(typ uint8, fields func(e *encoder)) (uint8, *decoder) {
+	c.tag++
+	e := newEncoder(typ, c.tag)
+	if fields != nil {
+		fields(e)
+	}
+	_, err := c.conn.Write(e.bytes())
+	require.NoError(c.t, err)
+	respTyp, tag, body, err := readMessage(c.r, testMsize)
+	require.NoError(c.t, err)
+	require.Equal(c.t, c.tag, tag)
+	return respTyp, &decoder{buf: body}
+}
+
+// call makes a request that should succeed.
+func (c *testClient) call(typ uint8, fields func(e *encoder)) *decoder {
+	respTyp, d := c.rpc(typ, fields)
+	if respTyp == rerror {
+		c.t.Fatalf("request %d failed: %s", typ, d.string())
+	}
+	require.Equal(c.t, typ+1, respTyp)
+	return d
+}
+
+// callError makes a request that should fail, and returns its error.
+func (c *testClient) callError(typ uint8, fields func(e *encoder)) string {
+	respTyp, d := c.rpc(typ, fields)
+	require.Equal(c.t, uint8(rerror), respTyp)
+	return d.string()
+}
+
+func (c *testClient) walk(fid uint32, newFid uint32, names ...string) []qid {
+	d := c.call(twalk, func(e *encoder) {
+		e.uint32(fid)
+		e.uint32(newFid)
+		e.uint16(uint16(len(names)))
+		for _, name := range names {
+			e.string(name)
+		}
+	})
+	qids := make([]qid, d.uint16())
+	for i := range qids {
+		qids[i] = decodeQid(d)
+	}
+	require.NoError(c.t, d.err)
+	return qids
+}
+
+func (c *testClient) walkError(fid uint32, newFid uint32, names ...string) string {
+	return c.callError(twalk, func(e *encoder) {
+		e.uint32(fid)
+		e.uint32(newFid)
+		e.uint16(uint16(len(names)))
+		for _, name := range names {
+			e.string(name)
+		}
+	})
+}
+
+// walkOne walks from fid to names, using a fid that isn't in use, which it
+// returns.
+func (c *testClient) walkOne(fid uint32, names ...string) uint32 {
+	newFid := uint32(100) + uint32(c.tag)
+	c.walk(fid, newFid, names...)
+	return newFid
+}
+
+// open opens fid for reading and returns its iounit.
+func (c *testClient) open(fid uint32) uint32 {
+	d := c.call(topen, func(e *encoder) {
+		e.uint32(fid)
+		e.uint8(oRead)
+	})
+	decodeQid(d)
+	return d.uint32()
+}
+
+func (c *testClient) read(fid uint32, offset uint64, count uint32) []byte {
+	d := c.call(tread, func(e *encoder) {
+		e.uint32(fid)
+		e.uint64(offset)
+		e.uint32(count)
+	})
+	data := d.next(int(d.uint32()))
+	require.NoError(c.t, d.err)
+	return data
+}
+
+// readAll reads fid, which must be open, until EOF.
+func (c *testClient) readAll(fid uint32, iounit uint32) []byte {
+	var data []byte
+	for {
+		chunk := c.read(fid, uint64(len(data)), iounit)
+		if len(chunk) == 0 {
+			return data
+		}
+		data = append(data, chunk...)
+	}
+}
+
+func (c *testClient) readDir(fid uint32) []*stat {
+	iounit := c.open(fid)
+	d := &decoder{buf: c.readAll(fid, iounit)}
+	var stats []*stat
+	for len(d.buf) > 0 && d.err == nil {
+		stats = append(stats, unmarshalStat(d))
+	}
+	require.NoError(c.t, d.err)
+	return stats
+}
+
+func (c *testClient) stat(fid uint32) *stat {
+	d := c.call(tstat, func(e *encoder) {
+		e.uint32(fid)
+	})
+	d.uint16() // size of the stat
+	s := unmarshalStat(d)
+	require.NoError(c.t, d.err)
+	return s
+}
+
+func (c *testClient) clunk(fid uint32) {
+	c.call(tclunk, func(e *encoder) {
+		e.uint32(fid)
+	})
+}
+
+func names(stats []*stat) []string {
+	var result []string
+	for _, s := range stats {
+		result = append(result, s.name)
+	}
+	return result
+}
+
+func putFiles(t *testing.T, c *client.APIClient, repo string, branch string, files map[string]string) {
+	commit, err := c.StartCommit(repo, branch)
+	require.NoError(t, err)
+	for path, content := range files {
+		_, err := c.PutFile(repo, commit.ID, path, strings.NewReader(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, c.FinishCommit(repo, commit.ID))
+}
+
+func TestWalkAndRead(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipped because of short mode")
+	}
+	pachClient := getPachClient(t)
+	require.NoError(t, pachClient.CreateRepo("images"))
+	require.NoError(t, pachClient.CreateRepo("other"))
+	big := make([]byte, 3*testMsize)
+	rand.New(rand.NewSource(0)).Read(big)
+	putFiles(t, pachClient, "images", "master", map[string]string{
+		"a.txt":     "hello\n",
+		"dir/b.bin": string(big),
+	})
+	putFiles(t, pachClient, "images", "dev", map[string]string{"dev.txt": "dev\n"})
+	putFiles(t, pachClient, "other", "master", map[string]string{"a.txt": "other\n"})
+
+	s, err := NewServer(pachClient, []string{"images/master"})
+	require.NoError(t, err)
+	c := newTestClient(t, s)
+
+	// Only the exported repo and branch are listed.
+	require.Equal(t, []string{"images"}, names(c.readDir(c.walkOne(testFid))))
+	require.Equal(t, []string{"master"}, names(c.readDir(c.walkOne(testFid, "images"))))
+	entries := c.readDir(c.walkOne(testFid, "images", "master"))
+	require.Equal(t, []string{"a.txt", "dir"}, names(entries))
+	require.Equal(t, uint64(6), entries[0].length)
+	require.Equal(t, uint32(0444), entries[0].mode)
+	require.Equal(t, uint32(dmDir|0555), entries[1].mode)
+
+	qids := c.walk(testFid, 10, "images", "master", "a.txt")
+	require.Equal(t, 3, len(qids))
+	require.Equal(t, uint8(qtDir), qids[1].typ)
+	require.Equal(t, uint8(qtFile), qids[2].typ)
+	require.Equal(t, uint64(6), c.stat(10).length)
+	iounit := c.open(10)
+	require.Equal(t, uint32(testMsize-ioHeaderSize), iounit)
+	require.Equal(t, "hello\n", string(c.readAll(10, iounit)))
+	require.Equal(t, "llo", string(c.read(10, 2, 3)))
+
+	// A file bigger than a message is read in several.
+	c.walk(testFid, 11, "images", "master", "dir", "b.bin")
+	require.Equal(t, uint64(len(big)), c.stat(11).length)
+	require.True(t, bytes.Equal(big, c.readAll(11, c.open(11))))
+	c.clunk(11)
+
+	// ".." walks back up.
+	qids = c.walk(testFid, 12, "images", "master", "dir", "..", "a.txt")
+	require.Equal(t, 5, len(qids))
+	require.Equal(t, "a.txt", c.stat(12).name)
+	c.clunk(12)
+
+	require.Equal(t, errNotExist, c.walkError(testFid, 13, "other"))
+	require.Equal(t, errNotExist, c.walkError(c.walkOne(testFid, "images"), 13, "dev"))
+	// A partial walk returns the qids that it walked, but doesn't bind
+	// newfid.
+	require.Equal(t, 1, len(c.walk(testFid, 13, "images", "dev")))
+	require.Equal(t, 2, len(c.walk(testFid, 13, "images", "master", "missing")))
+	require.Equal(t, "unknown fid", c.callError(tstat, func(e *encoder) { e.uint32(13) }))
+
+	// A fid that's open keeps reading the commit that it was walked in,
+	// while new walks see the branch's new head.
+	commit, err := pachClient.StartCommit("images", "master")
+	require.NoError(t, err)
+	require.NoError(t, pachClient.DeleteFile("images", commit.ID, "a.txt"))
+	_, err = pachClient.PutFile("images", commit.ID, "a.txt", strings.NewReader("goodbye\n"))
+	require.NoError(t, err)
+	require.NoError(t, pachClient.FinishCommit("images", commit.ID))
+	require.Equal(t, "hello\n", string(c.readAll(10, iounit)))
+	c.walk(testFid, 14, "images", "master", "a.txt")
+	require.Equal(t, "goodbye\n", string(c.readAll(14, c.open(14))))
+}
+
+func TestReadOnly(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipped because of short mode")
+	}
+	pachClient := getPachClient(t)
+	require.NoError(t, pachClient.CreateRepo("repo"))
+	putFiles(t, pachClient, "repo", "master", map[string]string{"file": "data"})
+	s, err := NewServer(pachClient, []string{"repo"})
+	require.NoError(t, err)
+	c := newTestClient(t, s)
+
+	c.walk(testFid, 2, "repo", "master", "file")
+	for _, mode := range []uint8{1, 2, oRead | oTrunc, oRead | oRClose} {
+		require.Equal(t, errReadOnly, c.callError(topen, func(e *encoder) {
+			e.uint32(2)
+			e.uint8(mode)
+		}))
+	}
+	require.Equal(t, errReadOnly, c.callError(twrite, func(e *encoder) {
+		e.uint32(2)
+		e.uint64(0)
+		e.uint32(4)
+		e.buf = append(e.buf, "new!"...)
+	}))
+	require.Equal(t, errReadOnly, c.callError(twstat, func(e *encoder) {
+		e.uint32(2)
+	}))
+	c.walk(testFid, 3, "repo", "master")
+	require.Equal(t, errReadOnly, c.callError(tcreate, func(e *encoder) {
+		e.uint32(3)
+		e.string("new")
+		e.uint32(0644)
+		e.uint8(1)
+	}))
+	// Tremove fails, but clunks the fid.
+	require.Equal(t, errReadOnly, c.callError(tremove, func(e *encoder) {
+		e.uint32(2)
+	}))
+	require.Equal(t, "unknown fid", c.callError(tclunk, func(e *encoder) {
+		e.uint32(2)
+	}))
+
+	// The file is unchanged.
+	c.walk(testFid, 4, "repo", "master", "file")
+	require.Equal(t, "data", string(c.readAll(4, c.open(4))))
+}
@@ -0,0 +1,66 @@
+package pfs
+
+import (
+	"sync"
+
+	"github.com/pachyderm/pachyderm/src/client/limit"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+
+	"golang.org/x/net/context"
+	"golang.org/x/sync/errgroup"
+)
+
+// inspectObjectsBatchSize is the number of objects that DeltaBases
+// inspects in each InspectObjects call.
+const inspectObjectsBatchSize = 1000
+
+// DeltaBases returns the objects that objects are stored as deltas against,
+// directly or through other deltas. They can't be deleted for as long as
+// objects aren't, since reading objects requires them, so everything that
+// decides which objects are still referenced counts them too. The objects in
+// objects aren't included, and objects that don't exist are skipped.
+func DeltaBases(ctx context.Context, objClient pfs.ObjectAPIClient, objects []*pfs.Object) ([]*pfs.Object, error) {
+	seen := make(map[string]bool)
+	for _, object := range objects {
+		seen[object.Hash] = true
+	}
+	var bases []*pfs.Object
+	limiter := limit.New(10)
+	for len(objects) > 0 {
+		var mu sync.Mutex
+		var next []*pfs.Object
+		var eg errgroup.Group
+		for len(objects) > 0 {
+			n := len(objects)
+			if n > inspectObjectsBatchSize {
+				n = inspectObjectsBatchSize
+			}
+			batch := objects[:n]
+			objects = objects[n:]
+			limiter.Acquire()
+			eg.Go(func() error {
+				defer limiter.Release()
+				resp, err := objClient.InspectObjects(ctx, &pfs.InspectObjectsRequest{Objects: batch})
+				if err != nil {
+					return err
+				}
+				mu.Lock()
+				defer mu.Unlock()
+				for _, objectInfo := range resp.ObjectInfo {
+					base := objectInfo.BlockRef.GetDeltaBase()
+					if base != nil && !seen[base.Hash] {
+						seen[base.Hash] = true
+						next = append(next, base)
+					}
+				}
+				return nil
+			})
+		}
+		if err := eg.Wait(); err != nil {
+			return nil, err
+		}
+		bases = append(bases, next...)
+		objects = next
+	}
+	return bases, nil
+}
@@ -0,0 +1,86 @@
+package pfs
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// fakeObjectAPIClient serves InspectObjects from a map of objects to the
+// objects that they're stored as deltas against, "" for full objects.
+type fakeObjectAPIClient struct {
+	pfs.ObjectAPIClient
+	bases map[string]string
+	mu    sync.Mutex
+	calls int
+}
+
+func (c *fakeObjectAPIClient) InspectObjects(ctx context.Context, request *pfs.InspectObjectsRequest, opts ...grpc.CallOption) (*pfs.InspectObjectsResponse, error) {
+	c.mu.Lock()
+	c.calls++
+	c.mu.Unlock()
+	if len(request.Objects) > inspectObjectsBatchSize {
+		return nil, fmt.Errorf("too many objects: %d", len(request.Objects))
+	}
+	response := &pfs.InspectObjectsResponse{}
+	for _, object := range request.Objects {
+		base, ok := c.bases[object.Hash]
+		if !ok {
+			continue
+		}
+		blockRef := &pfs.BlockRef{}
+		if base != "" {
+			blockRef.DeltaBase = &pfs.Object{Hash: base}
+		}
+		response.ObjectInfo = append(response.ObjectInfo, &pfs.ObjectInfo{Object: object, BlockRef: blockRef})
+	}
+	return response, nil
+}
+
+func hashes(objects []*pfs.Object) map[string]bool {
+	result := make(map[string]bool)
+	for _, object := range objects {
+		result[object.Hash] = true
+	}
+	return result
+}
+
+func TestDeltaBases(t *testing.T) {
+	c := &fakeObjectAPIClient{bases: map[string]string{
+		"a": "b",
+		"b": "c",
+		"c": "",
+		"d": "c",
+		"e": "",
+	}}
+	bases, err := DeltaBases(context.Background(), c, []*pfs.Object{{Hash: "a"}, {Hash: "d"}, {Hash: "e"}, {Hash: "missing"}})
+	require.NoError(t, err)
+	require.Equal(t, map[string]bool{"b": true, "c": true}, hashes(bases))
+	require.Equal(t, len(bases), len(hashes(bases)))
+
+	// Bases that are in objects themselves aren't returned.
+	bases, err = DeltaBases(context.Background(), c, []*pfs.Object{{Hash: "a"}, {Hash: "b"}})
+	require.NoError(t, err)
+	require.Equal(t, map[string]bool{"c": true}, hashes(bases))
+}
+
+func TestDeltaBasesBatches(t *testing.T) {
+	c := &fakeObjectAPIClient{bases: map[string]string{"base": ""}}
+	var objects []*pfs.Object
+	for i := 0; i < 2*inspectObjectsBatchSize+1; i++ {
+		hash := fmt.Sprintf("object-%d", i)
+		c.bases[hash] = "base"
+		objects = append(objects, &pfs.Object{Hash: hash})
+	}
+	bases, err := DeltaBases(context.Background(), c, objects)
+	require.NoError(t, err)
+	require.Equal(t, map[string]bool{"base": true}, hashes(bases))
+	// Three batches of objects, then one for their base.
+	require.Equal(t, 4, c.calls)
+}
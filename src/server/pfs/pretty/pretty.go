@@ -5,15 +5,31 @@ import (
 	"html/template"
 	"io"
 	"os"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/docker/go-units"
+	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/server/pkg/pretty"
 )
 
+// PrintProjectHeader prints a project header.
+func PrintProjectHeader(w io.Writer) {
+	fmt.Fprint(w, "NAME\tCREATED\tDESCRIPTION\t\n")
+}
+
+// PrintProjectInfo pretty-prints project info.
+func PrintProjectInfo(w io.Writer, projectInfo *pfs.ProjectInfo) {
+	fmt.Fprintf(w, "%s\t", projectInfo.Project.Name)
+	fmt.Fprintf(w, "%s\t", pretty.Ago(projectInfo.Created))
+	fmt.Fprintf(w, "%s\t\n", projectInfo.Description)
+}
+
 // PrintRepoHeader prints a repo header.
 func PrintRepoHeader(w io.Writer) {
-	fmt.Fprint(w, "NAME\tCREATED\tSIZE\t\n")
+	fmt.Fprint(w, "NAME\tCREATED\tSIZE\tLABELS\tDESCRIPTION\t\n")
 }
 
 // PrintRepoInfo pretty-prints repo info.
@@ -24,7 +40,9 @@ func PrintRepoInfo(w io.Writer, repoInfo *pfs.RepoInfo) {
 		"%s\t",
 		pretty.Ago(repoInfo.Created),
 	)
-	fmt.Fprintf(w, "%s\t\n", units.BytesSize(float64(repoInfo.SizeBytes)))
+	fmt.Fprintf(w, "%s\t", units.BytesSize(float64(repoInfo.SizeBytes)))
+	fmt.Fprintf(w, "%s\t", labels(repoInfo.Labels))
+	fmt.Fprintf(w, "%s\t\n", repoInfo.Description)
 }
 
 // PrintDetailedRepoInfo pretty-prints detailed repo info.
@@ -33,7 +51,9 @@ func PrintDetailedRepoInfo(repoInfo *pfs.RepoInfo) error {
 		`Name: {{.Repo.Name}}{{if .Description}}
 Description: {{.Description}}{{end}}
 Created: {{prettyAgo .Created}}
-Size: {{prettySize .SizeBytes}}{{if .Provenance}}
+Size: {{prettySize .SizeBytes}}{{if .Retention}}
+Retention: {{retention .Retention}}{{end}}{{if .Labels}}
+Labels: {{labels .Labels}}{{end}}{{if .Provenance}}
 Provenance: {{range .Provenance}} {{.Name}} {{end}} {{end}}
 `)
 	if err != nil {
@@ -57,31 +77,71 @@ func PrintBranch(w io.Writer, branch *pfs.Branch) {
 	fmt.Fprintf(w, "%s\t\n", branch.Head.ID)
 }
 
+var commitInfoColumns = []pretty.Column{
+	{Name: "repo", Value: func(row interface{}) string {
+		return row.(*pfs.CommitInfo).Commit.Repo.Name
+	}},
+	{Name: "id", Value: func(row interface{}) string {
+		return row.(*pfs.CommitInfo).Commit.ID
+	}},
+	{Name: "parent", Value: func(row interface{}) string {
+		commitInfo := row.(*pfs.CommitInfo)
+		if commitInfo.ParentCommit == nil {
+			return "<none>"
+		}
+		return commitInfo.ParentCommit.ID
+	}},
+	{Name: "started", Value: func(row interface{}) string {
+		return pretty.Ago(row.(*pfs.CommitInfo).Started)
+	}},
+	{Name: "finished", Value: func(row interface{}) string {
+		commitInfo := row.(*pfs.CommitInfo)
+		if commitInfo.Finished == nil {
+			return "-"
+		}
+		return pretty.Ago(commitInfo.Finished)
+	}},
+	{Name: "duration", Value: func(row interface{}) string {
+		commitInfo := row.(*pfs.CommitInfo)
+		if commitInfo.Finished == nil {
+			return "-"
+		}
+		return pretty.Duration(commitInfo.Started, commitInfo.Finished)
+	}},
+	{Name: "size", Value: func(row interface{}) string {
+		return units.BytesSize(float64(row.(*pfs.CommitInfo).SizeBytes))
+	}},
+	{Name: "provenance", Value: func(row interface{}) string {
+		var provenance []string
+		for _, commit := range row.(*pfs.CommitInfo).Provenance {
+			provenance = append(provenance, fmt.Sprintf("%s/%s", commit.Repo.Name, commit.ID))
+		}
+		if len(provenance) == 0 {
+			return "-"
+		}
+		return strings.Join(provenance, ",")
+	}},
+	{Name: "description", Value: func(row interface{}) string {
+		return row.(*pfs.CommitInfo).Description
+	}},
+}
+
+var defaultCommitInfoColumns = []string{"repo", "id", "parent", "started", "duration", "size"}
+
+// CommitInfoColumns returns the columns list-commit prints, given the values
+// of its --columns and --output flags.
+func CommitInfoColumns(names string, output string) ([]pretty.Column, error) {
+	return pretty.SelectColumns(commitInfoColumns, defaultCommitInfoColumns, names, output)
+}
+
 // PrintCommitInfoHeader prints a commit info header.
-func PrintCommitInfoHeader(w io.Writer) {
-	fmt.Fprint(w, "REPO\tID\tPARENT\tSTARTED\tDURATION\tSIZE\t\n")
+func PrintCommitInfoHeader(w io.Writer, columns []pretty.Column) {
+	pretty.PrintHeader(w, columns)
 }
 
 // PrintCommitInfo pretty-prints commit info.
-func PrintCommitInfo(w io.Writer, commitInfo *pfs.CommitInfo) {
-	fmt.Fprintf(w, "%s\t", commitInfo.Commit.Repo.Name)
-	fmt.Fprintf(w, "%s\t", commitInfo.Commit.ID)
-	if commitInfo.ParentCommit != nil {
-		fmt.Fprintf(w, "%s\t", commitInfo.ParentCommit.ID)
-	} else {
-		fmt.Fprint(w, "<none>\t")
-	}
-	fmt.Fprintf(
-		w,
-		"%s\t",
-		pretty.Ago(commitInfo.Started),
-	)
-	duration := "-\t"
-	if commitInfo.Finished != nil {
-		duration = fmt.Sprintf("%s\t", pretty.Duration(commitInfo.Started, commitInfo.Finished))
-	}
-	fmt.Fprintf(w, duration)
-	fmt.Fprintf(w, "%s\t\n", units.BytesSize(float64(commitInfo.SizeBytes)))
+func PrintCommitInfo(w io.Writer, commitInfo *pfs.CommitInfo, columns []pretty.Column) {
+	pretty.PrintRow(w, columns, commitInfo)
 }
 
 // PrintDetailedCommitInfo pretty-prints detailed commit info.
@@ -91,7 +151,9 @@ func PrintDetailedCommitInfo(commitInfo *pfs.CommitInfo) error {
 Parent: {{.ParentCommit.ID}} {{end}}
 Started: {{prettyAgo .Started}}{{if .Finished}}
 Finished: {{prettyAgo .Finished}} {{end}}
-Size: {{prettySize .SizeBytes}}{{if .Provenance}}
+Size: {{prettySize .SizeBytes}}{{if .Description}}
+Description: {{.Description}}{{end}}{{if .Metadata}}
+Metadata: {{labels .Metadata}}{{end}}{{if .Provenance}}
 Provenance: {{range .Provenance}} {{.Repo.Name}}/{{.ID}} {{end}} {{end}}
 `)
 	if err != nil {
@@ -122,6 +184,64 @@ func PrintFileInfo(w io.Writer, fileInfo *pfs.FileInfo) {
 	fmt.Fprintf(w, "%s\t\n", units.BytesSize(float64(fileInfo.SizeBytes)))
 }
 
+// PrintDiffFileHeader prints a file diff header.
+func PrintDiffFileHeader(w io.Writer) {
+	fmt.Fprint(w, "STATUS\tNAME\tTYPE\tOLD SIZE\tNEW SIZE\tDELTA\t\n")
+}
+
+// PrintDiffFile pretty-prints a file that differs between two trees, as
+// returned by DiffFileF. newFile is nil if the file was removed, and oldFile
+// is nil if it was added.
+func PrintDiffFile(w io.Writer, newFile *pfs.FileInfo, oldFile *pfs.FileInfo) {
+	var newSize, oldSize int64
+	fileInfo := newFile
+	switch {
+	case oldFile == nil:
+		fmt.Fprint(w, "added\t")
+		newSize = int64(newFile.SizeBytes)
+	case newFile == nil:
+		fmt.Fprint(w, "removed\t")
+		oldSize = int64(oldFile.SizeBytes)
+		fileInfo = oldFile
+	default:
+		fmt.Fprint(w, "modified\t")
+		newSize = int64(newFile.SizeBytes)
+		oldSize = int64(oldFile.SizeBytes)
+	}
+	fmt.Fprintf(w, "%s\t", fileInfo.File.Path)
+	fmt.Fprintf(w, "%s\t", fileType(fileInfo.FileType))
+	if oldFile == nil {
+		fmt.Fprint(w, "-\t")
+	} else {
+		fmt.Fprintf(w, "%s\t", units.BytesSize(float64(oldSize)))
+	}
+	if newFile == nil {
+		fmt.Fprint(w, "-\t")
+	} else {
+		fmt.Fprintf(w, "%s\t", units.BytesSize(float64(newSize)))
+	}
+	delta := newSize - oldSize
+	if delta < 0 {
+		fmt.Fprintf(w, "-%s\t\n", units.BytesSize(float64(-delta)))
+	} else {
+		fmt.Fprintf(w, "+%s\t\n", units.BytesSize(float64(delta)))
+	}
+}
+
+// PrintLeaseHeader prints a lease header.
+func PrintLeaseHeader(w io.Writer) {
+	fmt.Fprint(w, "ID\tPATH\tOWNER\tEXPIRES\t\n")
+}
+
+// PrintLease pretty-prints a lease.
+func PrintLease(w io.Writer, lease *pfs.Lease) {
+	fmt.Fprintf(w, "%s\t", lease.ID)
+	fmt.Fprintf(w, "%s\t", lease.File.Path)
+	fmt.Fprintf(w, "%s\t", lease.Owner)
+	expires, _ := types.TimestampFromProto(lease.Expires)
+	fmt.Fprintf(w, "in %s\t\n", units.HumanDuration(expires.Sub(time.Now())))
+}
+
 // PrintDetailedFileInfo pretty-prints detailed file info.
 func PrintDetailedFileInfo(fileInfo *pfs.FileInfo) error {
 	template, err := template.New("FileInfo").Funcs(funcMap).Parse(
@@ -152,8 +272,28 @@ func fileType(fileType pfs.FileType) string {
 	return "dir"
 }
 
+func retention(retention *types.Duration) string {
+	duration, err := types.DurationFromProto(retention)
+	if err != nil {
+		return err.Error()
+	}
+	return duration.String()
+}
+
+// labels formats labels as a sorted, comma-separated list of key=value.
+func labels(labels map[string]string) string {
+	var pairs []string
+	for key, value := range labels {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
 var funcMap = template.FuncMap{
 	"prettyAgo":  pretty.Ago,
 	"prettySize": pretty.Size,
 	"fileType":   fileType,
+	"retention":  retention,
+	"labels":     labels,
 }
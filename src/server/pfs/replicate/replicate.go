@@ -0,0 +1,143 @@
+// Package replicate mirrors a branch of a repo from one Pachyderm cluster to
+// another.
+package replicate
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
+)
+
+// Replicate continuously mirrors the commits on repo/branch in src to the
+// same repo/branch in dst. Commits are replicated one at a time, oldest
+// first, as soon as they're finished in src, and keep their IDs in dst. The
+// content of each commit is copied object by object, objects that dst
+// already has aren't copied again.
+//
+// Replication picks up from the head of the branch in dst, so it can be
+// stopped and restarted at any time. Commit provenance isn't replicated,
+// replicated commits are plain data in dst.
+//
+// onCommit, if non-nil, is called after each commit is replicated.
+// Replicate only returns when it encounters an error.
+func Replicate(src *client.APIClient, dst *client.APIClient, repo string, branch string, onCommit func(*pfs.Commit)) error {
+	if _, err := dst.InspectRepo(repo); err != nil {
+		if !isNotFoundErr(err) {
+			return err
+		}
+		if err := dst.CreateRepo(repo); err != nil {
+			return err
+		}
+	}
+	var from string
+	head, err := dst.InspectCommit(repo, branch)
+	if err != nil {
+		if !isNotFoundErr(err) {
+			return err
+		}
+	} else {
+		if _, err := src.InspectCommit(repo, head.Commit.ID); err != nil {
+			return fmt.Errorf("head of %s/%s in the destination (%s) isn't in the source: %v", repo, branch, head.Commit.ID, err)
+		}
+		from = head.Commit.ID
+	}
+	commitInfos, err := src.SubscribeCommit(repo, branch, from)
+	if err != nil {
+		return err
+	}
+	defer commitInfos.Close()
+	for {
+		commitInfo, err := commitInfos.Next()
+		if err != nil {
+			return err
+		}
+		if err := replicateCommit(src, dst, branch, commitInfo); err != nil {
+			return fmt.Errorf("error replicating commit %s: %v", commitInfo.Commit.ID, err)
+		}
+		if onCommit != nil {
+			onCommit(commitInfo.Commit)
+		}
+	}
+}
+
+// replicateCommit copies the objects that commitInfo references to dst and
+// then builds the commit in dst on top of its parent.
+func replicateCommit(src *client.APIClient, dst *client.APIClient, branch string, commitInfo *pfs.CommitInfo) error {
+	repo := commitInfo.Commit.Repo.Name
+	if _, err := dst.InspectCommit(repo, commitInfo.Commit.ID); err == nil {
+		// Already replicated, we might have been stopped before we could
+		// move the branch.
+		return dst.SetBranch(repo, commitInfo.Commit.ID, branch)
+	}
+	var tree string
+	if commitInfo.Tree != nil {
+		tree = commitInfo.Tree.Hash
+		treeBytes, err := src.ReadObject(tree)
+		if err != nil {
+			return err
+		}
+		hashTree, err := hashtree.Deserialize(treeBytes)
+		if err != nil {
+			return err
+		}
+		if err := hashTree.Walk(func(path string, node *hashtree.NodeProto) error {
+			if node.FileNode == nil {
+				return nil
+			}
+			for _, object := range node.FileNode.Objects {
+				if err := copyObject(src, dst, object.Hash); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		// The tree goes last, so that a commit is never built on a tree
+		// whose content is missing.
+		if err := copyObject(src, dst, tree); err != nil {
+			return err
+		}
+	}
+	var parent string
+	if commitInfo.ParentCommit != nil {
+		parent = commitInfo.ParentCommit.ID
+	}
+	commit, err := dst.BuildCommit(repo, branch, parent, tree, commitInfo.Commit.ID)
+	if err != nil {
+		return err
+	}
+	// BuildCommit leaves commits without a tree open.
+	if tree == "" {
+		return dst.FinishCommit(repo, commit.ID)
+	}
+	return nil
+}
+
+// copyObject streams an object from src to dst, unless dst already has it.
+func copyObject(src *client.APIClient, dst *client.APIClient, hash string) error {
+	if _, err := dst.InspectObject(hash); err == nil {
+		return nil
+	}
+	r, w := io.Pipe()
+	go func() {
+		w.CloseWithError(src.GetObject(hash, w))
+	}()
+	object, _, err := dst.PutObject(r)
+	if err != nil {
+		r.CloseWithError(err)
+		return err
+	}
+	if object.Hash != hash {
+		return fmt.Errorf("object %s was copied as %s", hash, object.Hash)
+	}
+	return nil
+}
+
+func isNotFoundErr(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "not found")
+}
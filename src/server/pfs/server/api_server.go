@@ -2,10 +2,15 @@ package server
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"hash"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"net/url"
+	"os"
 	"path"
 	"path/filepath"
 	"strconv"
@@ -16,7 +21,9 @@ import (
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/pkg/shard"
 	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
+	"github.com/pachyderm/pachyderm/src/server/pkg/sqlquery"
 
 	protolion "go.pedge.io/lion/proto"
 	"go.pedge.io/proto/rpclog"
@@ -24,6 +31,7 @@ import (
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
+	kube_labels "k8s.io/kubernetes/pkg/labels"
 )
 
 var (
@@ -38,6 +46,9 @@ const (
 type apiServer struct {
 	protorpclog.Logger
 	driver *driver
+	// shards, if it's set, forwards metadata-heavy requests to the pachd
+	// that's responsible for their repo.
+	shards *metadataShards
 }
 
 func newLocalAPIServer(address string, etcdPrefix string) (*apiServer, error) {
@@ -51,22 +62,85 @@ func newLocalAPIServer(address string, etcdPrefix string) (*apiServer, error) {
 	}, nil
 }
 
-func newAPIServer(address string, etcdAddresses []string, etcdPrefix string, cacheBytes int64) (*apiServer, error) {
-	d, err := newDriver(address, etcdAddresses, etcdPrefix, cacheBytes)
+func newAPIServer(address string, etcdAddresses []string, etcdPrefix string, metadataStore string, cacheBytes int64, blockSize int64, router shard.Router, numShards uint64) (*apiServer, error) {
+	d, err := newDriver(address, etcdAddresses, etcdPrefix, metadataStore, cacheBytes, blockSize)
 	if err != nil {
 		return nil, err
 	}
-	return &apiServer{
+	server := &apiServer{
 		Logger: protorpclog.NewLogger("pfs.API"),
 		driver: d,
-	}, nil
+	}
+	if router != nil {
+		server.shards = newMetadataShards(router, numShards)
+	}
+	return server, nil
+}
+
+// AddShard implements the shard.Server interface.
+func (a *apiServer) AddShard(shard uint64) error {
+	if a.shards == nil {
+		return nil
+	}
+	return a.shards.AddShard(shard)
+}
+
+// DeleteShard implements the shard.Server interface.
+func (a *apiServer) DeleteShard(shard uint64) error {
+	if a.shards == nil {
+		return nil
+	}
+	return a.shards.DeleteShard(shard)
+}
+
+// Version implements the shard.Frontend interface.
+func (a *apiServer) Version(version int64) error {
+	if a.shards == nil {
+		return nil
+	}
+	return a.shards.Version(version)
+}
+
+func (a *apiServer) CreateProject(ctx context.Context, request *pfs.CreateProjectRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	if err := a.driver.createProject(ctx, request.Project, request.Description); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+func (a *apiServer) InspectProject(ctx context.Context, request *pfs.InspectProjectRequest) (response *pfs.ProjectInfo, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	return a.driver.inspectProject(ctx, request.Project)
+}
+
+func (a *apiServer) ListProject(ctx context.Context, request *pfs.ListProjectRequest) (response *pfs.ProjectInfos, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	projectInfos, err := a.driver.listProject(ctx)
+	return &pfs.ProjectInfos{ProjectInfo: projectInfos}, err
+}
+
+func (a *apiServer) DeleteProject(ctx context.Context, request *pfs.DeleteProjectRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	if err := a.driver.deleteProject(ctx, request.Project, request.Force); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
 }
 
 func (a *apiServer) CreateRepo(ctx context.Context, request *pfs.CreateRepoRequest) (response *types.Empty, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 
-	if err := a.driver.createRepo(ctx, request.Repo, request.Provenance, request.Description, request.Update); err != nil {
+	if err := a.driver.createRepo(ctx, request.Repo, request.Provenance, request.Description, request.Retention, request.Labels, request.RemoveLabels, request.Update); err != nil {
 		return nil, err
 	}
 	return &types.Empty{}, nil
@@ -84,13 +158,54 @@ func (a *apiServer) ListRepo(ctx context.Context, request *pfs.ListRepoRequest)
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 
 	repoInfos, err := a.driver.listRepo(ctx, request.Provenance)
-	return &pfs.RepoInfos{RepoInfo: repoInfos}, err
+	if err != nil {
+		return nil, err
+	}
+	if request.Project != "" {
+		repoInfos = projectRepos(repoInfos, request.Project)
+	}
+	if request.LabelSelector != "" {
+		selector, err := kube_labels.Parse(request.LabelSelector)
+		if err != nil {
+			return nil, err
+		}
+		var selected []*pfs.RepoInfo
+		for _, repoInfo := range repoInfos {
+			if selector.Matches(kube_labels.Set(repoInfo.Labels)) {
+				selected = append(selected, repoInfo)
+			}
+		}
+		repoInfos = selected
+	}
+	return &pfs.RepoInfos{RepoInfo: repoInfos}, nil
 }
 
 func (a *apiServer) DeleteRepo(ctx context.Context, request *pfs.DeleteRepoRequest) (response *types.Empty, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 
+	// The objects that the repos reference are found before they're
+	// deleted, and the ones that nothing else references are reclaimed
+	// afterwards.
+	var candidates map[string]*pfs.Object
+	if request.Prune {
+		repos := []*pfs.Repo{request.Repo}
+		if request.All {
+			repoInfos, err := a.driver.listRepo(ctx, nil)
+			if err != nil {
+				return nil, err
+			}
+			repos = nil
+			for _, repoInfo := range repoInfos {
+				repos = append(repos, repoInfo.Repo)
+			}
+		}
+		var err error
+		candidates, err = a.driver.repoObjects(ctx, repos)
+		if err != nil {
+			return nil, err
+		}
+	}
 	if request.All {
 		if err := a.driver.deleteAll(ctx); err != nil {
 			return nil, err
@@ -100,6 +215,11 @@ func (a *apiServer) DeleteRepo(ctx context.Context, request *pfs.DeleteRepoReque
 			return nil, err
 		}
 	}
+	if len(candidates) > 0 {
+		if err := a.driver.reclaimObjects(ctx, candidates, reclaimGracePeriod); err != nil {
+			return nil, err
+		}
+	}
 
 	return &types.Empty{}, nil
 }
@@ -108,7 +228,7 @@ func (a *apiServer) StartCommit(ctx context.Context, request *pfs.StartCommitReq
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 
-	commit, err := a.driver.startCommit(ctx, request.Parent, request.Branch, request.Provenance)
+	commit, err := a.driver.startCommit(ctx, request.Parent, request.Branch, request.Provenance, request.Description, request.Metadata)
 	if err != nil {
 		return nil, err
 	}
@@ -119,7 +239,7 @@ func (a *apiServer) BuildCommit(ctx context.Context, request *pfs.BuildCommitReq
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 
-	commit, err := a.driver.buildCommit(ctx, request.Parent, request.Branch, request.Provenance, request.Tree)
+	commit, err := a.driver.buildCommit(ctx, request.ID, request.Parent, request.Branch, request.Provenance, request.Tree, request.Description, request.Metadata)
 	if err != nil {
 		return nil, err
 	}
@@ -130,7 +250,12 @@ func (a *apiServer) FinishCommit(ctx context.Context, request *pfs.FinishCommitR
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 
-	if err := a.driver.finishCommit(ctx, request.Commit); err != nil {
+	if request.Commit != nil {
+		if conn := a.shards.conn(ctx, request.Commit.Repo); conn != nil {
+			return pfs.NewAPIClient(conn).FinishCommit(forwardedContext(ctx), request)
+		}
+	}
+	if err := a.driver.finishCommit(ctx, request.Commit, request.Description, request.Metadata); err != nil {
 		return nil, err
 	}
 	return &types.Empty{}, nil
@@ -147,7 +272,23 @@ func (a *apiServer) ListCommit(ctx context.Context, request *pfs.ListCommitReque
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 
-	commitInfos, err := a.driver.listCommit(ctx, request.Repo, request.To, request.From, request.Number)
+	if conn := a.shards.conn(ctx, request.Repo); conn != nil {
+		return pfs.NewAPIClient(conn).ListCommit(forwardedContext(ctx), request)
+	}
+	commitInfos, err := a.driver.listCommit(ctx, request.Repo, request.To, request.From, request.Number, request.Since, request.Until, request.Provenance)
+	if err != nil {
+		return nil, err
+	}
+	return &pfs.CommitInfos{
+		CommitInfo: commitInfos,
+	}, nil
+}
+
+func (a *apiServer) FindCommits(ctx context.Context, request *pfs.FindCommitsRequest) (response *pfs.CommitInfos, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	commitInfos, err := a.driver.findCommits(ctx, request.Repo, request.MessageContains, request.Metadata, request.Number)
 	if err != nil {
 		return nil, err
 	}
@@ -191,9 +332,22 @@ func (a *apiServer) DeleteCommit(ctx context.Context, request *pfs.DeleteCommitR
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 
+	var candidates map[string]*pfs.Object
+	if request.Prune {
+		var err error
+		candidates, err = a.driver.commitObjects(ctx, request.Commit)
+		if err != nil {
+			return nil, err
+		}
+	}
 	if err := a.driver.deleteCommit(ctx, request.Commit); err != nil {
 		return nil, err
 	}
+	if len(candidates) > 0 {
+		if err := a.driver.reclaimObjects(ctx, candidates, reclaimGracePeriod); err != nil {
+			return nil, err
+		}
+	}
 	return &types.Empty{}, nil
 }
 
@@ -308,8 +462,25 @@ func (a *apiServer) PutFile(putFileServer pfs.API_PutFileServer) (retErr error)
 			}()
 			r = resp.Body
 		case "pfs":
+			if request.Checksum != "" {
+				return fmt.Errorf("checksums aren't supported for pfs URLs")
+			}
 			return a.putFilePfs(ctx, request, url)
+		case "sql":
+			queryReader, err := sqlQueryReader(url)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := queryReader.Close(); err != nil && retErr == nil {
+					retErr = err
+				}
+			}()
+			r = queryReader
 		default:
+			if request.Checksum != "" {
+				return fmt.Errorf("checksums aren't supported for %s URLs", url.Scheme)
+			}
 			objClient, err := obj.NewClientFromURLAndSecret(putFileServer.Context(), request.Url)
 			if err != nil {
 				return err
@@ -318,13 +489,25 @@ func (a *apiServer) PutFile(putFileServer pfs.API_PutFileServer) (retErr error)
 		}
 	} else {
 		reader := putFileReader{
-			server: putFileServer,
+			server:   putFileServer,
+			checksum: request.Checksum,
 		}
 		_, err = reader.buffer.Write(request.Value)
 		if err != nil {
 			return err
 		}
-		r = &reader
+		r = &checksumReader{
+			r:        &reader,
+			hash:     sha256.New(),
+			checksum: func() string { return reader.checksum },
+		}
+	}
+	if request.Url != "" && request.Checksum != "" {
+		r = &checksumReader{
+			r:        r,
+			hash:     sha256.New(),
+			checksum: func() string { return request.Checksum },
+		}
 	}
 	if err := a.driver.putFile(ctx, request.File, request.Delimiter, request.TargetFileDatums, request.TargetFileBytes, r); err != nil {
 		return err
@@ -332,6 +515,35 @@ func (a *apiServer) PutFile(putFileServer pfs.API_PutFileServer) (retErr error)
 	return nil
 }
 
+// sqlQueryReader runs the query of a URL like
+// sql://<connection>?query=<query>&format=<csv|json> and returns a reader
+// of its result. Reading returns the query's error, if it fails.
+func sqlQueryReader(url *url.URL) (io.ReadCloser, error) {
+	query := url.Query().Get("query")
+	if query == "" {
+		return nil, fmt.Errorf("sql put-file URL must have a query")
+	}
+	format := url.Query().Get("format")
+	if format != "" && format != sqlquery.FormatCSV && format != sqlquery.FormatJSON {
+		return nil, fmt.Errorf("invalid format %q, must be %q or %q", format, sqlquery.FormatCSV, sqlquery.FormatJSON)
+	}
+	if url.Host == "" || strings.ContainsAny(url.Host, "/.") {
+		return nil, fmt.Errorf("invalid sql connection name %q", url.Host)
+	}
+	dataSource, err := ioutil.ReadFile(filepath.Join(SQLConnectionsDir, url.Host))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("sql connection %q not found, it must be added to pachd's %s secret", url.Host, filepath.Base(SQLConnectionsDir))
+		}
+		return nil, err
+	}
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(sqlquery.Query(strings.TrimSpace(string(dataSource)), query, format, pw))
+	}()
+	return pr, nil
+}
+
 func (a *apiServer) putFilePfs(ctx context.Context, request *pfs.PutFileRequest, url *url.URL) error {
 	pClient, err := client.NewFromAddress(url.Host)
 	if err != nil {
@@ -457,6 +669,11 @@ func (a *apiServer) ListFile(ctx context.Context, request *pfs.ListFileRequest)
 		}
 	}(time.Now())
 
+	if request.File != nil && request.File.Commit != nil {
+		if conn := a.shards.conn(ctx, request.File.Commit.Repo); conn != nil {
+			return pfs.NewAPIClient(conn).ListFile(forwardedContext(ctx), request)
+		}
+	}
 	fileInfos, err := a.driver.listFile(ctx, request.File)
 	if err != nil {
 		return nil, err
@@ -477,6 +694,11 @@ func (a *apiServer) GlobFile(ctx context.Context, request *pfs.GlobFileRequest)
 		}
 	}(time.Now())
 
+	if request.Commit != nil {
+		if conn := a.shards.conn(ctx, request.Commit.Repo); conn != nil {
+			return pfs.NewAPIClient(conn).GlobFile(forwardedContext(ctx), request)
+		}
+	}
 	fileInfos, err := a.driver.globFile(ctx, request.Commit, request.Pattern)
 	if err != nil {
 		return nil, err
@@ -499,7 +721,7 @@ func (a *apiServer) DiffFile(ctx context.Context, request *pfs.DiffFileRequest)
 			a.Log(request, response, retErr, time.Since(start))
 		}
 	}(time.Now())
-	newFileInfos, oldFileInfos, err := a.driver.diffFile(ctx, request.NewFile, request.OldFile)
+	newFileInfos, oldFileInfos, err := a.driver.diffFile(ctx, request.NewFile, request.OldFile, request.Shallow)
 	if err != nil {
 		return nil, err
 	}
@@ -530,9 +752,19 @@ func (a *apiServer) DeleteAll(ctx context.Context, request *types.Empty) (respon
 	return &types.Empty{}, nil
 }
 
+func (a *apiServer) Fsck(request *pfs.FsckRequest, fsckServer pfs.API_FsckServer) (retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
+
+	return a.driver.fsck(fsckServer.Context(), request.Fix, request.RemoveDangling, fsckServer.Send)
+}
+
 type putFileReader struct {
 	server pfs.API_PutFileServer
 	buffer bytes.Buffer
+	// checksum is the checksum sent by the client, if any, it's sent in
+	// the last request.
+	checksum string
 }
 
 func (r *putFileReader) Read(p []byte) (int, error) {
@@ -541,12 +773,37 @@ func (r *putFileReader) Read(p []byte) (int, error) {
 		if err != nil {
 			return 0, err
 		}
+		if request.Checksum != "" {
+			r.checksum = request.Checksum
+		}
 		//buffer.Write cannot error
 		r.buffer.Write(request.Value)
 	}
 	return r.buffer.Read(p)
 }
 
+// checksumReader computes the SHA-256 of what's read from r. When r is
+// exhausted it returns an error rather than io.EOF if that doesn't match
+// checksum, so that the file isn't added to the commit.
+type checksumReader struct {
+	r        io.Reader
+	hash     hash.Hash
+	checksum func() string
+}
+
+func (r *checksumReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.hash.Write(p[:n])
+	if err == io.EOF {
+		if checksum := r.checksum(); checksum != "" {
+			if actual := hex.EncodeToString(r.hash.Sum(nil)); actual != strings.ToLower(checksum) {
+				return n, fmt.Errorf("checksum mismatch: the client sent %s but pachd stored %s, the file may have been corrupted in transit", checksum, actual)
+			}
+		}
+	}
+	return n, err
+}
+
 func (a *apiServer) getVersion(ctx context.Context) (int64, error) {
 	md, ok := metadata.FromContext(ctx)
 	if !ok {
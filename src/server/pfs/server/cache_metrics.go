@@ -0,0 +1,69 @@
+package server
+
+import (
+	"github.com/golang/groupcache"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	cacheGetsDesc = prometheus.NewDesc(
+		"pachd_cache_gets_total",
+		"Number of reads from the cache.",
+		[]string{"cache"}, nil,
+	)
+	cacheHitsDesc = prometheus.NewDesc(
+		"pachd_cache_hits_total",
+		"Number of reads that were served from the cache.",
+		[]string{"cache"}, nil,
+	)
+	cacheEvictionsDesc = prometheus.NewDesc(
+		"pachd_cache_evictions_total",
+		"Number of values evicted from the cache.",
+		[]string{"cache"}, nil,
+	)
+	cacheBytesDesc = prometheus.NewDesc(
+		"pachd_cache_bytes",
+		"Number of bytes in the cache.",
+		[]string{"cache"}, nil,
+	)
+)
+
+// cacheCollector exports the stats of an objBlockAPIServer's caches to
+// prometheus.
+type cacheCollector struct {
+	s *objBlockAPIServer
+}
+
+func (c *cacheCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- cacheGetsDesc
+	ch <- cacheHitsDesc
+	ch <- cacheEvictionsDesc
+	ch <- cacheBytesDesc
+}
+
+func (c *cacheCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, group := range []struct {
+		name  string
+		group *groupcache.Group
+	}{
+		{"object", c.s.objectCache},
+		{"tag", c.s.tagCache},
+		{"object_info", c.s.objectInfoCache},
+	} {
+		main := group.group.CacheStats(groupcache.MainCache)
+		hot := group.group.CacheStats(groupcache.HotCache)
+		collect(ch, group.name, group.group.Stats.Gets.Get(), group.group.Stats.CacheHits.Get(),
+			main.Evictions+hot.Evictions, main.Bytes+hot.Bytes)
+	}
+	if c.s.diskCache != nil {
+		stats := c.s.diskCache.Stats()
+		collect(ch, "disk", stats.Hits+stats.Misses, stats.Hits, stats.Evictions, stats.Bytes)
+	}
+}
+
+func collect(ch chan<- prometheus.Metric, cache string, gets int64, hits int64, evictions int64, bytes int64) {
+	ch <- prometheus.MustNewConstMetric(cacheGetsDesc, prometheus.CounterValue, float64(gets), cache)
+	ch <- prometheus.MustNewConstMetric(cacheHitsDesc, prometheus.CounterValue, float64(hits), cache)
+	ch <- prometheus.MustNewConstMetric(cacheEvictionsDesc, prometheus.CounterValue, float64(evictions), cache)
+	ch <- prometheus.MustNewConstMetric(cacheBytesDesc, prometheus.GaugeValue, float64(bytes), cache)
+}
@@ -1,15 +1,19 @@
 package server
 
 import (
+	"archive/tar"
 	"bufio"
 	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"math"
+	"os"
 	"path"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -22,7 +26,9 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
 	"github.com/pachyderm/pachyderm/src/client/pkg/uuid"
 	pfsserver "github.com/pachyderm/pachyderm/src/server/pfs"
+	"github.com/pachyderm/pachyderm/src/server/pkg/chunk"
 	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/columnar"
 	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
 	"github.com/pachyderm/pachyderm/src/server/pkg/pfsdb"
 	"github.com/pachyderm/pachyderm/src/server/pkg/watch"
@@ -31,6 +37,7 @@ import (
 	"github.com/gogo/protobuf/types"
 	"github.com/hashicorp/golang-lru"
 	"google.golang.org/grpc"
+	"k8s.io/kubernetes/pkg/util/validation"
 )
 
 const (
@@ -41,6 +48,12 @@ const (
 
 // ValidateRepoName determines if a repo name is valid
 func ValidateRepoName(name string) error {
+	project, name := pfs.SplitProject(name)
+	if project != "" {
+		if err := ValidateProjectName(project); err != nil {
+			return err
+		}
+	}
 	match, _ := regexp.MatchString("^[a-zA-Z0-9_-]+$", name)
 
 	if !match {
@@ -50,6 +63,31 @@ func ValidateRepoName(name string) error {
 	return nil
 }
 
+// ValidateProjectName determines if a project name is valid.
+func ValidateProjectName(name string) error {
+	match, _ := regexp.MatchString("^[a-zA-Z0-9_-]+$", name)
+
+	if !match {
+		return fmt.Errorf("project name (%v) invalid: only alphanumeric characters, underscores, and dashes are allowed", name)
+	}
+
+	return nil
+}
+
+// validateLabels checks that labels are valid kubernetes labels, so that
+// they can be matched by label selectors.
+func validateLabels(labels map[string]string) error {
+	for key, value := range labels {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return fmt.Errorf("label key (%v) invalid: %s", key, strings.Join(errs, "; "))
+		}
+		if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+			return fmt.Errorf("label value (%v) invalid: %s", value, strings.Join(errs, "; "))
+		}
+	}
+	return nil
+}
+
 // ListFileMode specifies how ListFile executes.
 type ListFileMode int
 
@@ -85,14 +123,23 @@ type driver struct {
 	address      string
 	pachConnOnce sync.Once
 	pachConn     *grpc.ClientConn
-	etcdClient   *etcd.Client
-	prefix       string
+	// store is where PFS's metadata is kept, see col.Store.
+	store  col.Store
+	prefix string
+	// batcher batches the transactions that start and finish commits, which
+	// are frequent when commits are small.
+	batcher *col.Batcher
+	// blockSize is the average size of the objects that a file is
+	// split into, 0 means files aren't split.
+	blockSize int64
 
 	// collections
+	projects      col.Collection
 	repos         col.Collection
 	repoRefCounts col.Collection
 	commits       collectionFactory
 	branches      collectionFactory
+	leases        collectionFactory
 
 	// a cache for commit IDs that we know exist
 	commitCache *lru.Cache
@@ -116,14 +163,25 @@ const (
 	defaultCacheSize = 1024 * 1024
 )
 
-// newDriver is used to create a new Driver instance
-func newDriver(address string, etcdAddresses []string, etcdPrefix string, cacheBytes int64) (*driver, error) {
-	etcdClient, err := etcd.New(etcd.Config{
-		Endpoints:   etcdAddresses,
-		DialOptions: client.EtcdDialOptions(),
-	})
-	if err != nil {
-		return nil, err
+// newDriver is used to create a new Driver instance. metadataStore is the
+// data source of the Postgres database that PFS's metadata is kept in, if
+// it's empty the metadata is kept in etcd.
+func newDriver(address string, etcdAddresses []string, etcdPrefix string, metadataStore string, cacheBytes int64, blockSize int64) (*driver, error) {
+	var store col.Store
+	if metadataStore != "" {
+		var err error
+		if store, err = col.NewPostgresStore(metadataStore); err != nil {
+			return nil, err
+		}
+	} else {
+		etcdClient, err := etcd.New(etcd.Config{
+			Endpoints:   etcdAddresses,
+			DialOptions: client.EtcdDialOptions(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		store = col.NewEtcdStore(etcdClient)
 	}
 
 	commitCache, err := lru.New(int(float64(cacheBytes) * commitCachePercentage))
@@ -137,15 +195,21 @@ func newDriver(address string, etcdAddresses []string, etcdPrefix string, cacheB
 
 	return &driver{
 		address:       address,
-		etcdClient:    etcdClient,
+		store:         store,
 		prefix:        etcdPrefix,
-		repos:         pfsdb.Repos(etcdClient, etcdPrefix),
-		repoRefCounts: pfsdb.RepoRefCounts(etcdClient, etcdPrefix),
+		batcher:       col.NewBatcher(context.Background(), store),
+		blockSize:     blockSize,
+		projects:      pfsdb.Projects(store, etcdPrefix),
+		repos:         pfsdb.Repos(store, etcdPrefix),
+		repoRefCounts: pfsdb.RepoRefCounts(store, etcdPrefix),
 		commits: func(repo string) col.Collection {
-			return pfsdb.Commits(etcdClient, etcdPrefix, repo)
+			return pfsdb.Commits(store, etcdPrefix, repo)
 		},
 		branches: func(repo string) col.Collection {
-			return pfsdb.Branches(etcdClient, etcdPrefix, repo)
+			return pfsdb.Branches(store, etcdPrefix, repo)
+		},
+		leases: func(repo string) col.Collection {
+			return pfsdb.Leases(store, etcdPrefix, repo)
 		},
 		commitCache: commitCache,
 		treeCache:   treeCache,
@@ -155,7 +219,7 @@ func newDriver(address string, etcdAddresses []string, etcdPrefix string, cacheB
 // newLocalDriver creates a driver using an local etcd instance.  This
 // function is intended for testing purposes
 func newLocalDriver(blockAddress string, etcdPrefix string) (*driver, error) {
-	return newDriver(blockAddress, []string{"localhost:32379"}, etcdPrefix, defaultCacheSize)
+	return newDriver(blockAddress, []string{"localhost:32379"}, etcdPrefix, "", defaultCacheSize, DefaultBlockSize)
 }
 
 func (d *driver) getObjectClient() (*client.APIClient, error) {
@@ -191,15 +255,115 @@ func absent(key string) etcd.Cmp {
 	return etcd.Compare(etcd.CreateRevision(key), "=", 0)
 }
 
-func (d *driver) createRepo(ctx context.Context, repo *pfs.Repo, provenance []*pfs.Repo, description string, update bool) error {
+func (d *driver) createProject(ctx context.Context, project *pfs.Project, description string) error {
+	if err := ValidateProjectName(project.Name); err != nil {
+		return err
+	}
+	_, err := d.store.NewSTM(ctx, func(stm col.STM) error {
+		return d.projects.ReadWrite(stm).Create(project.Name, &pfs.ProjectInfo{
+			Project:     project,
+			Created:     now(),
+			Description: description,
+		})
+	})
+	return err
+}
+
+func (d *driver) inspectProject(ctx context.Context, project *pfs.Project) (*pfs.ProjectInfo, error) {
+	projectInfo := new(pfs.ProjectInfo)
+	if err := d.projects.ReadOnly(ctx).Get(project.Name, projectInfo); err != nil {
+		return nil, err
+	}
+	return projectInfo, nil
+}
+
+func (d *driver) listProject(ctx context.Context) ([]*pfs.ProjectInfo, error) {
+	iterator, err := d.projects.ReadOnly(ctx).List()
+	if err != nil {
+		return nil, err
+	}
+	var result []*pfs.ProjectInfo
+	for {
+		var projectName string
+		projectInfo := new(pfs.ProjectInfo)
+		ok, err := iterator.Next(&projectName, projectInfo)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		result = append(result, projectInfo)
+	}
+	return result, nil
+}
+
+// deleteProject deletes a project. If force is true the repos in the
+// project are deleted too, otherwise the project has to be empty.
+func (d *driver) deleteProject(ctx context.Context, project *pfs.Project, force bool) error {
+	if _, err := d.inspectProject(ctx, project); err != nil {
+		return err
+	}
+	repoInfos, err := d.listRepo(ctx, nil)
+	if err != nil {
+		return err
+	}
+	repoInfos = projectRepos(repoInfos, project.Name)
+	if len(repoInfos) > 0 && !force {
+		return fmt.Errorf("project %s isn't empty, it has %d repos", project.Name, len(repoInfos))
+	}
+	for _, repoInfo := range repoInfos {
+		if err := d.deleteRepo(ctx, repoInfo.Repo, true); err != nil {
+			return err
+		}
+	}
+	_, err = d.store.NewSTM(ctx, func(stm col.STM) error {
+		return d.projects.ReadWrite(stm).Delete(project.Name)
+	})
+	return err
+}
+
+// projectRepos returns the repos in repoInfos that are in project.
+func projectRepos(repoInfos []*pfs.RepoInfo, project string) []*pfs.RepoInfo {
+	var result []*pfs.RepoInfo
+	for _, repoInfo := range repoInfos {
+		if repoProject, _ := pfs.SplitProject(repoInfo.Repo.Name); repoProject == project {
+			result = append(result, repoInfo)
+		}
+	}
+	return result
+}
+
+func (d *driver) createRepo(ctx context.Context, repo *pfs.Repo, provenance []*pfs.Repo, description string, retention *types.Duration, labels map[string]string, removeLabels []string, update bool) error {
 	if err := ValidateRepoName(repo.Name); err != nil {
 		return err
 	}
+	if err := validateLabels(labels); err != nil {
+		return err
+	}
+	if retention != nil {
+		duration, err := types.DurationFromProto(retention)
+		if err != nil {
+			return err
+		}
+		if duration < 0 {
+			return fmt.Errorf("retention can't be negative")
+		}
+	}
 
-	_, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+	_, err := d.store.NewSTM(ctx, func(stm col.STM) error {
 		repos := d.repos.ReadWrite(stm)
 		repoRefCounts := d.repoRefCounts.ReadWriteInt(stm)
 
+		if project, _ := pfs.SplitProject(repo.Name); project != "" {
+			if err := d.projects.ReadWrite(stm).Get(project, new(pfs.ProjectInfo)); err != nil {
+				if _, ok := err.(col.ErrNotFound); ok {
+					return fmt.Errorf("project %s doesn't exist", project)
+				}
+				return err
+			}
+		}
+
 		if update {
 			repoInfo := new(pfs.RepoInfo)
 			if err := repos.Get(repo.Name, repoInfo); err != nil {
@@ -275,6 +439,21 @@ func (d *driver) createRepo(ctx context.Context, repo *pfs.Repo, provenance []*p
 
 			repoInfo.Description = description
 			repoInfo.Provenance = provenance
+			for key, value := range labels {
+				if repoInfo.Labels == nil {
+					repoInfo.Labels = make(map[string]string)
+				}
+				repoInfo.Labels[key] = value
+			}
+			for _, key := range removeLabels {
+				delete(repoInfo.Labels, key)
+			}
+			if retention != nil {
+				repoInfo.Retention = retention
+				if *retention == (types.Duration{}) {
+					repoInfo.Retention = nil
+				}
+			}
 			repos.Put(repo.Name, repoInfo)
 			return nil
 		}
@@ -310,6 +489,10 @@ func (d *driver) createRepo(ctx context.Context, repo *pfs.Repo, provenance []*p
 			Created:     now(),
 			Provenance:  fullProvRepos,
 			Description: description,
+			Labels:      labels,
+		}
+		if retention != nil && *retention != (types.Duration{}) {
+			repoInfo.Retention = retention
 		}
 		return repos.Create(repo.Name, repoInfo)
 	})
@@ -368,7 +551,7 @@ nextRepo:
 }
 
 func (d *driver) deleteRepo(ctx context.Context, repo *pfs.Repo, force bool) error {
-	_, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+	_, err := d.store.NewSTM(ctx, func(stm col.STM) error {
 		repos := d.repos.ReadWrite(stm)
 		repoRefCounts := d.repoRefCounts.ReadWriteInt(stm)
 		commits := d.commits(repo.Name).ReadWrite(stm)
@@ -407,26 +590,34 @@ func (d *driver) deleteRepo(ctx context.Context, repo *pfs.Repo, force bool) err
 		}
 		commits.DeleteAll()
 		branches.DeleteAll()
+		d.leases(repo.Name).ReadWrite(stm).DeleteAll()
 		return nil
 	})
 	return err
 }
 
-func (d *driver) startCommit(ctx context.Context, parent *pfs.Commit, branch string, provenance []*pfs.Commit) (*pfs.Commit, error) {
-	return d.makeCommit(ctx, parent, branch, provenance, nil)
+func (d *driver) startCommit(ctx context.Context, parent *pfs.Commit, branch string, provenance []*pfs.Commit, description string, metadata map[string]string) (*pfs.Commit, error) {
+	return d.makeCommit(ctx, "", parent, branch, provenance, nil, description, metadata)
 }
 
-func (d *driver) buildCommit(ctx context.Context, parent *pfs.Commit, branch string, provenance []*pfs.Commit, tree *pfs.Object) (*pfs.Commit, error) {
-	return d.makeCommit(ctx, parent, branch, provenance, tree)
+func (d *driver) buildCommit(ctx context.Context, ID string, parent *pfs.Commit, branch string, provenance []*pfs.Commit, tree *pfs.Object, description string, metadata map[string]string) (*pfs.Commit, error) {
+	return d.makeCommit(ctx, ID, parent, branch, provenance, tree, description, metadata)
 }
 
-func (d *driver) makeCommit(ctx context.Context, parent *pfs.Commit, branch string, provenance []*pfs.Commit, treeRef *pfs.Object) (*pfs.Commit, error) {
+// makeCommit makes a new commit, if ID is empty a new ID is generated for it.
+func (d *driver) makeCommit(ctx context.Context, ID string, parent *pfs.Commit, branch string, provenance []*pfs.Commit, treeRef *pfs.Object, description string, metadata map[string]string) (*pfs.Commit, error) {
 	if parent == nil {
 		return nil, fmt.Errorf("parent cannot be nil")
 	}
+	if err := validateCommitMetadata(metadata); err != nil {
+		return nil, err
+	}
+	if ID == "" {
+		ID = uuid.NewWithoutDashes()
+	}
 	commit := &pfs.Commit{
 		Repo: parent.Repo,
-		ID:   uuid.NewWithoutDashes(),
+		ID:   ID,
 	}
 	var commitSize uint64
 	if treeRef != nil {
@@ -444,7 +635,7 @@ func (d *driver) makeCommit(ctx context.Context, parent *pfs.Commit, branch stri
 		}
 		commitSize = uint64(tree.FSSize())
 	}
-	if _, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+	if _, err := d.batcher.NewSTM(ctx, func(stm col.STM) error {
 		repos := d.repos.ReadWrite(stm)
 		commits := d.commits(parent.Repo.Name).ReadWrite(stm)
 		branches := d.branches(parent.Repo.Name).ReadWrite(stm)
@@ -456,8 +647,10 @@ func (d *driver) makeCommit(ctx context.Context, parent *pfs.Commit, branch stri
 		}
 
 		commitInfo := &pfs.CommitInfo{
-			Commit:  commit,
-			Started: now(),
+			Commit:      commit,
+			Started:     now(),
+			Description: description,
+			Metadata:    metadata,
 		}
 
 		// Use a map to de-dup provenance
@@ -525,7 +718,17 @@ func (d *driver) makeCommit(ctx context.Context, parent *pfs.Commit, branch stri
 	return commit, nil
 }
 
-func (d *driver) finishCommit(ctx context.Context, commit *pfs.Commit) error {
+// validateCommitMetadata returns an error if metadata has an empty key.
+func validateCommitMetadata(metadata map[string]string) error {
+	for key := range metadata {
+		if key == "" {
+			return fmt.Errorf("commit metadata keys can't be empty")
+		}
+	}
+	return nil
+}
+
+func (d *driver) finishCommit(ctx context.Context, commit *pfs.Commit, description string, metadata map[string]string) error {
 	commitInfo, err := d.inspectCommit(ctx, commit)
 	if err != nil {
 		return err
@@ -533,6 +736,18 @@ func (d *driver) finishCommit(ctx context.Context, commit *pfs.Commit) error {
 	if commitInfo.Finished != nil {
 		return fmt.Errorf("commit %s has already been finished", commit.FullID())
 	}
+	if err := validateCommitMetadata(metadata); err != nil {
+		return err
+	}
+	if description != "" {
+		commitInfo.Description = description
+	}
+	for key, value := range metadata {
+		if commitInfo.Metadata == nil {
+			commitInfo.Metadata = make(map[string]string)
+		}
+		commitInfo.Metadata[key] = value
+	}
 
 	prefix, err := d.scratchCommitPrefix(ctx, commit)
 	if err != nil {
@@ -540,7 +755,7 @@ func (d *driver) finishCommit(ctx context.Context, commit *pfs.Commit) error {
 	}
 
 	// Read everything under the scratch space for this commit
-	resp, err := d.etcdClient.Get(ctx, prefix, etcd.WithPrefix(), etcd.WithSort(etcd.SortByModRevision, etcd.SortAscend))
+	kvs, err := d.store.List(ctx, prefix, true)
 	if err != nil {
 		return err
 	}
@@ -551,7 +766,7 @@ func (d *driver) finishCommit(ctx context.Context, commit *pfs.Commit) error {
 	}
 	tree := parentTree.Open()
 
-	if err := d.applyWrites(resp, tree); err != nil {
+	if err := d.applyWrites(kvs, tree); err != nil {
 		return err
 	}
 
@@ -583,7 +798,7 @@ func (d *driver) finishCommit(ctx context.Context, commit *pfs.Commit) error {
 	commitInfo.SizeBytes = uint64(finishedTree.FSSize())
 	commitInfo.Finished = now()
 
-	_, err = col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+	_, err = d.batcher.NewSTM(ctx, func(stm col.STM) error {
 		commits := d.commits(commit.Repo.Name).ReadWrite(stm)
 		repos := d.repos.ReadWrite(stm)
 
@@ -596,7 +811,7 @@ func (d *driver) finishCommit(ctx context.Context, commit *pfs.Commit) error {
 
 		// Increment the repo sizes by the sizes of the files that have
 		// been added in this commit.
-		finishedTree.Diff(parentTree, "", "", func(path string, node *hashtree.NodeProto, new bool) error {
+		finishedTree.Diff(parentTree, "", "", -1, func(path string, node *hashtree.NodeProto, new bool) error {
 			if node.FileNode != nil && new {
 				repoInfo.SizeBytes += uint64(node.SubtreeSize)
 			}
@@ -610,8 +825,10 @@ func (d *driver) finishCommit(ctx context.Context, commit *pfs.Commit) error {
 	}
 
 	// Delete the scratch space for this commit
-	_, err = d.etcdClient.Delete(ctx, prefix, etcd.WithPrefix())
-	return err
+	if err := d.store.DeleteAll(ctx, prefix); err != nil {
+		return err
+	}
+	return d.deleteLeases(ctx, commit)
 }
 
 // inspectCommit takes a Commit and returns the corresponding CommitInfo.
@@ -625,7 +842,7 @@ func (d *driver) inspectCommit(ctx context.Context, commit *pfs.Commit) (*pfs.Co
 	if commit == nil {
 		return nil, fmt.Errorf("cannot inspect nil commit")
 	}
-	_, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+	_, err := d.store.NewSTM(ctx, func(stm col.STM) error {
 		branches := d.branches(commit.Repo.Name).ReadWrite(stm)
 
 		head := new(pfs.Commit)
@@ -652,7 +869,11 @@ func (d *driver) inspectCommit(ctx context.Context, commit *pfs.Commit) (*pfs.Co
 	return commitInfo, nil
 }
 
-func (d *driver) listCommit(ctx context.Context, repo *pfs.Repo, to *pfs.Commit, from *pfs.Commit, number uint64) ([]*pfs.CommitInfo, error) {
+// listCommit returns the commits of repo, or the ancestors of to, up to
+// from, newest first. If since or until are set, only the commits started in
+// that range are returned. At most number commits are returned, unless it's
+// 0.
+func (d *driver) listCommit(ctx context.Context, repo *pfs.Repo, to *pfs.Commit, from *pfs.Commit, number uint64, since *types.Timestamp, until *types.Timestamp, provenance *pfs.Commit) ([]*pfs.CommitInfo, error) {
 	if from != nil && from.Repo.Name != repo.Name || to != nil && to.Repo.Name != repo.Name {
 		return nil, fmt.Errorf("`from` and `to` commits need to be from repo %s", repo.Name)
 	}
@@ -674,6 +895,14 @@ func (d *driver) listCommit(ctx context.Context, repo *pfs.Repo, to *pfs.Commit,
 			return nil, err
 		}
 	}
+	if provenance != nil {
+		// Resolve branch names, since commits' provenance is recorded by ID.
+		provenanceInfo, err := d.inspectCommit(ctx, provenance)
+		if err != nil {
+			return nil, err
+		}
+		provenance = provenanceInfo.Commit
+	}
 
 	// if number is 0, we return all commits that match the criteria
 	if number == 0 {
@@ -687,7 +916,13 @@ func (d *driver) listCommit(ctx context.Context, repo *pfs.Repo, to *pfs.Commit,
 	} else if from == nil && to == nil {
 		// if neither from and to is given, we list all commits in
 		// the repo, sorted by revision timestamp
-		iterator, err := commits.List()
+		var iterator col.Iterator
+		var err error
+		if provenance != nil {
+			iterator, err = commits.GetByIndex(pfsdb.ProvenanceIndex, provenance)
+		} else {
+			iterator, err = commits.List()
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -701,6 +936,9 @@ func (d *driver) listCommit(ctx context.Context, repo *pfs.Repo, to *pfs.Commit,
 			if !ok {
 				break
 			}
+			if !startedBetween(&commitInfo, since, until) || !hasProvenance(&commitInfo, provenance) {
+				continue
+			}
 			commitInfos = append(commitInfos, &commitInfo)
 			number--
 		}
@@ -711,14 +949,109 @@ func (d *driver) listCommit(ctx context.Context, repo *pfs.Repo, to *pfs.Commit,
 			if err := commits.Get(cursor.ID, &commitInfo); err != nil {
 				return nil, err
 			}
-			commitInfos = append(commitInfos, &commitInfo)
 			cursor = commitInfo.ParentCommit
+			if since != nil && commitInfo.Started != nil && commitInfo.Started.Compare(since) < 0 {
+				// The remaining ancestors were started even earlier.
+				break
+			}
+			if !startedBetween(&commitInfo, since, until) || !hasProvenance(&commitInfo, provenance) {
+				continue
+			}
+			commitInfos = append(commitInfos, &commitInfo)
 			number--
 		}
 	}
 	return commitInfos, nil
 }
 
+// hasProvenance returns true if provenance is nil or in commitInfo's
+// provenance.
+func hasProvenance(commitInfo *pfs.CommitInfo, provenance *pfs.Commit) bool {
+	if provenance == nil {
+		return true
+	}
+	for _, commit := range commitInfo.Provenance {
+		if commit.Repo.Name == provenance.Repo.Name && commit.ID == provenance.ID {
+			return true
+		}
+	}
+	return false
+}
+
+// startedBetween returns true if commitInfo was started in [since, until],
+// either of which may be nil.
+func startedBetween(commitInfo *pfs.CommitInfo, since *types.Timestamp, until *types.Timestamp) bool {
+	if since == nil && until == nil {
+		return true
+	}
+	if commitInfo.Started == nil {
+		return false
+	}
+	return (since == nil || commitInfo.Started.Compare(since) >= 0) &&
+		(until == nil || commitInfo.Started.Compare(until) <= 0)
+}
+
+// findCommits returns the commits of repo, or of every repo if it's nil,
+// whose description contains messageContains, ignoring case, and whose
+// metadata has all the pairs in metadata, newest first. At most number
+// commits are returned, unless it's 0.
+func (d *driver) findCommits(ctx context.Context, repo *pfs.Repo, messageContains string, metadata map[string]string, number uint64) ([]*pfs.CommitInfo, error) {
+	var repos []*pfs.Repo
+	if repo != nil {
+		if _, err := d.inspectRepo(ctx, repo); err != nil {
+			return nil, err
+		}
+		repos = append(repos, repo)
+	} else {
+		repoInfos, err := d.listRepo(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, repoInfo := range repoInfos {
+			repos = append(repos, repoInfo.Repo)
+		}
+	}
+	messageContains = strings.ToLower(messageContains)
+	var result []*pfs.CommitInfo
+	for _, repo := range repos {
+		iterator, err := d.commits(repo.Name).ReadOnly(ctx).List()
+		if err != nil {
+			return nil, err
+		}
+		for {
+			var commitID string
+			commitInfo := new(pfs.CommitInfo)
+			ok, err := iterator.Next(&commitID, commitInfo)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				break
+			}
+			if !strings.Contains(strings.ToLower(commitInfo.Description), messageContains) {
+				continue
+			}
+			matches := true
+			for key, value := range metadata {
+				if actual, ok := commitInfo.Metadata[key]; !ok || actual != value {
+					matches = false
+					break
+				}
+			}
+			if matches {
+				result = append(result, commitInfo)
+			}
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Started.Compare(result[j].Started) > 0
+	})
+	if number != 0 && uint64(len(result)) > number {
+		result = result[:number]
+	}
+	return result, nil
+}
+
 type commitStream struct {
 	stream chan CommitEvent
 	done   chan struct{}
@@ -764,12 +1097,16 @@ func (d *driver) subscribeCommit(ctx context.Context, repo *pfs.Repo, branch str
 		}()
 		// keep track of the commits that have been sent
 		seen := make(map[string]bool)
+		// lastSent is the ID of the last commit that was sent. If the branch
+		// is moved back to a commit that has been sent before, the commit is
+		// sent again, so that subscribers see the branch being rewound.
+		var lastSent string
 		// include all commits that are currently on the given branch,
 		// but only the ones that have been finished
 		commitInfos, err := d.listCommit(ctx, repo, &pfs.Commit{
 			Repo: repo,
 			ID:   branch,
-		}, from, 0)
+		}, from, 0, nil, nil, nil)
 		if err != nil {
 			// We skip NotFound error because it's ok if the branch
 			// doesn't exist yet, in which case ListCommit returns
@@ -789,6 +1126,7 @@ func (d *driver) subscribeCommit(ctx context.Context, repo *pfs.Repo, branch str
 					Value: commitInfo,
 				}:
 					seen[commitInfo.Commit.ID] = true
+					lastSent = commitInfo.Commit.ID
 				case <-done:
 					return nil
 				}
@@ -817,7 +1155,7 @@ func (d *driver) subscribeCommit(ctx context.Context, repo *pfs.Repo, branch str
 				case watch.EventDelete:
 					continue
 				}
-				if !seen[commit.ID] {
+				if !seen[commit.ID] || commit.ID != lastSent {
 					break
 				}
 			}
@@ -851,6 +1189,7 @@ func (d *driver) subscribeCommit(ctx context.Context, repo *pfs.Repo, branch str
 							Value: commitInfo,
 						}:
 							seen[commitInfo.Commit.ID] = true
+							lastSent = commitInfo.Commit.ID
 						case <-done:
 							return nil
 						}
@@ -1060,8 +1399,10 @@ func (d *driver) deleteCommit(ctx context.Context, commit *pfs.Commit) error {
 	if err != nil {
 		return err
 	}
-	_, err = d.etcdClient.Delete(ctx, prefix, etcd.WithPrefix())
-	if err != nil {
+	if err := d.store.DeleteAll(ctx, prefix); err != nil {
+		return err
+	}
+	if err := d.deleteLeases(ctx, commitInfo.Commit); err != nil {
 		return err
 	}
 
@@ -1089,7 +1430,7 @@ func (d *driver) deleteCommit(ctx context.Context, commit *pfs.Commit) error {
 
 	// Delete the commit itself and subtract the size of the commit
 	// from repo size.
-	_, err = col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+	_, err = d.store.NewSTM(ctx, func(stm col.STM) error {
 		repos := d.repos.ReadWrite(stm)
 		repoInfo := new(pfs.RepoInfo)
 		if err := repos.Get(commit.Repo.Name, repoInfo); err != nil {
@@ -1135,7 +1476,7 @@ func (d *driver) setBranch(ctx context.Context, commit *pfs.Commit, name string)
 	if _, err := d.inspectCommit(ctx, commit); err != nil {
 		return err
 	}
-	_, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+	_, err := d.store.NewSTM(ctx, func(stm col.STM) error {
 		commits := d.commits(commit.Repo.Name).ReadWrite(stm)
 		branches := d.branches(commit.Repo.Name).ReadWrite(stm)
 
@@ -1152,7 +1493,7 @@ func (d *driver) setBranch(ctx context.Context, commit *pfs.Commit, name string)
 }
 
 func (d *driver) deleteBranch(ctx context.Context, repo *pfs.Repo, name string) error {
-	_, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+	_, err := d.store.NewSTM(ctx, func(stm col.STM) error {
 		branches := d.branches(repo.Name).ReadWrite(stm)
 		return branches.Delete(name)
 	})
@@ -1222,6 +1563,9 @@ func (d *driver) putFile(ctx context.Context, file *pfs.File, delimiter pfs.Deli
 	if err := checkPath(file.Path); err != nil {
 		return err
 	}
+	if delimiter == pfs.Delimiter_TAR {
+		return d.putTar(ctx, file, reader)
+	}
 	prefix, err := d.scratchFilePrefix(ctx, file)
 	if err != nil {
 		return err
@@ -1233,79 +1577,125 @@ func (d *driver) putFile(ctx context.Context, file *pfs.File, delimiter pfs.Deli
 		return err
 	}
 	if delimiter == pfs.Delimiter_NONE {
-		object, size, err := objClient.PutObject(reader)
-		if err != nil {
-			return err
+		// The file is put in content-defined chunks averaging blockSize
+		// bytes, which together are the file's content. Their boundaries
+		// depend on the bytes around them rather than on their offsets,
+		// so when bytes are inserted into or removed from a file, only
+		// the chunks around the change are new, and the rest dedupe with
+		// the file's previous version. Each one may also be stored as a
+		// delta against the same chunk of the previous version, so that
+		// small changes within a chunk are cheap.
+		previous := d.previousObjects(ctx, file)
+		splitter := chunk.NewSplitter(reader, d.blockSize)
+		for i := 0; ; i++ {
+			var base *pfs.Object
+			if i < len(previous) {
+				base = previous[i]
+			}
+			object, size, err := objClient.PutObjectDelta(splitter.Next(), base)
+			if err != nil {
+				return err
+			}
+			records.Records = append(records.Records, &PutFileRecord{
+				SizeBytes:  size,
+				ObjectHash: object.Hash,
+			})
+			if more, err := splitter.More(); err != nil {
+				return err
+			} else if !more {
+				break
+			}
 		}
-		records.Records = append(records.Records, &PutFileRecord{
-			SizeBytes:  size,
-			ObjectHash: object.Hash,
-		})
 		marshalledRecords, err := records.Marshal()
 		if err != nil {
 			return err
 		}
-		_, err = d.etcdClient.Put(ctx, path.Join(prefix, uuid.NewWithoutDashes()), string(marshalledRecords))
+		err = d.store.Put(ctx, path.Join(prefix, uuid.NewWithoutDashes()), string(marshalledRecords))
 		return err
 	}
-	buffer := &bytes.Buffer{}
-	var datumsWritten int64
-	var bytesWritten int64
 	var filesPut int
-	EOF := false
 	var eg errgroup.Group
-	decoder := json.NewDecoder(reader)
-	bufioR := bufio.NewReader(reader)
-
 	indexToRecord := make(map[int]*PutFileRecord)
 	var mu sync.Mutex
-	for !EOF {
-		var err error
-		var value []byte
-		switch delimiter {
-		case pfs.Delimiter_JSON:
-			var jsonValue json.RawMessage
-			err = decoder.Decode(&jsonValue)
-			value = jsonValue
-		case pfs.Delimiter_LINE:
-			value, err = bufioR.ReadBytes('\n')
-		default:
-			return fmt.Errorf("unrecognized delimiter %s", delimiter.String())
-		}
-		if err != nil {
-			if err == io.EOF {
-				EOF = true
-			} else {
+	putSplitFile := func(buffer *bytes.Buffer) {
+		index := filesPut
+		eg.Go(func() error {
+			object, size, err := objClient.PutObject(buffer)
+			if err != nil {
 				return err
 			}
+			mu.Lock()
+			defer mu.Unlock()
+			indexToRecord[index] = &PutFileRecord{
+				SizeBytes:  size,
+				ObjectHash: object.Hash,
+			}
+			return nil
+		})
+		filesPut++
+	}
+	if delimiter == pfs.Delimiter_PARQUET {
+		if err := splitParquet(reader, targetFileDatums, targetFileBytes, putSplitFile); err != nil {
+			return err
 		}
-		buffer.Write(value)
-		bytesWritten += int64(len(value))
-		datumsWritten++
-		if buffer.Len() != 0 &&
-			((targetFileBytes != 0 && bytesWritten >= targetFileBytes) ||
-				(targetFileDatums != 0 && datumsWritten >= targetFileDatums) ||
-				(targetFileBytes == 0 && targetFileDatums == 0) ||
-				EOF) {
-			_buffer := buffer
-			index := filesPut
-			eg.Go(func() error {
-				object, size, err := objClient.PutObject(_buffer)
-				if err != nil {
+	} else {
+		buffer := &bytes.Buffer{}
+		var datumsWritten int64
+		var bytesWritten int64
+		EOF := false
+		decoder := json.NewDecoder(reader)
+		bufioR := bufio.NewReader(reader)
+		// header is written at the start of each file
+		var header []byte
+		var avroReader *columnar.AvroReader
+		if delimiter == pfs.Delimiter_AVRO {
+			var err error
+			if avroReader, err = columnar.NewAvroReader(reader); err != nil {
+				return err
+			}
+			header = avroReader.Header
+		}
+		for !EOF {
+			var err error
+			var value []byte
+			datums := int64(1)
+			switch delimiter {
+			case pfs.Delimiter_JSON:
+				var jsonValue json.RawMessage
+				err = decoder.Decode(&jsonValue)
+				value = jsonValue
+			case pfs.Delimiter_LINE:
+				value, err = bufioR.ReadBytes('\n')
+			case pfs.Delimiter_AVRO:
+				// Each block holds a number of records, which are
+				// compressed together so the block can't be split.
+				value, datums, err = avroReader.ReadBlock()
+			default:
+				return fmt.Errorf("unrecognized delimiter %s", delimiter.String())
+			}
+			if err != nil {
+				if err == io.EOF {
+					EOF = true
+				} else {
 					return err
 				}
-				mu.Lock()
-				defer mu.Unlock()
-				indexToRecord[index] = &PutFileRecord{
-					SizeBytes:  size,
-					ObjectHash: object.Hash,
-				}
-				return nil
-			})
-			datumsWritten = 0
-			bytesWritten = 0
-			buffer = &bytes.Buffer{}
-			filesPut++
+			}
+			if buffer.Len() == 0 && len(value) != 0 {
+				buffer.Write(header)
+			}
+			buffer.Write(value)
+			bytesWritten += int64(len(value))
+			datumsWritten += datums
+			if buffer.Len() != 0 &&
+				((targetFileBytes != 0 && bytesWritten >= targetFileBytes) ||
+					(targetFileDatums != 0 && datumsWritten >= targetFileDatums) ||
+					(targetFileBytes == 0 && targetFileDatums == 0) ||
+					EOF) {
+				putSplitFile(buffer)
+				datumsWritten = 0
+				bytesWritten = 0
+				buffer = &bytes.Buffer{}
+			}
 		}
 	}
 	if err := eg.Wait(); err != nil {
@@ -1320,10 +1710,98 @@ func (d *driver) putFile(ctx context.Context, file *pfs.File, delimiter pfs.Deli
 	if err != nil {
 		return err
 	}
-	_, err = d.etcdClient.Put(ctx, path.Join(prefix, uuid.NewWithoutDashes()), string(marshalledRecords))
+	err = d.store.Put(ctx, path.Join(prefix, uuid.NewWithoutDashes()), string(marshalledRecords))
 	return err
 }
 
+// putTar puts each regular file in the tar stream in reader at its path in
+// the stream, relative to file.
+func (d *driver) putTar(ctx context.Context, file *pfs.File, reader io.Reader) error {
+	tr := tar.NewReader(reader)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if hdr.Typeflag != tar.TypeReg && hdr.Typeflag != tar.TypeRegA {
+			continue
+		}
+		if err := d.putFile(ctx, client.NewFile(file.Commit.Repo.Name, file.Commit.ID, path.Join(file.Path, hdr.Name)), pfs.Delimiter_NONE, 0, 0, tr); err != nil {
+			return err
+		}
+	}
+}
+
+// previousObjects returns the objects of file in the parent of its commit,
+// or nil if there aren't any. They're only used as delta bases, so errors
+// are ignored.
+func (d *driver) previousObjects(ctx context.Context, file *pfs.File) []*pfs.Object {
+	commitInfo, err := d.inspectCommit(ctx, file.Commit)
+	if err != nil || commitInfo.ParentCommit == nil {
+		return nil
+	}
+	tree, err := d.getTreeForCommit(ctx, commitInfo.ParentCommit)
+	if err != nil {
+		return nil
+	}
+	node, err := tree.Get(file.Path)
+	if err != nil || node.FileNode == nil {
+		return nil
+	}
+	return node.FileNode.Objects
+}
+
+// splitParquet splits the Parquet file in reader along its row groups,
+// calling putSplitFile with each resulting file. Parquet's metadata is at
+// the end of the file, so the file is buffered on disk first.
+func splitParquet(reader io.Reader, targetFileDatums int64, targetFileBytes int64, putSplitFile func(*bytes.Buffer)) (retErr error) {
+	f, err := ioutil.TempFile("", "pachyderm-parquet")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := f.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+		if err := os.Remove(f.Name()); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	size, err := io.Copy(f, reader)
+	if err != nil {
+		return err
+	}
+	parquetFile, err := columnar.OpenParquet(f, size)
+	if err != nil {
+		return err
+	}
+	var datumsWritten int64
+	var bytesWritten int64
+	first := 0
+	for i := 0; i < parquetFile.NumRowGroups(); i++ {
+		rows, rowGroupBytes := parquetFile.RowGroup(i)
+		datumsWritten += rows
+		bytesWritten += rowGroupBytes
+		if (targetFileBytes != 0 && bytesWritten >= targetFileBytes) ||
+			(targetFileDatums != 0 && datumsWritten >= targetFileDatums) ||
+			(targetFileBytes == 0 && targetFileDatums == 0) ||
+			i == parquetFile.NumRowGroups()-1 {
+			buffer := &bytes.Buffer{}
+			if err := parquetFile.WriteRowGroups(buffer, first, i+1); err != nil {
+				return err
+			}
+			putSplitFile(buffer)
+			datumsWritten = 0
+			bytesWritten = 0
+			first = i + 1
+		}
+	}
+	return nil
+}
+
 func (d *driver) getTreeForCommit(ctx context.Context, commit *pfs.Commit) (hashtree.HashTree, error) {
 	if commit == nil {
 		t, err := hashtree.NewHashTree().Finish()
@@ -1412,7 +1890,7 @@ func (d *driver) getTreeForFile(ctx context.Context, file *pfs.File) (hashtree.H
 		return nil, err
 	}
 	// Read everything under the scratch space for this commit
-	resp, err := d.etcdClient.Get(ctx, prefix, etcd.WithPrefix(), etcd.WithSort(etcd.SortByModRevision, etcd.SortAscend))
+	kvs, err := d.store.List(ctx, prefix, true)
 	if err != nil {
 		return nil, err
 	}
@@ -1422,7 +1900,7 @@ func (d *driver) getTreeForFile(ctx context.Context, file *pfs.File) (hashtree.H
 		return nil, err
 	}
 	openTree := parentTree.Open()
-	if err := d.applyWrites(resp, openTree); err != nil {
+	if err := d.applyWrites(kvs, openTree); err != nil {
 		return nil, err
 	}
 	tree, err := openTree.Finish()
@@ -1537,7 +2015,7 @@ func (d *driver) globFile(ctx context.Context, commit *pfs.Commit, pattern strin
 	return fileInfos, nil
 }
 
-func (d *driver) diffFile(ctx context.Context, newFile *pfs.File, oldFile *pfs.File) ([]*pfs.FileInfo, []*pfs.FileInfo, error) {
+func (d *driver) diffFile(ctx context.Context, newFile *pfs.File, oldFile *pfs.File, shallow bool) ([]*pfs.FileInfo, []*pfs.FileInfo, error) {
 	newTree, err := d.getTreeForFile(ctx, newFile)
 	if err != nil {
 		return nil, nil, err
@@ -1560,7 +2038,11 @@ func (d *driver) diffFile(ctx context.Context, newFile *pfs.File, oldFile *pfs.F
 	}
 	var newFileInfos []*pfs.FileInfo
 	var oldFileInfos []*pfs.FileInfo
-	if err := newTree.Diff(oldTree, newFile.Path, oldFile.Path, func(path string, node *hashtree.NodeProto, new bool) error {
+	recursiveDepth := int64(-1)
+	if shallow {
+		recursiveDepth = 1
+	}
+	if err := newTree.Diff(oldTree, newFile.Path, oldFile.Path, recursiveDepth, func(path string, node *hashtree.NodeProto, new bool) error {
 		if new {
 			newFileInfos = append(newFileInfos, nodeToFileInfo(newFile.Commit, path, node, false))
 		} else {
@@ -1583,15 +2065,54 @@ func (d *driver) deleteFile(ctx context.Context, file *pfs.File) error {
 		return pfsserver.ErrCommitFinished{file.Commit}
 	}
 
+	if isGlob(file.Path) {
+		// A glob is recorded as a single write, which deletes whatever
+		// matches it at that point in the commit, under the part of
+		// the pattern that has no wildcards so that reads of that
+		// directory see it.
+		if _, err := path.Match(file.Path, ""); err != nil {
+			return fmt.Errorf("glob %q is malformed", file.Path)
+		}
+		prefix, err := d.scratchFilePrefix(ctx, client.NewFile(file.Commit.Repo.Name, file.Commit.ID, globDir(file.Path)))
+		if err != nil {
+			return err
+		}
+		records := &PutFileRecords{DeleteGlob: file.Path}
+		marshalledRecords, err := records.Marshal()
+		if err != nil {
+			return err
+		}
+		err = d.store.Put(ctx, path.Join(prefix, uuid.NewWithoutDashes()), string(marshalledRecords))
+		return err
+	}
+
 	prefix, err := d.scratchFilePrefix(ctx, file)
 	if err != nil {
 		return err
 	}
 
-	_, err = d.etcdClient.Put(ctx, path.Join(prefix, uuid.NewWithoutDashes()), tombstone)
+	err = d.store.Put(ctx, path.Join(prefix, uuid.NewWithoutDashes()), tombstone)
 	return err
 }
 
+// isGlob returns true if p is a glob pattern rather than a path.
+func isGlob(p string) bool {
+	return strings.ContainsAny(p, `*?[\`)
+}
+
+// globDir returns the directory that everything that matches pattern is
+// under, i.e. its leading components that have no wildcards.
+func globDir(pattern string) string {
+	var dir []string
+	for _, component := range strings.Split(path.Dir(path.Clean("/"+pattern)), "/") {
+		if isGlob(component) {
+			break
+		}
+		dir = append(dir, component)
+	}
+	return path.Join(dir...)
+}
+
 func (d *driver) deleteAll(ctx context.Context) error {
 	repoInfos, err := d.listRepo(ctx, nil)
 	if err != nil {
@@ -1602,13 +2123,247 @@ func (d *driver) deleteAll(ctx context.Context) error {
 			return err
 		}
 	}
+	_, err = d.store.NewSTM(ctx, func(stm col.STM) error {
+		d.projects.ReadWrite(stm).DeleteAll()
+		return nil
+	})
+	return err
+}
+
+// fsck checks the consistency of PFS's metadata, calling cb with each
+// inconsistency it finds. If fix and removeDangling are true, branches whose
+// head doesn't exist are deleted and repos that don't exist are removed from
+// provenance. Both remove metadata, so fix alone doesn't repair them.
+func (d *driver) fsck(ctx context.Context, fix bool, removeDangling bool, cb func(*pfs.FsckResponse) error) error {
+	objClient, err := d.getObjectClient()
+	if err != nil {
+		return err
+	}
+	onError := func(format string, args ...interface{}) error {
+		return cb(&pfs.FsckResponse{Error: fmt.Sprintf(format, args...)})
+	}
+	onFix := func(format string, args ...interface{}) error {
+		return cb(&pfs.FsckResponse{Fix: fmt.Sprintf(format, args...)})
+	}
+	removeDangling = fix && removeDangling
+	// checkedObjects caches whether objects exist, referenced is the set of
+	// objects that are referenced by a commit
+	checkedObjects := make(map[string]bool)
+	referenced := make(map[string]bool)
+	objectExists := func(object *pfs.Object) (bool, error) {
+		referenced[object.Hash] = true
+		exists, ok := checkedObjects[object.Hash]
+		if !ok {
+			resp, err := objClient.ObjectAPIClient.CheckObject(ctx, &pfs.CheckObjectRequest{Object: object})
+			if err != nil {
+				return false, err
+			}
+			exists = resp.Exists
+			checkedObjects[object.Hash] = exists
+		}
+		return exists, nil
+	}
+
+	repoInfos, err := d.listRepo(ctx, nil)
+	if err != nil {
+		return err
+	}
+	repoExists := make(map[string]bool)
+	// commitInfos maps the full IDs of commits to their CommitInfos
+	commitInfos := make(map[string]*pfs.CommitInfo)
+	for _, repoInfo := range repoInfos {
+		repoExists[repoInfo.Repo.Name] = true
+		iterator, err := d.commits(repoInfo.Repo.Name).ReadOnly(ctx).List()
+		if err != nil {
+			return err
+		}
+		for {
+			var commitID string
+			commitInfo := &pfs.CommitInfo{}
+			ok, err := iterator.Next(&commitID, commitInfo)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				break
+			}
+			commitInfos[commitInfo.Commit.FullID()] = commitInfo
+		}
+	}
+
+	for _, repoInfo := range repoInfos {
+		var provenance []*pfs.Repo
+		for _, prov := range repoInfo.Provenance {
+			if repoExists[prov.Name] {
+				provenance = append(provenance, prov)
+				continue
+			}
+			if err := onFix("repo %s has provenance repo %s which doesn't exist, removing it from the provenance", repoInfo.Repo.Name, prov.Name); err != nil {
+				return err
+			}
+		}
+		if removeDangling && len(provenance) != len(repoInfo.Provenance) {
+			if _, err := d.store.NewSTM(ctx, func(stm col.STM) error {
+				repos := d.repos.ReadWrite(stm)
+				repoName := repoInfo.Repo.Name
+				repoInfo := new(pfs.RepoInfo)
+				if err := repos.Get(repoName, repoInfo); err != nil {
+					return err
+				}
+				repoInfo.Provenance = provenance
+				repos.Put(repoName, repoInfo)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+
+		branches, err := d.listBranch(ctx, repoInfo.Repo)
+		if err != nil {
+			return err
+		}
+		for _, branch := range branches {
+			head := &pfs.Commit{Repo: repoInfo.Repo, ID: branch.Head.ID}
+			if _, ok := commitInfos[head.FullID()]; ok {
+				continue
+			}
+			if err := onFix("branch %s/%s has head %s which doesn't exist, deleting the branch", repoInfo.Repo.Name, branch.Name, branch.Head.ID); err != nil {
+				return err
+			}
+			if removeDangling {
+				if err := d.deleteBranch(ctx, repoInfo.Repo, branch.Name); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	for _, commitInfo := range commitInfos {
+		commit := commitInfo.Commit
+		if commitInfo.ParentCommit != nil {
+			if _, ok := commitInfos[commitInfo.ParentCommit.FullID()]; !ok {
+				if err := onError("commit %s has parent %s which doesn't exist", commit.FullID(), commitInfo.ParentCommit.ID); err != nil {
+					return err
+				}
+			}
+		}
+		for _, prov := range commitInfo.Provenance {
+			if _, ok := commitInfos[prov.FullID()]; !ok {
+				if err := onError("commit %s has provenance %s which doesn't exist", commit.FullID(), prov.FullID()); err != nil {
+					return err
+				}
+			}
+		}
+		if commitInfo.Tree == nil {
+			continue
+		}
+		exists, err := objectExists(commitInfo.Tree)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			if err := onError("commit %s has tree %s which doesn't exist", commit.FullID(), commitInfo.Tree.Hash); err != nil {
+				return err
+			}
+			continue
+		}
+		tree, err := d.getTreeForCommit(ctx, commit)
+		if err != nil {
+			return err
+		}
+		if err := tree.Walk(func(path string, node *hashtree.NodeProto) error {
+			if node.FileNode == nil {
+				return nil
+			}
+			for _, object := range node.FileNode.Objects {
+				exists, err := objectExists(object)
+				if err != nil {
+					return err
+				}
+				if !exists {
+					if err := onError("file %s:%s references object %s which doesn't exist", commit.FullID(), path, object.Hash); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	// Objects that are only referenced by tags (e.g. the worker's datum
+	// hashtrees and the objects in them), by files being written to open
+	// commits or by running jobs (their datum checkpoints and the logs
+	// that haven't been committed yet) aren't orphaned.
+	inUse := make(map[string]*pfs.Object)
+	if err := d.addScratchObjects(ctx, d.scratchPrefix(), inUse); err != nil {
+		return err
+	}
+	treeTagPrefixes, err := d.addPPSObjects(ctx, objClient, inUse)
+	if err != nil {
+		return err
+	}
+	if err := addTagObjects(ctx, objClient, treeTagPrefixes, inUse); err != nil {
+		return err
+	}
+	for hash := range inUse {
+		referenced[hash] = true
+	}
+	// Objects that referenced objects are stored as deltas against are
+	// needed to read them.
+	var referencedObjects []*pfs.Object
+	for hash := range referenced {
+		referencedObjects = append(referencedObjects, &pfs.Object{Hash: hash})
+	}
+	bases, err := pfsserver.DeltaBases(ctx, objClient.ObjectAPIClient, referencedObjects)
+	if err != nil {
+		return err
+	}
+	for _, base := range bases {
+		referenced[base.Hash] = true
+	}
+	objects, err := objClient.ObjectAPIClient.ListObjects(ctx, &pfs.ListObjectsRequest{})
+	if err != nil {
+		return err
+	}
+	for {
+		object, err := objects.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if !referenced[object.Hash] {
+			if err := cb(&pfs.FsckResponse{OrphanedObject: object}); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
-func (d *driver) applyWrites(resp *etcd.GetResponse, tree hashtree.OpenHashTree) error {
-	for _, kv := range resp.Kvs {
+// deleteGlob deletes everything in tree that matches pattern.
+func deleteGlob(tree hashtree.OpenHashTree, pattern string) error {
+	nodes, err := tree.Glob(pattern)
+	if err != nil {
+		return err
+	}
+	for _, node := range nodes {
+		// Matches under a directory that's already been deleted are
+		// gone.
+		if err := tree.DeleteFile(node.Name); err != nil && hashtree.Code(err) != hashtree.PathNotFound {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *driver) applyWrites(kvs []*col.KV, tree hashtree.OpenHashTree) error {
+	for _, kv := range kvs {
 		// fileStr is going to look like "some/path/UUID"
-		fileStr := d.filePathFromEtcdPath(string(kv.Key))
+		fileStr := d.filePathFromEtcdPath(kv.Key)
 		// the last element of `parts` is going to be UUID
 		parts := strings.Split(fileStr, "/")
 		// filePath should look like "some/path"
@@ -1627,11 +2382,23 @@ func (d *driver) applyWrites(resp *etcd.GetResponse, tree hashtree.OpenHashTree)
 			if err := records.Unmarshal(kv.Value); err != nil {
 				return err
 			}
+			if records.DeleteGlob != "" {
+				if err := deleteGlob(tree, records.DeleteGlob); err != nil {
+					return err
+				}
+				continue
+			}
 			if !records.Split {
-				if len(records.Records) != 1 {
-					return fmt.Errorf("unexpect %d length PutFileRecord (this is likely a bug)", len(records.Records))
+				if len(records.Records) == 0 {
+					return fmt.Errorf("unexpected empty PutFileRecords (this is likely a bug)")
+				}
+				var objects []*pfs.Object
+				var size int64
+				for _, record := range records.Records {
+					objects = append(objects, &pfs.Object{Hash: record.ObjectHash})
+					size += record.SizeBytes
 				}
-				if err := tree.PutFile(filePath, []*pfs.Object{{Hash: records.Records[0].ObjectHash}}, records.Records[0].SizeBytes); err != nil {
+				if err := tree.PutFile(filePath, objects, size); err != nil {
 					return err
 				}
 			} else {
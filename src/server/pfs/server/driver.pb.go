@@ -58,6 +58,9 @@ func (m *PutFileRecord) GetObjectHash() string {
 type PutFileRecords struct {
 	Split   bool             `protobuf:"varint,1,opt,name=split,proto3" json:"split,omitempty"`
 	Records []*PutFileRecord `protobuf:"bytes,2,rep,name=records" json:"records,omitempty"`
+	// DeleteGlob is set, instead of records, by a DeleteFile with a glob
+	// pattern. Everything that matches it when it's applied is deleted.
+	DeleteGlob string `protobuf:"bytes,3,opt,name=delete_glob,json=deleteGlob,proto3" json:"delete_glob,omitempty"`
 }
 
 func (m *PutFileRecords) Reset()                    { *m = PutFileRecords{} }
@@ -79,6 +82,13 @@ func (m *PutFileRecords) GetRecords() []*PutFileRecord {
 	return nil
 }
 
+func (m *PutFileRecords) GetDeleteGlob() string {
+	if m != nil {
+		return m.DeleteGlob
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*PutFileRecord)(nil), "server.PutFileRecord")
 	proto.RegisterType((*PutFileRecords)(nil), "server.PutFileRecords")
@@ -149,6 +159,12 @@ func (m *PutFileRecords) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.DeleteGlob) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintDriver(dAtA, i, uint64(len(m.DeleteGlob)))
+		i += copy(dAtA[i:], m.DeleteGlob)
+	}
 	return i, nil
 }
 
@@ -204,6 +220,10 @@ func (m *PutFileRecords) Size() (n int) {
 			n += 1 + l + sovDriver(uint64(l))
 		}
 	}
+	l = len(m.DeleteGlob)
+	if l > 0 {
+		n += 1 + l + sovDriver(uint64(l))
+	}
 	return n
 }
 
@@ -398,6 +418,35 @@ func (m *PutFileRecords) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteGlob", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDriver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDriver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeleteGlob = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDriver(dAtA[iNdEx:])
@@ -527,17 +576,19 @@ var (
 func init() { proto.RegisterFile("server/pfs/server/driver.proto", fileDescriptorDriver) }
 
 var fileDescriptorDriver = []byte{
-	// 192 bytes of a gzipped FileDescriptorProto
+	// 214 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2b, 0x4e, 0x2d, 0x2a,
 	0x4b, 0x2d, 0xd2, 0x2f, 0x48, 0x2b, 0xd6, 0x87, 0x32, 0x53, 0x8a, 0x32, 0xcb, 0x52, 0x8b, 0xf4,
 	0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xd8, 0x20, 0x82, 0x4a, 0x7e, 0x5c, 0xbc, 0x01, 0xa5, 0x25,
 	0x6e, 0x99, 0x39, 0xa9, 0x41, 0xa9, 0xc9, 0xf9, 0x45, 0x29, 0x42, 0xb2, 0x5c, 0x5c, 0xc5, 0x99,
 	0x55, 0xa9, 0xf1, 0x49, 0x95, 0x25, 0xa9, 0xc5, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xcc, 0x41, 0x9c,
 	0x20, 0x11, 0x27, 0x90, 0x80, 0x90, 0x1c, 0x17, 0x57, 0x7e, 0x52, 0x56, 0x6a, 0x72, 0x89, 0x47,
-	0x62, 0x71, 0x86, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x67, 0x10, 0x92, 0x88, 0x52, 0x38, 0x17, 0x1f,
+	0x62, 0x71, 0x86, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x67, 0x10, 0x92, 0x88, 0x52, 0x05, 0x17, 0x1f,
 	0x8a, 0x79, 0xc5, 0x42, 0x22, 0x5c, 0xac, 0xc5, 0x05, 0x39, 0x99, 0x25, 0x60, 0xb3, 0x38, 0x82,
 	0x20, 0x1c, 0x21, 0x7d, 0x2e, 0xf6, 0x22, 0x88, 0x02, 0x09, 0x26, 0x05, 0x66, 0x0d, 0x6e, 0x23,
-	0x51, 0x3d, 0x88, 0x8b, 0xf4, 0x50, 0xb4, 0x07, 0xc1, 0x54, 0x39, 0x09, 0x9c, 0x78, 0x24, 0xc7,
-	0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x33, 0x1e, 0xcb, 0x31, 0x24, 0xb1, 0x81,
-	0x7d, 0x62, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xda, 0xf7, 0x8b, 0x53, 0xeb, 0x00, 0x00, 0x00,
+	0x51, 0x3d, 0x88, 0x8b, 0xf4, 0x50, 0xb4, 0x07, 0xc1, 0x54, 0x09, 0xc9, 0x73, 0x71, 0xa7, 0xa4,
+	0xe6, 0xa4, 0x96, 0xa4, 0xc6, 0xa7, 0xe7, 0xe4, 0x27, 0x49, 0x30, 0x43, 0x6c, 0x86, 0x08, 0xb9,
+	0xe7, 0xe4, 0x27, 0x39, 0x09, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47,
+	0x72, 0x8c, 0x33, 0x1e, 0xcb, 0x31, 0x24, 0xb1, 0x81, 0xbd, 0x6a, 0x0c, 0x18, 0x00, 0x06, 0xd0,
+	0xa6, 0x66, 0x0c, 0x01, 0x00, 0x00,
 }
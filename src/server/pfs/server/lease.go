@@ -0,0 +1,276 @@
+package server
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/uuid"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+
+	"github.com/gogo/protobuf/types"
+	"golang.org/x/net/context"
+)
+
+// defaultLeaseTTL is how long a lease lasts if its TTL isn't set.
+const defaultLeaseTTL = time.Minute
+
+// AcquireLease implements the protobuf pfs.AcquireLease RPC
+func (a *apiServer) AcquireLease(ctx context.Context, request *pfs.AcquireLeaseRequest) (response *pfs.Lease, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	ttl, err := leaseTTL(request.TTL)
+	if err != nil {
+		return nil, err
+	}
+	return a.driver.acquireLease(ctx, request.File, request.Owner, ttl)
+}
+
+// RenewLease implements the protobuf pfs.RenewLease RPC
+func (a *apiServer) RenewLease(ctx context.Context, request *pfs.RenewLeaseRequest) (response *pfs.Lease, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	ttl, err := leaseTTL(request.TTL)
+	if err != nil {
+		return nil, err
+	}
+	return a.driver.renewLease(ctx, request.Lease, ttl)
+}
+
+// ReleaseLease implements the protobuf pfs.ReleaseLease RPC
+func (a *apiServer) ReleaseLease(ctx context.Context, request *pfs.Lease) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	if err := a.driver.releaseLease(ctx, request); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// ListLease implements the protobuf pfs.ListLease RPC
+func (a *apiServer) ListLease(ctx context.Context, request *pfs.ListLeaseRequest) (response *pfs.Leases, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	leases, err := a.driver.listLease(ctx, request.Commit)
+	if err != nil {
+		return nil, err
+	}
+	return &pfs.Leases{Lease: leases}, nil
+}
+
+func leaseTTL(ttl *types.Duration) (time.Duration, error) {
+	if ttl == nil {
+		return defaultLeaseTTL, nil
+	}
+	result, err := types.DurationFromProto(ttl)
+	if err != nil {
+		return 0, err
+	}
+	if result <= 0 {
+		return 0, fmt.Errorf("lease TTL must be positive, got %v", result)
+	}
+	return result, nil
+}
+
+// acquireLease leases file, which must be in an open commit, to owner for
+// ttl.
+func (d *driver) acquireLease(ctx context.Context, file *pfs.File, owner string, ttl time.Duration) (*pfs.Lease, error) {
+	if owner == "" {
+		return nil, fmt.Errorf("a lease's owner must be set")
+	}
+	if err := checkPath(file.Path); err != nil {
+		return nil, err
+	}
+	commit, err := d.openCommit(ctx, file.Commit)
+	if err != nil {
+		return nil, err
+	}
+	expires, err := types.TimestampProto(time.Now().Add(ttl))
+	if err != nil {
+		return nil, err
+	}
+	lease := &pfs.Lease{
+		ID:      uuid.NewWithoutDashes(),
+		File:    client.NewFile(commit.Repo.Name, commit.ID, leasePath(file.Path)),
+		Owner:   owner,
+		Expires: expires,
+	}
+	if _, err := d.store.NewSTM(ctx, func(stm col.STM) error {
+		return d.updateLeases(stm, commit, func(leases []*pfs.Lease) ([]*pfs.Lease, error) {
+			for _, other := range leases {
+				if other.Owner != owner && leasesOverlap(other.File.Path, lease.File.Path) {
+					return nil, fmt.Errorf("%s is leased by %s", other.File.Path, other.Owner)
+				}
+			}
+			return append(leases, lease), nil
+		})
+	}); err != nil {
+		return nil, err
+	}
+	return lease, nil
+}
+
+// renewLease makes lease, which mustn't have expired, last for ttl from now.
+func (d *driver) renewLease(ctx context.Context, lease *pfs.Lease, ttl time.Duration) (*pfs.Lease, error) {
+	if lease == nil || lease.File == nil {
+		return nil, fmt.Errorf("a lease must be specified")
+	}
+	commit, err := d.openCommit(ctx, lease.File.Commit)
+	if err != nil {
+		return nil, err
+	}
+	expires, err := types.TimestampProto(time.Now().Add(ttl))
+	if err != nil {
+		return nil, err
+	}
+	var result *pfs.Lease
+	if _, err := d.store.NewSTM(ctx, func(stm col.STM) error {
+		return d.updateLeases(stm, commit, func(leases []*pfs.Lease) ([]*pfs.Lease, error) {
+			for _, other := range leases {
+				if other.ID == lease.ID {
+					other.Expires = expires
+					result = other
+					return leases, nil
+				}
+			}
+			return nil, fmt.Errorf("lease %s has expired or been released", lease.ID)
+		})
+	}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// releaseLease releases lease, releasing a lease that has expired or been
+// released already isn't an error.
+func (d *driver) releaseLease(ctx context.Context, lease *pfs.Lease) error {
+	if lease.File == nil {
+		return fmt.Errorf("a lease must be specified")
+	}
+	commit, err := d.openCommit(ctx, lease.File.Commit)
+	if err != nil {
+		return err
+	}
+	_, err = d.store.NewSTM(ctx, func(stm col.STM) error {
+		return d.updateLeases(stm, commit, func(leases []*pfs.Lease) ([]*pfs.Lease, error) {
+			var result []*pfs.Lease
+			for _, other := range leases {
+				if other.ID != lease.ID {
+					result = append(result, other)
+				}
+			}
+			return result, nil
+		})
+	})
+	return err
+}
+
+// listLease returns the leases in commit that haven't expired.
+func (d *driver) listLease(ctx context.Context, commit *pfs.Commit) ([]*pfs.Lease, error) {
+	commit, err := d.openCommit(ctx, commit)
+	if err != nil {
+		return nil, err
+	}
+	leases := &pfs.Leases{}
+	if err := d.leases(commit.Repo.Name).ReadOnly(ctx).Get(commit.ID, leases); err != nil {
+		if _, ok := err.(col.ErrNotFound); ok {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return unexpiredLeases(leases.Lease)
+}
+
+// updateLeases replaces the unexpired leases in commit with the result of
+// f. The commit's leases are deleted if there are none left.
+func (d *driver) updateLeases(stm col.STM, commit *pfs.Commit, f func([]*pfs.Lease) ([]*pfs.Lease, error)) error {
+	leasesCol := d.leases(commit.Repo.Name).ReadWrite(stm)
+	leases := &pfs.Leases{}
+	if err := leasesCol.Get(commit.ID, leases); err != nil {
+		if _, ok := err.(col.ErrNotFound); !ok {
+			return err
+		}
+	}
+	current, err := unexpiredLeases(leases.Lease)
+	if err != nil {
+		return err
+	}
+	result, err := f(current)
+	if err != nil {
+		return err
+	}
+	if len(result) == 0 {
+		if len(leases.Lease) > 0 {
+			return leasesCol.Delete(commit.ID)
+		}
+		return nil
+	}
+	leasesCol.Put(commit.ID, &pfs.Leases{Lease: result})
+	return nil
+}
+
+// deleteLeases deletes the leases in commit, which happens when it's
+// finished or deleted.
+func (d *driver) deleteLeases(ctx context.Context, commit *pfs.Commit) error {
+	_, err := d.store.NewSTM(ctx, func(stm col.STM) error {
+		if err := d.leases(commit.Repo.Name).ReadWrite(stm).Delete(commit.ID); err != nil {
+			if _, ok := err.(col.ErrNotFound); !ok {
+				return err
+			}
+		}
+		return nil
+	})
+	return err
+}
+
+// openCommit returns commit, with its ID resolved if it's a branch, if it
+// exists and hasn't been finished.
+func (d *driver) openCommit(ctx context.Context, commit *pfs.Commit) (*pfs.Commit, error) {
+	if commit == nil {
+		return nil, fmt.Errorf("a commit must be specified")
+	}
+	commitInfo, err := d.inspectCommit(ctx, commit)
+	if err != nil {
+		return nil, err
+	}
+	if commitInfo.Finished != nil {
+		return nil, fmt.Errorf("commit %s has already been finished", commitInfo.Commit.ID)
+	}
+	return commitInfo.Commit, nil
+}
+
+func unexpiredLeases(leases []*pfs.Lease) ([]*pfs.Lease, error) {
+	var result []*pfs.Lease
+	for _, lease := range leases {
+		expires, err := types.TimestampFromProto(lease.Expires)
+		if err != nil {
+			return nil, err
+		}
+		if time.Now().Before(expires) {
+			result = append(result, lease)
+		}
+	}
+	return result, nil
+}
+
+// leasePath returns p with a leading slash and no trailing slash, "/" for
+// the root.
+func leasePath(p string) string {
+	return path.Clean("/" + p)
+}
+
+// leasesOverlap returns true if a and b are the same path, or one is a
+// directory above the other.
+func leasesOverlap(a string, b string) bool {
+	under := func(p string, dir string) bool {
+		return dir == "/" || p == dir || strings.HasPrefix(p, dir+"/")
+	}
+	return under(a, b) || under(b, a)
+}
@@ -6,8 +6,10 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/gogo/protobuf/types"
@@ -110,7 +112,7 @@ func (s *localBlockAPIServer) GetObjects(request *pfsclient.GetObjectsRequest, g
 		if err != nil {
 			return err
 		}
-		if fileInfo.Size() < offsetBytes {
+		if fileInfo.Size() <= offsetBytes {
 			offsetBytes -= fileInfo.Size()
 			continue
 		}
@@ -174,10 +176,34 @@ func (s *localBlockAPIServer) InspectObject(ctx context.Context, request *pfscli
 	}, nil
 }
 
+func (s *localBlockAPIServer) InspectObjects(ctx context.Context, request *pfsclient.InspectObjectsRequest) (response *pfsclient.InspectObjectsResponse, retErr error) {
+	func() { s.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { s.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	response = &pfsclient.InspectObjectsResponse{}
+	for _, object := range request.Objects {
+		fileInfo, err := os.Stat(s.objectPath(object))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		response.ObjectInfo = append(response.ObjectInfo, &pfsclient.ObjectInfo{
+			Object: object,
+			BlockRef: &pfsclient.BlockRef{
+				Range: &pfsclient.ByteRange{
+					Upper: uint64(fileInfo.Size()),
+				},
+			},
+		})
+	}
+	return response, nil
+}
+
 func (s *localBlockAPIServer) CheckObject(ctx context.Context, request *pfsclient.CheckObjectRequest) (response *pfsclient.CheckObjectResponse, retErr error) {
 	func() { s.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { s.Log(request, response, retErr, time.Since(start)) }(time.Now())
-	_, err := os.Stat(s.objectPath(request.Object))
+	fileInfo, err := os.Stat(s.objectPath(request.Object))
 	if err != nil {
 		if os.IsNotExist(err) {
 			return &pfsclient.CheckObjectResponse{
@@ -186,17 +212,70 @@ func (s *localBlockAPIServer) CheckObject(ctx context.Context, request *pfsclien
 		}
 		return nil, err
 	}
-	return &pfsclient.CheckObjectResponse{
+	response = &pfsclient.CheckObjectResponse{
 		Exists: true,
-	}, nil
+	}
+	if request.IncludeModified {
+		response.Modified, err = types.TimestampProto(fileInfo.ModTime())
+		if err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
 }
 
 func (s *localBlockAPIServer) ListObjects(request *pfsclient.ListObjectsRequest, listObjectsServer pfsclient.ObjectAPI_ListObjectsServer) (retErr error) {
-	return errors.New("TODO")
+	func() { s.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { s.Log(request, nil, retErr, time.Since(start)) }(time.Now())
+	fileInfos, err := ioutil.ReadDir(s.objectDir())
+	if err != nil {
+		return err
+	}
+	// Objects that are still being written live in the object dir under a
+	// temporary name until they're renamed to their hash.
+	hashLen := hex.EncodedLen(newHash().Size())
+	for _, fileInfo := range fileInfos {
+		if len(fileInfo.Name()) != hashLen {
+			continue
+		}
+		if err := listObjectsServer.Send(&pfsclient.Object{Hash: fileInfo.Name()}); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (s *localBlockAPIServer) ListTags(request *pfsclient.ListTagsRequest, server pfsclient.ObjectAPI_ListTagsServer) (retErr error) {
-	return errors.New("TODO")
+	func() { s.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { s.Log(request, nil, retErr, time.Since(start)) }(time.Now())
+	fileInfos, err := ioutil.ReadDir(s.tagDir())
+	if err != nil {
+		return err
+	}
+	for _, fileInfo := range fileInfos {
+		tag := fileInfo.Name()
+		if !strings.HasPrefix(tag, request.Prefix) {
+			continue
+		}
+		if request.IncludeObject {
+			objectPath, err := os.Readlink(s.tagPath(&pfsclient.Tag{Name: tag}))
+			if err != nil {
+				return err
+			}
+			if err := server.Send(&pfsclient.ListTagsResponse{
+				Tag:    tag,
+				Object: &pfsclient.Object{Hash: filepath.Base(objectPath)},
+			}); err != nil {
+				return err
+			}
+		}
+		if err := server.Send(&pfsclient.ListTagsResponse{
+			Tag: tag,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (s *localBlockAPIServer) DeleteTags(ctx context.Context, request *pfsclient.DeleteTagsRequest) (response *pfsclient.DeleteTagsResponse, retErr error) {
@@ -232,6 +311,53 @@ func (s *localBlockAPIServer) InspectTag(ctx context.Context, request *pfsclient
 	return s.InspectObject(ctx, &pfsclient.Object{Hash: filepath.Base(objectPath)})
 }
 
+// DebugObject reports on an object, which is stored in a file of its own
+// rather than in a block, so its BlockRef only has a range.
+func (s *localBlockAPIServer) DebugObject(request *pfsclient.DebugObjectRequest, server pfsclient.ObjectAPI_DebugObjectServer) (retErr error) {
+	func() { s.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { s.Log(request, nil, retErr, time.Since(start)) }(time.Now())
+	resp := &pfsclient.DebugObjectResponse{}
+	fileInfo, err := os.Stat(s.objectPath(request.Object))
+	if err != nil {
+		if os.IsNotExist(err) {
+			resp.Error = fmt.Sprintf("object %s not found", request.Object.Hash)
+		} else {
+			resp.Error = err.Error()
+		}
+		return server.Send(resp)
+	}
+	resp.BlockRef = &pfsclient.BlockRef{
+		Range: &pfsclient.ByteRange{
+			Upper: uint64(fileInfo.Size()),
+		},
+	}
+	resp.BlockExists = true
+	if !request.Verify && !request.IncludeContent {
+		return server.Send(resp)
+	}
+	data, err := ioutil.ReadFile(s.objectPath(request.Object))
+	if err != nil {
+		resp.Error = err.Error()
+		return server.Send(resp)
+	}
+	hash := newHash()
+	hash.Write(data)
+	resp.Hash = hex.EncodeToString(hash.Sum(nil))
+	resp.SizeBytes = uint64(len(data))
+	if err := server.Send(resp); err != nil {
+		return err
+	}
+	if !request.IncludeContent {
+		return nil
+	}
+	for _, chunk := range grpcutil.Chunk(data, grpcutil.MaxMsgSize/2) {
+		if err := server.Send(&pfsclient.DebugObjectResponse{Value: chunk}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *localBlockAPIServer) Compact(ctx context.Context, request *types.Empty) (response *types.Empty, retErr error) {
 	return &types.Empty{}, nil
 }
@@ -269,24 +395,46 @@ func (s *localBlockAPIServer) indexPath(prefix string) string {
 }
 
 type putObjectReader struct {
-	server pfsclient.ObjectAPI_PutObjectServer
-	buffer bytes.Buffer
-	tags   []*pfsclient.Tag
+	server    pfsclient.ObjectAPI_PutObjectServer
+	buffer    bytes.Buffer
+	tags      []*pfsclient.Tag
+	deltaBase *pfsclient.Object
+	started   bool
 }
 
 func (r *putObjectReader) Read(p []byte) (int, error) {
 	if r.buffer.Len() == 0 {
-		request, err := r.server.Recv()
-		if err != nil {
+		if err := r.recv(); err != nil {
 			return 0, err
 		}
-		// buffer.Write cannot error
-		r.buffer.Write(request.Value)
-		r.tags = append(r.tags, request.Tags...)
 	}
 	return r.buffer.Read(p)
 }
 
+// start receives the first request, if it hasn't been received, so that
+// deltaBase is set.
+func (r *putObjectReader) start() error {
+	if r.started {
+		return nil
+	}
+	return r.recv()
+}
+
+func (r *putObjectReader) recv() error {
+	request, err := r.server.Recv()
+	if err != nil {
+		return err
+	}
+	if !r.started {
+		r.deltaBase = request.DeltaBase
+		r.started = true
+	}
+	// buffer.Write cannot error
+	r.buffer.Write(request.Value)
+	r.tags = append(r.tags, request.Tags...)
+	return nil
+}
+
 func drainObjectServer(putObjectServer pfsclient.ObjectAPI_PutObjectServer) {
 	for {
 		if _, err := putObjectServer.Recv(); err != nil {
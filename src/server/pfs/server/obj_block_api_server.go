@@ -1,6 +1,7 @@
 package server
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
 	"io"
@@ -16,6 +17,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 	"github.com/golang/groupcache"
+	"github.com/prometheus/client_golang/prometheus"
 	protolion "go.pedge.io/lion"
 	protorpclog "go.pedge.io/proto/rpclog"
 	"golang.org/x/net/context"
@@ -27,6 +29,9 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
 	"github.com/pachyderm/pachyderm/src/client/pkg/uuid"
 	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
+	"github.com/pachyderm/pachyderm/src/server/pkg/delta"
+	"github.com/pachyderm/pachyderm/src/server/pkg/diskcache"
+	"github.com/pachyderm/pachyderm/src/server/pkg/dlock"
 	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
 	"github.com/pachyderm/pachyderm/src/server/pkg/watch"
 )
@@ -39,6 +44,15 @@ const (
 	objectInfoCacheShares = 1
 	maxCachedObjectDenom  = 4                // We will only cache objects less than 1/maxCachedObjectDenom of total cache size
 	bufferSize            = 15 * 1024 * 1024 // 15 MB
+	// objectIndexLockKey is the etcd key of the lock on the object indexes,
+	// see lockIndexes.
+	objectIndexLockKey = "pfs-object-index-lock"
+	// maxDeltaBytes is the size of the biggest object that's stored as a
+	// delta.
+	maxDeltaBytes = 64 * 1024 * 1024
+	// maxDeltaDepth is the longest chain of deltas that an object can be
+	// stored as, after which objects are stored in full again.
+	maxDeltaDepth = 10
 )
 
 type objBlockAPIServer struct {
@@ -53,6 +67,9 @@ type objBlockAPIServer struct {
 	objectInfoCache *groupcache.Group
 	// The total number of bytes cached for objects
 	objectCacheBytes int64
+	// diskCache, if it's set, caches objects on local disk, including ones
+	// that are too big for objectCache.
+	diskCache *diskcache.Cache
 	// The GC generation number.  Incrementing this number effectively
 	// invalidates all current cache.
 	generation int
@@ -60,9 +77,15 @@ type objBlockAPIServer struct {
 
 	objectIndexes     map[string]*pfsclient.ObjectIndex
 	objectIndexesLock sync.RWMutex
+
+	// etcdClient is used to lock the object indexes, it's created when
+	// they're first locked.
+	etcdAddress  string
+	etcdClient   *etcd.Client
+	etcdClientMu sync.Mutex
 }
 
-func newObjBlockAPIServer(dir string, cacheBytes int64, etcdAddress string, objClient obj.Client) (*objBlockAPIServer, error) {
+func newObjBlockAPIServer(dir string, cacheBytes int64, diskCache *diskcache.Cache, etcdAddress string, objClient obj.Client) (*objBlockAPIServer, error) {
 	// defensive mesaure incase IsNotExist checking breaks due to underlying changes
 	if err := obj.TestIsNotExist(objClient); err != nil {
 		return nil, err
@@ -79,6 +102,8 @@ func newObjBlockAPIServer(dir string, cacheBytes int64, etcdAddress string, objC
 		objClient:        objClient,
 		objectIndexes:    make(map[string]*pfsclient.ObjectIndex),
 		objectCacheBytes: oneCacheShare * objectCacheShares,
+		diskCache:        diskCache,
+		etcdAddress:      etcdAddress,
 	}
 	s.objectCache = groupcache.NewGroup("object", oneCacheShare*objectCacheShares, groupcache.GetterFunc(s.objectGetter))
 	s.tagCache = groupcache.NewGroup("tag", oneCacheShare*tagCacheShares, groupcache.GetterFunc(s.tagGetter))
@@ -92,8 +117,16 @@ func newObjBlockAPIServer(dir string, cacheBytes int64, etcdAddress string, objC
 			protolion.Infof("objectCache stats: %+v", s.objectCache.Stats)
 			protolion.Infof("tagCache stats: %+v", s.tagCache.Stats)
 			protolion.Infof("objectInfoCache stats: %+v", s.objectInfoCache.Stats)
+			if s.diskCache != nil {
+				protolion.Infof("diskCache stats: %+v", s.diskCache.Stats())
+			}
 		}
 	}()
+	if err := prometheus.Register(&cacheCollector{s}); err != nil {
+		// This only happens when there's more than one server in a process,
+		// e.g. in tests.
+		protolion.Errorf("error registering cache metrics: %v", err)
+	}
 	go s.watchGC(etcdAddress)
 	return s, nil
 }
@@ -150,36 +183,70 @@ func (s *objBlockAPIServer) getGeneration() int {
 	return s.generation
 }
 
-func newMinioBlockAPIServer(dir string, cacheBytes int64, etcdAddress string) (*objBlockAPIServer, error) {
-	objClient, err := obj.NewMinioClientFromSecret("")
+func newMinioBlockAPIServer(dir string, cacheBytes int64, diskCache *diskcache.Cache, etcdAddress string) (*objBlockAPIServer, error) {
+	objClient, err := obj.NewReloadingClient("/minio-secret", func() (obj.Client, error) {
+		return obj.NewMinioClientFromSecret("")
+	})
 	if err != nil {
 		return nil, err
 	}
-	return newObjBlockAPIServer(dir, cacheBytes, etcdAddress, objClient)
+	return newObjBlockAPIServer(dir, cacheBytes, diskCache, etcdAddress, objClient)
 }
 
-func newAmazonBlockAPIServer(dir string, cacheBytes int64, etcdAddress string) (*objBlockAPIServer, error) {
-	objClient, err := obj.NewAmazonClientFromSecret("")
+func newAmazonBlockAPIServer(dir string, cacheBytes int64, diskCache *diskcache.Cache, etcdAddress string) (*objBlockAPIServer, error) {
+	objClient, err := obj.NewReloadingClient("/amazon-secret", func() (obj.Client, error) {
+		return obj.NewAmazonClientFromSecret("")
+	})
 	if err != nil {
 		return nil, err
 	}
-	return newObjBlockAPIServer(dir, cacheBytes, etcdAddress, objClient)
+	return newObjBlockAPIServer(dir, cacheBytes, diskCache, etcdAddress, objClient)
 }
 
-func newGoogleBlockAPIServer(dir string, cacheBytes int64, etcdAddress string) (*objBlockAPIServer, error) {
+func newGoogleBlockAPIServer(dir string, cacheBytes int64, diskCache *diskcache.Cache, etcdAddress string) (*objBlockAPIServer, error) {
 	objClient, err := obj.NewGoogleClientFromSecret(context.Background(), "")
 	if err != nil {
 		return nil, err
 	}
-	return newObjBlockAPIServer(dir, cacheBytes, etcdAddress, objClient)
+	return newObjBlockAPIServer(dir, cacheBytes, diskCache, etcdAddress, objClient)
+}
+
+func newMicrosoftBlockAPIServer(dir string, cacheBytes int64, diskCache *diskcache.Cache, etcdAddress string) (*objBlockAPIServer, error) {
+	objClient, err := obj.NewReloadingClient("/microsoft-secret", func() (obj.Client, error) {
+		return obj.NewMicrosoftClientFromSecret("")
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newObjBlockAPIServer(dir, cacheBytes, diskCache, etcdAddress, objClient)
+}
+
+func newSwiftBlockAPIServer(dir string, cacheBytes int64, diskCache *diskcache.Cache, etcdAddress string) (*objBlockAPIServer, error) {
+	objClient, err := obj.NewReloadingClient("/swift-secret", func() (obj.Client, error) {
+		return obj.NewSwiftClientFromSecret("")
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newObjBlockAPIServer(dir, cacheBytes, diskCache, etcdAddress, objClient)
+}
+
+func newAlibabaBlockAPIServer(dir string, cacheBytes int64, diskCache *diskcache.Cache, etcdAddress string) (*objBlockAPIServer, error) {
+	objClient, err := obj.NewReloadingClient("/alibaba-secret", func() (obj.Client, error) {
+		return obj.NewAlibabaClientFromSecret("")
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newObjBlockAPIServer(dir, cacheBytes, diskCache, etcdAddress, objClient)
 }
 
-func newMicrosoftBlockAPIServer(dir string, cacheBytes int64, etcdAddress string) (*objBlockAPIServer, error) {
-	objClient, err := obj.NewMicrosoftClientFromSecret("")
+func newPosixBlockAPIServer(dir string, cacheBytes int64, diskCache *diskcache.Cache, etcdAddress string) (*objBlockAPIServer, error) {
+	objClient, err := obj.NewLocalClient(PosixStorageDir)
 	if err != nil {
 		return nil, err
 	}
-	return newObjBlockAPIServer(dir, cacheBytes, etcdAddress, objClient)
+	return newObjBlockAPIServer(dir, cacheBytes, diskCache, etcdAddress, objClient)
 }
 
 func (s *objBlockAPIServer) PutObject(server pfsclient.ObjectAPI_PutObjectServer) (retErr error) {
@@ -190,7 +257,23 @@ func (s *objBlockAPIServer) PutObject(server pfsclient.ObjectAPI_PutObjectServer
 	putObjectReader := &putObjectReader{
 		server: server,
 	}
-	r := io.TeeReader(putObjectReader, hash)
+	if err := putObjectReader.start(); err != nil && err != io.EOF {
+		return err
+	}
+	var r io.Reader = putObjectReader
+	if putObjectReader.deltaBase != nil {
+		// Objects with a delta base are read into memory, so that they can
+		// be compared to it, unless they're too big for that.
+		content, err := ioutil.ReadAll(io.LimitReader(putObjectReader, maxDeltaBytes+1))
+		if err != nil {
+			return err
+		}
+		if len(content) <= maxDeltaBytes {
+			return s.putObjectDelta(server, content, putObjectReader.deltaBase, putObjectReader.tags)
+		}
+		r = io.MultiReader(bytes.NewReader(content), putObjectReader)
+	}
+	r = io.TeeReader(r, hash)
 	block := &pfsclient.Block{Hash: uuid.NewWithoutDashes()}
 	var size int64
 	if err := func() (retErr error) {
@@ -219,7 +302,7 @@ func (s *objBlockAPIServer) PutObject(server pfsclient.ObjectAPI_PutObjectServer
 	}
 	var eg errgroup.Group
 	// Now that we have a hash of the object we can check if it already exists.
-	resp, err := s.CheckObject(server.Context(), &pfsclient.CheckObjectRequest{object})
+	resp, err := s.CheckObject(server.Context(), &pfsclient.CheckObjectRequest{Object: object})
 	if err != nil {
 		return err
 	}
@@ -250,6 +333,56 @@ func (s *objBlockAPIServer) PutObject(server pfsclient.ObjectAPI_PutObjectServer
 	return eg.Wait()
 }
 
+// putObjectDelta puts an object that's been read into memory, storing it as
+// a delta against base if that's much smaller.
+func (s *objBlockAPIServer) putObjectDelta(server pfsclient.ObjectAPI_PutObjectServer, content []byte, base *pfsclient.Object, tags []*pfsclient.Tag) error {
+	hash := newHash()
+	hash.Write(content)
+	object := &pfsclient.Object{Hash: hex.EncodeToString(hash.Sum(nil))}
+	// The object is only acknowledged once its block and index entry are
+	// written, so that the client never gets the hash of an object that
+	// doesn't exist, and sees the error if they can't be written.
+	resp, err := s.CheckObject(server.Context(), &pfsclient.CheckObjectRequest{Object: object})
+	if err != nil {
+		return err
+	}
+	var eg errgroup.Group
+	if !resp.Exists {
+		eg.Go(func() error {
+			blockRef, data := s.encodeDelta(server.Context(), content, base)
+			blockRef.Block = &pfsclient.Block{Hash: uuid.NewWithoutDashes()}
+			blockRef.Range = &pfsclient.ByteRange{Lower: 0, Upper: uint64(len(data))}
+			if err := func() (retErr error) {
+				w, err := s.objClient.Writer(s.localServer.blockPath(blockRef.Block))
+				if err != nil {
+					return err
+				}
+				defer func() {
+					if err := w.Close(); err != nil && retErr == nil {
+						retErr = err
+					}
+				}()
+				_, err = w.Write(data)
+				return err
+			}(); err != nil {
+				return err
+			}
+			return s.writeProto(s.localServer.objectPath(object), blockRef)
+		})
+	}
+	for _, tag := range tags {
+		tag := tag
+		eg.Go(func() error {
+			index := &pfsclient.ObjectIndex{Tags: map[string]*pfsclient.Object{tag.Name: object}}
+			return s.writeProto(s.localServer.tagPath(tag), index)
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+	return server.SendAndClose(object)
+}
+
 func (s *objBlockAPIServer) GetObject(request *pfsclient.Object, getObjectServer pfsclient.ObjectAPI_GetObjectServer) (retErr error) {
 	func() { s.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { s.Log(request, nil, retErr, time.Since(start)) }(time.Now())
@@ -258,15 +391,15 @@ func (s *objBlockAPIServer) GetObject(request *pfsclient.Object, getObjectServer
 	if err != nil {
 		return err
 	}
-	objectSize := objectInfo.BlockRef.Range.Upper - objectInfo.BlockRef.Range.Lower
+	objectSize := objectSize(objectInfo.BlockRef)
 	if (objectSize) >= uint64(s.objectCacheBytes/maxCachedObjectDenom) {
 		// The object is a substantial portion of the available cache space so
 		// we bypass the cache and stream it directly out of the underlying store.
-		blockPath := s.localServer.blockPath(objectInfo.BlockRef.Block)
-		r, err := s.objClient.Reader(blockPath, objectInfo.BlockRef.Range.Lower, objectSize)
+		r, err := s.objectReader(objectInfo, 0, objectSize)
 		if err != nil {
 			return err
 		}
+		defer r.Close()
 		return grpcutil.WriteToStreamingBytesServer(r, getObjectServer)
 	}
 	var data []byte
@@ -296,8 +429,8 @@ func (s *objBlockAPIServer) GetObjects(request *pfsclient.GetObjectsRequest, get
 			protolion.Debugf("objectInfo.BlockRef.Range is nil; info: %+v; request: %v", objectInfo, request)
 		}
 
-		objectSize := objectInfo.BlockRef.Range.Upper - objectInfo.BlockRef.Range.Lower
-		if offset > objectSize {
+		objectSize := objectSize(objectInfo.BlockRef)
+		if offset >= objectSize {
 			offset -= objectSize
 			continue
 		}
@@ -308,23 +441,28 @@ func (s *objBlockAPIServer) GetObjects(request *pfsclient.GetObjectsRequest, get
 		if s.objectCacheBytes == 0 || (objectSize) > uint64(s.objectCacheBytes/maxCachedObjectDenom) {
 			// The object is a substantial portion of the available cache space so
 			// we bypass the cache and stream it directly out of the underlying store.
-			blockPath := s.localServer.blockPath(objectInfo.BlockRef.Block)
-			r, err := s.objClient.Reader(blockPath, objectInfo.BlockRef.Range.Lower+offset, readSize)
-			if err != nil {
+			if err := func() error {
+				r, err := s.objectReader(objectInfo, offset, readSize)
+				if err != nil {
+					return err
+				}
+				defer r.Close()
+				return grpcutil.WriteToStreamingBytesServer(r, getObjectsServer)
+			}(); err != nil {
+				return err
+			}
+		} else {
+			var data []byte
+			sink := groupcache.AllocatingByteSliceSink(&data)
+			if err := s.objectCache.Get(getObjectsServer.Context(), s.splitKey(object.Hash), sink); err != nil {
+				return err
+			}
+			if uint64(len(data)) < offset+readSize {
+				return fmt.Errorf("undersized object (this is likely a bug)")
+			}
+			if err := getObjectsServer.Send(&types.BytesValue{Value: data[offset : offset+readSize]}); err != nil {
 				return err
 			}
-			return grpcutil.WriteToStreamingBytesServer(r, getObjectsServer)
-		}
-		var data []byte
-		sink := groupcache.AllocatingByteSliceSink(&data)
-		if err := s.objectCache.Get(getObjectsServer.Context(), s.splitKey(object.Hash), sink); err != nil {
-			return err
-		}
-		if uint64(len(data)) < offset+readSize {
-			return fmt.Errorf("undersized object (this is likely a bug)")
-		}
-		if err := getObjectsServer.Send(&types.BytesValue{Value: data[offset : offset+readSize]}); err != nil {
-			return err
 		}
 		// We've hit the offset so we set it to 0
 		offset = 0
@@ -342,7 +480,7 @@ func (s *objBlockAPIServer) TagObject(ctx context.Context, request *pfsclient.Ta
 	func() { s.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { s.Log(request, response, retErr, time.Since(start)) }(time.Now())
 	// First inspect the object to make sure it actually exists
-	resp, err := s.CheckObject(ctx, &pfsclient.CheckObjectRequest{request.Object})
+	resp, err := s.CheckObject(ctx, &pfsclient.CheckObjectRequest{Object: request.Object})
 	if err != nil {
 		return nil, err
 	}
@@ -374,12 +512,65 @@ func (s *objBlockAPIServer) InspectObject(ctx context.Context, request *pfsclien
 	return objectInfo, nil
 }
 
+func (s *objBlockAPIServer) InspectObjects(ctx context.Context, request *pfsclient.InspectObjectsRequest) (response *pfsclient.InspectObjectsResponse, retErr error) {
+	func() { s.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { s.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	objectInfos := make([]*pfsclient.ObjectInfo, len(request.Objects))
+	limiter := limit.New(100)
+	var eg errgroup.Group
+	for i, object := range request.Objects {
+		i, object := i, object
+		limiter.Acquire()
+		eg.Go(func() error {
+			defer limiter.Release()
+			objectInfo := &pfsclient.ObjectInfo{}
+			sink := groupcache.ProtoSink(objectInfo)
+			if err := s.objectInfoCache.Get(ctx, s.splitKey(object.Hash), sink); err != nil {
+				if s.isNotFoundErr(err) {
+					return nil
+				}
+				return err
+			}
+			objectInfos[i] = objectInfo
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+	response = &pfsclient.InspectObjectsResponse{}
+	for _, objectInfo := range objectInfos {
+		if objectInfo != nil {
+			response.ObjectInfo = append(response.ObjectInfo, objectInfo)
+		}
+	}
+	return response, nil
+}
+
 func (s *objBlockAPIServer) CheckObject(ctx context.Context, request *pfsclient.CheckObjectRequest) (response *pfsclient.CheckObjectResponse, retErr error) {
 	func() { s.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { s.Log(request, response, retErr, time.Since(start)) }(time.Now())
 
+	objectPath := s.localServer.objectPath(request.Object)
+	if !request.IncludeModified {
+		return &pfsclient.CheckObjectResponse{
+			Exists: s.objClient.Exists(objectPath),
+		}, nil
+	}
+	modTime, err := s.objClient.ModTime(objectPath)
+	if err != nil {
+		if s.objClient.IsNotExist(err) {
+			return &pfsclient.CheckObjectResponse{}, nil
+		}
+		return nil, err
+	}
+	modified, err := types.TimestampProto(modTime)
+	if err != nil {
+		return nil, err
+	}
 	return &pfsclient.CheckObjectResponse{
-		Exists: s.objClient.Exists(s.localServer.objectPath(request.Object)),
+		Exists:   true,
+		Modified: modified,
 	}, nil
 }
 
@@ -462,34 +653,138 @@ func (s *objBlockAPIServer) isNotFoundErr(err error) bool {
 	return s.objClient.IsNotExist(err) || s.objClient.IsIgnorable(err)
 }
 
+// DeleteObjects deletes objects. Objects that haven't been compacted have
+// blocks of their own, which are deleted with them. Compacted objects share
+// blocks with other objects, so they're removed from their index and their
+// blocks are only deleted once no index refers to them anymore.
 func (s *objBlockAPIServer) DeleteObjects(ctx context.Context, request *pfsclient.DeleteObjectsRequest) (response *pfsclient.DeleteObjectsResponse, retErr error) {
 	func() { s.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { s.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	indexLock, ctx, err := s.lockIndexes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer indexLock.Unlock(ctx)
 
 	limiter := limit.New(100)
 	var eg errgroup.Group
+	prefixes := make(map[string][]string)
 	for _, object := range request.Objects {
 		object := object
+		if len(object.Hash) >= prefixLength {
+			prefix := object.Hash[:prefixLength]
+			prefixes[prefix] = append(prefixes[prefix], object.Hash)
+		}
 		limiter.Acquire()
 		eg.Go(func() error {
 			defer limiter.Release()
-			objectInfo, err := s.InspectObject(ctx, object)
-			if err != nil && !s.isNotFoundErr(err) {
+			objPath := s.localServer.objectPath(object)
+			blockRef := &pfsclient.BlockRef{}
+			if err := s.readProto(objPath, blockRef); err != nil {
+				if s.isNotFoundErr(err) {
+					return nil
+				}
 				return err
 			}
-
-			objPath := s.localServer.objectPath(object)
 			if err := s.objClient.Delete(objPath); err != nil && !s.isNotFoundErr(err) {
 				return err
 			}
-
-			if objectInfo != nil && objectInfo.BlockRef != nil && objectInfo.BlockRef.Block != nil {
-				blockPath := s.localServer.blockPath(objectInfo.BlockRef.Block)
-				if err := s.objClient.Delete(blockPath); err != nil && !s.isNotFoundErr(err) {
+			if blockRef.Block != nil {
+				if err := s.objClient.Delete(s.localServer.blockPath(blockRef.Block)); err != nil && !s.isNotFoundErr(err) {
 					return err
 				}
 			}
+			return nil
+		})
+	}
+	var mu sync.Mutex
+	// candidates are the shared blocks that compacted objects were deleted
+	// from.
+	candidates := make(map[string]bool)
+	for prefix, hashes := range prefixes {
+		prefix, hashes := prefix, hashes
+		limiter.Acquire()
+		eg.Go(func() error {
+			defer limiter.Release()
+			objectIndex := &pfsclient.ObjectIndex{}
+			if err := s.readProto(s.localServer.indexPath(prefix), objectIndex); err != nil {
+				if s.isNotFoundErr(err) {
+					return nil
+				}
+				return err
+			}
+			var removed []*pfsclient.BlockRef
+			for _, hash := range hashes {
+				if blockRef, ok := objectIndex.Objects[hash]; ok {
+					removed = append(removed, blockRef)
+					delete(objectIndex.Objects, hash)
+				}
+			}
+			if len(removed) == 0 {
+				return nil
+			}
+			if err := s.writeProto(s.localServer.indexPath(prefix), objectIndex); err != nil {
+				return err
+			}
+			s.setObjectIndex(prefix, objectIndex)
+			mu.Lock()
+			defer mu.Unlock()
+			for _, blockRef := range removed {
+				if blockRef.Block != nil {
+					candidates[blockRef.Block.Hash] = true
+				}
+			}
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+	if len(candidates) == 0 {
+		return &pfsclient.DeleteObjectsResponse{}, nil
+	}
 
+	// Blocks that are still referred to by an index entry have other
+	// objects in them.
+	eg = errgroup.Group{}
+	if err := s.objClient.Walk(s.localServer.indexDir(), func(name string) error {
+		limiter.Acquire()
+		eg.Go(func() error {
+			defer limiter.Release()
+			objectIndex := &pfsclient.ObjectIndex{}
+			if err := s.readProto(name, objectIndex); err != nil {
+				return err
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			for _, blockRef := range objectIndex.Objects {
+				if blockRef.Block != nil {
+					delete(candidates, blockRef.Block.Hash)
+				}
+			}
+			return nil
+		})
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+	// Don't delete anything if the lock was lost while looking, compaction
+	// may be referring to the candidates again by now.
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	eg = errgroup.Group{}
+	for hash := range candidates {
+		block := &pfsclient.Block{Hash: hash}
+		limiter.Acquire()
+		eg.Go(func() error {
+			defer limiter.Release()
+			if err := s.objClient.Delete(s.localServer.blockPath(block)); err != nil && !s.isNotFoundErr(err) {
+				return err
+			}
 			return nil
 		})
 	}
@@ -525,12 +820,111 @@ func (s *objBlockAPIServer) InspectTag(ctx context.Context, request *pfsclient.T
 func (s *objBlockAPIServer) Compact(ctx context.Context, request *types.Empty) (response *types.Empty, retErr error) {
 	func() { s.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { s.Log(request, response, retErr, time.Since(start)) }(time.Now())
-	if err := s.compact(); err != nil {
+	if err := s.compact(ctx); err != nil {
 		return nil, err
 	}
 	return &types.Empty{}, nil
 }
 
+// DebugObject reads an object's index entry, and optionally its content,
+// directly from object storage, so that support can tell whether a
+// corrupt file is due to PFS's metadata or to the stored bytes.
+func (s *objBlockAPIServer) DebugObject(request *pfsclient.DebugObjectRequest, server pfsclient.ObjectAPI_DebugObjectServer) (retErr error) {
+	func() { s.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { s.Log(request, nil, retErr, time.Since(start)) }(time.Now())
+	resp := &pfsclient.DebugObjectResponse{}
+	blockRef, err := s.readBlockRef(request.Object)
+	if err != nil {
+		resp.Error = err.Error()
+		return server.Send(resp)
+	}
+	resp.BlockRef = blockRef
+	resp.BlockExists = s.objClient.Exists(s.localServer.blockPath(blockRef.Block))
+	if !resp.BlockExists {
+		resp.Error = fmt.Sprintf("block %s not found", blockRef.Block.Hash)
+		return server.Send(resp)
+	}
+	if !request.Verify && !request.IncludeContent {
+		return server.Send(resp)
+	}
+	data, err := s.readObjectDirect(blockRef)
+	if err != nil {
+		resp.Error = err.Error()
+		return server.Send(resp)
+	}
+	hash := newHash()
+	hash.Write(data)
+	resp.Hash = hex.EncodeToString(hash.Sum(nil))
+	resp.SizeBytes = uint64(len(data))
+	if err := server.Send(resp); err != nil {
+		return err
+	}
+	if !request.IncludeContent {
+		return nil
+	}
+	for _, chunk := range grpcutil.Chunk(data, grpcutil.MaxMsgSize/2) {
+		if err := server.Send(&pfsclient.DebugObjectResponse{Value: chunk}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readBlockRef reads an object's index entry from object storage, without
+// going through objectInfoCache.
+func (s *objBlockAPIServer) readBlockRef(object *pfsclient.Object) (*pfsclient.BlockRef, error) {
+	blockRef := &pfsclient.BlockRef{}
+	if err := s.readProto(s.localServer.objectPath(object), blockRef); err == nil {
+		return blockRef, nil
+	} else if !s.isNotFoundErr(err) {
+		return nil, err
+	}
+	if len(object.Hash) >= prefixLength {
+		objectIndex := &pfsclient.ObjectIndex{}
+		if err := s.readProto(s.localServer.indexPath(object.Hash[:prefixLength]), objectIndex); err != nil && !s.isNotFoundErr(err) {
+			return nil, err
+		}
+		if blockRef, ok := objectIndex.Objects[object.Hash]; ok {
+			return blockRef, nil
+		}
+	}
+	return nil, fmt.Errorf("object %s not found", object.Hash)
+}
+
+// readObjectDirect reads the content of the object stored at blockRef from
+// object storage, without going through the caches. Deltas are applied to
+// their bases, which are read the same way.
+func (s *objBlockAPIServer) readObjectDirect(blockRef *pfsclient.BlockRef) (_ []byte, retErr error) {
+	r, err := s.reader(s.localServer.blockPath(blockRef.Block), blockRef.Range.Lower, blockRef.Range.Upper-blockRef.Range.Lower)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := r.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(data)) != blockRef.Range.Upper-blockRef.Range.Lower {
+		return nil, fmt.Errorf("block %s is truncated, read %d bytes of the range %d-%d", blockRef.Block.Hash, len(data), blockRef.Range.Lower, blockRef.Range.Upper)
+	}
+	if blockRef.DeltaBase == nil {
+		return data, nil
+	}
+	baseRef, err := s.readBlockRef(blockRef.DeltaBase)
+	if err != nil {
+		return nil, fmt.Errorf("error reading delta base %s: %v", blockRef.DeltaBase.Hash, err)
+	}
+	base, err := s.readObjectDirect(baseRef)
+	if err != nil {
+		return nil, fmt.Errorf("error reading delta base %s: %v", blockRef.DeltaBase.Hash, err)
+	}
+	return delta.Apply(base, data)
+}
+
 func (s *objBlockAPIServer) objectPrefix(prefix string) string {
 	return s.localServer.objectPath(&pfsclient.Object{Hash: prefix})
 }
@@ -539,11 +933,16 @@ func (s *objBlockAPIServer) tagPrefix(prefix string) string {
 	return s.localServer.tagPath(&pfsclient.Tag{Name: prefix})
 }
 
-func (s *objBlockAPIServer) compact() (retErr error) {
-	w, err := s.newBlockWriter(&pfsclient.Block{Hash: uuid.NewWithoutDashes()})
+// compact merges the blocks of small objects into larger blocks and moves
+// their metadata (along with the tags') into the per-prefix indexes. Objects
+// that are at least DefaultBlockSize are left in their own blocks.
+func (s *objBlockAPIServer) compact(ctx context.Context) (retErr error) {
+	indexLock, ctx, err := s.lockIndexes(ctx)
 	if err != nil {
 		return err
 	}
+	defer indexLock.Unlock(ctx)
+	w := &compactionWriter{s: s}
 	defer func() {
 		if err := w.Close(); err != nil && retErr == nil {
 			retErr = err
@@ -563,6 +962,9 @@ func (s *objBlockAPIServer) compact() (retErr error) {
 				if err := s.readProto(name, blockRef); err != nil {
 					return err
 				}
+				if blockRef.Range.Upper-blockRef.Range.Lower >= uint64(DefaultBlockSize) {
+					return nil
+				}
 				blockPath := s.localServer.blockPath(blockRef.Block)
 				r, err := s.objClient.Reader(blockPath, blockRef.Range.Lower, blockRef.Range.Upper-blockRef.Range.Lower)
 				if err != nil {
@@ -577,10 +979,15 @@ func (s *objBlockAPIServer) compact() (retErr error) {
 				if err != nil {
 					return err
 				}
-				blockRef, err = w.Write(object)
+				newBlockRef, err := w.Write(object)
 				if err != nil {
 					return err
 				}
+				// Deltas are moved as they are.
+				newBlockRef.DeltaBase = blockRef.DeltaBase
+				newBlockRef.DeltaDepth = blockRef.DeltaDepth
+				newBlockRef.SizeBytes = blockRef.SizeBytes
+				blockRef = newBlockRef
 				mu.Lock()
 				defer mu.Unlock()
 				objectIndex.Objects[filepath.Base(name)] = blockRef
@@ -611,6 +1018,10 @@ func (s *objBlockAPIServer) compact() (retErr error) {
 	if err := eg.Wait(); err != nil {
 		return err
 	}
+	// The new blocks need to be complete before the indexes refer to them.
+	if err := w.Close(); err != nil {
+		return err
+	}
 	prefixes := make(map[string]bool)
 	for hash := range objectIndex.Objects {
 		prefixes[hash[:2]] = true
@@ -649,6 +1060,9 @@ func (s *objBlockAPIServer) compact() (retErr error) {
 	if err := eg.Wait(); err != nil {
 		return err
 	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
 	eg = errgroup.Group{}
 	for _, file := range toDelete {
 		file := file
@@ -659,6 +1073,40 @@ func (s *objBlockAPIServer) compact() (retErr error) {
 	return eg.Wait()
 }
 
+// lockIndexes acquires the cluster wide lock that compaction and
+// DeleteObjects hold while they move objects in and out of the per-prefix
+// indexes and delete blocks, so that neither deletes a block that the other,
+// possibly in another pachd, is about to refer to. The returned context is
+// cancelled if the lock is lost.
+func (s *objBlockAPIServer) lockIndexes(ctx context.Context) (dlock.DLock, context.Context, error) {
+	etcdClient, err := s.getEtcdClient()
+	if err != nil {
+		return nil, nil, err
+	}
+	indexLock := dlock.NewDLock(etcdClient, objectIndexLockKey)
+	ctx, err = indexLock.Lock(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error locking the object indexes: %v", err)
+	}
+	return indexLock, ctx, nil
+}
+
+func (s *objBlockAPIServer) getEtcdClient() (*etcd.Client, error) {
+	s.etcdClientMu.Lock()
+	defer s.etcdClientMu.Unlock()
+	if s.etcdClient == nil {
+		etcdClient, err := etcd.New(etcd.Config{
+			Endpoints:   []string{s.etcdAddress},
+			DialOptions: client.EtcdDialOptions(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error instantiating etcd client: %v", err)
+		}
+		s.etcdClient = etcdClient
+	}
+	return s.etcdClient, nil
+}
+
 func (s *objBlockAPIServer) readProto(path string, pb proto.Unmarshaler) (retErr error) {
 	r, err := s.objClient.Reader(path, 0, 0)
 	if err != nil {
@@ -704,7 +1152,16 @@ func (s *objBlockAPIServer) objectGetter(ctx groupcache.Context, key string, des
 	if err := s.objectInfoCache.Get(ctx, key, sink); err != nil {
 		return err
 	}
-	return s.readBlockRef(objectInfo.BlockRef, dest)
+	r, err := s.objectReader(objectInfo, 0, 0)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	return dest.SetBytes(data)
 }
 
 func (s *objBlockAPIServer) tagGetter(ctx groupcache.Context, key string, dest groupcache.Sink) error {
@@ -812,6 +1269,25 @@ func (s *objBlockAPIServer) objectInfoGetter(ctx groupcache.Context, key string,
 }
 
 func (s *objBlockAPIServer) readObj(path string, offset uint64, size uint64, dest groupcache.Sink) (retErr error) {
+	reader, err := s.reader(path, offset, size)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := reader.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	return dest.SetBytes(data)
+}
+
+// reader returns a reader for part of an object in object storage, retrying
+// errors.
+func (s *objBlockAPIServer) reader(path string, offset uint64, size uint64) (io.ReadCloser, error) {
 	var reader io.ReadCloser
 	var err error
 	backoff.RetryNotify(func() error {
@@ -828,22 +1304,160 @@ func (s *objBlockAPIServer) readObj(path string, offset uint64, size uint64, des
 		return nil
 	})
 	if err != nil {
+		return nil, err
+	}
+	return reader, nil
+}
+
+// objectReader returns a reader for size bytes of an object starting at
+// offset, or the rest of the object if size is 0. Objects that are small
+// enough are read through the disk cache, if there is one.
+func (s *objBlockAPIServer) objectReader(objectInfo *pfsclient.ObjectInfo, offset uint64, size uint64) (io.ReadCloser, error) {
+	blockRef := objectInfo.BlockRef
+	objectSize := objectSize(blockRef)
+	if size == 0 {
+		size = objectSize - offset
+	}
+	if size == 0 {
+		return ioutil.NopCloser(&bytes.Buffer{}), nil
+	}
+	if s.diskCache == nil || objectSize > uint64(s.diskCache.MaxBytes()/maxCachedObjectDenom) {
+		if blockRef.DeltaBase != nil {
+			data, err := s.readDelta(blockRef)
+			if err != nil {
+				return nil, err
+			}
+			return ioutil.NopCloser(bytes.NewReader(data[offset : offset+size])), nil
+		}
+		return s.reader(s.localServer.blockPath(blockRef.Block), blockRef.Range.Lower+offset, size)
+	}
+	f, err := s.diskCache.Get(objectInfo.Object.Hash, func(w io.Writer) (retErr error) {
+		if blockRef.DeltaBase != nil {
+			data, err := s.readDelta(blockRef)
+			if err != nil {
+				return err
+			}
+			_, err = w.Write(data)
+			return err
+		}
+		r, err := s.reader(s.localServer.blockPath(blockRef.Block), blockRef.Range.Lower, objectSize)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := r.Close(); err != nil && retErr == nil {
+				retErr = err
+			}
+		}()
+		_, err = io.Copy(w, r)
 		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &sectionReadCloser{io.NewSectionReader(f, int64(offset), int64(size)), f}, nil
+}
+
+type sectionReadCloser struct {
+	*io.SectionReader
+	io.Closer
+}
+
+// readDelta reads an object that's stored as a delta, by applying the delta
+// to its base.
+func (s *objBlockAPIServer) readDelta(blockRef *pfsclient.BlockRef) (_ []byte, retErr error) {
+	r, err := s.reader(s.localServer.blockPath(blockRef.Block), blockRef.Range.Lower, blockRef.Range.Upper-blockRef.Range.Lower)
+	if err != nil {
+		return nil, err
 	}
 	defer func() {
-		if err := reader.Close(); err != nil && retErr == nil {
+		if err := r.Close(); err != nil && retErr == nil {
 			retErr = err
 		}
 	}()
-	data, err := ioutil.ReadAll(reader)
+	d, err := ioutil.ReadAll(r)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return dest.SetBytes(data)
+	baseInfo, err := s.InspectObject(context.Background(), blockRef.DeltaBase)
+	if err != nil {
+		return nil, fmt.Errorf("error inspecting delta base %s: %v", blockRef.DeltaBase.Hash, err)
+	}
+	baseR, err := s.objectReader(baseInfo, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := baseR.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	base, err := ioutil.ReadAll(baseR)
+	if err != nil {
+		return nil, err
+	}
+	data, err := delta.Apply(base, d)
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(data)) != blockRef.SizeBytes {
+		return nil, fmt.Errorf("delta produced %d bytes, expected %d (this is likely a bug)", len(data), blockRef.SizeBytes)
+	}
+	return data, nil
 }
 
-func (s *objBlockAPIServer) readBlockRef(blockRef *pfsclient.BlockRef, dest groupcache.Sink) error {
-	return s.readObj(s.localServer.blockPath(blockRef.Block), blockRef.Range.Lower, blockRef.Range.Upper-blockRef.Range.Lower, dest)
+// encodeDelta returns content encoded as a delta against base, along with
+// a BlockRef, without its Block and Range, that describes it. If base can't
+// be used, or the delta isn't much smaller than content, it returns content
+// as is.
+func (s *objBlockAPIServer) encodeDelta(ctx context.Context, content []byte, base *pfsclient.Object) (*pfsclient.BlockRef, []byte) {
+	full := &pfsclient.BlockRef{}
+	baseInfo, err := s.InspectObject(ctx, base)
+	if err != nil {
+		return full, content
+	}
+	depth, ok := deltaDepth(baseInfo.BlockRef)
+	if !ok {
+		return full, content
+	}
+	r, err := s.objectReader(baseInfo, 0, 0)
+	if err != nil {
+		return full, content
+	}
+	defer r.Close()
+	baseContent, err := ioutil.ReadAll(r)
+	if err != nil {
+		return full, content
+	}
+	d := delta.Encode(baseContent, content)
+	if len(d) > len(content)/2 {
+		return full, content
+	}
+	return &pfsclient.BlockRef{
+		DeltaBase:  base,
+		DeltaDepth: depth,
+		SizeBytes:  uint64(len(content)),
+	}, d
+}
+
+// deltaDepth returns the depth of a delta against the object stored at
+// baseRef, and false if the object should be stored in full instead. Every
+// maxDeltaDepth versions are stored in full, so that reading an object never
+// has to apply too many deltas.
+func deltaDepth(baseRef *pfsclient.BlockRef) (uint64, bool) {
+	depth := baseRef.DeltaDepth + 1
+	if depth > maxDeltaDepth || objectSize(baseRef) > maxDeltaBytes {
+		return 0, false
+	}
+	return depth, true
+}
+
+// objectSize returns the size of the object that blockRef refers to.
+func objectSize(blockRef *pfsclient.BlockRef) uint64 {
+	if blockRef.DeltaBase != nil {
+		return blockRef.SizeBytes
+	}
+	return blockRef.Range.Upper - blockRef.Range.Lower
 }
 
 func (s *objBlockAPIServer) getObjectIndex(prefix string) (*pfsclient.ObjectIndex, bool) {
@@ -919,3 +1533,42 @@ func (w *blockWriter) Write(p []byte) (*pfsclient.BlockRef, error) {
 func (w *blockWriter) Close() error {
 	return w.w.Close()
 }
+
+// compactionWriter writes objects into new blocks, starting another block
+// whenever the current one reaches maxBlockSize.
+type compactionWriter struct {
+	s  *objBlockAPIServer
+	w  *blockWriter
+	mu sync.Mutex
+}
+
+func (c *compactionWriter) Write(p []byte) (*pfsclient.BlockRef, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.w != nil && c.w.written >= uint64(maxBlockSize) {
+		if err := c.w.Close(); err != nil {
+			return nil, err
+		}
+		c.w = nil
+	}
+	if c.w == nil {
+		w, err := c.s.newBlockWriter(&pfsclient.Block{Hash: uuid.NewWithoutDashes()})
+		if err != nil {
+			return nil, err
+		}
+		c.w = w
+	}
+	return c.w.Write(p)
+}
+
+// Close closes the current block, it's safe to call more than once.
+func (c *compactionWriter) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.w == nil {
+		return nil
+	}
+	w := c.w
+	c.w = nil
+	return w.Close()
+}
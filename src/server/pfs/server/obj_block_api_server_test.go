@@ -0,0 +1,45 @@
+package server
+
+import (
+	"testing"
+
+	pfsclient "github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+func TestDeltaDepth(t *testing.T) {
+	full := &pfsclient.BlockRef{
+		Range: &pfsclient.ByteRange{Upper: 1024},
+	}
+	depth, ok := deltaDepth(full)
+	require.True(t, ok)
+	require.Equal(t, uint64(1), depth)
+
+	// Chains of deltas stop at maxDeltaDepth, after which the next version
+	// is stored in full and starts a new chain.
+	base := full
+	for i := uint64(1); i <= maxDeltaDepth; i++ {
+		depth, ok := deltaDepth(base)
+		require.True(t, ok)
+		require.Equal(t, i, depth)
+		base = &pfsclient.BlockRef{
+			DeltaBase:  &pfsclient.Object{Hash: "base"},
+			DeltaDepth: depth,
+			SizeBytes:  1024,
+		}
+	}
+	_, ok = deltaDepth(base)
+	require.False(t, ok)
+
+	// Bases that are too big to read into memory aren't used.
+	_, ok = deltaDepth(&pfsclient.BlockRef{
+		Range: &pfsclient.ByteRange{Upper: maxDeltaBytes + 1},
+	})
+	require.False(t, ok)
+	_, ok = deltaDepth(&pfsclient.BlockRef{
+		DeltaBase:  &pfsclient.Object{Hash: "base"},
+		DeltaDepth: 1,
+		SizeBytes:  maxDeltaBytes + 1,
+	})
+	require.False(t, ok)
+}
@@ -0,0 +1,508 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	pfsserver "github.com/pachyderm/pachyderm/src/server/pfs"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
+	"github.com/pachyderm/pachyderm/src/server/pkg/worker"
+
+	"github.com/gogo/protobuf/types"
+	"go.pedge.io/lion/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// reclaimGracePeriod is how long ago an object must have been written
+	// for history trimming to reclaim it, so that an object that's written
+	// again while its references are being checked isn't deleted.
+	reclaimGracePeriod = time.Hour
+	// trimBatchSize is the number of commits that are dropped in each etcd
+	// transaction.
+	trimBatchSize = 100
+)
+
+// TrimHistory implements the APIServer interface.
+func (a *apiServer) TrimHistory(ctx context.Context) error {
+	return a.driver.trimHistory(ctx)
+}
+
+// trimHistory drops the commits that are older than their repo's retention
+// and reclaims the objects that only they referenced.
+func (d *driver) trimHistory(ctx context.Context) error {
+	repoInfos, err := d.listRepo(ctx, nil)
+	if err != nil {
+		return err
+	}
+	// candidates are the objects referenced by the dropped commits
+	candidates := make(map[string]*pfs.Object)
+	for _, repoInfo := range repoInfos {
+		if repoInfo.Retention == nil {
+			continue
+		}
+		retention, err := types.DurationFromProto(repoInfo.Retention)
+		if err != nil {
+			return err
+		}
+		if retention <= 0 {
+			continue
+		}
+		if err := d.trimRepo(ctx, repoInfo.Repo, time.Now().Add(-retention), candidates); err != nil {
+			return err
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	return d.reclaimObjects(ctx, candidates, reclaimGracePeriod)
+}
+
+// trimRepo drops the commits to repo that finished before cutoff, except
+// for the ones that are still needed: branch heads, the parents of open
+// commits and the provenance of commits in other repos. The objects that
+// the dropped commits referenced are added to candidates.
+func (d *driver) trimRepo(ctx context.Context, repo *pfs.Repo, cutoff time.Time, candidates map[string]*pfs.Object) error {
+	commitInfos, err := d.allCommits(ctx, repo)
+	if err != nil {
+		return err
+	}
+	keep := make(map[string]bool)
+	branches, err := d.listBranch(ctx, repo)
+	if err != nil {
+		return err
+	}
+	for _, branch := range branches {
+		keep[branch.Head.ID] = true
+	}
+	for _, commitInfo := range commitInfos {
+		if commitInfo.Finished == nil {
+			keep[commitInfo.Commit.ID] = true
+			if commitInfo.ParentCommit != nil {
+				keep[commitInfo.ParentCommit.ID] = true
+			}
+		}
+	}
+	downstreamRepos, err := d.listRepo(ctx, []*pfs.Repo{repo})
+	if err != nil {
+		return err
+	}
+	for _, repoInfo := range downstreamRepos {
+		downstreamCommitInfos, err := d.allCommits(ctx, repoInfo.Repo)
+		if err != nil {
+			return err
+		}
+		for _, commitInfo := range downstreamCommitInfos {
+			for _, prov := range commitInfo.Provenance {
+				if prov.Repo.Name == repo.Name {
+					keep[prov.ID] = true
+				}
+			}
+		}
+	}
+
+	dropped := make(map[string]bool)
+	for commitID, commitInfo := range commitInfos {
+		if keep[commitID] {
+			continue
+		}
+		finished, err := types.TimestampFromProto(commitInfo.Finished)
+		if err != nil {
+			return err
+		}
+		if !finished.Before(cutoff) {
+			continue
+		}
+		dropped[commitID] = true
+		// Read the commit's tree while the commit still exists.
+		if commitInfo.Tree == nil {
+			continue
+		}
+		candidates[commitInfo.Tree.Hash] = commitInfo.Tree
+		tree, err := d.getTreeForCommit(ctx, commitInfo.Commit)
+		if err != nil {
+			return err
+		}
+		if err := addTreeObjects(tree, candidates); err != nil {
+			return err
+		}
+	}
+	if len(dropped) == 0 {
+		return nil
+	}
+
+	// The commits whose parents are dropped are reparented onto their
+	// closest ancestor that's kept, before anything is dropped so that no
+	// commit's parent is ever missing. Every commit's tree has all of the
+	// repo's files, so no data is lost from the commits that are kept.
+	for commitID, commitInfo := range commitInfos {
+		if dropped[commitID] || commitInfo.ParentCommit == nil || !dropped[commitInfo.ParentCommit.ID] {
+			continue
+		}
+		parent := commitInfo.ParentCommit
+		for parent != nil && dropped[parent.ID] {
+			parent = commitInfos[parent.ID].ParentCommit
+		}
+		if _, err := d.store.NewSTM(ctx, func(stm col.STM) error {
+			commits := d.commits(repo.Name).ReadWrite(stm)
+			commitInfo := new(pfs.CommitInfo)
+			if err := commits.Get(commitID, commitInfo); err != nil {
+				return err
+			}
+			commitInfo.ParentCommit = parent
+			commits.Put(commitID, commitInfo)
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	// The repo's size isn't changed: it counts the data that was added by
+	// each commit, and the data added by dropped commits is either still
+	// in the repo's later commits or reclaimed.
+	var batch []string
+	dropBatch := func() error {
+		if _, err := d.store.NewSTM(ctx, func(stm col.STM) error {
+			commits := d.commits(repo.Name).ReadWrite(stm)
+			for _, commitID := range batch {
+				if err := commits.Delete(commitID); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		for _, commitID := range batch {
+			d.commitCache.Remove(commitID)
+			d.treeCache.Remove(commitID)
+		}
+		batch = nil
+		return nil
+	}
+	for commitID := range dropped {
+		batch = append(batch, commitID)
+		if len(batch) == trimBatchSize {
+			if err := dropBatch(); err != nil {
+				return err
+			}
+		}
+	}
+	if len(batch) > 0 {
+		if err := dropBatch(); err != nil {
+			return err
+		}
+	}
+	protolion.Infof("dropped %d commits to repo %s that are older than its retention", len(dropped), repo.Name)
+	return nil
+}
+
+// reclaimObjects deletes the objects in candidates that nothing references
+// anymore: not a commit in any repo, not a file being written to an open
+// commit, not a tag, or a file in a datum's output hashtree that a tag points
+// to, not a running job's datum checkpoint or the logs it records, and not an
+// object that's stored as a delta against it. Objects written less than
+// gracePeriod ago are left alone.
+func (d *driver) reclaimObjects(ctx context.Context, candidates map[string]*pfs.Object, gracePeriod time.Duration) error {
+	objClient, err := d.getObjectClient()
+	if err != nil {
+		return err
+	}
+	referenced := make(map[string]*pfs.Object)
+	repoInfos, err := d.listRepo(ctx, nil)
+	if err != nil {
+		return err
+	}
+	for _, repoInfo := range repoInfos {
+		commitInfos, err := d.allCommits(ctx, repoInfo.Repo)
+		if err != nil {
+			return err
+		}
+		for _, commitInfo := range commitInfos {
+			if commitInfo.Finished == nil || commitInfo.Tree == nil {
+				continue
+			}
+			referenced[commitInfo.Tree.Hash] = commitInfo.Tree
+			tree, err := d.getTreeForCommit(ctx, commitInfo.Commit)
+			if err != nil {
+				return err
+			}
+			if err := addTreeObjects(tree, referenced); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := d.addScratchObjects(ctx, d.scratchPrefix(), referenced); err != nil {
+		return err
+	}
+
+	treeTagPrefixes, err := d.addPPSObjects(ctx, objClient, referenced)
+	if err != nil {
+		return err
+	}
+	if err := addTagObjects(ctx, objClient, treeTagPrefixes, referenced); err != nil {
+		return err
+	}
+
+	var referencedObjects []*pfs.Object
+	for _, object := range referenced {
+		referencedObjects = append(referencedObjects, object)
+	}
+	bases, err := pfsserver.DeltaBases(ctx, objClient.ObjectAPIClient, referencedObjects)
+	if err != nil {
+		return err
+	}
+	for _, base := range bases {
+		referenced[base.Hash] = base
+	}
+
+	var objects []*pfs.Object
+	for hash, object := range candidates {
+		if referenced[hash] != nil {
+			continue
+		}
+		resp, err := objClient.ObjectAPIClient.CheckObject(ctx, &pfs.CheckObjectRequest{
+			Object:          object,
+			IncludeModified: true,
+		})
+		if err != nil {
+			return err
+		}
+		if !resp.Exists {
+			continue
+		}
+		if resp.Modified != nil && gracePeriod > 0 {
+			modified, err := types.TimestampFromProto(resp.Modified)
+			if err != nil {
+				return err
+			}
+			if time.Since(modified) < gracePeriod {
+				continue
+			}
+		}
+		objects = append(objects, object)
+	}
+	reclaimed := len(objects)
+	for len(objects) > 0 {
+		n := len(objects)
+		if n > trimBatchSize {
+			n = trimBatchSize
+		}
+		if _, err := objClient.ObjectAPIClient.DeleteObjects(ctx, &pfs.DeleteObjectsRequest{Objects: objects[:n]}); err != nil {
+			return err
+		}
+		objects = objects[n:]
+	}
+	protolion.Infof("reclaimed %d of %d objects", reclaimed, len(candidates))
+	return nil
+}
+
+// repoObjects returns the objects that the finished commits to repos
+// reference: their hashtrees and the objects that their files are made of.
+func (d *driver) repoObjects(ctx context.Context, repos []*pfs.Repo) (map[string]*pfs.Object, error) {
+	objects := make(map[string]*pfs.Object)
+	for _, repo := range repos {
+		commitInfos, err := d.allCommits(ctx, repo)
+		if err != nil {
+			return nil, err
+		}
+		for _, commitInfo := range commitInfos {
+			if commitInfo.Finished == nil || commitInfo.Tree == nil {
+				continue
+			}
+			objects[commitInfo.Tree.Hash] = commitInfo.Tree
+			tree, err := d.getTreeForCommit(ctx, commitInfo.Commit)
+			if err != nil {
+				return nil, err
+			}
+			if err := addTreeObjects(tree, objects); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return objects, nil
+}
+
+// commitObjects returns the objects that have been written to commit,
+// which must be open, and aren't in its parent.
+func (d *driver) commitObjects(ctx context.Context, commit *pfs.Commit) (map[string]*pfs.Object, error) {
+	prefix, err := d.scratchCommitPrefix(ctx, commit)
+	if err != nil {
+		return nil, err
+	}
+	objects := make(map[string]*pfs.Object)
+	if err := d.addScratchObjects(ctx, prefix, objects); err != nil {
+		return nil, err
+	}
+	return objects, nil
+}
+
+// addScratchObjects adds the objects that the files being written under
+// prefix in the scratch space are made of to objects.
+func (d *driver) addScratchObjects(ctx context.Context, prefix string, objects map[string]*pfs.Object) error {
+	kvs, err := d.store.List(ctx, prefix, true)
+	if err != nil {
+		return err
+	}
+	for _, kv := range kvs {
+		if string(kv.Value) == tombstone {
+			continue
+		}
+		records := &PutFileRecords{}
+		if err := records.Unmarshal(kv.Value); err != nil {
+			return err
+		}
+		for _, record := range records.Records {
+			if record.ObjectHash == "" {
+				continue
+			}
+			objects[record.ObjectHash] = &pfs.Object{Hash: record.ObjectHash}
+		}
+	}
+	return nil
+}
+
+// addPPSObjects adds the objects that PPS refers to outside of PFS to
+// objects: the datum checkpoints of running jobs and the logs that they
+// record as waiting to be committed. It returns the prefixes of the tags
+// that existing pipelines' workers put on the hashtrees of their datums'
+// outputs. PPS is reached through pachd, if it isn't served, e.g. in PFS's
+// tests, nothing is added.
+func (d *driver) addPPSObjects(ctx context.Context, objClient *client.APIClient, objects map[string]*pfs.Object) ([]string, error) {
+	ppsClient := pps.NewAPIClient(d.pachConn)
+	pipelineInfos, err := ppsClient.ListPipeline(ctx, &pps.ListPipelineRequest{})
+	if err != nil {
+		if grpc.Code(err) == codes.Unimplemented {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var treeTagPrefixes []string
+	for _, pipelineInfo := range pipelineInfos.PipelineInfo {
+		treeTagPrefixes = append(treeTagPrefixes, client.HashPipelineID(pipelineInfo.ID))
+	}
+	jobInfos, err := ppsClient.ListJob(ctx, &pps.ListJobRequest{})
+	if err != nil {
+		return nil, err
+	}
+	for _, jobInfo := range jobInfos.JobInfo {
+		checkpoint := jobInfo.DatumCheckpoint
+		if checkpoint == nil || jobStopped(jobInfo.State) {
+			continue
+		}
+		objects[checkpoint.Hash] = checkpoint
+		var buf bytes.Buffer
+		if err := objClient.GetObject(checkpoint.Hash, &buf); err != nil {
+			return nil, err
+		}
+		logs, err := worker.CheckpointLogs(buf.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("error reading datum checkpoint %s: %v", checkpoint.Hash, err)
+		}
+		for _, log := range logs {
+			objects[log.Hash] = log
+		}
+	}
+	return treeTagPrefixes, nil
+}
+
+// jobStopped returns true if a job in state won't run anymore.
+func jobStopped(state pps.JobState) bool {
+	switch state {
+	case pps.JobState_JOB_SUCCESS, pps.JobState_JOB_FAILURE, pps.JobState_JOB_STOPPED:
+		return true
+	}
+	return false
+}
+
+// addTagObjects adds the tagged objects to objects. The ones whose tags start
+// with one of treeTagPrefixes are datums' output hashtrees, the objects that
+// their files are made of are added too. Other tagged objects aren't read,
+// since nothing is known about what they hold.
+func addTagObjects(ctx context.Context, objClient *client.APIClient, treeTagPrefixes []string, objects map[string]*pfs.Object) error {
+	tags, err := objClient.ObjectAPIClient.ListTags(ctx, &pfs.ListTagsRequest{IncludeObject: true})
+	if err != nil {
+		return err
+	}
+	for {
+		resp, err := tags.Recv()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		// Tag-only responses don't name an object.
+		if resp.Object == nil {
+			continue
+		}
+		objects[resp.Object.Hash] = resp.Object
+		if !hasAnyPrefix(resp.Tag, treeTagPrefixes) {
+			continue
+		}
+		var buf bytes.Buffer
+		if err := objClient.GetObject(resp.Object.Hash, &buf); err != nil {
+			return err
+		}
+		tree, err := hashtree.Deserialize(buf.Bytes())
+		if err != nil {
+			return fmt.Errorf("error reading the hashtree tagged %s: %v", resp.Tag, err)
+		}
+		if err := addTreeObjects(tree, objects); err != nil {
+			return err
+		}
+	}
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// allCommits returns every commit to repo, keyed by ID.
+func (d *driver) allCommits(ctx context.Context, repo *pfs.Repo) (map[string]*pfs.CommitInfo, error) {
+	iterator, err := d.commits(repo.Name).ReadOnly(ctx).List()
+	if err != nil {
+		return nil, err
+	}
+	commitInfos := make(map[string]*pfs.CommitInfo)
+	for {
+		var commitID string
+		commitInfo := new(pfs.CommitInfo)
+		ok, err := iterator.Next(&commitID, commitInfo)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return commitInfos, nil
+		}
+		commitInfos[commitInfo.Commit.ID] = commitInfo
+	}
+}
+
+// addTreeObjects adds the objects that the files in tree are made of to
+// objects.
+func addTreeObjects(tree hashtree.HashTree, objects map[string]*pfs.Object) error {
+	return tree.Walk(func(path string, node *hashtree.NodeProto) error {
+		if node.FileNode != nil {
+			for _, object := range node.FileNode.Objects {
+				objects[object.Hash] = object
+			}
+		}
+		return nil
+	})
+}
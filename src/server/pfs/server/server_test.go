@@ -2188,6 +2188,36 @@ func runServers(t *testing.T, port int32, apiServer pfs.APIServer,
 	<-ready
 }
 
+func TestFsck(t *testing.T) {
+	c := getClient(t)
+	repo := "TestFsck"
+	require.NoError(t, c.CreateRepo(repo))
+	_, err := c.StartCommit(repo, "master")
+	require.NoError(t, err)
+	_, err = c.PutFile(repo, "master", "file", strings.NewReader("foo"))
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(repo, "master"))
+
+	// Tagged objects that aren't datum hashtrees are in use, and aren't
+	// read as hashtrees.
+	tagged, _, err := c.PutObject(strings.NewReader("not a hashtree"), "tag")
+	require.NoError(t, err)
+	orphan, _, err := c.PutObject(strings.NewReader("orphan"))
+	require.NoError(t, err)
+
+	orphaned := make(map[string]bool)
+	require.NoError(t, c.Fsck(false, false, func(resp *pfs.FsckResponse) error {
+		require.Equal(t, "", resp.Error)
+		require.Equal(t, "", resp.Fix)
+		if resp.OrphanedObject != nil {
+			orphaned[resp.OrphanedObject.Hash] = true
+		}
+		return nil
+	}))
+	require.False(t, orphaned[tagged.Hash])
+	require.True(t, orphaned[orphan.Hash])
+}
+
 func getClient(t *testing.T) pclient.APIClient {
 	dbName := "pachyderm_test_" + uuid.NewWithoutDashes()[0:12]
 	testDBs = append(testDBs, dbName)
@@ -0,0 +1,115 @@
+package server
+
+import (
+	"hash/adler32"
+	"sync"
+
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/shard"
+
+	protolion "go.pedge.io/lion/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// forwardedKey is set in the metadata of requests that one pachd forwards
+// to another, so that they're served where they land rather than forwarded
+// again while the shards are being reassigned.
+const forwardedKey = "pfs-forwarded"
+
+// metadataShards spreads responsibility for repos' metadata across the
+// pachds in the cluster. Each repo hashes to a shard, and the sharder
+// assigns shards to pachds, moving as few as it can when pachds come and
+// go. Metadata-heavy requests (finishing commits, listing commits and
+// files) are forwarded to the pachd that's responsible for their repo, so
+// that the work is spread across pachds and each repo's hashtrees stay
+// cached in one place.
+//
+// Every pachd can serve every request, since they share etcd and object
+// storage, so requests are served locally whenever forwarding isn't
+// possible.
+type metadataShards struct {
+	router      shard.Router
+	numShards   uint64
+	mu          sync.Mutex
+	localShards map[uint64]bool
+	version     int64
+}
+
+func newMetadataShards(router shard.Router, numShards uint64) *metadataShards {
+	return &metadataShards{
+		router:      router,
+		numShards:   numShards,
+		localShards: make(map[uint64]bool),
+		version:     shard.InvalidVersion,
+	}
+}
+
+// conn returns a connection to the pachd responsible for repo's metadata, or
+// nil if the request should be served locally: because this pachd is
+// responsible for it, because it was already forwarded, or because there's
+// no pachd to forward it to. s may be nil, in which case everything is
+// served locally.
+func (s *metadataShards) conn(ctx context.Context, repo *pfs.Repo) *grpc.ClientConn {
+	if s == nil || repo == nil || isForwarded(ctx) {
+		return nil
+	}
+	shard := uint64(adler32.Checksum([]byte(repo.Name))) % s.numShards
+	s.mu.Lock()
+	local := s.localShards[shard]
+	version := s.version
+	s.mu.Unlock()
+	if local || version < 0 {
+		return nil
+	}
+	conn, err := s.router.GetClientConn(shard, version)
+	if err != nil {
+		protolion.Infof("error finding the pachd responsible for repo %s, serving it locally: %v", repo.Name, err)
+		return nil
+	}
+	return conn
+}
+
+func (s *metadataShards) AddShard(shard uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.localShards[shard] = true
+	return nil
+}
+
+func (s *metadataShards) DeleteShard(shard uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.localShards, shard)
+	return nil
+}
+
+func (s *metadataShards) Version(version int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.version = version
+	return nil
+}
+
+// forwardedContext returns a context for forwarding the request in ctx to
+// another pachd.
+func forwardedContext(ctx context.Context) context.Context {
+	md := metadata.Pairs(forwardedKey, "true")
+	if incoming, ok := metadata.FromContext(ctx); ok {
+		for key, values := range incoming {
+			if key != forwardedKey {
+				md[key] = values
+			}
+		}
+	}
+	return metadata.NewContext(ctx, md)
+}
+
+func isForwarded(ctx context.Context) bool {
+	md, ok := metadata.FromContext(ctx)
+	if !ok {
+		return false
+	}
+	return len(md[forwardedKey]) > 0
+}
@@ -0,0 +1,146 @@
+// Package chunk splits a stream into content-defined chunks, whose
+// boundaries are chosen by a rolling hash of the bytes before them rather
+// than by their offsets. Inserting or removing bytes only changes the
+// chunks around the change, the rest of the stream is split the same way
+// it was before, so its chunks dedupe.
+//
+// The rolling hash is a gear hash: each byte shifts the hash left and adds
+// a random value for the byte, so the hash's top bits depend on the last 64
+// bytes. A chunk ends where enough of those top bits are zero.
+package chunk
+
+import (
+	"bufio"
+	"io"
+)
+
+// bufferSize is the size of the buffer that the stream is read through, and
+// the most of it that each read of a chunk scans for the chunk's boundary.
+const bufferSize = 64 * 1024
+
+// gear is the random value added to the hash for each byte. It's generated
+// from a fixed seed, and must never change, since chunks that are already
+// stored were split with it.
+var gear [256]uint64
+
+func init() {
+	// splitmix64
+	seed := uint64(0x5061636879646572) // "Pachyder"
+	for i := range gear {
+		seed += 0x9e3779b97f4a7c15
+		z := seed
+		z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+		z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+		gear[i] = z ^ (z >> 31)
+	}
+}
+
+// Splitter splits a stream into chunks averaging about avgSize bytes, none
+// of which are smaller than avgSize/4, except the last, or larger than
+// avgSize*4.
+type Splitter struct {
+	r       *bufio.Reader
+	minSize int64
+	maxSize int64
+	mask    uint64
+	// whole is set if the stream isn't split.
+	whole bool
+}
+
+// NewSplitter returns a Splitter that splits r into chunks averaging about
+// avgSize bytes. If avgSize isn't positive, r is one chunk.
+func NewSplitter(r io.Reader, avgSize int64) *Splitter {
+	if avgSize <= 0 {
+		return &Splitter{
+			r:     bufio.NewReaderSize(r, bufferSize),
+			whole: true,
+		}
+	}
+	minSize := avgSize / 4
+	if minSize < 1 {
+		minSize = 1
+	}
+	// A boundary is found, after the first minSize bytes, with probability
+	// 1/2^bits at each byte, so chunks average about minSize+2^bits bytes.
+	bits := uint(0)
+	for int64(1)<<(bits+1) <= avgSize-minSize {
+		bits++
+	}
+	return &Splitter{
+		r:       bufio.NewReaderSize(r, bufferSize),
+		minSize: minSize,
+		maxSize: avgSize * 4,
+		mask:    ^(^uint64(0) >> bits),
+	}
+}
+
+// Next returns a reader of the next chunk, which must be read to EOF
+// before Next or More is called again. An empty stream has one empty
+// chunk.
+func (s *Splitter) Next() io.Reader {
+	return &chunkReader{s: s}
+}
+
+// More returns whether there's another chunk in the stream.
+func (s *Splitter) More() (bool, error) {
+	if _, err := s.r.Peek(1); err == io.EOF {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// chunkReader reads one chunk from its Splitter's stream, stopping at the
+// chunk's boundary.
+type chunkReader struct {
+	s    *Splitter
+	size int64
+	hash uint64
+	done bool
+}
+
+func (c *chunkReader) Read(p []byte) (int, error) {
+	if c.done {
+		return 0, io.EOF
+	}
+	// Only what's buffered is scanned, so that reads don't wait for more of
+	// the stream than they need.
+	if c.s.r.Buffered() == 0 {
+		if _, err := c.s.r.Peek(1); err != nil {
+			if err == io.EOF {
+				c.done = true
+			}
+			return 0, err
+		}
+	}
+	n := c.s.r.Buffered()
+	if n > len(p) {
+		n = len(p)
+	}
+	// can't error because n bytes are buffered
+	buf, _ := c.s.r.Peek(n)
+	n = c.scan(buf)
+	copy(p, buf[:n])
+	if _, err := c.s.r.Discard(n); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+// scan feeds buf through the rolling hash, and returns how much of it is in
+// the chunk, which is all of it unless the chunk's boundary is in it.
+func (c *chunkReader) scan(buf []byte) int {
+	if c.s.whole {
+		return len(buf)
+	}
+	for i, b := range buf {
+		c.size++
+		c.hash = c.hash<<1 + gear[b]
+		if c.size >= c.s.maxSize || (c.size >= c.s.minSize && c.hash&c.s.mask == 0) {
+			c.done = true
+			return i + 1
+		}
+	}
+	return len(buf)
+}
@@ -0,0 +1,122 @@
+package chunk
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+func split(t *testing.T, r io.Reader, avgSize int64) [][]byte {
+	s := NewSplitter(r, avgSize)
+	var chunks [][]byte
+	for {
+		chunk, err := ioutil.ReadAll(s.Next())
+		require.NoError(t, err)
+		chunks = append(chunks, chunk)
+		more, err := s.More()
+		require.NoError(t, err)
+		if !more {
+			return chunks
+		}
+	}
+}
+
+func randBytes(r *rand.Rand, n int) []byte {
+	result := make([]byte, n)
+	r.Read(result)
+	return result
+}
+
+func TestSizes(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	data := randBytes(r, 1024*1024)
+	avgSize := int64(4 * 1024)
+	chunks := split(t, bytes.NewReader(data), avgSize)
+	require.True(t, len(chunks) > 1)
+	require.True(t, bytes.Equal(data, bytes.Join(chunks, nil)))
+	for i, chunk := range chunks {
+		if i < len(chunks)-1 {
+			require.True(t, int64(len(chunk)) >= avgSize/4)
+		}
+		require.True(t, int64(len(chunk)) <= avgSize*4)
+	}
+	// The average is roughly avgSize.
+	avg := int64(len(data) / len(chunks))
+	require.True(t, avg > avgSize/2 && avg < avgSize*2)
+}
+
+func TestMaxSize(t *testing.T) {
+	// Zeros never hit a boundary, so every chunk is cut at the max size.
+	data := make([]byte, 100*1024)
+	chunks := split(t, bytes.NewReader(data), 1024)
+	require.True(t, bytes.Equal(data, bytes.Join(chunks, nil)))
+	for _, chunk := range chunks[:len(chunks)-1] {
+		require.Equal(t, 4*1024, len(chunk))
+	}
+}
+
+func TestWhole(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	data := randBytes(r, 1024*1024)
+	chunks := split(t, bytes.NewReader(data), 0)
+	require.Equal(t, 1, len(chunks))
+	require.True(t, bytes.Equal(data, chunks[0]))
+}
+
+func TestEmpty(t *testing.T) {
+	chunks := split(t, bytes.NewReader(nil), 1024)
+	require.Equal(t, 1, len(chunks))
+	require.Equal(t, 0, len(chunks[0]))
+}
+
+func TestStableBoundaries(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	data := randBytes(r, 1024*1024)
+	offset := len(data) / 2
+	edited := append(append(append([]byte{}, data[:offset]...), randBytes(r, 100)...), data[offset:]...)
+	avgSize := int64(4 * 1024)
+	before := split(t, bytes.NewReader(data), avgSize)
+	after := split(t, bytes.NewReader(edited), avgSize)
+	require.True(t, bytes.Equal(edited, bytes.Join(after, nil)))
+
+	// Only the chunks around the insertion differ.
+	chunks := make(map[string]bool)
+	for _, chunk := range before {
+		chunks[string(chunk)] = true
+	}
+	changed := 0
+	for _, chunk := range after {
+		if !chunks[string(chunk)] {
+			changed++
+		}
+	}
+	require.True(t, changed <= 2)
+	require.True(t, len(after)-changed >= len(before)-2)
+}
+
+type oneByteReader struct {
+	r io.Reader
+}
+
+func (r oneByteReader) Read(p []byte) (int, error) {
+	if len(p) > 1 {
+		p = p[:1]
+	}
+	return r.r.Read(p)
+}
+
+func TestShortReads(t *testing.T) {
+	// Boundaries don't depend on how the stream is read.
+	r := rand.New(rand.NewSource(0))
+	data := randBytes(r, 256*1024)
+	expected := split(t, bytes.NewReader(data), 4*1024)
+	actual := split(t, oneByteReader{bytes.NewReader(data)}, 4*1024)
+	require.Equal(t, len(expected), len(actual))
+	for i := range expected {
+		require.True(t, bytes.Equal(expected[i], actual[i]))
+	}
+}
@@ -3,7 +3,9 @@ package cmdutil
 import (
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/spf13/cobra"
@@ -103,3 +105,23 @@ func (r *RepeatedStringArg) Set(s string) error {
 func (r *RepeatedStringArg) Type() string {
 	return "[]string"
 }
+
+// ParseTime parses either a duration before now, which in addition to the
+// units time.ParseDuration understands may be in days ("30d"), or an RFC3339
+// timestamp.
+func ParseTime(s string) (time.Time, error) {
+	if strings.HasSuffix(s, "d") {
+		days, err := strconv.ParseFloat(strings.TrimSuffix(s, "d"), 64)
+		if err == nil {
+			return time.Now().Add(-time.Duration(days * float64(24*time.Hour))), nil
+		}
+	}
+	if d, err := time.ParseDuration(s); err == nil {
+		return time.Now().Add(-d), nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("could not parse %q as a duration or an RFC3339 timestamp", s)
+	}
+	return t, nil
+}
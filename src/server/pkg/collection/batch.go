@@ -0,0 +1,156 @@
+package collection
+
+import (
+	v3 "github.com/coreos/etcd/clientv3"
+	"golang.org/x/net/context"
+)
+
+const (
+	// maxBatchOps is the most ops put in one branch of a batched txn, it's
+	// etcd's default limit (--max-txn-ops).
+	maxBatchOps = 128
+	// maxBatchBytes is the most bytes written by a batched txn, it's below
+	// etcd's default request size limit of 1.5MB.
+	maxBatchBytes = 1024 * 1024
+)
+
+// A Batcher runs STM transactions, combining those that are waiting to run
+// into a single etcd (or Postgres) transaction. Transactions are run one batch at a time,
+// so under low load each one is run by itself, and under high load (e.g.
+// many small commits) they're run in batches as big as etcd allows, instead
+// of contending with each other.
+//
+// The transactions in a batch are applied in order, each one sees the
+// writes of those before it, so the batch is equivalent to running them
+// one after another. A transaction that returns an error has its writes
+// undone and doesn't affect the rest of the batch. If the batch conflicts
+// with another write, all of its transactions are retried, as they are by
+// NewSTM, so they must be safe to apply more than once.
+//
+// apply must not call Batcher.NewSTM, since the batch it's in can't finish
+// until it returns.
+type Batcher struct {
+	store Store
+	txns  chan *batchedTxn
+}
+
+type batchedTxn struct {
+	ctx   context.Context
+	apply func(STM) error
+	done  chan stmResponse
+}
+
+// NewBatcher creates a Batcher that runs transactions against store. It
+// runs until ctx is cancelled.
+func NewBatcher(ctx context.Context, store Store) *Batcher {
+	b := &Batcher{
+		store: store,
+		txns:  make(chan *batchedTxn),
+	}
+	go b.run(ctx)
+	return b
+}
+
+// NewSTM is the same as the package's NewSTM, except that apply may be run
+// in the same etcd transaction as others. The response is that of the
+// whole batch.
+func (b *Batcher) NewSTM(ctx context.Context, apply func(STM) error) (*v3.TxnResponse, error) {
+	txn := &batchedTxn{
+		ctx:   ctx,
+		apply: apply,
+		done:  make(chan stmResponse, 1),
+	}
+	select {
+	case b.txns <- txn:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	// Once the txn has been handed off it may be committed, so we wait for
+	// it even if ctx is cancelled.
+	r := <-txn.done
+	return r.resp, r.err
+}
+
+func (b *Batcher) run(ctx context.Context) {
+	var pending []*batchedTxn
+	for {
+		if len(pending) == 0 {
+			select {
+			case txn := <-b.txns:
+				pending = append(pending, txn)
+			case <-ctx.Done():
+				return
+			}
+		}
+		// Add everything that's waiting to the batch.
+	drain:
+		for {
+			select {
+			case txn := <-b.txns:
+				pending = append(pending, txn)
+			default:
+				break drain
+			}
+		}
+		pending = b.runBatch(ctx, pending)
+	}
+}
+
+// runBatch runs as many of txns as fit in one etcd transaction, and
+// returns the ones that didn't fit.
+func (b *Batcher) runBatch(ctx context.Context, txns []*batchedTxn) []*batchedTxn {
+	var n int
+	var errs []error
+	resp, err := b.store.NewSTM(ctx, func(stm STM) error {
+		n, errs = 0, nil
+		for _, txn := range txns {
+			if err := txn.ctx.Err(); err != nil {
+				errs = append(errs, err)
+				n++
+				continue
+			}
+			undo := stm.checkpoint()
+			err := txn.apply(&batchedSTM{stm, txn.ctx})
+			if err != nil {
+				undo()
+			} else if ops, bytes := stm.size(); n > 0 && (ops > maxBatchOps || bytes > maxBatchBytes) {
+				// The txn doesn't fit, it goes in the next batch. Its reads
+				// stay in the read set, which is harmless.
+				undo()
+				break
+			}
+			errs = append(errs, err)
+			n++
+		}
+		return nil
+	})
+	for i, txn := range txns[:n] {
+		if err != nil {
+			txn.done <- stmResponse{nil, err}
+		} else if errs[i] != nil {
+			txn.done <- stmResponse{nil, errs[i]}
+		} else {
+			txn.done <- stmResponse{resp, nil}
+		}
+	}
+	if err != nil && n == 0 {
+		// The batch failed before anything was applied, e.g. because etcd
+		// is unreachable, fail the rest too rather than retrying forever.
+		for _, txn := range txns {
+			txn.done <- stmResponse{nil, err}
+		}
+		return nil
+	}
+	return txns[n:]
+}
+
+// batchedSTM is an STM in a batch, with the context of the txn that's
+// applied to it.
+type batchedSTM struct {
+	STM
+	ctx context.Context
+}
+
+func (s *batchedSTM) Context() context.Context {
+	return s.ctx
+}
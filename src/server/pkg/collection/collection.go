@@ -15,9 +15,9 @@ import (
 )
 
 type collection struct {
-	etcdClient *etcd.Client
-	prefix     string
-	indexes    []Index
+	store   Store
+	prefix  string
+	indexes []Index
 	// We need this to figure out the concrete type of the objects
 	// that this collection is storing. It's pretty retarded, but
 	// not sure what else we can do since types in Go are not first-class
@@ -29,6 +29,11 @@ type collection struct {
 
 // NewCollection creates a new collection.
 func NewCollection(etcdClient *etcd.Client, prefix string, indexes []Index, template proto.Message) Collection {
+	return NewStoreCollection(NewEtcdStore(etcdClient), prefix, indexes, template)
+}
+
+// NewStoreCollection creates a new collection that keeps its items in store.
+func NewStoreCollection(store Store, prefix string, indexes []Index, template proto.Message) Collection {
 	// We want to ensure that the prefix always ends with a trailing
 	// slash.  Otherwise, when you list the items under a collection
 	// such as `foo`, you might end up listing items under `foobar`
@@ -38,10 +43,10 @@ func NewCollection(etcdClient *etcd.Client, prefix string, indexes []Index, temp
 	}
 
 	return &collection{
-		prefix:     prefix,
-		etcdClient: etcdClient,
-		indexes:    indexes,
-		template:   template,
+		prefix:   prefix,
+		store:    store,
+		indexes:  indexes,
+		template: template,
 	}
 }
 
@@ -127,6 +132,23 @@ func (c *readWriteCollection) getMultiIndexPaths(val interface{}, index Index, k
 }
 
 func (c *readWriteCollection) Put(key string, val proto.Marshaler) {
+	c.put(key, val, 0)
+}
+
+func (c *readWriteCollection) PutTTL(key string, val proto.Marshaler, ttl int64) {
+	c.put(key, val, ttl)
+}
+
+// put writes key and its index entries, which expire after ttl seconds if
+// it's set.
+func (c *readWriteCollection) put(key string, val proto.Marshaler, ttl int64) {
+	putKey := func(key, val string) {
+		if ttl > 0 {
+			c.stm.PutTTL(key, val, ttl)
+		} else {
+			c.stm.Put(key, val)
+		}
+	}
 	if c.indexes != nil {
 		clone := cloneProtoMsg(val)
 
@@ -139,7 +161,7 @@ func (c *readWriteCollection) Put(key string, val proto.Marshaler) {
 					// we might trigger an unnecessary event if someone is
 					// watching the index
 					if c.stm.Get(indexPath) == "" {
-						c.stm.Put(indexPath, key)
+						putKey(indexPath, key)
 					}
 				}
 				// If we can get the original value, we remove the original indexes
@@ -169,13 +191,13 @@ func (c *readWriteCollection) Put(key string, val proto.Marshaler) {
 				// we might trigger an unnecessary event if someone is
 				// watching the index
 				if c.stm.Get(indexPath) == "" {
-					c.stm.Put(indexPath, key)
+					putKey(indexPath, key)
 				}
 			}
 		}
 	}
 	bytes, _ := val.Marshal()
-	c.stm.Put(c.path(key), string(bytes))
+	putKey(c.path(key), string(bytes))
 }
 
 func (c *readWriteCollection) Create(key string, val proto.Marshaler) error {
@@ -297,32 +319,32 @@ type readonlyCollection struct {
 }
 
 func (c *readonlyCollection) Get(key string, val proto.Unmarshaler) error {
-	resp, err := c.etcdClient.Get(c.ctx, c.path(key))
+	kv, err := c.store.Get(c.ctx, c.path(key))
 	if err != nil {
 		return err
 	}
 
-	if len(resp.Kvs) == 0 {
+	if kv == nil {
 		return ErrNotFound{c.prefix, key}
 	}
 
-	return val.Unmarshal(resp.Kvs[0].Value)
+	return val.Unmarshal(kv.Value)
 }
 
 // an indirect iterator goes through a list of keys and retrieve those
 // items from the collection.
 type indirectIterator struct {
 	index int
-	resp  *etcd.GetResponse
+	kvs   []*KV
 	col   *readonlyCollection
 }
 
 func (i *indirectIterator) Next(key *string, val proto.Unmarshaler) (ok bool, retErr error) {
-	if i.index < len(i.resp.Kvs) {
-		kv := i.resp.Kvs[i.index]
+	if i.index < len(i.kvs) {
+		kv := i.kvs[i.index]
 		i.index++
 
-		*key = path.Base(string(kv.Key))
+		*key = path.Base(kv.Key)
 		if err := i.col.Get(*key, val); err != nil {
 			return false, err
 		}
@@ -334,13 +356,13 @@ func (i *indirectIterator) Next(key *string, val proto.Unmarshaler) (ok bool, re
 
 func (c *readonlyCollection) GetByIndex(index Index, val interface{}) (Iterator, error) {
 	valStr := fmt.Sprintf("%s", val)
-	resp, err := c.etcdClient.Get(c.ctx, c.indexDir(index, valStr), etcd.WithPrefix(), etcd.WithSort(etcd.SortByModRevision, etcd.SortDescend))
+	kvs, err := c.store.List(c.ctx, c.indexDir(index, valStr), false)
 	if err != nil {
 		return nil, err
 	}
 	return &indirectIterator{
-		resp: resp,
-		col:  c,
+		kvs: kvs,
+		col: c,
 	}, nil
 }
 
@@ -348,26 +370,26 @@ func (c *readonlyCollection) GetByIndex(index Index, val interface{}) (Iterator,
 // The objects are sorted by revision time in descending order, i.e. newer
 // objects are returned first.
 func (c *readonlyCollection) List() (Iterator, error) {
-	resp, err := c.etcdClient.Get(c.ctx, c.prefix, etcd.WithPrefix(), etcd.WithSort(etcd.SortByModRevision, etcd.SortDescend))
+	kvs, err := c.store.List(c.ctx, c.prefix, false)
 	if err != nil {
 		return nil, err
 	}
 	return &iterator{
-		resp: resp,
+		kvs: kvs,
 	}, nil
 }
 
 type iterator struct {
 	index int
-	resp  *etcd.GetResponse
+	kvs   []*KV
 }
 
 func (i *iterator) Next(key *string, val proto.Unmarshaler) (ok bool, retErr error) {
-	if i.index < len(i.resp.Kvs) {
-		kv := i.resp.Kvs[i.index]
+	if i.index < len(i.kvs) {
+		kv := i.kvs[i.index]
 		i.index++
 
-		*key = path.Base(string(kv.Key))
+		*key = path.Base(kv.Key)
 		if err := val.Unmarshal(kv.Value); err != nil {
 			return false, err
 		}
@@ -380,18 +402,18 @@ func (i *iterator) Next(key *string, val proto.Unmarshaler) (ok bool, retErr err
 // Watch a collection, returning the current content of the collection as
 // well as any future additions.
 func (c *readonlyCollection) Watch() (watch.Watcher, error) {
-	return watch.NewWatcher(c.ctx, c.etcdClient, c.prefix)
+	return c.store.Watch(c.ctx, c.prefix, false)
 }
 
 func (c *readonlyCollection) WatchWithPrev() (watch.Watcher, error) {
-	return watch.NewWatcherWithPrev(c.ctx, c.etcdClient, c.prefix)
+	return c.store.Watch(c.ctx, c.prefix, true)
 }
 
 // WatchByIndex watches items in a collection that match a particular index
 func (c *readonlyCollection) WatchByIndex(index Index, val interface{}) (watch.Watcher, error) {
 	eventCh := make(chan *watch.Event)
 	done := make(chan struct{})
-	watcher, err := watch.NewWatcher(c.ctx, c.etcdClient, c.indexDir(index, fmt.Sprintf("%s", val)))
+	watcher, err := c.store.Watch(c.ctx, c.indexDir(index, fmt.Sprintf("%s", val)), false)
 	if err != nil {
 		return nil, err
 	}
@@ -426,18 +448,18 @@ func (c *readonlyCollection) WatchByIndex(index Index, val interface{}) (watch.W
 				// pass along the error
 				return ev.Err
 			case watch.EventPut:
-				resp, err := c.etcdClient.Get(c.ctx, c.path(path.Base(string(ev.Key))))
+				kv, err := c.store.Get(c.ctx, c.path(path.Base(string(ev.Key))))
 				if err != nil {
 					return err
 				}
-				if len(resp.Kvs) == 0 {
+				if kv == nil {
 					// this happens only if the item was deleted shortly after
 					// we receive this event.
 					continue
 				}
 				directEv = &watch.Event{
 					Key:   []byte(path.Base(string(ev.Key))),
-					Value: resp.Kvs[0].Value,
+					Value: kv.Value,
 					Type:  ev.Type,
 				}
 			case watch.EventDelete:
@@ -455,5 +477,5 @@ func (c *readonlyCollection) WatchByIndex(index Index, val interface{}) (watch.W
 // WatchOne watches a given item.  The first value returned from the watch
 // will be the current value of the item.
 func (c *readonlyCollection) WatchOne(key string) (watch.Watcher, error) {
-	return watch.NewWatcher(c.ctx, c.etcdClient, c.path(key))
+	return c.store.Watch(c.ctx, c.path(key), false)
 }
@@ -2,6 +2,12 @@ package collection
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -20,11 +26,13 @@ var (
 )
 
 func TestIndex(t *testing.T) {
-	etcdClient, err := getEtcdClient()
-	require.NoError(t, err)
+	testStores(t, testIndex)
+}
+
+func testIndex(t *testing.T, store Store) {
 	uuidPrefix := uuid.NewWithoutDashes()
 
-	jobInfos := NewCollection(etcdClient, uuidPrefix, []Index{pipelineIndex}, &pps.JobInfo{})
+	jobInfos := NewStoreCollection(store, uuidPrefix, []Index{pipelineIndex}, &pps.JobInfo{})
 
 	j1 := &pps.JobInfo{
 		Job:      &pps.Job{"j1"},
@@ -38,7 +46,7 @@ func TestIndex(t *testing.T) {
 		Job:      &pps.Job{"j3"},
 		Pipeline: &pps.Pipeline{"p2"},
 	}
-	_, err = NewSTM(context.Background(), etcdClient, func(stm STM) error {
+	_, err := store.NewSTM(context.Background(), func(stm STM) error {
 		jobInfos := jobInfos.ReadWrite(stm)
 		jobInfos.Put(j1.Job.ID, j1)
 		jobInfos.Put(j2.Job.ID, j2)
@@ -80,17 +88,19 @@ func TestIndex(t *testing.T) {
 }
 
 func TestIndexWatch(t *testing.T) {
-	etcdClient, err := getEtcdClient()
-	require.NoError(t, err)
+	testStores(t, testIndexWatch)
+}
+
+func testIndexWatch(t *testing.T, store Store) {
 	uuidPrefix := uuid.NewWithoutDashes()
 
-	jobInfos := NewCollection(etcdClient, uuidPrefix, []Index{pipelineIndex}, &pps.JobInfo{})
+	jobInfos := NewStoreCollection(store, uuidPrefix, []Index{pipelineIndex}, &pps.JobInfo{})
 
 	j1 := &pps.JobInfo{
 		Job:      &pps.Job{"j1"},
 		Pipeline: &pps.Pipeline{"p1"},
 	}
-	_, err = NewSTM(context.Background(), etcdClient, func(stm STM) error {
+	_, err := store.NewSTM(context.Background(), func(stm STM) error {
 		jobInfos := jobInfos.ReadWrite(stm)
 		jobInfos.Put(j1.Job.ID, j1)
 		return nil
@@ -113,7 +123,7 @@ func TestIndexWatch(t *testing.T) {
 
 	// Now we will put j1 again, unchanged.  We want to make sure
 	// that we do not receive an event.
-	_, err = NewSTM(context.Background(), etcdClient, func(stm STM) error {
+	_, err = store.NewSTM(context.Background(), func(stm STM) error {
 		jobInfos := jobInfos.ReadWrite(stm)
 		jobInfos.Put(j1.Job.ID, j1)
 		return nil
@@ -130,7 +140,7 @@ func TestIndexWatch(t *testing.T) {
 		Pipeline: &pps.Pipeline{"p1"},
 	}
 
-	_, err = NewSTM(context.Background(), etcdClient, func(stm STM) error {
+	_, err = store.NewSTM(context.Background(), func(stm STM) error {
 		jobInfos := jobInfos.ReadWrite(stm)
 		jobInfos.Put(j2.Job.ID, j2)
 		return nil
@@ -148,7 +158,7 @@ func TestIndexWatch(t *testing.T) {
 		Job:      &pps.Job{"j1"},
 		Pipeline: &pps.Pipeline{"p3"},
 	}
-	_, err = NewSTM(context.Background(), etcdClient, func(stm STM) error {
+	_, err = store.NewSTM(context.Background(), func(stm STM) error {
 		jobInfos := jobInfos.ReadWrite(stm)
 		jobInfos.Put(j1.Job.ID, j1Prime)
 		return nil
@@ -161,7 +171,7 @@ func TestIndexWatch(t *testing.T) {
 	require.NoError(t, event.Unmarshal(&ID, job))
 	require.Equal(t, j1.Job.ID, ID)
 
-	_, err = NewSTM(context.Background(), etcdClient, func(stm STM) error {
+	_, err = store.NewSTM(context.Background(), func(stm STM) error {
 		jobInfos := jobInfos.ReadWrite(stm)
 		jobInfos.Delete(j2.Job.ID)
 		return nil
@@ -176,11 +186,13 @@ func TestIndexWatch(t *testing.T) {
 }
 
 func TestMultiIndex(t *testing.T) {
-	etcdClient, err := getEtcdClient()
-	require.NoError(t, err)
+	testStores(t, testMultiIndex)
+}
+
+func testMultiIndex(t *testing.T, store Store) {
 	uuidPrefix := uuid.NewWithoutDashes()
 
-	jobInfos := NewCollection(etcdClient, uuidPrefix, []Index{inputsMultiIndex}, &pps.JobInfo{})
+	jobInfos := NewStoreCollection(store, uuidPrefix, []Index{inputsMultiIndex}, &pps.JobInfo{})
 
 	j1 := &pps.JobInfo{
 		Job: &pps.Job{"j1"},
@@ -198,7 +210,7 @@ func TestMultiIndex(t *testing.T) {
 			{Name: "input3"},
 		},
 	}
-	_, err = NewSTM(context.Background(), etcdClient, func(stm STM) error {
+	_, err := store.NewSTM(context.Background(), func(stm STM) error {
 		jobInfos := jobInfos.ReadWrite(stm)
 		jobInfos.Put(j1.Job.ID, j1)
 		jobInfos.Put(j2.Job.ID, j2)
@@ -241,7 +253,7 @@ func TestMultiIndex(t *testing.T) {
 	require.Equal(t, j2, job)
 
 	j1.Inputs[2] = &pps.JobInput{Name: "input4"}
-	_, err = NewSTM(context.Background(), etcdClient, func(stm STM) error {
+	_, err = store.NewSTM(context.Background(), func(stm STM) error {
 		jobInfos := jobInfos.ReadWrite(stm)
 		jobInfos.Put(j1.Job.ID, j1)
 		return nil
@@ -268,7 +280,7 @@ func TestMultiIndex(t *testing.T) {
 	require.Equal(t, j1.Job.ID, ID)
 	require.Equal(t, j1, job)
 
-	_, err = NewSTM(context.Background(), etcdClient, func(stm STM) error {
+	_, err = store.NewSTM(context.Background(), func(stm STM) error {
 		jobInfos := jobInfos.ReadWrite(stm)
 		jobInfos.Delete(j1.Job.ID)
 		return nil
@@ -285,6 +297,251 @@ func TestMultiIndex(t *testing.T) {
 	require.Equal(t, j2, job)
 }
 
+func TestSTMCheckpoint(t *testing.T) {
+	testStores(t, testSTMCheckpoint)
+}
+
+func testSTMCheckpoint(t *testing.T, store Store) {
+	a, b := uuid.NewWithoutDashes(), uuid.NewWithoutDashes()
+
+	var sizes [][2]int
+	var value string
+	_, err := store.NewSTM(context.Background(), func(stm STM) error {
+		sizes = nil
+		stm.Put(a, "1")
+		undo := stm.checkpoint()
+		stm.Put(a, "22")
+		stm.Put(b, "333")
+		ops, bytes := stm.size()
+		sizes = append(sizes, [2]int{ops, bytes})
+		undo()
+		ops, bytes = stm.size()
+		sizes = append(sizes, [2]int{ops, bytes})
+		value = stm.Get(a)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, [][2]int{{2, len(a) + 2 + len(b) + 3}, {1, len(a) + 1}}, sizes)
+	require.Equal(t, "1", value)
+
+	kv, err := store.Get(context.Background(), a)
+	require.NoError(t, err)
+	require.Equal(t, "1", string(kv.Value))
+	kv, err = store.Get(context.Background(), b)
+	require.NoError(t, err)
+	require.Nil(t, kv)
+}
+
+func TestBatcher(t *testing.T) {
+	testStores(t, testBatcher)
+}
+
+func testBatcher(t *testing.T, store Store) {
+	uuidPrefix := uuid.NewWithoutDashes()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	batcher := NewBatcher(ctx, store)
+
+	// Every txn increments the same counter, so they only add up if each
+	// txn in a batch sees the writes of the ones before it.
+	counter := uuidPrefix + "counter"
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := batcher.NewSTM(ctx, func(stm STM) error {
+				n, _ := strconv.Atoi(stm.Get(counter))
+				stm.Put(counter, strconv.Itoa(n+1))
+				stm.Put(fmt.Sprintf("%s%d", uuidPrefix, i), "")
+				return nil
+			})
+			require.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	kv, err := store.Get(ctx, counter)
+	require.NoError(t, err)
+	require.Equal(t, "100", string(kv.Value))
+	kvs, err := store.List(ctx, uuidPrefix, true)
+	require.NoError(t, err)
+	require.Equal(t, 101, len(kvs))
+}
+
+// newTestTxns returns a txn for each of applies, to pass to runBatch.
+func newTestTxns(ctx context.Context, applies ...func(STM) error) []*batchedTxn {
+	var txns []*batchedTxn
+	for _, apply := range applies {
+		txns = append(txns, &batchedTxn{
+			ctx:   ctx,
+			apply: apply,
+			done:  make(chan stmResponse, 1),
+		})
+	}
+	return txns
+}
+
+func TestBatcherUndo(t *testing.T) {
+	testStores(t, testBatcherUndo)
+}
+
+func testBatcherUndo(t *testing.T, store Store) {
+	uuidPrefix := uuid.NewWithoutDashes()
+	batcher := &Batcher{store: store}
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	put := func(key string, err error) func(STM) error {
+		return func(stm STM) error {
+			stm.Put(uuidPrefix+key, key)
+			return err
+		}
+	}
+	txns := newTestTxns(context.Background(), put("a", nil), put("b", errors.New("failed")), put("c", nil))
+	txns = append(txns, newTestTxns(cancelled, put("d", nil))...)
+	require.Equal(t, 0, len(batcher.runBatch(context.Background(), txns)))
+
+	// The failed and cancelled txns' writes are undone, the others' aren't.
+	r := <-txns[0].done
+	require.NoError(t, r.err)
+	r = <-txns[1].done
+	require.YesError(t, r.err)
+	r = <-txns[2].done
+	require.NoError(t, r.err)
+	r = <-txns[3].done
+	require.YesError(t, r.err)
+	kvs, err := store.List(context.Background(), uuidPrefix, true)
+	require.NoError(t, err)
+	var keys []string
+	for _, kv := range kvs {
+		keys = append(keys, strings.TrimPrefix(kv.Key, uuidPrefix))
+	}
+	require.Equal(t, []string{"a", "c"}, keys)
+}
+
+func TestBatcherSizeLimit(t *testing.T) {
+	testStores(t, testBatcherSizeLimit)
+}
+
+func testBatcherSizeLimit(t *testing.T, store Store) {
+	uuidPrefix := uuid.NewWithoutDashes()
+	batcher := &Batcher{store: store}
+
+	put := func(key string, value string) func(STM) error {
+		return func(stm STM) error {
+			stm.Put(uuidPrefix+key, value)
+			return nil
+		}
+	}
+	checkBatch := func(txns []*batchedTxn, n int) {
+		rest := batcher.runBatch(context.Background(), txns)
+		require.Equal(t, len(txns)-n, len(rest))
+		for _, txn := range txns[:n] {
+			r := <-txn.done
+			require.NoError(t, r.err)
+		}
+		for _, txn := range rest {
+			require.Equal(t, 0, len(txn.done))
+		}
+	}
+
+	// Too many ops
+	var applies []func(STM) error
+	for i := 0; i < 2*maxBatchOps; i++ {
+		applies = append(applies, put(fmt.Sprintf("ops%d", i), ""))
+	}
+	txns := newTestTxns(context.Background(), applies...)
+	checkBatch(txns, maxBatchOps)
+	checkBatch(txns[maxBatchOps:], maxBatchOps)
+
+	// Too many bytes, a txn that's too big by itself is still run.
+	value := strings.Repeat("a", maxBatchBytes/2)
+	txns = newTestTxns(context.Background(), put("bytes0", value), put("bytes1", value), put("bytes2", value+value))
+	checkBatch(txns, 1)
+	checkBatch(txns[1:], 1)
+	checkBatch(txns[2:], 1)
+
+	kvs, err := store.List(context.Background(), uuidPrefix, true)
+	require.NoError(t, err)
+	require.Equal(t, 2*maxBatchOps+3, len(kvs))
+}
+
+func TestPutTTL(t *testing.T) {
+	testStores(t, testPutTTL)
+}
+
+func testPutTTL(t *testing.T, store Store) {
+	uuidPrefix := uuid.NewWithoutDashes()
+
+	jobInfos := NewStoreCollection(store, uuidPrefix, []Index{pipelineIndex}, &pps.JobInfo{})
+	j1 := &pps.JobInfo{
+		Job:      &pps.Job{"j1"},
+		Pipeline: &pps.Pipeline{"p1"},
+	}
+	_, err := store.NewSTM(context.Background(), func(stm STM) error {
+		jobInfos.ReadWrite(stm).PutTTL(j1.Job.ID, j1, 2)
+		return nil
+	})
+	require.NoError(t, err)
+
+	jobInfosReadonly := jobInfos.ReadOnly(context.Background())
+	job := new(pps.JobInfo)
+	require.NoError(t, jobInfosReadonly.Get(j1.Job.ID, job))
+	require.Equal(t, j1, job)
+	iter, err := jobInfosReadonly.GetByIndex(pipelineIndex, j1.Pipeline)
+	require.NoError(t, err)
+	var ID string
+	ok, err := iter.Next(&ID, job)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// The item and its index entry are deleted when the TTL passes.
+	watcher, err := jobInfosReadonly.WatchOne(j1.Job.ID)
+	require.NoError(t, err)
+	defer watcher.Close()
+	event := <-watcher.Watch()
+	require.NoError(t, event.Err)
+	require.Equal(t, watch.EventPut, event.Type)
+	select {
+	case event := <-watcher.Watch():
+		require.NoError(t, event.Err)
+		require.Equal(t, watch.EventDelete, event.Type)
+	case <-time.After(30 * time.Second):
+		t.Fatal("item wasn't deleted after its TTL")
+	}
+	require.YesError(t, jobInfosReadonly.Get(j1.Job.ID, job))
+	iter, err = jobInfosReadonly.GetByIndex(pipelineIndex, j1.Pipeline)
+	require.NoError(t, err)
+	ok, err = iter.Next(&ID, job)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+// postgresTestEnv is the environment variable that holds the data source of
+// a Postgres database to run the tests against.
+const postgresTestEnv = "PACH_TEST_POSTGRES"
+
+// testStores runs test against etcd, and against Postgres if
+// PACH_TEST_POSTGRES is set.
+func testStores(t *testing.T, test func(*testing.T, Store)) {
+	t.Run("etcd", func(t *testing.T) {
+		etcdClient, err := getEtcdClient()
+		require.NoError(t, err)
+		test(t, NewEtcdStore(etcdClient))
+	})
+	t.Run("postgres", func(t *testing.T) {
+		dataSource := os.Getenv(postgresTestEnv)
+		if dataSource == "" {
+			t.Skipf("%s isn't set", postgresTestEnv)
+		}
+		store, err := NewPostgresStore(dataSource)
+		require.NoError(t, err)
+		test(t, store)
+	})
+}
+
 func getEtcdClient() (*etcd.Client, error) {
 	etcdClient, err := etcd.New(etcd.Config{
 		Endpoints:   []string{"localhost:2379"},
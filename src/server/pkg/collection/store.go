@@ -0,0 +1,102 @@
+package collection
+
+import (
+	"github.com/pachyderm/pachyderm/src/server/pkg/watch"
+
+	etcd "github.com/coreos/etcd/clientv3"
+	"golang.org/x/net/context"
+)
+
+// KV is a key, its value and the revision at which it was last modified.
+type KV struct {
+	Key         string
+	Value       []byte
+	ModRevision int64
+}
+
+// A Store is the key-value store that collections keep their items in. It's
+// etcd by default, metadata that outgrows etcd can be kept in Postgres
+// instead (see NewPostgresStore).
+type Store interface {
+	// Get returns key, or nil if it doesn't exist.
+	Get(ctx context.Context, key string) (*KV, error)
+	// List returns the keys under prefix sorted by revision, oldest first if
+	// ascending is true and newest first otherwise.
+	List(ctx context.Context, prefix string, ascending bool) ([]*KV, error)
+	// Put writes key, outside of any transaction.
+	Put(ctx context.Context, key string, value string) error
+	// DeleteAll deletes the keys under prefix, outside of any transaction.
+	DeleteAll(ctx context.Context, prefix string) error
+	// Watch returns the keys under prefix, followed by changes to them, as
+	// watch.NewWatcher does for etcd. If withPrev is true, events include
+	// the previous value of the key.
+	Watch(ctx context.Context, prefix string, withPrev bool) (watch.Watcher, error)
+	// NewSTM runs apply in a serializable transaction, retrying it if it
+	// conflicts with another, see the package's NewSTM.
+	NewSTM(ctx context.Context, apply func(STM) error) (*etcd.TxnResponse, error)
+}
+
+type etcdStore struct {
+	client *etcd.Client
+}
+
+// NewEtcdStore returns a Store that keeps its keys in etcd.
+func NewEtcdStore(client *etcd.Client) Store {
+	return &etcdStore{client: client}
+}
+
+func (s *etcdStore) Get(ctx context.Context, key string) (*KV, error) {
+	resp, err := s.client.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Kvs) == 0 {
+		return nil, nil
+	}
+	return &KV{
+		Key:         string(resp.Kvs[0].Key),
+		Value:       resp.Kvs[0].Value,
+		ModRevision: resp.Kvs[0].ModRevision,
+	}, nil
+}
+
+func (s *etcdStore) List(ctx context.Context, prefix string, ascending bool) ([]*KV, error) {
+	order := etcd.SortDescend
+	if ascending {
+		order = etcd.SortAscend
+	}
+	resp, err := s.client.Get(ctx, prefix, etcd.WithPrefix(), etcd.WithSort(etcd.SortByModRevision, order))
+	if err != nil {
+		return nil, err
+	}
+	kvs := make([]*KV, len(resp.Kvs))
+	for i, kv := range resp.Kvs {
+		kvs[i] = &KV{
+			Key:         string(kv.Key),
+			Value:       kv.Value,
+			ModRevision: kv.ModRevision,
+		}
+	}
+	return kvs, nil
+}
+
+func (s *etcdStore) Put(ctx context.Context, key string, value string) error {
+	_, err := s.client.Put(ctx, key, value)
+	return err
+}
+
+func (s *etcdStore) DeleteAll(ctx context.Context, prefix string) error {
+	_, err := s.client.Delete(ctx, prefix, etcd.WithPrefix())
+	return err
+}
+
+func (s *etcdStore) Watch(ctx context.Context, prefix string, withPrev bool) (watch.Watcher, error) {
+	if withPrev {
+		return watch.NewWatcherWithPrev(ctx, s.client, prefix)
+	}
+	return watch.NewWatcher(ctx, s.client, prefix)
+}
+
+func (s *etcdStore) NewSTM(ctx context.Context, apply func(STM) error) (*etcd.TxnResponse, error) {
+	return newSTMSerializable(ctx, s.client, apply)
+}
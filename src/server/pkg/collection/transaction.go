@@ -29,6 +29,9 @@ type STM interface {
 	Get(key string) string
 	// Put adds a value for a key to the write set.
 	Put(key, val string, opts ...v3.OpOption)
+	// PutTTL adds a value for a key that expires after ttl seconds to the
+	// write set.
+	PutTTL(key, val string, ttl int64)
 	// Rev returns the revision of a key in the read set.
 	Rev(key string) int64
 	// Del deletes a key.
@@ -43,6 +46,12 @@ type STM interface {
 	// commit attempts to apply the txn's changes to the server.
 	commit() *v3.TxnResponse
 	reset()
+	// checkpoint returns a function that undoes the writes made since
+	// checkpoint was called.
+	checkpoint() func()
+	// size returns the number of ops the txn needs, and the number of bytes
+	// it writes.
+	size() (int, int)
 }
 
 // stmError safely passes STM errors through panic to the STM error channel.
@@ -142,6 +151,16 @@ func (s *stm) Put(key, val string, opts ...v3.OpOption) {
 	s.wset[key] = stmPut{val, v3.OpPut(key, val, opts...)}
 }
 
+// PutTTL grants a lease for each put, if the txn is retried the leases
+// granted for earlier attempts expire unused.
+func (s *stm) PutTTL(key, val string, ttl int64) {
+	lease, err := s.client.Grant(s.ctx, ttl)
+	if err != nil {
+		panic(stmError{err})
+	}
+	s.Put(key, val, v3.WithLease(lease.ID))
+}
+
 func (s *stm) Del(key string) { s.wset[key] = stmPut{"", v3.OpDelete(key)} }
 
 func (s *stm) DelAll(key string) { s.wset[key] = stmPut{"", v3.OpDelete(key, v3.WithPrefix())} }
@@ -199,6 +218,26 @@ func (s *stm) reset() {
 	s.wset = make(map[string]stmPut)
 }
 
+func (s *stm) checkpoint() func() {
+	wset := make(map[string]stmPut, len(s.wset))
+	for k, v := range s.wset {
+		wset[k] = v
+	}
+	return func() { s.wset = wset }
+}
+
+func (s *stm) size() (int, int) {
+	ops := len(s.rset)
+	if len(s.wset) > ops {
+		ops = len(s.wset)
+	}
+	var bytes int
+	for k, v := range s.wset {
+		bytes += len(k) + len(v.val)
+	}
+	return ops, bytes
+}
+
 type stmSerializable struct {
 	stm
 	prefetch map[string]*v3.GetResponse
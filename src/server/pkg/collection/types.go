@@ -58,6 +58,9 @@ type Index struct {
 type ReadWriteCollection interface {
 	Get(key string, val proto.Unmarshaler) error
 	Put(key string, val proto.Marshaler)
+	// PutTTL is like Put, except that the item (and its index entries)
+	// expires after ttl seconds.
+	PutTTL(key string, val proto.Marshaler, ttl int64)
 	Create(key string, val proto.Marshaler) error
 	Delete(key string) error
 	DeleteAll()
@@ -0,0 +1,146 @@
+// Package columnar splits files in record oriented binary formats, Avro
+// and Parquet, along the boundaries that the formats define, so that each
+// piece is a valid file of the same format.
+package columnar
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+)
+
+const (
+	avroSyncSize = 16
+	// maxAvroBlockSize guards against allocating huge buffers for corrupt
+	// files.
+	maxAvroBlockSize = 1 << 30
+)
+
+var avroMagic = []byte{'O', 'b', 'j', 1}
+
+// AvroReader reads the blocks of an Avro object container file.
+type AvroReader struct {
+	r *bufio.Reader
+	// Header is the file's header, a valid Avro file is the header followed
+	// by any number of its blocks.
+	Header []byte
+	sync   []byte
+	// raw records the bytes that have been read since it was last reset.
+	raw bytes.Buffer
+}
+
+// NewAvroReader reads the header of the Avro file in r.
+func NewAvroReader(r io.Reader) (*AvroReader, error) {
+	a := &AvroReader{r: bufio.NewReader(r)}
+	magic, err := a.readFull(int64(len(avroMagic)))
+	if err != nil {
+		return nil, fmt.Errorf("error reading avro header: %v", err)
+	}
+	if !bytes.Equal(magic, avroMagic) {
+		return nil, fmt.Errorf("not an avro object container file")
+	}
+	// The metadata is a map of string to bytes, encoded as a series of
+	// blocks of entries ending with an empty block.
+	for {
+		count, err := a.readLong()
+		if err != nil {
+			return nil, fmt.Errorf("error reading avro header: %v", err)
+		}
+		if count == 0 {
+			break
+		}
+		if count < 0 {
+			count = -count
+			// The block's size in bytes, which we don't need.
+			if _, err := a.readLong(); err != nil {
+				return nil, fmt.Errorf("error reading avro header: %v", err)
+			}
+		}
+		// Each entry is a key and a value.
+		for i := int64(0); i < 2*count; i++ {
+			size, err := a.readLong()
+			if err != nil {
+				return nil, fmt.Errorf("error reading avro header: %v", err)
+			}
+			if _, err := a.readFull(size); err != nil {
+				return nil, fmt.Errorf("error reading avro header: %v", err)
+			}
+		}
+	}
+	if a.sync, err = a.readFull(avroSyncSize); err != nil {
+		return nil, fmt.Errorf("error reading avro header: %v", err)
+	}
+	a.Header = append([]byte{}, a.raw.Bytes()...)
+	return a, nil
+}
+
+// ReadBlock returns the next block of the file, as it's encoded in the
+// file, and the number of records in it. It returns io.EOF when there are
+// no more blocks.
+func (a *AvroReader) ReadBlock() ([]byte, int64, error) {
+	a.raw.Reset()
+	if _, err := a.r.Peek(1); err != nil {
+		return nil, 0, err
+	}
+	count, err := a.readLong()
+	if err != nil {
+		return nil, 0, unexpectedEOF(err)
+	}
+	if count < 0 {
+		return nil, 0, fmt.Errorf("invalid avro block count %d", count)
+	}
+	size, err := a.readLong()
+	if err != nil {
+		return nil, 0, unexpectedEOF(err)
+	}
+	if _, err := a.readFull(size); err != nil {
+		return nil, 0, unexpectedEOF(err)
+	}
+	sync, err := a.readFull(avroSyncSize)
+	if err != nil {
+		return nil, 0, unexpectedEOF(err)
+	}
+	if !bytes.Equal(sync, a.sync) {
+		return nil, 0, fmt.Errorf("avro block has the wrong sync marker, the file is corrupt")
+	}
+	return append([]byte{}, a.raw.Bytes()...), count, nil
+}
+
+// readLong reads a zig-zag encoded variable length long.
+func (a *AvroReader) readLong() (int64, error) {
+	var value uint64
+	for shift := uint(0); shift < 64; shift += 7 {
+		b, err := a.r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		a.raw.WriteByte(b)
+		value |= uint64(b&0x7f) << shift
+		if b&0x80 == 0 {
+			return int64(value>>1) ^ -int64(value&1), nil
+		}
+	}
+	return 0, fmt.Errorf("invalid avro long")
+}
+
+func (a *AvroReader) readFull(size int64) ([]byte, error) {
+	if size < 0 || size > maxAvroBlockSize {
+		return nil, fmt.Errorf("invalid avro size %d", size)
+	}
+	// The buffer grows as the data arrives, rather than trusting size up
+	// front, so a corrupt size in a short file doesn't allocate much.
+	buf := &bytes.Buffer{}
+	if _, err := io.CopyN(buf, a.r, size); err != nil {
+		return nil, unexpectedEOF(err)
+	}
+	a.raw.Write(buf.Bytes())
+	return buf.Bytes(), nil
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
@@ -0,0 +1,86 @@
+package columnar
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+// testdata/rows.avro has 25 records of (id, name) in deflate compressed
+// blocks of 10, 10 and 5 records, written by goavro.
+
+func readAvroBlocks(t *testing.T, data []byte) (*AvroReader, [][]byte, []int64, error) {
+	a, err := NewAvroReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	var blocks [][]byte
+	var counts []int64
+	for {
+		block, count, err := a.ReadBlock()
+		if err == io.EOF {
+			return a, blocks, counts, nil
+		}
+		if err != nil {
+			return a, blocks, counts, err
+		}
+		blocks = append(blocks, block)
+		counts = append(counts, count)
+	}
+}
+
+func TestAvroBlocks(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/rows.avro")
+	require.NoError(t, err)
+	a, blocks, counts, err := readAvroBlocks(t, data)
+	require.NoError(t, err)
+	require.Equal(t, []int64{10, 10, 5}, counts)
+	// The header and the blocks are the whole file, so the header followed
+	// by any of the blocks is a valid file.
+	require.Equal(t, data, bytes.Join(append([][]byte{a.Header}, blocks...), nil))
+
+	// A file with just the second block.
+	_, split, counts, err := readAvroBlocks(t, append(append([]byte{}, a.Header...), blocks[1]...))
+	require.NoError(t, err)
+	require.Equal(t, []int64{10}, counts)
+	require.Equal(t, blocks[1:2], split)
+}
+
+func TestAvroInvalid(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/rows.avro")
+	require.NoError(t, err)
+	a, blocks, _, err := readAvroBlocks(t, data)
+	require.NoError(t, err)
+
+	_, err = NewAvroReader(bytes.NewReader([]byte("Obj\x02")))
+	require.YesError(t, err)
+	for size := 0; size < len(a.Header); size++ {
+		_, err = NewAvroReader(bytes.NewReader(data[:size]))
+		require.YesError(t, err)
+	}
+
+	// Blocks cut short.
+	for size := 1; size < len(blocks[0]); size++ {
+		_, _, _, err := readAvroBlocks(t, append(append([]byte{}, a.Header...), blocks[0][:size]...))
+		require.Equal(t, io.ErrUnexpectedEOF, err)
+	}
+
+	// The wrong sync marker.
+	corrupt := append(append([]byte{}, a.Header...), blocks[0]...)
+	corrupt[len(corrupt)-1] ^= 0xff
+	_, _, _, err = readAvroBlocks(t, corrupt)
+	require.YesError(t, err)
+
+	// A negative count, and sizes that are negative, too big, or bigger
+	// than the file.
+	for _, block := range [][]byte{
+		{0x01, 0x02},
+		{0x02, 0x01},
+		{0x02, 0x82, 0x80, 0x80, 0x80, 0x08},
+		{0x02, 0xfe, 0xff, 0xff, 0xff, 0x07},
+	} {
+		_, _, _, err = readAvroBlocks(t, append(append([]byte{}, a.Header...), block...))
+		require.YesError(t, err)
+	}
+}
@@ -0,0 +1,275 @@
+package columnar
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+const (
+	parquetFooterSize = 8 // metadata length and magic
+
+	// FileMetaData fields
+	fileMetaDataNumRows   = 3
+	fileMetaDataRowGroups = 4
+	// RowGroup fields
+	rowGroupColumns    = 1
+	rowGroupNumRows    = 3
+	rowGroupFileOffset = 5
+	rowGroupOrdinal    = 7
+	// ColumnChunk fields
+	columnChunkFilePath          = 1
+	columnChunkFileOffset        = 2
+	columnChunkMetaData          = 3
+	columnChunkOffsetIndexOffset = 4
+	columnChunkOffsetIndexLength = 5
+	columnChunkColumnIndexOffset = 6
+	columnChunkColumnIndexLength = 7
+	// ColumnMetaData fields
+	columnMetaDataTotalCompressedSize  = 7
+	columnMetaDataDataPageOffset       = 9
+	columnMetaDataIndexPageOffset      = 10
+	columnMetaDataDictionaryPageOffset = 11
+	columnMetaDataBloomFilterOffset    = 14
+	columnMetaDataBloomFilterLength    = 15
+)
+
+var parquetMagic = []byte("PAR1")
+
+// ParquetFile is a Parquet file that can be split along its row groups.
+type ParquetFile struct {
+	r         io.ReaderAt
+	metadata  *thriftStruct
+	rowGroups []*parquetRowGroup
+}
+
+type parquetRowGroup struct {
+	metadata *thriftStruct
+	numRows  int64
+	// The row group's column chunks are in [start, end) of the file.
+	start int64
+	end   int64
+}
+
+// OpenParquet reads the metadata of the Parquet file in r, which is size
+// bytes long.
+func OpenParquet(r io.ReaderAt, size int64) (*ParquetFile, error) {
+	if size < int64(len(parquetMagic))+parquetFooterSize {
+		return nil, fmt.Errorf("not a parquet file")
+	}
+	footer := make([]byte, parquetFooterSize)
+	if _, err := r.ReadAt(footer, size-parquetFooterSize); err != nil {
+		return nil, err
+	}
+	if bytes.Equal(footer[4:], []byte("PARE")) {
+		return nil, fmt.Errorf("encrypted parquet files can't be split")
+	}
+	if !bytes.Equal(footer[4:], parquetMagic) {
+		return nil, fmt.Errorf("not a parquet file")
+	}
+	metadataSize := int64(binary.LittleEndian.Uint32(footer))
+	if metadataSize > size-parquetFooterSize-int64(len(parquetMagic)) {
+		return nil, fmt.Errorf("invalid parquet metadata size %d", metadataSize)
+	}
+	metadataStart := size - parquetFooterSize - metadataSize
+	buf := make([]byte, metadataSize)
+	if _, err := r.ReadAt(buf, metadataStart); err != nil {
+		return nil, err
+	}
+	metadata, err := decodeThriftStruct(buf)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding parquet metadata: %v", err)
+	}
+	p := &ParquetFile{r: r, metadata: metadata}
+	if rowGroups := metadata.listField(fileMetaDataRowGroups); rowGroups != nil {
+		for i, elem := range rowGroups.elems {
+			rowGroup, err := newParquetRowGroup(elem, metadataStart)
+			if err != nil {
+				return nil, fmt.Errorf("row group %d: %v", i, err)
+			}
+			p.rowGroups = append(p.rowGroups, rowGroup)
+		}
+	}
+	return p, nil
+}
+
+func newParquetRowGroup(elem interface{}, metadataStart int64) (*parquetRowGroup, error) {
+	metadata, ok := elem.(*thriftStruct)
+	if !ok {
+		return nil, fmt.Errorf("invalid metadata")
+	}
+	rowGroup := &parquetRowGroup{metadata: metadata, start: -1}
+	rowGroup.numRows, _ = metadata.int(rowGroupNumRows)
+	if rowGroup.numRows < 0 {
+		return nil, fmt.Errorf("invalid number of rows %d", rowGroup.numRows)
+	}
+	columns := metadata.listField(rowGroupColumns)
+	if columns == nil || len(columns.elems) == 0 {
+		return nil, fmt.Errorf("no columns")
+	}
+	for _, elem := range columns.elems {
+		column, ok := elem.(*thriftStruct)
+		if !ok {
+			return nil, fmt.Errorf("invalid column metadata")
+		}
+		if column.field(columnChunkFilePath) != nil {
+			return nil, fmt.Errorf("column chunks in other files aren't supported")
+		}
+		columnMetaData := column.structField(columnChunkMetaData)
+		if columnMetaData == nil {
+			return nil, fmt.Errorf("column metadata is missing")
+		}
+		start, end, err := columnChunkRange(columnMetaData)
+		if err != nil {
+			return nil, err
+		}
+		// end < start means that the size overflowed.
+		if start < int64(len(parquetMagic)) || end < start || end > metadataStart {
+			return nil, fmt.Errorf("column chunk [%d, %d) is out of range", start, end)
+		}
+		if rowGroup.start < 0 || start < rowGroup.start {
+			rowGroup.start = start
+		}
+		if end > rowGroup.end {
+			rowGroup.end = end
+		}
+	}
+	return rowGroup, nil
+}
+
+// columnChunkRange returns where a column chunk's pages are in the file.
+func columnChunkRange(columnMetaData *thriftStruct) (int64, int64, error) {
+	start, ok := columnMetaData.int(columnMetaDataDataPageOffset)
+	if !ok {
+		return 0, 0, fmt.Errorf("column chunk has no data page offset")
+	}
+	// Some writers set the dictionary page offset to 0 when there isn't
+	// one.
+	if dictionary, ok := columnMetaData.int(columnMetaDataDictionaryPageOffset); ok && dictionary > 0 && dictionary < start {
+		start = dictionary
+	}
+	if index, ok := columnMetaData.int(columnMetaDataIndexPageOffset); ok && index > 0 && index < start {
+		start = index
+	}
+	size, ok := columnMetaData.int(columnMetaDataTotalCompressedSize)
+	if !ok || size < 0 {
+		return 0, 0, fmt.Errorf("column chunk has no size")
+	}
+	return start, start + size, nil
+}
+
+// NumRowGroups returns the number of row groups in the file.
+func (p *ParquetFile) NumRowGroups() int {
+	return len(p.rowGroups)
+}
+
+// RowGroup returns the number of rows and bytes in row group i.
+func (p *ParquetFile) RowGroup(i int) (int64, int64) {
+	return p.rowGroups[i].numRows, p.rowGroups[i].end - p.rowGroups[i].start
+}
+
+// WriteRowGroups writes a Parquet file with the file's schema and metadata
+// that contains row groups [from, to) to w. Column and offset indexes and
+// bloom filters, which aren't part of row groups' data, are dropped.
+func (p *ParquetFile) WriteRowGroups(w io.Writer, from int, to int) error {
+	if from < 0 || to > len(p.rowGroups) || from >= to {
+		return fmt.Errorf("invalid row groups [%d, %d)", from, to)
+	}
+	if _, err := w.Write(parquetMagic); err != nil {
+		return err
+	}
+	offset := int64(len(parquetMagic))
+	var numRows int64
+	rowGroups := &thriftList{elemType: thriftTypeStruct}
+	for _, rowGroup := range p.rowGroups[from:to] {
+		if _, err := io.Copy(w, io.NewSectionReader(p.r, rowGroup.start, rowGroup.end-rowGroup.start)); err != nil {
+			return err
+		}
+		rowGroups.elems = append(rowGroups.elems, rowGroup.moved(offset))
+		numRows += rowGroup.numRows
+		offset += rowGroup.end - rowGroup.start
+	}
+	metadata := &thriftStruct{fields: append([]*thriftField{}, p.metadata.fields...)}
+	metadata.remove(fileMetaDataNumRows, fileMetaDataRowGroups)
+	metadata.set(fileMetaDataNumRows, thriftTypeI64, numRows)
+	metadata.set(fileMetaDataRowGroups, thriftTypeList, rowGroups)
+	encoded, err := encodeThriftStruct(metadata)
+	if err != nil {
+		return err
+	}
+	footer := make([]byte, 4)
+	binary.LittleEndian.PutUint32(footer, uint32(len(encoded)))
+	footer = append(footer, parquetMagic...)
+	if _, err := w.Write(encoded); err != nil {
+		return err
+	}
+	_, err = w.Write(footer)
+	return err
+}
+
+// moved returns the row group's metadata with its offsets changed for its
+// column chunks starting at offset.
+func (r *parquetRowGroup) moved(offset int64) *thriftStruct {
+	delta := offset - r.start
+	metadata := cloneThriftStruct(r.metadata)
+	metadata.remove(rowGroupOrdinal)
+	if _, ok := metadata.int(rowGroupFileOffset); ok {
+		metadata.set(rowGroupFileOffset, thriftTypeI64, offset)
+	}
+	for _, elem := range metadata.listField(rowGroupColumns).elems {
+		column := elem.(*thriftStruct)
+		column.remove(columnChunkOffsetIndexOffset, columnChunkOffsetIndexLength,
+			columnChunkColumnIndexOffset, columnChunkColumnIndexLength)
+		columnMetaData := column.structField(columnChunkMetaData)
+		columnMetaData.remove(columnMetaDataBloomFilterOffset, columnMetaDataBloomFilterLength)
+		columnMetaData.addInt(columnMetaDataDataPageOffset, delta)
+		if v, ok := columnMetaData.int(columnMetaDataDictionaryPageOffset); ok && v > 0 {
+			columnMetaData.addInt(columnMetaDataDictionaryPageOffset, delta)
+		}
+		if v, ok := columnMetaData.int(columnMetaDataIndexPageOffset); ok && v > 0 {
+			columnMetaData.addInt(columnMetaDataIndexPageOffset, delta)
+		}
+		if v, ok := column.int(columnChunkFileOffset); ok {
+			if v >= r.start && v <= r.end {
+				column.addInt(columnChunkFileOffset, delta)
+			} else {
+				// It points at a copy of the column metadata after the
+				// chunk, which we don't write.
+				start, _, _ := columnChunkRange(columnMetaData)
+				column.set(columnChunkFileOffset, thriftTypeI64, start)
+			}
+		}
+	}
+	return metadata
+}
+
+func cloneThriftStruct(s *thriftStruct) *thriftStruct {
+	result := &thriftStruct{}
+	for _, f := range s.fields {
+		result.fields = append(result.fields, &thriftField{id: f.id, typ: f.typ, value: cloneThriftValue(f.value)})
+	}
+	return result
+}
+
+func cloneThriftValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case *thriftStruct:
+		return cloneThriftStruct(v)
+	case *thriftList:
+		l := &thriftList{elemType: v.elemType}
+		for _, elem := range v.elems {
+			l.elems = append(l.elems, cloneThriftValue(elem))
+		}
+		return l
+	case *thriftMap:
+		m := &thriftMap{keyType: v.keyType, valueType: v.valueType}
+		for i := range v.keys {
+			m.keys = append(m.keys, cloneThriftValue(v.keys[i]))
+			m.values = append(m.values, cloneThriftValue(v.values[i]))
+		}
+		return m
+	}
+	// Everything else is immutable, or never modified.
+	return value
+}
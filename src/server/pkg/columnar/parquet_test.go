@@ -0,0 +1,153 @@
+package columnar
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+// testdata/rows.parquet has 25 rows of (id, name) in row groups of 10, 10
+// and 5 rows, with dictionary encoded names, written by parquet-go.
+// testdata/rows-1-3.parquet is its last two row groups, which parquet-go
+// reads back as rows 10 to 24.
+
+func openTestParquet(t *testing.T, name string) ([]byte, *ParquetFile) {
+	data, err := ioutil.ReadFile(name)
+	require.NoError(t, err)
+	p, err := OpenParquet(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	return data, p
+}
+
+func TestParquetRowGroups(t *testing.T) {
+	_, p := openTestParquet(t, "testdata/rows.parquet")
+	require.Equal(t, 3, p.NumRowGroups())
+	var totalBytes int64
+	for i, expected := range []int64{10, 10, 5} {
+		rows, size := p.RowGroup(i)
+		require.Equal(t, expected, rows)
+		require.True(t, size > 0)
+		totalBytes += size
+	}
+	require.True(t, totalBytes < 1513)
+}
+
+func TestParquetWriteRowGroups(t *testing.T) {
+	data, p := openTestParquet(t, "testdata/rows.parquet")
+	buf := &bytes.Buffer{}
+	require.NoError(t, p.WriteRowGroups(buf, 1, 3))
+	expected, err := ioutil.ReadFile("testdata/rows-1-3.parquet")
+	require.NoError(t, err)
+	require.Equal(t, expected, buf.Bytes())
+
+	// The row groups' data is copied as is, and their metadata points at
+	// where it ends up.
+	split, err := OpenParquet(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	require.Equal(t, 2, split.NumRowGroups())
+	numRows, _ := split.metadata.int(fileMetaDataNumRows)
+	require.Equal(t, int64(15), numRows)
+	for i := 0; i < 2; i++ {
+		from, to := p.rowGroups[i+1], split.rowGroups[i]
+		require.Equal(t, from.numRows, to.numRows)
+		require.Equal(t, data[from.start:from.end], buf.Bytes()[to.start:to.end])
+	}
+
+	// Writing every row group gives an equivalent file.
+	buf.Reset()
+	require.NoError(t, p.WriteRowGroups(buf, 0, 3))
+	whole, err := OpenParquet(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	require.Equal(t, 3, whole.NumRowGroups())
+	for i := 0; i < 3; i++ {
+		rows, size := p.RowGroup(i)
+		splitRows, splitSize := whole.RowGroup(i)
+		require.Equal(t, rows, splitRows)
+		require.Equal(t, size, splitSize)
+	}
+
+	require.YesError(t, p.WriteRowGroups(buf, 2, 2))
+	require.YesError(t, p.WriteRowGroups(buf, 0, 4))
+	require.YesError(t, p.WriteRowGroups(buf, -1, 1))
+}
+
+func TestParquetMetadataRoundTrip(t *testing.T) {
+	data, _ := openTestParquet(t, "testdata/rows.parquet")
+	metadataSize := int(binary.LittleEndian.Uint32(data[len(data)-parquetFooterSize:]))
+	encoded := data[len(data)-parquetFooterSize-metadataSize : len(data)-parquetFooterSize]
+	metadata, err := decodeThriftStruct(encoded)
+	require.NoError(t, err)
+	reencoded, err := encodeThriftStruct(metadata)
+	require.NoError(t, err)
+	require.Equal(t, encoded, reencoded)
+}
+
+func TestParquetInvalid(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/rows.parquet")
+	require.NoError(t, err)
+	open := func(data []byte) error {
+		_, err := OpenParquet(bytes.NewReader(data), int64(len(data)))
+		return err
+	}
+	require.YesError(t, open([]byte("PAR1")))
+	require.YesError(t, open(append(append([]byte{}, data[:len(data)-4]...), "PARE"...)))
+	require.YesError(t, open(append(append([]byte{}, data[:len(data)-4]...), "XXXX"...)))
+
+	// A metadata size bigger than the file.
+	corrupt := append([]byte{}, data...)
+	binary.LittleEndian.PutUint32(corrupt[len(corrupt)-parquetFooterSize:], uint32(len(data)))
+	require.YesError(t, open(corrupt))
+
+	// Truncated metadata, which fails to decode rather than panicking.
+	metadataSize := int(binary.LittleEndian.Uint32(data[len(data)-parquetFooterSize:]))
+	metadataStart := len(data) - parquetFooterSize - metadataSize
+	for size := 0; size < metadataSize; size++ {
+		truncated := append([]byte{}, data[:metadataStart+size]...)
+		footer := make([]byte, 4)
+		binary.LittleEndian.PutUint32(footer, uint32(size))
+		truncated = append(append(truncated, footer...), parquetMagic...)
+		require.YesError(t, open(truncated))
+	}
+}
+
+func TestParquetInvalidColumnChunk(t *testing.T) {
+	data, p := openTestParquet(t, "testdata/rows.parquet")
+	metadataSize := int64(binary.LittleEndian.Uint32(data[len(data)-parquetFooterSize:]))
+	metadataStart := int64(len(data)) - parquetFooterSize - metadataSize
+	columnMetaData := func(rowGroup *thriftStruct) *thriftStruct {
+		column := rowGroup.listField(rowGroupColumns).elems[0].(*thriftStruct)
+		return column.structField(columnChunkMetaData)
+	}
+	for _, corrupt := range []func(*thriftStruct){
+		func(rowGroup *thriftStruct) {
+			rowGroup.set(rowGroupNumRows, thriftTypeI64, int64(-1))
+		},
+		func(rowGroup *thriftStruct) {
+			rowGroup.set(rowGroupColumns, thriftTypeList, &thriftList{elemType: thriftTypeStruct})
+		},
+		func(rowGroup *thriftStruct) {
+			columnMetaData(rowGroup).set(columnMetaDataDataPageOffset, thriftTypeI64, int64(0))
+		},
+		func(rowGroup *thriftStruct) {
+			columnMetaData(rowGroup).set(columnMetaDataTotalCompressedSize, thriftTypeI64, metadataStart)
+		},
+		func(rowGroup *thriftStruct) {
+			// Overflows the end of the column chunk.
+			columnMetaData(rowGroup).set(columnMetaDataTotalCompressedSize, thriftTypeI64, int64(1<<63-1))
+		},
+		func(rowGroup *thriftStruct) {
+			columnMetaData(rowGroup).remove(columnMetaDataTotalCompressedSize)
+		},
+		func(rowGroup *thriftStruct) {
+			rowGroup.listField(rowGroupColumns).elems[0].(*thriftStruct).remove(columnChunkMetaData)
+		},
+	} {
+		rowGroup := cloneThriftStruct(p.rowGroups[0].metadata)
+		corrupt(rowGroup)
+		_, err := newParquetRowGroup(rowGroup, metadataStart)
+		require.YesError(t, err)
+	}
+}
@@ -0,0 +1,405 @@
+package columnar
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// Parquet's metadata is encoded with thrift's compact protocol. Rather than
+// generating code for the whole parquet.thrift schema, the metadata is
+// decoded into generic structs, which are re-encoded with only the fields
+// that splitting touches changed, so fields we don't know about survive.
+
+// Compact protocol types.
+const (
+	thriftTypeStop   = 0
+	thriftTypeTrue   = 1
+	thriftTypeFalse  = 2
+	thriftTypeByte   = 3
+	thriftTypeI16    = 4
+	thriftTypeI32    = 5
+	thriftTypeI64    = 6
+	thriftTypeDouble = 7
+	thriftTypeBinary = 8
+	thriftTypeList   = 9
+	thriftTypeSet    = 10
+	thriftTypeMap    = 11
+	thriftTypeStruct = 12
+	maxThriftLength  = 1 << 28
+	maxThriftDepth   = 64
+)
+
+type thriftField struct {
+	id  int16
+	typ byte
+	// value is an int64 for integer types, a bool, a uint64 of a double's
+	// bits, a []byte, a *thriftList, a *thriftMap or a *thriftStruct.
+	value interface{}
+}
+
+type thriftStruct struct {
+	fields []*thriftField
+}
+
+type thriftList struct {
+	elemType byte
+	elems    []interface{}
+}
+
+type thriftMap struct {
+	keyType   byte
+	valueType byte
+	keys      []interface{}
+	values    []interface{}
+}
+
+func (s *thriftStruct) field(id int16) *thriftField {
+	for _, f := range s.fields {
+		if f.id == id {
+			return f
+		}
+	}
+	return nil
+}
+
+func (s *thriftStruct) int(id int16) (int64, bool) {
+	if f := s.field(id); f != nil {
+		if v, ok := f.value.(int64); ok {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+// set sets a field, adding it if it isn't set.
+func (s *thriftStruct) set(id int16, typ byte, value interface{}) {
+	if f := s.field(id); f != nil {
+		f.value = value
+		return
+	}
+	s.fields = append(s.fields, &thriftField{id: id, typ: typ, value: value})
+	// Fields are written in the order they're in, keep them sorted.
+	for i := len(s.fields) - 1; i > 0 && s.fields[i].id < s.fields[i-1].id; i-- {
+		s.fields[i], s.fields[i-1] = s.fields[i-1], s.fields[i]
+	}
+}
+
+// addInt adds delta to an integer field, if it's set.
+func (s *thriftStruct) addInt(id int16, delta int64) {
+	if v, ok := s.int(id); ok {
+		s.field(id).value = v + delta
+	}
+}
+
+func (s *thriftStruct) remove(ids ...int16) {
+	var fields []*thriftField
+	for _, f := range s.fields {
+		keep := true
+		for _, id := range ids {
+			if f.id == id {
+				keep = false
+			}
+		}
+		if keep {
+			fields = append(fields, f)
+		}
+	}
+	s.fields = fields
+}
+
+func (s *thriftStruct) structField(id int16) *thriftStruct {
+	if f := s.field(id); f != nil {
+		if v, ok := f.value.(*thriftStruct); ok {
+			return v
+		}
+	}
+	return nil
+}
+
+func (s *thriftStruct) listField(id int16) *thriftList {
+	if f := s.field(id); f != nil {
+		if v, ok := f.value.(*thriftList); ok {
+			return v
+		}
+	}
+	return nil
+}
+
+// thriftDecoder decodes the compact protocol.
+type thriftDecoder struct {
+	r     *bytes.Reader
+	depth int
+}
+
+func decodeThriftStruct(data []byte) (*thriftStruct, error) {
+	d := &thriftDecoder{r: bytes.NewReader(data)}
+	s, err := d.readValue(thriftTypeStruct)
+	if err != nil {
+		return nil, err
+	}
+	return s.(*thriftStruct), nil
+}
+
+func (d *thriftDecoder) readStruct() (*thriftStruct, error) {
+	s := &thriftStruct{}
+	var lastID int16
+	for {
+		header, err := d.r.ReadByte()
+		if err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		typ := header & 0x0f
+		if typ == thriftTypeStop {
+			return s, nil
+		}
+		id := lastID + int16(header>>4)
+		if header>>4 == 0 {
+			v, err := d.readVarint()
+			if err != nil {
+				return nil, err
+			}
+			id = int16(zigzag(v))
+		}
+		lastID = id
+		var value interface{}
+		switch typ {
+		case thriftTypeTrue:
+			value = true
+		case thriftTypeFalse:
+			value = false
+		default:
+			if value, err = d.readValue(typ); err != nil {
+				return nil, err
+			}
+		}
+		s.fields = append(s.fields, &thriftField{id: id, typ: typ, value: value})
+	}
+}
+
+func (d *thriftDecoder) readValue(typ byte) (interface{}, error) {
+	switch typ {
+	case thriftTypeList, thriftTypeSet, thriftTypeMap, thriftTypeStruct:
+		// Each level of nesting only takes a byte or two, so corrupt
+		// metadata could otherwise recurse until the stack runs out.
+		d.depth++
+		defer func() { d.depth-- }()
+		if d.depth > maxThriftDepth {
+			return nil, fmt.Errorf("thrift value is nested too deeply")
+		}
+	}
+	switch typ {
+	case thriftTypeTrue, thriftTypeFalse:
+		// Booleans in lists, sets and maps are a byte.
+		b, err := d.r.ReadByte()
+		if err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		return b == thriftTypeTrue, nil
+	case thriftTypeByte:
+		b, err := d.r.ReadByte()
+		if err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		return int64(int8(b)), nil
+	case thriftTypeI16, thriftTypeI32, thriftTypeI64:
+		v, err := d.readVarint()
+		if err != nil {
+			return nil, err
+		}
+		return zigzag(v), nil
+	case thriftTypeDouble:
+		var buf [8]byte
+		if _, err := io.ReadFull(d.r, buf[:]); err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		return binary.LittleEndian.Uint64(buf[:]), nil
+	case thriftTypeBinary:
+		size, err := d.readLength()
+		if err != nil {
+			return nil, err
+		}
+		buf := make([]byte, size)
+		if _, err := io.ReadFull(d.r, buf); err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		return buf, nil
+	case thriftTypeList, thriftTypeSet:
+		header, err := d.r.ReadByte()
+		if err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		size := int(header >> 4)
+		if size == 15 {
+			if size, err = d.readLength(); err != nil {
+				return nil, err
+			}
+		}
+		l := &thriftList{elemType: header & 0x0f}
+		for i := 0; i < size; i++ {
+			elem, err := d.readValue(l.elemType)
+			if err != nil {
+				return nil, err
+			}
+			l.elems = append(l.elems, elem)
+		}
+		return l, nil
+	case thriftTypeMap:
+		size, err := d.readLength()
+		if err != nil {
+			return nil, err
+		}
+		m := &thriftMap{}
+		if size == 0 {
+			return m, nil
+		}
+		types, err := d.r.ReadByte()
+		if err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		m.keyType, m.valueType = types>>4, types&0x0f
+		for i := 0; i < size; i++ {
+			key, err := d.readValue(m.keyType)
+			if err != nil {
+				return nil, err
+			}
+			value, err := d.readValue(m.valueType)
+			if err != nil {
+				return nil, err
+			}
+			m.keys = append(m.keys, key)
+			m.values = append(m.values, value)
+		}
+		return m, nil
+	case thriftTypeStruct:
+		return d.readStruct()
+	}
+	return nil, fmt.Errorf("unknown thrift type %d", typ)
+}
+
+func (d *thriftDecoder) readVarint() (uint64, error) {
+	v, err := binary.ReadUvarint(d.r)
+	return v, unexpectedEOF(err)
+}
+
+func (d *thriftDecoder) readLength() (int, error) {
+	v, err := d.readVarint()
+	if err != nil {
+		return 0, err
+	}
+	if v > maxThriftLength || int(v) > d.r.Len() {
+		return 0, fmt.Errorf("invalid thrift length %d", v)
+	}
+	return int(v), nil
+}
+
+func zigzag(v uint64) int64 {
+	return int64(v>>1) ^ -int64(v&1)
+}
+
+// thriftEncoder encodes the compact protocol.
+type thriftEncoder struct {
+	buf bytes.Buffer
+}
+
+func encodeThriftStruct(s *thriftStruct) ([]byte, error) {
+	e := &thriftEncoder{}
+	if err := e.writeStruct(s); err != nil {
+		return nil, err
+	}
+	return e.buf.Bytes(), nil
+}
+
+func (e *thriftEncoder) writeStruct(s *thriftStruct) error {
+	var lastID int16
+	for _, f := range s.fields {
+		typ := f.typ
+		if typ == thriftTypeTrue || typ == thriftTypeFalse {
+			typ = thriftTypeFalse
+			if f.value.(bool) {
+				typ = thriftTypeTrue
+			}
+		}
+		if delta := f.id - lastID; delta > 0 && delta <= 15 {
+			e.buf.WriteByte(byte(delta)<<4 | typ)
+		} else {
+			e.buf.WriteByte(typ)
+			e.writeVarint(unzigzag(int64(f.id)))
+		}
+		lastID = f.id
+		if typ == thriftTypeTrue || typ == thriftTypeFalse {
+			continue
+		}
+		if err := e.writeValue(typ, f.value); err != nil {
+			return err
+		}
+	}
+	e.buf.WriteByte(thriftTypeStop)
+	return nil
+}
+
+func (e *thriftEncoder) writeValue(typ byte, value interface{}) error {
+	switch typ {
+	case thriftTypeTrue, thriftTypeFalse:
+		if value.(bool) {
+			e.buf.WriteByte(thriftTypeTrue)
+		} else {
+			e.buf.WriteByte(thriftTypeFalse)
+		}
+	case thriftTypeByte:
+		e.buf.WriteByte(byte(value.(int64)))
+	case thriftTypeI16, thriftTypeI32, thriftTypeI64:
+		e.writeVarint(unzigzag(value.(int64)))
+	case thriftTypeDouble:
+		var buf [8]byte
+		binary.LittleEndian.PutUint64(buf[:], value.(uint64))
+		e.buf.Write(buf[:])
+	case thriftTypeBinary:
+		b := value.([]byte)
+		e.writeVarint(uint64(len(b)))
+		e.buf.Write(b)
+	case thriftTypeList, thriftTypeSet:
+		l := value.(*thriftList)
+		if len(l.elems) < 15 {
+			e.buf.WriteByte(byte(len(l.elems))<<4 | l.elemType)
+		} else {
+			e.buf.WriteByte(0xf0 | l.elemType)
+			e.writeVarint(uint64(len(l.elems)))
+		}
+		for _, elem := range l.elems {
+			if err := e.writeValue(l.elemType, elem); err != nil {
+				return err
+			}
+		}
+	case thriftTypeMap:
+		m := value.(*thriftMap)
+		e.writeVarint(uint64(len(m.keys)))
+		if len(m.keys) == 0 {
+			return nil
+		}
+		e.buf.WriteByte(m.keyType<<4 | m.valueType)
+		for i := range m.keys {
+			if err := e.writeValue(m.keyType, m.keys[i]); err != nil {
+				return err
+			}
+			if err := e.writeValue(m.valueType, m.values[i]); err != nil {
+				return err
+			}
+		}
+	case thriftTypeStruct:
+		return e.writeStruct(value.(*thriftStruct))
+	default:
+		return fmt.Errorf("unknown thrift type %d", typ)
+	}
+	return nil
+}
+
+func (e *thriftEncoder) writeVarint(v uint64) {
+	var buf [binary.MaxVarintLen64]byte
+	e.buf.Write(buf[:binary.PutUvarint(buf[:], v)])
+}
+
+func unzigzag(v int64) uint64 {
+	return uint64(v<<1) ^ uint64(v>>63)
+}
@@ -0,0 +1,118 @@
+package columnar
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+func TestThriftRoundTrip(t *testing.T) {
+	s := &thriftStruct{fields: []*thriftField{
+		{id: 1, typ: thriftTypeTrue, value: true},
+		{id: 2, typ: thriftTypeFalse, value: false},
+		{id: 3, typ: thriftTypeByte, value: int64(-5)},
+		{id: 4, typ: thriftTypeI16, value: int64(-300)},
+		{id: 5, typ: thriftTypeI32, value: int64(1 << 20)},
+		{id: 6, typ: thriftTypeI64, value: int64(-1 << 40)},
+		{id: 7, typ: thriftTypeDouble, value: uint64(0x3ff0000000000000)},
+		{id: 8, typ: thriftTypeBinary, value: []byte("value")},
+		{id: 9, typ: thriftTypeList, value: &thriftList{elemType: thriftTypeTrue, elems: []interface{}{true, false}}},
+		{id: 10, typ: thriftTypeSet, value: &thriftList{elemType: thriftTypeI32, elems: []interface{}{int64(1), int64(2)}}},
+		{id: 11, typ: thriftTypeMap, value: &thriftMap{
+			keyType:   thriftTypeBinary,
+			valueType: thriftTypeStruct,
+			keys:      []interface{}{[]byte("key")},
+			values:    []interface{}{&thriftStruct{fields: []*thriftField{{id: 1, typ: thriftTypeI32, value: int64(7)}}}},
+		}},
+		{id: 12, typ: thriftTypeMap, value: &thriftMap{}},
+		// Ids that don't fit in a delta.
+		{id: 40, typ: thriftTypeI32, value: int64(1)},
+		{id: 30, typ: thriftTypeI32, value: int64(2)},
+	}}
+	var long []interface{}
+	for i := 0; i < 20; i++ {
+		long = append(long, &thriftStruct{})
+	}
+	s.fields = append(s.fields, &thriftField{id: 41, typ: thriftTypeList, value: &thriftList{elemType: thriftTypeStruct, elems: long}})
+
+	encoded, err := encodeThriftStruct(s)
+	require.NoError(t, err)
+	decoded, err := decodeThriftStruct(encoded)
+	require.NoError(t, err)
+	require.Equal(t, s, decoded)
+	reencoded, err := encodeThriftStruct(decoded)
+	require.NoError(t, err)
+	require.Equal(t, encoded, reencoded)
+
+	// Every prefix of it is an error.
+	for size := 0; size < len(encoded); size++ {
+		_, err := decodeThriftStruct(encoded[:size])
+		require.YesError(t, err)
+	}
+}
+
+func TestThriftStructFields(t *testing.T) {
+	s := &thriftStruct{}
+	s.set(3, thriftTypeI64, int64(3))
+	s.set(1, thriftTypeI64, int64(1))
+	s.set(2, thriftTypeBinary, []byte("2"))
+	require.Equal(t, []int16{1, 2, 3}, fieldIDs(s))
+	s.addInt(1, 10)
+	s.addInt(2, 10)
+	v, ok := s.int(1)
+	require.True(t, ok)
+	require.Equal(t, int64(11), v)
+	_, ok = s.int(2)
+	require.False(t, ok)
+	s.remove(1, 3)
+	require.Equal(t, []int16{2}, fieldIDs(s))
+	require.Nil(t, s.structField(2))
+	require.Nil(t, s.listField(2))
+}
+
+func fieldIDs(s *thriftStruct) []int16 {
+	var ids []int16
+	for _, f := range s.fields {
+		ids = append(ids, f.id)
+	}
+	return ids
+}
+
+func TestThriftInvalid(t *testing.T) {
+	for _, data := range [][]byte{
+		// A binary field longer than the data.
+		{0x18, 0x10, 'a'},
+		// A binary field longer than the limit.
+		{0x18, 0x80, 0x80, 0x80, 0x80, 0x01},
+		// A list longer than the data.
+		{0x19, 0xf5, 0x10, 0x02},
+		// A map longer than the data.
+		{0x1b, 0x10, 0x55},
+		// A field of an unknown type.
+		{0x1d},
+		// A list of an unknown type.
+		{0x19, 0x1d, 0x00},
+		// A varint that overflows.
+		{0x15, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01},
+	} {
+		_, err := decodeThriftStruct(data)
+		require.YesError(t, err)
+	}
+
+	// Lists and structs nested deeper than maxThriftDepth.
+	nestedLists := func(depth int) []byte {
+		data := append([]byte{0x19}, bytes.Repeat([]byte{0x19}, depth-2)...)
+		return append(data, 0x09, thriftTypeStop)
+	}
+	nestedStructs := func(depth int) []byte {
+		data := bytes.Repeat([]byte{0x1c}, depth-1)
+		return append(data, bytes.Repeat([]byte{thriftTypeStop}, depth)...)
+	}
+	for _, nested := range []func(int) []byte{nestedLists, nestedStructs} {
+		_, err := decodeThriftStruct(nested(maxThriftDepth))
+		require.NoError(t, err)
+		_, err = decodeThriftStruct(nested(maxThriftDepth + 1))
+		require.YesError(t, err)
+	}
+}
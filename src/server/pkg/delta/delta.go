@@ -0,0 +1,157 @@
+// Package delta encodes a value as the differences between it and a similar
+// value, e.g. a previous version of it, and decodes it again.
+//
+// A delta is a sequence of instructions, each of which is either a copy,
+// which copies a range of the base, or an insert, which inserts bytes that
+// are in the delta.
+package delta
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+const (
+	// windowSize is the smallest match that's copied from the base.
+	windowSize = 32
+	// prime is the base of the rolling hash.
+	prime = 16777619
+
+	opCopy   = 0
+	opInsert = 1
+)
+
+// Encode returns a delta that turns base into target.
+func Encode(base []byte, target []byte) []byte {
+	// Index the windows of base at multiples of windowSize, a match of at
+	// least 2*windowSize-1 bytes contains one of them.
+	index := make(map[uint64]int)
+	for i := 0; i+windowSize <= len(base); i += windowSize {
+		h := hash(base[i : i+windowSize])
+		if _, ok := index[h]; !ok {
+			index[h] = i
+		}
+	}
+	e := &encoder{}
+	var pow uint64 = 1
+	for i := 0; i < windowSize-1; i++ {
+		pow *= prime
+	}
+	literal := 0 // start of the bytes that haven't been encoded yet
+	i := 0
+	var h uint64
+	if len(target) >= windowSize {
+		h = hash(target[:windowSize])
+	}
+	for i+windowSize <= len(target) {
+		if offset, ok := index[h]; ok && equal(base[offset:offset+windowSize], target[i:i+windowSize]) {
+			// Extend the match backwards into the literal, and forwards.
+			start, baseStart := i, offset
+			for start > literal && baseStart > 0 && target[start-1] == base[baseStart-1] {
+				start--
+				baseStart--
+			}
+			end, baseEnd := i+windowSize, offset+windowSize
+			for end < len(target) && baseEnd < len(base) && target[end] == base[baseEnd] {
+				end++
+				baseEnd++
+			}
+			e.insert(target[literal:start])
+			e.copy(baseStart, end-start)
+			literal = end
+			i = end
+			if i+windowSize <= len(target) {
+				h = hash(target[i : i+windowSize])
+			}
+			continue
+		}
+		if i+windowSize < len(target) {
+			h = (h-uint64(target[i])*pow)*prime + uint64(target[i+windowSize])
+		}
+		i++
+	}
+	e.insert(target[literal:])
+	return e.buf
+}
+
+// Apply applies delta to base, returning the target that was passed to
+// Encode.
+func Apply(base []byte, delta []byte) ([]byte, error) {
+	var result []byte
+	for len(delta) > 0 {
+		op := delta[0]
+		delta = delta[1:]
+		switch op {
+		case opCopy:
+			offset, n := binary.Uvarint(delta)
+			if n <= 0 {
+				return nil, fmt.Errorf("invalid delta: bad copy offset")
+			}
+			delta = delta[n:]
+			length, n := binary.Uvarint(delta)
+			if n <= 0 {
+				return nil, fmt.Errorf("invalid delta: bad copy length")
+			}
+			delta = delta[n:]
+			if offset+length > uint64(len(base)) {
+				return nil, fmt.Errorf("invalid delta: copy of [%d, %d) is out of range", offset, offset+length)
+			}
+			result = append(result, base[offset:offset+length]...)
+		case opInsert:
+			length, n := binary.Uvarint(delta)
+			if n <= 0 {
+				return nil, fmt.Errorf("invalid delta: bad insert length")
+			}
+			delta = delta[n:]
+			if length > uint64(len(delta)) {
+				return nil, fmt.Errorf("invalid delta: insert is truncated")
+			}
+			result = append(result, delta[:length]...)
+			delta = delta[length:]
+		default:
+			return nil, fmt.Errorf("invalid delta: unknown instruction %d", op)
+		}
+	}
+	return result, nil
+}
+
+type encoder struct {
+	buf []byte
+}
+
+func (e *encoder) copy(offset int, length int) {
+	e.buf = append(e.buf, opCopy)
+	e.uvarint(uint64(offset))
+	e.uvarint(uint64(length))
+}
+
+func (e *encoder) insert(data []byte) {
+	if len(data) == 0 {
+		return
+	}
+	e.buf = append(e.buf, opInsert)
+	e.uvarint(uint64(len(data)))
+	e.buf = append(e.buf, data...)
+}
+
+func (e *encoder) uvarint(v uint64) {
+	var buf [binary.MaxVarintLen64]byte
+	e.buf = append(e.buf, buf[:binary.PutUvarint(buf[:], v)]...)
+}
+
+func hash(window []byte) uint64 {
+	var h uint64
+	for _, b := range window {
+		h = h*prime + uint64(b)
+	}
+	return h
+}
+
+func equal(a []byte, b []byte) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,114 @@
+package delta
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+func randBytes(r *rand.Rand, n int) []byte {
+	result := make([]byte, n)
+	r.Read(result)
+	return result
+}
+
+func roundTrip(t *testing.T, base []byte, target []byte) []byte {
+	d := Encode(base, target)
+	result, err := Apply(base, d)
+	require.NoError(t, err)
+	require.True(t, bytes.Equal(target, result))
+	return d
+}
+
+func TestEmpty(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	data := randBytes(r, 1000)
+	require.Equal(t, 0, len(roundTrip(t, nil, nil)))
+	require.Equal(t, 0, len(roundTrip(t, data, nil)))
+	roundTrip(t, nil, data)
+	// Empty deltas apply to anything.
+	result, err := Apply(data, nil)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(result))
+}
+
+func TestIdentical(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	data := randBytes(r, 100*1024)
+	d := roundTrip(t, data, data)
+	require.True(t, len(d) < 16)
+}
+
+func TestSmallerThanWindow(t *testing.T) {
+	roundTrip(t, []byte("foo"), []byte("foo"))
+	roundTrip(t, []byte("foo"), []byte("bar"))
+	roundTrip(t, bytes.Repeat([]byte("a"), windowSize), bytes.Repeat([]byte("a"), windowSize-1))
+}
+
+func TestEdits(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	base := randBytes(r, 100*1024)
+	insert := randBytes(r, 100)
+
+	// insert in the middle
+	target := append(append(append([]byte{}, base[:50*1024]...), insert...), base[50*1024:]...)
+	d := roundTrip(t, base, target)
+	require.True(t, len(d) < 200)
+
+	// delete from the middle
+	target = append(append([]byte{}, base[:50*1024]...), base[60*1024:]...)
+	d = roundTrip(t, base, target)
+	require.True(t, len(d) < 100)
+
+	// append and prepend
+	target = append(append(append([]byte{}, insert...), base...), insert...)
+	d = roundTrip(t, base, target)
+	require.True(t, len(d) < 300)
+
+	// unrelated content
+	target = randBytes(r, 10*1024)
+	d = roundTrip(t, base, target)
+	require.True(t, len(d) > len(target))
+}
+
+func TestChain(t *testing.T) {
+	// Each version is stored as a delta against the one before it, applying
+	// the deltas in order gets back every version.
+	r := rand.New(rand.NewSource(0))
+	versions := [][]byte{randBytes(r, 10*1024)}
+	for i := 0; i < 20; i++ {
+		prev := versions[len(versions)-1]
+		offset := r.Intn(len(prev))
+		next := append(append(append([]byte{}, prev[:offset]...), randBytes(r, 50)...), prev[offset:]...)
+		versions = append(versions, next)
+	}
+	var deltas [][]byte
+	for i := 1; i < len(versions); i++ {
+		deltas = append(deltas, Encode(versions[i-1], versions[i]))
+	}
+	current := versions[0]
+	for i, d := range deltas {
+		var err error
+		current, err = Apply(current, d)
+		require.NoError(t, err)
+		require.True(t, bytes.Equal(versions[i+1], current))
+	}
+}
+
+func TestInvalid(t *testing.T) {
+	base := []byte("foo")
+	for _, d := range [][]byte{
+		{2},                    // unknown instruction
+		{opCopy},               // missing offset
+		{opCopy, 0},            // missing length
+		{opCopy, 1, 3},         // out of range
+		{opInsert},             // missing length
+		{opInsert, 4, 'b'},     // truncated
+		{opInsert, 0xff, 0xff}, // bad varint
+	} {
+		_, err := Apply(base, d)
+		require.YesError(t, err)
+	}
+}
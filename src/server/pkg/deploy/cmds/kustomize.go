@@ -0,0 +1,132 @@
+package cmds
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// kustomizeResource is one Kubernetes object in a manifest.
+type kustomizeResource struct {
+	file string
+	kind string
+	data []byte // the object as it's written, with sorted keys
+}
+
+// writeKustomize writes base, the objects that are deployed wherever
+// Pachyderm is, to dir/base as a kustomize base, and the rest of manifest,
+// a deployment of variant, to dir/overlays/variant as an overlay of it. The
+// base is rewritten each time an overlay is written, so all of the overlays
+// in dir should be written with the same options.
+func writeKustomize(dir string, variant string, manifest io.Reader, base io.Reader) error {
+	resources, err := kustomizeResources(manifest)
+	if err != nil {
+		return err
+	}
+	baseResources, err := kustomizeResources(base)
+	if err != nil {
+		return err
+	}
+	inBase := make(map[string]bool)
+	for _, resource := range baseResources {
+		inBase[string(resource.data)] = true
+	}
+	var overlayResources []*kustomizeResource
+	for _, resource := range resources {
+		if !inBase[string(resource.data)] {
+			overlayResources = append(overlayResources, resource)
+		}
+	}
+	baseDir := filepath.Join(dir, "base")
+	if err := writeKustomization(baseDir, nil, baseResources); err != nil {
+		return err
+	}
+	overlayDir := filepath.Join(dir, "overlays", variant)
+	if err := writeKustomization(overlayDir, []string{"../../base"}, overlayResources); err != nil {
+		return err
+	}
+	warnSecrets(baseDir, baseResources)
+	warnSecrets(overlayDir, overlayResources)
+	fmt.Printf("Wrote the %s overlay to %s, deploy it with \"kubectl apply -k %s\".\n", variant, overlayDir, overlayDir)
+	return nil
+}
+
+func warnSecrets(dir string, resources []*kustomizeResource) {
+	for _, resource := range resources {
+		if resource.kind == "Secret" {
+			fmt.Fprintf(os.Stderr, "WARNING: %s is a secret, which may contain credentials, don't commit it to version control unencrypted.\n", filepath.Join(dir, resource.file))
+		}
+	}
+}
+
+// kustomizeResources splits manifest, a stream of JSON objects, into the
+// objects in it.
+func kustomizeResources(manifest io.Reader) ([]*kustomizeResource, error) {
+	var result []*kustomizeResource
+	decoder := json.NewDecoder(manifest)
+	for {
+		var value map[string]interface{}
+		if err := decoder.Decode(&value); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("error parsing manifest: %v", err)
+		}
+		kind, _ := value["kind"].(string)
+		var name string
+		if metadata, ok := value["metadata"].(map[string]interface{}); ok {
+			name, _ = metadata["name"].(string)
+		}
+		if kind == "" || name == "" {
+			return nil, fmt.Errorf("manifest has an object without a kind or name")
+		}
+		// encoding/json sorts map keys, so the same object is always
+		// written the same way.
+		data, err := json.MarshalIndent(value, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, &kustomizeResource{
+			file: fmt.Sprintf("%s-%s.json", strings.ToLower(kind), name),
+			kind: kind,
+			data: append(data, '\n'),
+		})
+	}
+	return result, nil
+}
+
+// writeKustomization writes resources, and a kustomization.yaml that lists
+// them and bases, to dir, replacing what was there.
+func writeKustomization(dir string, bases []string, resources []*kustomizeResource) error {
+	if err := os.RemoveAll(dir); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	var files []string
+	for _, resource := range resources {
+		if err := ioutil.WriteFile(filepath.Join(dir, resource.file), resource.data, 0644); err != nil {
+			return err
+		}
+		files = append(files, resource.file)
+	}
+	sort.Strings(files)
+	var kustomization bytes.Buffer
+	kustomization.WriteString("# Generated by pachctl deploy --kustomize.\n")
+	kustomization.WriteString("apiVersion: kustomize.config.k8s.io/v1beta1\n")
+	kustomization.WriteString("kind: Kustomization\n")
+	kustomization.WriteString("resources:\n")
+	for _, base := range bases {
+		fmt.Fprintf(&kustomization, "- %s\n", base)
+	}
+	for _, file := range files {
+		fmt.Fprintf(&kustomization, "- %s\n", file)
+	}
+	return ioutil.WriteFile(filepath.Join(dir, "kustomization.yaml"), kustomization.Bytes(), 0644)
+}
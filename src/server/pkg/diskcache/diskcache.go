@@ -0,0 +1,180 @@
+// Package diskcache is an LRU cache of immutable values, such as objects,
+// stored as files in a local directory.
+package diskcache
+
+import (
+	"container/list"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Cache is an LRU cache of values stored as files in a directory. Values
+// are never modified once they're cached, so they should be immutable (e.g.
+// content addressed).
+type Cache struct {
+	dir      string
+	maxBytes int64
+
+	mu      sync.Mutex
+	size    int64
+	lru     *list.List // of *entry, most recently used at the front
+	entries map[string]*list.Element
+
+	hits      int64
+	misses    int64
+	evictions int64
+}
+
+type entry struct {
+	key  string
+	size int64
+}
+
+// Stats are a cache's counters, as in groupcache.CacheStats.
+type Stats struct {
+	Bytes     int64
+	Items     int64
+	Hits      int64
+	Misses    int64
+	Evictions int64
+}
+
+// New creates a cache in dir that holds at most maxBytes. Anything already
+// in dir is removed, since there's no record of how recently it was used.
+func New(dir string, maxBytes int64) (*Cache, error) {
+	if err := os.RemoveAll(dir); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return &Cache{
+		dir:      dir,
+		maxBytes: maxBytes,
+		lru:      list.New(),
+		entries:  make(map[string]*list.Element),
+	}, nil
+}
+
+// MaxBytes returns the most bytes the cache holds.
+func (c *Cache) MaxBytes() int64 {
+	return c.maxBytes
+}
+
+// Get returns the value of key, which stays readable if it's evicted while
+// it's open. If the value isn't cached, fill is called to write it, and it's
+// cached, evicting the least recently used values to make room. Values
+// larger than the cache are returned but not cached.
+func (c *Cache) Get(key string, fill func(w io.Writer) error) (*os.File, error) {
+	if f := c.open(key); f != nil {
+		return f, nil
+	}
+	f, err := ioutil.TempFile(c.dir, "tmp-")
+	if err != nil {
+		return nil, err
+	}
+	size, err := c.fill(f, fill)
+	if err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return nil, err
+	}
+	if size > c.maxBytes {
+		// Unlinking the file leaves it readable through f.
+		return f, os.Remove(f.Name())
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.entries[key]; ok {
+		// Someone else cached it while we were filling it.
+		c.lru.MoveToFront(elem)
+		return f, os.Remove(f.Name())
+	}
+	for c.size+size > c.maxBytes && c.lru.Len() > 0 {
+		c.remove(c.lru.Back())
+		c.evictions++
+	}
+	if err := os.Rename(f.Name(), c.path(key)); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return nil, err
+	}
+	c.entries[key] = c.lru.PushFront(&entry{key: key, size: size})
+	c.size += size
+	return f, nil
+}
+
+// Contains returns whether key is cached, without counting as a use of it.
+func (c *Cache) Contains(key string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, ok := c.entries[key]
+	return ok
+}
+
+// open returns the cached value of key, or nil if it isn't cached.
+func (c *Cache) open(key string) *os.File {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.entries[key]
+	if !ok {
+		c.misses++
+		return nil
+	}
+	f, err := os.Open(c.path(key))
+	if err != nil {
+		// The file went missing, forget about it.
+		c.remove(elem)
+		c.misses++
+		return nil
+	}
+	c.lru.MoveToFront(elem)
+	c.hits++
+	return f
+}
+
+// fill calls fill with f and returns the number of bytes written, leaving
+// f at its start.
+func (c *Cache) fill(f *os.File, fill func(w io.Writer) error) (int64, error) {
+	if err := fill(f); err != nil {
+		return 0, err
+	}
+	size, err := f.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return 0, err
+	}
+	return size, nil
+}
+
+// Stats returns the cache's counters.
+func (c *Cache) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return Stats{
+		Bytes:     c.size,
+		Items:     int64(c.lru.Len()),
+		Hits:      c.hits,
+		Misses:    c.misses,
+		Evictions: c.evictions,
+	}
+}
+
+// remove must be called with c.mu held.
+func (c *Cache) remove(elem *list.Element) {
+	e := c.lru.Remove(elem).(*entry)
+	delete(c.entries, e.key)
+	c.size -= e.size
+	os.Remove(c.path(e.key))
+}
+
+func (c *Cache) path(key string) string {
+	// Keys are used as file names, so they can't contain separators.
+	return filepath.Join(c.dir, fmt.Sprintf("%x", key))
+}
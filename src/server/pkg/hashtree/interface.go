@@ -73,8 +73,11 @@ type HashTree interface {
 
 	// Diff returns a the diff of 2 HashTrees at particular Paths. It takes a
 	// callback function f, which will be called with paths that are not
-	// identical to the same path in the other HashTree.
-	Diff(oldHashTree HashTree, newPath string, oldPath string, f func(path string, node *NodeProto, new bool) error) error
+	// identical to the same path in the other HashTree. Diff descends
+	// recursiveDepth levels of directories below the paths, and calls f with
+	// the directories that differ at that depth rather than their contents.
+	// A recursiveDepth of -1 descends all the way to the files.
+	Diff(oldHashTree HashTree, newPath string, oldPath string, recursiveDepth int64, f func(path string, node *NodeProto, new bool) error) error
 }
 
 // OpenNode is similar to NodeProto, except that it doesn't include the Hash
@@ -0,0 +1,410 @@
+// Package kafka is a minimal Kafka consumer. It reads partitions of a topic
+// from explicit offsets, and leaves tracking offsets, and the consumer
+// group protocol, to the caller.
+package kafka
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// OffsetOldest asks for the oldest offset still in a partition.
+	OffsetOldest int64 = -2
+	// OffsetNewest asks for the offset of the next message written to a
+	// partition.
+	OffsetNewest int64 = -1
+
+	clientID    = "pachyderm"
+	dialTimeout = 10 * time.Second
+)
+
+// ErrOffsetOutOfRange is returned by Fetch when the offset is no longer, or
+// not yet, in the partition.
+var ErrOffsetOutOfRange = errors.New("kafka: offset out of range")
+
+// Message is a message read from a partition.
+type Message struct {
+	Offset int64
+	Key    []byte
+	Value  []byte
+}
+
+// Client reads messages from a Kafka cluster.
+type Client struct {
+	bootstrap []string
+
+	mu          sync.Mutex
+	brokers     map[int32]string
+	leaders     map[string]map[int32]int32
+	conns       map[string]*conn
+	correlation int32
+}
+
+// NewClient returns a Client which discovers the cluster through the
+// brokers at bootstrap (host:port).
+func NewClient(bootstrap []string) *Client {
+	return &Client{
+		bootstrap: bootstrap,
+		brokers:   make(map[int32]string),
+		leaders:   make(map[string]map[int32]int32),
+		conns:     make(map[string]*conn),
+	}
+}
+
+// Close closes the client's connections.
+func (c *Client) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for addr, conn := range c.conns {
+		conn.Close()
+		delete(c.conns, addr)
+	}
+	return nil
+}
+
+// Partitions returns the partitions of topic.
+func (c *Client) Partitions(topic string) ([]int32, error) {
+	if err := c.refreshMetadata(topic); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var partitions []int32
+	for partition := range c.leaders[topic] {
+		partitions = append(partitions, partition)
+	}
+	return partitions, nil
+}
+
+// Offset returns the offset in partition that time refers to, time must be
+// OffsetOldest or OffsetNewest.
+func (c *Client) Offset(topic string, partition int32, time int64) (int64, error) {
+	e := &encoder{}
+	e.int32(-1) // replica id
+	e.int32(1)  // topics
+	e.string(topic)
+	e.int32(1) // partitions
+	e.int32(partition)
+	e.int64(time)
+	e.int32(1) // max number of offsets
+	d, err := c.leaderRequest(topic, partition, apiListOffsets, e.buf)
+	if err != nil {
+		return 0, err
+	}
+	var offset int64
+	found := false
+	for i, n := 0, d.arrayLen(); i < n; i++ {
+		d.string() // topic
+		for j, m := 0, d.arrayLen(); j < m; j++ {
+			p := d.int32()
+			code := d.int16()
+			var offsets []int64
+			for k, l := 0, d.arrayLen(); k < l; k++ {
+				offsets = append(offsets, d.int64())
+			}
+			if d.err != nil || p != partition {
+				continue
+			}
+			if err := errorForCode(code); err != nil {
+				c.forgetLeader(topic, code)
+				return 0, err
+			}
+			if len(offsets) > 0 {
+				offset = offsets[0]
+				found = true
+			}
+		}
+	}
+	if d.err != nil {
+		return 0, d.err
+	}
+	if !found {
+		return 0, fmt.Errorf("kafka: no offset returned for %s/%d", topic, partition)
+	}
+	return offset, nil
+}
+
+// Fetch returns the messages in partition from offset on, up to about
+// maxBytes of them. If there are none it waits up to maxWait for some to
+// arrive.
+func (c *Client) Fetch(topic string, partition int32, offset int64, maxBytes int32, maxWait time.Duration) ([]*Message, error) {
+	e := &encoder{}
+	e.int32(-1) // replica id
+	e.int32(int32(maxWait / time.Millisecond))
+	e.int32(1) // min bytes
+	e.int32(1) // topics
+	e.string(topic)
+	e.int32(1) // partitions
+	e.int32(partition)
+	e.int64(offset)
+	e.int32(maxBytes)
+	d, err := c.leaderRequest(topic, partition, apiFetch, e.buf)
+	if err != nil {
+		return nil, err
+	}
+	var messages []*Message
+	for i, n := 0, d.arrayLen(); i < n; i++ {
+		d.string() // topic
+		for j, m := 0, d.arrayLen(); j < m; j++ {
+			p := d.int32()
+			code := d.int16()
+			d.int64() // high watermark
+			messageSet := d.bytes()
+			if d.err != nil {
+				return nil, d.err
+			}
+			if p != partition {
+				continue
+			}
+			if err := errorForCode(code); err != nil {
+				c.forgetLeader(topic, code)
+				return nil, err
+			}
+			ms, err := decodeMessageSet(messageSet)
+			if err != nil {
+				return nil, err
+			}
+			for _, message := range ms {
+				// Compressed message sets are returned whole, so they can
+				// start before offset.
+				if message.Offset >= offset {
+					messages = append(messages, message)
+				}
+			}
+		}
+	}
+	return messages, d.err
+}
+
+// decodeMessageSet decodes a v0/v1 message set. A broker may cut the last
+// message short, partial messages are ignored.
+func decodeMessageSet(b []byte) ([]*Message, error) {
+	var messages []*Message
+	for len(b) >= 12 {
+		offset := int64(binary.BigEndian.Uint64(b))
+		size := int(int32(binary.BigEndian.Uint32(b[8:])))
+		if size < 0 || len(b) < 12+size {
+			break
+		}
+		d := &decoder{buf: b[12 : 12+size]}
+		b = b[12+size:]
+		d.int32() // crc
+		magic := d.int8()
+		attributes := d.int8()
+		if magic >= 1 {
+			d.int64() // timestamp
+		}
+		key := d.bytes()
+		value := d.bytes()
+		if d.err != nil {
+			return nil, d.err
+		}
+		switch attributes & compressionCodecMask {
+		case 0:
+			messages = append(messages, &Message{Offset: offset, Key: key, Value: value})
+		case compressionGZIP:
+			r, err := gzip.NewReader(bytes.NewReader(value))
+			if err != nil {
+				return nil, err
+			}
+			inner, err := ioutil.ReadAll(r)
+			if err != nil {
+				return nil, err
+			}
+			innerMessages, err := decodeMessageSet(inner)
+			if err != nil {
+				return nil, err
+			}
+			if magic >= 1 && len(innerMessages) > 0 {
+				// Inner offsets are relative, and the wrapper has the
+				// offset of the last inner message.
+				last := innerMessages[len(innerMessages)-1].Offset
+				for _, message := range innerMessages {
+					message.Offset = offset - last + message.Offset
+				}
+			}
+			messages = append(messages, innerMessages...)
+		default:
+			return nil, fmt.Errorf("kafka: unsupported compression codec %d, only gzip is supported", attributes&compressionCodecMask)
+		}
+	}
+	return messages, nil
+}
+
+func (c *Client) refreshMetadata(topic string) error {
+	e := &encoder{}
+	e.int32(1)
+	e.string(topic)
+	var lastErr error
+	addrs := append([]string{}, c.bootstrap...)
+	c.mu.Lock()
+	for _, addr := range c.brokers {
+		addrs = append(addrs, addr)
+	}
+	c.mu.Unlock()
+	for _, addr := range addrs {
+		d, err := c.request(addr, apiMetadata, e.buf)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		brokers := make(map[int32]string)
+		for i, n := 0, d.arrayLen(); i < n; i++ {
+			nodeID := d.int32()
+			host := d.string()
+			port := d.int32()
+			brokers[nodeID] = net.JoinHostPort(host, strconv.Itoa(int(port)))
+		}
+		leaders := make(map[int32]int32)
+		for i, n := 0, d.arrayLen(); i < n; i++ {
+			topicCode := d.int16()
+			name := d.string()
+			for j, m := 0, d.arrayLen(); j < m; j++ {
+				d.int16() // partition error code
+				partition := d.int32()
+				leader := d.int32()
+				for k, l := 0, d.arrayLen(); k < l; k++ {
+					d.int32() // replica
+				}
+				for k, l := 0, d.arrayLen(); k < l; k++ {
+					d.int32() // in-sync replica
+				}
+				if name == topic {
+					leaders[partition] = leader
+				}
+			}
+			if name == topic {
+				if err := errorForCode(topicCode); err != nil {
+					return err
+				}
+			}
+		}
+		if d.err != nil {
+			lastErr = d.err
+			continue
+		}
+		c.mu.Lock()
+		for nodeID, addr := range brokers {
+			c.brokers[nodeID] = addr
+		}
+		c.leaders[topic] = leaders
+		c.mu.Unlock()
+		return nil
+	}
+	if lastErr == nil {
+		lastErr = fmt.Errorf("kafka: no brokers to connect to")
+	}
+	return lastErr
+}
+
+// forgetLeader drops the cached leaders of topic if code means that they
+// may have moved.
+func (c *Client) forgetLeader(topic string, code int16) {
+	if code != errNotLeaderForPart && code != errLeaderNotAvail && code != errUnknownTopic {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.leaders, topic)
+}
+
+func (c *Client) leaderRequest(topic string, partition int32, apiKey int16, body []byte) (*decoder, error) {
+	c.mu.Lock()
+	_, ok := c.leaders[topic]
+	c.mu.Unlock()
+	if !ok {
+		if err := c.refreshMetadata(topic); err != nil {
+			return nil, err
+		}
+	}
+	c.mu.Lock()
+	leader, ok := c.leaders[topic][partition]
+	addr := c.brokers[leader]
+	c.mu.Unlock()
+	if !ok || leader < 0 || addr == "" {
+		c.forgetLeader(topic, errLeaderNotAvail)
+		return nil, &Error{Code: errLeaderNotAvail}
+	}
+	return c.request(addr, apiKey, body)
+}
+
+// request sends a request to the broker at addr and returns a decoder for
+// the body of its response.
+func (c *Client) request(addr string, apiKey int16, body []byte) (*decoder, error) {
+	c.mu.Lock()
+	cn, ok := c.conns[addr]
+	if !ok {
+		netConn, err := net.DialTimeout("tcp", addr, dialTimeout)
+		if err != nil {
+			c.mu.Unlock()
+			return nil, err
+		}
+		cn = &conn{Conn: netConn, r: bufio.NewReader(netConn)}
+		c.conns[addr] = cn
+	}
+	c.correlation++
+	correlation := c.correlation
+	c.mu.Unlock()
+
+	resp, err := cn.roundTrip(apiKey, correlation, body)
+	if err != nil {
+		// The connection is in an unknown state, start over next time.
+		c.mu.Lock()
+		if c.conns[addr] == cn {
+			delete(c.conns, addr)
+		}
+		c.mu.Unlock()
+		cn.Close()
+		return nil, err
+	}
+	return &decoder{buf: resp}, nil
+}
+
+type conn struct {
+	net.Conn
+	r  *bufio.Reader
+	mu sync.Mutex
+}
+
+func (cn *conn) roundTrip(apiKey int16, correlation int32, body []byte) ([]byte, error) {
+	cn.mu.Lock()
+	defer cn.mu.Unlock()
+	e := &encoder{}
+	e.int32(0) // size, filled in below
+	e.int16(apiKey)
+	e.int16(0) // api version
+	e.int32(correlation)
+	e.string(clientID)
+	e.buf = append(e.buf, body...)
+	binary.BigEndian.PutUint32(e.buf, uint32(len(e.buf)-4))
+	if _, err := cn.Write(e.buf); err != nil {
+		return nil, err
+	}
+	var header [8]byte
+	if _, err := io.ReadFull(cn.r, header[:]); err != nil {
+		return nil, err
+	}
+	size := int32(binary.BigEndian.Uint32(header[:4]))
+	if size < 4 {
+		return nil, fmt.Errorf("kafka: invalid response size %d", size)
+	}
+	if got := int32(binary.BigEndian.Uint32(header[4:])); got != correlation {
+		return nil, fmt.Errorf("kafka: response for request %d, expected %d", got, correlation)
+	}
+	resp := make([]byte, size-4)
+	if _, err := io.ReadFull(cn.r, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
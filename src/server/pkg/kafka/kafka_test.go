@@ -0,0 +1,339 @@
+package kafka
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+	"net"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+// Requests and responses recorded from a reference client (sarama),
+// hex encoded, without the size and header that frame them.
+const (
+	// metadataRequest is a v0 metadata request for "events".
+	metadataRequest = "0000000100066576656e7473"
+	// metadataResponse is broker 1 at 127.0.0.1:9092 leading partitions 0 and 1 of "events".
+	metadataResponse = "000000010000000100093132372e302e302e3100002384000000010000000665" +
+		"76656e7473000000020000000000000000000100000001000000010000000100" +
+		"0000010000000000010000000100000001000000010000000100000001"
+	// metadataUnknownTopicResponse is "events" with an unknown topic error.
+	metadataUnknownTopicResponse = "000000010000000100093132372e302e302e3100002384000000010003000665" +
+		"76656e747300000000"
+	// offsetRequest is the oldest offset of events/0.
+	offsetRequest = "ffffffff0000000100066576656e74730000000100000000fffffffffffffffe" +
+		"00000001"
+	// offsetResponse is offset 3 for events/0.
+	offsetResponse = "0000000100066576656e74730000000100000000000000000001000000000000" +
+		"0003"
+	// fetchRequest is events/0 from offset 5, waiting up to 100ms for up to 1MiB.
+	fetchRequest = "ffffffff00000064000000010000000100066576656e74730000000100000000" +
+		"000000000000000500100000"
+	// fetchResponse is a v1 gzip message wrapping offsets 4 to 6 (a/one, b/two and
+	// three), and an uncompressed v0 message at offset 7 (four).
+	fetchResponse = "0000000100066576656e74730000000100000000000000000000000000080000" +
+		"00cc0000000000000006000000a23f1ebdb001010000015d3ef79800ffffffff" +
+		"0000008c1f8b08000000000000ff0073008cff00000000000000000000001a40" +
+		"8023cc01000000015d3ef798000000000161000000036f6e6500000000000000" +
+		"010000001aa5a928b801000000015d3ef7980000000001620000000374776f00" +
+		"000000000000020000001bbacdfc8701000000015d3ef79800ffffffff000000" +
+		"0574687265650300584f93d07300000000000000000000070000001211945ff6" +
+		"0000ffffffff00000004666f7572"
+	// fetchNotLeaderResponse is a not leader for partition error for events/0.
+	fetchNotLeaderResponse = "0000000100066576656e74730000000100000000000600000000000000000000" +
+		"0000"
+	// fetchOutOfRangeResponse is an offset out of range error for events/0.
+	fetchOutOfRangeResponse = "0000000100066576656e74730000000100000000000100000000000000000000" +
+		"0000"
+	// plainMessageSet is v0 messages k/v at offset 0 and w at offset 1.
+	plainMessageSet = "0000000000000000000000101fecd70a0000000000016b000000017600000000" +
+		"000000010000000fa50b8f630000ffffffff0000000177"
+	// gzipV0MessageSet is a v0 gzip message wrapping x and y at offsets 10 and 11.
+	gzipV0MessageSet = "000000000000000b0000005d84ff78a20001ffffffff0000004f1f8b08000000" +
+		"000000ff003600c9ff000000000000000a0000000f35b492f20000ffffffff00" +
+		"00000178000000000000000b0000000f42b3a2640000ffffffff000000017903" +
+		"008bd09dc536000000"
+	// snappyMessageSet is a snappy compressed message.
+	snappyMessageSet = "0000000000000000000000113a9eaad90002ffffffff00000003010078"
+)
+
+// metadataBrokerPort is the offset of broker 1's port in metadataResponse
+// and metadataUnknownTopicResponse.
+const metadataBrokerPort = 19
+
+type exchange struct {
+	apiKey   int16
+	request  string
+	response string
+}
+
+// fakeBroker answers a scripted sequence of requests, on any connection,
+// with recorded responses. Metadata responses are rewritten to point at
+// the fake broker.
+type fakeBroker struct {
+	t         *testing.T
+	listener  net.Listener
+	mu        sync.Mutex
+	exchanges []exchange
+}
+
+func newFakeBroker(t *testing.T, exchanges ...exchange) *fakeBroker {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	b := &fakeBroker{t: t, listener: listener, exchanges: exchanges}
+	go b.serve()
+	return b
+}
+
+func (b *fakeBroker) addr() string {
+	return b.listener.Addr().String()
+}
+
+func (b *fakeBroker) close() {
+	b.listener.Close()
+}
+
+// done checks that every scripted request was made.
+func (b *fakeBroker) done() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	require.Equal(b.t, 0, len(b.exchanges))
+}
+
+func (b *fakeBroker) serve() {
+	for {
+		conn, err := b.listener.Accept()
+		if err != nil {
+			return
+		}
+		go b.serveConn(conn)
+	}
+}
+
+func (b *fakeBroker) serveConn(conn net.Conn) {
+	defer conn.Close()
+	for {
+		var size [4]byte
+		if _, err := io.ReadFull(conn, size[:]); err != nil {
+			return
+		}
+		req := make([]byte, binary.BigEndian.Uint32(size[:]))
+		if _, err := io.ReadFull(conn, req); err != nil {
+			return
+		}
+		d := &decoder{buf: req}
+		apiKey := d.int16()
+		version := d.int16()
+		correlation := d.int32()
+		client := d.string()
+		if d.err != nil {
+			b.t.Errorf("invalid request header: %v", d.err)
+			return
+		}
+		body := hex.EncodeToString(d.buf)
+
+		b.mu.Lock()
+		if len(b.exchanges) == 0 {
+			b.mu.Unlock()
+			b.t.Errorf("unexpected request %d: %s", apiKey, body)
+			return
+		}
+		x := b.exchanges[0]
+		b.exchanges = b.exchanges[1:]
+		b.mu.Unlock()
+		if apiKey != x.apiKey || version != 0 || client != clientID || body != x.request {
+			b.t.Errorf("got request %d v%d from %q: %s, expected request %d: %s", apiKey, version, client, body, x.apiKey, x.request)
+			return
+		}
+
+		resp, err := hex.DecodeString(x.response)
+		if err != nil {
+			b.t.Errorf("invalid response fixture: %v", err)
+			return
+		}
+		if apiKey == apiMetadata {
+			_, port, _ := net.SplitHostPort(b.addr())
+			p, _ := strconv.Atoi(port)
+			binary.BigEndian.PutUint32(resp[metadataBrokerPort:], uint32(p))
+		}
+		e := &encoder{}
+		e.int32(int32(4 + len(resp)))
+		e.int32(correlation)
+		e.buf = append(e.buf, resp...)
+		if _, err := conn.Write(e.buf); err != nil {
+			return
+		}
+	}
+}
+
+func TestClient(t *testing.T) {
+	b := newFakeBroker(t,
+		exchange{apiMetadata, metadataRequest, metadataResponse},
+		exchange{apiListOffsets, offsetRequest, offsetResponse},
+		exchange{apiFetch, fetchRequest, fetchResponse},
+		exchange{apiFetch, fetchRequest, fetchNotLeaderResponse},
+		exchange{apiMetadata, metadataRequest, metadataResponse},
+		exchange{apiFetch, fetchRequest, fetchOutOfRangeResponse},
+	)
+	defer b.close()
+	c := NewClient([]string{b.addr()})
+	defer c.Close()
+
+	partitions, err := c.Partitions("events")
+	require.NoError(t, err)
+	sort.Slice(partitions, func(i, j int) bool { return partitions[i] < partitions[j] })
+	require.Equal(t, []int32{0, 1}, partitions)
+
+	offset, err := c.Offset("events", 0, OffsetOldest)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), offset)
+
+	// The message at offset 4 is in the same compressed message as 5 and 6,
+	// but is before the offset that was asked for.
+	messages, err := c.Fetch("events", 0, 5, 1<<20, 100*time.Millisecond)
+	require.NoError(t, err)
+	require.Equal(t, []*Message{
+		{Offset: 5, Key: []byte("b"), Value: []byte("two")},
+		{Offset: 6, Value: []byte("three")},
+		{Offset: 7, Value: []byte("four")},
+	}, messages)
+
+	// A leadership error drops the cached leaders, so the next fetch looks
+	// them up again.
+	_, err = c.Fetch("events", 0, 5, 1<<20, 100*time.Millisecond)
+	require.Equal(t, &Error{Code: errNotLeaderForPart}, err)
+	_, err = c.Fetch("events", 0, 5, 1<<20, 100*time.Millisecond)
+	require.Equal(t, ErrOffsetOutOfRange, err)
+	b.done()
+}
+
+func TestClientUnknownTopic(t *testing.T) {
+	b := newFakeBroker(t, exchange{apiMetadata, metadataRequest, metadataUnknownTopicResponse})
+	defer b.close()
+	c := NewClient([]string{b.addr()})
+	defer c.Close()
+	_, err := c.Partitions("events")
+	require.Equal(t, &Error{Code: errUnknownTopic}, err)
+	b.done()
+}
+
+func TestClientNoBrokers(t *testing.T) {
+	b := newFakeBroker(t)
+	addr := b.addr()
+	b.close()
+	c := NewClient([]string{addr})
+	defer c.Close()
+	_, err := c.Partitions("events")
+	require.YesError(t, err)
+}
+
+func decodeHex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return b
+}
+
+func TestDecodeMessageSet(t *testing.T) {
+	plain := decodeHex(t, plainMessageSet)
+	messages, err := decodeMessageSet(plain)
+	require.NoError(t, err)
+	require.Equal(t, []*Message{
+		{Offset: 0, Key: []byte("k"), Value: []byte("v")},
+		{Offset: 1, Value: []byte("w")},
+	}, messages)
+
+	// A message cut short by the broker is ignored.
+	messages, err = decodeMessageSet(plain[:len(plain)-3])
+	require.NoError(t, err)
+	require.Equal(t, 1, len(messages))
+	messages, err = decodeMessageSet(plain[:5])
+	require.NoError(t, err)
+	require.Equal(t, 0, len(messages))
+
+	// Offsets inside a v0 compressed message are absolute.
+	messages, err = decodeMessageSet(decodeHex(t, gzipV0MessageSet))
+	require.NoError(t, err)
+	require.Equal(t, []*Message{
+		{Offset: 10, Value: []byte("x")},
+		{Offset: 11, Value: []byte("y")},
+	}, messages)
+
+	_, err = decodeMessageSet(decodeHex(t, snappyMessageSet))
+	require.YesError(t, err)
+}
+
+func TestDecodeMessageSetCorrupt(t *testing.T) {
+	// A message whose key claims to be longer than the message.
+	e := &encoder{}
+	e.int64(0)  // offset
+	e.int32(10) // size
+	e.int32(0)  // crc
+	e.int8(0)   // magic
+	e.int8(0)   // attributes
+	e.int32(1 << 30)
+	_, err := decodeMessageSet(e.buf)
+	require.YesError(t, err)
+
+	// A gzip message whose value isn't gzipped.
+	e = &encoder{}
+	e.int64(0)  // offset
+	e.int32(15) // size
+	e.int32(0)  // crc
+	e.int8(0)   // magic
+	e.int8(compressionGZIP)
+	e.int32(-1) // key
+	e.int32(3)
+	e.buf = append(e.buf, "abc"...)
+	_, err = decodeMessageSet(e.buf)
+	require.YesError(t, err)
+}
+
+func TestDecoder(t *testing.T) {
+	e := &encoder{}
+	e.int8(-1)
+	e.int16(-2)
+	e.int32(-3)
+	e.int64(-4)
+	e.string("topic")
+	e.int32(-1) // null bytes
+	e.int32(-1) // null array
+	d := &decoder{buf: e.buf}
+	require.Equal(t, int8(-1), d.int8())
+	require.Equal(t, int16(-2), d.int16())
+	require.Equal(t, int32(-3), d.int32())
+	require.Equal(t, int64(-4), d.int64())
+	require.Equal(t, "topic", d.string())
+	require.Nil(t, d.bytes())
+	require.Equal(t, 0, d.arrayLen())
+	require.NoError(t, d.err)
+	require.Equal(t, 0, len(d.buf))
+
+	// Lengths that run past the end of the response are errors, and stay
+	// errors.
+	e = &encoder{}
+	e.int16(10)
+	e.buf = append(e.buf, "short"...)
+	d = &decoder{buf: e.buf}
+	require.Equal(t, "", d.string())
+	require.YesError(t, d.err)
+	require.Equal(t, int32(0), d.int32())
+
+	e = &encoder{}
+	e.int32(1 << 30)
+	d = &decoder{buf: e.buf}
+	require.Nil(t, d.bytes())
+	require.YesError(t, d.err)
+
+	e = &encoder{}
+	e.int32(1 << 30)
+	e.int32(0)
+	d = &decoder{buf: e.buf}
+	require.Equal(t, 0, d.arrayLen())
+	require.YesError(t, d.err)
+}
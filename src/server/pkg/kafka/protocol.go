@@ -0,0 +1,170 @@
+package kafka
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// API keys and the versions of them that we speak. We use the oldest
+// versions, which every broker supports and which return messages in the
+// old (v0/v1) message set format.
+const (
+	apiFetch       = 1
+	apiListOffsets = 2
+	apiMetadata    = 3
+)
+
+// Error codes that callers need to handle, see the protocol guide for the
+// rest.
+const (
+	errNone             = 0
+	errOffsetOutOfRange = 1
+	errUnknownTopic     = 3
+	errLeaderNotAvail   = 5
+	errNotLeaderForPart = 6
+	errRequestTimedOut  = 7
+	errReplicaNotAvail  = 9
+)
+
+// Message attributes.
+const (
+	compressionCodecMask = 0x07
+	compressionGZIP      = 1
+)
+
+type encoder struct {
+	buf []byte
+}
+
+func (e *encoder) int8(v int8) {
+	e.buf = append(e.buf, byte(v))
+}
+
+func (e *encoder) int16(v int16) {
+	e.buf = append(e.buf, byte(v>>8), byte(v))
+}
+
+func (e *encoder) int32(v int32) {
+	e.buf = append(e.buf, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+func (e *encoder) int64(v int64) {
+	e.int32(int32(v >> 32))
+	e.int32(int32(v))
+}
+
+func (e *encoder) string(s string) {
+	e.int16(int16(len(s)))
+	e.buf = append(e.buf, s...)
+}
+
+// decoder reads the fields of a response. Errors are sticky, the response
+// is invalid if err is set once all the fields have been read.
+type decoder struct {
+	buf []byte
+	err error
+}
+
+func (d *decoder) next(n int) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if n < 0 || len(d.buf) < n {
+		d.err = fmt.Errorf("kafka response too short")
+		return nil
+	}
+	b := d.buf[:n]
+	d.buf = d.buf[n:]
+	return b
+}
+
+func (d *decoder) int8() int8 {
+	if b := d.next(1); b != nil {
+		return int8(b[0])
+	}
+	return 0
+}
+
+func (d *decoder) int16() int16 {
+	if b := d.next(2); b != nil {
+		return int16(binary.BigEndian.Uint16(b))
+	}
+	return 0
+}
+
+func (d *decoder) int32() int32 {
+	if b := d.next(4); b != nil {
+		return int32(binary.BigEndian.Uint32(b))
+	}
+	return 0
+}
+
+func (d *decoder) int64() int64 {
+	if b := d.next(8); b != nil {
+		return int64(binary.BigEndian.Uint64(b))
+	}
+	return 0
+}
+
+func (d *decoder) string() string {
+	n := d.int16()
+	if n < 0 {
+		return ""
+	}
+	return string(d.next(int(n)))
+}
+
+// bytes reads a nullable byte array.
+func (d *decoder) bytes() []byte {
+	n := d.int32()
+	if n < 0 {
+		return nil
+	}
+	return d.next(int(n))
+}
+
+// arrayLen reads the length of an array, a null array has length 0.
+func (d *decoder) arrayLen() int {
+	n := d.int32()
+	if n < 0 {
+		return 0
+	}
+	if int(n) > len(d.buf) {
+		// Every element is at least a byte, so this can't be right.
+		d.err = fmt.Errorf("kafka response has an invalid array length %d", n)
+		return 0
+	}
+	return int(n)
+}
+
+func errorForCode(code int16) error {
+	switch code {
+	case errNone:
+		return nil
+	case errOffsetOutOfRange:
+		return ErrOffsetOutOfRange
+	default:
+		return &Error{Code: code}
+	}
+}
+
+// Error is an error code returned by a broker.
+type Error struct {
+	Code int16
+}
+
+func (e *Error) Error() string {
+	switch e.Code {
+	case errUnknownTopic:
+		return "kafka: unknown topic or partition"
+	case errLeaderNotAvail:
+		return "kafka: leader not available"
+	case errNotLeaderForPart:
+		return "kafka: broker is not the leader for the partition"
+	case errRequestTimedOut:
+		return "kafka: request timed out"
+	case errReplicaNotAvail:
+		return "kafka: replica not available"
+	}
+	return fmt.Sprintf("kafka: error code %d", e.Code)
+}
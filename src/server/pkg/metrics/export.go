@@ -0,0 +1,104 @@
+package metrics
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+)
+
+// exportQueueSize is how many events can wait to be exported before new
+// ones are dropped, so that a slow destination doesn't slow down the
+// requests being reported.
+const exportQueueSize = 1000
+
+// Event is a usage event, as it's written to a metrics export. Events are
+// the same as those that are reported to Segment, when reporting is on.
+type Event struct {
+	Time       time.Time              `json:"time"`
+	Event      string                 `json:"event"`
+	ClusterID  string                 `json:"clusterId,omitempty"`
+	UserID     string                 `json:"userId,omitempty"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+// exporter writes usage events to a destination chosen by the cluster's
+// admin, either a file, which events are appended to as lines of JSON, or
+// an http(s) URL, which each event is POSTed to as JSON.
+type exporter struct {
+	destination string
+	events      chan *Event
+	write       func(*Event) error
+}
+
+func newExporter(destination string) (*exporter, error) {
+	e := &exporter{
+		destination: destination,
+		events:      make(chan *Event, exportQueueSize),
+	}
+	if strings.HasPrefix(destination, "http://") || strings.HasPrefix(destination, "https://") {
+		client := &http.Client{Timeout: 10 * time.Second}
+		e.write = func(event *Event) error {
+			return postEvent(client, destination, event)
+		}
+	} else {
+		f, err := os.OpenFile(destination, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+		if err != nil {
+			return nil, fmt.Errorf("error opening metrics export file: %v", err)
+		}
+		e.write = func(event *Event) error {
+			return writeEvent(f, event)
+		}
+	}
+	go e.run()
+	return e, nil
+}
+
+// export queues event to be exported. If the queue is full, event is
+// dropped.
+func (e *exporter) export(event *Event) {
+	select {
+	case e.events <- event:
+	default:
+		log.Errorf("dropping usage event %s, too many events are waiting to be exported to %s", event.Event, e.destination)
+	}
+}
+
+func (e *exporter) run() {
+	for event := range e.events {
+		if err := e.write(event); err != nil {
+			log.Errorf("error exporting usage event to %s: %v", e.destination, err)
+		}
+	}
+}
+
+func writeEvent(w io.Writer, event *Event) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(data, '\n'))
+	return err
+}
+
+func postEvent(client *http.Client, url string, event *Event) error {
+	var buf bytes.Buffer
+	if err := writeEvent(&buf, event); err != nil {
+		return err
+	}
+	resp, err := client.Post(url, "application/json", &buf)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%s", resp.Status)
+	}
+	return nil
+}
@@ -15,11 +15,13 @@ import (
 	kube "k8s.io/kubernetes/pkg/client/unversioned"
 )
 
-//Reporter is used to submit user & cluster metrics to segment
+//Reporter is used to submit user & cluster metrics to segment, and to a
+// local export if one is configured
 type Reporter struct {
 	segmentClient *analytics.Client
 	clusterID     string
 	kubeClient    *kube.Client
+	exporter      *exporter
 }
 
 // NewReporter creates a new reporter and kicks off the loop to report cluster
@@ -34,6 +36,27 @@ func NewReporter(clusterID string, kubeClient *kube.Client) *Reporter {
 	return reporter
 }
 
+// NewExportingReporter creates a reporter that also writes the events it
+// reports to export, a file or an http(s) URL, e.g. to feed an internal
+// analytics system. If external is false, events are only exported, not
+// reported to Segment.
+func NewExportingReporter(clusterID string, kubeClient *kube.Client, external bool, export string) (*Reporter, error) {
+	exporter, err := newExporter(export)
+	if err != nil {
+		return nil, err
+	}
+	reporter := &Reporter{
+		clusterID:  clusterID,
+		kubeClient: kubeClient,
+		exporter:   exporter,
+	}
+	if external {
+		reporter.segmentClient = newPersistentClient()
+	}
+	go reporter.reportClusterMetrics()
+	return reporter, nil
+}
+
 //ReportUserAction pushes the action into a queue for reporting,
 // and reports the start, finish, and error conditions
 func ReportUserAction(ctx context.Context, r *Reporter, action string) func(time.Time, error) {
@@ -73,17 +96,39 @@ func (r *Reporter) reportUserAction(ctx context.Context, action string, value in
 			log.Errorln(err)
 			return
 		}
-		reportUserMetricsToSegment(
-			r.segmentClient,
-			userID,
-			prefix,
-			action,
-			value,
-			r.clusterID,
-		)
+		if r.segmentClient != nil {
+			reportUserMetricsToSegment(
+				r.segmentClient,
+				userID,
+				prefix,
+				action,
+				value,
+				r.clusterID,
+			)
+		}
+		if r.exporter != nil {
+			r.exporter.export(&Event{
+				Time:      time.Now(),
+				Event:     fmt.Sprintf("%v.usage", prefix),
+				ClusterID: r.clusterID,
+				UserID:    userID,
+				Properties: map[string]interface{}{
+					action: exportValue(value),
+				},
+			})
+		}
 	}
 }
 
+// exportValue returns value in a form that can be written as JSON. Errors
+// are reported as their messages.
+func exportValue(value interface{}) interface{} {
+	if err, ok := value.(error); ok {
+		return err.Error()
+	}
+	return value
+}
+
 func reportAndFlushUserAction(action string, value interface{}) func() {
 	metricsDone := make(chan struct{})
 	go func() {
@@ -138,6 +183,16 @@ func (r *Reporter) reportClusterMetrics() {
 		metrics.ClusterID = r.clusterID
 		metrics.PodID = uuid.NewWithoutDashes()
 		metrics.Version = version.PrettyPrintVersion(version.Version)
-		reportClusterMetricsToSegment(r.segmentClient, metrics)
+		if r.segmentClient != nil {
+			reportClusterMetricsToSegment(r.segmentClient, metrics)
+		}
+		if r.exporter != nil {
+			r.exporter.export(&Event{
+				Time:       time.Now(),
+				Event:      "cluster.metrics",
+				ClusterID:  metrics.ClusterID,
+				Properties: clusterMetricsProperties(metrics),
+			})
+		}
 	}
 }
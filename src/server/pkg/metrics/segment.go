@@ -25,23 +25,27 @@ func reportClusterMetricsToSegment(client *analytics.Client, metrics *Metrics) {
 	err := client.Track(&analytics.Track{
 		Event:       "cluster.metrics",
 		AnonymousId: metrics.ClusterID,
-		Properties: map[string]interface{}{
-			"PodID":     metrics.PodID,
-			"nodes":     metrics.Nodes,
-			"version":   metrics.Version,
-			"repos":     metrics.Repos,
-			"commits":   metrics.Commits,
-			"files":     metrics.Files,
-			"bytes":     metrics.Bytes,
-			"jobs":      metrics.Jobs,
-			"pipelines": metrics.Pipelines,
-		},
+		Properties:  clusterMetricsProperties(metrics),
 	})
 	if err != nil {
 		log.Errorf("error reporting cluster metrics to Segment: %s", err.Error())
 	}
 }
 
+func clusterMetricsProperties(metrics *Metrics) map[string]interface{} {
+	return map[string]interface{}{
+		"PodID":     metrics.PodID,
+		"nodes":     metrics.Nodes,
+		"version":   metrics.Version,
+		"repos":     metrics.Repos,
+		"commits":   metrics.Commits,
+		"files":     metrics.Files,
+		"bytes":     metrics.Bytes,
+		"jobs":      metrics.Jobs,
+		"pipelines": metrics.Pipelines,
+	}
+}
+
 /*
 Segment needs us to identify a user before we report any events for that user.
 We have no way of knowing if a user has previously been identified, so we call this
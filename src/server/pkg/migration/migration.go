@@ -1,8 +1,13 @@
 package migration
 
 import (
+	"context"
 	"fmt"
 	"strings"
+
+	"github.com/pachyderm/pachyderm/src/client"
+
+	etcd "github.com/coreos/etcd/clientv3"
 )
 
 // migrationFunc is a function that migrates Pachyderm's internal state from
@@ -43,5 +48,47 @@ func Run(etcdAddress, pfsPrefix, ppsPrefix, from, to string) error {
 		return fmt.Errorf("unable to find a migration routine that migrates from version %v to version %v", from, to)
 	}
 
-	return routine(etcdAddress, pfsPrefix, ppsPrefix)
+	if err := routine(etcdAddress, pfsPrefix, ppsPrefix); err != nil {
+		return err
+	}
+	etcdClient, err := newEtcdClient(etcdAddress)
+	if err != nil {
+		return err
+	}
+	defer etcdClient.Close()
+	_, err = etcdClient.Put(context.Background(), client.MetadataVersionKey, to)
+	return err
+}
+
+// InitMetadataVersion records that the cluster's metadata is compatible with
+// version, unless a metadata version has already been recorded. Clusters
+// that already have PFS metadata but no metadata version predate metadata
+// versioning, so we can't tell which version their metadata is from and
+// leave them alone.
+func InitMetadataVersion(etcdAddress, pfsPrefix, version string) error {
+	etcdClient, err := newEtcdClient(etcdAddress)
+	if err != nil {
+		return err
+	}
+	defer etcdClient.Close()
+	ctx := context.Background()
+	resp, err := etcdClient.Get(ctx, pfsPrefix, etcd.WithPrefix(), etcd.WithCountOnly())
+	if err != nil {
+		return err
+	}
+	if resp.Count > 0 {
+		return nil
+	}
+	_, err = etcdClient.Txn(ctx).
+		If(etcd.Compare(etcd.CreateRevision(client.MetadataVersionKey), "=", 0)).
+		Then(etcd.OpPut(client.MetadataVersionKey, version)).
+		Commit()
+	return err
+}
+
+func newEtcdClient(etcdAddress string) (*etcd.Client, error) {
+	return etcd.New(etcd.Config{
+		Endpoints:   []string{fmt.Sprintf("%s:2379", etcdAddress)},
+		DialOptions: client.EtcdDialOptions(),
+	})
 }
@@ -0,0 +1,318 @@
+// Package notify sends notifications about jobs to the sinks, e.g. Slack or
+// PagerDuty, that the cluster's notification config routes them to.
+package notify
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/smtp"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/admin"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
+
+	etcd "github.com/coreos/etcd/clientv3"
+	"go.pedge.io/lion/proto"
+	"golang.org/x/net/context"
+)
+
+const (
+	sendTimeout    = 10 * time.Second
+	sendMaxElapsed = 5 * time.Minute
+	// pagerDutyURL is PagerDuty's Events API (v2).
+	pagerDutyURL = "https://events.pagerduty.com/v2/enqueue"
+)
+
+// GetConfig reads the cluster's notification config from etcd, it returns
+// an empty config if none has been set.
+func GetConfig(ctx context.Context, etcdClient *etcd.Client) (*admin.NotificationConfig, error) {
+	resp, err := etcdClient.Get(ctx, client.NotificationConfigKey)
+	if err != nil {
+		return nil, err
+	}
+	config := &admin.NotificationConfig{}
+	if resp.Count > 0 {
+		if err := config.Unmarshal(resp.Kvs[0].Value); err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
+
+// SetConfig validates config and writes it to etcd.
+func SetConfig(ctx context.Context, etcdClient *etcd.Client, config *admin.NotificationConfig) error {
+	if err := Validate(config); err != nil {
+		return err
+	}
+	data, err := config.Marshal()
+	if err != nil {
+		return err
+	}
+	_, err = etcdClient.Put(ctx, client.NotificationConfigKey, string(data))
+	return err
+}
+
+// Validate returns an error if config is invalid.
+func Validate(config *admin.NotificationConfig) error {
+	sinks := make(map[string]bool)
+	for _, sink := range config.Sinks {
+		if sink.Name == "" {
+			return fmt.Errorf("sinks must have a name")
+		}
+		if sinks[sink.Name] {
+			return fmt.Errorf("there's more than one sink named %q", sink.Name)
+		}
+		sinks[sink.Name] = true
+		var destinations int
+		if sink.Slack != nil {
+			destinations++
+			if sink.Slack.WebhookURL == "" {
+				return fmt.Errorf("sink %q: slack sinks need a webhook URL", sink.Name)
+			}
+		}
+		if sink.PagerDuty != nil {
+			destinations++
+			if sink.PagerDuty.RoutingKey == "" {
+				return fmt.Errorf("sink %q: pagerduty sinks need a routing key", sink.Name)
+			}
+			switch sink.PagerDuty.Severity {
+			case "", "critical", "error", "warning", "info":
+			default:
+				return fmt.Errorf("sink %q: invalid severity %q", sink.Name, sink.PagerDuty.Severity)
+			}
+		}
+		if sink.Email != nil {
+			destinations++
+			if _, _, err := net.SplitHostPort(sink.Email.SMTPAddress); err != nil {
+				return fmt.Errorf("sink %q: invalid SMTP address: %v", sink.Name, err)
+			}
+			if sink.Email.From == "" || len(sink.Email.To) == 0 {
+				return fmt.Errorf("sink %q: email sinks need from and to addresses", sink.Name)
+			}
+		}
+		if destinations != 1 {
+			return fmt.Errorf("sink %q must have exactly one of slack, pagerDuty and email set", sink.Name)
+		}
+	}
+	for i, rule := range config.Rules {
+		if _, err := path.Match(rule.Pipeline, ""); err != nil {
+			return fmt.Errorf("rule %d: invalid pipeline pattern %q: %v", i, rule.Pipeline, err)
+		}
+		if len(rule.Sinks) == 0 {
+			return fmt.Errorf("rule %d has no sinks", i)
+		}
+		for _, sink := range rule.Sinks {
+			if !sinks[sink] {
+				return fmt.Errorf("rule %d refers to sink %q, which doesn't exist", i, sink)
+			}
+		}
+	}
+	return nil
+}
+
+// Job sends a notification about jobInfo to each sink that config routes it
+// to. Failed sends are retried for a while, then logged, they never return
+// an error because a sink that's down shouldn't affect the job.
+func Job(config *admin.NotificationConfig, jobInfo *pps.JobInfo) {
+	for _, sink := range routeJob(config, jobInfo) {
+		b := backoff.NewExponentialBackOff()
+		b.MaxElapsedTime = sendMaxElapsed
+		if err := backoff.RetryNotify(func() error {
+			return send(sink, jobInfo)
+		}, b, func(err error, d time.Duration) error {
+			protolion.Errorf("error sending notification to %s for job %s: %v; retrying in %v", sink.Name, jobInfo.Job.ID, err, d)
+			return nil
+		}); err != nil {
+			protolion.Errorf("giving up on notification to %s for job %s: %v", sink.Name, jobInfo.Job.ID, err)
+		}
+	}
+}
+
+// routeJob returns the sinks that config routes jobInfo to, each sink is
+// returned at most once.
+func routeJob(config *admin.NotificationConfig, jobInfo *pps.JobInfo) []*admin.NotificationSink {
+	var pipeline string
+	if jobInfo.Pipeline != nil {
+		pipeline = jobInfo.Pipeline.Name
+	}
+	routed := make(map[string]bool)
+	for _, rule := range config.Rules {
+		if !ruleMatches(rule, pipeline, jobInfo.State) {
+			continue
+		}
+		for _, sink := range rule.Sinks {
+			routed[sink] = true
+		}
+	}
+	var result []*admin.NotificationSink
+	for _, sink := range config.Sinks {
+		if routed[sink.Name] {
+			result = append(result, sink)
+		}
+	}
+	return result
+}
+
+func ruleMatches(rule *admin.NotificationRule, pipeline string, state pps.JobState) bool {
+	if rule.Pipeline != "" {
+		if pipeline == "" {
+			return false
+		}
+		if matched, err := path.Match(rule.Pipeline, pipeline); err != nil || !matched {
+			return false
+		}
+	}
+	if len(rule.States) == 0 {
+		return state == pps.JobState_JOB_FAILURE
+	}
+	for _, s := range rule.States {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
+
+func send(sink *admin.NotificationSink, jobInfo *pps.JobInfo) error {
+	switch {
+	case sink.Slack != nil:
+		return sendSlack(sink.Slack, jobInfo)
+	case sink.PagerDuty != nil:
+		return sendPagerDuty(sink.PagerDuty, jobInfo)
+	case sink.Email != nil:
+		return sendEmail(sink.Email, jobInfo)
+	}
+	return fmt.Errorf("sink has no destination")
+}
+
+// summary is a one line description of the job's state.
+func summary(jobInfo *pps.JobInfo) string {
+	var state string
+	switch jobInfo.State {
+	case pps.JobState_JOB_SUCCESS:
+		state = "succeeded"
+	case pps.JobState_JOB_FAILURE:
+		state = "failed"
+	case pps.JobState_JOB_STOPPED:
+		state = "was stopped"
+	default:
+		state = "is " + strings.ToLower(strings.TrimPrefix(jobInfo.State.String(), "JOB_"))
+	}
+	if jobInfo.Pipeline != nil {
+		return fmt.Sprintf("Job %s of pipeline %s %s", jobInfo.Job.ID, jobInfo.Pipeline.Name, state)
+	}
+	return fmt.Sprintf("Job %s %s", jobInfo.Job.ID, state)
+}
+
+// details are the facts about the job that are included in notifications
+// after the summary.
+func details(jobInfo *pps.JobInfo) []string {
+	var result []string
+	for _, commit := range pps.InputCommits(jobInfo.Input) {
+		result = append(result, fmt.Sprintf("Input: %s/%s", commit.Repo.Name, commit.ID))
+	}
+	if jobInfo.OutputCommit != nil {
+		result = append(result, fmt.Sprintf("Output: %s/%s", jobInfo.OutputCommit.Repo.Name, jobInfo.OutputCommit.ID))
+	}
+	result = append(result, fmt.Sprintf("Data processed: %d/%d", jobInfo.DataProcessed, jobInfo.DataTotal))
+	if jobInfo.Restart > 0 {
+		result = append(result, fmt.Sprintf("Restarts: %d", jobInfo.Restart))
+	}
+	return result
+}
+
+func post(url string, body interface{}) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	httpClient := &http.Client{Timeout: sendTimeout}
+	resp, err := httpClient.Post(url, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%s returned %s", url, resp.Status)
+	}
+	return nil
+}
+
+func sendSlack(sink *admin.SlackSink, jobInfo *pps.JobInfo) error {
+	message := struct {
+		Text    string `json:"text"`
+		Channel string `json:"channel,omitempty"`
+	}{
+		Text:    fmt.Sprintf("*%s*\n%s", summary(jobInfo), strings.Join(details(jobInfo), "\n")),
+		Channel: sink.Channel,
+	}
+	return post(sink.WebhookURL, message)
+}
+
+func sendPagerDuty(sink *admin.PagerDutySink, jobInfo *pps.JobInfo) error {
+	type payload struct {
+		Summary       string   `json:"summary"`
+		Source        string   `json:"source"`
+		Severity      string   `json:"severity"`
+		Component     string   `json:"component,omitempty"`
+		CustomDetails []string `json:"custom_details,omitempty"`
+	}
+	event := struct {
+		RoutingKey  string   `json:"routing_key"`
+		EventAction string   `json:"event_action"`
+		DedupKey    string   `json:"dedup_key"`
+		Payload     *payload `json:"payload,omitempty"`
+	}{
+		RoutingKey: sink.RoutingKey,
+		// Alerts are per pipeline, so that a pipeline that fails every job
+		// doesn't open an incident for each one.
+		DedupKey: "pachyderm-job-" + jobInfo.Job.ID,
+	}
+	if jobInfo.Pipeline != nil {
+		event.DedupKey = "pachyderm-pipeline-" + jobInfo.Pipeline.Name
+	}
+	if jobInfo.State == pps.JobState_JOB_SUCCESS {
+		event.EventAction = "resolve"
+	} else {
+		event.EventAction = "trigger"
+		event.Payload = &payload{
+			Summary:       summary(jobInfo),
+			Source:        "pachyderm",
+			Severity:      sink.Severity,
+			CustomDetails: details(jobInfo),
+		}
+		if event.Payload.Severity == "" {
+			event.Payload.Severity = "error"
+		}
+		if jobInfo.Pipeline != nil {
+			event.Payload.Component = jobInfo.Pipeline.Name
+		}
+	}
+	return post(pagerDutyURL, event)
+}
+
+func sendEmail(sink *admin.EmailSink, jobInfo *pps.JobInfo) error {
+	host, _, err := net.SplitHostPort(sink.SMTPAddress)
+	if err != nil {
+		return err
+	}
+	var auth smtp.Auth
+	if sink.Username != "" {
+		auth = smtp.PlainAuth("", sink.Username, sink.Password, host)
+	}
+	subject := summary(jobInfo)
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", sink.From)
+	fmt.Fprintf(&msg, "To: %s\r\n", strings.Join(sink.To, ", "))
+	fmt.Fprintf(&msg, "Subject: %s\r\n", subject)
+	fmt.Fprintf(&msg, "Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	fmt.Fprintf(&msg, "%s\r\n\r\n%s\r\n", subject, strings.Join(details(jobInfo), "\r\n"))
+	return smtp.SendMail(sink.SMTPAddress, auth, sink.From, sink.To, msg.Bytes())
+}
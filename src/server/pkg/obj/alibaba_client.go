@@ -0,0 +1,491 @@
+package obj
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff"
+	"github.com/pachyderm/pachyderm/src/client/limit"
+	"go.pedge.io/lion"
+	"golang.org/x/net/context"
+	"golang.org/x/sync/errgroup"
+)
+
+// alibabaListLimit is the most object names that are listed per request.
+const alibabaListLimit = 1000
+
+// alibabaSubresources are the query parameters that are part of the
+// resource that a request to OSS signs.
+var alibabaSubresources = map[string]bool{
+	"partNumber": true,
+	"uploadId":   true,
+	"uploads":    true,
+}
+
+// alibabaClient is a client for Alibaba Cloud OSS, which talks to its REST
+// API directly and signs requests with OSS's HMAC-SHA1 signatures.
+type alibabaClient struct {
+	httpClient  *http.Client
+	bucket      string
+	scheme      string
+	host        string
+	id          string
+	secret      string
+	token       string
+	concurrency int
+}
+
+// alibabaError is an error returned by OSS.
+type alibabaError struct {
+	statusCode int
+	Code       string `xml:"Code"`
+	Message    string `xml:"Message"`
+	RequestID  string `xml:"RequestId"`
+}
+
+func (e *alibabaError) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("oss: status %d", e.statusCode)
+	}
+	return fmt.Sprintf("oss: %s: %s (request %s)", e.Code, e.Message, e.RequestID)
+}
+
+func newAlibabaClient(bucket string, endpoint string, id string, secret string, token string) (*alibabaClient, error) {
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "https://" + endpoint
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid OSS endpoint %s: %v", endpoint, err)
+	}
+	return &alibabaClient{
+		httpClient:  &http.Client{},
+		bucket:      bucket,
+		scheme:      u.Scheme,
+		host:        u.Host,
+		id:          id,
+		secret:      secret,
+		token:       token,
+		concurrency: uploadConcurrency,
+	}, nil
+}
+
+func (c *alibabaClient) Writer(name string) (io.WriteCloser, error) {
+	return newAlibabaWriter(c, name), nil
+}
+
+func (c *alibabaClient) Reader(name string, offset uint64, size uint64) (io.ReadCloser, error) {
+	header := make(http.Header)
+	if byteRange := byteRange(offset, size); byteRange != "" {
+		header.Set("Range", "bytes="+byteRange)
+	}
+	resp, err := c.do(context.Background(), "GET", name, nil, header, nil)
+	if err != nil {
+		return nil, err
+	}
+	return newBackoffReadCloser(c, resp.Body), nil
+}
+
+func (c *alibabaClient) Delete(name string) error {
+	resp, err := c.do(context.Background(), "DELETE", name, nil, nil, nil)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+func (c *alibabaClient) Walk(prefix string, fn func(name string) error) error {
+	var marker string
+	for {
+		query := url.Values{}
+		query.Set("prefix", prefix)
+		query.Set("max-keys", strconv.Itoa(alibabaListLimit))
+		if marker != "" {
+			query.Set("marker", marker)
+		}
+		resp, err := c.do(context.Background(), "GET", "", query, nil, nil)
+		if err != nil {
+			return err
+		}
+		var result struct {
+			IsTruncated bool   `xml:"IsTruncated"`
+			NextMarker  string `xml:"NextMarker"`
+			Contents    []struct {
+				Key string `xml:"Key"`
+			} `xml:"Contents"`
+		}
+		err = xml.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return err
+		}
+		for _, object := range result.Contents {
+			if err := fn(object.Key); err != nil {
+				return err
+			}
+		}
+		if !result.IsTruncated {
+			return nil
+		}
+		marker = result.NextMarker
+	}
+}
+
+func (c *alibabaClient) Exists(name string) bool {
+	resp, err := c.do(context.Background(), "HEAD", name, nil, nil, nil)
+	if err != nil {
+		return false
+	}
+	resp.Body.Close()
+	return true
+}
+
+func (c *alibabaClient) ModTime(name string) (time.Time, error) {
+	resp, err := c.do(context.Background(), "HEAD", name, nil, nil, nil)
+	if err != nil {
+		return time.Time{}, err
+	}
+	resp.Body.Close()
+	return time.Parse(http.TimeFormat, resp.Header.Get("Last-Modified"))
+}
+
+func (c *alibabaClient) isRetryable(err error) bool {
+	alibabaErr, ok := err.(*alibabaError)
+	if !ok {
+		return false
+	}
+	return alibabaErr.statusCode >= 500
+}
+
+func (c *alibabaClient) IsNotExist(err error) bool {
+	alibabaErr, ok := err.(*alibabaError)
+	if !ok {
+		return false
+	}
+	return alibabaErr.statusCode == http.StatusNotFound
+}
+
+func (c *alibabaClient) IsIgnorable(err error) bool {
+	return false
+}
+
+// do makes a signed request for the object name, or for the bucket if name
+// is empty, and returns an *alibabaError if OSS returns an error status.
+func (c *alibabaClient) do(ctx context.Context, method string, name string, query url.Values, header http.Header, body []byte) (*http.Response, error) {
+	u := &url.URL{
+		Scheme:   c.scheme,
+		Host:     c.bucket + "." + c.host,
+		Path:     "/" + name,
+		RawQuery: alibabaQuery(query),
+	}
+	var bodyReader io.Reader
+	if body != nil {
+		bodyReader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequest(method, u.String(), bodyReader)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	if body != nil {
+		sum := md5.Sum(body)
+		req.Header.Set("Content-MD5", base64.StdEncoding.EncodeToString(sum[:]))
+	}
+	if c.token != "" {
+		req.Header.Set("X-Oss-Security-Token", c.token)
+	}
+	req.Header.Set("Date", time.Now().UTC().Format(http.TimeFormat))
+	req.Header.Set("Authorization", fmt.Sprintf("OSS %s:%s", c.id, c.sign(req, name, query)))
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		alibabaErr := &alibabaError{statusCode: resp.StatusCode}
+		data, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 64*1024))
+		xml.Unmarshal(data, alibabaErr)
+		return nil, alibabaErr
+	}
+	return resp, nil
+}
+
+// sign returns the signature of req, see
+// https://www.alibabacloud.com/help/doc-detail/31951.htm
+func (c *alibabaClient) sign(req *http.Request, name string, query url.Values) string {
+	var ossHeaders []string
+	for key, values := range req.Header {
+		key = strings.ToLower(key)
+		if strings.HasPrefix(key, "x-oss-") {
+			ossHeaders = append(ossHeaders, key+":"+strings.Join(values, ","))
+		}
+	}
+	sort.Strings(ossHeaders)
+	resource := "/" + c.bucket + "/" + name
+	var subresources []string
+	for key := range query {
+		if alibabaSubresources[key] {
+			subresources = append(subresources, key)
+		}
+	}
+	sort.Strings(subresources)
+	for i, key := range subresources {
+		if i == 0 {
+			resource += "?"
+		} else {
+			resource += "&"
+		}
+		resource += key
+		if value := query.Get(key); value != "" {
+			resource += "=" + value
+		}
+	}
+	var toSign bytes.Buffer
+	toSign.WriteString(req.Method + "\n")
+	toSign.WriteString(req.Header.Get("Content-MD5") + "\n")
+	toSign.WriteString(req.Header.Get("Content-Type") + "\n")
+	toSign.WriteString(req.Header.Get("Date") + "\n")
+	for _, header := range ossHeaders {
+		toSign.WriteString(header + "\n")
+	}
+	toSign.WriteString(resource)
+	mac := hmac.New(sha1.New, []byte(c.secret))
+	mac.Write(toSign.Bytes())
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// alibabaQuery encodes query, leaving out the "=" of parameters without a
+// value, which OSS expects for subresources like "uploads".
+func alibabaQuery(query url.Values) string {
+	var keys []string
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var parts []string
+	for _, key := range keys {
+		value := query.Get(key)
+		if value == "" && alibabaSubresources[key] {
+			parts = append(parts, url.QueryEscape(key))
+		} else {
+			parts = append(parts, url.QueryEscape(key)+"="+url.QueryEscape(value))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// retry calls f until it succeeds or fails with an error that isn't
+// retryable.
+func (c *alibabaClient) retry(description string, f func() error) error {
+	var err error
+	backoff.RetryNotify(func() error {
+		err = f()
+		if err != nil && IsRetryable(c, err) {
+			return err
+		}
+		return nil
+	}, NewExponentialBackOffConfig(), func(err error, d time.Duration) {
+		lion.Infof("Error %s; retrying in %s: %v", description, d, err)
+	})
+	return err
+}
+
+// putObject uploads an object in a single request.
+func (c *alibabaClient) putObject(ctx context.Context, name string, data []byte) error {
+	return c.retry("writing "+name, func() error {
+		header := make(http.Header)
+		header.Set("Content-Type", "application/octet-stream")
+		resp, err := c.do(ctx, "PUT", name, nil, header, data)
+		if err != nil {
+			return err
+		}
+		return resp.Body.Close()
+	})
+}
+
+// alibabaWriter uploads an object with a multipart upload, several parts
+// at once. Objects smaller than a part are uploaded directly.
+type alibabaWriter struct {
+	client   *alibabaClient
+	name     string
+	uploadID string
+	buf      []byte
+	parts    int
+	// etags are the ETags of the uploaded parts, by part number
+	etags   map[int]string
+	mu      sync.Mutex
+	ctx     context.Context
+	cancel  context.CancelFunc
+	eg      *errgroup.Group
+	limiter limit.ConcurrencyLimiter
+	err     error
+}
+
+func newAlibabaWriter(client *alibabaClient, name string) *alibabaWriter {
+	ctx, cancel := context.WithCancel(context.Background())
+	eg, ctx := errgroup.WithContext(ctx)
+	return &alibabaWriter{
+		client:  client,
+		name:    name,
+		etags:   make(map[int]string),
+		ctx:     ctx,
+		cancel:  cancel,
+		eg:      eg,
+		limiter: limit.New(client.concurrency),
+	}
+}
+
+func (w *alibabaWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		if w.err != nil {
+			return written, w.err
+		}
+		if w.ctx.Err() != nil {
+			// A part failed to upload, Wait returns its error.
+			return written, w.eg.Wait()
+		}
+		n := uploadPartSize - len(w.buf)
+		if n > len(p) {
+			n = len(p)
+		}
+		w.buf = append(w.buf, p[:n]...)
+		p = p[n:]
+		written += n
+		if len(w.buf) == uploadPartSize {
+			w.uploadPart()
+		}
+	}
+	return written, nil
+}
+
+// uploadPart starts uploading the buffer as the next part, starting the
+// multipart upload if it's the first part. It blocks while the maximum
+// number of parts are being uploaded.
+func (w *alibabaWriter) uploadPart() {
+	if w.uploadID == "" {
+		if w.err = w.initiate(); w.err != nil {
+			return
+		}
+	}
+	w.parts++
+	partNumber := w.parts
+	data := w.buf
+	w.buf = nil
+	w.limiter.Acquire()
+	w.eg.Go(func() error {
+		defer w.limiter.Release()
+		query := url.Values{}
+		query.Set("partNumber", strconv.Itoa(partNumber))
+		query.Set("uploadId", w.uploadID)
+		return w.client.retry(fmt.Sprintf("uploading part %d of %s", partNumber, w.name), func() error {
+			resp, err := w.client.do(w.ctx, "PUT", w.name, query, nil, data)
+			if err != nil {
+				return err
+			}
+			resp.Body.Close()
+			w.mu.Lock()
+			defer w.mu.Unlock()
+			w.etags[partNumber] = resp.Header.Get("ETag")
+			return nil
+		})
+	})
+}
+
+func (w *alibabaWriter) initiate() error {
+	query := url.Values{}
+	query.Set("uploads", "")
+	return w.client.retry("starting upload of "+w.name, func() error {
+		resp, err := w.client.do(w.ctx, "POST", w.name, query, nil, nil)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		var result struct {
+			UploadID string `xml:"UploadId"`
+		}
+		if err := xml.NewDecoder(resp.Body).Decode(&result); err != nil {
+			return err
+		}
+		w.uploadID = result.UploadID
+		return nil
+	})
+}
+
+func (w *alibabaWriter) Close() (retErr error) {
+	defer w.cancel()
+	if w.err != nil {
+		return w.err
+	}
+	if w.uploadID == "" {
+		// The object fits in a single part.
+		return w.client.putObject(w.ctx, w.name, w.buf)
+	}
+	defer func() {
+		if retErr != nil {
+			w.abort()
+		}
+	}()
+	if len(w.buf) > 0 {
+		w.uploadPart()
+		if w.err != nil {
+			return w.err
+		}
+	}
+	if err := w.eg.Wait(); err != nil {
+		return err
+	}
+	type part struct {
+		PartNumber int    `xml:"PartNumber"`
+		ETag       string `xml:"ETag"`
+	}
+	complete := struct {
+		XMLName xml.Name `xml:"CompleteMultipartUpload"`
+		Parts   []part   `xml:"Part"`
+	}{}
+	for partNumber := 1; partNumber <= w.parts; partNumber++ {
+		complete.Parts = append(complete.Parts, part{PartNumber: partNumber, ETag: w.etags[partNumber]})
+	}
+	data, err := xml.Marshal(complete)
+	if err != nil {
+		return err
+	}
+	query := url.Values{}
+	query.Set("uploadId", w.uploadID)
+	return w.client.retry("completing upload of "+w.name, func() error {
+		resp, err := w.client.do(context.Background(), "POST", w.name, query, nil, data)
+		if err != nil {
+			return err
+		}
+		return resp.Body.Close()
+	})
+}
+
+// abort aborts the multipart upload, so that OSS drops its parts.
+func (w *alibabaWriter) abort() {
+	query := url.Values{}
+	query.Set("uploadId", w.uploadID)
+	resp, err := w.client.do(context.Background(), "DELETE", w.name, query, nil, nil)
+	if err != nil {
+		lion.Errorf("error aborting upload of %s: %v", w.name, err)
+		return
+	}
+	resp.Body.Close()
+}
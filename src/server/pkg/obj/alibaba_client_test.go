@@ -0,0 +1,159 @@
+package obj
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"golang.org/x/net/context"
+)
+
+// TestAlibabaSignature checks signatures against the ones that the OSS Go
+// SDK computes for the same requests, the first of which is the example in
+// OSS's documentation.
+func TestAlibabaSignature(t *testing.T) {
+	c, err := newAlibabaClient("oss-example", "oss-cn-hangzhou.aliyuncs.com", "44CF9590006BF252F707", "OtxrzxIsfpFjA7SwPzILwy8Bw21TLhquhboDYROV", "")
+	require.NoError(t, err)
+	for _, v := range []struct {
+		method    string
+		name      string
+		query     url.Values
+		header    map[string]string
+		signature string
+	}{
+		{
+			method: "PUT",
+			name:   "nelson",
+			header: map[string]string{
+				"Content-MD5":       "eB5eJF1ptWaXm4bijSPyxw==",
+				"Content-Type":      "text/html",
+				"X-OSS-Meta-Author": "foo@bar.com",
+				"X-OSS-Magic":       "abracadabra",
+			},
+			signature: "hD208RWMpg77svXkQRwWXS+V5KQ=",
+		},
+		{
+			method:    "GET",
+			name:      "dir/an object.txt",
+			header:    map[string]string{"Range": "bytes=10-19"},
+			signature: "HiwZ6UHzRv3qNHAYVNx2kPvXRj8=",
+		},
+		{
+			method:    "PUT",
+			name:      "object",
+			query:     url.Values{"partNumber": {"2"}, "uploadId": {"0004B9895DBBB6EC98E36"}},
+			header:    map[string]string{"Content-MD5": "1B2M2Y8AsgTpgAmY7PhCfg=="},
+			signature: "I0LFYhgt1oO6QjiKhRw4lYEj/tQ=",
+		},
+		{
+			method:    "POST",
+			name:      "object",
+			query:     url.Values{"uploads": {""}},
+			signature: "I5Jag8DbUeYjT3yETVFCGcwHk4A=",
+		},
+		{
+			// Parameters that aren't subresources aren't signed.
+			method:    "GET",
+			query:     url.Values{"prefix": {"dir/"}, "max-keys": {"1000"}, "marker": {"dir/a"}},
+			signature: "1i+yu0gFakinOBU1ZoOH3eaXi5k=",
+		},
+		{
+			method:    "HEAD",
+			name:      "object",
+			header:    map[string]string{"X-Oss-Security-Token": "CAES+wMIARKAAZhjH0EUOIhJMQBMjRywXq7MQ/cjLYg80Aho1ek0Jm63XMhr9Oc5s="},
+			signature: "rVbns27u1vuJWKAn6PBVkJStFls=",
+		},
+	} {
+		req, err := http.NewRequest(v.method, "https://oss-example.oss-cn-hangzhou.aliyuncs.com/", nil)
+		require.NoError(t, err)
+		req.Header.Set("Date", "Thu, 17 Nov 2005 18:49:58 GMT")
+		for key, value := range v.header {
+			req.Header.Set(key, value)
+		}
+		require.Equal(t, v.signature, c.sign(req, v.name, v.query))
+	}
+}
+
+func TestAlibabaQuery(t *testing.T) {
+	require.Equal(t, "uploads", alibabaQuery(url.Values{"uploads": {""}}))
+	require.Equal(t, "partNumber=1&uploadId=a%2Bb", alibabaQuery(url.Values{"uploadId": {"a+b"}, "partNumber": {"1"}}))
+	require.Equal(t, "marker=&prefix=a+b%2F", alibabaQuery(url.Values{"prefix": {"a b/"}, "marker": {""}}))
+}
+
+// newTestAlibabaClient returns a client for "bucket" whose requests all go
+// to handler, and a function that stops handler's server.
+func newTestAlibabaClient(t *testing.T, handler http.HandlerFunc) (*alibabaClient, func()) {
+	server := httptest.NewServer(handler)
+	c, err := newAlibabaClient("bucket", "http://oss.example.com", "id", "secret", "token")
+	require.NoError(t, err)
+	c.httpClient = &http.Client{Transport: &http.Transport{
+		Dial: func(network string, addr string) (net.Conn, error) {
+			return net.Dial(network, server.Listener.Addr().String())
+		},
+	}}
+	return c, server.Close
+}
+
+func TestAlibabaRequests(t *testing.T) {
+	objects := map[string][]byte{}
+	var c *alibabaClient
+	c, done := newTestAlibabaClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "bucket.oss.example.com", r.Host)
+		require.Equal(t, "token", r.Header.Get("X-Oss-Security-Token"))
+		require.NotEqual(t, "", r.Header.Get("Date"))
+		require.Equal(t, fmt.Sprintf("OSS id:%s", c.sign(r, r.URL.Path[1:], r.URL.Query())), r.Header.Get("Authorization"))
+		switch r.Method {
+		case "PUT":
+			data, err := ioutil.ReadAll(r.Body)
+			require.NoError(t, err)
+			sum := md5.Sum(data)
+			require.Equal(t, base64.StdEncoding.EncodeToString(sum[:]), r.Header.Get("Content-MD5"))
+			objects[r.URL.Path[1:]] = data
+		case "GET":
+			data, ok := objects[r.URL.Path[1:]]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?>
+<Error>
+  <Code>NoSuchKey</Code>
+  <Message>The specified key does not exist.</Message>
+  <RequestId>5A2F8A2B3D2A2C3E</RequestId>
+</Error>`)
+				return
+			}
+			w.Write(data)
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	})
+	defer done()
+
+	require.NoError(t, c.putObject(context.Background(), "dir/object", []byte("data")))
+	r, err := c.Reader("dir/object", 0, 0)
+	require.NoError(t, err)
+	data, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, "data", string(data))
+
+	_, err = c.Reader("missing", 0, 0)
+	require.YesError(t, err)
+	require.Equal(t, "oss: NoSuchKey: The specified key does not exist. (request 5A2F8A2B3D2A2C3E)", err.Error())
+	require.True(t, c.IsNotExist(err))
+	require.False(t, IsRetryable(c, err))
+
+	err = c.Delete("dir/object")
+	require.YesError(t, err)
+	require.Equal(t, "oss: status 503", err.Error())
+	require.True(t, IsRetryable(c, err))
+	require.False(t, c.IsNotExist(err))
+
+	require.False(t, c.IsNotExist(fmt.Errorf("not found")))
+	require.False(t, IsRetryable(c, fmt.Errorf("internal error")))
+}
@@ -59,15 +59,47 @@ func newAmazonClient(bucket string, cloudfrontDistribution string, id string, se
 		signer = sign.NewURLSigner(string(cloudfrontKeyPairID), cloudfrontPrivateKey)
 		lion.Infof("Using cloudfront security credentials - keypair ID (%v) - to sign cloudfront URLs", string(cloudfrontKeyPairID))
 	}
+	// Large objects are uploaded with multipart uploads, with several parts
+	// in flight at once.
+	uploader := s3manager.NewUploader(session, func(u *s3manager.Uploader) {
+		u.Concurrency = uploadConcurrency
+		u.PartSize = uploadPartSize
+	})
 	return &amazonClient{
 		bucket:                 bucket,
 		cloudfrontDistribution: cloudfrontDistribution,
 		cloudfrontURLSigner:    signer,
 		s3:                     s3.New(session),
-		uploader:               s3manager.NewUploader(session),
+		uploader:               uploader,
 	}, nil
 }
 
+// AmazonBucketRegion returns the region that bucket is in, using the given
+// credentials. region is the region that the request is sent to, S3 answers
+// it for buckets in any region.
+func AmazonBucketRegion(bucket string, id string, secret string, token string, region string) (string, error) {
+	session := session.New(&aws.Config{
+		Credentials: credentials.NewStaticCredentials(id, secret, token),
+		Region:      aws.String(region),
+	})
+	location, err := s3.New(session).GetBucketLocation(&s3.GetBucketLocationInput{
+		Bucket: aws.String(bucket),
+	})
+	if err != nil {
+		return "", err
+	}
+	// Buckets in us-east-1 have no location constraint, and some old
+	// buckets in eu-west-1 have the constraint "EU".
+	switch constraint := aws.StringValue(location.LocationConstraint); constraint {
+	case "":
+		return "us-east-1", nil
+	case "EU":
+		return "eu-west-1", nil
+	default:
+		return constraint, nil
+	}
+}
+
 func (c *amazonClient) Writer(name string) (io.WriteCloser, error) {
 	return newBackoffWriteCloser(c, newWriter(c, name)), nil
 }
@@ -165,6 +197,17 @@ func (c *amazonClient) Exists(name string) bool {
 	return err == nil
 }
 
+func (c *amazonClient) ModTime(name string) (time.Time, error) {
+	output, err := c.s3.HeadObject(&s3.HeadObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(name),
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+	return aws.TimeValue(output.LastModified), nil
+}
+
 func (c *amazonClient) isRetryable(err error) (retVal bool) {
 	if strings.Contains(err.Error(), "unexpected EOF") {
 		return true
@@ -1,19 +1,33 @@
 package obj
 
 import (
+	"fmt"
 	"io"
 	"strings"
+	"time"
 
+	"github.com/cenkalti/backoff"
+	"github.com/pachyderm/pachyderm/src/client/limit"
+	"github.com/pachyderm/pachyderm/src/client/pkg/uuid"
+	"go.pedge.io/lion"
 	"golang.org/x/net/context"
+	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/google"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/api/googleapi"
+	raw "google.golang.org/api/storage/v1"
 	"google.golang.org/cloud"
 	"google.golang.org/cloud/storage"
 )
 
 type googleClient struct {
-	ctx    context.Context
-	bucket *storage.BucketHandle
+	ctx        context.Context
+	bucket     *storage.BucketHandle
+	bucketName string
+	// raw is used to compose objects, which the storage package doesn't
+	// support.
+	raw         *raw.Service
+	concurrency int
 }
 
 func newGoogleClient(ctx context.Context, bucket string) (*googleClient, error) {
@@ -25,7 +39,17 @@ func newGoogleClient(ctx context.Context, bucket string) (*googleClient, error)
 	if err != nil {
 		return nil, err
 	}
-	return &googleClient{ctx, client.Bucket(bucket)}, nil
+	rawService, err := raw.New(oauth2.NewClient(ctx, google.ComputeTokenSource("")))
+	if err != nil {
+		return nil, err
+	}
+	return &googleClient{
+		ctx:         ctx,
+		bucket:      client.Bucket(bucket),
+		bucketName:  bucket,
+		raw:         rawService,
+		concurrency: uploadConcurrency,
+	}, nil
 }
 
 func (c *googleClient) Exists(name string) bool {
@@ -33,8 +57,16 @@ func (c *googleClient) Exists(name string) bool {
 	return err == nil
 }
 
+func (c *googleClient) ModTime(name string) (time.Time, error) {
+	attrs, err := c.bucket.Object(name).Attrs(c.ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return attrs.Updated, nil
+}
+
 func (c *googleClient) Writer(name string) (io.WriteCloser, error) {
-	return newBackoffWriteCloser(c, c.bucket.Object(name).NewWriter(c.ctx)), nil
+	return newBackoffWriteCloser(c, newGoogleWriter(c, name)), nil
 }
 
 func (c *googleClient) Walk(name string, fn func(name string) error) error {
@@ -93,3 +125,175 @@ func (c *googleClient) IsIgnorable(err error) bool {
 	}
 	return googleErr.Code == 429
 }
+
+// maxComposeSources is the most objects that GCS can compose at once.
+const maxComposeSources = 32
+
+// googleWriter uploads an object in parts, several at once, and then
+// composes them into the object. Objects smaller than a part are uploaded
+// directly.
+type googleWriter struct {
+	client *googleClient
+	name   string
+	// prefix is the prefix of the temporary objects that parts are uploaded
+	// to.
+	prefix  string
+	buf     []byte
+	parts   []string
+	ctx     context.Context
+	eg      *errgroup.Group
+	limiter limit.ConcurrencyLimiter
+}
+
+func newGoogleWriter(client *googleClient, name string) *googleWriter {
+	eg, ctx := errgroup.WithContext(client.ctx)
+	return &googleWriter{
+		client:  client,
+		name:    name,
+		prefix:  fmt.Sprintf("%s.upload-%s", name, uuid.NewWithoutDashes()),
+		ctx:     ctx,
+		eg:      eg,
+		limiter: limit.New(client.concurrency),
+	}
+}
+
+func (w *googleWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		if err := w.ctx.Err(); err != nil {
+			// A part failed to upload, or the client's context is done.
+			if err := w.eg.Wait(); err != nil {
+				return written, err
+			}
+			return written, err
+		}
+		n := uploadPartSize - len(w.buf)
+		if n > len(p) {
+			n = len(p)
+		}
+		w.buf = append(w.buf, p[:n]...)
+		p = p[n:]
+		written += n
+		if len(w.buf) == uploadPartSize {
+			w.uploadPart()
+		}
+	}
+	return written, nil
+}
+
+// uploadPart starts uploading the buffer as the next part, it blocks while
+// the maximum number of parts are being uploaded.
+func (w *googleWriter) uploadPart() {
+	part := fmt.Sprintf("%s-%d", w.prefix, len(w.parts))
+	w.parts = append(w.parts, part)
+	data := w.buf
+	w.buf = nil
+	w.limiter.Acquire()
+	w.eg.Go(func() error {
+		defer w.limiter.Release()
+		return w.client.writeObject(w.ctx, part, data)
+	})
+}
+
+func (w *googleWriter) Close() (retErr error) {
+	if len(w.parts) == 0 {
+		// The object fits in a single part.
+		return w.client.writeObject(w.client.ctx, w.name, w.buf)
+	}
+	defer func() {
+		if err := w.deleteParts(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	if len(w.buf) > 0 {
+		w.uploadPart()
+	}
+	if err := w.eg.Wait(); err != nil {
+		return err
+	}
+	// Compose the parts in groups until there are few enough to compose
+	// into the object.
+	sources := w.parts
+	for level := 0; len(sources) > maxComposeSources; level++ {
+		var composed []string
+		for i := 0; i < len(sources); i += maxComposeSources {
+			end := i + maxComposeSources
+			if end > len(sources) {
+				end = len(sources)
+			}
+			dst := fmt.Sprintf("%s-compose-%d-%d", w.prefix, level, i/maxComposeSources)
+			w.parts = append(w.parts, dst)
+			if err := w.client.compose(dst, sources[i:end]); err != nil {
+				return err
+			}
+			composed = append(composed, dst)
+		}
+		sources = composed
+	}
+	return w.client.compose(w.name, sources)
+}
+
+// deleteParts deletes the temporary objects that parts were uploaded to.
+func (w *googleWriter) deleteParts() error {
+	var eg errgroup.Group
+	limiter := limit.New(w.client.concurrency)
+	for _, part := range w.parts {
+		part := part
+		limiter.Acquire()
+		eg.Go(func() error {
+			defer limiter.Release()
+			if err := w.client.Delete(part); err != nil && !w.client.IsNotExist(err) {
+				return err
+			}
+			return nil
+		})
+	}
+	return eg.Wait()
+}
+
+// writeObject writes data to an object, retrying failures.
+func (c *googleClient) writeObject(ctx context.Context, name string, data []byte) error {
+	var writeErr error
+	backoff.RetryNotify(func() error {
+		w := c.bucket.Object(name).NewWriter(ctx)
+		if _, writeErr = w.Write(data); writeErr != nil {
+			w.CloseWithError(writeErr)
+		} else {
+			writeErr = w.Close()
+		}
+		if writeErr != nil && IsRetryable(c, writeErr) {
+			return writeErr
+		}
+		return nil
+	}, NewExponentialBackOffConfig(), func(err error, d time.Duration) {
+		lion.Infof("Error writing %s; retrying in %s: %v", name, d, err)
+	})
+	if writeErr != nil && !c.IsIgnorable(writeErr) {
+		return fmt.Errorf("error writing %s: %v", name, writeErr)
+	}
+	return nil
+}
+
+// compose concatenates sources into dst.
+func (c *googleClient) compose(dst string, sources []string) error {
+	request := &raw.ComposeRequest{
+		Destination: &raw.Object{Bucket: c.bucketName, Name: dst},
+	}
+	for _, source := range sources {
+		request.SourceObjects = append(request.SourceObjects, &raw.ComposeRequestSourceObjects{Name: source})
+	}
+	var composeErr error
+	backoff.RetryNotify(func() error {
+		_, composeErr = c.raw.Objects.Compose(c.bucketName, dst, request).Context(c.ctx).Do()
+		if composeErr != nil && IsRetryable(c, composeErr) {
+			return composeErr
+		}
+		return nil
+	}, NewExponentialBackOffConfig(), func(err error, d time.Duration) {
+		lion.Infof("Error composing %s; retrying in %s: %v", dst, d, err)
+	})
+	if composeErr != nil {
+		return fmt.Errorf("error composing %s: %v", dst, composeErr)
+	}
+	return nil
+}
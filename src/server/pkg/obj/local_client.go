@@ -0,0 +1,161 @@
+package obj
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// localTmpDir is the directory, under the client's root, that objects are
+// written to before they're moved into place.
+const localTmpDir = ".tmp"
+
+// localClient stores objects as files under a directory, which is usually a
+// shared filesystem such as NFS or GlusterFS mounted on every pachd.
+type localClient struct {
+	rootDir string
+}
+
+func newLocalClient(rootDir string) (*localClient, error) {
+	if err := os.MkdirAll(filepath.Join(rootDir, localTmpDir), 0777); err != nil {
+		return nil, err
+	}
+	return &localClient{rootDir: rootDir}, nil
+}
+
+// Writer writes the object to a temporary file and renames it into place
+// when it's closed, so that readers, possibly on other hosts, never see a
+// partially written object.
+func (c *localClient) Writer(name string) (io.WriteCloser, error) {
+	f, err := ioutil.TempFile(filepath.Join(c.rootDir, localTmpDir), "")
+	if err != nil {
+		return nil, err
+	}
+	return &localWriter{f: f, path: c.path(name)}, nil
+}
+
+func (c *localClient) Reader(name string, offset uint64, size uint64) (io.ReadCloser, error) {
+	f, err := os.Open(c.path(name))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := f.Seek(int64(offset), io.SeekStart); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if size == 0 {
+		return f, nil
+	}
+	return &localReader{Reader: io.LimitReader(f, int64(size)), f: f}, nil
+}
+
+func (c *localClient) Delete(name string) error {
+	return os.Remove(c.path(name))
+}
+
+func (c *localClient) Walk(prefix string, fn func(name string) error) error {
+	// prefix needn't end at a directory, so walk the directory that contains
+	// it and filter by name.
+	dir := filepath.Join(c.rootDir, prefix)
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		dir = filepath.Dir(dir)
+	}
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		name, err := filepath.Rel(c.rootDir, path)
+		if err != nil {
+			return err
+		}
+		name = filepath.ToSlash(name)
+		if info.IsDir() {
+			if name == localTmpDir {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasPrefix(name, prefix) {
+			return nil
+		}
+		return fn(name)
+	})
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+func (c *localClient) Exists(name string) bool {
+	_, err := os.Stat(c.path(name))
+	return err == nil
+}
+
+func (c *localClient) ModTime(name string) (time.Time, error) {
+	info, err := os.Stat(c.path(name))
+	if err != nil {
+		return time.Time{}, err
+	}
+	return info.ModTime(), nil
+}
+
+func (c *localClient) isRetryable(err error) bool {
+	return false
+}
+
+func (c *localClient) IsNotExist(err error) bool {
+	return os.IsNotExist(err)
+}
+
+func (c *localClient) IsIgnorable(err error) bool {
+	return false
+}
+
+func (c *localClient) path(name string) string {
+	return filepath.Join(c.rootDir, filepath.FromSlash(name))
+}
+
+type localWriter struct {
+	f    *os.File
+	path string
+}
+
+func (w *localWriter) Write(p []byte) (int, error) {
+	return w.f.Write(p)
+}
+
+func (w *localWriter) Close() (retErr error) {
+	defer func() {
+		if retErr != nil {
+			os.Remove(w.f.Name())
+		}
+	}()
+	// Sync before renaming so that a crash can't leave an object in place
+	// without its contents.
+	if err := w.f.Sync(); err != nil {
+		w.f.Close()
+		return err
+	}
+	if err := w.f.Close(); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(w.path), 0777); err != nil {
+		return err
+	}
+	return os.Rename(w.f.Name(), w.path)
+}
+
+type localReader struct {
+	io.Reader
+	f *os.File
+}
+
+func (r *localReader) Close() error {
+	return r.f.Close()
+}
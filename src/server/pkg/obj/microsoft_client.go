@@ -5,6 +5,8 @@ import (
 	"encoding/base64"
 	"fmt"
 	"io"
+	"net/http"
+	"time"
 
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
 
@@ -92,6 +94,14 @@ func (c *microsoftClient) Exists(name string) bool {
 	return exists
 }
 
+func (c *microsoftClient) ModTime(name string) (time.Time, error) {
+	properties, err := c.blobClient.GetBlobProperties(c.container, name)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Parse(http.TimeFormat, properties.LastModified)
+}
+
 func (c *microsoftClient) isRetryable(err error) (ret bool) {
 	microsoftErr, ok := err.(storage.AzureStorageServiceError)
 	if !ok {
@@ -2,6 +2,7 @@ package obj
 
 import (
 	"io"
+	"time"
 
 	minio "github.com/minio/minio-go"
 )
@@ -116,6 +117,14 @@ func (c *minioClient) Exists(name string) bool {
 	return err == nil
 }
 
+func (c *minioClient) ModTime(name string) (time.Time, error) {
+	objectInfo, err := c.StatObject(c.bucket, name)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return objectInfo.LastModified, nil
+}
+
 func (c *minioClient) isRetryable(err error) bool {
 	// Minio client already implements retrying, no
 	// need for a caller retry.
@@ -14,6 +14,27 @@ import (
 	"golang.org/x/net/context"
 )
 
+const (
+	// DefaultUploadConcurrency is the default number of parts of an object
+	// that are uploaded at once.
+	DefaultUploadConcurrency = 4
+	// uploadPartSize is the size of the parts that large objects are split
+	// into for uploading.
+	uploadPartSize = 8 * 1024 * 1024
+)
+
+var uploadConcurrency = DefaultUploadConcurrency
+
+// SetUploadConcurrency sets the number of parts of an object that clients
+// upload at once, for backends that support uploading objects in parts (S3,
+// GCS and OSS). It only affects clients created after it's called.
+func SetUploadConcurrency(concurrency int) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	uploadConcurrency = concurrency
+}
+
 // Client is an interface to object storage.
 type Client interface {
 	// Writer returns a writer which writes to an object.
@@ -33,6 +54,9 @@ type Client interface {
 	Walk(prefix string, fn func(name string) error) error
 	// Exsits checks if a given object already exists
 	Exists(name string) bool
+	// ModTime returns the time at which an object was last written.
+	// It should error if the object doesn't exist.
+	ModTime(name string) (time.Time, error)
 	// isRetryable determines if an operation should be retried given an error
 	isRetryable(err error) bool
 	// IsNotExist returns true if err is a non existence error
@@ -90,6 +114,90 @@ func NewMicrosoftClientFromSecret(container string) (Client, error) {
 	return NewMicrosoftClient(container, string(id), string(secret))
 }
 
+// NewSwiftClient creates an OpenStack Swift client:
+//   container - Swift container name
+//   authURL   - Keystone auth URL, e.g. https://keystone:5000/v3, or a
+//               Swift v1 auth URL
+//   user      - OpenStack user name
+//   password  - OpenStack password
+//   tenant    - OpenStack project (tenant) name, optional for v1 auth
+//   domain    - Keystone v3 domain name, "Default" if it's empty
+//   region    - OpenStack region, the first object-store endpoint is used if
+//               it's empty
+func NewSwiftClient(container string, authURL string, user string, password string, tenant string, domain string, region string) (Client, error) {
+	return newSwiftClient(container, authURL, user, password, tenant, domain, region)
+}
+
+// NewSwiftClientFromSecret creates a swift client by reading credentials
+// from a mounted SwiftSecret. You may pass "" for container in which case it
+// will read the container from the secret.
+func NewSwiftClientFromSecret(container string) (Client, error) {
+	if container == "" {
+		_container, err := ioutil.ReadFile("/swift-secret/container")
+		if err != nil {
+			return nil, err
+		}
+		container = string(_container)
+	}
+	var values []string
+	for _, key := range []string{"auth-url", "user", "password", "tenant", "domain", "region"} {
+		value, err := ioutil.ReadFile("/swift-secret/" + key)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, string(value))
+	}
+	return NewSwiftClient(container, values[0], values[1], values[2], values[3], values[4], values[5])
+}
+
+// NewAlibabaClient creates an Alibaba Cloud OSS client:
+//   bucket   - OSS bucket name
+//   endpoint - OSS endpoint of the bucket's region, e.g.
+//              oss-cn-hangzhou.aliyuncs.com
+//   id       - AccessKey ID
+//   secret   - AccessKey secret
+//   token    - STS security token, if the AccessKey is temporary
+func NewAlibabaClient(bucket string, endpoint string, id string, secret string, token string) (Client, error) {
+	return newAlibabaClient(bucket, endpoint, id, secret, token)
+}
+
+// NewAlibabaClientFromSecret creates an alibaba client by reading
+// credentials from a mounted AlibabaSecret. You may pass "" for bucket in
+// which case it will read the bucket from the secret.
+func NewAlibabaClientFromSecret(bucket string) (Client, error) {
+	if bucket == "" {
+		_bucket, err := ioutil.ReadFile("/alibaba-secret/bucket")
+		if err != nil {
+			return nil, err
+		}
+		bucket = string(_bucket)
+	}
+	endpoint, err := ioutil.ReadFile("/alibaba-secret/endpoint")
+	if err != nil {
+		return nil, err
+	}
+	id, err := ioutil.ReadFile("/alibaba-secret/id")
+	if err != nil {
+		return nil, err
+	}
+	secret, err := ioutil.ReadFile("/alibaba-secret/secret")
+	if err != nil {
+		return nil, err
+	}
+	token, err := ioutil.ReadFile("/alibaba-secret/token")
+	if err != nil {
+		return nil, err
+	}
+	return NewAlibabaClient(bucket, string(endpoint), string(id), string(secret), string(token))
+}
+
+// NewLocalClient creates a client that stores objects as files under
+// rootDir, which should be on a filesystem shared by every pachd, such as
+// NFS or GlusterFS.
+func NewLocalClient(rootDir string) (Client, error) {
+	return newLocalClient(rootDir)
+}
+
 // NewMinioClient creates an s3 compatible client with the following credentials:
 //   endpoint - S3 compatible endpoint
 //   bucket - S3 bucket name
@@ -198,6 +306,10 @@ func NewClientFromURLAndSecret(ctx context.Context, URL string) (Client, error)
 		fallthrough
 	case "wasb":
 		return NewMicrosoftClientFromSecret(_URL.Host)
+	case "swift":
+		return NewSwiftClientFromSecret(_URL.Host)
+	case "oss":
+		return NewAlibabaClientFromSecret(_URL.Host)
 	}
 	return nil, fmt.Errorf("unrecognized object store: %s", _URL.Scheme)
 }
@@ -0,0 +1,162 @@
+package obj
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"go.pedge.io/lion"
+)
+
+// secretPollInterval is how often a reloadingClient checks its secret for
+// new credentials. Kubernetes only updates mounted secrets every minute or
+// so, so polling more often wouldn't pick them up much sooner.
+const secretPollInterval = 10 * time.Second
+
+// reloadingClient is a Client that's recreated whenever the credentials in
+// the secret it was created from change, so that the credentials can be
+// rotated without restarting pachd or its workers.
+type reloadingClient struct {
+	secretDir string
+	newClient func() (Client, error)
+
+	mu       sync.RWMutex
+	client   Client
+	checksum []byte
+}
+
+// NewReloadingClient creates a client with newClient, which should read its
+// credentials from the secret mounted at secretDir, and creates it again
+// whenever the secret is updated. Requests that are in progress when the
+// client is recreated finish with the old credentials. If the client can't
+// be created from the updated secret the old one is kept, and creating it is
+// retried the next time the secret changes.
+func NewReloadingClient(secretDir string, newClient func() (Client, error)) (Client, error) {
+	checksum, err := secretChecksum(secretDir)
+	if err != nil {
+		return nil, err
+	}
+	client, err := newClient()
+	if err != nil {
+		return nil, err
+	}
+	c := &reloadingClient{
+		secretDir: secretDir,
+		newClient: newClient,
+		client:    client,
+		checksum:  checksum,
+	}
+	go c.watch()
+	return c, nil
+}
+
+func (c *reloadingClient) watch() {
+	ticker := time.NewTicker(secretPollInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		if err := c.reload(); err != nil {
+			lion.Errorf("error reloading object storage credentials from %s: %v", c.secretDir, err)
+		}
+	}
+}
+
+// reload recreates the client if the secret has changed since it was last
+// created.
+func (c *reloadingClient) reload() error {
+	checksum, err := secretChecksum(c.secretDir)
+	if err != nil {
+		return err
+	}
+	c.mu.RLock()
+	changed := !bytes.Equal(checksum, c.checksum)
+	c.mu.RUnlock()
+	if !changed {
+		return nil
+	}
+	client, err := c.newClient()
+	if err != nil {
+		return err
+	}
+	if err := TestIsNotExist(client); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.client = client
+	c.checksum = checksum
+	lion.Infof("reloaded object storage credentials from %s", c.secretDir)
+	return nil
+}
+
+func (c *reloadingClient) current() Client {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.client
+}
+
+// secretChecksum returns a checksum of the keys and values in the secret
+// mounted at dir. Kubernetes mounts each key as a symlink into a hidden
+// directory, whose name starts with "..", that it swaps out when the secret
+// is updated.
+func secretChecksum(dir string) ([]byte, error) {
+	fileInfos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.New()
+	for _, fileInfo := range fileInfos {
+		if strings.HasPrefix(fileInfo.Name(), "..") || fileInfo.IsDir() {
+			continue
+		}
+		value, err := ioutil.ReadFile(filepath.Join(dir, fileInfo.Name()))
+		if err != nil {
+			return nil, err
+		}
+		hash.Write([]byte(fileInfo.Name()))
+		hash.Write([]byte{0})
+		hash.Write(value)
+		hash.Write([]byte{0})
+	}
+	return hash.Sum(nil), nil
+}
+
+func (c *reloadingClient) Writer(name string) (io.WriteCloser, error) {
+	return c.current().Writer(name)
+}
+
+func (c *reloadingClient) Reader(name string, offset uint64, size uint64) (io.ReadCloser, error) {
+	return c.current().Reader(name, offset, size)
+}
+
+func (c *reloadingClient) Delete(name string) error {
+	return c.current().Delete(name)
+}
+
+func (c *reloadingClient) Walk(prefix string, fn func(name string) error) error {
+	return c.current().Walk(prefix, fn)
+}
+
+func (c *reloadingClient) Exists(name string) bool {
+	return c.current().Exists(name)
+}
+
+func (c *reloadingClient) ModTime(name string) (time.Time, error) {
+	return c.current().ModTime(name)
+}
+
+func (c *reloadingClient) isRetryable(err error) bool {
+	return c.current().isRetryable(err)
+}
+
+func (c *reloadingClient) IsNotExist(err error) bool {
+	return c.current().IsNotExist(err)
+}
+
+func (c *reloadingClient) IsIgnorable(err error) bool {
+	return c.current().IsIgnorable(err)
+}
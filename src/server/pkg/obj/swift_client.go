@@ -0,0 +1,479 @@
+package obj
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// swiftTokenRefresh is how long before a token expires that it's
+	// replaced, so that requests aren't made with a token that expires
+	// while they're in flight.
+	swiftTokenRefresh = 5 * time.Minute
+	// swiftListLimit is the most object names that are listed per request.
+	swiftListLimit = 10000
+)
+
+// swiftClient is a client for OpenStack Swift. It authenticates with the
+// Keystone v3 or v2 API, or Swift's own v1 auth, depending on which version
+// the auth URL ends in. Objects are uploaded in a single request, so they're
+// limited to Swift's maximum object size (5GB by default), which is much
+// larger than the blocks that pachd splits files into.
+type swiftClient struct {
+	httpClient *http.Client
+	container  string
+	authURL    string
+	user       string
+	password   string
+	tenant     string
+	domain     string
+	region     string
+
+	mu         sync.Mutex
+	token      string
+	expires    time.Time
+	storageURL string
+}
+
+// swiftError is returned when Swift responds with an unexpected status.
+type swiftError struct {
+	method     string
+	name       string
+	statusCode int
+	status     string
+}
+
+func (e *swiftError) Error() string {
+	return fmt.Sprintf("swift: %s %s: %s", e.method, e.name, e.status)
+}
+
+func newSwiftClient(container string, authURL string, user string, password string, tenant string, domain string, region string) (*swiftClient, error) {
+	c := &swiftClient{
+		httpClient: &http.Client{},
+		container:  container,
+		authURL:    strings.TrimSuffix(authURL, "/"),
+		user:       user,
+		password:   password,
+		tenant:     tenant,
+		domain:     domain,
+		region:     region,
+	}
+	// Create the container if it doesn't exist, this is a no-op if it does.
+	resp, err := c.do("PUT", "", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusAccepted {
+		return nil, c.error(resp, "PUT", container)
+	}
+	return c, nil
+}
+
+func (c *swiftClient) Writer(name string) (io.WriteCloser, error) {
+	return newSwiftWriter(c, name), nil
+}
+
+func (c *swiftClient) Reader(name string, offset uint64, size uint64) (io.ReadCloser, error) {
+	header := make(http.Header)
+	if byteRange := byteRange(offset, size); byteRange != "" {
+		header.Set("Range", "bytes="+byteRange)
+	}
+	resp, err := c.do("GET", name, header, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusPartialContent {
+		resp.Body.Close()
+		return nil, c.error(resp, "GET", name)
+	}
+	return newBackoffReadCloser(c, resp.Body), nil
+}
+
+func (c *swiftClient) Delete(name string) error {
+	resp, err := c.do("DELETE", name, nil, nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return c.error(resp, "DELETE", name)
+	}
+	return nil
+}
+
+func (c *swiftClient) Walk(prefix string, fn func(name string) error) error {
+	var marker string
+	for {
+		query := url.Values{}
+		query.Set("format", "json")
+		query.Set("prefix", prefix)
+		query.Set("limit", fmt.Sprint(swiftListLimit))
+		if marker != "" {
+			query.Set("marker", marker)
+		}
+		resp, err := c.do("GET", "?"+query.Encode(), nil, nil)
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode == http.StatusNoContent {
+			resp.Body.Close()
+			return nil
+		}
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return c.error(resp, "GET", c.container)
+		}
+		var objects []struct {
+			Name string `json:"name"`
+		}
+		err = json.NewDecoder(resp.Body).Decode(&objects)
+		resp.Body.Close()
+		if err != nil {
+			return err
+		}
+		for _, object := range objects {
+			if err := fn(object.Name); err != nil {
+				return err
+			}
+		}
+		if len(objects) < swiftListLimit {
+			return nil
+		}
+		marker = objects[len(objects)-1].Name
+	}
+}
+
+func (c *swiftClient) Exists(name string) bool {
+	resp, err := c.do("HEAD", name, nil, nil)
+	if err != nil {
+		return false
+	}
+	resp.Body.Close()
+	return resp.StatusCode == http.StatusOK
+}
+
+func (c *swiftClient) ModTime(name string) (time.Time, error) {
+	resp, err := c.do("HEAD", name, nil, nil)
+	if err != nil {
+		return time.Time{}, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return time.Time{}, c.error(resp, "HEAD", name)
+	}
+	return time.Parse(http.TimeFormat, resp.Header.Get("Last-Modified"))
+}
+
+func (c *swiftClient) isRetryable(err error) bool {
+	swiftErr, ok := err.(*swiftError)
+	if !ok {
+		return false
+	}
+	return swiftErr.statusCode >= 500
+}
+
+func (c *swiftClient) IsNotExist(err error) bool {
+	swiftErr, ok := err.(*swiftError)
+	if !ok {
+		return false
+	}
+	return swiftErr.statusCode == http.StatusNotFound
+}
+
+func (c *swiftClient) IsIgnorable(err error) bool {
+	return false
+}
+
+func (c *swiftClient) error(resp *http.Response, method string, name string) error {
+	return &swiftError{
+		method:     method,
+		name:       name,
+		statusCode: resp.StatusCode,
+		status:     resp.Status,
+	}
+}
+
+// do makes a request for name, an object in the container or a query on
+// the container if it's empty or starts with "?". Requests without a body
+// are retried once with a new token if the token has been revoked.
+func (c *swiftClient) do(method string, name string, header http.Header, body io.Reader) (*http.Response, error) {
+	token, storageURL, err := c.getToken(false)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.request(method, storageURL, token, name, header, body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized && body == nil {
+		resp.Body.Close()
+		token, storageURL, err = c.getToken(true)
+		if err != nil {
+			return nil, err
+		}
+		return c.request(method, storageURL, token, name, header, nil)
+	}
+	return resp, nil
+}
+
+func (c *swiftClient) request(method string, storageURL string, token string, name string, header http.Header, body io.Reader) (*http.Response, error) {
+	u := storageURL + "/" + (&url.URL{Path: c.container}).String()
+	if strings.HasPrefix(name, "?") {
+		u += name
+	} else if name != "" {
+		u += "/" + (&url.URL{Path: name}).String()
+	}
+	req, err := http.NewRequest(method, u, body)
+	if err != nil {
+		return nil, err
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	req.Header.Set("X-Auth-Token", token)
+	return c.httpClient.Do(req)
+}
+
+// getToken returns a token and the storage URL that it's for, getting a new
+// token if there isn't one, it's about to expire or force is set.
+func (c *swiftClient) getToken(force bool) (string, string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !force && c.token != "" && (c.expires.IsZero() || time.Now().Add(swiftTokenRefresh).Before(c.expires)) {
+		return c.token, c.storageURL, nil
+	}
+	var err error
+	switch {
+	case strings.HasSuffix(c.authURL, "/v3"):
+		err = c.authenticateV3()
+	case strings.HasSuffix(c.authURL, "/v2.0") || strings.HasSuffix(c.authURL, "/v2"):
+		err = c.authenticateV2()
+	default:
+		err = c.authenticateV1()
+	}
+	if err != nil {
+		return "", "", fmt.Errorf("could not authenticate with %s: %v", c.authURL, err)
+	}
+	return c.token, c.storageURL, nil
+}
+
+// authenticateV1 authenticates with Swift's own auth, whose tokens don't
+// say when they expire.
+func (c *swiftClient) authenticateV1() error {
+	req, err := http.NewRequest("GET", c.authURL, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Auth-User", c.user)
+	req.Header.Set("X-Auth-Key", c.password)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("%s", resp.Status)
+	}
+	c.token = resp.Header.Get("X-Auth-Token")
+	c.storageURL = strings.TrimSuffix(resp.Header.Get("X-Storage-Url"), "/")
+	c.expires = time.Time{}
+	return nil
+}
+
+func (c *swiftClient) authenticateV2() error {
+	var request struct {
+		Auth struct {
+			PasswordCredentials struct {
+				Username string `json:"username"`
+				Password string `json:"password"`
+			} `json:"passwordCredentials"`
+			TenantName string `json:"tenantName,omitempty"`
+		} `json:"auth"`
+	}
+	request.Auth.PasswordCredentials.Username = c.user
+	request.Auth.PasswordCredentials.Password = c.password
+	request.Auth.TenantName = c.tenant
+	var response struct {
+		Access struct {
+			Token struct {
+				ID      string    `json:"id"`
+				Expires time.Time `json:"expires"`
+			} `json:"token"`
+			ServiceCatalog []struct {
+				Type      string `json:"type"`
+				Endpoints []struct {
+					Region    string `json:"region"`
+					PublicURL string `json:"publicURL"`
+				} `json:"endpoints"`
+			} `json:"serviceCatalog"`
+		} `json:"access"`
+	}
+	if _, err := c.postJSON(c.authURL+"/tokens", &request, &response); err != nil {
+		return err
+	}
+	for _, service := range response.Access.ServiceCatalog {
+		if service.Type != "object-store" {
+			continue
+		}
+		for _, endpoint := range service.Endpoints {
+			if c.region == "" || endpoint.Region == c.region {
+				c.token = response.Access.Token.ID
+				c.expires = response.Access.Token.Expires
+				c.storageURL = strings.TrimSuffix(endpoint.PublicURL, "/")
+				return nil
+			}
+		}
+	}
+	return c.noEndpointError()
+}
+
+func (c *swiftClient) authenticateV3() error {
+	type domain struct {
+		Name string `json:"name"`
+	}
+	type scope struct {
+		Project struct {
+			Name   string `json:"name"`
+			Domain domain `json:"domain"`
+		} `json:"project"`
+	}
+	var request struct {
+		Auth struct {
+			Identity struct {
+				Methods  []string `json:"methods"`
+				Password struct {
+					User struct {
+						Name     string `json:"name"`
+						Password string `json:"password"`
+						Domain   domain `json:"domain"`
+					} `json:"user"`
+				} `json:"password"`
+			} `json:"identity"`
+			Scope *scope `json:"scope,omitempty"`
+		} `json:"auth"`
+	}
+	domainName := c.domain
+	if domainName == "" {
+		domainName = "Default"
+	}
+	request.Auth.Identity.Methods = []string{"password"}
+	request.Auth.Identity.Password.User.Name = c.user
+	request.Auth.Identity.Password.User.Password = c.password
+	request.Auth.Identity.Password.User.Domain.Name = domainName
+	if c.tenant != "" {
+		request.Auth.Scope = &scope{}
+		request.Auth.Scope.Project.Name = c.tenant
+		request.Auth.Scope.Project.Domain.Name = domainName
+	}
+	var response struct {
+		Token struct {
+			ExpiresAt time.Time `json:"expires_at"`
+			Catalog   []struct {
+				Type      string `json:"type"`
+				Endpoints []struct {
+					Interface string `json:"interface"`
+					Region    string `json:"region"`
+					URL       string `json:"url"`
+				} `json:"endpoints"`
+			} `json:"catalog"`
+		} `json:"token"`
+	}
+	header, err := c.postJSON(c.authURL+"/auth/tokens", &request, &response)
+	if err != nil {
+		return err
+	}
+	for _, service := range response.Token.Catalog {
+		if service.Type != "object-store" {
+			continue
+		}
+		for _, endpoint := range service.Endpoints {
+			if endpoint.Interface == "public" && (c.region == "" || endpoint.Region == c.region) {
+				c.token = header.Get("X-Subject-Token")
+				c.expires = response.Token.ExpiresAt
+				c.storageURL = strings.TrimSuffix(endpoint.URL, "/")
+				return nil
+			}
+		}
+	}
+	return c.noEndpointError()
+}
+
+func (c *swiftClient) noEndpointError() error {
+	if c.region != "" {
+		return fmt.Errorf("no object-store endpoint in region %s", c.region)
+	}
+	return fmt.Errorf("no object-store endpoint")
+}
+
+// postJSON posts request to u and decodes the response into response,
+// returning the response's header.
+func (c *swiftClient) postJSON(u string, request interface{}, response interface{}) (http.Header, error) {
+	data, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.httpClient.Post(u, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+		return nil, err
+	}
+	return resp.Header, nil
+}
+
+// swiftWriter streams an object to Swift in a single chunked request.
+type swiftWriter struct {
+	errChan chan error
+	pipe    *io.PipeWriter
+}
+
+func newSwiftWriter(client *swiftClient, name string) *swiftWriter {
+	reader, writer := io.Pipe()
+	w := &swiftWriter{
+		errChan: make(chan error),
+		pipe:    writer,
+	}
+	go func() {
+		header := make(http.Header)
+		header.Set("Content-Type", "application/octet-stream")
+		resp, err := client.do("PUT", name, header, reader)
+		if err == nil {
+			resp.Body.Close()
+			if resp.StatusCode != http.StatusCreated {
+				err = client.error(resp, "PUT", name)
+			}
+		}
+		if err != nil {
+			reader.CloseWithError(err)
+		}
+		w.errChan <- err
+	}()
+	return w
+}
+
+func (w *swiftWriter) Write(p []byte) (int, error) {
+	return w.pipe.Write(p)
+}
+
+// Close blocks until the upload is done.
+func (w *swiftWriter) Close() error {
+	if err := w.pipe.Close(); err != nil {
+		return err
+	}
+	return <-w.errChan
+}
@@ -0,0 +1,433 @@
+package obj
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+const (
+	testSwiftUser      = "user"
+	testSwiftPassword  = "password"
+	testSwiftTenant    = "tenant"
+	testSwiftContainer = "container"
+	testSwiftAccount   = "/v1/AUTH_test/"
+)
+
+// fakeSwift is a Swift cluster with v1, v2 and v3 auth endpoints, and a
+// single account.
+type fakeSwift struct {
+	t      *testing.T
+	server *httptest.Server
+	url    string
+
+	mu       sync.Mutex
+	tokens   map[string]bool
+	auths    int
+	expires  time.Duration
+	objects  map[string][]byte
+	modTime  time.Time
+	created  bool
+	lists    int
+	chunked  bool
+	failNext int
+}
+
+func newFakeSwift(t *testing.T) *fakeSwift {
+	s := &fakeSwift{
+		t:       t,
+		tokens:  make(map[string]bool),
+		expires: time.Hour,
+		objects: make(map[string][]byte),
+		modTime: time.Date(2017, 10, 1, 12, 0, 0, 0, time.UTC),
+	}
+	s.server = httptest.NewServer(s)
+	s.url = s.server.URL
+	return s
+}
+
+func (s *fakeSwift) close() {
+	s.server.Close()
+}
+
+func (s *fakeSwift) newToken() string {
+	s.auths++
+	token := fmt.Sprintf("token-%d", s.auths)
+	s.tokens[token] = true
+	return token
+}
+
+func (s *fakeSwift) revokeTokens() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tokens = make(map[string]bool)
+}
+
+func (s *fakeSwift) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch {
+	case r.URL.Path == "/auth/v1.0":
+		s.authV1(w, r)
+	case r.URL.Path == "/v2.0/tokens":
+		s.authV2(w, r)
+	case r.URL.Path == "/v3/auth/tokens":
+		s.authV3(w, r)
+	case strings.HasPrefix(r.URL.Path, testSwiftAccount):
+		if !s.tokens[r.Header.Get("X-Auth-Token")] {
+			http.Error(w, "invalid token", http.StatusUnauthorized)
+			return
+		}
+		if s.failNext != 0 {
+			http.Error(w, "injected error", s.failNext)
+			s.failNext = 0
+			return
+		}
+		parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, testSwiftAccount), "/", 2)
+		if parts[0] != testSwiftContainer {
+			http.NotFound(w, r)
+			return
+		}
+		if len(parts) == 1 || parts[1] == "" {
+			s.container(w, r)
+		} else {
+			s.object(w, r, parts[1])
+		}
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *fakeSwift) authV1(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("X-Auth-User") != testSwiftUser || r.Header.Get("X-Auth-Key") != testSwiftPassword {
+		http.Error(w, "invalid credentials", http.StatusUnauthorized)
+		return
+	}
+	w.Header().Set("X-Auth-Token", s.newToken())
+	w.Header().Set("X-Storage-Url", s.url+testSwiftAccount)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *fakeSwift) authV2(w http.ResponseWriter, r *http.Request) {
+	var request struct {
+		Auth struct {
+			PasswordCredentials struct {
+				Username string `json:"username"`
+				Password string `json:"password"`
+			} `json:"passwordCredentials"`
+			TenantName string `json:"tenantName"`
+		} `json:"auth"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	credentials := request.Auth.PasswordCredentials
+	if credentials.Username != testSwiftUser || credentials.Password != testSwiftPassword || request.Auth.TenantName != testSwiftTenant {
+		http.Error(w, "invalid credentials", http.StatusUnauthorized)
+		return
+	}
+	fmt.Fprintf(w, `{"access": {
+		"token": {"id": %q, "expires": %q},
+		"serviceCatalog": [
+			{"type": "identity", "endpoints": [{"region": "RegionOne", "publicURL": "http://identity"}]},
+			{"type": "object-store", "endpoints": [
+				{"region": "RegionTwo", "publicURL": "http://elsewhere/v1/AUTH_test"},
+				{"region": "RegionOne", "publicURL": %q}
+			]}
+		]
+	}}`, s.newToken(), time.Now().Add(s.expires).Format(time.RFC3339), s.url+testSwiftAccount)
+}
+
+func (s *fakeSwift) authV3(w http.ResponseWriter, r *http.Request) {
+	var request struct {
+		Auth struct {
+			Identity struct {
+				Methods  []string `json:"methods"`
+				Password struct {
+					User struct {
+						Name     string `json:"name"`
+						Password string `json:"password"`
+						Domain   struct {
+							Name string `json:"name"`
+						} `json:"domain"`
+					} `json:"user"`
+				} `json:"password"`
+			} `json:"identity"`
+			Scope struct {
+				Project struct {
+					Name string `json:"name"`
+				} `json:"project"`
+			} `json:"scope"`
+		} `json:"auth"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	identity := request.Auth.Identity
+	if len(identity.Methods) != 1 || identity.Methods[0] != "password" ||
+		identity.Password.User.Name != testSwiftUser || identity.Password.User.Password != testSwiftPassword ||
+		identity.Password.User.Domain.Name != "Default" || request.Auth.Scope.Project.Name != testSwiftTenant {
+		http.Error(w, "invalid credentials", http.StatusUnauthorized)
+		return
+	}
+	w.Header().Set("X-Subject-Token", s.newToken())
+	w.WriteHeader(http.StatusCreated)
+	fmt.Fprintf(w, `{"token": {
+		"expires_at": %q,
+		"catalog": [
+			{"type": "object-store", "endpoints": [
+				{"interface": "internal", "region": "RegionOne", "url": "http://internal/v1/AUTH_test"},
+				{"interface": "public", "region": "RegionOne", "url": %q}
+			]}
+		]
+	}}`, time.Now().Add(s.expires).Format(time.RFC3339), s.url+testSwiftAccount)
+}
+
+func (s *fakeSwift) container(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "PUT":
+		if s.created {
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		s.created = true
+		w.WriteHeader(http.StatusCreated)
+	case "GET":
+		s.lists++
+		query := r.URL.Query()
+		require.Equal(s.t, "json", query.Get("format"))
+		limit, err := strconv.Atoi(query.Get("limit"))
+		require.NoError(s.t, err)
+		var names []string
+		for name := range s.objects {
+			if strings.HasPrefix(name, query.Get("prefix")) && name > query.Get("marker") {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+		if len(names) > limit {
+			names = names[:limit]
+		}
+		if len(names) == 0 {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		var objects []map[string]interface{}
+		for _, name := range names {
+			objects = append(objects, map[string]interface{}{"name": name, "bytes": len(s.objects[name])})
+		}
+		json.NewEncoder(w).Encode(objects)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *fakeSwift) object(w http.ResponseWriter, r *http.Request, name string) {
+	switch r.Method {
+	case "PUT":
+		data, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		s.chunked = len(r.TransferEncoding) > 0 && r.TransferEncoding[0] == "chunked"
+		s.objects[name] = data
+		w.WriteHeader(http.StatusCreated)
+	case "GET", "HEAD":
+		data, ok := s.objects[name]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		http.ServeContent(w, r, name, s.modTime, bytes.NewReader(data))
+	case "DELETE":
+		if _, ok := s.objects[name]; !ok {
+			http.NotFound(w, r)
+			return
+		}
+		delete(s.objects, name)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func writeSwiftObject(t *testing.T, c *swiftClient, name string, data ...string) {
+	w, err := c.Writer(name)
+	require.NoError(t, err)
+	for _, d := range data {
+		_, err = w.Write([]byte(d))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+}
+
+func readSwiftObject(t *testing.T, c *swiftClient, name string, offset uint64, size uint64) string {
+	r, err := c.Reader(name, offset, size)
+	require.NoError(t, err)
+	data, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	return string(data)
+}
+
+func TestSwiftAuth(t *testing.T) {
+	s := newFakeSwift(t)
+	defer s.close()
+	for _, authURL := range []string{"/auth/v1.0", "/v2.0/", "/v3"} {
+		c, err := newSwiftClient(testSwiftContainer, s.url+authURL, testSwiftUser, testSwiftPassword, testSwiftTenant, "", "RegionOne")
+		require.NoError(t, err)
+		writeSwiftObject(t, c, authURL, "data")
+		require.Equal(t, "data", readSwiftObject(t, c, authURL, 0, 0))
+
+		_, err = newSwiftClient(testSwiftContainer, s.url+authURL, testSwiftUser, "wrong", testSwiftTenant, "", "RegionOne")
+		require.YesError(t, err)
+	}
+
+	// The endpoint is picked by region.
+	_, err := newSwiftClient(testSwiftContainer, s.url+"/v2.0", testSwiftUser, testSwiftPassword, testSwiftTenant, "", "RegionThree")
+	require.YesError(t, err)
+	require.True(t, strings.Contains(err.Error(), "no object-store endpoint in region RegionThree"))
+	_, err = newSwiftClient(testSwiftContainer, s.url+"/v3", testSwiftUser, testSwiftPassword, testSwiftTenant, "", "RegionTwo")
+	require.YesError(t, err)
+}
+
+func TestSwiftTokenRefresh(t *testing.T) {
+	s := newFakeSwift(t)
+	defer s.close()
+	c, err := newSwiftClient(testSwiftContainer, s.url+"/v3", testSwiftUser, testSwiftPassword, testSwiftTenant, "", "")
+	require.NoError(t, err)
+	writeSwiftObject(t, c, "object", "data")
+	require.Equal(t, 1, s.auths)
+
+	// A revoked token is replaced, and the request retried.
+	s.revokeTokens()
+	require.Equal(t, "data", readSwiftObject(t, c, "object", 0, 0))
+	require.Equal(t, 2, s.auths)
+
+	// A token that's about to expire is replaced before it's used.
+	s.mu.Lock()
+	s.expires = swiftTokenRefresh / 2
+	s.mu.Unlock()
+	s.revokeTokens()
+	require.True(t, c.Exists("object"))
+	require.Equal(t, 3, s.auths)
+	require.True(t, c.Exists("object"))
+	require.Equal(t, 4, s.auths)
+}
+
+func TestSwiftReadWrite(t *testing.T) {
+	s := newFakeSwift(t)
+	defer s.close()
+	c, err := newSwiftClient(testSwiftContainer, s.url+"/auth/v1.0", testSwiftUser, testSwiftPassword, "", "", "")
+	require.NoError(t, err)
+
+	// Objects are streamed, so the length isn't known up front.
+	name := "dir/an object?#%"
+	writeSwiftObject(t, c, name, "0123", "4567", "89")
+	require.True(t, s.chunked)
+	require.Equal(t, "0123456789", string(s.objects[name]))
+
+	require.Equal(t, "0123456789", readSwiftObject(t, c, name, 0, 0))
+	require.Equal(t, "234", readSwiftObject(t, c, name, 2, 3))
+	require.Equal(t, "6789", readSwiftObject(t, c, name, 6, 0))
+	require.Equal(t, "89", readSwiftObject(t, c, name, 8, 10))
+
+	require.True(t, c.Exists(name))
+	modTime, err := c.ModTime(name)
+	require.NoError(t, err)
+	require.Equal(t, s.modTime, modTime.UTC())
+
+	require.NoError(t, c.Delete(name))
+	require.False(t, c.Exists(name))
+}
+
+func TestSwiftErrors(t *testing.T) {
+	s := newFakeSwift(t)
+	defer s.close()
+	c, err := newSwiftClient(testSwiftContainer, s.url+"/auth/v1.0", testSwiftUser, testSwiftPassword, "", "", "")
+	require.NoError(t, err)
+
+	_, err = c.Reader("missing", 0, 0)
+	require.True(t, c.IsNotExist(err))
+	require.False(t, IsRetryable(c, err))
+	_, err = c.ModTime("missing")
+	require.True(t, c.IsNotExist(err))
+	err = c.Delete("missing")
+	require.True(t, c.IsNotExist(err))
+	require.False(t, c.Exists("missing"))
+
+	s.mu.Lock()
+	s.failNext = http.StatusServiceUnavailable
+	s.mu.Unlock()
+	_, err = c.Reader("missing", 0, 0)
+	require.YesError(t, err)
+	require.True(t, IsRetryable(c, err))
+	require.False(t, c.IsNotExist(err))
+
+	s.mu.Lock()
+	s.failNext = http.StatusInternalServerError
+	s.mu.Unlock()
+	w, err := c.Writer("object")
+	require.NoError(t, err)
+	err = w.Close()
+	require.YesError(t, err)
+	require.True(t, IsRetryable(c, err))
+
+	require.False(t, c.IsNotExist(fmt.Errorf("not found")))
+	require.False(t, IsRetryable(c, fmt.Errorf("internal error")))
+}
+
+func TestSwiftWalk(t *testing.T) {
+	s := newFakeSwift(t)
+	defer s.close()
+	c, err := newSwiftClient(testSwiftContainer, s.url+"/auth/v1.0", testSwiftUser, testSwiftPassword, "", "", "")
+	require.NoError(t, err)
+
+	var names []string
+	require.NoError(t, c.Walk("", func(name string) error {
+		names = append(names, name)
+		return nil
+	}))
+	require.Equal(t, 0, len(names))
+
+	// More objects than are listed per request.
+	s.mu.Lock()
+	for i := 0; i < swiftListLimit+1; i++ {
+		s.objects[fmt.Sprintf("a/%05d", i)] = nil
+	}
+	s.objects["b/0"] = nil
+	s.lists = 0
+	s.mu.Unlock()
+	require.NoError(t, c.Walk("a/", func(name string) error {
+		names = append(names, name)
+		return nil
+	}))
+	require.Equal(t, swiftListLimit+1, len(names))
+	require.Equal(t, "a/00000", names[0])
+	require.Equal(t, fmt.Sprintf("a/%05d", swiftListLimit), names[swiftListLimit])
+	require.Equal(t, 2, s.lists)
+
+	names = nil
+	require.NoError(t, c.Walk("b/", func(name string) error {
+		names = append(names, name)
+		return nil
+	}))
+	require.Equal(t, []string{"b/0"}, names)
+
+	errStop := fmt.Errorf("stop")
+	require.Equal(t, errStop, c.Walk("", func(name string) error { return errStop }))
+}
@@ -1,19 +1,22 @@
-// Package pfsdb contains the database schema that PFS uses.
+// Package pfsdb contains the database schema that PFS uses. Its collections
+// are kept in a col.Store, which is etcd unless PFS is configured to keep its
+// metadata in Postgres.
 package pfsdb
 
 import (
 	"path"
 
-	etcd "github.com/coreos/etcd/clientv3"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
 )
 
 const (
+	projectsPrefix      = "/projects"
 	reposPrefix         = "/repos"
 	repoRefCountsPrefix = "/repoRefCounts"
 	commitsPrefix       = "/commits"
 	branchesPrefix      = "/branches"
+	leasesPrefix        = "/leases"
 )
 
 var (
@@ -21,42 +24,63 @@ var (
 	ProvenanceIndex = col.Index{"Provenance", true}
 )
 
+// Projects returns a collection of projects
+func Projects(store col.Store, prefix string) col.Collection {
+	return col.NewStoreCollection(
+		store,
+		path.Join(prefix, projectsPrefix),
+		nil,
+		&pfs.ProjectInfo{},
+	)
+}
+
 // Repos returns a collection of repos
-func Repos(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
-	return col.NewCollection(
-		etcdClient,
-		path.Join(etcdPrefix, reposPrefix),
+func Repos(store col.Store, prefix string) col.Collection {
+	return col.NewStoreCollection(
+		store,
+		path.Join(prefix, reposPrefix),
 		[]col.Index{ProvenanceIndex},
 		&pfs.RepoInfo{},
 	)
 }
 
 // RepoRefCounts returns a collection of repo ref counts
-func RepoRefCounts(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
-	return col.NewCollection(
-		etcdClient,
-		path.Join(etcdPrefix, repoRefCountsPrefix),
+func RepoRefCounts(store col.Store, prefix string) col.Collection {
+	return col.NewStoreCollection(
+		store,
+		path.Join(prefix, repoRefCountsPrefix),
 		nil,
 		nil,
 	)
 }
 
 // Commits returns a collection of commits
-func Commits(etcdClient *etcd.Client, etcdPrefix string, repo string) col.Collection {
-	return col.NewCollection(
-		etcdClient,
-		path.Join(etcdPrefix, commitsPrefix, repo),
+func Commits(store col.Store, prefix string, repo string) col.Collection {
+	return col.NewStoreCollection(
+		store,
+		path.Join(prefix, commitsPrefix, repo),
 		[]col.Index{ProvenanceIndex},
 		&pfs.CommitInfo{},
 	)
 }
 
 // Branches returns a collection of branches
-func Branches(etcdClient *etcd.Client, etcdPrefix string, repo string) col.Collection {
-	return col.NewCollection(
-		etcdClient,
-		path.Join(etcdPrefix, branchesPrefix, repo),
+func Branches(store col.Store, prefix string, repo string) col.Collection {
+	return col.NewStoreCollection(
+		store,
+		path.Join(prefix, branchesPrefix, repo),
 		nil,
 		&pfs.Commit{},
 	)
 }
+
+// Leases returns a collection of the leases in the open commits to a repo,
+// keyed by commit ID
+func Leases(store col.Store, prefix string, repo string) col.Collection {
+	return col.NewStoreCollection(
+		store,
+		path.Join(prefix, leasesPrefix, repo),
+		nil,
+		&pfs.Leases{},
+	)
+}
@@ -0,0 +1,193 @@
+package portforward
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/ghodss/yaml"
+	"k8s.io/kubernetes/pkg/client/restclient"
+	clientcmdapi "k8s.io/kubernetes/pkg/client/unversioned/clientcmd/api"
+)
+
+// kubeConfig is the part of a kubeconfig file that's needed to talk to the
+// Kubernetes API server.
+type kubeConfig struct {
+	CurrentContext string         `json:"current-context"`
+	Clusters       []namedCluster `json:"clusters"`
+	Users          []namedUser    `json:"users"`
+	Contexts       []namedContext `json:"contexts"`
+}
+
+type namedCluster struct {
+	Name    string `json:"name"`
+	Cluster struct {
+		Server                   string `json:"server"`
+		InsecureSkipTLSVerify    bool   `json:"insecure-skip-tls-verify"`
+		CertificateAuthority     string `json:"certificate-authority"`
+		CertificateAuthorityData []byte `json:"certificate-authority-data"`
+	} `json:"cluster"`
+	// dir is the directory of the file the cluster is from, which relative
+	// paths are relative to.
+	dir string
+}
+
+type namedUser struct {
+	Name string `json:"name"`
+	User struct {
+		ClientCertificate     string                           `json:"client-certificate"`
+		ClientCertificateData []byte                           `json:"client-certificate-data"`
+		ClientKey             string                           `json:"client-key"`
+		ClientKeyData         []byte                           `json:"client-key-data"`
+		Token                 string                           `json:"token"`
+		TokenFile             string                           `json:"tokenFile"`
+		Username              string                           `json:"username"`
+		Password              string                           `json:"password"`
+		AuthProvider          *clientcmdapi.AuthProviderConfig `json:"auth-provider"`
+	} `json:"user"`
+	dir string
+}
+
+type namedContext struct {
+	Name    string `json:"name"`
+	Context struct {
+		Cluster   string `json:"cluster"`
+		User      string `json:"user"`
+		Namespace string `json:"namespace"`
+	} `json:"context"`
+}
+
+// KubeConfig returns the config for the Kubernetes API server that the
+// kubeconfig files describe, which are found the way kubectl finds them:
+// path if it's set, otherwise the files listed in $KUBECONFIG, otherwise
+// ~/.kube/config. kubeContext is the context to use, the files' current
+// context if it's empty. The context's namespace is returned too, or
+// "default" if it doesn't have one.
+func KubeConfig(path string, kubeContext string) (*restclient.Config, string, error) {
+	paths := []string{path}
+	if path == "" {
+		paths = filepath.SplitList(os.Getenv("KUBECONFIG"))
+		if len(paths) == 0 {
+			paths = []string{filepath.Join(homeDir(), ".kube", "config")}
+		}
+	}
+	// Like kubectl, the first file to set something wins.
+	merged := &kubeConfig{}
+	for _, path := range paths {
+		if path == "" {
+			continue
+		}
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			if os.IsNotExist(err) && len(paths) > 1 {
+				continue
+			}
+			return nil, "", fmt.Errorf("error reading kubeconfig: %v", err)
+		}
+		config := &kubeConfig{}
+		if err := yaml.Unmarshal(data, config); err != nil {
+			return nil, "", fmt.Errorf("error parsing kubeconfig %s: %v", path, err)
+		}
+		dir := filepath.Dir(path)
+		if merged.CurrentContext == "" {
+			merged.CurrentContext = config.CurrentContext
+		}
+		for _, cluster := range config.Clusters {
+			cluster.dir = dir
+			merged.Clusters = append(merged.Clusters, cluster)
+		}
+		for _, user := range config.Users {
+			user.dir = dir
+			merged.Users = append(merged.Users, user)
+		}
+		merged.Contexts = append(merged.Contexts, config.Contexts...)
+	}
+	if kubeContext == "" {
+		kubeContext = merged.CurrentContext
+	}
+	if kubeContext == "" {
+		return nil, "", fmt.Errorf("no Kubernetes context is set, pass --context or run `kubectl config use-context`")
+	}
+	context := merged.context(kubeContext)
+	if context == nil {
+		return nil, "", fmt.Errorf("the Kubernetes context %q isn't in the kubeconfig", kubeContext)
+	}
+	cluster := merged.cluster(context.Context.Cluster)
+	if cluster == nil {
+		return nil, "", fmt.Errorf("the Kubernetes cluster %q, of context %q, isn't in the kubeconfig", context.Context.Cluster, kubeContext)
+	}
+	config := &restclient.Config{
+		Host:     cluster.Cluster.Server,
+		Insecure: cluster.Cluster.InsecureSkipTLSVerify,
+	}
+	config.CAFile = resolvePath(cluster.dir, cluster.Cluster.CertificateAuthority)
+	config.CAData = cluster.Cluster.CertificateAuthorityData
+	if user := merged.user(context.Context.User); user != nil {
+		config.CertFile = resolvePath(user.dir, user.User.ClientCertificate)
+		config.CertData = user.User.ClientCertificateData
+		config.KeyFile = resolvePath(user.dir, user.User.ClientKey)
+		config.KeyData = user.User.ClientKeyData
+		config.BearerToken = user.User.Token
+		if config.BearerToken == "" && user.User.TokenFile != "" {
+			token, err := ioutil.ReadFile(resolvePath(user.dir, user.User.TokenFile))
+			if err != nil {
+				return nil, "", fmt.Errorf("error reading Kubernetes token: %v", err)
+			}
+			config.BearerToken = string(token)
+		}
+		config.Username = user.User.Username
+		config.Password = user.User.Password
+		config.AuthProvider = user.User.AuthProvider
+	}
+	namespace := context.Context.Namespace
+	if namespace == "" {
+		namespace = "default"
+	}
+	return config, namespace, nil
+}
+
+func (c *kubeConfig) context(name string) *namedContext {
+	for i := range c.Contexts {
+		if c.Contexts[i].Name == name {
+			return &c.Contexts[i]
+		}
+	}
+	return nil
+}
+
+func (c *kubeConfig) cluster(name string) *namedCluster {
+	for i := range c.Clusters {
+		if c.Clusters[i].Name == name {
+			return &c.Clusters[i]
+		}
+	}
+	return nil
+}
+
+func (c *kubeConfig) user(name string) *namedUser {
+	for i := range c.Users {
+		if c.Users[i].Name == name {
+			return &c.Users[i]
+		}
+	}
+	return nil
+}
+
+// resolvePath returns path relative to dir, if it's relative.
+func resolvePath(dir string, path string) string {
+	if path == "" || filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(dir, path)
+}
+
+func homeDir() string {
+	if runtime.GOOS == "windows" {
+		if home := os.Getenv("USERPROFILE"); home != "" {
+			return home
+		}
+	}
+	return os.Getenv("HOME")
+}
@@ -0,0 +1,248 @@
+// Package portforward forwards local ports to ports of pods through the
+// Kubernetes API server, like `kubectl port-forward`, but without kubectl.
+// It speaks the kubelet's WebSocket port forwarding protocol, in which each
+// connection to a pod's port is a WebSocket whose messages are prefixed with
+// the channel that they're on: 0 for the connection's data, and 1 for errors.
+package portforward
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"path"
+	"strconv"
+	"sync"
+	"time"
+
+	log "github.com/Sirupsen/logrus"
+	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/client/restclient"
+	kube "k8s.io/kubernetes/pkg/client/unversioned"
+	"k8s.io/kubernetes/pkg/labels"
+
+	// Initialize the auth plugins that kubeconfigs can use, e.g. for GKE.
+	_ "k8s.io/kubernetes/plugin/pkg/client/auth"
+)
+
+const (
+	// channelProtocol is the WebSocket subprotocol that the kubelet
+	// forwards ports over.
+	channelProtocol = "v4.channel.k8s.io"
+	dataChannel     = 0
+	errorChannel    = 1
+	// reconnectTimeout is how long a connection waits for a running pod,
+	// e.g. while the one it was forwarded to is restarted.
+	reconnectTimeout = time.Minute
+)
+
+// Forwarder forwards local ports to the ports of pods in a namespace.
+type Forwarder struct {
+	config    *restclient.Config
+	client    *kube.Client
+	namespace string
+}
+
+// New returns a Forwarder to the pods in namespace, on the cluster that
+// config describes.
+func New(config *restclient.Config, namespace string) (*Forwarder, error) {
+	client, err := kube.New(config)
+	if err != nil {
+		return nil, err
+	}
+	return &Forwarder{
+		config:    config,
+		client:    client,
+		namespace: namespace,
+	}, nil
+}
+
+// Running returns true if there's a running pod with the labels in
+// selector.
+func (f *Forwarder) Running(selector map[string]string) (bool, error) {
+	pod, err := f.findPod(selector)
+	if err != nil {
+		return false, err
+	}
+	return pod != "", nil
+}
+
+// Forward forwards each connection to listener to remotePort of a running
+// pod with the labels in selector, until listener is closed. The pod is
+// looked up again whenever a connection to it fails, so forwarding carries
+// on when the pod is restarted or replaced.
+func (f *Forwarder) Forward(listener net.Listener, selector map[string]string, remotePort int) error {
+	target := &target{
+		f:        f,
+		selector: selector,
+	}
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return err
+		}
+		go func() {
+			if err := f.forward(conn, target, remotePort); err != nil {
+				log.Errorf("error forwarding to port %d: %v", remotePort, err)
+			}
+		}()
+	}
+}
+
+// target is the pod that a port is being forwarded to, which is remembered
+// between connections until one fails.
+type target struct {
+	f        *Forwarder
+	selector map[string]string
+	mu       sync.Mutex
+	pod      string
+}
+
+func (t *target) get() (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.pod != "" {
+		return t.pod, nil
+	}
+	pod, err := t.f.findPod(t.selector)
+	if err != nil {
+		return "", err
+	}
+	if pod == "" {
+		return "", fmt.Errorf("no running pods match %v", labels.Set(t.selector))
+	}
+	t.pod = pod
+	return pod, nil
+}
+
+// forget makes the next connection look the pod up again, if pod is still
+// the target.
+func (t *target) forget(pod string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.pod == pod {
+		t.pod = ""
+	}
+}
+
+// findPod returns the name of a running pod with the labels in selector,
+// or "" if there isn't one.
+func (f *Forwarder) findPod(selector map[string]string) (string, error) {
+	pods, err := f.client.Pods(f.namespace).List(api.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set(selector)),
+	})
+	if err != nil {
+		return "", err
+	}
+	for _, pod := range pods.Items {
+		if pod.Status.Phase == api.PodRunning && pod.DeletionTimestamp == nil {
+			return pod.Name, nil
+		}
+	}
+	return "", nil
+}
+
+func (f *Forwarder) forward(local net.Conn, target *target, remotePort int) error {
+	defer local.Close()
+	var ws *webSocket
+	b := backoff.NewExponentialBackOff()
+	b.MaxElapsedTime = reconnectTimeout
+	if err := backoff.RetryNotify(func() error {
+		pod, err := target.get()
+		if err != nil {
+			return err
+		}
+		ws, err = f.dial(pod, remotePort)
+		if err != nil {
+			target.forget(pod)
+			return fmt.Errorf("error connecting to %s: %v", pod, err)
+		}
+		return nil
+	}, b, func(err error, d time.Duration) error {
+		log.Infof("%v; retrying in %v", err, d)
+		return nil
+	}); err != nil {
+		return err
+	}
+	defer ws.Close()
+
+	errCh := make(chan error, 2)
+	go func() {
+		buf := make([]byte, 32*1024)
+		for {
+			n, err := local.Read(buf)
+			if n > 0 {
+				if err := ws.writeMessage(append([]byte{dataChannel}, buf[:n]...)); err != nil {
+					errCh <- err
+					return
+				}
+			}
+			if err != nil {
+				if err == io.EOF {
+					err = nil
+				}
+				errCh <- err
+				return
+			}
+		}
+	}()
+	go func() {
+		// The first message on each channel is the port it's for.
+		seen := make(map[byte]bool)
+		for {
+			message, err := ws.readMessage()
+			if err != nil {
+				if err == io.EOF {
+					err = nil
+				}
+				errCh <- err
+				return
+			}
+			if len(message) == 0 {
+				continue
+			}
+			channel, data := message[0], message[1:]
+			if !seen[channel] {
+				seen[channel] = true
+				if len(data) < 2 {
+					continue
+				}
+				data = data[2:]
+			}
+			if len(data) == 0 {
+				continue
+			}
+			switch channel {
+			case dataChannel:
+				if _, err := local.Write(data); err != nil {
+					errCh <- err
+					return
+				}
+			case errorChannel:
+				errCh <- fmt.Errorf("%s", data)
+				return
+			}
+		}
+	}()
+	// Either side finishing ends the connection, which stops the other.
+	return <-errCh
+}
+
+// dial opens a connection to port of pod.
+func (f *Forwarder) dial(pod string, port int) (*webSocket, error) {
+	u, err := url.Parse(f.config.Host)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme == "" {
+		// The host can be given without a scheme, like the client allows.
+		u, err = url.Parse("https://" + f.config.Host)
+		if err != nil {
+			return nil, err
+		}
+	}
+	u.Path = path.Join(u.Path, "api", "v1", "namespaces", f.namespace, "pods", pod, "portforward")
+	u.RawQuery = url.Values{"ports": []string{strconv.Itoa(port)}}.Encode()
+	return dialWebSocket(f.config, u, channelProtocol)
+}
@@ -0,0 +1,272 @@
+package portforward
+
+import (
+	"bufio"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/kubernetes/pkg/client/restclient"
+)
+
+// WebSocket opcodes, from RFC 6455.
+const (
+	opContinuation = 0x0
+	opText         = 0x1
+	opBinary       = 0x2
+	opClose        = 0x8
+	opPing         = 0x9
+	opPong         = 0xa
+)
+
+const (
+	// webSocketGUID is appended to the handshake's key to compute the
+	// server's accept header.
+	webSocketGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+	// maxMessageSize is the largest message that's accepted from the
+	// server. The kubelet sends at most 32KiB at a time.
+	maxMessageSize = 16 * 1024 * 1024
+	dialTimeout    = 30 * time.Second
+)
+
+// webSocket is the client end of a WebSocket connection. It only implements
+// what talking to the Kubernetes API server needs: binary messages, pings
+// and closing.
+type webSocket struct {
+	conn    net.Conn
+	r       *bufio.Reader
+	writeMu sync.Mutex
+}
+
+// dialWebSocket opens a WebSocket to u, on the API server described by
+// config, speaking protocol.
+func dialWebSocket(config *restclient.Config, u *url.URL, protocol string) (*webSocket, error) {
+	host := u.Host
+	if _, _, err := net.SplitHostPort(host); err != nil {
+		if u.Scheme == "https" {
+			host = net.JoinHostPort(host, "443")
+		} else {
+			host = net.JoinHostPort(host, "80")
+		}
+	}
+	var conn net.Conn
+	var err error
+	if u.Scheme == "https" {
+		tlsConfig, err := restclient.TLSConfigFor(config)
+		if err != nil {
+			return nil, err
+		}
+		if tlsConfig == nil {
+			tlsConfig = &tls.Config{}
+		}
+		// The connection is upgraded, which HTTP/2 doesn't support.
+		tlsConfig.NextProtos = []string{"http/1.1"}
+		if tlsConfig.ServerName == "" {
+			tlsConfig.ServerName, _, _ = net.SplitHostPort(host)
+		}
+		conn, err = tls.DialWithDialer(&net.Dialer{Timeout: dialTimeout}, "tcp", host, tlsConfig)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		conn, err = net.DialTimeout("tcp", host, dialTimeout)
+		if err != nil {
+			return nil, err
+		}
+	}
+	ws, err := handshake(conn, config, u, protocol)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return ws, nil
+}
+
+func handshake(conn net.Conn, config *restclient.Config, u *url.URL, protocol string) (*webSocket, error) {
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	key := base64.StdEncoding.EncodeToString(nonce)
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Upgrade", "websocket")
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Sec-WebSocket-Key", key)
+	req.Header.Set("Sec-WebSocket-Version", "13")
+	req.Header.Set("Sec-WebSocket-Protocol", protocol)
+	// The client's round trippers add its credentials to the request, which
+	// is then sent over conn rather than by them.
+	rt, err := restclient.HTTPWrappersForConfig(config, roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		req = r
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(strings.NewReader("")),
+			Request:    r,
+		}, nil
+	}))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := rt.RoundTrip(req); err != nil {
+		return nil, err
+	}
+	conn.SetDeadline(time.Now().Add(dialTimeout))
+	if err := req.Write(conn); err != nil {
+		return nil, err
+	}
+	r := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(r, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 4096))
+		resp.Body.Close()
+		return nil, fmt.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	accept := sha1.Sum([]byte(key + webSocketGUID))
+	if resp.Header.Get("Sec-WebSocket-Accept") != base64.StdEncoding.EncodeToString(accept[:]) {
+		return nil, fmt.Errorf("invalid WebSocket handshake from %s", u.Host)
+	}
+	conn.SetDeadline(time.Time{})
+	return &webSocket{
+		conn: conn,
+		r:    r,
+	}, nil
+}
+
+// readMessage returns the next data message, answering pings on the way. It
+// returns io.EOF once the server closes the WebSocket.
+func (ws *webSocket) readMessage() ([]byte, error) {
+	var message []byte
+	for {
+		fin, opcode, payload, err := ws.readFrame()
+		if err != nil {
+			return nil, err
+		}
+		switch opcode {
+		case opPing:
+			if err := ws.writeFrame(opPong, payload); err != nil {
+				return nil, err
+			}
+		case opPong:
+		case opClose:
+			return nil, io.EOF
+		case opContinuation, opText, opBinary:
+			message = append(message, payload...)
+			if len(message) > maxMessageSize {
+				return nil, fmt.Errorf("WebSocket message is larger than %d bytes", maxMessageSize)
+			}
+			if fin {
+				return message, nil
+			}
+		default:
+			return nil, fmt.Errorf("unexpected WebSocket opcode %d", opcode)
+		}
+	}
+}
+
+func (ws *webSocket) readFrame() (bool, byte, []byte, error) {
+	var header [2]byte
+	if _, err := io.ReadFull(ws.r, header[:]); err != nil {
+		return false, 0, nil, err
+	}
+	fin := header[0]&0x80 != 0
+	opcode := header[0] & 0x0f
+	masked := header[1]&0x80 != 0
+	length := uint64(header[1] & 0x7f)
+	switch length {
+	case 126:
+		var extended [2]byte
+		if _, err := io.ReadFull(ws.r, extended[:]); err != nil {
+			return false, 0, nil, err
+		}
+		length = uint64(binary.BigEndian.Uint16(extended[:]))
+	case 127:
+		var extended [8]byte
+		if _, err := io.ReadFull(ws.r, extended[:]); err != nil {
+			return false, 0, nil, err
+		}
+		length = binary.BigEndian.Uint64(extended[:])
+	}
+	if length > maxMessageSize {
+		return false, 0, nil, fmt.Errorf("WebSocket frame is larger than %d bytes", maxMessageSize)
+	}
+	var mask [4]byte
+	if masked {
+		if _, err := io.ReadFull(ws.r, mask[:]); err != nil {
+			return false, 0, nil, err
+		}
+	}
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(ws.r, payload); err != nil {
+		return false, 0, nil, err
+	}
+	if masked {
+		for i := range payload {
+			payload[i] ^= mask[i%4]
+		}
+	}
+	return fin, opcode, payload, nil
+}
+
+// writeMessage sends payload as a binary message.
+func (ws *webSocket) writeMessage(payload []byte) error {
+	return ws.writeFrame(opBinary, payload)
+}
+
+// writeFrame sends a frame, which is masked, as frames from clients must be.
+func (ws *webSocket) writeFrame(opcode byte, payload []byte) error {
+	frame := make([]byte, 0, 14+len(payload))
+	frame = append(frame, 0x80|opcode)
+	switch n := len(payload); {
+	case n < 126:
+		frame = append(frame, 0x80|byte(n))
+	case n <= 0xffff:
+		frame = append(frame, 0x80|126, byte(n>>8), byte(n))
+	default:
+		var extended [8]byte
+		binary.BigEndian.PutUint64(extended[:], uint64(n))
+		frame = append(frame, 0x80|127)
+		frame = append(frame, extended[:]...)
+	}
+	var mask [4]byte
+	if _, err := rand.Read(mask[:]); err != nil {
+		return err
+	}
+	frame = append(frame, mask[:]...)
+	for i, b := range payload {
+		frame = append(frame, b^mask[i%4])
+	}
+	ws.writeMu.Lock()
+	defer ws.writeMu.Unlock()
+	_, err := ws.conn.Write(frame)
+	return err
+}
+
+// Close closes the WebSocket, telling the server first.
+func (ws *webSocket) Close() error {
+	// 1000 is a normal closure.
+	ws.writeFrame(opClose, []byte{0x03, 0xe8})
+	return ws.conn.Close()
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -0,0 +1,155 @@
+package portforward
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+// pipe returns the two ends of an in-memory WebSocket connection, and the
+// raw connection that the second one is read from.
+func pipe() (*webSocket, *webSocket, net.Conn) {
+	client, server := net.Pipe()
+	return &webSocket{conn: client, r: bufio.NewReader(client)},
+		&webSocket{conn: server, r: bufio.NewReader(server)},
+		server
+}
+
+// unmaskedFrame returns a frame as a server would send it.
+func unmaskedFrame(fin bool, opcode byte, payload []byte) []byte {
+	var frame []byte
+	if fin {
+		frame = append(frame, 0x80|opcode)
+	} else {
+		frame = append(frame, opcode)
+	}
+	switch n := len(payload); {
+	case n < 126:
+		frame = append(frame, byte(n))
+	case n <= 0xffff:
+		frame = append(frame, 126, byte(n>>8), byte(n))
+	default:
+		var extended [8]byte
+		binary.BigEndian.PutUint64(extended[:], uint64(n))
+		frame = append(frame, 127)
+		frame = append(frame, extended[:]...)
+	}
+	return append(frame, payload...)
+}
+
+func TestMessageSizes(t *testing.T) {
+	client, server, _ := pipe()
+	// The lengths around where the frame's length encoding changes.
+	for _, n := range []int{0, 1, 125, 126, 127, 0xffff, 0x10000, 100000} {
+		payload := bytes.Repeat([]byte{'a'}, n)
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- client.writeMessage(payload)
+		}()
+		message, err := server.readMessage()
+		require.NoError(t, err)
+		require.NoError(t, <-errCh)
+		require.True(t, bytes.Equal(payload, message))
+	}
+}
+
+func TestMasking(t *testing.T) {
+	client, _, raw := pipe()
+	payload := []byte("hello, world")
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- client.writeMessage(payload)
+	}()
+	frame := make([]byte, 2+4+len(payload))
+	_, err := io.ReadFull(raw, frame)
+	require.NoError(t, err)
+	require.NoError(t, <-errCh)
+	require.Equal(t, byte(0x80|opBinary), frame[0])
+	// Frames from clients are masked.
+	require.Equal(t, byte(0x80|len(payload)), frame[1])
+	mask := frame[2:6]
+	masked := frame[6:]
+	for i := range masked {
+		masked[i] ^= mask[i%4]
+	}
+	require.Equal(t, payload, masked)
+}
+
+func TestFragmentation(t *testing.T) {
+	client, _, raw := pipe()
+	errCh := make(chan error, 1)
+	go func() {
+		for _, frame := range [][]byte{
+			unmaskedFrame(false, opBinary, []byte("foo")),
+			// Control frames can come between the fragments of a message,
+			// pings are answered.
+			unmaskedFrame(true, opPing, []byte("ping")),
+		} {
+			if _, err := raw.Write(frame); err != nil {
+				errCh <- err
+				return
+			}
+		}
+		errCh <- nil
+	}()
+	go func() {
+		message, err := client.readMessage()
+		if err != nil {
+			errCh <- err
+			return
+		}
+		if string(message) != "foobar" {
+			errCh <- io.ErrUnexpectedEOF
+			return
+		}
+		errCh <- nil
+	}()
+	require.NoError(t, <-errCh)
+	// Read the pong, then finish the message.
+	server := &webSocket{conn: raw, r: bufio.NewReader(raw)}
+	fin, opcode, payload, err := server.readFrame()
+	require.NoError(t, err)
+	require.True(t, fin)
+	require.Equal(t, byte(opPong), opcode)
+	require.Equal(t, "ping", string(payload))
+	for _, frame := range [][]byte{
+		unmaskedFrame(false, opContinuation, []byte("b")),
+		unmaskedFrame(true, opContinuation, []byte("ar")),
+	} {
+		_, err := raw.Write(frame)
+		require.NoError(t, err)
+	}
+	require.NoError(t, <-errCh)
+}
+
+func TestClose(t *testing.T) {
+	client, _, raw := pipe()
+	go raw.Write(unmaskedFrame(true, opClose, []byte{0x03, 0xe8}))
+	_, err := client.readMessage()
+	require.Equal(t, io.EOF, err)
+}
+
+func TestTooLarge(t *testing.T) {
+	client, _, raw := pipe()
+	go raw.Write([]byte{0x80 | opBinary, 127, 0, 0, 0, 0, 0x10, 0, 0, 0})
+	_, err := client.readMessage()
+	require.YesError(t, err)
+
+	// Messages are limited too, not just their frames.
+	client, _, raw = pipe()
+	go func() {
+		frame := unmaskedFrame(false, opBinary, make([]byte, maxMessageSize))
+		if _, err := raw.Write(frame); err != nil {
+			return
+		}
+		raw.Write(unmaskedFrame(true, opContinuation, []byte("a")))
+	}()
+	_, err = client.readMessage()
+	require.YesError(t, err)
+	client.conn.Close()
+}
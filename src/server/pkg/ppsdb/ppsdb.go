@@ -2,6 +2,7 @@
 package ppsdb
 
 import (
+	"fmt"
 	"path"
 
 	etcd "github.com/coreos/etcd/clientv3"
@@ -11,11 +12,15 @@ import (
 )
 
 const (
-	pipelinesPrefix = "/pipelines"
-	jobsPrefix      = "/jobs"
+	pipelinesPrefix        = "/pipelines"
+	pipelineVersionsPrefix = "/pipelineVersions"
+	jobsPrefix             = "/jobs"
 )
 
 var (
+	// PipelineVersionsPipelineIndex maps pipeline to its versions
+	PipelineVersionsPipelineIndex = col.Index{"Pipeline", false}
+
 	// JobsPipelineIndex maps pipeline to jobs started by the pipeline
 	JobsPipelineIndex = col.Index{"Pipeline", false}
 
@@ -39,6 +44,23 @@ func Pipelines(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
 	)
 }
 
+// PipelineVersions returns a Collection of every version of every pipeline,
+// keyed by PipelineVersionKey.
+func PipelineVersions(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
+	return col.NewCollection(
+		etcdClient,
+		path.Join(etcdPrefix, pipelineVersionsPrefix),
+		[]col.Index{PipelineVersionsPipelineIndex},
+		&pps.PipelineInfo{},
+	)
+}
+
+// PipelineVersionKey returns the key of a version of a pipeline in the
+// PipelineVersions collection.
+func PipelineVersionKey(pipelineName string, version uint64) string {
+	return fmt.Sprintf("%s@%d", pipelineName, version)
+}
+
 // Jobs returns a Collection of jobs
 func Jobs(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
 	return col.NewCollection(
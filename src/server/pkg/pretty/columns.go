@@ -0,0 +1,78 @@
+package pretty
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/fatih/color"
+)
+
+// Column is a column in the table printed by a list command.
+type Column struct {
+	// Name is the name of the column in --columns, its header is the name in
+	// upper case.
+	Name string
+	// Value returns the column's value in a row.
+	Value func(row interface{}) string
+	// Color, if set, returns the color of the column's value in a row.
+	// Terminal escape characters trip up the tabwriter, so the value is only
+	// colored if it's in the last column.
+	Color func(row interface{}) color.Attribute
+}
+
+// SelectColumns returns the columns to print. names is a comma-separated
+// list of columns, e.g. the value of a --columns flag, if it's empty then
+// every column is returned if output is "wide", otherwise the columns in
+// defaults are.
+func SelectColumns(columns []Column, defaults []string, names string, output string) ([]Column, error) {
+	if err := ValidateOutput(output); err != nil {
+		return nil, err
+	}
+	if names == "" {
+		if output == OutputWide {
+			return columns, nil
+		}
+		names = strings.Join(defaults, ",")
+	}
+	var result []Column
+	for _, name := range strings.Split(names, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		found := false
+		for _, column := range columns {
+			if column.Name == name {
+				result = append(result, column)
+				found = true
+				break
+			}
+		}
+		if !found {
+			var valid []string
+			for _, column := range columns {
+				valid = append(valid, column.Name)
+			}
+			return nil, fmt.Errorf("unknown column %q, valid columns are: %s", name, strings.Join(valid, ", "))
+		}
+	}
+	return result, nil
+}
+
+// PrintHeader prints the header of a table with columns.
+func PrintHeader(w io.Writer, columns []Column) {
+	for _, column := range columns {
+		fmt.Fprintf(w, "%s\t", strings.ToUpper(strings.Replace(column.Name, "-", " ", -1)))
+	}
+	fmt.Fprint(w, "\n")
+}
+
+// PrintRow prints row's value in each of columns.
+func PrintRow(w io.Writer, columns []Column, row interface{}) {
+	for i, column := range columns {
+		value := column.Value(row)
+		if column.Color != nil && i == len(columns)-1 {
+			value = color.New(column.Color(row)).SprintFunc()(value)
+		}
+		fmt.Fprintf(w, "%s\t", value)
+	}
+	fmt.Fprint(w, "\n")
+}
@@ -0,0 +1,111 @@
+package pretty
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/ghodss/yaml"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+)
+
+// The values of pachctl's --output flag.
+const (
+	// OutputTable prints a table of the default columns, or of --columns.
+	OutputTable = "table"
+	// OutputWide prints a table of every column.
+	OutputWide = "wide"
+	// OutputJSON prints JSON.
+	OutputJSON = "json"
+	// OutputYAML prints YAML.
+	OutputYAML = "yaml"
+)
+
+// ValidateOutput returns an error if output isn't a valid value of the
+// --output flag. The empty string is, and means OutputTable.
+func ValidateOutput(output string) error {
+	switch output {
+	case "", OutputTable, OutputWide, OutputJSON, OutputYAML:
+		return nil
+	}
+	return fmt.Errorf("invalid output format %q, valid formats are: %s, %s, %s, %s", output, OutputTable, OutputWide, OutputJSON, OutputYAML)
+}
+
+// Structured returns true if output is a machine-readable format, which is
+// printed with PrintStructured rather than as a table.
+func Structured(output string) bool {
+	return output == OutputJSON || output == OutputYAML
+}
+
+// PrintStructured prints v, which is a protobuf message or a slice of them,
+// in output, OutputJSON or OutputYAML. A slice is printed as a JSON array or
+// YAML sequence, even if it's empty, so a list command's output can always
+// be parsed the same way.
+func PrintStructured(w io.Writer, output string, v interface{}) error {
+	data, err := marshalJSON(v)
+	if err != nil {
+		return err
+	}
+	switch output {
+	case OutputJSON:
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, data, "", "  "); err != nil {
+			return err
+		}
+		buf.WriteByte('\n')
+		_, err = w.Write(buf.Bytes())
+		return err
+	case OutputYAML:
+		data, err := yaml.JSONToYAML(data)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(data)
+		return err
+	}
+	return fmt.Errorf("%q isn't a structured output format", output)
+}
+
+// PrintStructuredStream prints message like PrintStructured, as one of a
+// stream of documents, for commands that print messages as they arrive
+// rather than all at once. JSON values are printed one after another, and
+// YAML documents are separated with "---".
+func PrintStructuredStream(w io.Writer, output string, message proto.Message) error {
+	if output == OutputYAML {
+		if _, err := io.WriteString(w, "---\n"); err != nil {
+			return err
+		}
+	}
+	return PrintStructured(w, output, message)
+}
+
+// marshalJSON marshals v, a protobuf message or a slice of them, with the
+// protobuf JSON mapping, which is what the messages look like in pipeline
+// specs and the API.
+func marshalJSON(v interface{}) ([]byte, error) {
+	marshaller := &jsonpb.Marshaler{}
+	if message, ok := v.(proto.Message); ok {
+		var buf bytes.Buffer
+		if err := marshaller.Marshal(&buf, message); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+	value := reflect.ValueOf(v)
+	if value.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("can't print %T, it isn't a protobuf message or a slice of them", v)
+	}
+	// A nil slice still becomes [], not null.
+	items := make([]json.RawMessage, 0, value.Len())
+	for i := 0; i < value.Len(); i++ {
+		data, err := marshalJSON(value.Index(i).Interface())
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, data)
+	}
+	return json.Marshal(items)
+}
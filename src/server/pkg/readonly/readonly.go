@@ -0,0 +1,215 @@
+// Package readonly implements the cluster's read-only mode, in which pachd
+// serves reads but rejects every request that would modify the cluster.
+package readonly
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
+	"github.com/pachyderm/pachyderm/src/server/pkg/watch"
+
+	etcd "github.com/coreos/etcd/clientv3"
+	"go.pedge.io/lion/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	// ErrReadOnly is returned for requests that would modify the cluster
+	// while it's in read-only mode.
+	ErrReadOnly = grpc.Errorf(codes.FailedPrecondition, "the cluster is in read-only mode, run `pachctl set-read-only false` to allow writes again")
+
+	// ErrDraining is returned for requests that would modify the cluster
+	// that are made to a pachd that's shutting down. They can be retried
+	// against another pachd.
+	ErrDraining = grpc.Errorf(codes.Unavailable, "pachd is shutting down, retry the request")
+
+	// writeMethods are the methods that modify the cluster.
+	writeMethods = map[string]bool{
+		"/pfs.API/CreateProject":           true,
+		"/pfs.API/DeleteProject":           true,
+		"/pfs.API/CreateRepo":              true,
+		"/pfs.API/DeleteRepo":              true,
+		"/pfs.API/StartCommit":             true,
+		"/pfs.API/FinishCommit":            true,
+		"/pfs.API/DeleteCommit":            true,
+		"/pfs.API/BuildCommit":             true,
+		"/pfs.API/SetBranch":               true,
+		"/pfs.API/DeleteBranch":            true,
+		"/pfs.API/PutFile":                 true,
+		"/pfs.API/DeleteFile":              true,
+		"/pfs.API/DeleteAll":               true,
+		"/pfs.ObjectAPI/PutObject":         true,
+		"/pfs.ObjectAPI/TagObject":         true,
+		"/pfs.ObjectAPI/DeleteObjects":     true,
+		"/pfs.ObjectAPI/DeleteTags":        true,
+		"/pfs.ObjectAPI/Compact":           true,
+		"/pps.API/CreateJob":               true,
+		"/pps.API/DeleteJob":               true,
+		"/pps.API/StopJob":                 true,
+		"/pps.API/RestartDatum":            true,
+		"/pps.API/CreatePipeline":          true,
+		"/pps.API/DeletePipeline":          true,
+		"/pps.API/StartPipeline":           true,
+		"/pps.API/StopPipeline":            true,
+		"/pps.API/RerunPipeline":           true,
+		"/pps.API/DeleteAll":               true,
+		"/pps.API/GarbageCollect":          true,
+		"/admin.API/Restore":               true,
+		"/admin.API/MigrateStorage":        true,
+		"/admin.API/DeleteOrphanedObjects": true,
+	}
+)
+
+// isWrite returns true if a request to method with the request message req
+// would modify the cluster.
+func isWrite(method string, req interface{}) bool {
+	if writeMethods[method] {
+		return true
+	}
+	// fsck only modifies the cluster when it's asked to fix things.
+	if request, ok := req.(*pfs.FsckRequest); ok {
+		return request.Fix
+	}
+	return false
+}
+
+// Gate rejects requests that would modify the cluster while the cluster is
+// in read-only mode. The mode is stored in etcd, so that it applies to
+// every pachd, and each Gate keeps a copy of it up to date. A Gate also
+// rejects new writes once its pachd starts draining, before it shuts down.
+type Gate struct {
+	readOnly bool
+	draining bool
+	mu       sync.RWMutex
+}
+
+// NewGate returns a Gate that follows the read-only mode stored in the etcd
+// at etcdAddress.
+func NewGate(etcdAddress string) *Gate {
+	g := &Gate{}
+	go g.watch(etcdAddress)
+	return g
+}
+
+// ReadOnly returns true if the cluster is in read-only mode.
+func (g *Gate) ReadOnly() bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.readOnly
+}
+
+// Drain rejects the writes that are made from now on, while letting the ones
+// in progress finish, so that pachd can shut down without leaving them half
+// done. Unlike read-only mode it only applies to this pachd.
+func (g *Gate) Drain() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.draining = true
+}
+
+// check returns the error that a new request would be rejected with, if
+// it's a write.
+func (g *Gate) check() error {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	if g.readOnly {
+		return ErrReadOnly
+	}
+	if g.draining {
+		return ErrDraining
+	}
+	return nil
+}
+
+func (g *Gate) setReadOnly(readOnly bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if readOnly != g.readOnly {
+		protolion.Infof("read-only mode set to %t", readOnly)
+	}
+	g.readOnly = readOnly
+}
+
+func (g *Gate) watch(etcdAddress string) {
+	backoff.RetryNotify(func() error {
+		etcdClient, err := etcd.New(etcd.Config{
+			Endpoints:   []string{etcdAddress},
+			DialOptions: client.EtcdDialOptions(),
+		})
+		if err != nil {
+			return fmt.Errorf("error instantiating etcd client: %v", err)
+		}
+		defer etcdClient.Close()
+
+		watcher, err := watch.NewWatcher(context.Background(), etcdClient, client.ReadOnlyKey)
+		if err != nil {
+			return fmt.Errorf("error instantiating watch stream for read-only mode: %v", err)
+		}
+		defer watcher.Close()
+
+		for {
+			ev, ok := <-watcher.Watch()
+			if !ok {
+				return fmt.Errorf("read-only mode watch stream closed unexpectedly")
+			}
+			if ev.Err != nil {
+				return fmt.Errorf("error from read-only mode watch: %v", ev.Err)
+			}
+			if ev.Type == watch.EventDelete {
+				g.setReadOnly(false)
+				continue
+			}
+			readOnly, err := strconv.ParseBool(string(ev.Value))
+			if err != nil {
+				return fmt.Errorf("error parsing read-only mode: %v", err)
+			}
+			g.setReadOnly(readOnly)
+		}
+	}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
+		protolion.Errorf("error watching read-only mode: %v; retrying in %s", err, d)
+		return nil
+	})
+}
+
+// UnaryInterceptor is a grpc.UnaryServerInterceptor which rejects writes
+// while the cluster is read-only or pachd is draining.
+func (g *Gate) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := g.check(); err != nil && isWrite(info.FullMethod, req) {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamInterceptor is a grpc.StreamServerInterceptor which rejects writes
+// while the cluster is read-only or pachd is draining.
+func (g *Gate) StreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := g.check(); err != nil && writeMethods[info.FullMethod] {
+		return err
+	}
+	return handler(srv, &gatedServerStream{ServerStream: ss, g: g, method: info.FullMethod})
+}
+
+// gatedServerStream checks each message it receives, for streaming methods
+// that only write for some requests.
+type gatedServerStream struct {
+	grpc.ServerStream
+	g      *Gate
+	method string
+}
+
+func (s *gatedServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if s.g.ReadOnly() && isWrite(s.method, m) {
+		return ErrReadOnly
+	}
+	return nil
+}
@@ -0,0 +1,646 @@
+// Package s3gateway serves a directory over a subset of the S3 API. Each
+// directory in the root is a bucket, and the files under it are its
+// objects. It's used to expose a worker's inputs and output to user code
+// that's written against S3, so requests aren't authenticated, any
+// credentials are accepted.
+package s3gateway
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.pedge.io/lion/proto"
+)
+
+const (
+	defaultMaxKeys  = 1000
+	timeFormat      = "2006-01-02T15:04:05.000Z"
+	streamingSHA256 = "STREAMING-AWS4-HMAC-SHA256-PAYLOAD"
+)
+
+// server implements the S3 API.
+type server struct {
+	root     string
+	writable map[string]bool
+	// uploadDir holds the parts of multipart uploads.
+	uploadDir string
+	mu        sync.Mutex
+	uploads   map[string]*upload
+}
+
+// upload is an in progress multipart upload.
+type upload struct {
+	bucket string
+	key    string
+	dir    string
+}
+
+// NewServer returns an http.Handler that serves the directories in root as
+// buckets. Only the buckets in writable can be written to.
+func NewServer(root string, writable []string) (http.Handler, error) {
+	uploadDir, err := ioutil.TempDir("", "s3gateway-uploads")
+	if err != nil {
+		return nil, err
+	}
+	s := &server{
+		root:      root,
+		writable:  make(map[string]bool),
+		uploadDir: uploadDir,
+		uploads:   make(map[string]*upload),
+	}
+	for _, bucket := range writable {
+		s.writable[bucket] = true
+	}
+	return s, nil
+}
+
+func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	bucket, key := splitPath(r.URL.Path)
+	if bucket == "" {
+		if r.Method != "GET" {
+			writeError(w, r, errMethodNotAllowed)
+			return
+		}
+		s.listBuckets(w, r)
+		return
+	}
+	if !validName(bucket) {
+		writeError(w, r, errNoSuchBucket)
+		return
+	}
+	if info, err := os.Stat(filepath.Join(s.root, bucket)); err != nil || !info.IsDir() {
+		writeError(w, r, errNoSuchBucket)
+		return
+	}
+	if key == "" {
+		s.serveBucket(w, r, bucket)
+		return
+	}
+	if !validKey(key) {
+		writeError(w, r, &s3Error{http.StatusBadRequest, "InvalidArgument", "invalid key"})
+		return
+	}
+	s.serveObject(w, r, bucket, key)
+}
+
+func (s *server) serveBucket(w http.ResponseWriter, r *http.Request, bucket string) {
+	query := r.URL.Query()
+	switch r.Method {
+	case "HEAD":
+		w.WriteHeader(http.StatusOK)
+	case "GET":
+		if _, ok := query["location"]; ok {
+			writeXML(w, http.StatusOK, &locationConstraint{})
+			return
+		}
+		if _, ok := query["uploads"]; ok {
+			writeError(w, r, errNotImplemented)
+			return
+		}
+		s.listObjects(w, r, bucket)
+	case "POST":
+		if _, ok := query["delete"]; ok {
+			s.deleteObjects(w, r, bucket)
+			return
+		}
+		writeError(w, r, errNotImplemented)
+	case "PUT", "DELETE":
+		// Buckets are the worker's inputs and output, they can't be created
+		// or deleted.
+		writeError(w, r, errAccessDenied)
+	default:
+		writeError(w, r, errMethodNotAllowed)
+	}
+}
+
+func (s *server) serveObject(w http.ResponseWriter, r *http.Request, bucket string, key string) {
+	query := r.URL.Query()
+	uploadID := query.Get("uploadId")
+	switch r.Method {
+	case "GET", "HEAD":
+		s.getObject(w, r, bucket, key)
+		return
+	}
+	if !s.writable[bucket] {
+		writeError(w, r, errAccessDenied)
+		return
+	}
+	switch r.Method {
+	case "PUT":
+		if uploadID != "" {
+			s.uploadPart(w, r, uploadID, query.Get("partNumber"))
+		} else if r.Header.Get("x-amz-copy-source") != "" {
+			s.copyObject(w, r, bucket, key)
+		} else {
+			s.putObject(w, r, bucket, key)
+		}
+	case "POST":
+		if _, ok := query["uploads"]; ok {
+			s.createUpload(w, r, bucket, key)
+		} else if uploadID != "" {
+			s.completeUpload(w, r, bucket, key, uploadID)
+		} else {
+			writeError(w, r, errNotImplemented)
+		}
+	case "DELETE":
+		if uploadID != "" {
+			s.abortUpload(w, r, uploadID)
+			return
+		}
+		if err := s.deleteObject(bucket, key); err != nil {
+			writeError(w, r, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, r, errMethodNotAllowed)
+	}
+}
+
+func (s *server) listBuckets(w http.ResponseWriter, r *http.Request) {
+	infos, err := ioutil.ReadDir(s.root)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	result := &listAllMyBucketsResult{Owner: owner{ID: "pachyderm", DisplayName: "pachyderm"}}
+	for _, info := range infos {
+		if info.IsDir() && validName(info.Name()) {
+			result.Buckets = append(result.Buckets, bucketInfo{
+				Name:         info.Name(),
+				CreationDate: info.ModTime().UTC().Format(timeFormat),
+			})
+		}
+	}
+	writeXML(w, http.StatusOK, result)
+}
+
+func (s *server) getObject(w http.ResponseWriter, r *http.Request, bucket string, key string) {
+	filePath := s.filePath(bucket, key)
+	info, err := os.Stat(filePath)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if info.IsDir() {
+		// Keys that end in / are directory markers.
+		if strings.HasSuffix(key, "/") {
+			setObjectHeaders(w, info)
+			w.Header().Set("Content-Length", "0")
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		writeError(w, r, errNoSuchKey)
+		return
+	}
+	f, err := os.Open(filePath)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	defer f.Close()
+	setObjectHeaders(w, info)
+	// ServeContent handles Range and conditional requests.
+	http.ServeContent(w, r, "", info.ModTime(), f)
+}
+
+func (s *server) putObject(w http.ResponseWriter, r *http.Request, bucket string, key string) {
+	etag, err := s.writeFile(s.filePath(bucket, key), key, requestBody(r))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.Header().Set("ETag", etag)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *server) copyObject(w http.ResponseWriter, r *http.Request, bucket string, key string) {
+	source := r.Header.Get("x-amz-copy-source")
+	if i := strings.Index(source, "?"); i >= 0 {
+		// Versions aren't supported.
+		source = source[:i]
+	}
+	sourceBucket, sourceKey := splitPath(source)
+	if !validName(sourceBucket) || !validKey(sourceKey) {
+		writeError(w, r, errNoSuchKey)
+		return
+	}
+	f, err := os.Open(s.filePath(sourceBucket, sourceKey))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	defer f.Close()
+	etag, err := s.writeFile(s.filePath(bucket, key), key, f)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	writeXML(w, http.StatusOK, &copyObjectResult{
+		LastModified: time.Now().UTC().Format(timeFormat),
+		ETag:         etag,
+	})
+}
+
+// writeFile writes the content of r to filePath and returns its ETag.
+func (s *server) writeFile(filePath string, key string, r io.Reader) (string, error) {
+	if strings.HasSuffix(key, "/") {
+		// A directory marker.
+		if _, err := io.Copy(ioutil.Discard, r); err != nil {
+			return "", err
+		}
+		sum := md5.Sum(nil)
+		return `"` + hex.EncodeToString(sum[:]) + `"`, os.MkdirAll(filePath, 0777)
+	}
+	if err := os.MkdirAll(filepath.Dir(filePath), 0777); err != nil {
+		return "", err
+	}
+	f, err := os.Create(filePath)
+	if err != nil {
+		return "", err
+	}
+	hash := md5.New()
+	if _, err := io.Copy(io.MultiWriter(f, hash), r); err != nil {
+		f.Close()
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		return "", err
+	}
+	return `"` + hex.EncodeToString(hash.Sum(nil)) + `"`, nil
+}
+
+func (s *server) deleteObject(bucket string, key string) error {
+	filePath := s.filePath(bucket, key)
+	info, err := os.Stat(filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			// Deleting a key that doesn't exist succeeds.
+			return nil
+		}
+		return err
+	}
+	if info.IsDir() {
+		if !strings.HasSuffix(key, "/") {
+			return nil
+		}
+		// Only empty directories can be deleted through their markers,
+		// like S3 where deleting a marker leaves the keys under it.
+		if err := os.Remove(filePath); err != nil && !isNotEmptyErr(err) {
+			return err
+		}
+		return nil
+	}
+	return os.Remove(filePath)
+}
+
+func (s *server) deleteObjects(w http.ResponseWriter, r *http.Request, bucket string) {
+	if !s.writable[bucket] {
+		writeError(w, r, errAccessDenied)
+		return
+	}
+	var request deleteRequest
+	if err := xml.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeError(w, r, &s3Error{http.StatusBadRequest, "MalformedXML", err.Error()})
+		return
+	}
+	result := &deleteResult{}
+	for _, object := range request.Objects {
+		if !validKey(object.Key) {
+			result.Errors = append(result.Errors, deleteError{Key: object.Key, Code: "InvalidArgument", Message: "invalid key"})
+			continue
+		}
+		if err := s.deleteObject(bucket, object.Key); err != nil {
+			result.Errors = append(result.Errors, deleteError{Key: object.Key, Code: "InternalError", Message: err.Error()})
+			continue
+		}
+		if !request.Quiet {
+			result.Deleted = append(result.Deleted, deletedObject{Key: object.Key})
+		}
+	}
+	writeXML(w, http.StatusOK, result)
+}
+
+func (s *server) filePath(bucket string, key string) string {
+	return filepath.Join(s.root, bucket, filepath.FromSlash(key))
+}
+
+// requestBody returns the body of a PUT, decoding it if the client signed
+// it in chunks.
+func requestBody(r *http.Request) io.Reader {
+	if r.Header.Get("x-amz-content-sha256") == streamingSHA256 ||
+		strings.Contains(r.Header.Get("Content-Encoding"), "aws-chunked") {
+		return newChunkedReader(r.Body)
+	}
+	return r.Body
+}
+
+func setObjectHeaders(w http.ResponseWriter, info os.FileInfo) {
+	w.Header().Set("Last-Modified", info.ModTime().UTC().Format(http.TimeFormat))
+	w.Header().Set("ETag", fileETag(info))
+	w.Header().Set("Content-Type", "application/octet-stream")
+}
+
+// fileETag returns an ETag for a file that changes when it does. S3's
+// ETags are usually MD5 sums, but we don't want to read every file to
+// list it.
+func fileETag(info os.FileInfo) string {
+	return fmt.Sprintf(`"%x-%x"`, info.ModTime().UnixNano(), info.Size())
+}
+
+// splitPath splits a request path into a bucket and a key.
+func splitPath(p string) (string, string) {
+	p = strings.TrimPrefix(p, "/")
+	parts := strings.SplitN(p, "/", 2)
+	if len(parts) == 1 {
+		return parts[0], ""
+	}
+	return parts[0], parts[1]
+}
+
+// validName returns true if name can be a bucket, which excludes hidden
+// directories.
+func validName(name string) bool {
+	return name != "" && !strings.HasPrefix(name, ".") && !strings.ContainsAny(name, `/\`)
+}
+
+// validKey returns true if key stays within its bucket.
+func validKey(key string) bool {
+	if key == "" || strings.HasPrefix(key, "/") {
+		return false
+	}
+	for _, part := range strings.Split(key, "/") {
+		if part == ".." || part == "." {
+			return false
+		}
+	}
+	return path.Clean("/"+key) != "/"
+}
+
+func isNotEmptyErr(err error) bool {
+	return strings.Contains(err.Error(), "directory not empty")
+}
+
+func parseMaxKeys(value string) (int, error) {
+	if value == "" {
+		return defaultMaxKeys, nil
+	}
+	maxKeys, err := strconv.Atoi(value)
+	if err != nil || maxKeys < 0 {
+		return 0, &s3Error{http.StatusBadRequest, "InvalidArgument", "invalid max-keys"}
+	}
+	if maxKeys > defaultMaxKeys {
+		maxKeys = defaultMaxKeys
+	}
+	return maxKeys, nil
+}
+
+// listObjects implements ListObjects and ListObjectsV2.
+func (s *server) listObjects(w http.ResponseWriter, r *http.Request, bucket string) {
+	query := r.URL.Query()
+	prefix := query.Get("prefix")
+	delimiter := query.Get("delimiter")
+	maxKeys, err := parseMaxKeys(query.Get("max-keys"))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	v2 := query.Get("list-type") == "2"
+	marker := query.Get("marker")
+	if v2 {
+		marker = query.Get("start-after")
+		if token := query.Get("continuation-token"); token != "" {
+			marker = token
+		}
+	}
+	keys, infos, err := s.keys(bucket)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	result := &listBucketResult{
+		Name:      bucket,
+		Prefix:    prefix,
+		Delimiter: delimiter,
+		MaxKeys:   maxKeys,
+	}
+	seenPrefixes := make(map[string]bool)
+	var last string
+	for _, key := range keys {
+		if !strings.HasPrefix(key, prefix) || key <= marker {
+			continue
+		}
+		commonPrefix := ""
+		if delimiter != "" {
+			if i := strings.Index(key[len(prefix):], delimiter); i >= 0 {
+				commonPrefix = key[:len(prefix)+i+len(delimiter)]
+			}
+		}
+		if commonPrefix != "" && (seenPrefixes[commonPrefix] || commonPrefix <= marker) {
+			continue
+		}
+		if len(result.Contents)+len(result.CommonPrefixes) >= maxKeys {
+			result.IsTruncated = true
+			break
+		}
+		if commonPrefix != "" {
+			seenPrefixes[commonPrefix] = true
+			result.CommonPrefixes = append(result.CommonPrefixes, commonPrefixInfo{Prefix: commonPrefix})
+			last = commonPrefix
+			continue
+		}
+		info := infos[key]
+		size := info.Size()
+		if info.IsDir() {
+			size = 0
+		}
+		result.Contents = append(result.Contents, object{
+			Key:          key,
+			LastModified: info.ModTime().UTC().Format(timeFormat),
+			ETag:         fileETag(info),
+			Size:         size,
+			StorageClass: "STANDARD",
+		})
+		last = key
+	}
+	if v2 {
+		result.KeyCount = len(result.Contents) + len(result.CommonPrefixes)
+		result.ContinuationToken = query.Get("continuation-token")
+		result.StartAfter = query.Get("start-after")
+		if result.IsTruncated {
+			result.NextContinuationToken = last
+		}
+		writeXML(w, http.StatusOK, &listBucketResultV2{listBucketResult: result})
+		return
+	}
+	result.Marker = marker
+	if result.IsTruncated && delimiter != "" {
+		result.NextMarker = last
+	}
+	writeXML(w, http.StatusOK, &listBucketResultV1{listBucketResult: result})
+}
+
+// keys returns the keys in bucket in sorted order. Empty directories are
+// included as directory markers.
+func (s *server) keys(bucket string) ([]string, map[string]os.FileInfo, error) {
+	bucketPath := filepath.Join(s.root, bucket)
+	infos := make(map[string]os.FileInfo)
+	var keys []string
+	if err := filepath.Walk(bucketPath, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if filePath == bucketPath {
+			return nil
+		}
+		relPath, err := filepath.Rel(bucketPath, filePath)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(relPath)
+		if info.Mode()&os.ModeSymlink != 0 {
+			// Inputs may be symlinks, list what they point to.
+			if info, err = os.Stat(filePath); err != nil {
+				return err
+			}
+		}
+		if info.IsDir() {
+			entries, err := ioutil.ReadDir(filePath)
+			if err != nil {
+				return err
+			}
+			if len(entries) != 0 {
+				return nil
+			}
+			key += "/"
+		}
+		keys = append(keys, key)
+		infos[key] = info
+		return nil
+	}); err != nil {
+		return nil, nil, err
+	}
+	// Walk's order isn't S3's, which sorts "a-b" before "a/b".
+	sort.Strings(keys)
+	return keys, infos, nil
+}
+
+func (s *server) createUpload(w http.ResponseWriter, r *http.Request, bucket string, key string) {
+	dir, err := ioutil.TempDir(s.uploadDir, "")
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	uploadID := filepath.Base(dir)
+	s.mu.Lock()
+	s.uploads[uploadID] = &upload{bucket: bucket, key: key, dir: dir}
+	s.mu.Unlock()
+	writeXML(w, http.StatusOK, &initiateMultipartUploadResult{
+		Bucket:   bucket,
+		Key:      key,
+		UploadID: uploadID,
+	})
+}
+
+func (s *server) getUpload(uploadID string) (*upload, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	u, ok := s.uploads[uploadID]
+	if !ok {
+		return nil, errNoSuchUpload
+	}
+	return u, nil
+}
+
+func (s *server) uploadPart(w http.ResponseWriter, r *http.Request, uploadID string, partNumber string) {
+	u, err := s.getUpload(uploadID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	n, err := strconv.Atoi(partNumber)
+	if err != nil || n < 1 || n > 10000 {
+		writeError(w, r, &s3Error{http.StatusBadRequest, "InvalidArgument", "invalid part number"})
+		return
+	}
+	etag, err := s.writeFile(filepath.Join(u.dir, strconv.Itoa(n)), "", requestBody(r))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.Header().Set("ETag", etag)
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *server) completeUpload(w http.ResponseWriter, r *http.Request, bucket string, key string, uploadID string) {
+	u, err := s.getUpload(uploadID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if u.bucket != bucket || u.key != key {
+		writeError(w, r, errNoSuchUpload)
+		return
+	}
+	var request completeMultipartUpload
+	if err := xml.NewDecoder(r.Body).Decode(&request); err != nil {
+		writeError(w, r, &s3Error{http.StatusBadRequest, "MalformedXML", err.Error()})
+		return
+	}
+	var readers []io.Reader
+	for _, part := range request.Parts {
+		f, err := os.Open(filepath.Join(u.dir, strconv.Itoa(part.PartNumber)))
+		if err != nil {
+			writeError(w, r, &s3Error{http.StatusBadRequest, "InvalidPart", fmt.Sprintf("part %d wasn't uploaded", part.PartNumber)})
+			return
+		}
+		defer f.Close()
+		readers = append(readers, f)
+	}
+	etag, err := s.writeFile(s.filePath(bucket, key), key, io.MultiReader(readers...))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	s.removeUpload(uploadID)
+	writeXML(w, http.StatusOK, &completeMultipartUploadResult{
+		Location: r.URL.Path,
+		Bucket:   bucket,
+		Key:      key,
+		ETag:     etag,
+	})
+}
+
+func (s *server) abortUpload(w http.ResponseWriter, r *http.Request, uploadID string) {
+	if _, err := s.getUpload(uploadID); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	s.removeUpload(uploadID)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *server) removeUpload(uploadID string) {
+	s.mu.Lock()
+	u := s.uploads[uploadID]
+	delete(s.uploads, uploadID)
+	s.mu.Unlock()
+	if u != nil {
+		if err := os.RemoveAll(u.dir); err != nil {
+			protolion.Errorf("error removing upload %s: %v", uploadID, err)
+		}
+	}
+}
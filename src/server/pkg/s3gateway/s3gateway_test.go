@@ -0,0 +1,319 @@
+package s3gateway
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+// newTestServer serves a root with an "in" bucket holding files, and an
+// empty "out" bucket that can be written to.
+func newTestServer(t *testing.T, files map[string]string) (*httptest.Server, string) {
+	root, err := ioutil.TempDir("", "s3gateway-test")
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "out"), 0777))
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "in"), 0777))
+	for name, content := range files {
+		filePath := filepath.Join(root, "in", filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(filePath), 0777))
+		require.NoError(t, ioutil.WriteFile(filePath, []byte(content), 0666))
+	}
+	handler, err := NewServer(root, []string{"out"})
+	require.NoError(t, err)
+	return httptest.NewServer(handler), root
+}
+
+func do(t *testing.T, s *httptest.Server, method string, path string, header map[string]string, body string) (*http.Response, string) {
+	req, err := http.NewRequest(method, s.URL+path, strings.NewReader(body))
+	require.NoError(t, err)
+	for key, value := range header {
+		req.Header.Set(key, value)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp, string(data)
+}
+
+func requireError(t *testing.T, s *httptest.Server, method string, path string, status int, code string) {
+	resp, body := do(t, s, method, path, nil, "")
+	require.Equal(t, status, resp.StatusCode)
+	var e errorResponse
+	require.NoError(t, xml.Unmarshal([]byte(body), &e))
+	require.Equal(t, code, e.Code)
+}
+
+// listResult is the union of both versions of ListObjects' response.
+type listResult struct {
+	IsTruncated           bool
+	Marker                string
+	NextMarker            string
+	KeyCount              int
+	NextContinuationToken string
+	Contents              []object
+	CommonPrefixes        []commonPrefixInfo
+}
+
+func list(t *testing.T, s *httptest.Server, query string) *listResult {
+	resp, body := do(t, s, "GET", "/in?"+query, nil, "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	result := &listResult{}
+	require.NoError(t, xml.Unmarshal([]byte(body), result))
+	return result
+}
+
+func (l *listResult) keys() []string {
+	var keys []string
+	for _, o := range l.Contents {
+		keys = append(keys, o.Key)
+	}
+	for _, p := range l.CommonPrefixes {
+		keys = append(keys, p.Prefix)
+	}
+	return keys
+}
+
+func TestListBuckets(t *testing.T) {
+	s, root := newTestServer(t, nil)
+	defer s.Close()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, ".hidden"), 0777))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(root, "file"), nil, 0666))
+	resp, body := do(t, s, "GET", "/", nil, "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var result listAllMyBucketsResult
+	require.NoError(t, xml.Unmarshal([]byte(body), &result))
+	var names []string
+	for _, b := range result.Buckets {
+		names = append(names, b.Name)
+	}
+	require.Equal(t, []string{"in", "out"}, names)
+}
+
+func TestListObjects(t *testing.T) {
+	s, root := newTestServer(t, map[string]string{
+		"a.txt":   "1",
+		"a-b":     "22",
+		"a/b":     "333",
+		"a/c":     "4444",
+		"b/c/d":   "5",
+		"z":       "",
+		"dir/x":   "",
+		"dir/y/z": "",
+	})
+	defer s.Close()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "in", "empty"), 0777))
+
+	all := []string{"a-b", "a.txt", "a/b", "a/c", "b/c/d", "dir/x", "dir/y/z", "empty/", "z"}
+	result := list(t, s, "")
+	require.Equal(t, all, result.keys())
+	require.False(t, result.IsTruncated)
+	require.Equal(t, int64(3), result.Contents[2].Size)
+
+	require.Equal(t, []string{"a/b", "a/c"}, list(t, s, "prefix=a/").keys())
+	result = list(t, s, "delimiter=/")
+	require.Equal(t, []string{"a-b", "a.txt", "z", "a/", "b/", "dir/", "empty/"}, result.keys())
+
+	// Page through the keys, one at a time, with both versions.
+	var keys []string
+	marker := ""
+	for {
+		result := list(t, s, "max-keys=1&marker="+marker)
+		require.Equal(t, marker, result.Marker)
+		keys = append(keys, result.keys()...)
+		if !result.IsTruncated {
+			break
+		}
+		// Without a delimiter, the next marker is the last key.
+		require.Equal(t, "", result.NextMarker)
+		marker = keys[len(keys)-1]
+	}
+	require.Equal(t, all, keys)
+
+	keys = nil
+	token := ""
+	for {
+		result := list(t, s, "list-type=2&max-keys=2&continuation-token="+token)
+		require.Equal(t, len(result.keys()), result.KeyCount)
+		keys = append(keys, result.keys()...)
+		if !result.IsTruncated {
+			require.Equal(t, "", result.NextContinuationToken)
+			break
+		}
+		token = result.NextContinuationToken
+	}
+	require.Equal(t, all, keys)
+
+	// Common prefixes are only listed once across pages.
+	keys = nil
+	marker = ""
+	for {
+		result := list(t, s, "delimiter=/&max-keys=2&marker="+marker)
+		keys = append(keys, result.keys()...)
+		if !result.IsTruncated {
+			break
+		}
+		marker = result.NextMarker
+	}
+	sort.Strings(keys)
+	require.Equal(t, []string{"a-b", "a.txt", "a/", "b/", "dir/", "empty/", "z"}, keys)
+
+	require.Equal(t, []string{"b/c/d", "dir/x"}, list(t, s, "list-type=2&start-after=a/c&max-keys=2").keys())
+	require.Equal(t, 0, len(list(t, s, "max-keys=0").keys()))
+	requireError(t, s, "GET", "/in?max-keys=-1", http.StatusBadRequest, "InvalidArgument")
+	requireError(t, s, "GET", "/missing", http.StatusNotFound, "NoSuchBucket")
+}
+
+func TestGetObject(t *testing.T) {
+	s, _ := newTestServer(t, map[string]string{"dir/file": "0123456789"})
+	defer s.Close()
+
+	resp, body := do(t, s, "GET", "/in/dir/file", nil, "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "0123456789", body)
+	etag := resp.Header.Get("ETag")
+	require.NotEqual(t, "", etag)
+	require.NotEqual(t, "", resp.Header.Get("Last-Modified"))
+
+	resp, body = do(t, s, "HEAD", "/in/dir/file", nil, "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "", body)
+	require.Equal(t, "10", resp.Header.Get("Content-Length"))
+	require.Equal(t, etag, resp.Header.Get("ETag"))
+
+	for rangeHeader, expected := range map[string]string{
+		"bytes=2-4": "234",
+		"bytes=7-":  "789",
+		"bytes=-2":  "89",
+	} {
+		resp, body = do(t, s, "GET", "/in/dir/file", map[string]string{"Range": rangeHeader}, "")
+		require.Equal(t, http.StatusPartialContent, resp.StatusCode)
+		require.Equal(t, expected, body)
+	}
+	resp, _ = do(t, s, "GET", "/in/dir/file", map[string]string{"Range": "bytes=20-"}, "")
+	require.Equal(t, http.StatusRequestedRangeNotSatisfiable, resp.StatusCode)
+	resp, _ = do(t, s, "GET", "/in/dir/file", map[string]string{"If-None-Match": etag}, "")
+	require.Equal(t, http.StatusNotModified, resp.StatusCode)
+
+	// Directory markers.
+	resp, body = do(t, s, "GET", "/in/dir/", nil, "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "", body)
+	requireError(t, s, "GET", "/in/dir", http.StatusNotFound, "NoSuchKey")
+	requireError(t, s, "GET", "/in/missing", http.StatusNotFound, "NoSuchKey")
+	resp, body = do(t, s, "HEAD", "/in/missing", nil, "")
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+	require.Equal(t, "", body)
+}
+
+func TestInvalidPaths(t *testing.T) {
+	s, root := newTestServer(t, map[string]string{"file": "data"})
+	defer s.Close()
+	require.NoError(t, ioutil.WriteFile(filepath.Join(root, "secret"), []byte("secret"), 0666))
+	require.NoError(t, os.MkdirAll(filepath.Join(root, ".hidden"), 0777))
+
+	requireError(t, s, "GET", "/in/../secret", http.StatusBadRequest, "InvalidArgument")
+	requireError(t, s, "GET", "/in/./file", http.StatusBadRequest, "InvalidArgument")
+	requireError(t, s, "GET", "/in/a/../../secret", http.StatusBadRequest, "InvalidArgument")
+	requireError(t, s, "GET", "/in//file", http.StatusBadRequest, "InvalidArgument")
+	requireError(t, s, "PUT", "/out/../secret", http.StatusBadRequest, "InvalidArgument")
+	requireError(t, s, "GET", "/..", http.StatusNotFound, "NoSuchBucket")
+	requireError(t, s, "GET", "/.hidden", http.StatusNotFound, "NoSuchBucket")
+	requireError(t, s, "GET", `/in\..\secret`, http.StatusNotFound, "NoSuchBucket")
+	copyHeader := map[string]string{"x-amz-copy-source": "/in/../secret"}
+	resp, _ := do(t, s, "PUT", "/out/copy", copyHeader, "")
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	for _, name := range []string{"", ".", "..", ".hidden", "a/b", `a\b`} {
+		require.False(t, validName(name), name)
+	}
+	require.True(t, validName("bucket"))
+	for _, key := range []string{"", "/a", "..", "../a", "a/..", "a/../b", ".", "a/./b"} {
+		require.False(t, validKey(key), key)
+	}
+	for _, key := range []string{"a", "a/b", "a/", "..a", "a..b", "a/.hidden"} {
+		require.True(t, validKey(key), key)
+	}
+	data, err := ioutil.ReadFile(filepath.Join(root, "secret"))
+	require.NoError(t, err)
+	require.Equal(t, "secret", string(data))
+}
+
+func TestPutObject(t *testing.T) {
+	s, root := newTestServer(t, map[string]string{"file": "data"})
+	defer s.Close()
+
+	resp, _ := do(t, s, "PUT", "/out/dir/file", nil, "hello")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, `"5d41402abc4b2a76b9719d911017c592"`, resp.Header.Get("ETag"))
+	_, body := do(t, s, "GET", "/out/dir/file", nil, "")
+	require.Equal(t, "hello", body)
+
+	// A body signed in chunks.
+	chunked := "5;chunk-signature=abc\r\nhello\r\n6;chunk-signature=def\r\n world\r\n0;chunk-signature=ghi\r\n\r\n"
+	resp, _ = do(t, s, "PUT", "/out/chunked", map[string]string{"x-amz-content-sha256": streamingSHA256}, chunked)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	_, body = do(t, s, "GET", "/out/chunked", nil, "")
+	require.Equal(t, "hello world", body)
+
+	resp, _ = do(t, s, "PUT", "/out/copy", map[string]string{"x-amz-copy-source": "/in/file"}, "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	_, body = do(t, s, "GET", "/out/copy", nil, "")
+	require.Equal(t, "data", body)
+
+	resp, _ = do(t, s, "DELETE", "/out/dir/file", nil, "")
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+	_, err := os.Stat(filepath.Join(root, "out", "dir", "file"))
+	require.True(t, os.IsNotExist(err))
+
+	// Only the output bucket can be written to.
+	requireError(t, s, "PUT", "/in/file", http.StatusForbidden, "AccessDenied")
+	requireError(t, s, "DELETE", "/in/file", http.StatusForbidden, "AccessDenied")
+	requireError(t, s, "PUT", "/out", http.StatusForbidden, "AccessDenied")
+	_, body = do(t, s, "GET", "/in/file", nil, "")
+	require.Equal(t, "data", body)
+}
+
+func TestMultipartUpload(t *testing.T) {
+	s, _ := newTestServer(t, nil)
+	defer s.Close()
+
+	_, body := do(t, s, "POST", "/out/object?uploads", nil, "")
+	var initiate initiateMultipartUploadResult
+	require.NoError(t, xml.Unmarshal([]byte(body), &initiate))
+	require.NotEqual(t, "", initiate.UploadID)
+	query := "?uploadId=" + initiate.UploadID
+	// Parts are uploaded out of order.
+	for _, n := range []int{2, 1} {
+		resp, _ := do(t, s, "PUT", fmt.Sprintf("/out/object%s&partNumber=%d", query, n), nil, fmt.Sprintf("part%d,", n))
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+	requireError(t, s, "PUT", "/out/object"+query+"&partNumber=0", http.StatusBadRequest, "InvalidArgument")
+	complete := "<CompleteMultipartUpload><Part><PartNumber>1</PartNumber></Part><Part><PartNumber>2</PartNumber></Part></CompleteMultipartUpload>"
+	resp, _ := do(t, s, "POST", "/out/object"+query, nil, complete)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	_, body = do(t, s, "GET", "/out/object", nil, "")
+	require.Equal(t, "part1,part2,", body)
+	requireError(t, s, "POST", "/out/object"+query, http.StatusNotFound, "NoSuchUpload")
+}
+
+func TestChunkedReader(t *testing.T) {
+	data, err := ioutil.ReadAll(newChunkedReader(strings.NewReader("3;chunk-signature=x\r\nabc\r\n0;chunk-signature=y\r\n\r\n")))
+	require.NoError(t, err)
+	require.Equal(t, "abc", string(data))
+	_, err = ioutil.ReadAll(newChunkedReader(strings.NewReader("5;chunk-signature=x\r\nabc")))
+	require.Equal(t, io.ErrUnexpectedEOF, err)
+	_, err = ioutil.ReadAll(newChunkedReader(strings.NewReader("zz;chunk-signature=x\r\n")))
+	require.YesError(t, err)
+}
@@ -0,0 +1,301 @@
+package s3gateway
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"go.pedge.io/lion/proto"
+)
+
+type owner struct {
+	ID          string `xml:"ID"`
+	DisplayName string `xml:"DisplayName"`
+}
+
+type bucketInfo struct {
+	Name         string `xml:"Name"`
+	CreationDate string `xml:"CreationDate"`
+}
+
+type listAllMyBucketsResult struct {
+	XMLName xml.Name     `xml:"http://s3.amazonaws.com/doc/2006-03-01/ ListAllMyBucketsResult"`
+	Owner   owner        `xml:"Owner"`
+	Buckets []bucketInfo `xml:"Buckets>Bucket"`
+}
+
+type locationConstraint struct {
+	XMLName xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ LocationConstraint"`
+}
+
+type object struct {
+	Key          string `xml:"Key"`
+	LastModified string `xml:"LastModified"`
+	ETag         string `xml:"ETag"`
+	Size         int64  `xml:"Size"`
+	StorageClass string `xml:"StorageClass"`
+}
+
+type commonPrefixInfo struct {
+	Prefix string `xml:"Prefix"`
+}
+
+// listBucketResult has the fields of both versions of ListObjects'
+// response, listBucketResultV1 and listBucketResultV2 pick the ones that
+// apply.
+type listBucketResult struct {
+	Name                  string
+	Prefix                string
+	Delimiter             string
+	MaxKeys               int
+	IsTruncated           bool
+	Marker                string
+	NextMarker            string
+	KeyCount              int
+	ContinuationToken     string
+	NextContinuationToken string
+	StartAfter            string
+	Contents              []object
+	CommonPrefixes        []commonPrefixInfo
+}
+
+type listBucketResultV1 struct {
+	*listBucketResult
+}
+
+func (l *listBucketResultV1) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.Encode(&struct {
+		XMLName        xml.Name           `xml:"http://s3.amazonaws.com/doc/2006-03-01/ ListBucketResult"`
+		Name           string             `xml:"Name"`
+		Prefix         string             `xml:"Prefix"`
+		Marker         string             `xml:"Marker"`
+		NextMarker     string             `xml:"NextMarker,omitempty"`
+		MaxKeys        int                `xml:"MaxKeys"`
+		Delimiter      string             `xml:"Delimiter,omitempty"`
+		IsTruncated    bool               `xml:"IsTruncated"`
+		Contents       []object           `xml:"Contents"`
+		CommonPrefixes []commonPrefixInfo `xml:"CommonPrefixes"`
+	}{
+		Name:           l.Name,
+		Prefix:         l.Prefix,
+		Marker:         l.Marker,
+		NextMarker:     l.NextMarker,
+		MaxKeys:        l.MaxKeys,
+		Delimiter:      l.Delimiter,
+		IsTruncated:    l.IsTruncated,
+		Contents:       l.Contents,
+		CommonPrefixes: l.CommonPrefixes,
+	})
+}
+
+type listBucketResultV2 struct {
+	*listBucketResult
+}
+
+func (l *listBucketResultV2) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.Encode(&struct {
+		XMLName               xml.Name           `xml:"http://s3.amazonaws.com/doc/2006-03-01/ ListBucketResult"`
+		Name                  string             `xml:"Name"`
+		Prefix                string             `xml:"Prefix"`
+		StartAfter            string             `xml:"StartAfter,omitempty"`
+		ContinuationToken     string             `xml:"ContinuationToken,omitempty"`
+		NextContinuationToken string             `xml:"NextContinuationToken,omitempty"`
+		KeyCount              int                `xml:"KeyCount"`
+		MaxKeys               int                `xml:"MaxKeys"`
+		Delimiter             string             `xml:"Delimiter,omitempty"`
+		IsTruncated           bool               `xml:"IsTruncated"`
+		Contents              []object           `xml:"Contents"`
+		CommonPrefixes        []commonPrefixInfo `xml:"CommonPrefixes"`
+	}{
+		Name:                  l.Name,
+		Prefix:                l.Prefix,
+		StartAfter:            l.StartAfter,
+		ContinuationToken:     l.ContinuationToken,
+		NextContinuationToken: l.NextContinuationToken,
+		KeyCount:              l.KeyCount,
+		MaxKeys:               l.MaxKeys,
+		Delimiter:             l.Delimiter,
+		IsTruncated:           l.IsTruncated,
+		Contents:              l.Contents,
+		CommonPrefixes:        l.CommonPrefixes,
+	})
+}
+
+type copyObjectResult struct {
+	XMLName      xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ CopyObjectResult"`
+	LastModified string   `xml:"LastModified"`
+	ETag         string   `xml:"ETag"`
+}
+
+type deleteRequest struct {
+	Quiet   bool `xml:"Quiet"`
+	Objects []struct {
+		Key string `xml:"Key"`
+	} `xml:"Object"`
+}
+
+type deletedObject struct {
+	Key string `xml:"Key"`
+}
+
+type deleteError struct {
+	Key     string `xml:"Key"`
+	Code    string `xml:"Code"`
+	Message string `xml:"Message"`
+}
+
+type deleteResult struct {
+	XMLName xml.Name        `xml:"http://s3.amazonaws.com/doc/2006-03-01/ DeleteResult"`
+	Deleted []deletedObject `xml:"Deleted"`
+	Errors  []deleteError   `xml:"Error"`
+}
+
+type initiateMultipartUploadResult struct {
+	XMLName  xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ InitiateMultipartUploadResult"`
+	Bucket   string   `xml:"Bucket"`
+	Key      string   `xml:"Key"`
+	UploadID string   `xml:"UploadId"`
+}
+
+type completeMultipartUpload struct {
+	Parts []struct {
+		PartNumber int    `xml:"PartNumber"`
+		ETag       string `xml:"ETag"`
+	} `xml:"Part"`
+}
+
+type completeMultipartUploadResult struct {
+	XMLName  xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ CompleteMultipartUploadResult"`
+	Location string   `xml:"Location"`
+	Bucket   string   `xml:"Bucket"`
+	Key      string   `xml:"Key"`
+	ETag     string   `xml:"ETag"`
+}
+
+// s3Error is an error response.
+type s3Error struct {
+	status  int
+	code    string
+	message string
+}
+
+func (e *s3Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.code, e.message)
+}
+
+var (
+	errNoSuchBucket     = &s3Error{http.StatusNotFound, "NoSuchBucket", "The specified bucket does not exist"}
+	errNoSuchKey        = &s3Error{http.StatusNotFound, "NoSuchKey", "The specified key does not exist"}
+	errNoSuchUpload     = &s3Error{http.StatusNotFound, "NoSuchUpload", "The specified upload does not exist"}
+	errAccessDenied     = &s3Error{http.StatusForbidden, "AccessDenied", "Only the output bucket can be written to"}
+	errMethodNotAllowed = &s3Error{http.StatusMethodNotAllowed, "MethodNotAllowed", "The method is not allowed against this resource"}
+	errNotImplemented   = &s3Error{http.StatusNotImplemented, "NotImplemented", "The request is not supported"}
+)
+
+type errorResponse struct {
+	XMLName  xml.Name `xml:"Error"`
+	Code     string   `xml:"Code"`
+	Message  string   `xml:"Message"`
+	Resource string   `xml:"Resource"`
+}
+
+func writeError(w http.ResponseWriter, r *http.Request, err error) {
+	e, ok := err.(*s3Error)
+	if !ok {
+		switch {
+		case os.IsNotExist(err):
+			e = errNoSuchKey
+		case os.IsPermission(err):
+			e = &s3Error{http.StatusForbidden, "AccessDenied", err.Error()}
+		default:
+			protolion.Errorf("s3 gateway: error serving %s %s: %v", r.Method, r.URL.Path, err)
+			e = &s3Error{http.StatusInternalServerError, "InternalError", err.Error()}
+		}
+	}
+	if r.Method == "HEAD" {
+		// HEAD responses don't have a body.
+		w.WriteHeader(e.status)
+		return
+	}
+	writeXML(w, e.status, &errorResponse{Code: e.code, Message: e.message, Resource: r.URL.Path})
+}
+
+func writeXML(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return
+	}
+	if err := xml.NewEncoder(w).Encode(v); err != nil {
+		protolion.Errorf("s3 gateway: error writing response: %v", err)
+	}
+}
+
+// chunkedReader decodes a body that was uploaded with chunked signing
+// ("aws-chunked"), which is a series of
+// <hex size>;chunk-signature=<signature>\r\n<data>\r\n ending with an empty
+// chunk. The signatures aren't checked.
+type chunkedReader struct {
+	r         *bufio.Reader
+	remaining int64
+	done      bool
+}
+
+func newChunkedReader(r io.Reader) io.Reader {
+	return &chunkedReader{r: bufio.NewReader(r)}
+}
+
+func (c *chunkedReader) Read(p []byte) (int, error) {
+	for c.remaining == 0 {
+		if c.done {
+			return 0, io.EOF
+		}
+		if err := c.nextChunk(); err != nil {
+			return 0, err
+		}
+	}
+	if int64(len(p)) > c.remaining {
+		p = p[:c.remaining]
+	}
+	n, err := c.r.Read(p)
+	c.remaining -= int64(n)
+	if c.remaining == 0 && err == nil {
+		// The data is followed by \r\n.
+		if _, err := c.r.Discard(2); err != nil {
+			return n, err
+		}
+	}
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return n, err
+}
+
+func (c *chunkedReader) nextChunk() error {
+	line, err := c.r.ReadString('\n')
+	if err != nil {
+		if err == io.EOF {
+			return io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	line = strings.TrimSpace(line)
+	if i := strings.Index(line, ";"); i >= 0 {
+		line = line[:i]
+	}
+	size, err := strconv.ParseInt(line, 16, 64)
+	if err != nil || size < 0 {
+		return fmt.Errorf("invalid chunk size %q", line)
+	}
+	if size == 0 {
+		c.done = true
+		return nil
+	}
+	c.remaining = size
+	return nil
+}
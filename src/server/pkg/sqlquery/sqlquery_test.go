@@ -0,0 +1,152 @@
+package sqlquery
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+// testDriver is a database/sql driver whose queries all return testColumns
+// and testRows, except for testErrorQuery which fails.
+type testDriver struct{}
+
+const testErrorQuery = "SELECT error"
+
+var (
+	testColumns = []string{"id", "name"}
+	testRows    = [][]driver.Value{
+		{int64(1), "alice"},
+		{int64(2), nil},
+		{int64(3), []byte("carol, \"c\"")},
+	}
+)
+
+func init() {
+	sql.Register("sqlquery-test", testDriver{})
+}
+
+func (testDriver) Open(name string) (driver.Conn, error) {
+	return testConn{}, nil
+}
+
+type testConn struct{}
+
+func (testConn) Prepare(query string) (driver.Stmt, error) {
+	return testStmt{query: query}, nil
+}
+
+func (testConn) Close() error {
+	return nil
+}
+
+func (testConn) Begin() (driver.Tx, error) {
+	return nil, fmt.Errorf("transactions are not supported")
+}
+
+type testStmt struct {
+	query string
+}
+
+func (testStmt) Close() error {
+	return nil
+}
+
+func (testStmt) NumInput() int {
+	return 0
+}
+
+func (testStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return nil, fmt.Errorf("exec is not supported")
+}
+
+func (s testStmt) Query(args []driver.Value) (driver.Rows, error) {
+	if s.query == testErrorQuery {
+		return nil, fmt.Errorf("relation does not exist")
+	}
+	return &testRowsIter{}, nil
+}
+
+type testRowsIter struct {
+	i int
+}
+
+func (r *testRowsIter) Columns() []string {
+	return testColumns
+}
+
+func (r *testRowsIter) Close() error {
+	return nil
+}
+
+func (r *testRowsIter) Next(dest []driver.Value) error {
+	if r.i == len(testRows) {
+		return io.EOF
+	}
+	copy(dest, testRows[r.i])
+	r.i++
+	return nil
+}
+
+func TestQueryCSV(t *testing.T) {
+	for _, format := range []string{"", FormatCSV} {
+		buf := &bytes.Buffer{}
+		require.NoError(t, runQuery("sqlquery-test", "", "SELECT *", format, buf))
+		require.Equal(t, "id,name\n1,alice\n2,\n3,\"carol, \"\"c\"\"\"\n", buf.String())
+	}
+}
+
+func TestQueryJSON(t *testing.T) {
+	buf := &bytes.Buffer{}
+	require.NoError(t, runQuery("sqlquery-test", "", "SELECT *", FormatJSON, buf))
+	require.Equal(t, `{"id":"1","name":"alice"}
+{"id":"2","name":null}
+{"id":"3","name":"carol, \"c\""}
+`, buf.String())
+}
+
+func TestQueryErrors(t *testing.T) {
+	buf := &bytes.Buffer{}
+	require.YesError(t, runQuery("sqlquery-test", "", testErrorQuery, FormatCSV, buf))
+	require.YesError(t, runQuery("sqlquery-test", "", "SELECT *", "xml", buf))
+	require.Equal(t, 0, buf.Len())
+	require.YesError(t, Query("sqlite:///tmp/db", "SELECT 1", FormatCSV, buf))
+	require.YesError(t, Query("://", "SELECT 1", FormatCSV, buf))
+}
+
+func TestParseDataSourcePostgres(t *testing.T) {
+	driverName, dataSource, err := parseDataSource("postgres://user:pass@db:5432/pachyderm?sslmode=disable")
+	require.NoError(t, err)
+	require.Equal(t, "postgres", driverName)
+	require.Equal(t, "postgres://user:pass@db:5432/pachyderm?connect_timeout=30&sslmode=disable", dataSource)
+
+	_, dataSource, err = parseDataSource("postgresql://db/pachyderm?connect_timeout=5")
+	require.NoError(t, err)
+	require.Equal(t, "postgresql://db/pachyderm?connect_timeout=5", dataSource)
+}
+
+func TestParseDataSourceMySQL(t *testing.T) {
+	driverName, dataSource, err := parseDataSource("mysql://user:p%40ss@db/pachyderm")
+	require.NoError(t, err)
+	require.Equal(t, "mysql", driverName)
+	cfg, err := mysql.ParseDSN(dataSource)
+	require.NoError(t, err)
+	require.Equal(t, "user", cfg.User)
+	require.Equal(t, "p@ss", cfg.Passwd)
+	require.Equal(t, "tcp", cfg.Net)
+	require.Equal(t, "db:3306", cfg.Addr)
+	require.Equal(t, "pachyderm", cfg.DBName)
+	require.Equal(t, dialTimeout, cfg.Timeout)
+
+	_, dataSource, err = parseDataSource("mysql://db:3307/pachyderm")
+	require.NoError(t, err)
+	cfg, err = mysql.ParseDSN(dataSource)
+	require.NoError(t, err)
+	require.Equal(t, "db:3307", cfg.Addr)
+	require.Equal(t, "", cfg.User)
+}
@@ -2,11 +2,13 @@
 package sync
 
 import (
+	"archive/tar"
 	"io"
 	"os"
 	"path/filepath"
 	"sync"
 	"syscall"
+	"time"
 
 	pachclient "github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/limit"
@@ -144,6 +146,46 @@ func (p *Puller) Pull(client *pachclient.APIClient, root string, repo, commit, f
 	return eg.Wait()
 }
 
+// PullTar writes a tar stream of file, and everything under it if it's a
+// directory, to w. Paths in the stream are relative to file.
+func PullTar(client *pachclient.APIClient, w io.Writer, repo, commit, file string) error {
+	tw := tar.NewWriter(w)
+	now := time.Now()
+	if err := client.Walk(repo, commit, file, func(fileInfo *pfs.FileInfo) error {
+		basepath, err := filepath.Rel(file, fileInfo.File.Path)
+		if err != nil {
+			return err
+		}
+		if fileInfo.FileType == pfs.FileType_DIR {
+			if basepath == "." {
+				return nil
+			}
+			return tw.WriteHeader(&tar.Header{
+				Name:     basepath + "/",
+				Typeflag: tar.TypeDir,
+				Mode:     0755,
+				ModTime:  now,
+			})
+		}
+		if basepath == "." {
+			basepath = filepath.Base(file)
+		}
+		if err := tw.WriteHeader(&tar.Header{
+			Name:     basepath,
+			Typeflag: tar.TypeReg,
+			Mode:     0644,
+			Size:     int64(fileInfo.SizeBytes),
+			ModTime:  now,
+		}); err != nil {
+			return err
+		}
+		return client.GetFile(repo, commit, fileInfo.File.Path, 0, 0, tw)
+	}); err != nil {
+		return err
+	}
+	return tw.Close()
+}
+
 // PullDiff is like Pull except that it materializes a Diff of the content
 // rather than a the actual content. If newOnly is true then only new files
 // will be downloaded and they will be downloaded under root. Otherwise new and
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"log"
 	"os"
 	"os/exec"
@@ -19,6 +20,7 @@ import (
 	"time"
 
 	etcd "github.com/coreos/etcd/clientv3"
+	units "github.com/docker/go-units"
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/gogo/protobuf/types"
 	"golang.org/x/net/context"
@@ -52,6 +54,9 @@ type APIServer struct {
 	kubeClient *kube.Client
 	etcdClient *etcd.Client
 	etcdPrefix string
+	// batcher batches the master's updates to jobs, which can be frequent
+	// when it's running many small jobs.
+	batcher *col.Batcher
 
 	// Information needed to process input data and upload output
 	pipelineInfo *pps.PipelineInfo
@@ -60,14 +65,22 @@ type APIServer struct {
 	logMsgTemplate pps.LogMessage
 
 	statusMu sync.Mutex
-	// The currently running job ID
-	jobID string
-	// The currently running data
-	data []*Input
-	// The time we started the currently running
-	started time.Time
-	// Func to cancel the currently running datum
-	cancel func()
+	// The currently running datums, by the slot they're running in
+	running map[int]*runningDatum
+	// shuttingDown is set when the worker's pod is being deleted, e.g.
+	// because its node is being reclaimed
+	shuttingDown bool
+	// shutdownCancelled is set once the datums that were still running at
+	// the end of the shutdown grace period are cancelled
+	shutdownCancelled bool
+	// checkpointers write out the progress of the jobs that the master is
+	// running, if it's running in this worker, by job ID
+	checkpointers map[string]func()
+	// The free slots that datums can run in, there are datumConcurrency()
+	// slots in all
+	slots chan int
+	// prefetcher downloads the datums that are sent next
+	prefetcher *prefetcher
 	// The k8s pod name of this worker
 	workerName string
 	// The total number of workers for this pipeline
@@ -78,6 +91,22 @@ type APIServer struct {
 	jobs col.Collection
 	// The pipelines collection
 	pipelines col.Collection
+	// The most log bytes that are persisted per datum, if the pipeline
+	// persists its logs
+	maxLogBytes int64
+	// The time spent running the transform's setup command that hasn't
+	// been reported to the master yet, guarded by statusMu
+	setupTime time.Duration
+}
+
+// runningDatum is a datum that's being processed.
+type runningDatum struct {
+	jobID string
+	data  []*Input
+	// The time we started processing the datum
+	started time.Time
+	// Func to cancel processing the datum
+	cancel func()
 }
 
 type taggedLogger struct {
@@ -85,6 +114,9 @@ type taggedLogger struct {
 	stderrLog log.Logger
 	marshaler *jsonpb.Marshaler
 	buffer    bytes.Buffer
+	// logs collects the datum's log lines if the pipeline persists them,
+	// it's nil otherwise.
+	logs *datumLogs
 }
 
 func (a *APIServer) getTaggedLogger(req *ProcessRequest) *taggedLogger {
@@ -93,6 +125,9 @@ func (a *APIServer) getTaggedLogger(req *ProcessRequest) *taggedLogger {
 		stderrLog: log.Logger{},
 		marshaler: &jsonpb.Marshaler{},
 	}
+	if a.pipelineInfo.Logs != nil {
+		result.logs = &datumLogs{limit: a.maxLogBytes}
+	}
 	result.stderrLog.SetOutput(os.Stderr)
 	result.stderrLog.SetFlags(log.LstdFlags | log.Llongfile) // Log file/line
 
@@ -109,24 +144,50 @@ func (a *APIServer) getTaggedLogger(req *ProcessRequest) *taggedLogger {
 	return result
 }
 
+// jobLogger returns a logger for things that the worker does for a job,
+// rather than for one of its datums, or for the worker itself if jobID is "".
+func (a *APIServer) jobLogger(jobID string) *taggedLogger {
+	result := &taggedLogger{
+		template:  a.logMsgTemplate, // Copy struct
+		stderrLog: log.Logger{},
+		marshaler: &jsonpb.Marshaler{},
+	}
+	result.stderrLog.SetOutput(os.Stderr)
+	result.stderrLog.SetFlags(log.LstdFlags | log.Llongfile) // Log file/line
+	result.template.JobID = jobID
+	return result
+}
+
 // Logf logs the line Sprintf(formatString, args...), but formatted as a json
 // message and annotated with all of the metadata stored in 'loginfo'.
 //
 // Note: this is not thread-safe, as it modifies fields of 'logger.template'
 func (logger *taggedLogger) Logf(formatString string, args ...interface{}) {
-	logger.template.Message = fmt.Sprintf(formatString, args...)
-	if ts, err := types.TimestampProto(time.Now()); err == nil {
-		logger.template.Ts = ts
-	} else {
-		logger.stderrLog.Printf("could not generate logging timestamp: %s\n", err)
+	line, err := logger.marshal(fmt.Sprintf(formatString, args...))
+	if err != nil {
+		logger.stderrLog.Printf("%s\n", err)
 		return
 	}
-	bytes, err := logger.marshaler.MarshalToString(&logger.template)
+	fmt.Printf("%s\n", line)
+	if logger.logs != nil {
+		logger.logs.add(line)
+	}
+}
+
+// marshal returns message as a json log line, annotated with the metadata
+// stored in 'loginfo'.
+func (logger *taggedLogger) marshal(message string) (string, error) {
+	logger.template.Message = message
+	ts, err := types.TimestampProto(time.Now())
 	if err != nil {
-		logger.stderrLog.Printf("could not marshal %v for logging: %s\n", &logger.template, err)
-		return
+		return "", fmt.Errorf("could not generate logging timestamp: %s", err)
 	}
-	fmt.Printf("%s\n", bytes)
+	logger.template.Ts = ts
+	line, err := logger.marshaler.MarshalToString(&logger.template)
+	if err != nil {
+		return "", fmt.Errorf("could not marshal %v for logging: %s", &logger.template, err)
+	}
+	return line, nil
 }
 
 func (logger *taggedLogger) Write(p []byte) (_ int, retErr error) {
@@ -154,6 +215,7 @@ func (logger *taggedLogger) userLogger() *taggedLogger {
 		template:  logger.template, // Copy struct
 		stderrLog: log.Logger{},
 		marshaler: &jsonpb.Marshaler{},
+		logs:      logger.logs,
 	}
 	result.template.User = true
 	return result
@@ -174,39 +236,117 @@ func NewAPIServer(pachClient *client.APIClient, etcdClient *etcd.Client, etcdPre
 		kubeClient:   kubeClient,
 		etcdClient:   etcdClient,
 		etcdPrefix:   etcdPrefix,
+		batcher:      col.NewBatcher(context.Background(), col.NewEtcdStore(etcdClient)),
 		pipelineInfo: pipelineInfo,
 		logMsgTemplate: pps.LogMessage{
 			PipelineName: pipelineInfo.Pipeline.Name,
 			PipelineID:   pipelineInfo.ID,
 			WorkerID:     os.Getenv(client.PPSPodNameEnv),
 		},
-		workerName: workerName,
-		numWorkers: numWorkers,
-		namespace:  namespace,
-		jobs:       ppsdb.Jobs(etcdClient, etcdPrefix),
-		pipelines:  ppsdb.Pipelines(etcdClient, etcdPrefix),
+		workerName:    workerName,
+		numWorkers:    numWorkers,
+		namespace:     namespace,
+		jobs:          ppsdb.Jobs(etcdClient, etcdPrefix),
+		pipelines:     ppsdb.Pipelines(etcdClient, etcdPrefix),
+		running:       make(map[int]*runningDatum),
+		checkpointers: make(map[string]func()),
+	}
+	if pipelineInfo.Logs != nil {
+		server.maxLogBytes, err = units.RAMInBytes(pipelineInfo.Logs.MaxBytesPerDatum)
+		if err != nil {
+			return nil, err
+		}
+	}
+	server.initSlots()
+	server.prefetcher = newPrefetcher(server, server.datumConcurrency())
+	if err := server.runSetup(); err != nil {
+		return nil, err
 	}
 	go server.master()
 	return server, nil
 }
 
-func (a *APIServer) downloadData(logger *taggedLogger, inputs []*Input, puller *filesync.Puller, parentTag *pfs.Tag) error {
+// runSetup runs the transform's setup command, if it has one, and records
+// how long it took so that the time can be reported with the first datum
+// that the worker processes.
+func (a *APIServer) runSetup() (retErr error) {
+	setup := a.pipelineInfo.Transform.Setup
+	if len(setup) == 0 {
+		return nil
+	}
+	logger := a.jobLogger("")
+	logger.Logf("beginning to run setup")
+	start := time.Now()
+	defer func() {
+		logger.Logf("finished running setup - took (%v) - with error (%v)\n", time.Since(start), retErr)
+	}()
+	cmd := exec.Command(setup[0], setup[1:]...)
+	cmd.Stdout = logger.userLogger()
+	cmd.Stderr = logger.userLogger()
+	cmd.Env = os.Environ()
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("error running setup: %v", err)
+	}
+	a.statusMu.Lock()
+	defer a.statusMu.Unlock()
+	a.setupTime = time.Since(start)
+	return nil
+}
+
+// takeSetupTime returns the setup time that hasn't been reported yet, and
+// marks it as reported.
+func (a *APIServer) takeSetupTime() *types.Duration {
+	a.statusMu.Lock()
+	defer a.statusMu.Unlock()
+	if a.setupTime == 0 {
+		return nil
+	}
+	setupTime := types.DurationProto(a.setupTime)
+	a.setupTime = 0
+	return setupTime
+}
+
+// downloadData downloads inputs to root. If prefetched isn't empty, it's
+// where inputs were prefetched to, and they're moved to root instead.
+func (a *APIServer) downloadData(logger *taggedLogger, root string, prefetched string, inputs []*Input, puller *filesync.Puller, mounts *mounts, parentTag *pfs.Tag) error {
 	logger.Logf("input has not been processed, downloading data")
 	defer func(start time.Time) {
 		logger.Logf("input data download took (%v)\n", time.Since(start))
 	}(time.Now())
+	moved := make(map[string]bool)
 	for _, input := range inputs {
 		file := input.FileInfo.File
-		root := filepath.Join(client.PPSInputPrefix, input.Name, file.Path)
+		if prefetched != "" {
+			// Grouped inputs have several files under the same name, which
+			// are all moved at once.
+			if moved[input.Name] {
+				continue
+			}
+			moved[input.Name] = true
+			if err := os.MkdirAll(root, 0777); err != nil {
+				return err
+			}
+			if err := os.Rename(filepath.Join(prefetched, input.Name), filepath.Join(root, input.Name)); err != nil {
+				return err
+			}
+			continue
+		}
+		if input.Mount {
+			if err := mounts.mount(a.pachClient, input, filepath.Join(root, input.Name)); err != nil {
+				return fmt.Errorf("error mounting input %s: %v", input.Name, err)
+			}
+			continue
+		}
+		path := filepath.Join(root, input.Name, file.Path)
 		if a.pipelineInfo.Incremental && input.ParentCommit != nil {
-			if err := puller.PullDiff(a.pachClient, root,
+			if err := puller.PullDiff(a.pachClient, path,
 				file.Commit.Repo.Name, file.Commit.ID, file.Path,
 				input.ParentCommit.Repo.Name, input.ParentCommit.ID, file.Path,
 				true, input.Lazy, concurrency); err != nil {
 				return err
 			}
 		} else {
-			if err := puller.Pull(a.pachClient, root, file.Commit.Repo.Name, file.Commit.ID, file.Path, input.Lazy, concurrency); err != nil {
+			if err := puller.Pull(a.pachClient, path, file.Commit.Repo.Name, file.Commit.ID, file.Path, input.Lazy, concurrency); err != nil {
 				return err
 			}
 		}
@@ -220,21 +360,43 @@ func (a *APIServer) downloadData(logger *taggedLogger, inputs []*Input, puller *
 		if err != nil {
 			return fmt.Errorf("failed to deserialize parent hashtree: %v", err)
 		}
-		if err := puller.PullTree(a.pachClient, client.PPSOutputPath, tree, false, concurrency); err != nil {
+		if err := puller.PullTree(a.pachClient, outputPath(root), tree, false, concurrency); err != nil {
 			return fmt.Errorf("error pulling output tree: %+v", err)
 		}
+		for _, output := range a.pipelineInfo.SecondaryOutputs {
+			var buffer bytes.Buffer
+			if err := a.pachClient.GetTag(secondaryOutputTag(parentTag.Name, output.Name), &buffer); err != nil {
+				// The parent was processed before the output was added.
+				logger.Logf("error getting parent of secondary output %s for datum %v: %v", output.Name, inputs, err)
+				continue
+			}
+			tree, err := hashtree.Deserialize(buffer.Bytes())
+			if err != nil {
+				return fmt.Errorf("failed to deserialize parent hashtree: %v", err)
+			}
+			if err := puller.PullTree(a.pachClient, filepath.Join(root, output.Name), tree, false, concurrency); err != nil {
+				return fmt.Errorf("error pulling secondary output tree: %+v", err)
+			}
+		}
 	}
 	return nil
 }
 
 // Run user code and return the combined output of stdout and stderr.
-func (a *APIServer) runUserCode(ctx context.Context, logger *taggedLogger, environ []string) (retErr error) {
+func (a *APIServer) runUserCode(ctx context.Context, logger *taggedLogger, root string, environ []string) (retErr error) {
 	logger.Logf("beginning to run user code")
 	defer func(start time.Time) {
 		logger.Logf("finished running user code - took (%v) - with error (%v)\n", time.Since(start), retErr)
 	}(time.Now())
 	// Run user code
 	cmd := exec.CommandContext(ctx, a.pipelineInfo.Transform.Cmd[0], a.pipelineInfo.Transform.Cmd[1:]...)
+	if root != client.PPSInputPrefix {
+		var err error
+		cmd, err = namespaceCommand(ctx, root, a.pipelineInfo.Transform.Cmd)
+		if err != nil {
+			return err
+		}
+	}
 	cmd.Stdin = strings.NewReader(strings.Join(a.pipelineInfo.Transform.Stdin, "\n") + "\n")
 	cmd.Stdout = logger.userLogger()
 	cmd.Stderr = logger.userLogger()
@@ -258,7 +420,34 @@ func (a *APIServer) runUserCode(ctx context.Context, logger *taggedLogger, envir
 	return err
 }
 
-func (a *APIServer) uploadOutput(ctx context.Context, tag string, logger *taggedLogger, inputs []*Input) error {
+// runHook runs one of the transform's hooks, named name, with the datum
+// downloaded to root in /pfs. Unlike the user code, a hook has no stdin and
+// fails on any non-zero return code.
+func (a *APIServer) runHook(ctx context.Context, logger *taggedLogger, root string, environ []string, name string, hook []string) (retErr error) {
+	logger.Logf("beginning to run %s hook", name)
+	defer func(start time.Time) {
+		logger.Logf("finished running %s hook - took (%v) - with error (%v)\n", name, time.Since(start), retErr)
+	}(time.Now())
+	cmd := exec.CommandContext(ctx, hook[0], hook[1:]...)
+	if root != client.PPSInputPrefix {
+		var err error
+		cmd, err = namespaceCommand(ctx, root, hook)
+		if err != nil {
+			return err
+		}
+	}
+	cmd.Stdout = logger.userLogger()
+	cmd.Stderr = logger.userLogger()
+	cmd.Env = environ
+	return cmd.Run()
+}
+
+// uploadOutput uploads the files in dir, which is the output directory of
+// the datum downloaded to root, or one of its secondary output directories,
+// as a hashtree that's tagged with tag, and returns how many bytes it
+// uploaded. Files that are symlinks to input files aren't uploaded, so they
+// aren't counted.
+func (a *APIServer) uploadOutput(ctx context.Context, root string, dir string, tag string, logger *taggedLogger, inputs []*Input) (int64, error) {
 	logger.Logf("starting to upload output")
 	defer func(start time.Time) {
 		logger.Logf("finished uploading output - took %v\n", time.Since(start))
@@ -266,19 +455,21 @@ func (a *APIServer) uploadOutput(ctx context.Context, tag string, logger *tagged
 	// hashtree is not thread-safe--guard with 'lock'
 	var lock sync.Mutex
 	tree := hashtree.NewHashTree()
+	var uploaded int64
 
 	// Upload all files in output directory
 	var g errgroup.Group
 	limiter := limit.New(concurrency)
-	if err := filepath.Walk(client.PPSOutputPath, func(path string, info os.FileInfo, err error) error {
+	outputPath := dir
+	if err := filepath.Walk(outputPath, func(path string, info os.FileInfo, err error) error {
 		g.Go(func() (retErr error) {
 			limiter.Acquire()
 			defer limiter.Release()
-			if path == client.PPSOutputPath {
+			if path == outputPath {
 				return nil
 			}
 
-			relPath, err := filepath.Rel(client.PPSOutputPath, path)
+			relPath, err := filepath.Rel(outputPath, path)
 			if err != nil {
 				return err
 			}
@@ -326,6 +517,8 @@ func (a *APIServer) uploadOutput(ctx context.Context, tag string, logger *tagged
 						}
 					}
 					if input != nil {
+						// The user code sees root as /pfs.
+						realPath = filepath.Join(root, pathWithInput)
 						return filepath.Walk(realPath, func(path string, info os.FileInfo, err error) error {
 							rel, err := filepath.Rel(realPath, path)
 							if err != nil {
@@ -333,7 +526,7 @@ func (a *APIServer) uploadOutput(ctx context.Context, tag string, logger *tagged
 							}
 							subRelPath := filepath.Join(relPath, rel)
 							// The path of the input file
-							pfsPath, err := filepath.Rel(filepath.Join(client.PPSInputPrefix, input.Name), path)
+							pfsPath, err := filepath.Rel(filepath.Join(root, input.Name), path)
 							if err != nil {
 								return err
 							}
@@ -392,41 +585,60 @@ func (a *APIServer) uploadOutput(ctx context.Context, tag string, logger *tagged
 
 			lock.Lock()
 			defer lock.Unlock()
+			uploaded += int64(size)
 			return tree.PutFile(relPath, []*pfs.Object{object}, int64(size))
 		})
 		return nil
 	}); err != nil {
-		return err
+		return 0, err
 	}
 
 	if err := g.Wait(); err != nil {
-		return err
+		return 0, err
 	}
 
 	finTree, err := tree.Finish()
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	treeBytes, err := hashtree.Serialize(finTree)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	if _, _, err := a.pachClient.PutObject(bytes.NewReader(treeBytes), tag); err != nil {
-		return err
+		return 0, err
 	}
 
-	return nil
+	return uploaded, nil
 }
 
-// cleanUpData removes everything under /pfs
+// downloadSize returns how many bytes of inputs are downloaded to process
+// them. Lazily loaded and mounted inputs are read as the user code needs
+// them, so they aren't counted.
+func downloadSize(inputs []*Input) int64 {
+	var size int64
+	for _, input := range inputs {
+		if input.Lazy || input.Mount {
+			continue
+		}
+		size += int64(input.FileInfo.SizeBytes)
+	}
+	return size
+}
+
+// cleanUpData removes everything under root, the directory that a datum
+// was downloaded to.
 //
-// The reason we don't want to just os.RemoveAll(/pfs) is that we don't
-// want to remove /pfs itself, since it's a emptyDir volume.
+// If root is /pfs, the reason we don't want to just os.RemoveAll(/pfs) is
+// that we don't want to remove /pfs itself, since it's a emptyDir volume.
 //
 // Most of the code is copied from os.RemoveAll().
-func (a *APIServer) cleanUpData() error {
+func (a *APIServer) cleanUpData(root string) error {
+	if root != client.PPSInputPrefix {
+		return os.RemoveAll(root)
+	}
 	path := client.PPSInputPrefix
 	// Otherwise, is this a directory we need to recurse into?
 	dir, serr := os.Lstat(path)
@@ -457,6 +669,10 @@ func (a *APIServer) cleanUpData() error {
 	for {
 		names, err1 := fd.Readdirnames(100)
 		for _, name := range names {
+			if name == prefetchDir {
+				// Prefetched datums are removed once they're used.
+				continue
+			}
 			err1 := os.RemoveAll(path + string(os.PathSeparator) + name)
 			if err == nil {
 				err = err1
@@ -504,40 +720,59 @@ func HashDatum(pipelineInfo *pps.PipelineInfo, data []*Input) (string, error) {
 // Process processes a datum.
 func (a *APIServer) Process(ctx context.Context, req *ProcessRequest) (resp *ProcessResponse, retErr error) {
 	logger := a.getTaggedLogger(req)
+	if logger.logs != nil {
+		// This is deferred first so that it runs last, after everything
+		// the datum logs.
+		defer func() {
+			if resp == nil || resp.Skipped {
+				return
+			}
+			if err := a.uploadLogs(logger, resp); err != nil {
+				logger.stderrLog.Printf("could not upload logs: %v\n", err)
+			}
+		}()
+	}
+	// The first response the worker sends reports its setup time, whether
+	// or not the datum was processed, so that it's counted once.
+	defer func() {
+		if resp != nil {
+			resp.SetupTime = a.takeSetupTime()
+		}
+	}()
 	logger.Logf("process call started - request: %v", req)
 	defer func(start time.Time) {
 		logger.Logf("process call finished - request: %v, response: %v, err %v, duration: %v", req, resp, retErr, time.Since(start))
 	}(time.Now())
-	// We cannot run more than one user process at once; otherwise they'd be
-	// writing to the same output directory. Acquire lock to make sure only one
-	// user process runs at a time.
+	// We can only run as many user processes at once as there are slots,
+	// each of which has its own directory for inputs and output. Acquire a
+	// slot to run this datum in.
+	slot, err := a.acquireSlot()
+	if err != nil {
+		return nil, err
+	}
+	defer a.releaseSlot(slot)
 	// set the status for the datum
 	ctx, cancel := context.WithCancel(ctx)
-	if err := func() error {
-		a.statusMu.Lock()
-		defer a.statusMu.Unlock()
-		if a.jobID != "" {
-			// we error in this case so that callers have a chance to find a
-			// non-busy worker
-			return fmt.Errorf("worker busy")
-		}
-		a.jobID = req.JobID
-		a.data = req.Data
-		a.started = time.Now()
-		a.cancel = cancel
-		return nil
-	}(); err != nil {
-		return nil, err
+	a.statusMu.Lock()
+	if a.shuttingDown {
+		a.statusMu.Unlock()
+		cancel()
+		return nil, fmt.Errorf("worker is shutting down")
+	}
+	a.running[slot] = &runningDatum{
+		jobID:   req.JobID,
+		data:    req.Data,
+		started: time.Now(),
+		cancel:  cancel,
 	}
+	a.statusMu.Unlock()
 	// unset the status when this function exits
 	defer func() {
 		a.statusMu.Lock()
 		defer a.statusMu.Unlock()
-		a.jobID = ""
-		a.data = nil
-		a.started = time.Time{}
-		a.cancel = nil
+		delete(a.running, slot)
 	}()
+	root := a.datumRoot(slot)
 
 	// Hash inputs and check if output is in s3 already. Note: ppsserver sorts
 	// inputs by input name for both jobs and pipelines, so this hash is stable
@@ -546,21 +781,37 @@ func (a *APIServer) Process(ctx context.Context, req *ProcessRequest) (resp *Pro
 	if err != nil {
 		return nil, err
 	}
+	// Incremental datums depend on their parent's output, which is
+	// downloaded with them, so they aren't prefetched.
+	if len(req.Next) > 0 && !a.pipelineInfo.Incremental {
+		nextTag, err := HashDatum(a.pipelineInfo, req.Next)
+		if err != nil {
+			return nil, err
+		}
+		a.prefetcher.prefetch(nextTag, req.Next)
+	}
 	if _, err := a.pachClient.InspectTag(ctx, &pfs.Tag{tag}); err == nil {
 		// We've already computed the output for these inputs. Return immediately
 		logger.Logf("skipping input, as it's already been processed")
 		return &ProcessResponse{
-			Tag: &pfs.Tag{tag},
+			Tag:     &pfs.Tag{tag},
+			Skipped: true,
 		}, nil
 	}
 
-	// Download input data
+	// Download input data, unless it's been prefetched
+	prefetched := a.prefetcher.take(tag)
+	if prefetched != "" {
+		logger.Logf("using prefetched input data")
+		defer os.RemoveAll(prefetched)
+	}
 	puller := filesync.NewPuller()
-	err = a.downloadData(logger, req.Data, puller, req.ParentOutput)
+	mounts := &mounts{}
+	err = a.downloadData(logger, root, prefetched, req.Data, puller, mounts, req.ParentOutput)
 	// We run these cleanup functions no matter what, so that if
 	// downloadData partially succeeded, we still clean up the resources.
 	defer func() {
-		if err := a.cleanUpData(); retErr == nil && err != nil {
+		if err := a.cleanUpData(root); retErr == nil && err != nil {
 			retErr = err
 		}
 	}()
@@ -572,18 +823,57 @@ func (a *APIServer) Process(ctx context.Context, req *ProcessRequest) (resp *Pro
 			retErr = err
 		}
 	}()
+	// Mounts have to be unmounted before cleanUpData can remove their
+	// mount points.
+	defer func() {
+		if err := mounts.unmountAll(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
 	if err != nil {
 		return nil, err
 	}
 
-	environ := a.userCodeEnviron(req)
+	if err := writeDatumManifest(root, tag, req); err != nil {
+		return nil, err
+	}
+	environ := a.userCodeEnviron(req, tag)
 
-	// Create output directory (currently /pfs/out) and run user code
-	if err := os.MkdirAll(client.PPSOutputPath, 0666); err != nil {
+	// Create output directory (currently /pfs/out), and the secondary
+	// output directories, and run user code
+	if err := os.MkdirAll(outputPath(root), 0666); err != nil {
 		return nil, err
 	}
-	err = a.runUserCode(ctx, logger, environ)
+	for _, output := range a.pipelineInfo.SecondaryOutputs {
+		if err := os.MkdirAll(filepath.Join(root, output.Name), 0666); err != nil {
+			return nil, err
+		}
+	}
+	hooks := a.pipelineInfo.Transform.Hooks
+	if hooks != nil && len(hooks.BeforeDatum) > 0 {
+		err = a.runHook(ctx, logger, root, environ, "before datum", hooks.BeforeDatum)
+	}
+	if err == nil {
+		err = a.runUserCode(ctx, logger, root, environ)
+	}
+	if hooks != nil && len(hooks.AfterDatum) > 0 {
+		state := "success"
+		if err != nil {
+			state = "failure"
+		}
+		afterEnviron := append(environ, fmt.Sprintf("%s=%s", client.PPSDatumStateEnv, state))
+		if hookErr := a.runHook(ctx, logger, root, afterEnviron, "after datum", hooks.AfterDatum); err == nil {
+			err = hookErr
+		}
+	}
 	if err != nil {
+		if a.isShutdownCancelled() {
+			// The user code was stopped because the worker is going away,
+			// which isn't its fault, so the datum is retried elsewhere
+			// without counting against it.
+			logger.Logf("stopped processing datum, worker is shutting down")
+			return nil, fmt.Errorf("worker is shutting down")
+		}
 		logger.Logf("failed to process datum with error: %+v", err)
 		return &ProcessResponse{
 			Failed: true,
@@ -599,7 +889,23 @@ func (a *APIServer) Process(ctx context.Context, req *ProcessRequest) (resp *Pro
 		logger.Logf("puller encountered an error while cleaning up: %+v", err)
 		return nil, err
 	}
-	if err := a.uploadOutput(ctx, tag, logger, req.Data); err != nil {
+	// The secondary outputs are uploaded first, because the datum's tag
+	// is what marks it as processed.
+	var uploaded int64
+	for _, output := range a.pipelineInfo.SecondaryOutputs {
+		size, err := a.uploadOutput(ctx, root, filepath.Join(root, output.Name), secondaryOutputTag(tag, output.Name), logger, req.Data)
+		if err != nil {
+			if err == errSpecialFile {
+				return &ProcessResponse{
+					Failed: true,
+				}, nil
+			}
+			return nil, err
+		}
+		uploaded += size
+	}
+	size, err := a.uploadOutput(ctx, root, outputPath(root), tag, logger, req.Data)
+	if err != nil {
 		// If uploading failed because the user program outputed a special
 		// file, then there's no point in retrying.  Thus we signal that
 		// there's some problem with the user code so the job doesn't
@@ -612,49 +918,168 @@ func (a *APIServer) Process(ctx context.Context, req *ProcessRequest) (resp *Pro
 		return nil, err
 	}
 	return &ProcessResponse{
-		Tag: &pfs.Tag{tag},
+		Tag:           &pfs.Tag{tag},
+		DownloadBytes: downloadSize(req.Data),
+		UploadBytes:   uploaded + size,
 	}, nil
 }
 
-// Status returns the status of the current worker.
+// Status returns the status of the current worker. If it's processing more
+// than one datum, it returns the status of the one that's been running
+// longest.
 func (a *APIServer) Status(ctx context.Context, _ *types.Empty) (*pps.WorkerStatus, error) {
 	a.statusMu.Lock()
 	defer a.statusMu.Unlock()
-	started, err := types.TimestampProto(a.started)
+	oldest := &runningDatum{}
+	for _, running := range a.running {
+		if oldest.started.IsZero() || running.started.Before(oldest.started) {
+			oldest = running
+		}
+	}
+	started, err := types.TimestampProto(oldest.started)
 	if err != nil {
 		return nil, err
 	}
 	result := &pps.WorkerStatus{
-		JobID:    a.jobID,
+		JobID:    oldest.jobID,
 		WorkerID: a.workerName,
 		Started:  started,
-		Data:     a.datum(),
+		Data:     datum(oldest.data),
 	}
 	return result, nil
 }
 
-// Cancel cancels the currently running datum
+// Shutdown stops the worker from processing datums, for when its pod is
+// being deleted. The datums that it's processing are given gracePeriod to
+// finish, after which they're cancelled, and sent back to the master to be
+// retried on other workers. If the master is running in this worker, the
+// progress of its jobs is then checkpointed, so that the next master picks
+// them up where they got to. Shutdown returns once the datums have
+// returned.
+func (a *APIServer) Shutdown(gracePeriod time.Duration) {
+	a.statusMu.Lock()
+	a.shuttingDown = true
+	a.statusMu.Unlock()
+	// Taking every slot waits for the running datums, and keeps new ones
+	// from starting.
+	deadline := time.After(gracePeriod)
+	for i := 0; i < a.datumConcurrency(); i++ {
+		select {
+		case <-a.slots:
+		case <-deadline:
+			a.statusMu.Lock()
+			a.shutdownCancelled = true
+			for _, running := range a.running {
+				running.cancel()
+			}
+			a.statusMu.Unlock()
+			<-a.slots
+		}
+	}
+	a.statusMu.Lock()
+	var checkpointers []func()
+	for _, checkpointer := range a.checkpointers {
+		checkpointers = append(checkpointers, checkpointer)
+	}
+	a.statusMu.Unlock()
+	for _, checkpointer := range checkpointers {
+		checkpointer()
+	}
+}
+
+func (a *APIServer) isShutdownCancelled() bool {
+	a.statusMu.Lock()
+	defer a.statusMu.Unlock()
+	return a.shutdownCancelled
+}
+
+// setCheckpointer sets the function that checkpoints the progress of job
+// jobID, or removes it if checkpointer is nil.
+func (a *APIServer) setCheckpointer(jobID string, checkpointer func()) {
+	a.statusMu.Lock()
+	defer a.statusMu.Unlock()
+	if checkpointer == nil {
+		delete(a.checkpointers, jobID)
+		return
+	}
+	a.checkpointers[jobID] = checkpointer
+}
+
+// Cancel cancels the currently running datums that match the request
 func (a *APIServer) Cancel(ctx context.Context, request *CancelRequest) (*CancelResponse, error) {
 	a.statusMu.Lock()
 	defer a.statusMu.Unlock()
-	if request.JobID != a.jobID {
-		return &CancelResponse{Success: false}, nil
+	success := false
+	for slot, running := range a.running {
+		if request.JobID != running.jobID {
+			continue
+		}
+		if !MatchDatum(request.DataFilters, datum(running.data)) {
+			continue
+		}
+		running.cancel()
+		// clear the status since we're no longer processing this datum
+		delete(a.running, slot)
+		success = true
+	}
+	return &CancelResponse{Success: success}, nil
+}
+
+// Merge merges hashtrees, which are either datums' outputs or the results
+// of other merges, and stores the result as an object.
+func (a *APIServer) Merge(ctx context.Context, request *MergeRequest) (*MergeResponse, error) {
+	trees := make([]hashtree.HashTree, len(request.Tags)+len(request.Objects))
+	var g errgroup.Group
+	limiter := limit.New(concurrency)
+	get := func(i int, f func(w io.Writer) error) {
+		g.Go(func() error {
+			limiter.Acquire()
+			defer limiter.Release()
+			var buffer bytes.Buffer
+			if err := f(&buffer); err != nil {
+				return err
+			}
+			tree, err := hashtree.Deserialize(buffer.Bytes())
+			if err != nil {
+				return err
+			}
+			trees[i] = tree
+			return nil
+		})
+	}
+	for i, tag := range request.Tags {
+		tag := tag
+		get(i, func(w io.Writer) error { return a.pachClient.GetTag(tag.Name, w) })
+	}
+	for i, object := range request.Objects {
+		object := object
+		get(len(request.Tags)+i, func(w io.Writer) error { return a.pachClient.GetObject(object.Hash, w) })
+	}
+	if err := g.Wait(); err != nil {
+		return nil, fmt.Errorf("error getting hashtrees for job %s: %v", request.JobID, err)
 	}
-	if !MatchDatum(request.DataFilters, a.datum()) {
-		return &CancelResponse{Success: false}, nil
+	tree := hashtree.NewHashTree()
+	if err := tree.Merge(trees...); err != nil {
+		return nil, err
 	}
-	a.cancel()
-	// clear the status since we're no longer processing this datum
-	a.jobID = ""
-	a.data = nil
-	a.started = time.Time{}
-	a.cancel = nil
-	return &CancelResponse{Success: true}, nil
+	finishedTree, err := tree.Finish()
+	if err != nil {
+		return nil, err
+	}
+	data, err := hashtree.Serialize(finishedTree)
+	if err != nil {
+		return nil, err
+	}
+	object, _, err := a.pachClient.PutObject(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	return &MergeResponse{Tree: object}, nil
 }
 
-func (a *APIServer) datum() []*pps.Datum {
+func datum(data []*Input) []*pps.Datum {
 	var result []*pps.Datum
-	for _, datum := range a.data {
+	for _, datum := range data {
 		result = append(result, &pps.Datum{
 			Path: datum.FileInfo.File.Path,
 			Hash: datum.FileInfo.Hash,
@@ -663,8 +1088,91 @@ func (a *APIServer) datum() []*pps.Datum {
 	return result
 }
 
-func (a *APIServer) userCodeEnviron(req *ProcessRequest) []string {
-	return append(os.Environ(), fmt.Sprintf("PACH_JOB_ID=%s", req.JobID))
+// datumConcurrency returns the number of datums that the worker processes
+// at once.
+func (a *APIServer) datumConcurrency() int {
+	if a.pipelineInfo.DatumConcurrency < 1 {
+		// The pipeline was created before datum concurrency was added.
+		return 1
+	}
+	return int(a.pipelineInfo.DatumConcurrency)
+}
+
+// initSlots makes every slot free.
+func (a *APIServer) initSlots() {
+	a.slots = make(chan int, a.datumConcurrency())
+	for i := 0; i < a.datumConcurrency(); i++ {
+		a.slots <- i
+	}
+}
+
+// acquireSlot takes a free slot for a datum to run in. It errors, rather than
+// waiting, if every slot is taken.
+func (a *APIServer) acquireSlot() (int, error) {
+	select {
+	case slot := <-a.slots:
+		return slot, nil
+	default:
+		// we error in this case so that callers have a chance to find a
+		// non-busy worker
+		return 0, fmt.Errorf("worker busy")
+	}
+}
+
+// releaseSlot frees a slot taken by acquireSlot.
+func (a *APIServer) releaseSlot(slot int) {
+	a.slots <- slot
+}
+
+// datumRoot returns the directory that the datum running in slot is
+// downloaded to, which the user code sees as /pfs.
+func (a *APIServer) datumRoot(slot int) string {
+	if a.datumConcurrency() == 1 {
+		return client.PPSInputPrefix
+	}
+	return filepath.Join(client.PPSInputPrefix, fmt.Sprintf(".datum-%d", slot))
+}
+
+// outputPath returns the output directory of the datum downloaded to root.
+func outputPath(root string) string {
+	return filepath.Join(root, filepath.Base(client.PPSOutputPath))
+}
+
+// secondaryOutputTag returns the tag of a datum's secondary output, given
+// the datum's tag.
+func secondaryOutputTag(tag string, output string) string {
+	return fmt.Sprintf("%s-%s", tag, output)
+}
+
+func (a *APIServer) userCodeEnviron(req *ProcessRequest, tag string) []string {
+	return append(os.Environ(),
+		fmt.Sprintf("PACH_JOB_ID=%s", req.JobID),
+		fmt.Sprintf("%s=%s", client.PPSDatumIDEnv, tag),
+		fmt.Sprintf("%s=%s", client.PPSDatumManifestEnv, client.PPSDatumManifestPath))
+}
+
+// writeDatumManifest writes the manifest of the datum with ID tag, which is
+// downloaded to root, where the user code will find it in /pfs.
+func writeDatumManifest(root string, tag string, req *ProcessRequest) error {
+	manifest := &pps.DatumManifest{
+		DatumID: tag,
+		JobID:   req.JobID,
+	}
+	for _, input := range req.Data {
+		manifest.Inputs = append(manifest.Inputs, &pps.DatumManifestInput{
+			Name:      input.Name,
+			File:      input.FileInfo.File,
+			Branch:    input.Branch,
+			SizeBytes: input.FileInfo.SizeBytes,
+			GroupKey:  input.GroupKey,
+		})
+	}
+	marshaler := &jsonpb.Marshaler{Indent: "  "}
+	data, err := marshaler.MarshalToString(manifest)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(root, filepath.Base(client.PPSDatumManifestPath)), []byte(data), 0644)
 }
 
 func (a *APIServer) updateJobState(stm col.STM, jobInfo *pps.JobInfo, state pps.JobState) error {
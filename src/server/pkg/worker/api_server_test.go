@@ -0,0 +1,195 @@
+package worker
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
+)
+
+func newTestAPIServer(datumConcurrency int64) *APIServer {
+	a := &APIServer{
+		pipelineInfo: &pps.PipelineInfo{DatumConcurrency: datumConcurrency},
+	}
+	a.initSlots()
+	return a
+}
+
+func TestSlots(t *testing.T) {
+	a := newTestAPIServer(3)
+	roots := make(map[string]bool)
+	var slots []int
+	for i := 0; i < 3; i++ {
+		slot, err := a.acquireSlot()
+		require.NoError(t, err)
+		slots = append(slots, slot)
+		roots[a.datumRoot(slot)] = true
+	}
+	require.Equal(t, map[string]bool{
+		"/pfs/.datum-0": true,
+		"/pfs/.datum-1": true,
+		"/pfs/.datum-2": true,
+	}, roots)
+	_, err := a.acquireSlot()
+	require.YesError(t, err)
+
+	a.releaseSlot(slots[1])
+	slot, err := a.acquireSlot()
+	require.NoError(t, err)
+	require.Equal(t, slots[1], slot)
+	_, err = a.acquireSlot()
+	require.YesError(t, err)
+
+	for _, slot := range slots {
+		a.releaseSlot(slot)
+	}
+	for i := 0; i < 3; i++ {
+		_, err := a.acquireSlot()
+		require.NoError(t, err)
+	}
+}
+
+func TestSingleSlot(t *testing.T) {
+	// Pipelines created before datum concurrency was added have it unset.
+	for _, datumConcurrency := range []int64{0, 1} {
+		a := newTestAPIServer(datumConcurrency)
+		slot, err := a.acquireSlot()
+		require.NoError(t, err)
+		require.Equal(t, "/pfs", a.datumRoot(slot))
+		_, err = a.acquireSlot()
+		require.YesError(t, err)
+		a.releaseSlot(slot)
+		_, err = a.acquireSlot()
+		require.NoError(t, err)
+	}
+}
+
+// fakeObjectAPIClient stores the objects put through it in memory.
+type fakeObjectAPIClient struct {
+	pfs.ObjectAPIClient
+	mu      sync.Mutex
+	objects map[string][]byte
+	tags    map[string][]byte
+}
+
+func (c *fakeObjectAPIClient) PutObject(ctx context.Context, opts ...grpc.CallOption) (pfs.ObjectAPI_PutObjectClient, error) {
+	return &fakePutObjectClient{c: c}, nil
+}
+
+type fakePutObjectClient struct {
+	pfs.ObjectAPI_PutObjectClient
+	c     *fakeObjectAPIClient
+	tags  []*pfs.Tag
+	value bytes.Buffer
+}
+
+func (w *fakePutObjectClient) Send(request *pfs.PutObjectRequest) error {
+	w.tags = append(w.tags, request.Tags...)
+	w.value.Write(request.Value)
+	return nil
+}
+
+func (w *fakePutObjectClient) CloseAndRecv() (*pfs.Object, error) {
+	sum := sha256.Sum256(w.value.Bytes())
+	object := &pfs.Object{Hash: hex.EncodeToString(sum[:])}
+	w.c.mu.Lock()
+	defer w.c.mu.Unlock()
+	w.c.objects[object.Hash] = w.value.Bytes()
+	for _, tag := range w.tags {
+		w.c.tags[tag.Name] = w.value.Bytes()
+	}
+	return object, nil
+}
+
+// fakePfsAPIClient answers InspectFile for the files in an input commit.
+type fakePfsAPIClient struct {
+	pfs.APIClient
+	mu        sync.Mutex
+	inspected []*pfs.File
+}
+
+func (c *fakePfsAPIClient) InspectFile(ctx context.Context, request *pfs.InspectFileRequest, opts ...grpc.CallOption) (*pfs.FileInfo, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.inspected = append(c.inspected, request.File)
+	return &pfs.FileInfo{
+		File:      request.File,
+		Objects:   []*pfs.Object{{Hash: request.File.Commit.ID + ":" + request.File.Path}},
+		SizeBytes: 4,
+	}, nil
+}
+
+func TestUploadOutputRoot(t *testing.T) {
+	// The datum is downloaded to a root other than /pfs, like it is when
+	// the pipeline processes several datums at once, but the user code
+	// still sees it as /pfs, so that's where its symlinks point.
+	root, err := ioutil.TempDir("", "datum")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "in", "dir"), 0700))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(root, "in", "dir", "file"), []byte("data"), 0600))
+	out := filepath.Join(root, "out")
+	require.NoError(t, os.MkdirAll(out, 0700))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(out, "regular"), []byte("output"), 0600))
+	require.NoError(t, os.Symlink(filepath.Join(client.PPSInputPrefix, "in", "dir", "file"), filepath.Join(out, "link")))
+	require.NoError(t, os.Symlink(filepath.Join(client.PPSInputPrefix, "in", "dir"), filepath.Join(out, "dirlink")))
+
+	objClient := &fakeObjectAPIClient{
+		objects: make(map[string][]byte),
+		tags:    make(map[string][]byte),
+	}
+	pfsClient := &fakePfsAPIClient{}
+	a := &APIServer{
+		pachClient: &client.APIClient{
+			PfsAPIClient:    pfsClient,
+			ObjectAPIClient: objClient,
+		},
+	}
+	inputs := []*Input{{
+		Name: "in",
+		FileInfo: &pfs.FileInfo{
+			File: &pfs.File{Commit: client.NewCommit("in", "commit")},
+		},
+	}}
+	logger := &taggedLogger{marshaler: &jsonpb.Marshaler{}}
+	uploaded, err := a.uploadOutput(context.Background(), root, out, "tag", logger, inputs)
+	require.NoError(t, err)
+	// Only the regular file is uploaded, the symlinks reference the input.
+	require.Equal(t, int64(len("output")), uploaded)
+	require.Equal(t, 2, len(objClient.objects)) // the regular file and the hashtree
+	require.Equal(t, 2, len(pfsClient.inspected))
+	for _, file := range pfsClient.inspected {
+		require.Equal(t, "dir/file", file.Path)
+		require.Equal(t, "commit", file.Commit.ID)
+	}
+
+	treeBytes, ok := objClient.tags["tag"]
+	require.True(t, ok)
+	tree, err := hashtree.Deserialize(treeBytes)
+	require.NoError(t, err)
+	node, err := tree.Get("/regular")
+	require.NoError(t, err)
+	sum := sha256.Sum256([]byte("output"))
+	require.Equal(t, hex.EncodeToString(sum[:]), node.FileNode.Objects[0].Hash)
+	for _, path := range []string{"/link", "/dirlink/file"} {
+		node, err := tree.Get(path)
+		require.NoError(t, err)
+		require.Equal(t, "commit:dir/file", node.FileNode.Objects[0].Hash)
+	}
+	_, err = tree.Get("/dirlink")
+	require.NoError(t, err)
+}
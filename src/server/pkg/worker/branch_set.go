@@ -216,7 +216,7 @@ func (a *APIServer) _rootInputs(ctx context.Context, atomInputs []*pps.AtomInput
 			continue
 		}
 		// if the repo has nonzero provenance we know that it's a pipeline
-		pipelineInfo, err := ppsClient.InspectPipeline(ctx, &pps.InspectPipelineRequest{client.NewPipeline(atomInput.Repo)})
+		pipelineInfo, err := ppsClient.InspectPipeline(ctx, &pps.InspectPipelineRequest{Pipeline: client.NewPipeline(atomInput.Repo)})
 		if err != nil {
 			return nil, err
 		}
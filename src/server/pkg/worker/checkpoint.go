@@ -0,0 +1,75 @@
+package worker
+
+import (
+	"bytes"
+
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+
+	protolion "go.pedge.io/lion/proto"
+)
+
+// loadCheckpoint returns the outputs of the datums in df that the checkpoint
+// in object records as processed, by the datums' indices. Checkpoints are
+// only an optimization, since processed datums would be skipped by the
+// workers anyway, so a checkpoint that can't be read is ignored, as is any
+// datum whose output doesn't match it.
+func (a *APIServer) loadCheckpoint(object *pfs.Object, df datumFactory) (map[int]*pfs.Tag, int64) {
+	result := make(map[int]*pfs.Tag)
+	if object == nil {
+		return result, 0
+	}
+	var buffer bytes.Buffer
+	if err := a.pachClient.GetObject(object.Hash, &buffer); err != nil {
+		protolion.Errorf("error reading datum checkpoint %s, ignoring it: %v", object.Hash, err)
+		return result, 0
+	}
+	checkpoint := &DatumCheckpoint{}
+	if err := checkpoint.Unmarshal(buffer.Bytes()); err != nil || len(checkpoint.Processed) != len(checkpoint.Tags) {
+		protolion.Errorf("invalid datum checkpoint %s, ignoring it", object.Hash)
+		return result, 0
+	}
+	for i, index := range checkpoint.Processed {
+		if index < 0 || int(index) >= df.Len() {
+			continue
+		}
+		tag, err := HashDatum(a.pipelineInfo, df.Datum(int(index)))
+		if err != nil || tag != checkpoint.Tags[i].Name {
+			continue
+		}
+		result[int(index)] = checkpoint.Tags[i]
+	}
+	skipped := checkpoint.Skipped
+	if skipped > int64(len(result)) {
+		skipped = int64(len(result))
+	}
+	return result, skipped
+}
+
+// CheckpointLogs returns the logs that the datum checkpoint in data records
+// as waiting to be committed. Nothing in PFS refers to them yet, so while the
+// checkpoint's job is running they're only known to be in use through it.
+func CheckpointLogs(data []byte) ([]*pfs.Object, error) {
+	checkpoint := &DatumCheckpoint{}
+	if err := checkpoint.Unmarshal(data); err != nil {
+		return nil, err
+	}
+	return checkpoint.Logs, nil
+}
+
+// saveCheckpoint stores a checkpoint of the processed datums, with the
+// outputs in tags and the logs that are waiting to be committed in logs, and
+// returns the object it's stored in.
+func (a *APIServer) saveCheckpoint(processed []int64, tags []*pfs.Tag, skipped int64, logs []*pfs.Object) (*pfs.Object, error) {
+	checkpoint := &DatumCheckpoint{
+		Processed: processed,
+		Tags:      tags,
+		Skipped:   skipped,
+		Logs:      logs,
+	}
+	data, err := checkpoint.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	object, _, err := a.pachClient.PutObject(bytes.NewReader(data))
+	return object, err
+}
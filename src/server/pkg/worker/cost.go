@@ -0,0 +1,181 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"go.pedge.io/lion/proto"
+
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/resource"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	kube_labels "k8s.io/kubernetes/pkg/labels"
+)
+
+const (
+	// costSampleInterval is how often the master samples the resources that
+	// the worker pods use while a job runs.
+	costSampleInterval = 30 * time.Second
+	// instanceTypeLabel is the label that cloud providers set on nodes to
+	// their instance type.
+	instanceTypeLabel = "beta.kubernetes.io/instance-type"
+)
+
+// podMetricsList is the part of metrics-server's PodMetricsList that we use.
+type podMetricsList struct {
+	Items []struct {
+		Containers []struct {
+			Usage map[string]string `json:"usage"`
+		} `json:"containers"`
+	} `json:"items"`
+}
+
+// trackCost samples the resources that the pipeline's worker pods use every
+// costSampleInterval and adds them to the job's cost, until ctx is done.
+// Jobs of a pipeline run one at a time, so everything its pods use while
+// the job runs is attributed to the job.
+func (a *APIServer) trackCost(ctx context.Context, jobID string) {
+	// nodeTypes caches the instance type of each node.
+	nodeTypes := make(map[string]string)
+	// warned is set once we've logged that metrics-server isn't available.
+	warned := false
+	ticker := time.NewTicker(costSampleInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		workerNodeTypes, err := a.workerNodeTypes(nodeTypes)
+		if err != nil {
+			protolion.Errorf("error getting the node types of job %s's workers: %v", jobID, err)
+		}
+		cpu, memory, err := a.workerUsage()
+		if err != nil && !warned {
+			protolion.Infof("not measuring job resource usage, metrics-server isn't available: %v", err)
+			warned = true
+		}
+		measured := err == nil
+		if _, err := a.batcher.NewSTM(ctx, func(stm col.STM) error {
+			jobs := a.jobs.ReadWrite(stm)
+			jobInfo := new(pps.JobInfo)
+			if err := jobs.Get(jobID, jobInfo); err != nil {
+				return err
+			}
+			if jobInfo.Cost == nil {
+				jobInfo.Cost = &pps.JobCost{}
+			}
+			cost := jobInfo.Cost
+			cost.NodeTypes = mergeNodeTypes(cost.NodeTypes, workerNodeTypes)
+			if measured {
+				interval := costSampleInterval.Seconds()
+				cost.CpuSeconds += cpu * interval
+				cost.MemoryByteSeconds += float64(memory) * interval
+				if memory > cost.PeakMemoryBytes {
+					cost.PeakMemoryBytes = memory
+				}
+				cost.Samples++
+				cost.CurrentCpuCores = cpu
+				cost.CurrentMemoryBytes = memory
+			}
+			jobs.Put(jobID, jobInfo)
+			return nil
+		}); err != nil {
+			protolion.Errorf("error updating the cost of job %s: %v", jobID, err)
+		}
+	}
+}
+
+// workerNodeTypes returns the instance types of the nodes that the worker
+// pods are on, nodeTypes caches them by node.
+func (a *APIServer) workerNodeTypes(nodeTypes map[string]string) ([]string, error) {
+	pods, err := a.kubeClient.Pods(a.namespace).List(api.ListOptions{
+		TypeMeta: unversioned.TypeMeta{
+			Kind:       "ListOptions",
+			APIVersion: "v1",
+		},
+		LabelSelector: kube_labels.SelectorFromSet(map[string]string{"app": pps.PipelineRcName(a.pipelineInfo.Pipeline.Name, a.pipelineInfo.Version)}),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var result []string
+	for _, pod := range pods.Items {
+		if pod.Spec.NodeName == "" {
+			continue
+		}
+		nodeType, ok := nodeTypes[pod.Spec.NodeName]
+		if !ok {
+			node, err := a.kubeClient.Nodes().Get(pod.Spec.NodeName)
+			if err != nil {
+				return nil, err
+			}
+			nodeType = node.ObjectMeta.Labels[instanceTypeLabel]
+			nodeTypes[pod.Spec.NodeName] = nodeType
+		}
+		if nodeType != "" {
+			result = append(result, nodeType)
+		}
+	}
+	return result, nil
+}
+
+// workerUsage returns the cpu, in cores, and the memory, in bytes, that the
+// worker pods are using, as reported by metrics-server.
+func (a *APIServer) workerUsage() (float64, uint64, error) {
+	body, err := a.kubeClient.Get().
+		AbsPath("/apis/metrics.k8s.io/v1beta1/namespaces", a.namespace, "pods").
+		Param("labelSelector", "app="+pps.PipelineRcName(a.pipelineInfo.Pipeline.Name, a.pipelineInfo.Version)).
+		DoRaw()
+	if err != nil {
+		return 0, 0, err
+	}
+	var metrics podMetricsList
+	if err := json.Unmarshal(body, &metrics); err != nil {
+		return 0, 0, err
+	}
+	var cpu float64
+	var memory uint64
+	for _, pod := range metrics.Items {
+		for _, container := range pod.Containers {
+			if value, ok := container.Usage["cpu"]; ok {
+				quantity, err := resource.ParseQuantity(value)
+				if err != nil {
+					return 0, 0, err
+				}
+				cpu += float64(quantity.MilliValue()) / 1000
+			}
+			if value, ok := container.Usage["memory"]; ok {
+				quantity, err := resource.ParseQuantity(value)
+				if err != nil {
+					return 0, 0, err
+				}
+				memory += uint64(quantity.Value())
+			}
+		}
+	}
+	return cpu, memory, nil
+}
+
+// mergeNodeTypes returns the sorted union of a and b, without duplicates.
+func mergeNodeTypes(a []string, b []string) []string {
+	set := make(map[string]bool)
+	for _, nodeType := range a {
+		set[nodeType] = true
+	}
+	for _, nodeType := range b {
+		set[nodeType] = true
+	}
+	var result []string
+	for nodeType := range set {
+		result = append(result, nodeType)
+	}
+	sort.Strings(result)
+	return result
+}
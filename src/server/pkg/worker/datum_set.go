@@ -2,6 +2,8 @@ package worker
 
 import (
 	"fmt"
+	"regexp"
+	"sort"
 
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
@@ -16,8 +18,9 @@ type datumFactory interface {
 }
 
 type atomDatumFactory struct {
-	inputs []*Input
-	index  int
+	// groups are the datums, each of which is a single file unless the input
+	// has a group_by expression.
+	groups [][]*Input
 }
 
 func newAtomDatumFactory(ctx context.Context, pfsClient pfs.APIClient, input *pps.AtomInput) (datumFactory, error) {
@@ -29,23 +32,67 @@ func newAtomDatumFactory(ctx context.Context, pfsClient pfs.APIClient, input *pp
 	if err != nil {
 		return nil, err
 	}
+	if input.GroupBy == "" {
+		for _, fileInfo := range fileInfos.FileInfo {
+			result.groups = append(result.groups, []*Input{newAtomInput(input, fileInfo)})
+		}
+		return result, nil
+	}
+	groupBy, err := regexp.Compile(input.GroupBy)
+	if err != nil {
+		return nil, fmt.Errorf("error compiling group_by of input %s: %v", input.Name, err)
+	}
+	groups := make(map[string][]*Input)
+	var keys []string
 	for _, fileInfo := range fileInfos.FileInfo {
-		result.inputs = append(result.inputs, &Input{
-			FileInfo: fileInfo,
-			Name:     input.Name,
-			Lazy:     input.Lazy,
-			Branch:   input.Branch,
-		})
+		key, ok := groupKey(groupBy, fileInfo.File.Path)
+		if !ok {
+			continue
+		}
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		datumInput := newAtomInput(input, fileInfo)
+		datumInput.GroupKey = key
+		groups[key] = append(groups[key], datumInput)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		result.groups = append(result.groups, groups[key])
 	}
 	return result, nil
 }
 
+func newAtomInput(input *pps.AtomInput, fileInfo *pfs.FileInfo) *Input {
+	return &Input{
+		FileInfo: fileInfo,
+		Name:     input.Name,
+		Lazy:     input.Lazy,
+		Branch:   input.Branch,
+		Mount:    input.Mount,
+	}
+}
+
+// groupKey returns the key that groupBy gives path, which is the first group
+// it captures, or the whole match if it has no groups. ok is false if
+// groupBy doesn't match path.
+func groupKey(groupBy *regexp.Regexp, path string) (key string, ok bool) {
+	match := groupBy.FindStringSubmatch(path)
+	if match == nil {
+		return "", false
+	}
+	if len(match) > 1 {
+		return match[1], true
+	}
+	return match[0], true
+}
+
 func (d *atomDatumFactory) Len() int {
-	return len(d.inputs)
+	return len(d.groups)
 }
 
 func (d *atomDatumFactory) Datum(i int) []*Input {
-	return []*Input{d.inputs[i]}
+	return d.groups[i]
 }
 
 type unionDatumFactory struct {
@@ -132,3 +179,17 @@ func newDatumFactory(ctx context.Context, pfsClient pfs.APIClient, input *pps.In
 	}
 	return nil, fmt.Errorf("unrecognized input type")
 }
+
+// Datums returns the datums of input, each of which is the files that are
+// processed together, in the order that jobs process them.
+func Datums(ctx context.Context, pfsClient pfs.APIClient, input *pps.Input) ([][]*Input, error) {
+	df, err := newDatumFactory(ctx, pfsClient, input)
+	if err != nil {
+		return nil, err
+	}
+	var result [][]*Input
+	for i := 0; i < df.Len(); i++ {
+		result = append(result, df.Datum(i))
+	}
+	return result, nil
+}
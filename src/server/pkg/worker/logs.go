@@ -0,0 +1,171 @@
+package worker
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"golang.org/x/net/context"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
+)
+
+// datumLogs collects the log lines of a datum, so that they can be committed
+// to the pipeline's logs branch once the job finishes. The lines are kept up
+// to a limit, after which they're dropped. datumLogs is shared by the loggers
+// of the datum's user code, which write to it concurrently.
+type datumLogs struct {
+	mu        sync.Mutex
+	buffer    bytes.Buffer
+	limit     int64
+	truncated bool
+}
+
+// add appends line to the logs, unless that would take them over their
+// limit.
+func (l *datumLogs) add(line string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if int64(l.buffer.Len()+len(line)+1) > l.limit {
+		l.truncated = true
+		return
+	}
+	l.buffer.WriteString(line)
+	l.buffer.WriteString("\n")
+}
+
+// contents returns the logs, followed by marker if any lines were dropped.
+func (l *datumLogs) contents(marker string) []byte {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	result := append([]byte{}, l.buffer.Bytes()...)
+	if l.truncated {
+		result = append(result, marker+"\n"...)
+	}
+	return result
+}
+
+// uploadLogs stores the logs that logger collected as an object, and records
+// it in resp, so that the master can commit it to the logs branch.
+func (a *APIServer) uploadLogs(logger *taggedLogger, resp *ProcessResponse) error {
+	marker, err := logger.marshal(fmt.Sprintf("logs truncated: the datum logged more than %s", a.pipelineInfo.Logs.MaxBytesPerDatum))
+	if err != nil {
+		return err
+	}
+	object, size, err := a.pachClient.PutObject(bytes.NewReader(logger.logs.contents(marker)))
+	if err != nil {
+		return err
+	}
+	resp.Log = object
+	resp.LogSize = size
+	return nil
+}
+
+// commitLogs commits the logs of a job's datums to the pipeline's logs
+// branch, under /<job ID>/<datum hash>. The logs of jobs that have been
+// deleted, or that started longer than the retention period ago, are removed
+// in the same commit.
+func (a *APIServer) commitLogs(ctx context.Context, jobInfo *pps.JobInfo, datums []*pendingDatum) error {
+	logs := a.pipelineInfo.Logs
+	pfsClient := a.pachClient.PfsAPIClient
+	tree := hashtree.NewHashTree()
+	commitInfo, err := pfsClient.InspectCommit(ctx, &pfs.InspectCommitRequest{
+		Commit: client.NewCommit(jobInfo.OutputRepo.Name, logs.Branch),
+	})
+	if err != nil && !isNotFoundErr(err) {
+		return err
+	}
+	if err == nil && commitInfo.Tree != nil {
+		var buffer bytes.Buffer
+		if err := a.pachClient.GetObject(commitInfo.Tree.Hash, &buffer); err != nil {
+			return err
+		}
+		parent, err := hashtree.Deserialize(buffer.Bytes())
+		if err != nil {
+			return err
+		}
+		tree = parent.Open()
+	}
+	if err := a.pruneLogs(ctx, tree, jobInfo.Job.ID); err != nil {
+		return err
+	}
+	for _, datum := range datums {
+		hash, err := HashDatum(a.pipelineInfo, datum.files)
+		if err != nil {
+			return err
+		}
+		if err := tree.PutFile(path.Join(jobInfo.Job.ID, hash), []*pfs.Object{datum.log}, datum.logSize); err != nil {
+			return err
+		}
+	}
+	finished, err := tree.Finish()
+	if err != nil {
+		return err
+	}
+	data, err := hashtree.Serialize(finished)
+	if err != nil {
+		return err
+	}
+	object, _, err := a.pachClient.PutObject(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	_, err = pfsClient.BuildCommit(ctx, &pfs.BuildCommitRequest{
+		Parent: &pfs.Commit{
+			Repo: jobInfo.OutputRepo,
+		},
+		Branch: logs.Branch,
+		Tree:   object,
+	})
+	return err
+}
+
+// pruneLogs removes the logs of jobs that have been deleted or are past the
+// retention period from tree, along with any logs of jobID from a previous
+// attempt at running it.
+func (a *APIServer) pruneLogs(ctx context.Context, tree hashtree.OpenHashTree, jobID string) error {
+	var retention time.Duration
+	if a.pipelineInfo.Logs.Retention != nil {
+		var err error
+		retention, err = types.DurationFromProto(a.pipelineInfo.Logs.Retention)
+		if err != nil {
+			return err
+		}
+	}
+	nodes, err := tree.List("/")
+	if err != nil {
+		return err
+	}
+	jobs := a.jobs.ReadOnly(ctx)
+	for _, node := range nodes {
+		prune := node.Name == jobID
+		if !prune {
+			jobInfo := new(pps.JobInfo)
+			if err := jobs.Get(node.Name, jobInfo); err != nil {
+				if _, ok := err.(col.ErrNotFound); !ok {
+					return err
+				}
+				prune = true
+			} else if retention > 0 && jobInfo.Started != nil {
+				started, err := types.TimestampFromProto(jobInfo.Started)
+				if err != nil {
+					return err
+				}
+				prune = time.Since(started) > retention
+			}
+		}
+		if prune {
+			if err := tree.DeleteFile(node.Name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -1,10 +1,10 @@
 package worker
 
 import (
-	"bytes"
 	"context"
 	"fmt"
 	"net/url"
+	"os"
 	"path"
 	"strings"
 	"sync"
@@ -13,6 +13,9 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 	"go.pedge.io/lion/proto"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"k8s.io/kubernetes/pkg/api"
 
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/limit"
@@ -22,7 +25,6 @@ import (
 	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
 	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
 	"github.com/pachyderm/pachyderm/src/server/pkg/dlock"
-	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
 	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsdb"
 	pfs_sync "github.com/pachyderm/pachyderm/src/server/pkg/sync"
@@ -33,6 +35,10 @@ const (
 	// can failed to be processed before we declare that the job has failed.
 	maximumRetriesPerDatum = 3
 
+	// mergeFanIn is the most hashtrees that a worker merges at once when
+	// merging a job's output.
+	mergeFanIn = 64
+
 	masterLockPath = "_master_worker_lock"
 )
 
@@ -77,7 +83,7 @@ func (a *APIServer) jobSpawner(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	pool, err := grpcutil.NewPool(a.kubeClient, a.namespace, pps.PipelineRcName(a.pipelineInfo.Pipeline.Name, a.pipelineInfo.Version), numWorkers, client.PachDialOptions()...)
+	pool, err := grpcutil.NewPool(a.kubeClient, a.namespace, pps.PipelineRcName(a.pipelineInfo.Pipeline.Name, a.pipelineInfo.Version), numWorkers*a.datumConcurrency(), client.PachDialOptions()...)
 	if err != nil {
 		return fmt.Errorf("master: error constructing worker pool: %v; retrying in %v", err)
 	}
@@ -186,6 +192,10 @@ nextInput:
 					if err := a.runJob(ctx, &jobInfo, pool); err != nil {
 						return err
 					}
+				case pps.JobState_JOB_SUCCESS:
+					if err := a.followRewind(&jobInfo); err != nil {
+						return err
+					}
 				}
 				continue nextInput
 			}
@@ -259,10 +269,135 @@ nextInput:
 	}
 }
 
+// mergeTrees merges the hashtrees that a job's datums output into the job's
+// output tree. Rather than merging everything in one process, workers merge
+// up to mergeFanIn trees at a time, and then merge the results, until there's
+// one tree left.
+func (a *APIServer) mergeTrees(ctx context.Context, jobID string, pool *grpcutil.Pool, tags []*pfs.Tag) (*pfs.Object, error) {
+	var requests []*MergeRequest
+	for i := 0; i < len(tags); i += mergeFanIn {
+		requests = append(requests, &MergeRequest{
+			JobID: jobID,
+			Tags:  tags[i:min(i+mergeFanIn, len(tags))],
+		})
+	}
+	if len(requests) == 0 {
+		// The job has no datums, its output is an empty tree.
+		requests = append(requests, &MergeRequest{JobID: jobID})
+	}
+	for {
+		trees := make([]*pfs.Object, len(requests))
+		var g errgroup.Group
+		limiter := limit.New(a.numWorkers)
+		for i, request := range requests {
+			i, request := i, request
+			limiter.Acquire()
+			g.Go(func() error {
+				defer limiter.Release()
+				tree, err := a.merge(ctx, pool, request)
+				if err != nil {
+					return err
+				}
+				trees[i] = tree
+				return nil
+			})
+		}
+		if err := g.Wait(); err != nil {
+			return nil, err
+		}
+		if len(trees) == 1 {
+			return trees[0], nil
+		}
+		requests = nil
+		for i := 0; i < len(trees); i += mergeFanIn {
+			requests = append(requests, &MergeRequest{
+				JobID:   jobID,
+				Objects: trees[i:min(i+mergeFanIn, len(trees))],
+			})
+		}
+	}
+}
+
+// merge sends request to a worker, retrying until it succeeds or ctx is
+// cancelled.
+func (a *APIServer) merge(ctx context.Context, pool *grpcutil.Pool, request *MergeRequest) (*pfs.Object, error) {
+	var tree *pfs.Object
+	b := backoff.NewInfiniteBackOff()
+	if err := backoff.RetryNotify(func() error {
+		conn, err := pool.Get(ctx)
+		if err != nil {
+			return fmt.Errorf("error from connection pool: %v", err)
+		}
+		resp, err := NewWorkerClient(conn).Merge(ctx, request)
+		if err != nil {
+			if err := conn.Close(); err != nil {
+				protolion.Errorf("error closing conn: %+v", err)
+			}
+			return fmt.Errorf("Merge() call failed: %v", err)
+		}
+		if err := pool.Put(conn); err != nil {
+			protolion.Errorf("error Putting conn: %+v", err)
+		}
+		tree = resp.Tree
+		return nil
+	}, b, func(err error, d time.Duration) error {
+		select {
+		case <-ctx.Done():
+			return err
+		default:
+		}
+		protolion.Errorf("job %s failed to merge hashtrees with: %+v, retrying in: %+v", request.JobID, err, d)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return tree, nil
+}
+
+// followRewind is called for an input that a job has already processed. If
+// the input's commits are still the heads of their branches, it's because
+// the input branches were moved back to them, e.g. to revert a bad commit,
+// so the job's output branch is moved back to the job's output commit, which
+// downstream pipelines then follow in turn.
+func (a *APIServer) followRewind(jobInfo *pps.JobInfo) error {
+	if jobInfo.OutputCommit == nil || jobInfo.OutputBranch == "" {
+		return nil
+	}
+	heads := true
+	var visitErr error
+	pps.VisitInput(jobInfo.Input, func(input *pps.Input) {
+		if input.Atom == nil || !heads || visitErr != nil {
+			return
+		}
+		headInfo, err := a.pachClient.InspectCommit(input.Atom.Repo, input.Atom.Branch)
+		if err != nil {
+			visitErr = err
+			return
+		}
+		heads = headInfo.Commit.ID == input.Atom.Commit
+	})
+	if visitErr != nil || !heads {
+		return visitErr
+	}
+	outputRepo := jobInfo.OutputCommit.Repo.Name
+	outputHead, err := a.pachClient.InspectCommit(outputRepo, jobInfo.OutputBranch)
+	if err == nil && outputHead.Commit.ID == jobInfo.OutputCommit.ID {
+		return nil
+	}
+	protolion.Infof("input of job %s was rewound, moving branch %s of %s back to %s", jobInfo.Job.ID, jobInfo.OutputBranch, outputRepo, jobInfo.OutputCommit.ID)
+	return a.pachClient.SetBranch(outputRepo, jobInfo.OutputCommit.ID, jobInfo.OutputBranch)
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
 // jobManager feeds datums to jobs
 func (a *APIServer) runJob(ctx context.Context, jobInfo *pps.JobInfo, pool *grpcutil.Pool) error {
 	pfsClient := a.pachClient.PfsAPIClient
-	objectClient := a.pachClient.ObjectAPIClient
 	ppsClient := a.pachClient.PpsAPIClient
 
 	jobID := jobInfo.Job.ID
@@ -319,20 +454,45 @@ func (a *APIServer) runJob(ctx context.Context, jobInfo *pps.JobInfo, pool *grpc
 		}
 
 		// Set the state of this job to 'RUNNING'
-		_, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+		var checkpoint *pfs.Object
+		var setupTime time.Duration
+		var downloadBytes, uploadBytes int64
+		_, err := a.batcher.NewSTM(ctx, func(stm col.STM) error {
 			jobs := a.jobs.ReadWrite(stm)
 			jobInfo := new(pps.JobInfo)
 			if err := jobs.Get(jobID, jobInfo); err != nil {
 				return err
 			}
+			checkpoint = jobInfo.DatumCheckpoint
+			downloadBytes = jobInfo.DownloadBytes
+			uploadBytes = jobInfo.UploadBytes
+			if jobInfo.SetupTime != nil {
+				// The job is being restarted, keep the setup time that
+				// was already counted.
+				var err error
+				if setupTime, err = types.DurationFromProto(jobInfo.SetupTime); err != nil {
+					return err
+				}
+			}
 			return a.updateJobState(stm, jobInfo, pps.JobState_JOB_RUNNING)
 		})
 		if err != nil {
 			return err
 		}
+		go a.trackCost(ctx, jobID)
+
+		if hooks := a.pipelineInfo.Transform.Hooks; hooks != nil && len(hooks.JobStart) > 0 {
+			logger := a.jobLogger(jobID)
+			environ := append(os.Environ(), fmt.Sprintf("PACH_JOB_ID=%s", jobID))
+			if err := a.runHook(ctx, logger, client.PPSInputPrefix, environ, "job start", hooks.JobStart); err != nil {
+				if ctx.Err() != nil {
+					return err
+				}
+				return a.failJob(ctx, jobID)
+			}
+		}
 
 		failed := false
-		limiter := limit.New(a.numWorkers)
 		// process all datums
 		df, err := newDatumFactory(ctx, pfsClient, jobInfo.Input)
 		if err != nil {
@@ -350,179 +510,215 @@ func (a *APIServer) runJob(ctx context.Context, jobInfo *pps.JobInfo, pool *grpc
 			}
 			newBranchParentCommit = newBranchCommitInfo.ParentCommit
 		}
-		tree := hashtree.NewHashTree()
-		var treeMu sync.Mutex
+		// The datums' output hashtrees, which are merged once they've all
+		// been processed.
+		var tags []*pfs.Tag
+		// The indices of the processed datums, in the same order as tags,
+		// which are checkpointed with the job's progress.
+		var processedIndices []int64
+		// The datums whose logs are committed to the logs branch, if the
+		// pipeline persists its logs.
+		var logged []*pendingDatum
+		var tagsMu sync.Mutex
 
-		processedData := int64(0)
+		// If the job is being restarted, the datums that it had already
+		// processed are resumed from its checkpoint.
+		resumed, skippedData := a.loadCheckpoint(checkpoint, df)
+		for i, tag := range resumed {
+			tags = append(tags, tag)
+			processedIndices = append(processedIndices, int64(i))
+		}
+		processedData := int64(len(resumed))
 		setProcessedData := int64(0)
 		totalData := int64(df.Len())
 		var progressMu sync.Mutex
-		updateProgress := func(processed int64) {
+		// writeProgress checkpoints the job's progress, and writes it to
+		// etcd. It's called with progressMu held.
+		writeProgress := func() {
+			// we setProcessedData even though the update below may fail,
+			// if we didn't we'd retry updating the progress on the next
+			// datum, this would lead to more accurate progress but
+			// progress isn't that important and we don't want to overwelm
+			// etcd.
+			setProcessedData = processedData
+			tagsMu.Lock()
+			var logs []*pfs.Object
+			for _, datum := range logged {
+				logs = append(logs, datum.log)
+			}
+			object, err := a.saveCheckpoint(append([]int64{}, processedIndices...), append([]*pfs.Tag{}, tags...), skippedData, logs)
+			tagsMu.Unlock()
+			if err != nil {
+				protolion.Errorf("error checkpointing job progress: %+v", err)
+			}
+			if _, err := a.batcher.NewSTM(ctx, func(stm col.STM) error {
+				jobs := a.jobs.ReadWrite(stm)
+				jobInfo := new(pps.JobInfo)
+				if err := jobs.Get(jobID, jobInfo); err != nil {
+					return err
+				}
+				jobInfo.DataProcessed = processedData
+				jobInfo.DataSkipped = skippedData
+				jobInfo.DataTotal = totalData
+				jobInfo.DownloadBytes = downloadBytes
+				jobInfo.UploadBytes = uploadBytes
+				if setupTime > 0 {
+					jobInfo.SetupTime = types.DurationProto(setupTime)
+				}
+				if object != nil {
+					jobInfo.DatumCheckpoint = object
+				}
+				jobs.Put(jobInfo.Job.ID, jobInfo)
+				return nil
+			}); err != nil {
+				protolion.Errorf("error updating job progress: %+v", err)
+			}
+		}
+		// updateProgress counts datum, if it isn't nil, as processed, and
+		// writes the job's progress to etcd if it's changed enough.
+		updateProgress := func(datum *pendingDatum) {
 			progressMu.Lock()
 			defer progressMu.Unlock()
-			processedData += processed
+			if datum != nil {
+				processedData++
+				if datum.skipped {
+					skippedData++
+				}
+				setupTime += datum.setupTime
+				downloadBytes += datum.downloadBytes
+				uploadBytes += datum.uploadBytes
+			}
 			// so as not to overwhelm etcd we update at most 100 times per job
 			if (float64(processedData-setProcessedData)/float64(totalData)) > .01 ||
 				processedData == 0 || processedData == totalData {
-				// we setProcessedData even though the update below may fail,
-				// if we didn't we'd retry updating the progress on the next
-				// datum, this would lead to more accurate progress but
-				// progress isn't that important and we don't want to overwelm
-				// etcd.
-				setProcessedData = processedData
-				if _, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
-					jobs := a.jobs.ReadWrite(stm)
-					jobInfo := new(pps.JobInfo)
-					if err := jobs.Get(jobID, jobInfo); err != nil {
-						return err
-					}
-					jobInfo.DataProcessed = processedData
-					jobInfo.DataTotal = totalData
-					jobs.Put(jobInfo.Job.ID, jobInfo)
-					return nil
-				}); err != nil {
-					protolion.Errorf("error updating job progress: %+v", err)
-				}
+				writeProgress()
 			}
 		}
 		// set the initial values
-		updateProgress(0)
+		updateProgress(nil)
+		// If this worker's pod is deleted, the job's latest progress is
+		// written out, so that the next master doesn't redo any of it.
+		a.setCheckpointer(jobID, func() {
+			progressMu.Lock()
+			defer progressMu.Unlock()
+			writeProgress()
+		})
+		defer a.setCheckpointer(jobID, nil)
 
-		for i := 0; i < df.Len(); i++ {
-			limiter.Acquire()
-			files := df.Datum(i)
-			var parentOutputTag *pfs.Tag
-			if newBranchParentCommit != nil {
-				var parentFiles []*Input
-				for _, file := range files {
-					parentFile := proto.Clone(file).(*Input)
-					if file.FileInfo.File.Commit.Repo.Name == jobInfo.NewBranch.Head.Repo.Name && file.Branch == jobInfo.NewBranch.Name {
-						parentFileInfo, err := pfsClient.InspectFile(ctx, &pfs.InspectFileRequest{
-							File: client.NewFile(parentFile.FileInfo.File.Commit.Repo.Name, newBranchParentCommit.ID, parentFile.FileInfo.File.Path),
-						})
-						if err != nil {
-							if !isNotFoundErr(err) {
-								return err
-							}
-							// we didn't find a match for this file,
-							// so we know there's no matching datum
-							break
-						}
-						file.ParentCommit = parentFileInfo.File.Commit
-						parentFile.FileInfo = parentFileInfo
-					}
-					parentFiles = append(parentFiles, parentFile)
-				}
-				if len(parentFiles) == len(files) {
-					_parentOutputTag, err := HashDatum(pipelineInfo, parentFiles)
-					if err != nil {
-						return err
-					}
-					parentOutputTag = &pfs.Tag{Name: _parentOutputTag}
-				}
-			}
+		// Datums are sent to the workers by processors, each of which sends
+		// them to one worker, along with the datum it'll send next, so that
+		// the worker can prefetch it. Each worker processes
+		// datumConcurrency datums at once.
+		pending := make(chan *pendingDatum)
+		var processors sync.WaitGroup
+		for i := 0; i < a.numWorkers*a.datumConcurrency(); i++ {
+			processors.Add(1)
 			go func() {
-				userCodeFailures := 0
-				defer limiter.Release()
-				b := backoff.NewInfiniteBackOff()
-				b.Multiplier = 1
-				if err := backoff.RetryNotify(func() error {
-					conn, err := pool.Get(ctx)
-					if err != nil {
-						return fmt.Errorf("error from connection pool: %v", err)
+				defer processors.Done()
+				var conn *grpc.ClientConn
+				defer func() {
+					if conn == nil {
+						return
 					}
-					workerClient := NewWorkerClient(conn)
-					resp, err := workerClient.Process(ctx, &ProcessRequest{
-						JobID:        jobInfo.Job.ID,
-						Data:         files,
-						ParentOutput: parentOutputTag,
-					})
-					if err != nil {
-						if err := conn.Close(); err != nil {
-							protolion.Errorf("error closing conn: %+v", err)
-						}
-						return fmt.Errorf("Process() call failed: %v", err)
-					}
-					defer func() {
-						if err := pool.Put(conn); err != nil {
-							protolion.Errorf("error Putting conn: %+v", err)
-						}
-					}()
-					if resp.Failed {
-						userCodeFailures++
-						return fmt.Errorf("user code failed for datum %v", files)
+					if err := pool.Put(conn); err != nil {
+						protolion.Errorf("error Putting conn: %+v", err)
 					}
-					getTagClient, err := objectClient.GetTag(ctx, resp.Tag)
-					if err != nil {
-						return fmt.Errorf("failed to retrieve hashtree after processing for datum %v: %v", files, err)
+				}()
+				cur, ok := <-pending
+				for ok {
+					// Take the next datum now, if one's waiting, so that the
+					// worker can prefetch it.
+					var next *pendingDatum
+					select {
+					case next = <-pending:
+					default:
 					}
-					var buffer bytes.Buffer
-					if err := grpcutil.WriteFromStreamingBytesClient(getTagClient, &buffer); err != nil {
-						return fmt.Errorf("failed to retrieve hashtree after processing for datum %v: %v", files, err)
+					done := a.processDatum(ctx, pool, &conn, jobInfo, cur, next, &failed)
+					tagsMu.Lock()
+					if done {
+						tags = append(tags, cur.tag)
+						processedIndices = append(processedIndices, int64(cur.index))
 					}
-					subTree, err := hashtree.Deserialize(buffer.Bytes())
-					if err != nil {
-						return fmt.Errorf("failed deserialize hashtree after processing for datum %v: %v", files, err)
+					if cur.log != nil {
+						logged = append(logged, cur)
 					}
-					treeMu.Lock()
-					defer treeMu.Unlock()
-					return tree.Merge(subTree)
-				}, b, func(err error, d time.Duration) error {
-					select {
-					case <-ctx.Done():
-						return err
-					default:
+					tagsMu.Unlock()
+					if done {
+						go updateProgress(cur)
 					}
-					if userCodeFailures > maximumRetriesPerDatum {
-						protolion.Errorf("job %s failed to process datum %+v %d times failing", jobID, files, userCodeFailures)
-						failed = true
-						return err
+					if next != nil {
+						cur = next
+					} else {
+						cur, ok = <-pending
 					}
-					protolion.Errorf("job %s failed to process datum %+v with: %+v, retrying in: %+v", jobID, files, err, d)
-					return nil
-				}); err == nil {
-					go updateProgress(1)
 				}
 			}()
 		}
-		limiter.Wait()
-
-		// check if the job failed
-		if failed {
-			_, err = col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
-				jobs := a.jobs.ReadWrite(stm)
-				jobInfo := new(pps.JobInfo)
-				if err := jobs.Get(jobID, jobInfo); err != nil {
-					return err
+		if err := func() error {
+			defer close(pending)
+			for i := 0; i < df.Len(); i++ {
+				if _, ok := resumed[i]; ok {
+					continue
 				}
-				jobInfo.Finished = now()
-				return a.updateJobState(stm, jobInfo, pps.JobState_JOB_FAILURE)
-			})
+				files := df.Datum(i)
+				var parentOutputTag *pfs.Tag
+				if newBranchParentCommit != nil {
+					var parentFiles []*Input
+					for _, file := range files {
+						parentFile := proto.Clone(file).(*Input)
+						if file.FileInfo.File.Commit.Repo.Name == jobInfo.NewBranch.Head.Repo.Name && file.Branch == jobInfo.NewBranch.Name {
+							parentFileInfo, err := pfsClient.InspectFile(ctx, &pfs.InspectFileRequest{
+								File: client.NewFile(parentFile.FileInfo.File.Commit.Repo.Name, newBranchParentCommit.ID, parentFile.FileInfo.File.Path),
+							})
+							if err != nil {
+								if !isNotFoundErr(err) {
+									return err
+								}
+								// we didn't find a match for this file,
+								// so we know there's no matching datum
+								break
+							}
+							file.ParentCommit = parentFileInfo.File.Commit
+							parentFile.FileInfo = parentFileInfo
+						}
+						parentFiles = append(parentFiles, parentFile)
+					}
+					if len(parentFiles) == len(files) {
+						_parentOutputTag, err := HashDatum(pipelineInfo, parentFiles)
+						if err != nil {
+							return err
+						}
+						parentOutputTag = &pfs.Tag{Name: _parentOutputTag}
+					}
+				}
+				pending <- &pendingDatum{
+					index:           i,
+					files:           files,
+					parentOutputTag: parentOutputTag,
+				}
+			}
+			return nil
+		}(); err != nil {
+			processors.Wait()
 			return err
 		}
+		processors.Wait()
 
-		finishedTree, err := tree.Finish()
-		if err != nil {
-			return err
+		// Commit the datums' logs before the job finishes, so that they
+		// can be read as soon as it has. Logs are best effort, so failing
+		// to commit them doesn't fail the job.
+		if a.pipelineInfo.Logs != nil {
+			if err := a.commitLogs(ctx, jobInfo, logged); err != nil {
+				protolion.Errorf("error committing logs for job %s: %v", jobID, err)
+			}
 		}
 
-		data, err := hashtree.Serialize(finishedTree)
-		if err != nil {
-			return err
+		// check if the job failed
+		if failed {
+			return a.failJob(ctx, jobID)
 		}
 
-		putObjClient, err := objectClient.PutObject(ctx)
-		if err != nil {
-			return err
-		}
-		for _, chunk := range grpcutil.Chunk(data, grpcutil.MaxMsgSize/2) {
-			if err := putObjClient.Send(&pfs.PutObjectRequest{
-				Value: chunk,
-			}); err != nil {
-				return err
-			}
-		}
-		object, err := putObjClient.CloseAndRecv()
+		object, err := a.mergeTrees(ctx, jobID, pool, tags)
 		if err != nil {
 			return err
 		}
@@ -544,6 +740,32 @@ func (a *APIServer) runJob(ctx context.Context, jobInfo *pps.JobInfo, pool *grpc
 			return err
 		}
 
+		// Each secondary output is committed to its own branch, with the
+		// same provenance as the output commit.
+		var secondaryOutputCommits []*pfs.Commit
+		for _, output := range a.pipelineInfo.SecondaryOutputs {
+			var outputTags []*pfs.Tag
+			for _, tag := range tags {
+				outputTags = append(outputTags, &pfs.Tag{Name: secondaryOutputTag(tag.Name, output.Name)})
+			}
+			object, err := a.mergeTrees(ctx, jobID, pool, outputTags)
+			if err != nil {
+				return err
+			}
+			commit, err := pfsClient.BuildCommit(ctx, &pfs.BuildCommitRequest{
+				Parent: &pfs.Commit{
+					Repo: client.NewRepo(output.Repo),
+				},
+				Branch:     output.Branch,
+				Provenance: provenance,
+				Tree:       object,
+			})
+			if err != nil {
+				return err
+			}
+			secondaryOutputCommits = append(secondaryOutputCommits, commit)
+		}
+
 		if jobInfo.Egress != nil {
 			protolion.Infof("Starting egress upload for job (%v)\n", jobInfo)
 			start := time.Now()
@@ -567,20 +789,36 @@ func (a *APIServer) runJob(ctx context.Context, jobInfo *pps.JobInfo, pool *grpc
 
 		// Record the job's output commit and 'Finished' timestamp, and mark the job
 		// as a SUCCESS
-		_, err = col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+		var succeededJobInfo *pps.JobInfo
+		_, err = a.batcher.NewSTM(ctx, func(stm col.STM) error {
 			jobs := a.jobs.ReadWrite(stm)
 			jobInfo := new(pps.JobInfo)
 			if err := jobs.Get(jobID, jobInfo); err != nil {
 				return err
 			}
+			succeededJobInfo = jobInfo
 			jobInfo.OutputCommit = outputCommit
+			jobInfo.SecondaryOutputCommits = secondaryOutputCommits
 			jobInfo.Finished = now()
 			// By definition, we will have processed all datums at this point
 			jobInfo.DataProcessed = totalData
+			progressMu.Lock()
+			jobInfo.DataSkipped = skippedData
+			jobInfo.DownloadBytes = downloadBytes
+			jobInfo.UploadBytes = uploadBytes
+			if setupTime > 0 {
+				jobInfo.SetupTime = types.DurationProto(setupTime)
+			}
+			progressMu.Unlock()
 			// likely already set but just in case it failed
 			jobInfo.DataTotal = totalData
 			return a.updateJobState(stm, jobInfo, pps.JobState_JOB_SUCCESS)
 		})
+		if err == nil {
+			a.runJobEndHook(jobID, pps.JobState_JOB_SUCCESS)
+			go callWebhooks(succeededJobInfo)
+			go a.sendNotifications(succeededJobInfo)
+		}
 		return err
 	}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
 		select {
@@ -600,7 +838,7 @@ func (a *APIServer) runJob(ctx context.Context, jobInfo *pps.JobInfo, pool *grpc
 		protolion.Errorf("error running jobManager for job %s: %v; retrying in %v", jobInfo.Job.ID, err, d)
 
 		// Increment the job's restart count
-		_, err = col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+		_, err = a.batcher.NewSTM(ctx, func(stm col.STM) error {
 			jobs := a.jobs.ReadWrite(stm)
 			jobInfo := new(pps.JobInfo)
 			if err := jobs.Get(jobID, jobInfo); err != nil {
@@ -619,6 +857,136 @@ func (a *APIServer) runJob(ctx context.Context, jobInfo *pps.JobInfo, pool *grpc
 	return nil
 }
 
+// failJob marks a job as failed, and runs what's run when a job finishes.
+func (a *APIServer) failJob(ctx context.Context, jobID string) error {
+	var failedJobInfo *pps.JobInfo
+	_, err := a.batcher.NewSTM(ctx, func(stm col.STM) error {
+		jobs := a.jobs.ReadWrite(stm)
+		jobInfo := new(pps.JobInfo)
+		if err := jobs.Get(jobID, jobInfo); err != nil {
+			return err
+		}
+		jobInfo.Finished = now()
+		failedJobInfo = jobInfo
+		return a.updateJobState(stm, jobInfo, pps.JobState_JOB_FAILURE)
+	})
+	if err == nil {
+		a.runJobEndHook(jobID, pps.JobState_JOB_FAILURE)
+		go callWebhooks(failedJobInfo)
+		go a.sendNotifications(failedJobInfo)
+	}
+	return err
+}
+
+// runJobEndHook runs the transform's job_end hook, if it has one, for a job
+// that has finished in state. Failures are logged, since the job's state
+// can't change anymore.
+func (a *APIServer) runJobEndHook(jobID string, state pps.JobState) {
+	hooks := a.pipelineInfo.Transform.Hooks
+	if hooks == nil || len(hooks.JobEnd) == 0 {
+		return
+	}
+	environ := append(os.Environ(),
+		fmt.Sprintf("PACH_JOB_ID=%s", jobID),
+		fmt.Sprintf("%s=%s", client.PPSJobStateEnv, state))
+	// The job's context is cancelled once it has finished, so the hook
+	// gets its own.
+	if err := a.runHook(context.Background(), a.jobLogger(jobID), client.PPSInputPrefix, environ, "job end", hooks.JobEnd); err != nil {
+		protolion.Errorf("error running job end hook for job %s: %v", jobID, err)
+	}
+}
+
+// pendingDatum is a datum that's waiting to be sent to a worker.
+type pendingDatum struct {
+	// index is the datum's index in the job's datums.
+	index           int
+	files           []*Input
+	parentOutputTag *pfs.Tag
+	// tag is the datum's output, it's set once the datum is processed.
+	tag *pfs.Tag
+	// skipped is set if the datum's output had already been computed.
+	skipped bool
+	// log is the datum's logs from its last attempt, and logSize their
+	// size, if the pipeline persists its logs.
+	log     *pfs.Object
+	logSize int64
+	// setupTime is the setup time that the workers reported while
+	// processing the datum.
+	setupTime time.Duration
+	// downloadBytes and uploadBytes are how much data the worker that
+	// processed the datum downloaded and uploaded.
+	downloadBytes int64
+	uploadBytes   int64
+}
+
+// processDatum sends datum to a worker to be processed, along with next, so
+// that the worker can prefetch it. It retries until the datum is processed,
+// or the user code has failed on it too many times, in which case it sets
+// failed. conn is the connection to the worker, it's replaced when it
+// fails. processDatum returns whether the datum was processed.
+func (a *APIServer) processDatum(ctx context.Context, pool *grpcutil.Pool, conn **grpc.ClientConn, jobInfo *pps.JobInfo, datum *pendingDatum, next *pendingDatum, failed *bool) bool {
+	userCodeFailures := 0
+	b := backoff.NewInfiniteBackOff()
+	b.Multiplier = 1
+	return backoff.RetryNotify(func() error {
+		if *conn == nil {
+			c, err := pool.Get(ctx)
+			if err != nil {
+				return fmt.Errorf("error from connection pool: %v", err)
+			}
+			*conn = c
+		}
+		request := &ProcessRequest{
+			JobID:        jobInfo.Job.ID,
+			Data:         datum.files,
+			ParentOutput: datum.parentOutputTag,
+		}
+		if next != nil {
+			request.Next = next.files
+		}
+		resp, err := NewWorkerClient(*conn).Process(ctx, request)
+		if err != nil {
+			if err := (*conn).Close(); err != nil {
+				protolion.Errorf("error closing conn: %+v", err)
+			}
+			*conn = nil
+			return fmt.Errorf("Process() call failed: %v", err)
+		}
+		if resp.Log != nil {
+			datum.log = resp.Log
+			datum.logSize = resp.LogSize
+		}
+		if resp.SetupTime != nil {
+			setupTime, err := types.DurationFromProto(resp.SetupTime)
+			if err == nil {
+				datum.setupTime += setupTime
+			}
+		}
+		if resp.Failed {
+			userCodeFailures++
+			return fmt.Errorf("user code failed for datum %v", datum.files)
+		}
+		datum.tag = resp.Tag
+		datum.skipped = resp.Skipped
+		datum.downloadBytes = resp.DownloadBytes
+		datum.uploadBytes = resp.UploadBytes
+		return nil
+	}, b, func(err error, d time.Duration) error {
+		select {
+		case <-ctx.Done():
+			return err
+		default:
+		}
+		if userCodeFailures > maximumRetriesPerDatum {
+			protolion.Errorf("job %s failed to process datum %+v %d times failing", jobInfo.Job.ID, datum.files, userCodeFailures)
+			*failed = true
+			return err
+		}
+		protolion.Errorf("job %s failed to process datum %+v with: %+v, retrying in: %+v", jobInfo.Job.ID, datum.files, err, d)
+		return nil
+	}) == nil
+}
+
 func (a *APIServer) scaleDownWorkers() error {
 	rc := a.kubeClient.ReplicationControllers(a.namespace)
 	workerRc, err := rc.Get(pps.PipelineRcName(a.pipelineInfo.Pipeline.Name, a.pipelineInfo.Version))
@@ -626,9 +994,7 @@ func (a *APIServer) scaleDownWorkers() error {
 		return err
 	}
 	if workerRc.Spec.Replicas != 1 {
-		workerRc.Spec.Replicas = 1
-		_, err = rc.Update(workerRc)
-		return err
+		return a.setReplicas(workerRc.Name, 1)
 	}
 	return nil
 }
@@ -644,13 +1010,25 @@ func (a *APIServer) scaleUpWorkers() error {
 		return err
 	}
 	if workerRc.Spec.Replicas != int32(parallelism) {
-		workerRc.Spec.Replicas = int32(parallelism)
-		_, err = rc.Update(workerRc)
-		return err
+		return a.setReplicas(workerRc.Name, int32(parallelism))
 	}
 	return nil
 }
 
+// setReplicas sets the number of replicas of the workers' replication
+// controller. It patches the replication controller, rather than updating
+// it, so that fields that the vendored Kubernetes types don't have, such as
+// the pods' runtimeClassName, aren't dropped.
+func (a *APIServer) setReplicas(rcName string, replicas int32) error {
+	return a.kubeClient.Patch(api.MergePatchType).
+		Namespace(a.namespace).
+		Resource("replicationcontrollers").
+		Name(rcName).
+		Body([]byte(fmt.Sprintf(`{"spec":{"replicas":%d}}`, replicas))).
+		Do().
+		Error()
+}
+
 func untranslateJobInputs(input *pps.Input) []*pps.JobInput {
 	var result []*pps.JobInput
 	if input.Cross != nil {
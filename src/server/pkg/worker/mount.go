@@ -0,0 +1,300 @@
+package worker
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+
+	"bazil.org/fuse"
+	"bazil.org/fuse/fs"
+	"golang.org/x/net/context"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+)
+
+// mounts are the FUSE mounts of a datum's inputs that have mount set.
+type mounts struct {
+	mounts []*mount
+}
+
+// mount is an input mounted with FUSE.
+type mount struct {
+	mountPoint string
+	conn       *fuse.Conn
+	served     chan error
+	dir        string
+}
+
+// mount mounts input at mountPoint. Only the datum's files are visible, and
+// each one is downloaded to a local directory the first time it's opened.
+func (m *mounts) mount(pachClient *client.APIClient, input *Input, mountPoint string) (retErr error) {
+	dir, err := ioutil.TempDir("", "pfs-mount-")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if retErr != nil {
+			os.RemoveAll(dir)
+		}
+	}()
+	if err := os.MkdirAll(mountPoint, 0777); err != nil {
+		return err
+	}
+	conn, err := fuse.Mount(
+		mountPoint,
+		fuse.FSName("pfs://"+input.Name),
+		fuse.Subtype("pfs"),
+		fuse.ReadOnly(),
+		fuse.AllowOther(),
+	)
+	if err != nil {
+		return err
+	}
+	filesystem := newMountFS(pachClient, input.FileInfo, dir)
+	served := make(chan error, 1)
+	go func() {
+		served <- fs.Serve(conn, filesystem)
+	}()
+	<-conn.Ready
+	if err := conn.MountError; err != nil {
+		conn.Close()
+		return err
+	}
+	m.mounts = append(m.mounts, &mount{
+		mountPoint: mountPoint,
+		conn:       conn,
+		served:     served,
+		dir:        dir,
+	})
+	return nil
+}
+
+// unmountAll unmounts everything that's been mounted and removes the
+// downloaded files. It's idempotent.
+func (m *mounts) unmountAll() error {
+	var result error
+	for _, mount := range m.mounts {
+		if err := fuse.Unmount(mount.mountPoint); err != nil && result == nil {
+			result = err
+		}
+		if err := <-mount.served; err != nil && result == nil {
+			result = err
+		}
+		if err := mount.conn.Close(); err != nil && result == nil {
+			result = err
+		}
+		if err := os.RemoveAll(mount.dir); err != nil && result == nil {
+			result = err
+		}
+	}
+	m.mounts = nil
+	return result
+}
+
+// mountFS is a read-only filesystem of a datum's files in one input. The
+// directories containing the datum are shown with nothing else in them.
+type mountFS struct {
+	pachClient *client.APIClient
+	commit     *pfs.Commit
+	// dir is where files are downloaded to.
+	dir  string
+	root *mountNode
+
+	mu     sync.Mutex
+	inodes uint64
+}
+
+// mountNode is a file or directory in a mountFS.
+type mountNode struct {
+	fs    *mountFS
+	inode uint64
+	path  string
+	dir   bool
+	size  uint64
+
+	mu sync.Mutex
+	// children is nil until the directory is listed.
+	children map[string]*mountNode
+	// local is where the file was downloaded to, "" until it's opened.
+	local string
+}
+
+func newMountFS(pachClient *client.APIClient, datum *pfs.FileInfo, dir string) *mountFS {
+	f := &mountFS{
+		pachClient: pachClient,
+		commit:     datum.File.Commit,
+		dir:        dir,
+	}
+	node := f.newNode(datum)
+	// Add the directories containing the datum, up to the root.
+	for p := clean(datum.File.Path); p != "/"; p = path.Dir(p) {
+		parent := &mountNode{
+			fs:       f,
+			inode:    f.nextInode(),
+			path:     path.Dir(p),
+			dir:      true,
+			children: map[string]*mountNode{path.Base(p): node},
+		}
+		node = parent
+	}
+	f.root = node
+	return f
+}
+
+func (f *mountFS) Root() (fs.Node, error) {
+	return f.root, nil
+}
+
+func (f *mountFS) newNode(fileInfo *pfs.FileInfo) *mountNode {
+	return &mountNode{
+		fs:    f,
+		inode: f.nextInode(),
+		path:  clean(fileInfo.File.Path),
+		dir:   fileInfo.FileType == pfs.FileType_DIR,
+		size:  fileInfo.SizeBytes,
+	}
+}
+
+func (f *mountFS) nextInode() uint64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.inodes++
+	return f.inodes
+}
+
+func (n *mountNode) Attr(ctx context.Context, a *fuse.Attr) error {
+	a.Inode = n.inode
+	if n.dir {
+		a.Mode = os.ModeDir | 0555
+	} else {
+		a.Mode = 0444
+		a.Size = n.size
+	}
+	return nil
+}
+
+func (n *mountNode) Lookup(ctx context.Context, name string) (fs.Node, error) {
+	children, err := n.list()
+	if err != nil {
+		return nil, err
+	}
+	child, ok := children[name]
+	if !ok {
+		return nil, fuse.ENOENT
+	}
+	return child, nil
+}
+
+func (n *mountNode) ReadDirAll(ctx context.Context) ([]fuse.Dirent, error) {
+	children, err := n.list()
+	if err != nil {
+		return nil, err
+	}
+	var result []fuse.Dirent
+	for name, child := range children {
+		typ := fuse.DT_File
+		if child.dir {
+			typ = fuse.DT_Dir
+		}
+		result = append(result, fuse.Dirent{Inode: child.inode, Name: name, Type: typ})
+	}
+	return result, nil
+}
+
+func (n *mountNode) Open(ctx context.Context, req *fuse.OpenRequest, resp *fuse.OpenResponse) (fs.Handle, error) {
+	if n.dir {
+		return n, nil
+	}
+	if !req.Flags.IsReadOnly() {
+		return nil, fuse.Errno(syscall.EROFS)
+	}
+	local, err := n.download()
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(local)
+	if err != nil {
+		return nil, err
+	}
+	// The file never changes, so the kernel can keep it in its page cache.
+	resp.Flags |= fuse.OpenKeepCache
+	return &mountHandle{f}, nil
+}
+
+// list returns the directory's children, listing them the first time.
+func (n *mountNode) list() (map[string]*mountNode, error) {
+	if !n.dir {
+		return nil, fuse.Errno(syscall.ENOTDIR)
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.children != nil {
+		return n.children, nil
+	}
+	fileInfos, err := n.fs.pachClient.ListFile(n.fs.commit.Repo.Name, n.fs.commit.ID, n.path)
+	if err != nil {
+		return nil, err
+	}
+	children := make(map[string]*mountNode)
+	for _, fileInfo := range fileInfos {
+		children[path.Base(fileInfo.File.Path)] = n.fs.newNode(fileInfo)
+	}
+	n.children = children
+	return children, nil
+}
+
+// download downloads the file, the first time it's called, and returns
+// where it was downloaded to.
+func (n *mountNode) download() (_ string, retErr error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.local != "" {
+		return n.local, nil
+	}
+	f, err := ioutil.TempFile(n.fs.dir, "")
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		if err := f.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+		if retErr != nil {
+			os.Remove(f.Name())
+		}
+	}()
+	if err := n.fs.pachClient.GetFile(n.fs.commit.Repo.Name, n.fs.commit.ID, n.path, 0, 0, f); err != nil {
+		return "", err
+	}
+	n.local = f.Name()
+	return n.local, nil
+}
+
+// mountHandle is an open file in a mountFS.
+type mountHandle struct {
+	f *os.File
+}
+
+func (h *mountHandle) Read(ctx context.Context, req *fuse.ReadRequest, resp *fuse.ReadResponse) error {
+	buf := make([]byte, req.Size)
+	n, err := h.f.ReadAt(buf, req.Offset)
+	if err != nil && err != io.EOF {
+		return err
+	}
+	resp.Data = buf[:n]
+	return nil
+}
+
+func (h *mountHandle) Release(ctx context.Context, req *fuse.ReleaseRequest) error {
+	return h.f.Close()
+}
+
+func clean(p string) string {
+	return filepath.Clean("/" + strings.TrimPrefix(p, "/"))
+}
@@ -0,0 +1,50 @@
+package worker
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"syscall"
+
+	"golang.org/x/net/context"
+
+	"github.com/pachyderm/pachyderm/src/client"
+)
+
+// NamespaceArg is the first argument of the worker binary when it's run to
+// set up the mount namespace of a datum, see namespaceCommand.
+const NamespaceArg = "datum-namespace"
+
+// namespaceCommand returns a command that runs cmd in a new mount namespace
+// in which root is mounted at /pfs, so that each datum that's processed
+// concurrently sees its own inputs and output. It re-runs the worker
+// binary, which mounts root and then execs cmd, see RunNamespace.
+func namespaceCommand(ctx context.Context, root string, cmd []string) (*exec.Cmd, error) {
+	self, err := os.Executable()
+	if err != nil {
+		return nil, err
+	}
+	result := exec.CommandContext(ctx, self, append([]string{NamespaceArg, root}, cmd...)...)
+	// Mounts made in the new namespace aren't propagated back to the
+	// worker's, so they're removed when the user code exits.
+	result.SysProcAttr = &syscall.SysProcAttr{Unshareflags: syscall.CLONE_NEWNS}
+	return result, nil
+}
+
+// RunNamespace mounts args[0] at /pfs and execs args[1:]. It's run in the
+// mount namespace created by namespaceCommand, and only returns if it
+// fails.
+func RunNamespace(args []string) error {
+	if len(args) < 2 {
+		return fmt.Errorf("usage: %s root cmd [args...]", NamespaceArg)
+	}
+	root, cmd := args[0], args[1:]
+	if err := syscall.Mount(root, client.PPSInputPrefix, "", syscall.MS_BIND|syscall.MS_REC, ""); err != nil {
+		return fmt.Errorf("error mounting %s at %s: %v", root, client.PPSInputPrefix, err)
+	}
+	path, err := exec.LookPath(cmd[0])
+	if err != nil {
+		return err
+	}
+	return syscall.Exec(path, cmd, os.Environ())
+}
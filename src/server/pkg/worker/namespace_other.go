@@ -0,0 +1,25 @@
+//go:build !linux
+// +build !linux
+
+package worker
+
+import (
+	"fmt"
+	"os/exec"
+
+	"golang.org/x/net/context"
+)
+
+// NamespaceArg is the first argument of the worker binary when it's run to
+// set up the mount namespace of a datum.
+const NamespaceArg = "datum-namespace"
+
+// namespaceCommand is only supported on linux, which workers run on.
+func namespaceCommand(ctx context.Context, root string, cmd []string) (*exec.Cmd, error) {
+	return nil, fmt.Errorf("processing datums concurrently is only supported on linux")
+}
+
+// RunNamespace is only supported on linux, which workers run on.
+func RunNamespace(args []string) error {
+	return fmt.Errorf("processing datums concurrently is only supported on linux")
+}
@@ -0,0 +1,150 @@
+package worker
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"syscall"
+
+	"go.pedge.io/lion/proto"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	filesync "github.com/pachyderm/pachyderm/src/server/pkg/sync"
+)
+
+const (
+	// prefetchDir is the directory under /pfs that datums are prefetched to.
+	// It's under /pfs so that prefetched files can be moved into place,
+	// rather than copied.
+	prefetchDir = ".prefetch"
+	// prefetchMinFreeBytes is the disk space that's left free when
+	// prefetching, so that the datum being processed has room for its
+	// output.
+	prefetchMinFreeBytes = 1024 * 1024 * 1024
+)
+
+// prefetcher downloads the datums that a worker will process next, while it
+// processes the current ones, so that downloading and processing overlap.
+type prefetcher struct {
+	a *APIServer
+	// max is the most datums that are prefetched at once. Datums are
+	// usually sent to the worker that prefetched them, but not always (e.g.
+	// when a Process call is retried), so the oldest are dropped.
+	max int
+
+	mu     sync.Mutex
+	datums map[string]*prefetchedDatum
+	order  []string // tags of datums, oldest first
+}
+
+type prefetchedDatum struct {
+	dir  string
+	done chan struct{}
+	err  error
+}
+
+func newPrefetcher(a *APIServer, max int) *prefetcher {
+	// Remove datums that were prefetched before the worker restarted.
+	os.RemoveAll(filepath.Join(client.PPSInputPrefix, prefetchDir))
+	return &prefetcher{
+		a:      a,
+		max:    max,
+		datums: make(map[string]*prefetchedDatum),
+	}
+}
+
+// prefetch starts downloading data, whose tag is tag, in the background.
+// Datums that have inputs that can't be prefetched, or won't fit on disk,
+// are skipped.
+func (p *prefetcher) prefetch(tag string, data []*Input) {
+	var size uint64
+	for _, input := range data {
+		if input.Lazy || input.Mount {
+			// Lazy and mounted inputs are downloaded as they're read.
+			return
+		}
+		size += input.FileInfo.SizeBytes
+	}
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(client.PPSInputPrefix, &stat); err != nil {
+		return
+	}
+	if size+prefetchMinFreeBytes > uint64(stat.Bavail)*uint64(stat.Bsize) {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.datums[tag]; ok {
+		return
+	}
+	for len(p.order) >= p.max {
+		p.drop(p.order[0])
+	}
+	datum := &prefetchedDatum{
+		dir:  filepath.Join(client.PPSInputPrefix, prefetchDir, tag),
+		done: make(chan struct{}),
+	}
+	p.datums[tag] = datum
+	p.order = append(p.order, tag)
+	go func() {
+		defer close(datum.done)
+		datum.err = p.download(datum.dir, data)
+		if datum.err != nil {
+			protolion.Errorf("error prefetching datum %v: %v", data, datum.err)
+		}
+	}()
+}
+
+func (p *prefetcher) download(dir string, data []*Input) error {
+	puller := filesync.NewPuller()
+	for _, input := range data {
+		file := input.FileInfo.File
+		if err := puller.Pull(p.a.pachClient, filepath.Join(dir, input.Name, file.Path), file.Commit.Repo.Name, file.Commit.ID, file.Path, false, concurrency); err != nil {
+			return err
+		}
+	}
+	return puller.CleanUp()
+}
+
+// take returns the directory that the datum whose tag is tag was prefetched
+// to, waiting for it to finish downloading, or "" if it wasn't prefetched.
+// The caller is responsible for removing the directory.
+func (p *prefetcher) take(tag string) string {
+	p.mu.Lock()
+	datum, ok := p.datums[tag]
+	if ok {
+		p.forget(tag)
+	}
+	p.mu.Unlock()
+	if !ok {
+		return ""
+	}
+	<-datum.done
+	if datum.err != nil {
+		os.RemoveAll(datum.dir)
+		return ""
+	}
+	return datum.dir
+}
+
+// drop forgets a prefetched datum and removes it once it's downloaded. It
+// must be called with p.mu held.
+func (p *prefetcher) drop(tag string) {
+	datum := p.datums[tag]
+	p.forget(tag)
+	go func() {
+		<-datum.done
+		os.RemoveAll(datum.dir)
+	}()
+}
+
+// forget must be called with p.mu held.
+func (p *prefetcher) forget(tag string) {
+	delete(p.datums, tag)
+	for i, t := range p.order {
+		if t == tag {
+			p.order = append(p.order[:i], p.order[i+1:]...)
+			break
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package worker
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
+	"github.com/pachyderm/pachyderm/src/server/pkg/notify"
+
+	"github.com/gogo/protobuf/types"
+	"go.pedge.io/lion/proto"
+	"golang.org/x/net/context"
+)
+
+const (
+	webhookTimeout    = 10 * time.Second
+	webhookMaxElapsed = 5 * time.Minute
+)
+
+// webhookCommit is how commits appear in webhook payloads.
+type webhookCommit struct {
+	Repo string `json:"repo"`
+	ID   string `json:"id"`
+}
+
+// webhookPayload is the JSON body that's POSTed to a job's webhooks.
+type webhookPayload struct {
+	Job           string          `json:"job"`
+	Pipeline      string          `json:"pipeline,omitempty"`
+	State         string          `json:"state"`
+	InputCommits  []webhookCommit `json:"input_commits"`
+	OutputCommit  *webhookCommit  `json:"output_commit,omitempty"`
+	Started       time.Time       `json:"started"`
+	Finished      time.Time       `json:"finished"`
+	DataProcessed int64           `json:"data_processed"`
+	DataTotal     int64           `json:"data_total"`
+	Restarts      uint64          `json:"restarts"`
+}
+
+func newWebhookPayload(jobInfo *pps.JobInfo) *webhookPayload {
+	payload := &webhookPayload{
+		Job:           jobInfo.Job.ID,
+		State:         jobInfo.State.String(),
+		DataProcessed: jobInfo.DataProcessed,
+		DataTotal:     jobInfo.DataTotal,
+		Restarts:      jobInfo.Restart,
+	}
+	if jobInfo.Pipeline != nil {
+		payload.Pipeline = jobInfo.Pipeline.Name
+	}
+	for _, commit := range pps.InputCommits(jobInfo.Input) {
+		payload.InputCommits = append(payload.InputCommits, toWebhookCommit(commit))
+	}
+	if jobInfo.OutputCommit != nil {
+		outputCommit := toWebhookCommit(jobInfo.OutputCommit)
+		payload.OutputCommit = &outputCommit
+	}
+	if started, err := types.TimestampFromProto(jobInfo.Started); err == nil {
+		payload.Started = started
+	}
+	if finished, err := types.TimestampFromProto(jobInfo.Finished); err == nil {
+		payload.Finished = finished
+	}
+	return payload
+}
+
+func toWebhookCommit(commit *pfs.Commit) webhookCommit {
+	return webhookCommit{Repo: commit.Repo.Name, ID: commit.ID}
+}
+
+// callWebhooks POSTs jobInfo to each of its webhooks that wants to hear
+// about the job's state. Failed calls are retried for a while, but a
+// webhook that's down never holds up the job.
+func callWebhooks(jobInfo *pps.JobInfo) {
+	if len(jobInfo.Webhooks) == 0 {
+		return
+	}
+	body, err := json.Marshal(newWebhookPayload(jobInfo))
+	if err != nil {
+		protolion.Errorf("error marshalling webhook payload for job %s: %v", jobInfo.Job.ID, err)
+		return
+	}
+	httpClient := &http.Client{Timeout: webhookTimeout}
+	for _, webhook := range jobInfo.Webhooks {
+		if !webhookWantsState(webhook, jobInfo.State) {
+			continue
+		}
+		b := backoff.NewExponentialBackOff()
+		b.MaxElapsedTime = webhookMaxElapsed
+		if err := backoff.RetryNotify(func() error {
+			resp, err := httpClient.Post(webhook.URL, "application/json", bytes.NewReader(body))
+			if err != nil {
+				return err
+			}
+			resp.Body.Close()
+			if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+				return fmt.Errorf("webhook returned %s", resp.Status)
+			}
+			return nil
+		}, b, func(err error, d time.Duration) error {
+			protolion.Errorf("error calling webhook %s for job %s: %v; retrying in %v", webhook.URL, jobInfo.Job.ID, err, d)
+			return nil
+		}); err != nil {
+			protolion.Errorf("giving up on webhook %s for job %s: %v", webhook.URL, jobInfo.Job.ID, err)
+		}
+	}
+}
+
+func webhookWantsState(webhook *pps.Webhook, state pps.JobState) bool {
+	if len(webhook.States) == 0 {
+		return state == pps.JobState_JOB_SUCCESS || state == pps.JobState_JOB_FAILURE
+	}
+	for _, s := range webhook.States {
+		if s == state {
+			return true
+		}
+	}
+	return false
+}
+
+// sendNotifications sends notifications about jobInfo to the sinks that the cluster's
+// notification config routes it to.
+func (a *APIServer) sendNotifications(jobInfo *pps.JobInfo) {
+	config, err := notify.GetConfig(context.Background(), a.etcdClient)
+	if err != nil {
+		protolion.Errorf("error reading notification config for job %s: %v", jobInfo.Job.ID, err)
+		return
+	}
+	notify.Job(config, jobInfo)
+}
@@ -11,8 +11,11 @@
 		Input
 		ProcessRequest
 		ProcessResponse
+		DatumCheckpoint
 		CancelRequest
 		CancelResponse
+		MergeRequest
+		MergeResponse
 */
 package worker
 
@@ -23,6 +26,7 @@ import pfs "github.com/pachyderm/pachyderm/src/client/pfs"
 import pps "github.com/pachyderm/pachyderm/src/client/pps"
 import _ "github.com/gogo/protobuf/gogoproto"
 import google_protobuf "github.com/gogo/protobuf/types"
+import google_protobuf1 "github.com/gogo/protobuf/types"
 
 import (
 	context "golang.org/x/net/context"
@@ -48,6 +52,10 @@ type Input struct {
 	Name         string        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Lazy         bool          `protobuf:"varint,3,opt,name=lazy,proto3" json:"lazy,omitempty"`
 	Branch       string        `protobuf:"bytes,4,opt,name=branch,proto3" json:"branch,omitempty"`
+	Mount        bool          `protobuf:"varint,6,opt,name=mount,proto3" json:"mount,omitempty"`
+	// group_key is the key that the input's file was grouped by, if its atom
+	// input has a group_by expression.
+	GroupKey string `protobuf:"bytes,7,opt,name=group_key,json=groupKey,proto3" json:"group_key,omitempty"`
 }
 
 func (m *Input) Reset()                    { *m = Input{} }
@@ -90,6 +98,20 @@ func (m *Input) GetBranch() string {
 	return ""
 }
 
+func (m *Input) GetMount() bool {
+	if m != nil {
+		return m.Mount
+	}
+	return false
+}
+
+func (m *Input) GetGroupKey() string {
+	if m != nil {
+		return m.GroupKey
+	}
+	return ""
+}
+
 type ProcessRequest struct {
 	// ID of the job for which we're processing 'data'. This is attached to logs
 	// generated while processing 'data', so that they can be searched.
@@ -99,6 +121,9 @@ type ProcessRequest struct {
 	// The tag corresponding to the previous parent's run of this datum, used for
 	// incremental jobs, may be nil.
 	ParentOutput *pfs.Tag `protobuf:"bytes,3,opt,name=parent_output,json=parentOutput" json:"parent_output,omitempty"`
+	// The datum that the worker will be asked to process after this one, if
+	// any, which it downloads while it processes data.
+	Next []*Input `protobuf:"bytes,4,rep,name=next" json:"next,omitempty"`
 }
 
 func (m *ProcessRequest) Reset()                    { *m = ProcessRequest{} }
@@ -127,11 +152,32 @@ func (m *ProcessRequest) GetParentOutput() *pfs.Tag {
 	return nil
 }
 
+func (m *ProcessRequest) GetNext() []*Input {
+	if m != nil {
+		return m.Next
+	}
+	return nil
+}
+
 // ProcessResponse contains a tag, only if the processing was successful.
 type ProcessResponse struct {
 	Tag *pfs.Tag `protobuf:"bytes,1,opt,name=tag" json:"tag,omitempty"`
 	// If true, the user program has errored
 	Failed bool `protobuf:"varint,2,opt,name=failed,proto3" json:"failed,omitempty"`
+	// If true, the datum's output had already been computed, so the user
+	// program wasn't run
+	Skipped bool `protobuf:"varint,3,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	// The object holding the datum's logs, if the pipeline keeps its logs,
+	// and its size.
+	Log     *pfs.Object `protobuf:"bytes,4,opt,name=log" json:"log,omitempty"`
+	LogSize int64       `protobuf:"varint,5,opt,name=log_size,json=logSize,proto3" json:"log_size,omitempty"`
+	// The time the worker spent running the transform's setup command, if
+	// it hasn't been reported in an earlier response.
+	SetupTime *google_protobuf.Duration `protobuf:"bytes,6,opt,name=setup_time,json=setupTime" json:"setup_time,omitempty"`
+	// How much data the worker downloaded from the datum's inputs, and
+	// uploaded as its output, while processing it.
+	DownloadBytes int64 `protobuf:"varint,7,opt,name=download_bytes,json=downloadBytes,proto3" json:"download_bytes,omitempty"`
+	UploadBytes   int64 `protobuf:"varint,8,opt,name=upload_bytes,json=uploadBytes,proto3" json:"upload_bytes,omitempty"`
 }
 
 func (m *ProcessResponse) Reset()                    { *m = ProcessResponse{} }
@@ -153,6 +199,99 @@ func (m *ProcessResponse) GetFailed() bool {
 	return false
 }
 
+func (m *ProcessResponse) GetSkipped() bool {
+	if m != nil {
+		return m.Skipped
+	}
+	return false
+}
+
+func (m *ProcessResponse) GetLog() *pfs.Object {
+	if m != nil {
+		return m.Log
+	}
+	return nil
+}
+
+func (m *ProcessResponse) GetLogSize() int64 {
+	if m != nil {
+		return m.LogSize
+	}
+	return 0
+}
+
+func (m *ProcessResponse) GetSetupTime() *google_protobuf.Duration {
+	if m != nil {
+		return m.SetupTime
+	}
+	return nil
+}
+
+func (m *ProcessResponse) GetDownloadBytes() int64 {
+	if m != nil {
+		return m.DownloadBytes
+	}
+	return 0
+}
+
+func (m *ProcessResponse) GetUploadBytes() int64 {
+	if m != nil {
+		return m.UploadBytes
+	}
+	return 0
+}
+
+// DatumCheckpoint records which of a job's datums have been processed, so
+// that if the job is restarted, e.g. because the pod running it was deleted,
+// it resumes where it left off rather than sending every datum to the
+// workers again.
+type DatumCheckpoint struct {
+	// processed are the indices of the processed datums, and tags their
+	// outputs, in the same order.
+	Processed []int64    `protobuf:"varint,1,rep,packed,name=processed" json:"processed,omitempty"`
+	Tags      []*pfs.Tag `protobuf:"bytes,2,rep,name=tags" json:"tags,omitempty"`
+	// skipped is the number of processed datums whose output had already been
+	// computed.
+	Skipped int64 `protobuf:"varint,3,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	// logs are the objects holding the logs of processed datums that haven't
+	// been committed to the pipeline's logs branch yet, so that fsck and
+	// garbage collection know they're in use.
+	Logs []*pfs.Object `protobuf:"bytes,4,rep,name=logs" json:"logs,omitempty"`
+}
+
+func (m *DatumCheckpoint) Reset()                    { *m = DatumCheckpoint{} }
+func (m *DatumCheckpoint) String() string            { return proto.CompactTextString(m) }
+func (*DatumCheckpoint) ProtoMessage()               {}
+func (*DatumCheckpoint) Descriptor() ([]byte, []int) { return fileDescriptorWorkerService, []int{3} }
+
+func (m *DatumCheckpoint) GetProcessed() []int64 {
+	if m != nil {
+		return m.Processed
+	}
+	return nil
+}
+
+func (m *DatumCheckpoint) GetTags() []*pfs.Tag {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *DatumCheckpoint) GetSkipped() int64 {
+	if m != nil {
+		return m.Skipped
+	}
+	return 0
+}
+
+func (m *DatumCheckpoint) GetLogs() []*pfs.Object {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
 type CancelRequest struct {
 	JobID       string   `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
 	DataFilters []string `protobuf:"bytes,1,rep,name=data_filters,json=dataFilters" json:"data_filters,omitempty"`
@@ -161,7 +300,7 @@ type CancelRequest struct {
 func (m *CancelRequest) Reset()                    { *m = CancelRequest{} }
 func (m *CancelRequest) String() string            { return proto.CompactTextString(m) }
 func (*CancelRequest) ProtoMessage()               {}
-func (*CancelRequest) Descriptor() ([]byte, []int) { return fileDescriptorWorkerService, []int{3} }
+func (*CancelRequest) Descriptor() ([]byte, []int) { return fileDescriptorWorkerService, []int{4} }
 
 func (m *CancelRequest) GetJobID() string {
 	if m != nil {
@@ -184,7 +323,7 @@ type CancelResponse struct {
 func (m *CancelResponse) Reset()                    { *m = CancelResponse{} }
 func (m *CancelResponse) String() string            { return proto.CompactTextString(m) }
 func (*CancelResponse) ProtoMessage()               {}
-func (*CancelResponse) Descriptor() ([]byte, []int) { return fileDescriptorWorkerService, []int{4} }
+func (*CancelResponse) Descriptor() ([]byte, []int) { return fileDescriptorWorkerService, []int{5} }
 
 func (m *CancelResponse) GetSuccess() bool {
 	if m != nil {
@@ -193,12 +332,67 @@ func (m *CancelResponse) GetSuccess() bool {
 	return false
 }
 
+// MergeRequest asks a worker to merge hashtrees into one.
+type MergeRequest struct {
+	JobID string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// The hashtrees to merge, either datums' outputs, by tag, or the results
+	// of other merges.
+	Tags    []*pfs.Tag    `protobuf:"bytes,2,rep,name=tags" json:"tags,omitempty"`
+	Objects []*pfs.Object `protobuf:"bytes,3,rep,name=objects" json:"objects,omitempty"`
+}
+
+func (m *MergeRequest) Reset()                    { *m = MergeRequest{} }
+func (m *MergeRequest) String() string            { return proto.CompactTextString(m) }
+func (*MergeRequest) ProtoMessage()               {}
+func (*MergeRequest) Descriptor() ([]byte, []int) { return fileDescriptorWorkerService, []int{6} }
+
+func (m *MergeRequest) GetJobID() string {
+	if m != nil {
+		return m.JobID
+	}
+	return ""
+}
+
+func (m *MergeRequest) GetTags() []*pfs.Tag {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *MergeRequest) GetObjects() []*pfs.Object {
+	if m != nil {
+		return m.Objects
+	}
+	return nil
+}
+
+type MergeResponse struct {
+	// The merged hashtree.
+	Tree *pfs.Object `protobuf:"bytes,1,opt,name=tree" json:"tree,omitempty"`
+}
+
+func (m *MergeResponse) Reset()                    { *m = MergeResponse{} }
+func (m *MergeResponse) String() string            { return proto.CompactTextString(m) }
+func (*MergeResponse) ProtoMessage()               {}
+func (*MergeResponse) Descriptor() ([]byte, []int) { return fileDescriptorWorkerService, []int{7} }
+
+func (m *MergeResponse) GetTree() *pfs.Object {
+	if m != nil {
+		return m.Tree
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Input)(nil), "worker.Input")
 	proto.RegisterType((*ProcessRequest)(nil), "worker.ProcessRequest")
 	proto.RegisterType((*ProcessResponse)(nil), "worker.ProcessResponse")
+	proto.RegisterType((*DatumCheckpoint)(nil), "worker.DatumCheckpoint")
 	proto.RegisterType((*CancelRequest)(nil), "worker.CancelRequest")
 	proto.RegisterType((*CancelResponse)(nil), "worker.CancelResponse")
+	proto.RegisterType((*MergeRequest)(nil), "worker.MergeRequest")
+	proto.RegisterType((*MergeResponse)(nil), "worker.MergeResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -213,8 +407,9 @@ const _ = grpc.SupportPackageIsVersion4
 
 type WorkerClient interface {
 	Process(ctx context.Context, in *ProcessRequest, opts ...grpc.CallOption) (*ProcessResponse, error)
-	Status(ctx context.Context, in *google_protobuf.Empty, opts ...grpc.CallOption) (*pps.WorkerStatus, error)
+	Status(ctx context.Context, in *google_protobuf1.Empty, opts ...grpc.CallOption) (*pps.WorkerStatus, error)
 	Cancel(ctx context.Context, in *CancelRequest, opts ...grpc.CallOption) (*CancelResponse, error)
+	Merge(ctx context.Context, in *MergeRequest, opts ...grpc.CallOption) (*MergeResponse, error)
 }
 
 type workerClient struct {
@@ -234,7 +429,7 @@ func (c *workerClient) Process(ctx context.Context, in *ProcessRequest, opts ...
 	return out, nil
 }
 
-func (c *workerClient) Status(ctx context.Context, in *google_protobuf.Empty, opts ...grpc.CallOption) (*pps.WorkerStatus, error) {
+func (c *workerClient) Status(ctx context.Context, in *google_protobuf1.Empty, opts ...grpc.CallOption) (*pps.WorkerStatus, error) {
 	out := new(pps.WorkerStatus)
 	err := grpc.Invoke(ctx, "/worker.Worker/Status", in, out, c.cc, opts...)
 	if err != nil {
@@ -252,12 +447,22 @@ func (c *workerClient) Cancel(ctx context.Context, in *CancelRequest, opts ...gr
 	return out, nil
 }
 
+func (c *workerClient) Merge(ctx context.Context, in *MergeRequest, opts ...grpc.CallOption) (*MergeResponse, error) {
+	out := new(MergeResponse)
+	err := grpc.Invoke(ctx, "/worker.Worker/Merge", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Worker service
 
 type WorkerServer interface {
 	Process(context.Context, *ProcessRequest) (*ProcessResponse, error)
-	Status(context.Context, *google_protobuf.Empty) (*pps.WorkerStatus, error)
+	Status(context.Context, *google_protobuf1.Empty) (*pps.WorkerStatus, error)
 	Cancel(context.Context, *CancelRequest) (*CancelResponse, error)
+	Merge(context.Context, *MergeRequest) (*MergeResponse, error)
 }
 
 func RegisterWorkerServer(s *grpc.Server, srv WorkerServer) {
@@ -283,7 +488,7 @@ func _Worker_Process_Handler(srv interface{}, ctx context.Context, dec func(inte
 }
 
 func _Worker_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(google_protobuf.Empty)
+	in := new(google_protobuf1.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -295,7 +500,7 @@ func _Worker_Status_Handler(srv interface{}, ctx context.Context, dec func(inter
 		FullMethod: "/worker.Worker/Status",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WorkerServer).Status(ctx, req.(*google_protobuf.Empty))
+		return srv.(WorkerServer).Status(ctx, req.(*google_protobuf1.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -318,6 +523,24 @@ func _Worker_Cancel_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Worker_Merge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).Merge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/worker.Worker/Merge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).Merge(ctx, req.(*MergeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Worker_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "worker.Worker",
 	HandlerType: (*WorkerServer)(nil),
@@ -334,6 +557,10 @@ var _Worker_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Cancel",
 			Handler:    _Worker_Cancel_Handler,
 		},
+		{
+			MethodName: "Merge",
+			Handler:    _Worker_Merge_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "server/pkg/worker/worker_service.proto",
@@ -396,6 +623,22 @@ func (m *Input) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n2
 	}
+	if m.Mount {
+		dAtA[i] = 0x30
+		i++
+		if m.Mount {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.GroupKey) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintWorkerService(dAtA, i, uint64(len(m.GroupKey)))
+		i += copy(dAtA[i:], m.GroupKey)
+	}
 	return i, nil
 }
 
@@ -442,6 +685,18 @@ func (m *ProcessRequest) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n3
 	}
+	if len(m.Next) > 0 {
+		for _, msg := range m.Next {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintWorkerService(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -480,6 +735,116 @@ func (m *ProcessResponse) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if m.Skipped {
+		dAtA[i] = 0x18
+		i++
+		if m.Skipped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Log != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintWorkerService(dAtA, i, uint64(m.Log.Size()))
+		n5, err := m.Log.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if m.LogSize != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintWorkerService(dAtA, i, uint64(m.LogSize))
+	}
+	if m.SetupTime != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintWorkerService(dAtA, i, uint64(m.SetupTime.Size()))
+		n6, err := m.SetupTime.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if m.DownloadBytes != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintWorkerService(dAtA, i, uint64(m.DownloadBytes))
+	}
+	if m.UploadBytes != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintWorkerService(dAtA, i, uint64(m.UploadBytes))
+	}
+	return i, nil
+}
+
+func (m *DatumCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DatumCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Processed) > 0 {
+		dAtA8 := make([]byte, len(m.Processed)*10)
+		var j7 int
+		for _, num1 := range m.Processed {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintWorkerService(dAtA, i, uint64(j7))
+		i += copy(dAtA[i:], dAtA8[:j7])
+	}
+	if len(m.Tags) > 0 {
+		for _, msg := range m.Tags {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintWorkerService(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Skipped != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintWorkerService(dAtA, i, uint64(m.Skipped))
+	}
+	if len(m.Logs) > 0 {
+		for _, msg := range m.Logs {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintWorkerService(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -550,55 +915,138 @@ func (m *CancelResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeFixed64WorkerService(dAtA []byte, offset int, v uint64) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	dAtA[offset+4] = uint8(v >> 32)
-	dAtA[offset+5] = uint8(v >> 40)
-	dAtA[offset+6] = uint8(v >> 48)
-	dAtA[offset+7] = uint8(v >> 56)
-	return offset + 8
-}
-func encodeFixed32WorkerService(dAtA []byte, offset int, v uint32) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	return offset + 4
-}
-func encodeVarintWorkerService(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MergeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *Input) Size() (n int) {
+
+func (m *MergeRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.FileInfo != nil {
-		l = m.FileInfo.Size()
-		n += 1 + l + sovWorkerService(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovWorkerService(uint64(l))
+	if len(m.JobID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintWorkerService(dAtA, i, uint64(len(m.JobID)))
+		i += copy(dAtA[i:], m.JobID)
 	}
-	if m.Lazy {
-		n += 2
+	if len(m.Tags) > 0 {
+		for _, msg := range m.Tags {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintWorkerService(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	l = len(m.Branch)
-	if l > 0 {
-		n += 1 + l + sovWorkerService(uint64(l))
+	if len(m.Objects) > 0 {
+		for _, msg := range m.Objects {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintWorkerService(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *MergeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MergeResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Tree != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintWorkerService(dAtA, i, uint64(m.Tree.Size()))
+		n9, err := m.Tree.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	return i, nil
+}
+
+func encodeFixed64WorkerService(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32WorkerService(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintWorkerService(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Input) Size() (n int) {
+	var l int
+	_ = l
+	if m.FileInfo != nil {
+		l = m.FileInfo.Size()
+		n += 1 + l + sovWorkerService(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovWorkerService(uint64(l))
+	}
+	if m.Lazy {
+		n += 2
+	}
+	l = len(m.Branch)
+	if l > 0 {
+		n += 1 + l + sovWorkerService(uint64(l))
 	}
 	if m.ParentCommit != nil {
 		l = m.ParentCommit.Size()
 		n += 1 + l + sovWorkerService(uint64(l))
 	}
+	if m.Mount {
+		n += 2
+	}
+	l = len(m.GroupKey)
+	if l > 0 {
+		n += 1 + l + sovWorkerService(uint64(l))
+	}
 	return n
 }
 
@@ -619,6 +1067,12 @@ func (m *ProcessRequest) Size() (n int) {
 		l = m.ParentOutput.Size()
 		n += 1 + l + sovWorkerService(uint64(l))
 	}
+	if len(m.Next) > 0 {
+		for _, e := range m.Next {
+			l = e.Size()
+			n += 1 + l + sovWorkerService(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -632,6 +1086,54 @@ func (m *ProcessResponse) Size() (n int) {
 	if m.Failed {
 		n += 2
 	}
+	if m.Skipped {
+		n += 2
+	}
+	if m.Log != nil {
+		l = m.Log.Size()
+		n += 1 + l + sovWorkerService(uint64(l))
+	}
+	if m.LogSize != 0 {
+		n += 1 + sovWorkerService(uint64(m.LogSize))
+	}
+	if m.SetupTime != nil {
+		l = m.SetupTime.Size()
+		n += 1 + l + sovWorkerService(uint64(l))
+	}
+	if m.DownloadBytes != 0 {
+		n += 1 + sovWorkerService(uint64(m.DownloadBytes))
+	}
+	if m.UploadBytes != 0 {
+		n += 1 + sovWorkerService(uint64(m.UploadBytes))
+	}
+	return n
+}
+
+func (m *DatumCheckpoint) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Processed) > 0 {
+		l = 0
+		for _, e := range m.Processed {
+			l += sovWorkerService(uint64(e))
+		}
+		n += 1 + sovWorkerService(uint64(l)) + l
+	}
+	if len(m.Tags) > 0 {
+		for _, e := range m.Tags {
+			l = e.Size()
+			n += 1 + l + sovWorkerService(uint64(l))
+		}
+	}
+	if m.Skipped != 0 {
+		n += 1 + sovWorkerService(uint64(m.Skipped))
+	}
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovWorkerService(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -660,6 +1162,38 @@ func (m *CancelResponse) Size() (n int) {
 	return n
 }
 
+func (m *MergeRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.JobID)
+	if l > 0 {
+		n += 1 + l + sovWorkerService(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, e := range m.Tags {
+			l = e.Size()
+			n += 1 + l + sovWorkerService(uint64(l))
+		}
+	}
+	if len(m.Objects) > 0 {
+		for _, e := range m.Objects {
+			l = e.Size()
+			n += 1 + l + sovWorkerService(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MergeResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Tree != nil {
+		l = m.Tree.Size()
+		n += 1 + l + sovWorkerService(uint64(l))
+	}
+	return n
+}
+
 func sovWorkerService(x uint64) (n int) {
 	for {
 		n++
@@ -692,19 +1226,744 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Input: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Input: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkerService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkerService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FileInfo == nil {
+				m.FileInfo = &pfs.FileInfo{}
+			}
+			if err := m.FileInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkerService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkerService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkerService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Lazy = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkerService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkerService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkerService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkerService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParentCommit == nil {
+				m.ParentCommit = &pfs.Commit{}
+			}
+			if err := m.ParentCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mount", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkerService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Mount = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkerService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkerService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkerService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWorkerService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProcessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkerService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProcessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProcessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkerService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkerService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, &Input{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkerService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWorkerService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentOutput", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkerService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkerService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParentOutput == nil {
+				m.ParentOutput = &pfs.Tag{}
+			}
+			if err := m.ParentOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Next", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkerService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkerService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Next = append(m.Next, &Input{})
+			if err := m.Next[len(m.Next)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkerService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWorkerService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProcessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkerService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProcessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProcessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkerService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkerService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tag == nil {
+				m.Tag = &pfs.Tag{}
+			}
+			if err := m.Tag.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkerService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Failed = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Skipped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkerService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Skipped = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkerService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkerService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Log == nil {
+				m.Log = &pfs.Object{}
+			}
+			if err := m.Log.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogSize", wireType)
+			}
+			m.LogSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkerService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogSize |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetupTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkerService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkerService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SetupTime == nil {
+				m.SetupTime = &google_protobuf.Duration{}
+			}
+			if err := m.SetupTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadBytes", wireType)
+			}
+			m.DownloadBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkerService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DownloadBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadBytes", wireType)
+			}
+			m.UploadBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkerService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkerService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWorkerService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DatumCheckpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkerService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DatumCheckpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DatumCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowWorkerService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Processed = append(m.Processed, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowWorkerService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthWorkerService
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowWorkerService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Processed = append(m.Processed, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Processed", wireType)
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -728,47 +1987,16 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FileInfo == nil {
-				m.FileInfo = &pfs.FileInfo{}
-			}
-			if err := m.FileInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tags = append(m.Tags, &pfs.Tag{})
+			if err := m.Tags[len(m.Tags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowWorkerService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthWorkerService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Skipped", wireType)
 			}
-			var v int
+			m.Skipped = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWorkerService
@@ -778,44 +2006,14 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.Skipped |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Lazy = bool(v != 0)
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowWorkerService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthWorkerService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -839,10 +2037,8 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParentCommit == nil {
-				m.ParentCommit = &pfs.Commit{}
-			}
-			if err := m.ParentCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Logs = append(m.Logs, &pfs.Object{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -867,7 +2063,7 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProcessRequest) Unmarshal(dAtA []byte) error {
+func (m *CancelRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -890,17 +2086,17 @@ func (m *ProcessRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProcessRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CancelRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProcessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CancelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataFilters", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWorkerService
@@ -910,22 +2106,20 @@ func (m *ProcessRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthWorkerService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data, &Input{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DataFilters = append(m.DataFilters, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -956,39 +2150,6 @@ func (m *ProcessRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.JobID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentOutput", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowWorkerService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthWorkerService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ParentOutput == nil {
-				m.ParentOutput = &pfs.Tag{}
-			}
-			if err := m.ParentOutput.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorkerService(dAtA[iNdEx:])
@@ -1010,7 +2171,7 @@ func (m *ProcessRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProcessResponse) Unmarshal(dAtA []byte) error {
+func (m *CancelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1033,48 +2194,15 @@ func (m *ProcessResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProcessResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CancelResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProcessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CancelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowWorkerService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthWorkerService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Tag == nil {
-				m.Tag = &pfs.Tag{}
-			}
-			if err := m.Tag.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -1091,7 +2219,7 @@ func (m *ProcessResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Failed = bool(v != 0)
+			m.Success = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorkerService(dAtA[iNdEx:])
@@ -1113,7 +2241,7 @@ func (m *ProcessResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CancelRequest) Unmarshal(dAtA []byte) error {
+func (m *MergeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1136,15 +2264,15 @@ func (m *CancelRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CancelRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MergeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CancelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MergeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataFilters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1169,13 +2297,13 @@ func (m *CancelRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataFilters = append(m.DataFilters, string(dAtA[iNdEx:postIndex]))
+			m.JobID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWorkerService
@@ -1185,20 +2313,53 @@ func (m *CancelRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthWorkerService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobID = string(dAtA[iNdEx:postIndex])
+			m.Tags = append(m.Tags, &pfs.Tag{})
+			if err := m.Tags[len(m.Tags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Objects", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWorkerService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWorkerService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Objects = append(m.Objects, &pfs.Object{})
+			if err := m.Objects[len(m.Objects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1221,7 +2382,7 @@ func (m *CancelRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CancelResponse) Unmarshal(dAtA []byte) error {
+func (m *MergeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1244,17 +2405,17 @@ func (m *CancelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CancelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MergeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CancelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MergeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWorkerService
@@ -1264,12 +2425,25 @@ func (m *CancelResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Success = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthWorkerService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tree == nil {
+				m.Tree = &pfs.Object{}
+			}
+			if err := m.Tree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorkerService(dAtA[iNdEx:])
@@ -1396,40 +2570,61 @@ var (
 	ErrIntOverflowWorkerService   = fmt.Errorf("proto: integer overflow")
 )
 
-func init() { proto.RegisterFile("server/pkg/worker/worker_service.proto", fileDescriptorWorkerService) }
+func init() {
+	proto.RegisterFile("server/pkg/worker/worker_service.proto", fileDescriptorWorkerService)
+}
 
 var fileDescriptorWorkerService = []byte{
-	// 510 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0xb3, 0x24, 0x71, 0x9d, 0x4d, 0x53, 0x60, 0x05, 0xc1, 0x32, 0x52, 0x30, 0x3e, 0xa0,
-	0xa8, 0x12, 0x36, 0x0a, 0xe2, 0x80, 0xc4, 0xa9, 0xa5, 0x95, 0xc2, 0x05, 0xb4, 0x54, 0xe2, 0x68,
-	0xad, 0x9d, 0xb5, 0x71, 0xeb, 0x78, 0x8d, 0x77, 0x0d, 0x2a, 0x67, 0x1e, 0x82, 0x17, 0xe0, 0x1d,
-	0x78, 0x04, 0x8e, 0x3c, 0x01, 0x42, 0xe1, 0x45, 0xd0, 0xce, 0xda, 0x8d, 0x02, 0x87, 0x1e, 0x2c,
-	0xcf, 0xfc, 0xb3, 0xbb, 0xff, 0x37, 0xa3, 0xc1, 0x8f, 0x24, 0xaf, 0x3f, 0xf2, 0x3a, 0xac, 0x2e,
-	0xb2, 0xf0, 0x93, 0xa8, 0x2f, 0x78, 0xdd, 0xfe, 0x22, 0x5d, 0xc8, 0x13, 0x1e, 0x54, 0xb5, 0x50,
-	0x82, 0x58, 0x46, 0x75, 0xef, 0x24, 0x45, 0xce, 0x4b, 0x15, 0x56, 0xa9, 0xd4, 0x9f, 0xa9, 0x6e,
-	0xd5, 0x4a, 0xea, 0xaf, 0x53, 0x33, 0x91, 0x09, 0x08, 0x43, 0x1d, 0xb5, 0xea, 0xfd, 0x4c, 0x88,
-	0xac, 0xe0, 0x21, 0x64, 0x71, 0x93, 0x86, 0x7c, 0x5d, 0xa9, 0x4b, 0x53, 0xf4, 0xbf, 0x21, 0x3c,
-	0x5c, 0x96, 0x55, 0xa3, 0xc8, 0x21, 0x1e, 0xa5, 0x79, 0xc1, 0xa3, 0xbc, 0x4c, 0x85, 0x83, 0x3c,
-	0x34, 0x1f, 0x2f, 0x26, 0x81, 0x76, 0x3c, 0xcd, 0x0b, 0xbe, 0x2c, 0x53, 0x41, 0xed, 0xb4, 0x8d,
-	0x08, 0xc1, 0x83, 0x92, 0xad, 0xb9, 0x73, 0xc3, 0x43, 0xf3, 0x11, 0x85, 0x58, 0x6b, 0x05, 0xfb,
-	0x7c, 0xe9, 0xf4, 0x3d, 0x34, 0xb7, 0x29, 0xc4, 0x64, 0x8a, 0xad, 0xb8, 0x66, 0x65, 0xf2, 0xde,
-	0x19, 0xc0, 0xc9, 0x36, 0x23, 0x4f, 0xf0, 0xa4, 0x62, 0x35, 0x2f, 0x55, 0x94, 0x88, 0xf5, 0x3a,
-	0x57, 0xce, 0x10, 0xfc, 0xc6, 0xe0, 0x77, 0x0c, 0x12, 0xdd, 0x37, 0x27, 0x4c, 0xe6, 0x7f, 0x41,
-	0xf8, 0xe0, 0x4d, 0x2d, 0x12, 0x2e, 0x25, 0xe5, 0x1f, 0x1a, 0x2e, 0x15, 0x79, 0x88, 0x07, 0x2b,
-	0xa6, 0x98, 0x83, 0xbc, 0x3e, 0xb0, 0x9a, 0x81, 0x05, 0xd0, 0x0d, 0x85, 0x12, 0xf1, 0xb0, 0x75,
-	0x2e, 0xe2, 0x28, 0x5f, 0x19, 0xd2, 0xa3, 0xd1, 0xe6, 0xd7, 0x83, 0xe1, 0x2b, 0x11, 0x2f, 0x5f,
-	0xd2, 0xe1, 0xb9, 0x88, 0x97, 0x2b, 0xf2, 0xf8, 0x8a, 0x44, 0x34, 0xaa, 0x6a, 0x14, 0xe0, 0x8f,
-	0x17, 0x36, 0x90, 0x9c, 0xb1, 0xac, 0xc3, 0x78, 0x0d, 0x55, 0xff, 0x04, 0xdf, 0xbc, 0xa2, 0x90,
-	0x95, 0x28, 0x25, 0x27, 0x2e, 0xee, 0x2b, 0x96, 0xb5, 0x13, 0xdb, 0xde, 0xd3, 0xa2, 0xee, 0x3f,
-	0x65, 0x79, 0xc1, 0x8d, 0xbf, 0x4d, 0xdb, 0xcc, 0x3f, 0xc3, 0x93, 0x63, 0x56, 0x26, 0xbc, 0xd8,
-	0xf6, 0xb2, 0xaf, 0x81, 0xa3, 0x34, 0x2f, 0x14, 0xaf, 0x25, 0xf4, 0x34, 0xa2, 0x63, 0xad, 0x9d,
-	0x1a, 0xe9, 0xfa, 0x5e, 0xfc, 0x43, 0x7c, 0xd0, 0xbd, 0xda, 0xb2, 0x39, 0x78, 0x4f, 0x36, 0x89,
-	0xc6, 0x05, 0x3e, 0x9b, 0x76, 0xe9, 0xe2, 0x3b, 0xc2, 0xd6, 0x3b, 0x18, 0x18, 0x79, 0x81, 0xf7,
-	0xda, 0x9e, 0xc8, 0xb4, 0x1b, 0xe2, 0xee, 0xa8, 0xdd, 0x7b, 0xff, 0xe9, 0xc6, 0xc0, 0xef, 0x91,
-	0x67, 0xd8, 0x7a, 0xab, 0x98, 0x6a, 0xf4, 0x65, 0xb3, 0x68, 0x41, 0xb7, 0x68, 0xc1, 0x89, 0x5e,
-	0x34, 0xf7, 0x76, 0xa0, 0x37, 0xd4, 0x98, 0x99, 0xa3, 0x7e, 0x8f, 0x3c, 0xc7, 0x96, 0x61, 0x25,
-	0x77, 0xbb, 0xb7, 0x77, 0x26, 0xe2, 0x4e, 0xff, 0x95, 0x3b, 0xc7, 0xa3, 0x5b, 0x3f, 0x36, 0x33,
-	0xf4, 0x73, 0x33, 0x43, 0xbf, 0x37, 0x33, 0xf4, 0xf5, 0xcf, 0xac, 0x17, 0x5b, 0xe0, 0xf8, 0xf4,
-	0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x94, 0x07, 0xc7, 0x20, 0x5c, 0x03, 0x00, 0x00,
+	// 802 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0xae, 0x6b, 0xc7, 0x49, 0x4e, 0x9a, 0x2e, 0x8c, 0xba, 0xc5, 0x9b, 0x5d, 0xd2, 0xac, 0xa5,
+	0x45, 0xd5, 0x4a, 0x24, 0xab, 0x22, 0x10, 0x48, 0x5c, 0xb5, 0x65, 0xa5, 0x82, 0xd0, 0x22, 0x6f,
+	0x25, 0x2e, 0x2d, 0xff, 0x1c, 0x7b, 0xa7, 0xb1, 0x3d, 0x83, 0x67, 0xcc, 0x92, 0x3e, 0x01, 0x8f,
+	0xc0, 0x0d, 0xd7, 0xbc, 0x0a, 0x97, 0x5c, 0x72, 0x85, 0x50, 0x79, 0x01, 0x1e, 0x01, 0xcd, 0x8c,
+	0xdd, 0x34, 0x59, 0x60, 0x2f, 0xa2, 0xcc, 0xf9, 0xce, 0xf1, 0x9c, 0xef, 0x7c, 0xe7, 0xb3, 0xe1,
+	0x03, 0x81, 0xf5, 0xf7, 0x58, 0x2f, 0xf8, 0x32, 0x5f, 0xbc, 0x66, 0xf5, 0x12, 0xeb, 0xf6, 0x2f,
+	0x54, 0x09, 0x9a, 0xe0, 0x9c, 0xd7, 0x4c, 0x32, 0xe2, 0x1a, 0x74, 0x72, 0x90, 0x14, 0x14, 0x2b,
+	0xb9, 0xe0, 0x99, 0x50, 0x3f, 0x93, 0x5d, 0xa3, 0x5c, 0xa8, 0x5f, 0x87, 0xe6, 0x2c, 0x67, 0xfa,
+	0xb8, 0x50, 0xa7, 0x16, 0x9d, 0xe6, 0x8c, 0xe5, 0x05, 0x2e, 0x74, 0x14, 0x37, 0xd9, 0x22, 0x6d,
+	0xea, 0x48, 0x52, 0x56, 0xb5, 0xf9, 0x87, 0xdb, 0x79, 0x2c, 0xb9, 0x5c, 0x99, 0xa4, 0xff, 0xbb,
+	0x05, 0xbd, 0x8b, 0x8a, 0x37, 0x92, 0x3c, 0x85, 0x61, 0x46, 0x0b, 0x0c, 0x69, 0x95, 0x31, 0xcf,
+	0x9a, 0x59, 0xc7, 0xa3, 0x93, 0xf1, 0x5c, 0x31, 0x7a, 0x4e, 0x0b, 0xbc, 0xa8, 0x32, 0x16, 0x0c,
+	0xb2, 0xf6, 0x44, 0x08, 0x38, 0x55, 0x54, 0xa2, 0xb7, 0x3b, 0xb3, 0x8e, 0x87, 0x81, 0x3e, 0x2b,
+	0xac, 0x88, 0xae, 0x57, 0x9e, 0x3d, 0xb3, 0x8e, 0x07, 0x81, 0x3e, 0x93, 0x43, 0x70, 0xe3, 0x3a,
+	0xaa, 0x92, 0x57, 0x9e, 0xa3, 0x2b, 0xdb, 0x88, 0x3c, 0x83, 0x31, 0x8f, 0x6a, 0xac, 0x64, 0x98,
+	0xb0, 0xb2, 0xa4, 0xd2, 0xeb, 0xe9, 0x7e, 0x23, 0xdd, 0xef, 0x4c, 0x43, 0xc1, 0x9e, 0xa9, 0x30,
+	0x11, 0x39, 0x80, 0x5e, 0xc9, 0x9a, 0x4a, 0x7a, 0xae, 0xbe, 0xde, 0x04, 0xe4, 0x21, 0x0c, 0xf3,
+	0x9a, 0x35, 0x3c, 0x5c, 0xe2, 0xca, 0xeb, 0xeb, 0x16, 0x03, 0x0d, 0x7c, 0x85, 0x2b, 0xff, 0x17,
+	0x0b, 0xf6, 0xbf, 0xa9, 0x59, 0x82, 0x42, 0x04, 0xf8, 0x5d, 0x83, 0x42, 0x92, 0xc7, 0xe0, 0xa4,
+	0x91, 0x8c, 0x3c, 0x6b, 0x66, 0xeb, 0xf1, 0xcc, 0x0e, 0xe6, 0x5a, 0x80, 0x40, 0xa7, 0xc8, 0x0c,
+	0xdc, 0x2b, 0x16, 0x87, 0x34, 0x35, 0xc3, 0x9d, 0x0e, 0x6f, 0xfe, 0x38, 0xea, 0x7d, 0xc9, 0xe2,
+	0x8b, 0xf3, 0xa0, 0x77, 0xc5, 0xe2, 0x8b, 0x94, 0x7c, 0x78, 0x4b, 0x9e, 0x35, 0x92, 0x37, 0x52,
+	0x4f, 0x3c, 0x3a, 0x19, 0x68, 0xf2, 0x97, 0x51, 0xde, 0x31, 0x7f, 0xa1, 0xb3, 0xaa, 0x67, 0x85,
+	0x3f, 0x48, 0xcf, 0xf9, 0xd7, 0x9e, 0x2a, 0xe5, 0xff, 0xbc, 0x0b, 0xf7, 0x6e, 0x99, 0x0a, 0xce,
+	0x2a, 0x81, 0x64, 0x02, 0xb6, 0x8c, 0x72, 0xcf, 0xda, 0xba, 0x5b, 0x81, 0x4a, 0xd6, 0x2c, 0xa2,
+	0x05, 0x1a, 0x8e, 0x83, 0xa0, 0x8d, 0x88, 0x07, 0x7d, 0xb1, 0xa4, 0x9c, 0x63, 0xda, 0x6e, 0xa1,
+	0x0b, 0xc9, 0xfb, 0x60, 0x17, 0x2c, 0xf7, 0x9c, 0x3b, 0x32, 0xbf, 0x88, 0xaf, 0x30, 0x91, 0x81,
+	0xc2, 0xc9, 0x03, 0x18, 0x14, 0x2c, 0x0f, 0x05, 0xbd, 0x46, 0xbd, 0x0a, 0x3b, 0xe8, 0x17, 0x2c,
+	0x7f, 0x49, 0xaf, 0x91, 0x7c, 0x0a, 0x20, 0x50, 0x36, 0x3c, 0x94, 0xb4, 0x44, 0xad, 0xfe, 0xe8,
+	0xe4, 0xc1, 0xdc, 0x58, 0x6a, 0xde, 0x59, 0x6a, 0x7e, 0xde, 0x5a, 0x2e, 0x18, 0xea, 0xe2, 0x4b,
+	0x5a, 0x22, 0x79, 0x02, 0xfb, 0x29, 0x7b, 0x5d, 0x15, 0x2c, 0x4a, 0xc3, 0x78, 0x25, 0x51, 0xe8,
+	0x0d, 0xd9, 0xc1, 0xb8, 0x43, 0x4f, 0x15, 0x48, 0x1e, 0xc3, 0x5e, 0xc3, 0xef, 0x14, 0x0d, 0x74,
+	0xd1, 0xa8, 0xe1, 0xb7, 0x25, 0xfe, 0x8f, 0x16, 0xdc, 0x3b, 0x8f, 0x64, 0x53, 0x9e, 0xbd, 0xc2,
+	0x64, 0xc9, 0x19, 0xad, 0x24, 0x79, 0x04, 0x43, 0x6e, 0x24, 0xc3, 0x54, 0xef, 0xd3, 0x0e, 0xd6,
+	0x00, 0x79, 0x04, 0x8e, 0x8c, 0x72, 0xe1, 0xed, 0xce, 0xec, 0x0d, 0xf9, 0x34, 0xba, 0xad, 0x93,
+	0xbd, 0xd6, 0xe9, 0x08, 0x9c, 0x82, 0xe5, 0xa2, 0x5d, 0xd6, 0x86, 0x50, 0x3a, 0xe1, 0x5f, 0xc2,
+	0xf8, 0x2c, 0xaa, 0x12, 0x2c, 0xd6, 0x96, 0xda, 0x53, 0xbe, 0x09, 0x33, 0x5a, 0x48, 0xac, 0x85,
+	0xa6, 0x32, 0x0c, 0x46, 0x0a, 0x7b, 0x6e, 0xa0, 0xb7, 0x5b, 0xca, 0x7f, 0x0a, 0xfb, 0xdd, 0xad,
+	0xed, 0xfa, 0x15, 0xc5, 0x26, 0x51, 0xd3, 0x78, 0x56, 0xbb, 0x4a, 0x13, 0xfa, 0x0d, 0xec, 0x7d,
+	0x8d, 0x75, 0x8e, 0x1d, 0x81, 0xf5, 0xed, 0xd6, 0x7f, 0x18, 0xf6, 0xff, 0xc5, 0x78, 0x02, 0x7d,
+	0xa6, 0x27, 0x14, 0x9e, 0xfd, 0xe6, 0xd4, 0x5d, 0xce, 0x7f, 0x06, 0xe3, 0xb6, 0x6d, 0xcb, 0xf0,
+	0x08, 0x1c, 0x59, 0x23, 0xb6, 0x0e, 0xdd, 0x94, 0x4a, 0x25, 0x4e, 0xfe, 0xb6, 0xc0, 0xfd, 0x56,
+	0x9b, 0x9d, 0x7c, 0x0e, 0xfd, 0xd6, 0xdf, 0xe4, 0xb0, 0x7b, 0x01, 0x36, 0x5f, 0xcd, 0xc9, 0x7b,
+	0x6f, 0xe0, 0xa6, 0x8f, 0xbf, 0x43, 0x3e, 0x06, 0xf7, 0xa5, 0x8c, 0x64, 0xa3, 0x1e, 0xde, 0x36,
+	0xde, 0x17, 0xea, 0x5b, 0x36, 0x79, 0x77, 0xae, 0x3e, 0x92, 0xa6, 0x99, 0x29, 0xf5, 0x77, 0xc8,
+	0x67, 0xe0, 0x1a, 0x51, 0xc9, 0xfd, 0xee, 0xee, 0x8d, 0xd5, 0x4d, 0x0e, 0xb7, 0xe1, 0xdb, 0x8e,
+	0x9f, 0x40, 0x4f, 0x0f, 0x4b, 0x0e, 0xba, 0x92, 0xbb, 0x92, 0x4f, 0xee, 0x6f, 0xa1, 0xdd, 0x73,
+	0xa7, 0xef, 0xfc, 0x7a, 0x33, 0xb5, 0x7e, 0xbb, 0x99, 0x5a, 0x7f, 0xde, 0x4c, 0xad, 0x9f, 0xfe,
+	0x9a, 0xee, 0xc4, 0xae, 0x66, 0xfa, 0xd1, 0x3f, 0x03, 0x00, 0x81, 0xd4, 0xec, 0x67, 0x17, 0x06,
+	0x00, 0x00,
 }
@@ -1,7 +1,9 @@
 package cmds
 
 import (
+	"bufio"
 	"bytes"
+	"encoding/csv"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,10 +12,13 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"os/exec"
 	"os/user"
 	"sort"
+	"strconv"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/fsouza/go-dockerclient"
 	"github.com/gogo/protobuf/jsonpb"
@@ -21,6 +26,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/pkg/uuid"
 	ppsclient "github.com/pachyderm/pachyderm/src/client/pps"
 	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
+	pkgpretty "github.com/pachyderm/pachyderm/src/server/pkg/pretty"
 	"github.com/pachyderm/pachyderm/src/server/pps/pretty"
 	"github.com/spf13/cobra"
 	"golang.org/x/net/context"
@@ -33,12 +39,16 @@ const (
 )
 
 // Cmds returns a slice containing pps commands.
-func Cmds(address string, noMetrics *bool) ([]*cobra.Command, error) {
+func Cmds(address string, noMetrics *bool, output *string) ([]*cobra.Command, error) {
 	metrics := !*noMetrics
 	raw := false
 	rawFlag := func(cmd *cobra.Command) {
 		cmd.Flags().BoolVar(&raw, "raw", false, "disable pretty printing, print raw json")
 	}
+	var columnNames string
+	columnsFlag := func(cmd *cobra.Command) {
+		cmd.Flags().StringVar(&columnNames, "columns", "", "comma-separated list of the columns to print")
+	}
 	marshaller := &jsonpb.Marshaler{Indent: "  "}
 
 	job := &cobra.Command{
@@ -82,6 +92,9 @@ The increase the throughput of a job increase the Shard paremeter.
 			if raw {
 				return marshaller.Marshal(os.Stdout, jobInfo)
 			}
+			if pkgpretty.Structured(*output) {
+				return pkgpretty.PrintStructured(os.Stdout, *output, jobInfo)
+			}
 			return pretty.PrintDetailedJobInfo(jobInfo)
 		}),
 	}
@@ -107,6 +120,12 @@ Examples:
 
 	# return all jobs in pipeline foo and whose input commits include bar/YYY
 	$ pachctl list-job -p foo bar/YYY
+
+	# return all jobs, with every column
+	$ pachctl list-job -o wide
+
+	# return the IDs, restart counts and skipped datums of all jobs
+	$ pachctl list-job --columns id,restart,data-skipped
 ` + codeend,
 		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
 			client, err := pach.NewMetricsClientFromAddress(address, metrics, "user")
@@ -118,6 +137,10 @@ Examples:
 			if err != nil {
 				return err
 			}
+			columns, err := pretty.JobColumns(columnNames, *output)
+			if err != nil {
+				return err
+			}
 
 			jobInfos, err := client.ListJob(pipelineName, commits)
 			if err != nil {
@@ -135,10 +158,13 @@ Examples:
 				}
 				return nil
 			}
+			if pkgpretty.Structured(*output) {
+				return pkgpretty.PrintStructured(os.Stdout, *output, jobInfos)
+			}
 			writer := tabwriter.NewWriter(os.Stdout, 0, 1, 1, ' ', 0)
-			pretty.PrintJobHeader(writer)
+			pretty.PrintJobHeader(writer, columns)
 			for _, jobInfo := range jobInfos {
-				pretty.PrintJobInfo(writer, jobInfo)
+				pretty.PrintJobInfo(writer, jobInfo, columns)
 			}
 
 			return writer.Flush()
@@ -146,6 +172,69 @@ Examples:
 	}
 	listJob.Flags().StringVarP(&pipelineName, "pipeline", "p", "", "Limit to jobs made by pipeline.")
 	rawFlag(listJob)
+	columnsFlag(listJob)
+
+	var pipelines cmdutil.RepeatedStringArg
+	flushJob := &cobra.Command{
+		Use:   "flush-job commit [commit ...]",
+		Short: "Wait for all jobs caused by the specified commits to finish and return them.",
+		Long: `Wait for all jobs caused by the specified commits to finish and return them.
+
+The jobs are printed as they finish, and the command fails if any of them
+didn't succeed. Jobs downstream of a job that didn't succeed aren't run, so
+they aren't waited for.
+
+Examples:
+
+	` + codestart + `# return jobs caused by foo/XXX and bar/YYY
+	$ pachctl flush-job foo/XXX bar/YYY
+
+	# return jobs caused by foo/XXX leading to pipelines bar and baz
+	$ pachctl flush-job foo/XXX -p bar -p baz
+` + codeend,
+		Run: cmdutil.Run(func(args []string) error {
+			commits, err := cmdutil.ParseCommits(args)
+			if err != nil {
+				return err
+			}
+			columns, err := pretty.JobColumns(columnNames, *output)
+			if err != nil {
+				return err
+			}
+			client, err := pach.NewMetricsClientFromAddress(address, metrics, "user")
+			if err != nil {
+				return err
+			}
+
+			var failed int
+			writer := tabwriter.NewWriter(os.Stdout, 0, 1, 1, ' ', 0)
+			if !raw && !pkgpretty.Structured(*output) {
+				pretty.PrintJobHeader(writer, columns)
+			}
+			if err := client.FlushJob(commits, pipelines, func(jobInfo *ppsclient.JobInfo) error {
+				if jobInfo.State != ppsclient.JobState_JOB_SUCCESS {
+					failed++
+				}
+				if raw {
+					return marshaller.Marshal(os.Stdout, jobInfo)
+				}
+				if pkgpretty.Structured(*output) {
+					return pkgpretty.PrintStructuredStream(os.Stdout, *output, jobInfo)
+				}
+				pretty.PrintJobInfo(writer, jobInfo, columns)
+				return writer.Flush()
+			}); err != nil {
+				return sanitizeErr(err)
+			}
+			if failed > 0 {
+				return fmt.Errorf("%d job(s) didn't succeed", failed)
+			}
+			return nil
+		}),
+	}
+	flushJob.Flags().VarP(&pipelines, "pipeline", "p", "Wait only for jobs leading to a specific set of pipelines")
+	rawFlag(flushJob)
+	columnsFlag(flushJob)
 
 	deleteJob := &cobra.Command{
 		Use:   "delete-job job-id",
@@ -209,6 +298,7 @@ Examples:
 	var (
 		jobID       string
 		commaInputs string // comma-separated list of input files of interest
+		follow      bool
 	)
 	getLogs := &cobra.Command{
 		Use:   "get-logs [--pipeline=<pipeline>|--job=<job id>]",
@@ -225,6 +315,9 @@ Examples:
 
 	# return logs emitted by the pipeline \"filter\" while processing /apple.txt and a file with the hash 123aef
 	$ pachctl get-logs --pipeline=filter --inputs=/apple.txt,123aef
+
+	# follow the logs of the job aedfa12aedf as they're written, until it finishes
+	$ pachctl get-logs --job=aedfa12aedf --follow
 ` + codeend,
 		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
 			client, err := pach.NewMetricsClientFromAddress(address, metrics, "user")
@@ -251,7 +344,12 @@ Examples:
 
 			// Issue RPC
 			marshaler := &jsonpb.Marshaler{}
-			iter := client.GetLogs(pipelineName, jobID, data)
+			var iter *pach.LogsIter
+			if follow {
+				iter = client.GetLogsFollow(pipelineName, jobID, data)
+			} else {
+				iter = client.GetLogs(pipelineName, jobID, data)
+			}
 			for iter.Next() {
 				var messageStr string
 				if raw {
@@ -279,6 +377,7 @@ Examples:
 	getLogs.Flags().StringVar(&commaInputs, "inputs", "", "Filter for log lines "+
 		"generated while processing these files (accepts PFS paths or file hashes)")
 	getLogs.Flags().BoolVar(&raw, "raw", false, "Return log messages verbatim from server.")
+	getLogs.Flags().BoolVarP(&follow, "follow", "f", false, "Follow logs as more are created, until the job finishes (or, for a pipeline, until interrupted).")
 
 	pipeline := &cobra.Command{
 		Use:   "pipeline",
@@ -297,6 +396,8 @@ All jobs created by a pipeline will create commits in the pipeline's repo.
 	}
 
 	var pushImages bool
+	var pinImage bool
+	var skipCapacityCheck bool
 	var registry string
 	var username string
 	var password string
@@ -305,9 +406,12 @@ All jobs created by a pipeline will create commits in the pipeline's repo.
 	createPipeline := &cobra.Command{
 		Use:   "create-pipeline -f pipeline.json",
 		Short: "Create a new pipeline.",
-		Long:  fmt.Sprintf("Create a new pipeline from a %s", pipelineSpec),
+		Long: fmt.Sprintf(`Create a new pipeline from a %s
+
+The file can contain several pipelines, they're created in dependency order,
+so that each pipeline is created after the pipelines it takes input from.`, pipelineSpec),
 		Run: cmdutil.RunFixedArgs(0, func(args []string) (retErr error) {
-			cfgReader, err := newPipelineManifestReader(pipelinePath)
+			requests, err := readPipelineRequests(pipelinePath)
 			if err != nil {
 				return err
 			}
@@ -315,13 +419,7 @@ All jobs created by a pipeline will create commits in the pipeline's repo.
 			if err != nil {
 				return sanitizeErr(err)
 			}
-			for {
-				request, err := cfgReader.nextCreatePipelineRequest()
-				if err == io.EOF {
-					break
-				} else if err != nil {
-					return err
-				}
+			for _, request := range requests {
 				if len(request.Inputs) != 0 {
 					fmt.Printf("WARNING: field `inputs` is deprecated and will be removed in v1.6. Both formats are valid for v1.4.6 to 1.5.x. See docs for the new input format: http://pachyderm.readthedocs.io/en/latest/reference/pipeline_spec.html \n")
 				}
@@ -332,6 +430,8 @@ All jobs created by a pipeline will create commits in the pipeline's repo.
 					}
 					request.Transform.Image = pushedImage
 				}
+				request.PinImage = pinImage
+				request.SkipCapacityCheck = skipCapacityCheck
 				if _, err := client.PpsAPIClient.CreatePipeline(
 					context.Background(),
 					request,
@@ -344,6 +444,8 @@ All jobs created by a pipeline will create commits in the pipeline's repo.
 	}
 	createPipeline.Flags().StringVarP(&pipelinePath, "file", "f", "-", "The file containing the pipeline, it can be a url or local file. - reads from stdin.")
 	createPipeline.Flags().BoolVarP(&pushImages, "push-images", "p", false, "If true, push local docker images into the cluster registry.")
+	createPipeline.Flags().BoolVar(&skipCapacityCheck, "skip-capacity-check", false, "If true, don't check that the pipeline's workers fit on the cluster's nodes, e.g. because the cluster adds nodes as they're needed.")
+	createPipeline.Flags().BoolVar(&pinImage, "pin-image", false, "If true, resolve the tag of the pipeline's image to a digest, so that its workers keep running the same image if the tag is moved.")
 	createPipeline.Flags().StringVarP(&registry, "registry", "r", "docker.io", "The registry to push images to.")
 	createPipeline.Flags().StringVarP(&username, "username", "u", "", "The username to push images as, defaults to your OS username.")
 	createPipeline.Flags().StringVarP(&password, "password", "", "", "Your password for the registry being pushed to.")
@@ -352,9 +454,12 @@ All jobs created by a pipeline will create commits in the pipeline's repo.
 	updatePipeline := &cobra.Command{
 		Use:   "update-pipeline -f pipeline.json",
 		Short: "Update an existing Pachyderm pipeline.",
-		Long:  fmt.Sprintf("Update a Pachyderm pipeline with a new %s", pipelineSpec),
+		Long: fmt.Sprintf(`Update a Pachyderm pipeline with a new %s
+
+The file can contain several pipelines, they're updated in dependency order,
+so that each pipeline is updated after the pipelines it takes input from.`, pipelineSpec),
 		Run: cmdutil.RunFixedArgs(0, func(args []string) (retErr error) {
-			cfgReader, err := newPipelineManifestReader(pipelinePath)
+			requests, err := readPipelineRequests(pipelinePath)
 			if err != nil {
 				return err
 			}
@@ -362,13 +467,7 @@ All jobs created by a pipeline will create commits in the pipeline's repo.
 			if err != nil {
 				return sanitizeErr(err)
 			}
-			for {
-				request, err := cfgReader.nextCreatePipelineRequest()
-				if err == io.EOF {
-					break
-				} else if err != nil {
-					return err
-				}
+			for _, request := range requests {
 				request.Update = true
 				if pushImages {
 					pushedImage, err := pushImage(registry, username, password, request.Transform.Image)
@@ -377,6 +476,8 @@ All jobs created by a pipeline will create commits in the pipeline's repo.
 					}
 					request.Transform.Image = pushedImage
 				}
+				request.PinImage = pinImage
+				request.SkipCapacityCheck = skipCapacityCheck
 				if _, err := client.PpsAPIClient.CreatePipeline(
 					context.Background(),
 					request,
@@ -389,10 +490,143 @@ All jobs created by a pipeline will create commits in the pipeline's repo.
 	}
 	updatePipeline.Flags().StringVarP(&pipelinePath, "file", "f", "-", "The file containing the pipeline, it can be a url or local file. - reads from stdin.")
 	updatePipeline.Flags().BoolVarP(&pushImages, "push-images", "p", false, "If true, push local docker images into the cluster registry.")
+	updatePipeline.Flags().BoolVar(&skipCapacityCheck, "skip-capacity-check", false, "If true, don't check that the pipeline's workers fit on the cluster's nodes, e.g. because the cluster adds nodes as they're needed.")
+	updatePipeline.Flags().BoolVar(&pinImage, "pin-image", false, "If true, resolve the tag of the pipeline's image to a digest, so that its workers keep running the same image if the tag is moved.")
 	updatePipeline.Flags().StringVarP(&registry, "registry", "r", "docker.io", "The registry to push images to.")
 	updatePipeline.Flags().StringVarP(&username, "username", "u", "", "The username to push images as, defaults to your OS username.")
 	updatePipeline.Flags().StringVarP(&password, "password", "", "", "Your password for the registry being pushed to.")
 
+	var editor string
+	editPipeline := &cobra.Command{
+		Use:   "edit-pipeline pipeline-name",
+		Short: "Edit the spec of a pipeline.",
+		Long: `Edit the spec of a pipeline in a text editor, the pipeline is updated
+with the edited spec when the editor exits.
+
+The editor is --editor if it's set, otherwise $EDITOR, otherwise vi. If the
+edited spec can't be applied, it's kept in a temporary file so that it isn't
+lost.`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) (retErr error) {
+			client, err := pach.NewMetricsClientFromAddress(address, metrics, "user")
+			if err != nil {
+				return err
+			}
+			pipelineInfo, err := client.InspectPipeline(args[0])
+			if err != nil {
+				return sanitizeErr(err)
+			}
+			var spec bytes.Buffer
+			if err := marshaller.Marshal(&spec, ppsclient.PipelineReqFromInfo(pipelineInfo)); err != nil {
+				return err
+			}
+			f, err := ioutil.TempFile("", args[0]+"-spec-")
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if retErr == nil {
+					os.Remove(f.Name())
+				} else {
+					retErr = fmt.Errorf("%v (the edited spec is in %s)", retErr, f.Name())
+				}
+			}()
+			if _, err := f.Write(spec.Bytes()); err != nil {
+				f.Close()
+				return err
+			}
+			if err := f.Close(); err != nil {
+				return err
+			}
+			if err := runEditor(editor, f.Name()); err != nil {
+				return err
+			}
+			edited, err := ioutil.ReadFile(f.Name())
+			if err != nil {
+				return err
+			}
+			if bytes.Equal(edited, spec.Bytes()) {
+				fmt.Println("Pipeline unchanged.")
+				return nil
+			}
+			cfgReader, err := newPipelineManifestReader(f.Name())
+			if err != nil {
+				return err
+			}
+			request, err := cfgReader.nextCreatePipelineRequest()
+			if err != nil {
+				return err
+			}
+			if request.Pipeline == nil || request.Pipeline.Name != args[0] {
+				return fmt.Errorf("the pipeline's name can't be edited")
+			}
+			request.Update = true
+			if _, err := client.PpsAPIClient.CreatePipeline(
+				context.Background(),
+				request,
+			); err != nil {
+				return sanitizeErr(err)
+			}
+			return nil
+		}),
+	}
+	editPipeline.Flags().StringVar(&editor, "editor", "", "The editor to use, instead of $EDITOR.")
+
+	var fromVersion, toVersion uint64
+	diffPipeline := &cobra.Command{
+		Use:   "diff-pipeline pipeline-name",
+		Short: "Show the changes to a pipeline's spec between two versions.",
+		Long: `Show the changes to a pipeline's spec between two versions, as a unified diff.
+
+--to defaults to the pipeline's current version and --from defaults to the
+version before --to.
+
+Examples:
+
+` + codestart + `# show the changes made by the last update of pipeline foo
+$ pachctl diff-pipeline foo
+
+# show the changes between versions 3 and 5 of pipeline foo
+$ pachctl diff-pipeline foo --from 3 --to 5
+` + codeend,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			client, err := pach.NewMetricsClientFromAddress(address, metrics, "user")
+			if err != nil {
+				return err
+			}
+			toInfo, err := client.InspectPipelineVersion(args[0], toVersion)
+			if err != nil {
+				return sanitizeErr(err)
+			}
+			from := fromVersion
+			if from == 0 {
+				if toInfo.Version <= 1 {
+					return fmt.Errorf("pipeline %s has no version before version %d", args[0], toInfo.Version)
+				}
+				from = toInfo.Version - 1
+			}
+			fromInfo, err := client.InspectPipelineVersion(args[0], from)
+			if err != nil {
+				return sanitizeErr(err)
+			}
+			var fromSpec, toSpec bytes.Buffer
+			if err := marshaller.Marshal(&fromSpec, ppsclient.PipelineReqFromInfo(fromInfo)); err != nil {
+				return err
+			}
+			if err := marshaller.Marshal(&toSpec, ppsclient.PipelineReqFromInfo(toInfo)); err != nil {
+				return err
+			}
+			fmt.Print(pkgpretty.UnifiedDiff(
+				fmt.Sprintf("%s@v%d", args[0], fromInfo.Version),
+				fmt.Sprintf("%s@v%d", args[0], toInfo.Version),
+				fromSpec.String()+"\n",
+				toSpec.String()+"\n",
+			))
+			return nil
+		}),
+	}
+	diffPipeline.Flags().Uint64Var(&fromVersion, "from", 0, "The version to diff from.")
+	diffPipeline.Flags().Uint64Var(&toVersion, "to", 0, "The version to diff to.")
+
 	inspectPipeline := &cobra.Command{
 		Use:   "inspect-pipeline pipeline-name",
 		Short: "Return info about a pipeline.",
@@ -412,11 +646,15 @@ All jobs created by a pipeline will create commits in the pipeline's repo.
 			if raw {
 				return marshaller.Marshal(os.Stdout, pipelineInfo)
 			}
+			if pkgpretty.Structured(*output) {
+				return pkgpretty.PrintStructured(os.Stdout, *output, pipelineInfo)
+			}
 			return pretty.PrintDetailedPipelineInfo(pipelineInfo)
 		}),
 	}
 	rawFlag(inspectPipeline)
 
+	var listPipelineProject string
 	listPipeline := &cobra.Command{
 		Use:   "list-pipeline",
 		Short: "Return info about all pipelines.",
@@ -426,10 +664,17 @@ All jobs created by a pipeline will create commits in the pipeline's repo.
 			if err != nil {
 				return err
 			}
-			pipelineInfos, err := client.ListPipeline()
+			columns, err := pretty.PipelineColumns(columnNames, *output)
+			if err != nil {
+				return err
+			}
+			resp, err := client.PpsAPIClient.ListPipeline(context.Background(), &ppsclient.ListPipelineRequest{
+				Project: listPipelineProject,
+			})
 			if err != nil {
 				return sanitizeErr(err)
 			}
+			pipelineInfos := resp.PipelineInfo
 			if raw {
 				for _, pipelineInfo := range pipelineInfos {
 					if err := marshaller.Marshal(os.Stdout, pipelineInfo); err != nil {
@@ -438,23 +683,44 @@ All jobs created by a pipeline will create commits in the pipeline's repo.
 				}
 				return nil
 			}
+			if pkgpretty.Structured(*output) {
+				return pkgpretty.PrintStructured(os.Stdout, *output, pipelineInfos)
+			}
 			writer := tabwriter.NewWriter(os.Stdout, 20, 1, 3, ' ', 0)
-			pretty.PrintPipelineHeader(writer)
+			pretty.PrintPipelineHeader(writer, columns)
 			for _, pipelineInfo := range pipelineInfos {
-				pretty.PrintPipelineInfo(writer, pipelineInfo)
+				pretty.PrintPipelineInfo(writer, pipelineInfo, columns)
 			}
 			return writer.Flush()
 		}),
 	}
 	rawFlag(listPipeline)
+	columnsFlag(listPipeline)
+	listPipeline.Flags().StringVar(&listPipelineProject, "project", "", "list only the pipelines in project")
 
 	var all bool
 	var deleteJobs bool
 	var deleteRepo bool
+	var cascade bool
+	var yes bool
 	deletePipeline := &cobra.Command{
 		Use:   "delete-pipeline pipeline-name",
 		Short: "Delete a pipeline.",
-		Long:  "Delete a pipeline.",
+		Long: `Delete a pipeline.
+
+With --cascade the pipelines downstream of the pipeline are deleted as well,
+the pipelines that will be deleted are listed first, and you're asked to
+confirm, unless --yes is set.
+
+Examples:
+
+	` + codestart + `# delete pipeline foo
+	$ pachctl delete-pipeline foo
+
+	# delete pipeline foo, the pipelines downstream of it and all of their
+	# output repos
+	$ pachctl delete-pipeline foo --cascade --delete-repo
+` + codeend,
 		Run: cmdutil.RunBoundedArgs(0, 1, func(args []string) error {
 			client, err := pach.NewMetricsClientFromAddress(address, metrics, "user")
 			if err != nil {
@@ -466,6 +732,32 @@ All jobs created by a pipeline will create commits in the pipeline's repo.
 			if len(args) == 0 && !all {
 				return fmt.Errorf("either a pipeline name or the --all flag needs to be provided")
 			}
+			if all && cascade {
+				return fmt.Errorf("cannot use the --cascade flag with the --all flag")
+			}
+			if cascade && !yes {
+				pipelineInfos, err := client.ListPipeline()
+				if err != nil {
+					return sanitizeErr(err)
+				}
+				downstream := ppsclient.DownstreamPipelines(args[0], pipelineInfos)
+				fmt.Println("The following pipelines will be deleted:")
+				for _, pipeline := range append(downstream, args[0]) {
+					fmt.Printf("  %s\n", pipeline)
+				}
+				if deleteRepo {
+					fmt.Println("along with their output repos.")
+				}
+				fmt.Println("Are you sure you want to proceed? yN")
+				r := bufio.NewReader(os.Stdin)
+				answer, err := r.ReadBytes('\n')
+				if err != nil {
+					return err
+				}
+				if !(answer[0] == 'y' || answer[0] == 'Y') {
+					return nil
+				}
+			}
 			if all {
 				_, err = client.PpsAPIClient.DeletePipeline(context.Background(), &ppsclient.DeletePipelineRequest{
 					All:        all,
@@ -477,6 +769,7 @@ All jobs created by a pipeline will create commits in the pipeline's repo.
 					Pipeline:   &ppsclient.Pipeline{args[0]},
 					DeleteJobs: deleteJobs,
 					DeleteRepo: deleteRepo,
+					Cascade:    cascade,
 				})
 			}
 			if err != nil {
@@ -488,6 +781,8 @@ All jobs created by a pipeline will create commits in the pipeline's repo.
 	deletePipeline.Flags().BoolVar(&all, "all", false, "delete all pipelines")
 	deletePipeline.Flags().BoolVar(&deleteJobs, "delete-jobs", false, "delete the jobs in this pipeline as well")
 	deletePipeline.Flags().BoolVar(&deleteRepo, "delete-repo", false, "delete the output repo of the pipeline as well")
+	deletePipeline.Flags().BoolVar(&cascade, "cascade", false, "delete the pipelines downstream of the pipeline as well")
+	deletePipeline.Flags().BoolVar(&yes, "yes", false, "don't ask for confirmation before deleting downstream pipelines")
 
 	startPipeline := &cobra.Command{
 		Use:   "start-pipeline pipeline-name",
@@ -575,26 +870,129 @@ All jobs created by a pipeline will create commits in the pipeline's repo.
 	}
 	runPipeline.Flags().StringVarP(&specPath, "file", "f", "", "The file containing the run-pipeline spec, - reads from stdin.")
 
+	report := &cobra.Command{
+		Use:   "report usage",
+		Short: "Reports about the cluster.",
+		Long:  "Reports about the cluster.",
+	}
+
+	var since string
+	var format string
+	usage := &cobra.Command{
+		Use:   "usage",
+		Short: "Report the storage and compute used by each repo and pipeline.",
+		Long: `Report the storage and compute used by each repo and pipeline.
+
+Storage is the current size of each repo, and is also attributed to the
+pipeline that outputs to it. Compute is measured in pod-hours (a job's
+duration times the number of workers it ran on) and cpu-hours (pod-hours times
+the cpu each worker requested) for jobs that ran after --since. Wall-clock
+hours are the jobs' durations. Measured cpu-hours and memory GB-hours are
+what the jobs' worker pods actually used, sampled from metrics-server if it's
+deployed, and node types are the instance types of the nodes they ran on.
+
+Examples:
+
+` + codestart + `# Report usage over the last 30 days as CSV
+$ pachctl report usage --since 30d
+
+# Report all usage as JSON
+$ pachctl report usage -o json
+` + codeend,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			client, err := pach.NewMetricsClientFromAddress(address, metrics, "user")
+			if err != nil {
+				return err
+			}
+			var sinceTime time.Time
+			if since != "" {
+				sinceTime, err = cmdutil.ParseTime(since)
+				if err != nil {
+					return err
+				}
+			}
+			response, err := client.Usage(sinceTime)
+			if err != nil {
+				return err
+			}
+			switch format {
+			case "json":
+				marshaller := &jsonpb.Marshaler{Indent: "  "}
+				return marshaller.Marshal(os.Stdout, response)
+			case "csv":
+				return writeUsageCSV(os.Stdout, response)
+			default:
+				return fmt.Errorf("unrecognized output format %q, must be \"csv\" or \"json\"", format)
+			}
+		}),
+	}
+	usage.Flags().StringVar(&since, "since", "", "Only count compute used after this time, either a duration ago (\"30d\", \"12h\") or an RFC3339 timestamp.")
+	usage.Flags().StringVarP(&format, "output", "o", "csv", "Output format, \"csv\" or \"json\".")
+	report.AddCommand(usage)
+
 	var result []*cobra.Command
 	result = append(result, job)
 	result = append(result, inspectJob)
 	result = append(result, listJob)
+	result = append(result, flushJob)
 	result = append(result, deleteJob)
 	result = append(result, stopJob)
 	result = append(result, restartDatum)
 	result = append(result, getLogs)
+	result = append(result, topCmd(address, metrics))
 	result = append(result, pipeline)
 	result = append(result, createPipeline)
 	result = append(result, updatePipeline)
+	result = append(result, editPipeline)
+	result = append(result, diffPipeline)
 	result = append(result, inspectPipeline)
 	result = append(result, listPipeline)
 	result = append(result, deletePipeline)
 	result = append(result, startPipeline)
 	result = append(result, stopPipeline)
 	result = append(result, runPipeline)
+	result = append(result, report)
 	return result, nil
 }
 
+// writeUsageCSV writes a usage report as CSV, with one row per repo and one
+// row per pipeline.
+func writeUsageCSV(w io.Writer, response *ppsclient.UsageResponse) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"kind", "name", "size_bytes", "jobs", "pod_hours", "cpu_hours", "data_processed", "wall_clock_hours", "measured_cpu_hours", "memory_gb_hours", "node_types"}); err != nil {
+		return err
+	}
+	for _, repo := range response.Repos {
+		if err := writer.Write([]string{
+			"repo",
+			repo.Repo.Name,
+			strconv.FormatUint(repo.SizeBytes, 10),
+			"", "", "", "", "", "", "", "",
+		}); err != nil {
+			return err
+		}
+	}
+	for _, pipeline := range response.Pipelines {
+		if err := writer.Write([]string{
+			"pipeline",
+			pipeline.Pipeline.Name,
+			strconv.FormatUint(pipeline.OutputSizeBytes, 10),
+			strconv.FormatUint(pipeline.Jobs, 10),
+			strconv.FormatFloat(pipeline.PodHours, 'f', 3, 64),
+			strconv.FormatFloat(pipeline.CpuHours, 'f', 3, 64),
+			strconv.FormatInt(pipeline.DataProcessed, 10),
+			strconv.FormatFloat(pipeline.WallClockHours, 'f', 3, 64),
+			strconv.FormatFloat(pipeline.MeasuredCpuHours, 'f', 3, 64),
+			strconv.FormatFloat(pipeline.MemoryGbHours, 'f', 3, 64),
+			strings.Join(pipeline.NodeTypes, " "),
+		}); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
 // ByCreationTime is an implementation of sort.Interface which
 // sorts pps job info by creation time, ascending.
 type ByCreationTime []*ppsclient.JobInfo
@@ -624,6 +1022,92 @@ type pipelineManifestReader struct {
 	decoder *json.Decoder
 }
 
+// runEditor opens path in editor, or in $EDITOR if editor is "", or in vi if
+// neither is set, and waits for it to exit.
+func runEditor(editor string, path string) error {
+	if editor == "" {
+		editor = os.Getenv("EDITOR")
+	}
+	if editor == "" {
+		editor = "vi"
+	}
+	// editor may include arguments, e.g. "code --wait"
+	args := strings.Fields(editor)
+	cmd := exec.Command(args[0], append(args[1:], path)...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("error running %s: %v", editor, err)
+	}
+	return nil
+}
+
+// readPipelineRequests reads the pipeline specs in path, which can be a URL,
+// a local file or "-" for stdin. They're ordered so that each pipeline comes
+// after the pipelines it takes input from.
+func readPipelineRequests(path string) ([]*ppsclient.CreatePipelineRequest, error) {
+	cfgReader, err := newPipelineManifestReader(path)
+	if err != nil {
+		return nil, err
+	}
+	var requests []*ppsclient.CreatePipelineRequest
+	for {
+		request, err := cfgReader.nextCreatePipelineRequest()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		requests = append(requests, request)
+	}
+	names := make(map[string]bool)
+	for _, request := range requests {
+		if request.Pipeline != nil {
+			names[request.Pipeline.Name] = true
+		}
+	}
+	// Repeatedly take the pipelines whose inputs from the specs have all
+	// been taken, keeping the order of the specs where possible.
+	var result []*ppsclient.CreatePipelineRequest
+	taken := make([]bool, len(requests))
+	takenNames := make(map[string]bool)
+	for len(result) < len(requests) {
+		var ready []int
+		for i, request := range requests {
+			if taken[i] {
+				continue
+			}
+			inputs := ppsclient.InputRepos(request.Input)
+			for _, input := range request.Inputs {
+				if input.Repo != nil {
+					inputs = append(inputs, input.Repo.Name)
+				}
+			}
+			isReady := true
+			for _, input := range inputs {
+				if names[input] && !takenNames[input] {
+					isReady = false
+				}
+			}
+			if isReady {
+				ready = append(ready, i)
+			}
+		}
+		if len(ready) == 0 {
+			return nil, fmt.Errorf("the pipelines' inputs form a cycle")
+		}
+		for _, i := range ready {
+			taken[i] = true
+			if requests[i].Pipeline != nil {
+				takenNames[requests[i].Pipeline.Name] = true
+			}
+			result = append(result, requests[i])
+		}
+	}
+	return result, nil
+}
+
 func newPipelineManifestReader(path string) (result *pipelineManifestReader, retErr error) {
 	result = new(pipelineManifestReader)
 	var pipelineReader io.Reader
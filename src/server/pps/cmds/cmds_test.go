@@ -38,7 +38,8 @@ const badJSON2 = `
 func rootCmd() *cobra.Command {
 	rootCmd := &cobra.Command{}
 	noMetrics := false
-	cmds, _ := Cmds("0.0.0.0:30650", &noMetrics)
+	var output string
+	cmds, _ := Cmds("0.0.0.0:30650", &noMetrics, &output)
 	for _, cmd := range cmds {
 		rootCmd.AddCommand(cmd)
 	}
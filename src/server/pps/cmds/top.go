@@ -0,0 +1,201 @@
+package cmds
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	pach "github.com/pachyderm/pachyderm/src/client"
+	ppsclient "github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
+	pkgpretty "github.com/pachyderm/pachyderm/src/server/pkg/pretty"
+	"github.com/spf13/cobra"
+)
+
+// clearScreen moves the cursor to the top left of the terminal and clears
+// it, so that each refresh of top replaces the last one.
+const clearScreen = "\033[H\033[2J"
+
+// topSample is a running job's progress at one refresh of top, which the
+// next refresh compares against to compute the job's throughput.
+type topSample struct {
+	at            time.Time
+	processed     int64
+	downloadBytes int64
+	uploadBytes   int64
+}
+
+func topCmd(address string, metrics bool) *cobra.Command {
+	var interval time.Duration
+	var once bool
+	top := &cobra.Command{
+		Use:   "top",
+		Short: "Show the throughput of running jobs, refreshing in place.",
+		Long: `Show the throughput of running jobs, refreshing in place.
+
+For each running job, top shows how many of its datums have been processed and
+how many datums per second are being processed, how much data its workers have
+downloaded from its inputs and uploaded as its output (and how quickly), how
+many of its worker pods are busy processing a datum, and the cpu and memory
+they're using. Rates are computed between refreshes, so they're shown from the
+second refresh on. Cpu and memory are only shown if metrics-server is deployed.
+
+Examples:
+
+` + codestart + `# Watch running jobs, refreshing every 2 seconds
+$ pachctl top
+
+# Print running jobs' throughput over 10 seconds, once
+$ pachctl top --once --interval 10s
+` + codeend,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			if interval <= 0 {
+				return fmt.Errorf("--interval must be positive, got %v", interval)
+			}
+			client, err := pach.NewMetricsClientFromAddress(address, metrics, "user")
+			if err != nil {
+				return err
+			}
+			samples := make(map[string]*topSample)
+			for i := 0; ; i++ {
+				jobInfos, err := runningJobs(client)
+				if err != nil {
+					return err
+				}
+				if once {
+					if i == 0 {
+						updateTopSamples(samples, jobInfos, time.Now())
+						time.Sleep(interval)
+						continue
+					}
+					return printTop(os.Stdout, jobInfos, samples, time.Now())
+				}
+				var buffer bytes.Buffer
+				buffer.WriteString(clearScreen)
+				fmt.Fprintf(&buffer, "%s, every %v\n\n", time.Now().Format(time.Stamp), interval)
+				if err := printTop(&buffer, jobInfos, samples, time.Now()); err != nil {
+					return err
+				}
+				if _, err := buffer.WriteTo(os.Stdout); err != nil {
+					return err
+				}
+				time.Sleep(interval)
+			}
+		}),
+	}
+	top.Flags().DurationVar(&interval, "interval", 2*time.Second, "How often to refresh.")
+	top.Flags().BoolVar(&once, "once", false, "Print the running jobs' throughput over one interval and exit, rather than refreshing in place.")
+	return top
+}
+
+// runningJobs returns the jobs that are running, with their worker status
+// and worker pods filled in, ordered by pipeline.
+func runningJobs(client *pach.APIClient) ([]*ppsclient.JobInfo, error) {
+	jobInfos, err := client.ListJob("", nil)
+	if err != nil {
+		return nil, sanitizeErr(err)
+	}
+	var result []*ppsclient.JobInfo
+	for _, jobInfo := range jobInfos {
+		if jobInfo.State != ppsclient.JobState_JOB_RUNNING {
+			continue
+		}
+		jobInfo, err := client.InspectJob(jobInfo.Job.ID, false)
+		if err != nil {
+			// The job may have been deleted since it was listed.
+			continue
+		}
+		result = append(result, jobInfo)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Pipeline.Name != result[j].Pipeline.Name {
+			return result[i].Pipeline.Name < result[j].Pipeline.Name
+		}
+		return result[i].Job.ID < result[j].Job.ID
+	})
+	return result, nil
+}
+
+// printTop prints a line for each of jobInfos, computing their throughput
+// since their last samples, and replaces samples with the jobs' progress
+// now.
+func printTop(w io.Writer, jobInfos []*ppsclient.JobInfo, samples map[string]*topSample, now time.Time) error {
+	writer := tabwriter.NewWriter(w, 10, 1, 3, ' ', 0)
+	fmt.Fprint(writer, "PIPELINE\tJOB\tPROGRESS\tDATUMS/S\tDOWNLOADED\tUPLOADED\tWORKERS\tCPU\tMEMORY\t\n")
+	for _, jobInfo := range jobInfos {
+		datumRate, downloadRate, uploadRate := "-", "", ""
+		if last, ok := samples[jobInfo.Job.ID]; ok {
+			if seconds := now.Sub(last.at).Seconds(); seconds > 0 {
+				datumRate = fmt.Sprintf("%.1f", float64(jobInfo.DataProcessed-last.processed)/seconds)
+				downloadRate = " (" + byteRate(jobInfo.DownloadBytes-last.downloadBytes, seconds) + ")"
+				uploadRate = " (" + byteRate(jobInfo.UploadBytes-last.uploadBytes, seconds) + ")"
+			}
+		}
+		cpu, memory := "-", "-"
+		if cost := jobInfo.Cost; cost != nil && cost.Samples > 0 {
+			cpu = fmt.Sprintf("%.2f cores", cost.CurrentCpuCores)
+			memory = pkgpretty.Size(cost.CurrentMemoryBytes)
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%d / %d\t%s\t%s%s\t%s%s\t%s\t%s\t%s\t\n",
+			jobInfo.Pipeline.Name,
+			jobInfo.Job.ID,
+			jobInfo.DataProcessed,
+			jobInfo.DataTotal,
+			datumRate,
+			pkgpretty.Size(uint64(jobInfo.DownloadBytes)),
+			downloadRate,
+			pkgpretty.Size(uint64(jobInfo.UploadBytes)),
+			uploadRate,
+			workerUtilization(jobInfo),
+			cpu,
+			memory,
+		)
+	}
+	updateTopSamples(samples, jobInfos, now)
+	return writer.Flush()
+}
+
+// updateTopSamples replaces samples with the progress of jobInfos now. Jobs
+// that are no longer running are dropped.
+func updateTopSamples(samples map[string]*topSample, jobInfos []*ppsclient.JobInfo, now time.Time) {
+	for jobID := range samples {
+		delete(samples, jobID)
+	}
+	for _, jobInfo := range jobInfos {
+		samples[jobInfo.Job.ID] = &topSample{
+			at:            now,
+			processed:     jobInfo.DataProcessed,
+			downloadBytes: jobInfo.DownloadBytes,
+			uploadBytes:   jobInfo.UploadBytes,
+		}
+	}
+}
+
+// workerUtilization returns how many of a job's running worker pods are
+// processing one of its datums, e.g. "3/4".
+func workerUtilization(jobInfo *ppsclient.JobInfo) string {
+	busy := 0
+	for _, status := range jobInfo.WorkerStatus {
+		if len(status.Data) > 0 {
+			busy++
+		}
+	}
+	running := 0
+	for _, workerPod := range jobInfo.WorkerPods {
+		if workerPod.Phase == "Running" {
+			running++
+		}
+	}
+	return fmt.Sprintf("%d/%d", busy, running)
+}
+
+func byteRate(n int64, seconds float64) string {
+	if n < 0 {
+		n = 0
+	}
+	return pkgpretty.Size(uint64(float64(n)/seconds)) + "/s"
+}
@@ -11,52 +11,140 @@ import (
 	"text/template"
 
 	"github.com/fatih/color"
+	"github.com/gogo/protobuf/types"
 	ppsclient "github.com/pachyderm/pachyderm/src/client/pps"
 	"github.com/pachyderm/pachyderm/src/server/pkg/pretty"
 )
 
+var jobColumns = []pretty.Column{
+	{Name: "id", Value: func(row interface{}) string {
+		return row.(*ppsclient.JobInfo).Job.ID
+	}},
+	{Name: "pipeline", Value: func(row interface{}) string {
+		jobInfo := row.(*ppsclient.JobInfo)
+		if jobInfo.Pipeline == nil {
+			return "-"
+		}
+		return jobInfo.Pipeline.Name
+	}},
+	{Name: "output-commit", Value: func(row interface{}) string {
+		jobInfo := row.(*ppsclient.JobInfo)
+		if jobInfo.OutputCommit != nil {
+			return fmt.Sprintf("%s/%s", jobInfo.OutputCommit.Repo.Name, jobInfo.OutputCommit.ID)
+		} else if jobInfo.Pipeline != nil {
+			return fmt.Sprintf("%s/-", jobInfo.Pipeline.Name)
+		}
+		return "-"
+	}},
+	{Name: "started", Value: func(row interface{}) string {
+		return pretty.Ago(row.(*ppsclient.JobInfo).Started)
+	}},
+	{Name: "duration", Value: func(row interface{}) string {
+		jobInfo := row.(*ppsclient.JobInfo)
+		if jobInfo.Finished == nil {
+			return "-"
+		}
+		return pretty.Duration(jobInfo.Started, jobInfo.Finished)
+	}},
+	{Name: "restart", Value: func(row interface{}) string {
+		return fmt.Sprintf("%d", row.(*ppsclient.JobInfo).Restart)
+	}},
+	{Name: "progress", Value: func(row interface{}) string {
+		jobInfo := row.(*ppsclient.JobInfo)
+		return fmt.Sprintf("%d / %d", jobInfo.DataProcessed, jobInfo.DataTotal)
+	}},
+	{Name: "data-processed", Value: func(row interface{}) string {
+		return fmt.Sprintf("%d", row.(*ppsclient.JobInfo).DataProcessed)
+	}},
+	{Name: "data-skipped", Value: func(row interface{}) string {
+		return fmt.Sprintf("%d", row.(*ppsclient.JobInfo).DataSkipped)
+	}},
+	{Name: "data-total", Value: func(row interface{}) string {
+		return fmt.Sprintf("%d", row.(*ppsclient.JobInfo).DataTotal)
+	}},
+	{
+		Name: "state",
+		Value: func(row interface{}) string {
+			return stateName(row.(*ppsclient.JobInfo).State.String())
+		},
+		Color: func(row interface{}) color.Attribute {
+			return jobStateColor(row.(*ppsclient.JobInfo).State)
+		},
+	},
+}
+
+var defaultJobColumns = []string{"id", "output-commit", "started", "duration", "restart", "progress", "state"}
+
+// JobColumns returns the columns list-job prints, given the values of its
+// --columns and --output flags.
+func JobColumns(names string, output string) ([]pretty.Column, error) {
+	return pretty.SelectColumns(jobColumns, defaultJobColumns, names, output)
+}
+
 // PrintJobHeader prints a job header.
-func PrintJobHeader(w io.Writer) {
-	// because STATE is a colorful field it has to be at the end of the line,
-	// otherwise the terminal escape characters will trip up the tabwriter
-	fmt.Fprint(w, "ID\tOUTPUT COMMIT\tSTARTED\tDURATION\tRESTART\tPROGRESS\tSTATE\t\n")
+func PrintJobHeader(w io.Writer, columns []pretty.Column) {
+	pretty.PrintHeader(w, columns)
 }
 
 // PrintJobInfo pretty-prints job info.
-func PrintJobInfo(w io.Writer, jobInfo *ppsclient.JobInfo) {
-	fmt.Fprintf(w, "%s\t", jobInfo.Job.ID)
-	if jobInfo.OutputCommit != nil {
-		fmt.Fprintf(w, "%s/%s\t", jobInfo.OutputCommit.Repo.Name, jobInfo.OutputCommit.ID)
-	} else if jobInfo.Pipeline != nil {
-		fmt.Fprintf(w, "%s/-\t", jobInfo.Pipeline.Name)
-	} else {
-		fmt.Fprintf(w, "-\t")
-	}
-	fmt.Fprintf(w, "%s\t", pretty.Ago(jobInfo.Started))
-	if jobInfo.Finished != nil {
-		fmt.Fprintf(w, "%s\t", pretty.Duration(jobInfo.Started, jobInfo.Finished))
-	} else {
-		fmt.Fprintf(w, "-\t")
-	}
-	fmt.Fprintf(w, "%d\t", jobInfo.Restart)
-	fmt.Fprintf(w, "%d / %d\t", jobInfo.DataProcessed, jobInfo.DataTotal)
-	fmt.Fprintf(w, "%s\t\n", jobState(jobInfo.State))
+func PrintJobInfo(w io.Writer, jobInfo *ppsclient.JobInfo, columns []pretty.Column) {
+	pretty.PrintRow(w, columns, jobInfo)
+}
+
+var pipelineColumns = []pretty.Column{
+	{Name: "name", Value: func(row interface{}) string {
+		return row.(*ppsclient.PipelineInfo).Pipeline.Name
+	}},
+	{Name: "version", Value: func(row interface{}) string {
+		return fmt.Sprintf("%d", row.(*ppsclient.PipelineInfo).Version)
+	}},
+	{Name: "input", Value: func(row interface{}) string {
+		return shorthandInput(row.(*ppsclient.PipelineInfo).Input)
+	}},
+	{Name: "output", Value: func(row interface{}) string {
+		pipelineInfo := row.(*ppsclient.PipelineInfo)
+		return fmt.Sprintf("%s/%s", pipelineInfo.Pipeline.Name, pipelineInfo.OutputBranch)
+	}},
+	{Name: "created", Value: func(row interface{}) string {
+		return pretty.Ago(row.(*ppsclient.PipelineInfo).CreatedAt)
+	}},
+	{Name: "datum-concurrency", Value: func(row interface{}) string {
+		return fmt.Sprintf("%d", row.(*ppsclient.PipelineInfo).DatumConcurrency)
+	}},
+	{Name: "recent-error", Value: func(row interface{}) string {
+		pipelineInfo := row.(*ppsclient.PipelineInfo)
+		if pipelineInfo.RecentError == "" {
+			return "-"
+		}
+		return pipelineInfo.RecentError
+	}},
+	{
+		Name: "state",
+		Value: func(row interface{}) string {
+			return stateName(row.(*ppsclient.PipelineInfo).State.String())
+		},
+		Color: func(row interface{}) color.Attribute {
+			return pipelineStateColor(row.(*ppsclient.PipelineInfo).State)
+		},
+	},
+}
+
+var defaultPipelineColumns = []string{"name", "input", "output", "created", "state"}
+
+// PipelineColumns returns the columns list-pipeline prints, given the
+// values of its --columns and --output flags.
+func PipelineColumns(names string, output string) ([]pretty.Column, error) {
+	return pretty.SelectColumns(pipelineColumns, defaultPipelineColumns, names, output)
 }
 
 // PrintPipelineHeader prints a pipeline header.
-func PrintPipelineHeader(w io.Writer) {
-	// because STATE is a colorful field it has to be at the end of the line,
-	// otherwise the terminal escape characters will trip up the tabwriter
-	fmt.Fprint(w, "NAME\tINPUT\tOUTPUT\tCREATED\tSTATE\t\n")
+func PrintPipelineHeader(w io.Writer, columns []pretty.Column) {
+	pretty.PrintHeader(w, columns)
 }
 
 // PrintPipelineInfo pretty-prints pipeline info.
-func PrintPipelineInfo(w io.Writer, pipelineInfo *ppsclient.PipelineInfo) {
-	fmt.Fprintf(w, "%s\t", pipelineInfo.Pipeline.Name)
-	fmt.Fprintf(w, "%s\t", shorthandInput(pipelineInfo.Input))
-	fmt.Fprintf(w, "%s/%s\t", pipelineInfo.Pipeline.Name, pipelineInfo.OutputBranch)
-	fmt.Fprintf(w, "%s\t", pretty.Ago(pipelineInfo.CreatedAt))
-	fmt.Fprintf(w, "%s\t\n", pipelineState(pipelineInfo.State))
+func PrintPipelineInfo(w io.Writer, pipelineInfo *ppsclient.PipelineInfo, columns []pretty.Column) {
+	pretty.PrintRow(w, columns, pipelineInfo)
 }
 
 // PrintJobInputHeader pretty prints a job input header.
@@ -89,6 +177,40 @@ func PrintWorkerStatus(w io.Writer, workerStatus *ppsclient.WorkerStatus) {
 	fmt.Fprintf(w, "%s\t\n", pretty.Ago(workerStatus.Started))
 }
 
+// PrintWorkerPodHeader pretty prints a worker pod header.
+func PrintWorkerPodHeader(w io.Writer) {
+	fmt.Fprint(w, "POD\tNODE\tZONE\tPHASE\tRESTARTS\tREASON\t\n")
+}
+
+// PrintWorkerPod pretty prints a worker pod.
+func PrintWorkerPod(w io.Writer, workerPod *ppsclient.WorkerPod) {
+	fmt.Fprintf(w, "%s\t", workerPod.Name)
+	fmt.Fprintf(w, "%s\t", workerPod.Node)
+	fmt.Fprintf(w, "%s\t", workerPod.Zone)
+	fmt.Fprintf(w, "%s\t", workerPod.Phase)
+	fmt.Fprintf(w, "%d\t", workerPod.Restarts)
+	if workerPod.Message != "" {
+		fmt.Fprintf(w, "%s: %s\t\n", workerPod.Reason, workerPod.Message)
+	} else {
+		fmt.Fprintf(w, "%s\t\n", workerPod.Reason)
+	}
+}
+
+// PrintPodEventHeader pretty prints a pod event header.
+func PrintPodEventHeader(w io.Writer) {
+	fmt.Fprint(w, "LAST SEEN\tCOUNT\tPOD\tTYPE\tREASON\tMESSAGE\t\n")
+}
+
+// PrintPodEvent pretty prints a pod event.
+func PrintPodEvent(w io.Writer, podEvent *ppsclient.PodEvent) {
+	fmt.Fprintf(w, "%s\t", pretty.Ago(podEvent.LastSeen))
+	fmt.Fprintf(w, "%d\t", podEvent.Count)
+	fmt.Fprintf(w, "%s\t", podEvent.Pod)
+	fmt.Fprintf(w, "%s\t", podEvent.Type)
+	fmt.Fprintf(w, "%s\t", podEvent.Reason)
+	fmt.Fprintf(w, "%s\t\n", podEvent.Message)
+}
+
 // PrintPipelineInputHeader prints a pipeline input header.
 func PrintPipelineInputHeader(w io.Writer) {
 	fmt.Fprint(w, "NAME\tREPO\tBRANCH\tGLOB\tLAZY\t\n")
@@ -119,10 +241,17 @@ Pipeline: {{.Pipeline.Name}} {{end}} {{if .ParentJob}}
 Parent: {{.ParentJob.ID}} {{end}}
 Started: {{prettyAgo .Started}} {{if .Finished}}
 Duration: {{prettyDuration .Started .Finished}} {{end}}
-State: {{jobState .State}}
-Progress: {{.DataProcessed}} / {{.DataTotal}}
+State: {{jobState .State}}{{if .Reason}}
+Reason: {{.Reason}}{{end}}
+Progress: {{.DataProcessed}} / {{.DataTotal}}{{if .DataSkipped}} ({{.DataSkipped}} skipped){{end}}{{if .SetupTime}}
+Setup Time: {{setupTime .SetupTime}}{{end}}{{if .DownloadBytes}}
+Downloaded: {{prettySize .DownloadBytes}}{{end}}{{if .UploadBytes}}
+Uploaded: {{prettySize .UploadBytes}}{{end}}
 Worker Status:
-{{workerStatus .}}Restarts: {{.Restart}}
+{{workerStatus .}}{{if .WorkerPods}}Worker Pods:
+{{workerPods .}}{{end}}{{if .PodEvents}}Events:
+{{podEvents .}}{{end}}{{if .Cost}}Cost:
+{{jobCost .Cost}}{{end}}Restarts: {{.Restart}}
 ParallelismSpec: {{.ParallelismSpec}}
 {{ if .ResourceSpec }}ResourceSpec:
 	CPU: {{ .ResourceSpec.Cpu }}
@@ -133,7 +262,8 @@ ParallelismSpec: {{.ParallelismSpec}}
 {{jobInput .}}
 Transform:
 {{prettyTransform .Transform}} {{if .OutputCommit}}
-Output Commit: {{.OutputCommit.ID}} {{end}} {{ if .Egress }}
+Output Commit: {{.OutputCommit.ID}} {{end}} {{range .SecondaryOutputCommits}}
+Secondary Output Commit: {{.Repo.Name}}/{{.ID}} {{end}} {{ if .Egress }}
 Egress: {{.Egress.URL}} {{end}}
 `)
 	if err != nil {
@@ -160,7 +290,10 @@ Parallelism Spec: {{.ParallelismSpec}}
 Input:
 {{pipelineInput .}}
 Output Branch: {{.OutputBranch}}
-Transform:
+{{if .Transform.ImageDigest}}Image Digest: {{.Transform.ImageDigest}}
+{{end}}{{range .SecondaryOutputs}}Secondary Output: {{.Name}} -> {{.Repo}}/{{.Branch}}
+{{end}}{{if .Logs}}Logs Branch: {{.Logs.Branch}}
+{{end}}Transform:
 {{prettyTransform .Transform}}
 {{ if .Egress }}Egress: {{.Egress.URL}} {{end}}
 {{if .RecentError}} Recent Error: {{.RecentError}} {{end}}
@@ -189,10 +322,38 @@ func jobState(jobState ppsclient.JobState) string {
 		return color.New(color.FgGreen).SprintFunc()("success")
 	case ppsclient.JobState_JOB_STOPPED:
 		return color.New(color.FgYellow).SprintFunc()("stopped")
+	case ppsclient.JobState_JOB_WAITING_FOR_RESOURCES:
+		return color.New(color.FgYellow).SprintFunc()("waiting for resources")
 	}
 	return "-"
 }
 
+// stateName returns the name of a job or pipeline state, without its
+// prefix, e.g. "running" for JOB_RUNNING.
+func stateName(state string) string {
+	return strings.ToLower(state[strings.Index(state, "_")+1:])
+}
+
+func jobStateColor(jobState ppsclient.JobState) color.Attribute {
+	switch jobState {
+	case ppsclient.JobState_JOB_FAILURE:
+		return color.FgRed
+	case ppsclient.JobState_JOB_SUCCESS:
+		return color.FgGreen
+	}
+	return color.FgYellow
+}
+
+func pipelineStateColor(pipelineState ppsclient.PipelineState) color.Attribute {
+	switch pipelineState {
+	case ppsclient.PipelineState_PIPELINE_RUNNING:
+		return color.FgGreen
+	case ppsclient.PipelineState_PIPELINE_FAILURE:
+		return color.FgRed
+	}
+	return color.FgYellow
+}
+
 func pipelineState(pipelineState ppsclient.PipelineState) string {
 	switch pipelineState {
 	case ppsclient.PipelineState_PIPELINE_STARTING:
@@ -232,6 +393,43 @@ func workerStatus(jobInfo *ppsclient.JobInfo) string {
 	return buffer.String()
 }
 
+func workerPods(jobInfo *ppsclient.JobInfo) string {
+	var buffer bytes.Buffer
+	writer := tabwriter.NewWriter(&buffer, 20, 1, 3, ' ', 0)
+	PrintWorkerPodHeader(writer)
+	for _, workerPod := range jobInfo.WorkerPods {
+		PrintWorkerPod(writer, workerPod)
+	}
+	// can't error because buffer can't error on Write
+	writer.Flush()
+	return buffer.String()
+}
+
+func jobCost(cost *ppsclient.JobCost) string {
+	var buffer bytes.Buffer
+	if len(cost.NodeTypes) > 0 {
+		fmt.Fprintf(&buffer, "\tNode Types: %s\n", strings.Join(cost.NodeTypes, ", "))
+	}
+	if cost.Samples > 0 {
+		fmt.Fprintf(&buffer, "\tCPU: %.3f core-hours\n", cost.CpuSeconds/3600)
+		fmt.Fprintf(&buffer, "\tMemory: %.3f GB-hours\n", cost.MemoryByteSeconds/3600/1e9)
+		fmt.Fprintf(&buffer, "\tPeak Memory: %s\n", pretty.Size(cost.PeakMemoryBytes))
+	}
+	return buffer.String()
+}
+
+func podEvents(jobInfo *ppsclient.JobInfo) string {
+	var buffer bytes.Buffer
+	writer := tabwriter.NewWriter(&buffer, 20, 1, 3, ' ', 0)
+	PrintPodEventHeader(writer)
+	for _, podEvent := range jobInfo.PodEvents {
+		PrintPodEvent(writer, podEvent)
+	}
+	// can't error because buffer can't error on Write
+	writer.Flush()
+	return buffer.String()
+}
+
 func pipelineInput(pipelineInfo *ppsclient.PipelineInfo) string {
 	if pipelineInfo.Input == nil {
 		return ""
@@ -283,10 +481,27 @@ var funcMap = template.FuncMap{
 	"pipelineState":   pipelineState,
 	"jobState":        jobState,
 	"workerStatus":    workerStatus,
+	"workerPods":      workerPods,
+	"podEvents":       podEvents,
+	"jobCost":         jobCost,
 	"pipelineInput":   pipelineInput,
 	"jobInput":        jobInput,
 	"prettyAgo":       pretty.Ago,
 	"prettyDuration":  pretty.Duration,
 	"jobCounts":       jobCounts,
 	"prettyTransform": prettyTransform,
+	"setupTime":       setupTime,
+	"prettySize":      prettySize,
+}
+
+func prettySize(size int64) string {
+	return pretty.Size(uint64(size))
+}
+
+func setupTime(setupTime *types.Duration) string {
+	duration, err := types.DurationFromProto(setupTime)
+	if err != nil {
+		return setupTime.String()
+	}
+	return duration.String()
 }
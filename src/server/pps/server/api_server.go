@@ -5,6 +5,9 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"net/url"
+	"path"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -17,7 +20,9 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
 	"github.com/pachyderm/pachyderm/src/client/pkg/uuid"
 	"github.com/pachyderm/pachyderm/src/client/pps"
+	pfsserver "github.com/pachyderm/pachyderm/src/server/pfs"
 	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/deploy/assets"
 	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
 	"github.com/pachyderm/pachyderm/src/server/pkg/metrics"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsdb"
@@ -26,7 +31,10 @@ import (
 	ppsserver "github.com/pachyderm/pachyderm/src/server/pps"
 
 	etcd "github.com/coreos/etcd/clientv3"
+	"github.com/docker/distribution/digest"
+	units "github.com/docker/go-units"
 	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
 	"go.pedge.io/lion/proto"
 	"go.pedge.io/proto/rpclog"
@@ -39,7 +47,9 @@ import (
 	"k8s.io/kubernetes/pkg/api/resource"
 	"k8s.io/kubernetes/pkg/api/unversioned"
 	kube "k8s.io/kubernetes/pkg/client/unversioned"
+	kube_fields "k8s.io/kubernetes/pkg/fields"
 	kube_labels "k8s.io/kubernetes/pkg/labels"
+	"k8s.io/kubernetes/pkg/util/validation"
 )
 
 const (
@@ -103,10 +113,26 @@ type apiServer struct {
 	storageRoot           string
 	storageBackend        string
 	storageHostPath       string
-	reporter              *metrics.Reporter
+	// pfsMetadataInPostgres is true if PFS keeps its metadata in Postgres,
+	// in which case workers' sidecars need to as well.
+	pfsMetadataInPostgres bool
+	// preferredZone is the zone that workers prefer to be scheduled in,
+	// unless their pipeline prefers another. If empty, workers are
+	// scheduled in any zone.
+	preferredZone string
+	reporter      *metrics.Reporter
 	// collections
 	pipelines col.Collection
-	jobs      col.Collection
+	// pipelineVersions has every version of every pipeline, so that old
+	// versions can be inspected and diffed.
+	pipelineVersions col.Collection
+	jobs             col.Collection
+	// shutdown is closed when pachd is shutting down, which stops the
+	// master, and masterDone once it's stopped. They're nil for sidecars,
+	// which don't run the master.
+	shutdown     chan struct{}
+	shutdownOnce sync.Once
+	masterDone   chan struct{}
 }
 
 func (a *apiServer) validateInput(ctx context.Context, input *pps.Input, job bool) error {
@@ -137,6 +163,18 @@ func (a *apiServer) validateInput(ctx context.Context, input *pps.Input, job boo
 			case len(input.Atom.Glob) == 0:
 				result = fmt.Errorf("input must specify a glob")
 				return
+			case input.Atom.Lazy && input.Atom.Mount:
+				result = fmt.Errorf("input %s can't be both lazy and mounted", input.Atom.Name)
+				return
+			case input.Atom.GroupBy != "" && input.Atom.Mount:
+				result = fmt.Errorf("input %s can't be both grouped and mounted", input.Atom.Name)
+				return
+			}
+			if input.Atom.GroupBy != "" {
+				if _, err := regexp.Compile(input.Atom.GroupBy); err != nil {
+					result = fmt.Errorf("invalid groupBy for input %s: %v", input.Atom.Name, err)
+					return
+				}
 			}
 			if _, ok := names[input.Atom.Name]; ok {
 				result = fmt.Errorf("conflicting input names: %s", input.Atom.Name)
@@ -199,6 +237,11 @@ func validateTransform(transform *pps.Transform) error {
 	if len(transform.Cmd) == 0 {
 		return fmt.Errorf("no cmd set")
 	}
+	if transform.ImageDigest != "" {
+		if _, err := digest.ParseDigest(transform.ImageDigest); err != nil {
+			return fmt.Errorf("invalid image digest %s: %v", transform.ImageDigest, err)
+		}
+	}
 	return nil
 }
 
@@ -290,6 +333,7 @@ func (a *apiServer) CreateJob(ctx context.Context, request *pps.CreateJobRequest
 			jobInfo.Egress = pipelineInfo.Egress
 			jobInfo.ResourceSpec = pipelineInfo.ResourceSpec
 			jobInfo.Incremental = pipelineInfo.Incremental
+			jobInfo.Webhooks = pipelineInfo.Webhooks
 		} else {
 			if jobInfo.OutputRepo == nil {
 				jobInfo.OutputRepo = &pfs.Repo{job.ID}
@@ -349,12 +393,29 @@ func (a *apiServer) InspectJob(ctx context.Context, request *pps.InspectJobReque
 	if jobInfo.Input == nil {
 		jobInfo.Input = translateJobInputs(jobInfo.Inputs)
 	}
+	if jobInfo.Pipeline == nil || jobStateToStopped(jobInfo.State) {
+		return jobInfo, nil
+	}
+	// If the job is still running we fill in the state of its worker pods,
+	// so that users can see why a job is stuck or failing without going to
+	// kubernetes themselves. Once it's stopped the pods may be running later
+	// jobs, so they say nothing about it.
+	workerPoolID := pps.PipelineRcName(jobInfo.Pipeline.Name, jobInfo.PipelineVersion)
+	if err := a.fillWorkerPods(workerPoolID, jobInfo); err != nil {
+		protolion.Errorf("failed to get worker pods with err: %s", err.Error())
+	}
+	if jobInfo.State == pps.JobState_JOB_STARTING {
+		for _, workerPod := range jobInfo.WorkerPods {
+			if workerPod.Reason == podReasonUnschedulable {
+				jobInfo.State = pps.JobState_JOB_WAITING_FOR_RESOURCES
+			}
+		}
+	}
 	// If the job is running we fill in WorkerStatus field, otherwise we just
 	// return the jobInfo.
 	if jobInfo.State != pps.JobState_JOB_RUNNING {
 		return jobInfo, nil
 	}
-	workerPoolID := pps.PipelineRcName(jobInfo.Pipeline.Name, jobInfo.PipelineVersion)
 	workerStatus, err := status(ctx, workerPoolID, a.etcdClient, a.etcdPrefix)
 	if err != nil {
 		protolion.Errorf("failed to get worker status with err: %s", err.Error())
@@ -403,10 +464,234 @@ func (a *apiServer) ListJob(ctx context.Context, request *pps.ListJobRequest) (r
 		}
 		jobInfos = append(jobInfos, &jobInfo)
 	}
+	a.setWaitingForResources(jobInfos...)
 
 	return &pps.JobInfos{jobInfos}, nil
 }
 
+func (a *apiServer) FlushJob(request *pps.FlushJobRequest, resp pps.API_FlushJobServer) (retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
+	ctx := resp.Context()
+
+	pipelineInfos, err := a.ListPipeline(ctx, &pps.ListPipelineRequest{})
+	if err != nil {
+		return err
+	}
+	inputs := make(map[string][]string)
+	for _, pipelineInfo := range pipelineInfos.PipelineInfo {
+		inputs[pipelineInfo.Pipeline.Name] = pps.InputRepos(pipelineInfo.Input)
+	}
+	// commits are the commits whose jobs are waited for, the output commits
+	// of the jobs that succeed are added to them.
+	commits := make(map[string]bool)
+	// committed are the repos of commits.
+	committed := make(map[string]bool)
+	downstream := make(map[string]bool)
+	for _, commit := range request.Commits {
+		commits[commitKey(commit.Repo.Name, commit.ID)] = true
+		committed[commit.Repo.Name] = true
+		downstream[commit.Repo.Name] = true
+	}
+	// Find the pipelines downstream of the commits' repos
+	for changed := true; changed; {
+		changed = false
+		for pipeline, repos := range inputs {
+			if downstream[pipeline] {
+				continue
+			}
+			for _, repo := range repos {
+				if downstream[repo] {
+					downstream[pipeline] = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+	for _, commit := range request.Commits {
+		delete(downstream, commit.Repo.Name)
+	}
+	// If to_pipelines is set, only the pipelines upstream of them are waited
+	// for.
+	if len(request.ToPipelines) > 0 {
+		upstream := make(map[string]bool)
+		var visit func(pipeline string)
+		visit = func(pipeline string) {
+			if upstream[pipeline] {
+				return
+			}
+			upstream[pipeline] = true
+			for _, repo := range inputs[pipeline] {
+				visit(repo)
+			}
+		}
+		for _, pipeline := range request.ToPipelines {
+			visit(pipeline.Name)
+		}
+		for pipeline := range downstream {
+			if !upstream[pipeline] {
+				delete(downstream, pipeline)
+			}
+		}
+	}
+	// Wait for the pipelines' jobs in topological order, so that the jobs
+	// upstream of each pipeline have finished before its job is looked for.
+	for len(downstream) > 0 {
+		var ready []string
+		for pipeline := range downstream {
+			isReady := true
+			for _, repo := range inputs[pipeline] {
+				if downstream[repo] {
+					isReady = false
+				}
+			}
+			if isReady {
+				ready = append(ready, pipeline)
+			}
+		}
+		sort.Strings(ready)
+		for _, pipeline := range ready {
+			delete(downstream, pipeline)
+			triggered := false
+			for _, repo := range inputs[pipeline] {
+				if committed[repo] {
+					triggered = true
+				}
+			}
+			if !triggered {
+				// None of its inputs have commits being waited for, which
+				// happens when the jobs upstream of it didn't succeed.
+				continue
+			}
+			jobInfo, err := a.waitTriggeredJob(ctx, pipeline, commits)
+			if err != nil {
+				return err
+			}
+			if jobInfo.State == pps.JobState_JOB_SUCCESS && jobInfo.OutputCommit != nil {
+				commits[commitKey(jobInfo.OutputCommit.Repo.Name, jobInfo.OutputCommit.ID)] = true
+				committed[jobInfo.OutputCommit.Repo.Name] = true
+			}
+			if err := resp.Send(jobInfo); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (a *apiServer) SubscribeJob(request *pps.SubscribeJobRequest, resp pps.API_SubscribeJobServer) (retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
+	if request.Pipeline == nil {
+		return fmt.Errorf("must specify a pipeline")
+	}
+	jobs := a.jobs.ReadOnly(resp.Context())
+	// The watch starts with the jobs as they are now, existing holds them
+	// so that they can be skipped if they weren't asked for.
+	existing := make(map[string]*pps.JobInfo)
+	if !request.IncludeExisting {
+		iter, err := jobs.GetByIndex(ppsdb.JobsPipelineIndex, request.Pipeline)
+		if err != nil {
+			return err
+		}
+		for {
+			var jobID string
+			jobInfo := new(pps.JobInfo)
+			ok, err := iter.Next(&jobID, jobInfo)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				break
+			}
+			existing[jobID] = jobInfo
+		}
+	}
+	watcher, err := jobs.Watch()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+	for {
+		ev, ok := <-watcher.Watch()
+		if !ok {
+			return fmt.Errorf("the stream for job updates closed unexpectedly")
+		}
+		switch ev.Type {
+		case watch.EventError:
+			return ev.Err
+		case watch.EventPut:
+			var jobID string
+			jobInfo := new(pps.JobInfo)
+			if err := ev.Unmarshal(&jobID, jobInfo); err != nil {
+				return err
+			}
+			if jobInfo.Pipeline == nil || jobInfo.Pipeline.Name != request.Pipeline.Name {
+				continue
+			}
+			if existingInfo, ok := existing[jobID]; ok {
+				delete(existing, jobID)
+				if proto.Equal(existingInfo, jobInfo) {
+					continue
+				}
+			}
+			if jobInfo.Input == nil {
+				jobInfo.Input = translateJobInputs(jobInfo.Inputs)
+			}
+			a.setWaitingForResources(jobInfo)
+			if err := resp.Send(jobInfo); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// waitTriggeredJob waits for the job in pipeline whose input includes one of
+// commits to finish, and returns it.
+func (a *apiServer) waitTriggeredJob(ctx context.Context, pipeline string, commits map[string]bool) (*pps.JobInfo, error) {
+	watcher, err := a.jobs.ReadOnly(ctx).WatchByIndex(ppsdb.JobsPipelineIndex, client.NewPipeline(pipeline))
+	if err != nil {
+		return nil, err
+	}
+	defer watcher.Close()
+	for {
+		ev, ok := <-watcher.Watch()
+		if !ok {
+			return nil, fmt.Errorf("the stream for job updates closed unexpectedly")
+		}
+		switch ev.Type {
+		case watch.EventError:
+			return nil, ev.Err
+		case watch.EventPut:
+			var jobID string
+			var jobInfo pps.JobInfo
+			if err := ev.Unmarshal(&jobID, &jobInfo); err != nil {
+				return nil, err
+			}
+			if jobInfo.Input == nil {
+				jobInfo.Input = translateJobInputs(jobInfo.Inputs)
+			}
+			triggered := false
+			pps.VisitInput(jobInfo.Input, func(input *pps.Input) {
+				if input.Atom != nil && commits[commitKey(input.Atom.Repo, input.Atom.Commit)] {
+					triggered = true
+				}
+			})
+			if triggered {
+				return a.InspectJob(ctx, &pps.InspectJobRequest{
+					Job:        jobInfo.Job,
+					BlockState: true,
+				})
+			}
+		}
+	}
+}
+
+func commitKey(repo string, commitID string) string {
+	return repo + "@" + commitID
+}
+
 func (a *apiServer) DeleteJob(ctx context.Context, request *pps.DeleteJobRequest) (response *types.Empty, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
@@ -479,6 +764,10 @@ func (a *apiServer) GetLogs(request *pps.GetLogsRequest, apiGetLogsServer pps.AP
 	// Get list of pods containing logs we're interested in (based on pipeline and
 	// job filters)
 	var rcName string
+	// If the pipeline persists its logs, they're read from its logs branch
+	// when its pods are gone, or when the job has finished.
+	var persistedLogs *pps.PipelineInfo
+	var persistedJobIDs []string
 	if request.Pipeline != nil {
 		// If the user provides a pipeline, get logs from the pipeline RC directly
 		var err error
@@ -486,6 +775,10 @@ func (a *apiServer) GetLogs(request *pps.GetLogsRequest, apiGetLogsServer pps.AP
 		if err != nil {
 			return err
 		}
+		pipelineInfo := new(pps.PipelineInfo)
+		if err := a.pipelines.ReadOnly(ctx).Get(request.Pipeline.Name, pipelineInfo); err == nil && pipelineInfo.Logs != nil {
+			persistedLogs = pipelineInfo
+		}
 	} else if request.Job != nil {
 		var jobInfo pps.JobInfo
 		err := a.jobs.ReadOnly(ctx).Get(request.Job.ID, &jobInfo)
@@ -497,16 +790,38 @@ func (a *apiServer) GetLogs(request *pps.GetLogsRequest, apiGetLogsServer pps.AP
 		if err != nil {
 			return err
 		}
+		pipelineInfo := new(pps.PipelineInfo)
+		if err := a.pipelines.ReadOnly(ctx).Get(jobInfo.Pipeline.Name, pipelineInfo); err == nil && pipelineInfo.Logs != nil {
+			persistedLogs = pipelineInfo
+			persistedJobIDs = []string{jobInfo.Job.ID}
+			if jobInfo.Finished != nil {
+				found, err := a.sendPersistedLogs(ctx, request, persistedLogs, persistedJobIDs, apiGetLogsServer)
+				if err != nil || found {
+					return err
+				}
+			}
+		}
 	} else {
 		return fmt.Errorf("must specify either pipeline or job")
 	}
 	pods, err := a.rcPods(rcName)
+	if err != nil || len(pods) == 0 {
+		if persistedLogs != nil {
+			found, err := a.sendPersistedLogs(ctx, request, persistedLogs, persistedJobIDs, apiGetLogsServer)
+			if err != nil || found {
+				return err
+			}
+		}
+	}
 	if err != nil {
 		return fmt.Errorf("could not get pods in rc %s containing logs", rcName)
 	}
 	if len(pods) == 0 {
 		return fmt.Errorf("no pods belonging to the rc \"%s\" were found", rcName)
 	}
+	if request.Follow {
+		return a.followLogs(request, pods, apiGetLogsServer)
+	}
 
 	// Spawn one goroutine per pod. Each goro writes its pod's logs to a channel
 	// and channels are read into the output server in a stable order.
@@ -550,15 +865,8 @@ func (a *apiServer) GetLogs(request *pps.GetLogsRequest, apiGetLogsServer pps.AP
 					continue
 				}
 
-				// Filter out log lines that don't match on pipeline or job
-				if request.Pipeline != nil && request.Pipeline.Name != msg.PipelineName {
-					continue
-				}
-				if request.Job != nil && request.Job.ID != msg.JobID {
-					continue
-				}
-
-				if !workerpkg.MatchDatum(request.DataFilters, msg.Data) {
+				// Filter out log lines that don't match on pipeline, job or data
+				if !matchLogMessage(request, msg) {
 					continue
 				}
 
@@ -591,6 +899,15 @@ nextLogCh:
 }
 
 func (a *apiServer) validatePipeline(ctx context.Context, pipelineInfo *pps.PipelineInfo) error {
+	if project, _ := pfs.SplitProject(pipelineInfo.Pipeline.Name); project != "" {
+		pfsClient, err := a.getPFSClient()
+		if err != nil {
+			return err
+		}
+		if _, err := pfsClient.InspectProject(ctx, &pfs.InspectProjectRequest{Project: &pfs.Project{Name: project}}); err != nil {
+			return fmt.Errorf("error inspecting project %s of the pipeline: %v", project, err)
+		}
+	}
 	if err := a.validateInput(ctx, pipelineInfo.Input, false); err != nil {
 		return err
 	}
@@ -600,6 +917,268 @@ func (a *apiServer) validatePipeline(ctx context.Context, pipelineInfo *pps.Pipe
 	if pipelineInfo.OutputBranch == "" {
 		return fmt.Errorf("pipeline needs to specify an output branch")
 	}
+	if pipelineInfo.Incremental {
+		var mounted bool
+		pps.VisitInput(pipelineInfo.Input, func(input *pps.Input) {
+			if input.Atom != nil && input.Atom.Mount {
+				mounted = true
+			}
+		})
+		if mounted {
+			// Incremental jobs only download what's new in a datum, mounts
+			// show all of it.
+			return fmt.Errorf("incremental pipelines can't have mounted inputs")
+		}
+	}
+	for _, webhook := range pipelineInfo.Webhooks {
+		u, err := url.Parse(webhook.URL)
+		if err != nil {
+			return fmt.Errorf("invalid webhook URL %q: %v", webhook.URL, err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return fmt.Errorf("invalid webhook URL %q: must be http or https", webhook.URL)
+		}
+	}
+	if _, err := units.RAMInBytes(pipelineInfo.CacheSize); err != nil {
+		return fmt.Errorf("invalid cache size %q: %v", pipelineInfo.CacheSize, err)
+	}
+	if pipelineInfo.DiskCacheSize != "" {
+		if _, err := units.RAMInBytes(pipelineInfo.DiskCacheSize); err != nil {
+			return fmt.Errorf("invalid disk cache size %q: %v", pipelineInfo.DiskCacheSize, err)
+		}
+	}
+	if pipelineInfo.DatumConcurrency < 1 {
+		return fmt.Errorf("datum concurrency must be at least 1")
+	}
+	if pipelineInfo.DatumConcurrency > 1 && pipelineInfo.S3Gateway {
+		// The S3 gateway serves the files under /pfs, which are only where
+		// it expects them when datums are processed one at a time.
+		return fmt.Errorf("pipelines with an S3 gateway can't process datums concurrently")
+	}
+	for key, value := range pipelineInfo.PodLabels {
+		if _, ok := labels("")[key]; ok {
+			return fmt.Errorf("pod label %q is reserved", key)
+		}
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return fmt.Errorf("invalid pod label key %q: %s", key, strings.Join(errs, "; "))
+		}
+		if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+			return fmt.Errorf("invalid pod label value %q: %s", value, strings.Join(errs, "; "))
+		}
+	}
+	if err := validateSecondaryOutputs(pipelineInfo); err != nil {
+		return err
+	}
+	if err := validateLogs(pipelineInfo); err != nil {
+		return err
+	}
+	if err := validateScheduling(pipelineInfo.Scheduling); err != nil {
+		return err
+	}
+	if err := validateSidecars(pipelineInfo.Sidecars); err != nil {
+		return err
+	}
+	for key := range pipelineInfo.PodAnnotations {
+		if errs := validation.IsQualifiedName(strings.ToLower(key)); len(errs) > 0 {
+			return fmt.Errorf("invalid pod annotation key %q: %s", key, strings.Join(errs, "; "))
+		}
+	}
+	return nil
+}
+
+// validateSidecars checks that each of a pipeline's sidecars has a name that
+// Kubernetes accepts and that doesn't collide with another container in the
+// workers' pods, and an image.
+func validateSidecars(sidecars []*pps.Sidecar) error {
+	names := map[string]bool{
+		"init":                               true,
+		client.PPSWorkerUserContainerName:    true,
+		client.PPSWorkerSidecarContainerName: true,
+	}
+	for _, sidecar := range sidecars {
+		if errs := validation.IsDNS1123Label(sidecar.Name); len(errs) > 0 {
+			return fmt.Errorf("invalid sidecar name %q: %s", sidecar.Name, strings.Join(errs, "; "))
+		}
+		if names[sidecar.Name] {
+			return fmt.Errorf("sidecar name %q is reserved or already used", sidecar.Name)
+		}
+		names[sidecar.Name] = true
+		if sidecar.Image == "" {
+			return fmt.Errorf("sidecar %q needs an image", sidecar.Name)
+		}
+		if _, err := resourceSpecRequests(sidecar.ResourceSpec); err != nil {
+			return fmt.Errorf("invalid resources for sidecar %q: %v", sidecar.Name, err)
+		}
+	}
+	return nil
+}
+
+// validateServiceAccount checks that the service account that a pipeline's
+// workers run as exists, since otherwise Kubernetes accepts the workers'
+// replication controller but never creates their pods. The default one is
+// created along with the workers.
+func (a *apiServer) validateServiceAccount(name string) error {
+	if name == assets.WorkerServiceAccountName {
+		return nil
+	}
+	if _, err := a.kubeClient.ServiceAccounts(a.namespace).Get(name); err != nil {
+		if isNotFoundErr(err) {
+			return fmt.Errorf("service account %q doesn't exist in namespace %q", name, a.namespace)
+		}
+		return err
+	}
+	return nil
+}
+
+// runtimeClassAPIs are the API versions that RuntimeClasses are looked up
+// in, newest first.
+var runtimeClassAPIs = []string{"/apis/node.k8s.io/v1", "/apis/node.k8s.io/v1beta1"}
+
+// validateRuntimeClass checks that the RuntimeClass that a pipeline's
+// workers run with, if any, exists. This also checks that the cluster
+// supports RuntimeClasses at all, since older API servers silently drop the
+// field from pod specs, which would run the workers unsandboxed.
+func (a *apiServer) validateRuntimeClass(name string) error {
+	if name == "" {
+		return nil
+	}
+	if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+		return fmt.Errorf("invalid runtime class %q: %s", name, strings.Join(errs, "; "))
+	}
+	for _, prefix := range runtimeClassAPIs {
+		_, err := a.kubeClient.Get().AbsPath(prefix, "runtimeclasses", name).DoRaw()
+		if err == nil {
+			return nil
+		}
+		if !isNotFoundErr(err) {
+			return err
+		}
+	}
+	return fmt.Errorf("runtime class %q doesn't exist, or the cluster doesn't support runtime classes", name)
+}
+
+// validateSecondaryOutputs checks that each of a pipeline's secondary
+// outputs has its own directory and its own branch to be committed to.
+func validateSecondaryOutputs(pipelineInfo *pps.PipelineInfo) error {
+	names := map[string]bool{path.Base(client.PPSOutputPath): true}
+	pps.VisitInput(pipelineInfo.Input, func(input *pps.Input) {
+		if input.Atom != nil {
+			names[input.Atom.Name] = true
+		}
+	})
+	branches := map[string]bool{
+		pipelineInfo.Pipeline.Name + "/" + pipelineInfo.OutputBranch: true,
+	}
+	for _, output := range pipelineInfo.SecondaryOutputs {
+		if output.Name == "" {
+			return fmt.Errorf("secondary outputs need a name")
+		}
+		if strings.ContainsAny(output.Name, "/.") {
+			return fmt.Errorf("invalid secondary output name %q: can't contain \"/\" or \".\"", output.Name)
+		}
+		if names[output.Name] {
+			return fmt.Errorf("secondary output name %q is already used by the output or an input", output.Name)
+		}
+		names[output.Name] = true
+		branch := output.Repo + "/" + output.Branch
+		if branches[branch] {
+			return fmt.Errorf("secondary output %q is committed to %s, which another output is also committed to", output.Name, branch)
+		}
+		branches[branch] = true
+	}
+	return nil
+}
+
+// validateLogs checks that a pipeline's logs are committed to a branch of
+// their own, and that their limits parse.
+func validateLogs(pipelineInfo *pps.PipelineInfo) error {
+	logs := pipelineInfo.Logs
+	if logs == nil {
+		return nil
+	}
+	if logs.Branch == pipelineInfo.OutputBranch {
+		return fmt.Errorf("logs can't be committed to the output branch %q", logs.Branch)
+	}
+	for _, output := range pipelineInfo.SecondaryOutputs {
+		if output.Repo == pipelineInfo.Pipeline.Name && output.Branch == logs.Branch {
+			return fmt.Errorf("logs can't be committed to branch %q, which secondary output %q is committed to", logs.Branch, output.Name)
+		}
+	}
+	if _, err := units.RAMInBytes(logs.MaxBytesPerDatum); err != nil {
+		return fmt.Errorf("invalid max bytes per datum %q for logs: %v", logs.MaxBytesPerDatum, err)
+	}
+	if logs.Retention != nil {
+		retention, err := types.DurationFromProto(logs.Retention)
+		if err != nil {
+			return fmt.Errorf("invalid logs retention: %v", err)
+		}
+		if retention < 0 {
+			return fmt.Errorf("logs retention can't be negative")
+		}
+	}
+	return nil
+}
+
+// validateScheduling checks that a pipeline's node selector and tolerations
+// are ones that Kubernetes accepts.
+func validateScheduling(scheduling *pps.SchedulingSpec) error {
+	if scheduling == nil {
+		return nil
+	}
+	if scheduling.PreferredZone != "" {
+		if errs := validation.IsValidLabelValue(scheduling.PreferredZone); len(errs) > 0 {
+			return fmt.Errorf("invalid preferred zone %q: %s", scheduling.PreferredZone, strings.Join(errs, "; "))
+		}
+	}
+	for key, value := range scheduling.NodeSelector {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return fmt.Errorf("invalid node selector key %q: %s", key, strings.Join(errs, "; "))
+		}
+		if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+			return fmt.Errorf("invalid node selector value %q: %s", value, strings.Join(errs, "; "))
+		}
+	}
+	for _, toleration := range scheduling.Tolerations {
+		switch api.TolerationOperator(toleration.Operator) {
+		case "", api.TolerationOpEqual:
+		case api.TolerationOpExists:
+			if toleration.Value != "" {
+				return fmt.Errorf("toleration for %q can't have a value with operator %q", toleration.Key, toleration.Operator)
+			}
+		default:
+			return fmt.Errorf("invalid toleration operator %q: must be %q or %q", toleration.Operator, api.TolerationOpEqual, api.TolerationOpExists)
+		}
+		switch api.TaintEffect(toleration.Effect) {
+		case "", api.TaintEffectNoSchedule, api.TaintEffectPreferNoSchedule:
+		default:
+			return fmt.Errorf("invalid toleration effect %q: must be %q or %q", toleration.Effect, api.TaintEffectNoSchedule, api.TaintEffectPreferNoSchedule)
+		}
+	}
+	return nil
+}
+
+// createSecondaryOutputRepos creates the repos of the pipeline's secondary
+// outputs that aren't its output repo, or updates their provenance if they
+// already exist.
+func createSecondaryOutputRepos(ctx context.Context, pfsClient pfs.APIClient, pipelineInfo *pps.PipelineInfo, provenance []*pfs.Repo) error {
+	for _, output := range pipelineInfo.SecondaryOutputs {
+		if output.Repo == pipelineInfo.Pipeline.Name {
+			continue
+		}
+		request := &pfs.CreateRepoRequest{
+			Repo:       client.NewRepo(output.Repo),
+			Provenance: provenance,
+		}
+		if _, err := pfsClient.CreateRepo(ctx, request); err != nil {
+			if !isAlreadyExistsErr(err) {
+				return err
+			}
+			request.Update = true
+			if _, err := pfsClient.CreateRepo(ctx, request); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
@@ -625,6 +1204,56 @@ func translatePipelineInputs(inputs []*pps.PipelineInput) *pps.Input {
 	return result
 }
 
+// translateGitInputs fills in the atom input that each git input in input
+// stands for, and creates the repos that the git inputs are committed to.
+func (a *apiServer) translateGitInputs(ctx context.Context, input *pps.Input) error {
+	var gitInputs []*pps.Input
+	pps.VisitInput(input, func(input *pps.Input) {
+		// Inputs that already have an atom have been translated, e.g.
+		// they're from a pipeline that's being restored.
+		if input.Git != nil && input.Atom == nil {
+			gitInputs = append(gitInputs, input)
+		}
+	})
+	if len(gitInputs) == 0 {
+		return nil
+	}
+	pfsClient, err := a.getPFSClient()
+	if err != nil {
+		return err
+	}
+	for _, input := range gitInputs {
+		if input.Cross != nil || input.Union != nil {
+			return fmt.Errorf("multiple input types set")
+		}
+		if input.Git.URL == "" {
+			return fmt.Errorf("git input must specify a url")
+		}
+		if input.Git.Secret == "" {
+			return fmt.Errorf("git input must specify a secret, pushes are only committed if they're signed with it")
+		}
+		if input.Git.Name == "" {
+			input.Git.Name = pps.GitRepoName(input.Git.URL)
+		}
+		if input.Git.Branch == "" {
+			input.Git.Branch = "master"
+		}
+		input.Atom = &pps.AtomInput{
+			Name:   input.Git.Name,
+			Repo:   input.Git.Name,
+			Branch: input.Git.Branch,
+			Glob:   "/",
+		}
+		if _, err := pfsClient.CreateRepo(ctx, &pfs.CreateRepoRequest{
+			Repo:        client.NewRepo(input.Git.Name),
+			Description: fmt.Sprintf("pushes to %s", input.Git.URL),
+		}); err != nil && !isAlreadyExistsErr(err) {
+			return err
+		}
+	}
+	return nil
+}
+
 func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipelineRequest) (response *types.Empty, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
@@ -637,6 +1266,11 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 		}
 		request.Input = translatePipelineInputs(request.Inputs)
 	}
+	if request.Input != nil {
+		if err := a.translateGitInputs(ctx, request.Input); err != nil {
+			return nil, err
+		}
+	}
 
 	pipelineInfo := &pps.PipelineInfo{
 		ID:                 uuid.NewWithoutDashes(),
@@ -652,11 +1286,42 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 		ResourceSpec:       request.ResourceSpec,
 		Description:        request.Description,
 		Incremental:        request.Incremental,
+		Webhooks:           request.Webhooks,
+		S3Gateway:          request.S3Gateway,
+		CacheSize:          request.CacheSize,
+		DiskCacheSize:      request.DiskCacheSize,
+		DatumConcurrency:   request.DatumConcurrency,
+		PodLabels:          request.PodLabels,
+		PodAnnotations:     request.PodAnnotations,
+		SecondaryOutputs:   request.SecondaryOutputs,
+		Logs:               request.Logs,
+		Scheduling:         request.Scheduling,
+		ServiceAccount:     request.ServiceAccount,
+		Sidecars:           request.Sidecars,
+		RuntimeClass:       request.RuntimeClass,
 	}
 	setPipelineDefaults(pipelineInfo)
+	if request.PinImage && pipelineInfo.Transform != nil {
+		imageDigest, err := a.resolveImageDigest(ctx, pipelineInfo.Transform)
+		if err != nil {
+			return nil, err
+		}
+		pipelineInfo.Transform.ImageDigest = imageDigest
+	}
 	if err := a.validatePipeline(ctx, pipelineInfo); err != nil {
 		return nil, err
 	}
+	if err := a.validateServiceAccount(pipelineInfo.ServiceAccount); err != nil {
+		return nil, err
+	}
+	if err := a.validateRuntimeClass(pipelineInfo.RuntimeClass); err != nil {
+		return nil, err
+	}
+	if !request.SkipCapacityCheck {
+		if err := a.checkCapacity(pipelineInfo); err != nil {
+			return nil, err
+		}
+	}
 
 	pfsClient, err := a.getPFSClient()
 	if err != nil {
@@ -683,32 +1348,38 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 			}
 			pipelineInfo.Version = oldPipelineInfo.Version + 1
 			pipelines.Put(pipelineName, pipelineInfo)
+			a.pipelineVersions.ReadWrite(stm).Put(ppsdb.PipelineVersionKey(pipelineName, pipelineInfo.Version), pipelineInfo)
 			return nil
 		})
 		if err != nil {
 			return nil, err
 		}
 
-		// Rename the original output branch to `outputBranch-vN`, where N
-		// is the previous version number of the pipeline.
+		// If the new version outputs to the same branch, rename the
+		// original output branch to `outputBranch-vN`, where N is the
+		// previous version number of the pipeline. If it outputs to a
+		// different branch, the old version's output is left where it is,
+		// so that the two versions' outputs can be compared.
 		// We ignore NotFound errors because this pipeline might not have
 		// even output anything yet, in which case the output branch
 		// may not actually exist.
-		if _, err := pfsClient.SetBranch(ctx, &pfs.SetBranchRequest{
-			Commit: &pfs.Commit{
-				Repo: &pfs.Repo{pipelineName},
-				ID:   oldPipelineInfo.OutputBranch,
-			},
-			Branch: fmt.Sprintf("%s-v%d", oldPipelineInfo.OutputBranch, oldPipelineInfo.Version),
-		}); err != nil && !isNotFoundErr(err) {
-			return nil, err
-		}
+		if oldPipelineInfo.OutputBranch == pipelineInfo.OutputBranch {
+			if _, err := pfsClient.SetBranch(ctx, &pfs.SetBranchRequest{
+				Commit: &pfs.Commit{
+					Repo: &pfs.Repo{pipelineName},
+					ID:   oldPipelineInfo.OutputBranch,
+				},
+				Branch: fmt.Sprintf("%s-v%d", oldPipelineInfo.OutputBranch, oldPipelineInfo.Version),
+			}); err != nil && !isNotFoundErr(err) {
+				return nil, err
+			}
 
-		if _, err := pfsClient.DeleteBranch(ctx, &pfs.DeleteBranchRequest{
-			Repo:   &pfs.Repo{pipelineName},
-			Branch: oldPipelineInfo.OutputBranch,
-		}); err != nil && !isNotFoundErr(err) {
-			return nil, err
+			if _, err := pfsClient.DeleteBranch(ctx, &pfs.DeleteBranchRequest{
+				Repo:   &pfs.Repo{pipelineName},
+				Branch: oldPipelineInfo.OutputBranch,
+			}); err != nil && !isNotFoundErr(err) {
+				return nil, err
+			}
 		}
 
 		if _, err := a.StartPipeline(ctx, &pps.StartPipelineRequest{request.Pipeline}); err != nil {
@@ -742,6 +1413,9 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 		}); err != nil && !isAlreadyExistsErr(err) {
 			return nil, err
 		}
+		if err := createSecondaryOutputRepos(ctx, pfsClient, pipelineInfo, provenance); err != nil {
+			return nil, err
+		}
 
 		if provenanceChanged {
 
@@ -772,7 +1446,11 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 			if isAlreadyExistsErr(err) {
 				return newErrPipelineExists(pipelineName)
 			}
-			return err
+			if err != nil {
+				return err
+			}
+			a.pipelineVersions.ReadWrite(stm).Put(ppsdb.PipelineVersionKey(pipelineName, pipelineInfo.Version), pipelineInfo)
+			return nil
 		})
 		if err != nil {
 			return nil, err
@@ -790,6 +1468,9 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 		}); err != nil && !isAlreadyExistsErr(err) {
 			return nil, err
 		}
+		if err := createSecondaryOutputRepos(ctx, pfsClient, pipelineInfo, provenance); err != nil {
+			return nil, err
+		}
 	}
 
 	return &types.Empty{}, nil
@@ -811,6 +1492,31 @@ func setPipelineDefaults(pipelineInfo *pps.PipelineInfo) {
 		// Output branches default to master
 		pipelineInfo.OutputBranch = "master"
 	}
+	for _, output := range pipelineInfo.SecondaryOutputs {
+		if output.Repo == "" {
+			output.Repo = pipelineInfo.Pipeline.Name
+		}
+		if output.Branch == "" {
+			output.Branch = output.Name
+		}
+	}
+	if pipelineInfo.CacheSize == "" {
+		pipelineInfo.CacheSize = "64M"
+	}
+	if pipelineInfo.DatumConcurrency == 0 {
+		pipelineInfo.DatumConcurrency = 1
+	}
+	if pipelineInfo.ServiceAccount == "" {
+		pipelineInfo.ServiceAccount = assets.WorkerServiceAccountName
+	}
+	if pipelineInfo.Logs != nil {
+		if pipelineInfo.Logs.Branch == "" {
+			pipelineInfo.Logs.Branch = "logs"
+		}
+		if pipelineInfo.Logs.MaxBytesPerDatum == "" {
+			pipelineInfo.Logs.MaxBytesPerDatum = "1M"
+		}
+	}
 }
 
 func (a *apiServer) InspectPipeline(ctx context.Context, request *pps.InspectPipelineRequest) (response *pps.PipelineInfo, retErr error) {
@@ -821,6 +1527,18 @@ func (a *apiServer) InspectPipeline(ctx context.Context, request *pps.InspectPip
 	if err := a.pipelines.ReadOnly(ctx).Get(request.Pipeline.Name, pipelineInfo); err != nil {
 		return nil, err
 	}
+	if request.Version != 0 && request.Version != pipelineInfo.Version {
+		if request.Version > pipelineInfo.Version {
+			return nil, fmt.Errorf("pipeline %s has no version %d, its current version is %d", request.Pipeline.Name, request.Version, pipelineInfo.Version)
+		}
+		pipelineInfo = new(pps.PipelineInfo)
+		if err := a.pipelineVersions.ReadOnly(ctx).Get(ppsdb.PipelineVersionKey(request.Pipeline.Name, request.Version), pipelineInfo); err != nil {
+			if isNotFoundErr(err) {
+				return nil, fmt.Errorf("version %d of pipeline %s wasn't recorded, old versions are only kept for pipelines created or updated since pachd started recording them", request.Version, request.Pipeline.Name)
+			}
+			return nil, err
+		}
+	}
 	if pipelineInfo.Input == nil {
 		pipelineInfo.Input = translatePipelineInputs(pipelineInfo.Inputs)
 	}
@@ -846,6 +1564,9 @@ func (a *apiServer) ListPipeline(ctx context.Context, request *pps.ListPipelineR
 			return nil, err
 		}
 		if ok {
+			if project, _ := pfs.SplitProject(pipelineName); request.Project != "" && project != request.Project {
+				continue
+			}
 			if pipelineInfo.Input == nil {
 				pipelineInfo.Input = translatePipelineInputs(pipelineInfo.Inputs)
 			}
@@ -875,6 +1596,19 @@ func (a *apiServer) DeletePipeline(ctx context.Context, request *pps.DeletePipel
 		}
 		return &types.Empty{}, nil
 	}
+	if request.Cascade {
+		pipelineInfos, err := a.ListPipeline(ctx, &pps.ListPipelineRequest{})
+		if err != nil {
+			return nil, err
+		}
+		for _, pipeline := range pps.DownstreamPipelines(request.Pipeline.Name, pipelineInfos.PipelineInfo) {
+			downstreamRequest := *request
+			downstreamRequest.Pipeline = client.NewPipeline(pipeline)
+			if _, err := a.deletePipeline(ctx, &downstreamRequest); err != nil {
+				return nil, err
+			}
+		}
+	}
 	return a.deletePipeline(ctx, request)
 }
 
@@ -920,8 +1654,43 @@ func (a *apiServer) deletePipeline(ctx context.Context, request *pps.DeletePipel
 		}
 	}
 
+	pipelineInfo := new(pps.PipelineInfo)
+	if _, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+		pipelines := a.pipelines.ReadWrite(stm)
+		if err := pipelines.Get(request.Pipeline.Name, pipelineInfo); err != nil {
+			return err
+		}
+		return pipelines.Delete(request.Pipeline.Name)
+	}); err != nil {
+		return nil, err
+	}
+
+	// Delete the pipeline's old versions
+	iter, err = a.pipelineVersions.ReadOnly(ctx).GetByIndex(ppsdb.PipelineVersionsPipelineIndex, request.Pipeline)
+	if err != nil {
+		return nil, err
+	}
+	var versionKeys []string
+	for {
+		var key string
+		var pipelineInfo pps.PipelineInfo
+		ok, err := iter.Next(&key, &pipelineInfo)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		versionKeys = append(versionKeys, key)
+	}
 	if _, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
-		return a.pipelines.ReadWrite(stm).Delete(request.Pipeline.Name)
+		pipelineVersions := a.pipelineVersions.ReadWrite(stm)
+		for _, key := range versionKeys {
+			if err := pipelineVersions.Delete(key); err != nil && !isNotFoundErr(err) {
+				return err
+			}
+		}
+		return nil
 	}); err != nil {
 		return nil, err
 	}
@@ -938,6 +1707,21 @@ func (a *apiServer) deletePipeline(ctx context.Context, request *pps.DeletePipel
 		}); err != nil {
 			return nil, err
 		}
+		// Delete the secondary outputs' repos, unless they're the output
+		// repo, which was just deleted.
+		deleted := map[string]bool{request.Pipeline.Name: true}
+		for _, output := range pipelineInfo.SecondaryOutputs {
+			if deleted[output.Repo] {
+				continue
+			}
+			deleted[output.Repo] = true
+			if _, err := pfsClient.DeleteRepo(ctx, &pfs.DeleteRepoRequest{
+				Repo:  client.NewRepo(output.Repo),
+				Force: true,
+			}); err != nil && !isNotFoundErr(err) {
+				return nil, err
+			}
+		}
 	}
 
 	return &types.Empty{}, nil
@@ -1122,6 +1906,53 @@ func (a *apiServer) GarbageCollect(ctx context.Context, request *pps.GarbageColl
 		return nil, err
 	}
 
+	// The datum checkpoints of running jobs, and the logs that they record,
+	// aren't referenced by anything in PFS.
+	jobInfos, err := a.ListJob(ctx, &pps.ListJobRequest{})
+	if err != nil {
+		return nil, err
+	}
+	for _, jobInfo := range jobInfos.JobInfo {
+		checkpoint := jobInfo.DatumCheckpoint
+		if checkpoint == nil || jobStateToStopped(jobInfo.State) {
+			continue
+		}
+		addActiveObjects(checkpoint)
+		limiter.Acquire()
+		eg.Go(func() error {
+			defer limiter.Release()
+			getObjectClient, err := objClient.GetObject(ctx, checkpoint)
+			if err != nil {
+				return fmt.Errorf("error getting datum checkpoint: %v", err)
+			}
+			var buf bytes.Buffer
+			if err := grpcutil.WriteFromStreamingBytesClient(getObjectClient, &buf); err != nil {
+				return fmt.Errorf("error reading datum checkpoint: %v", err)
+			}
+			logs, err := workerpkg.CheckpointLogs(buf.Bytes())
+			if err != nil {
+				return fmt.Errorf("error reading datum checkpoint %s: %v", checkpoint.Hash, err)
+			}
+			addActiveObjects(logs...)
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	// Objects that active objects are stored as deltas against are needed
+	// to read them, so they're active too.
+	var active []*pfs.Object
+	for hash := range activeObjects {
+		active = append(active, &pfs.Object{Hash: hash})
+	}
+	bases, err := pfsserver.DeltaBases(ctx, objClient, active)
+	if err != nil {
+		return nil, err
+	}
+	addActiveObjects(bases...)
+
 	// Iterate through all objects.  If they are not active, delete them.
 	objects, err := objClient.ListObjects(ctx, &pfs.ListObjectsRequest{})
 	if err != nil {
@@ -1222,6 +2053,161 @@ func (a *apiServer) incrementGCGeneration(ctx context.Context) error {
 	return nil
 }
 
+func (a *apiServer) Usage(ctx context.Context, request *pps.UsageRequest) (response *pps.UsageResponse, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	until := time.Now()
+	var since time.Time
+	if request.Since != nil {
+		var err error
+		since, err = types.TimestampFromProto(request.Since)
+		if err != nil {
+			return nil, err
+		}
+	}
+	response = &pps.UsageResponse{
+		Since: request.Since,
+		Until: now(),
+	}
+
+	pfsClient, err := a.getPFSClient()
+	if err != nil {
+		return nil, err
+	}
+	repoInfos, err := pfsClient.ListRepo(ctx, &pfs.ListRepoRequest{})
+	if err != nil {
+		return nil, err
+	}
+	repoSizes := make(map[string]uint64)
+	for _, repoInfo := range repoInfos.RepoInfo {
+		repoSizes[repoInfo.Repo.Name] = repoInfo.SizeBytes
+		response.Repos = append(response.Repos, &pps.RepoUsage{
+			Repo:      repoInfo.Repo,
+			SizeBytes: repoInfo.SizeBytes,
+		})
+	}
+
+	pipelineUsage := make(map[string]*pps.PipelineUsage)
+	pipelineIter, err := a.pipelines.ReadOnly(ctx).List()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		var pipelineName string
+		var pipelineInfo pps.PipelineInfo
+		ok, err := pipelineIter.Next(&pipelineName, &pipelineInfo)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		pipelineUsage[pipelineInfo.Pipeline.Name] = &pps.PipelineUsage{
+			Pipeline:        pipelineInfo.Pipeline,
+			OutputSizeBytes: repoSizes[ppsserver.PipelineRepo(pipelineInfo.Pipeline).Name],
+		}
+	}
+
+	// numWorkers caches the number of workers for each parallelism spec, so
+	// that we don't ask kubernetes for the node list once per job.
+	numWorkers := make(map[string]int)
+	// nodeTypes are the node types that each pipeline's jobs ran on.
+	nodeTypes := make(map[string][]string)
+	jobIter, err := a.jobs.ReadOnly(ctx).List()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		var jobID string
+		var jobInfo pps.JobInfo
+		ok, err := jobIter.Next(&jobID, &jobInfo)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		if jobInfo.Pipeline == nil || jobInfo.Started == nil {
+			continue
+		}
+		usage, ok := pipelineUsage[jobInfo.Pipeline.Name]
+		if !ok {
+			// The pipeline has been deleted, its jobs are still accounted for.
+			usage = &pps.PipelineUsage{Pipeline: jobInfo.Pipeline}
+			pipelineUsage[jobInfo.Pipeline.Name] = usage
+		}
+		started, err := types.TimestampFromProto(jobInfo.Started)
+		if err != nil {
+			return nil, err
+		}
+		finished := until
+		if jobInfo.Finished != nil {
+			finished, err = types.TimestampFromProto(jobInfo.Finished)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if finished.Before(since) {
+			continue
+		}
+		if started.Before(since) {
+			started = since
+		}
+		key := jobInfo.ParallelismSpec.String()
+		workers, ok := numWorkers[key]
+		if !ok {
+			workers, err = pps.GetExpectedNumWorkers(a.kubeClient, jobInfo.ParallelismSpec)
+			if err != nil {
+				return nil, err
+			}
+			numWorkers[key] = workers
+		}
+		podHours := finished.Sub(started).Hours() * float64(workers)
+		usage.Jobs++
+		usage.WallClockHours += finished.Sub(started).Hours()
+		usage.PodHours += podHours
+		if jobInfo.ResourceSpec != nil {
+			usage.CpuHours += podHours * float64(jobInfo.ResourceSpec.Cpu)
+		}
+		usage.DataProcessed += jobInfo.DataProcessed
+		if jobInfo.Cost != nil {
+			// The measured usage isn't timestamped, so if the job started
+			// before since only the part of it that's proportional to the
+			// time the job ran after since is counted.
+			fraction := 1.0
+			if jobStarted, err := types.TimestampFromProto(jobInfo.Started); err == nil && finished.After(jobStarted) {
+				fraction = finished.Sub(started).Seconds() / finished.Sub(jobStarted).Seconds()
+			}
+			usage.MeasuredCpuHours += jobInfo.Cost.CpuSeconds / 3600 * fraction
+			usage.MemoryGbHours += jobInfo.Cost.MemoryByteSeconds / 3600 / 1e9 * fraction
+			nodeTypes[jobInfo.Pipeline.Name] = append(nodeTypes[jobInfo.Pipeline.Name], jobInfo.Cost.NodeTypes...)
+		}
+	}
+	for pipeline, usage := range pipelineUsage {
+		usage.NodeTypes = uniqueSorted(nodeTypes[pipeline])
+		response.Pipelines = append(response.Pipelines, usage)
+	}
+	sort.Slice(response.Pipelines, func(i, j int) bool {
+		return response.Pipelines[i].Pipeline.Name < response.Pipelines[j].Pipeline.Name
+	})
+	return response, nil
+}
+
+// uniqueSorted returns the distinct strings in ss, sorted.
+func uniqueSorted(ss []string) []string {
+	set := make(map[string]bool)
+	var result []string
+	for _, s := range ss {
+		if !set[s] {
+			set[s] = true
+			result = append(result, s)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
 func isAlreadyExistsErr(err error) bool {
 	return err != nil && strings.Contains(err.Error(), "already exists")
 }
@@ -1302,6 +2288,8 @@ func jobStateToStopped(state pps.JobState) bool {
 		return true
 	case pps.JobState_JOB_STOPPED:
 		return true
+	case pps.JobState_JOB_WAITING_FOR_RESOURCES:
+		return false
 	default:
 		panic(fmt.Sprintf("unrecognized job state: %s", state))
 	}
@@ -1382,6 +2370,143 @@ func (a *apiServer) rcPods(rcName string) ([]api.Pod, error) {
 	return podList.Items, nil
 }
 
+// fillWorkerPods sets jobInfo.WorkerPods and jobInfo.PodEvents from the pods
+// in the worker pool rcName.
+func (a *apiServer) fillWorkerPods(rcName string, jobInfo *pps.JobInfo) error {
+	pods, err := a.rcPods(rcName)
+	if err != nil {
+		return err
+	}
+	sort.Sort(podSlice(pods))
+	zones := make(map[string]string)
+	for _, pod := range pods {
+		workerPod := &pps.WorkerPod{
+			Name:    pod.ObjectMeta.Name,
+			Node:    pod.Spec.NodeName,
+			Phase:   string(pod.Status.Phase),
+			Reason:  pod.Status.Reason,
+			Message: pod.Status.Message,
+		}
+		if pod.Spec.NodeName != "" {
+			zone, ok := zones[pod.Spec.NodeName]
+			if !ok {
+				// The zone is informational, so the pod is still reported
+				// if its node can't be read.
+				if node, err := a.kubeClient.Nodes().Get(pod.Spec.NodeName); err == nil {
+					zone = node.ObjectMeta.Labels[unversioned.LabelZoneFailureDomain]
+				}
+				zones[pod.Spec.NodeName] = zone
+			}
+			workerPod.Zone = zone
+		}
+		if message, ok := unschedulable(&pod); ok {
+			workerPod.Reason = podReasonUnschedulable
+			workerPod.Message = message
+		}
+		if reason := podFailureReason(&pod); reason != "" && jobInfo.Reason == "" {
+			jobInfo.Reason = fmt.Sprintf("worker %s: %s", pod.ObjectMeta.Name, reason)
+		}
+		for _, status := range pod.Status.ContainerStatuses {
+			workerPod.Restarts += status.RestartCount
+			if workerPod.Reason != "" {
+				continue
+			}
+			switch {
+			case status.State.Waiting != nil && status.State.Waiting.Reason != "":
+				workerPod.Reason = status.State.Waiting.Reason
+				workerPod.Message = status.State.Waiting.Message
+			case status.State.Terminated != nil:
+				workerPod.Reason = status.State.Terminated.Reason
+				workerPod.Message = status.State.Terminated.Message
+			case status.LastTerminationState.Terminated != nil:
+				workerPod.Reason = status.LastTerminationState.Terminated.Reason
+				workerPod.Message = status.LastTerminationState.Terminated.Message
+			}
+		}
+		jobInfo.WorkerPods = append(jobInfo.WorkerPods, workerPod)
+
+		eventList, err := a.kubeClient.Events(a.namespace).List(api.ListOptions{
+			TypeMeta: unversioned.TypeMeta{
+				Kind:       "ListOptions",
+				APIVersion: "v1",
+			},
+			FieldSelector: kube_fields.OneTermEqualSelector("involvedObject.name", pod.ObjectMeta.Name),
+		})
+		if err != nil {
+			return err
+		}
+		for _, event := range eventList.Items {
+			lastSeen, err := types.TimestampProto(event.LastTimestamp.Time)
+			if err != nil {
+				return err
+			}
+			jobInfo.PodEvents = append(jobInfo.PodEvents, &pps.PodEvent{
+				Pod:      pod.ObjectMeta.Name,
+				Type:     event.Type,
+				Reason:   event.Reason,
+				Message:  event.Message,
+				Count:    event.Count,
+				LastSeen: lastSeen,
+			})
+		}
+	}
+	sort.Slice(jobInfo.PodEvents, func(i, j int) bool {
+		return jobInfo.PodEvents[i].LastSeen.Compare(jobInfo.PodEvents[j].LastSeen) < 0
+	})
+	return nil
+}
+
+// failureReasons are the reasons that Kubernetes gives for pods and
+// containers that keep workers from running.
+var failureReasons = map[string]bool{
+	"OOMKilled":                  true,
+	"Error":                      true,
+	"ContainerCannotRun":         true,
+	"CrashLoopBackOff":           true,
+	"ErrImagePull":               true,
+	"ImagePullBackOff":           true,
+	"InvalidImageName":           true,
+	"CreateContainerConfigError": true,
+	"Evicted":                    true,
+}
+
+// podFailureReason returns why pod is failing, e.g. because it was evicted
+// or one of its containers was OOMKilled, or "" if it isn't.
+func podFailureReason(pod *api.Pod) string {
+	if failureReasons[pod.Status.Reason] {
+		return withMessage(pod.Status.Reason, pod.Status.Message)
+	}
+	var statuses []api.ContainerStatus
+	statuses = append(statuses, pod.Status.InitContainerStatuses...)
+	statuses = append(statuses, pod.Status.ContainerStatuses...)
+	for _, status := range statuses {
+		var reason, message string
+		switch {
+		case status.State.Waiting != nil:
+			reason, message = status.State.Waiting.Reason, status.State.Waiting.Message
+		case status.State.Terminated != nil:
+			reason, message = status.State.Terminated.Reason, status.State.Terminated.Message
+		}
+		// A container that keeps being killed, e.g. for running out of
+		// memory, is waiting in CrashLoopBackOff between restarts, but why
+		// it was killed is what's useful.
+		if last := status.LastTerminationState.Terminated; last != nil && failureReasons[last.Reason] && (reason == "" || reason == "CrashLoopBackOff") {
+			return fmt.Sprintf("container %s: %s, restarted %d times", status.Name, withMessage(last.Reason, last.Message), status.RestartCount)
+		}
+		if failureReasons[reason] {
+			return fmt.Sprintf("container %s: %s", status.Name, withMessage(reason, message))
+		}
+	}
+	return ""
+}
+
+func withMessage(reason string, message string) string {
+	if message == "" {
+		return reason
+	}
+	return fmt.Sprintf("%s (%s)", reason, message)
+}
+
 func labels(app string) map[string]string {
 	return map[string]string{
 		"app":   app,
@@ -0,0 +1,158 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/pachyderm/pachyderm/src/client/pps"
+
+	units "github.com/docker/go-units"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/resource"
+	kube_labels "k8s.io/kubernetes/pkg/labels"
+)
+
+// checkCapacity returns an error, with a report of the cluster's capacity,
+// if pipelineInfo's workers can't all be scheduled on the cluster's nodes as
+// they are: because the workers need GPUs and no node has any, because no
+// node is big enough for a worker, or because the workers together need more
+// than the nodes have. Without it, such pipelines are created, but their
+// jobs never start.
+func (a *apiServer) checkCapacity(pipelineInfo *pps.PipelineInfo) error {
+	requests, err := workerRequests(pipelineInfo)
+	if err != nil {
+		return err
+	}
+	parallelism, err := pps.GetExpectedNumWorkers(a.kubeClient, pipelineInfo.ParallelismSpec)
+	if err != nil {
+		return err
+	}
+	nodeList, err := a.kubeClient.Nodes().List(api.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error listing nodes to check the cluster's capacity: %v", err)
+	}
+	selector := kube_labels.SelectorFromSet(pipelineInfo.Scheduling.GetNodeSelector())
+	allocatable := api.ResourceList{}
+	numNodes := 0
+	fits := false
+	for _, node := range nodeList.Items {
+		if node.Spec.Unschedulable || !selector.Matches(kube_labels.Set(node.ObjectMeta.Labels)) {
+			continue
+		}
+		numNodes++
+		fitsNode := true
+		for name, request := range requests {
+			nodeAllocatable := node.Status.Allocatable[name]
+			if request.Cmp(nodeAllocatable) > 0 {
+				fitsNode = false
+			}
+			total := allocatable[name]
+			total.Add(nodeAllocatable)
+			allocatable[name] = total
+		}
+		fits = fits || fitsNode
+	}
+
+	var problem string
+	_, needGPUs := requests[api.ResourceNvidiaGPU]
+	totalGPUs := allocatable[api.ResourceNvidiaGPU]
+	switch {
+	case numNodes == 0:
+		problem = "there are no nodes that its workers can run on"
+	case needGPUs && totalGPUs.IsZero():
+		problem = "its workers need GPUs, and no node has any"
+	case !fits:
+		problem = "no node is big enough for one of its workers"
+	default:
+		for _, name := range resourceNames(requests) {
+			request := requests[name]
+			total := allocatable[name]
+			needed := resource.NewMilliQuantity(request.MilliValue()*int64(parallelism), request.Format)
+			if needed.Cmp(total) > 0 {
+				problem = fmt.Sprintf("its workers need %s %s in all, but the nodes only have %s", needed.String(), name, total.String())
+				break
+			}
+		}
+	}
+	if problem == "" {
+		return nil
+	}
+	return fmt.Errorf("pipeline %s can't be scheduled on the cluster's nodes, %s:\n"+
+		"  workers: %d, each requesting %s\n"+
+		"  nodes the workers can run on: %d, with %s allocatable in all\n"+
+		"if the cluster adds nodes as they're needed, skip this check with --skip-capacity-check",
+		pipelineInfo.Pipeline.Name, problem, parallelism, formatResources(requests), numNodes, formatResources(allocatable))
+}
+
+// workerRequests returns the resources that each of pipelineInfo's workers
+// requests.
+func workerRequests(pipelineInfo *pps.PipelineInfo) (api.ResourceList, error) {
+	result, err := resourceSpecRequests(pipelineInfo.ResourceSpec)
+	if err != nil {
+		return nil, err
+	}
+	for _, sidecar := range pipelineInfo.Sidecars {
+		requests, err := resourceSpecRequests(sidecar.ResourceSpec)
+		if err != nil {
+			return nil, err
+		}
+		for name, quantity := range requests {
+			total := result[name]
+			total.Add(quantity)
+			result[name] = total
+		}
+	}
+	// The sidecar requests the memory that its cache uses, see
+	// workerPodSpec.
+	if cacheBytes, err := units.RAMInBytes(pipelineInfo.CacheSize); err == nil {
+		memory := result[api.ResourceMemory]
+		memory.Add(*resource.NewQuantity(cacheBytes, resource.BinarySI))
+		result[api.ResourceMemory] = memory
+	}
+	return result, nil
+}
+
+// resourceSpecRequests returns the resources that spec requests, leaving
+// out the ones it doesn't set.
+func resourceSpecRequests(spec *pps.ResourceSpec) (api.ResourceList, error) {
+	result := api.ResourceList{}
+	if spec == nil {
+		return result, nil
+	}
+	if spec.Cpu > 0 {
+		result[api.ResourceCPU] = *resource.NewMilliQuantity(int64(spec.Cpu*1000), resource.DecimalSI)
+	}
+	if spec.Memory != "" {
+		memory, err := resource.ParseQuantity(spec.Memory)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse memory quantity: %s", err)
+		}
+		result[api.ResourceMemory] = memory
+	}
+	if spec.Gpu > 0 {
+		result[api.ResourceNvidiaGPU] = *resource.NewQuantity(spec.Gpu, resource.DecimalSI)
+	}
+	return result, nil
+}
+
+func resourceNames(resources api.ResourceList) []api.ResourceName {
+	var names []api.ResourceName
+	for name := range resources {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+	return names
+}
+
+func formatResources(resources api.ResourceList) string {
+	var buf bytes.Buffer
+	for i, name := range resourceNames(resources) {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		quantity := resources[name]
+		fmt.Fprintf(&buf, "%s %s", name, quantity.String())
+	}
+	return buf.String()
+}
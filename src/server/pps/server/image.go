@@ -0,0 +1,315 @@
+package server
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client/pps"
+
+	"github.com/docker/distribution/digest"
+	"github.com/docker/distribution/reference"
+	"golang.org/x/net/context"
+
+	"k8s.io/kubernetes/pkg/api"
+)
+
+const (
+	// dockerHubRegistry is the registry of images whose name doesn't
+	// start with a registry host.
+	dockerHubRegistry = "registry-1.docker.io"
+	// registryTimeout bounds the requests to an image's registry.
+	registryTimeout = 30 * time.Second
+)
+
+// manifestMediaTypes are the manifest types that we accept from
+// registries, the digest of a manifest list is returned for multi-arch
+// images.
+var manifestMediaTypes = []string{
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.docker.distribution.manifest.v1+prettyjws",
+}
+
+// registryCredentials is a username and password for a registry.
+type registryCredentials struct {
+	username string
+	password string
+}
+
+// resolveImageDigest returns the digest that the tag of transform's image
+// currently points to in its registry. The credentials in the transform's
+// image pull secrets are used if the registry asks for them.
+func (a *apiServer) resolveImageDigest(ctx context.Context, transform *pps.Transform) (string, error) {
+	image := transform.Image
+	if image == "" {
+		image = DefaultUserImage
+	}
+	named, err := reference.ParseNamed(image)
+	if err != nil {
+		return "", fmt.Errorf("invalid image %s: %v", image, err)
+	}
+	if digested, ok := named.(reference.Digested); ok {
+		// The image is already pinned.
+		return digested.Digest().String(), nil
+	}
+	tag := "latest"
+	if tagged, ok := named.(reference.Tagged); ok {
+		tag = tagged.Tag()
+	}
+	registry, repo := splitImageName(named.Name())
+	credentials, err := a.registryCredentials(registry, transform.ImagePullSecrets)
+	if err != nil {
+		return "", err
+	}
+	result, err := getManifestDigest(ctx, registry, repo, tag, credentials)
+	if err != nil {
+		return "", fmt.Errorf("could not resolve the digest of %s: %v", image, err)
+	}
+	return result, nil
+}
+
+// pinnedImage returns image pinned to imageDigest.
+func pinnedImage(image string, imageDigest string) string {
+	named, err := reference.ParseNamed(image)
+	if err != nil {
+		return image
+	}
+	if _, ok := named.(reference.Digested); ok {
+		return image
+	}
+	return named.Name() + "@" + imageDigest
+}
+
+// splitImageName splits the name of an image into its registry and its
+// repo in the registry, following docker's rules: the first component of
+// the name is a registry only if it looks like a host.
+func splitImageName(name string) (string, string) {
+	parts := strings.SplitN(name, "/", 2)
+	if len(parts) == 2 && (strings.ContainsAny(parts[0], ".:") || parts[0] == "localhost") {
+		if parts[0] == "docker.io" || parts[0] == "index.docker.io" {
+			parts[0] = dockerHubRegistry
+		}
+		if parts[0] == dockerHubRegistry && !strings.Contains(parts[1], "/") {
+			parts[1] = "library/" + parts[1]
+		}
+		return parts[0], parts[1]
+	}
+	if !strings.Contains(name, "/") {
+		name = "library/" + name
+	}
+	return dockerHubRegistry, name
+}
+
+// registryCredentials returns the credentials for registry in the image
+// pull secrets, or nil if they don't have any.
+func (a *apiServer) registryCredentials(registry string, pullSecrets []string) (*registryCredentials, error) {
+	for _, name := range pullSecrets {
+		secret, err := a.kubeClient.Secrets(a.namespace).Get(name)
+		if err != nil {
+			return nil, fmt.Errorf("could not get image pull secret %s: %v", name, err)
+		}
+		var auths map[string]struct {
+			Auth     string `json:"auth"`
+			Username string `json:"username"`
+			Password string `json:"password"`
+		}
+		if data, ok := secret.Data[api.DockerConfigJsonKey]; ok {
+			var config struct {
+				Auths json.RawMessage `json:"auths"`
+			}
+			if err := json.Unmarshal(data, &config); err != nil {
+				return nil, fmt.Errorf("could not parse image pull secret %s: %v", name, err)
+			}
+			if len(config.Auths) > 0 {
+				if err := json.Unmarshal(config.Auths, &auths); err != nil {
+					return nil, fmt.Errorf("could not parse image pull secret %s: %v", name, err)
+				}
+			}
+		} else if data, ok := secret.Data[api.DockerConfigKey]; ok {
+			if err := json.Unmarshal(data, &auths); err != nil {
+				return nil, fmt.Errorf("could not parse image pull secret %s: %v", name, err)
+			}
+		}
+		for host, auth := range auths {
+			if registryHost(host) != registry {
+				continue
+			}
+			if auth.Username != "" {
+				return &registryCredentials{username: auth.Username, password: auth.Password}, nil
+			}
+			decoded, err := base64.StdEncoding.DecodeString(auth.Auth)
+			if err != nil {
+				return nil, fmt.Errorf("could not parse image pull secret %s: %v", name, err)
+			}
+			parts := strings.SplitN(string(decoded), ":", 2)
+			if len(parts) != 2 {
+				return nil, fmt.Errorf("could not parse image pull secret %s: malformed auth", name)
+			}
+			return &registryCredentials{username: parts[0], password: parts[1]}, nil
+		}
+	}
+	return nil, nil
+}
+
+// registryHost returns the registry that a key of a docker config refers
+// to, keys can be a host or a URL like "https://index.docker.io/v1/".
+func registryHost(key string) string {
+	if u, err := url.Parse(key); err == nil && u.Host != "" {
+		key = u.Host
+	}
+	key = strings.TrimSuffix(key, "/")
+	if key == "docker.io" || key == "index.docker.io" {
+		return dockerHubRegistry
+	}
+	return key
+}
+
+// getManifestDigest asks registry for the digest of the manifest of
+// repo:tag, authenticating with credentials if the registry asks for it.
+func getManifestDigest(ctx context.Context, registry string, repo string, tag string, credentials *registryCredentials) (string, error) {
+	httpClient := &http.Client{Timeout: registryTimeout}
+	manifestURL := fmt.Sprintf("https://%s/v2/%s/manifests/%s", registry, repo, tag)
+	newRequest := func(authorization string) (*http.Request, error) {
+		req, err := http.NewRequest("HEAD", manifestURL, nil)
+		if err != nil {
+			return nil, err
+		}
+		req = req.WithContext(ctx)
+		req.Header.Set("Accept", strings.Join(manifestMediaTypes, ", "))
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		return req, nil
+	}
+	req, err := newRequest("")
+	if err != nil {
+		return "", err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	resp.Body.Close()
+	if resp.StatusCode == http.StatusUnauthorized {
+		authorization, err := registryAuthorization(ctx, httpClient, resp.Header.Get("Www-Authenticate"), credentials)
+		if err != nil {
+			return "", err
+		}
+		req, err := newRequest(authorization)
+		if err != nil {
+			return "", err
+		}
+		resp, err = httpClient.Do(req)
+		if err != nil {
+			return "", err
+		}
+		resp.Body.Close()
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("%s returned %s", manifestURL, resp.Status)
+	}
+	result, err := digest.ParseDigest(resp.Header.Get("Docker-Content-Digest"))
+	if err != nil {
+		return "", fmt.Errorf("%s returned an invalid digest: %v", manifestURL, err)
+	}
+	return result.String(), nil
+}
+
+// registryAuthorization returns the Authorization header that answers
+// challenge, a registry's Www-Authenticate header. Bearer challenges are
+// answered with a token from the registry's token service.
+func registryAuthorization(ctx context.Context, httpClient *http.Client, challenge string, credentials *registryCredentials) (string, error) {
+	scheme, params := parseChallenge(challenge)
+	switch strings.ToLower(scheme) {
+	case "basic":
+		if credentials == nil {
+			return "", fmt.Errorf("the registry requires credentials, add them to the pipeline's image pull secrets")
+		}
+		return "Basic " + base64.StdEncoding.EncodeToString([]byte(credentials.username+":"+credentials.password)), nil
+	case "bearer":
+		tokenURL, err := url.Parse(params["realm"])
+		if err != nil || params["realm"] == "" {
+			return "", fmt.Errorf("the registry returned an invalid token realm %q", params["realm"])
+		}
+		query := tokenURL.Query()
+		if params["service"] != "" {
+			query.Set("service", params["service"])
+		}
+		if params["scope"] != "" {
+			query.Set("scope", params["scope"])
+		}
+		tokenURL.RawQuery = query.Encode()
+		req, err := http.NewRequest("GET", tokenURL.String(), nil)
+		if err != nil {
+			return "", err
+		}
+		req = req.WithContext(ctx)
+		if credentials != nil {
+			req.SetBasicAuth(credentials.username, credentials.password)
+		}
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			return "", err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return "", fmt.Errorf("the registry's token service returned %s", resp.Status)
+		}
+		var token struct {
+			Token       string `json:"token"`
+			AccessToken string `json:"access_token"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+			return "", err
+		}
+		if token.Token == "" {
+			token.Token = token.AccessToken
+		}
+		return "Bearer " + token.Token, nil
+	default:
+		return "", fmt.Errorf("unsupported registry authentication %q", challenge)
+	}
+}
+
+// parseChallenge parses a Www-Authenticate header, like
+// `Bearer realm="https://auth.docker.io/token",service="registry.docker.io"`,
+// into its scheme and parameters.
+func parseChallenge(challenge string) (string, map[string]string) {
+	params := make(map[string]string)
+	parts := strings.SplitN(strings.TrimSpace(challenge), " ", 2)
+	if len(parts) < 2 {
+		return parts[0], params
+	}
+	rest := parts[1]
+	for rest != "" {
+		eq := strings.Index(rest, "=")
+		if eq < 0 {
+			break
+		}
+		key := strings.ToLower(strings.TrimSpace(rest[:eq]))
+		rest = rest[eq+1:]
+		var value string
+		if strings.HasPrefix(rest, `"`) {
+			end := strings.Index(rest[1:], `"`)
+			if end < 0 {
+				value, rest = rest[1:], ""
+			} else {
+				value, rest = rest[1:end+1], rest[end+2:]
+			}
+		} else if comma := strings.Index(rest, ","); comma >= 0 {
+			value, rest = rest[:comma], rest[comma:]
+		} else {
+			value, rest = rest, ""
+		}
+		params[key] = value
+		rest = strings.TrimLeft(rest, ", ")
+	}
+	return parts[0], params
+}
@@ -0,0 +1,213 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	workerpkg "github.com/pachyderm/pachyderm/src/server/pkg/worker"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"golang.org/x/net/context"
+	"golang.org/x/sync/errgroup"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/errors"
+)
+
+// followGracePeriod is how long logs are still followed after the job that
+// they're followed for has finished, so that its last lines, which the
+// workers may still be writing, are sent.
+const followGracePeriod = 5 * time.Second
+
+// matchLogMessage returns true if msg, a line from a worker's log, is from
+// the pipeline, job and data that request filters for.
+func matchLogMessage(request *pps.GetLogsRequest, msg *pps.LogMessage) bool {
+	if request.Pipeline != nil && request.Pipeline.Name != msg.PipelineName {
+		return false
+	}
+	if request.Job != nil && request.Job.ID != msg.JobID {
+		return false
+	}
+	return workerpkg.MatchDatum(request.DataFilters, msg.Data)
+}
+
+// followLogs sends the log lines that match request from pods as they're
+// written, until the client goes away, every pod's log ends, or, if request
+// is for a job, the job has finished. Unlike the lines that GetLogs sends
+// otherwise, which are grouped by pod, lines are sent in the order in which
+// they arrive from the pods.
+func (a *apiServer) followLogs(request *pps.GetLogsRequest, pods []api.Pod, apiGetLogsServer pps.API_GetLogsServer) error {
+	ctx, cancel := context.WithCancel(apiGetLogsServer.Context())
+	defer cancel()
+	if request.Job != nil {
+		go func() {
+			if _, err := a.InspectJob(ctx, &pps.InspectJobRequest{
+				Job:        request.Job,
+				BlockState: true,
+			}); err == nil {
+				select {
+				case <-time.After(followGracePeriod):
+				case <-ctx.Done():
+				}
+			}
+			// The job has finished, or been deleted, so there's nothing
+			// more to follow.
+			cancel()
+		}()
+	}
+
+	msgCh := make(chan *pps.LogMessage)
+	eg, podCtx := errgroup.WithContext(ctx)
+	for _, pod := range pods {
+		pod := pod
+		eg.Go(func() error {
+			stream, err := a.kubeClient.Pods(a.namespace).GetLogs(
+				pod.ObjectMeta.Name, &api.PodLogOptions{
+					Container: client.PPSWorkerUserContainerName,
+					Follow:    true,
+				}).Stream()
+			if err != nil {
+				if apiStatus, ok := err.(errors.APIStatus); ok &&
+					strings.Contains(apiStatus.Status().Message, "PodInitializing") {
+					return nil // No logs to follow from this node, just skip it
+				}
+				return err
+			}
+			defer stream.Close()
+			// Reading the stream blocks until the pod writes another line,
+			// closing it is what stops the scanner once we're done.
+			go func() {
+				<-podCtx.Done()
+				stream.Close()
+			}()
+			scanner := bufio.NewScanner(stream)
+			for scanner.Scan() {
+				msg := new(pps.LogMessage)
+				if err := jsonpb.Unmarshal(bytes.NewReader(scanner.Bytes()), msg); err != nil {
+					continue
+				}
+				if !matchLogMessage(request, msg) {
+					continue
+				}
+				select {
+				case msgCh <- msg:
+				case <-podCtx.Done():
+					return nil
+				}
+			}
+			if podCtx.Err() != nil {
+				return nil
+			}
+			return scanner.Err()
+		})
+	}
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- eg.Wait()
+		close(msgCh)
+	}()
+	for msg := range msgCh {
+		if err := apiGetLogsServer.Send(msg); err != nil {
+			return err
+		}
+	}
+	return <-errCh
+}
+
+// sendPersistedLogs sends the log lines that match request from the logs
+// that pipelineInfo's workers committed to its logs branch, for the jobs in
+// jobIDs, or for every job that has logs there if jobIDs is nil. It returns
+// false if there were no logs to send, because the jobs' logs haven't been
+// committed or have been removed.
+func (a *apiServer) sendPersistedLogs(ctx context.Context, request *pps.GetLogsRequest, pipelineInfo *pps.PipelineInfo, jobIDs []string, apiGetLogsServer pps.API_GetLogsServer) (bool, error) {
+	pfsClient, err := a.getPFSClient()
+	if err != nil {
+		return false, err
+	}
+	branch := pipelineInfo.Logs.Branch
+	if jobIDs == nil {
+		jobIDs, err = a.persistedLogJobs(ctx, pfsClient, pipelineInfo)
+		if err != nil {
+			return false, err
+		}
+	}
+	found := false
+	for _, jobID := range jobIDs {
+		fileInfos, err := pfsClient.ListFile(ctx, &pfs.ListFileRequest{
+			File: client.NewFile(pipelineInfo.Pipeline.Name, branch, jobID),
+		})
+		if err != nil {
+			if isNotFoundErr(err) {
+				continue
+			}
+			return false, err
+		}
+		found = true
+		for _, fileInfo := range fileInfos.FileInfo {
+			getFileClient, err := pfsClient.GetFile(ctx, &pfs.GetFileRequest{
+				File: fileInfo.File,
+			})
+			if err != nil {
+				return false, err
+			}
+			var buf bytes.Buffer
+			if err := grpcutil.WriteFromStreamingBytesClient(getFileClient, &buf); err != nil {
+				return false, err
+			}
+			scanner := bufio.NewScanner(&buf)
+			for scanner.Scan() {
+				msg := new(pps.LogMessage)
+				if err := jsonpb.Unmarshal(bytes.NewReader(scanner.Bytes()), msg); err != nil {
+					continue
+				}
+				if !workerpkg.MatchDatum(request.DataFilters, msg.Data) {
+					continue
+				}
+				if err := apiGetLogsServer.Send(msg); err != nil {
+					return false, err
+				}
+			}
+		}
+	}
+	return found, nil
+}
+
+// persistedLogJobs returns the jobs whose logs are in pipelineInfo's logs
+// branch, in the order in which they started.
+func (a *apiServer) persistedLogJobs(ctx context.Context, pfsClient pfs.APIClient, pipelineInfo *pps.PipelineInfo) ([]string, error) {
+	fileInfos, err := pfsClient.ListFile(ctx, &pfs.ListFileRequest{
+		File: client.NewFile(pipelineInfo.Pipeline.Name, pipelineInfo.Logs.Branch, "/"),
+	})
+	if err != nil {
+		if isNotFoundErr(err) {
+			return []string{}, nil
+		}
+		return nil, err
+	}
+	var jobInfos []*pps.JobInfo
+	jobs := a.jobs.ReadOnly(ctx)
+	for _, fileInfo := range fileInfos.FileInfo {
+		jobInfo := new(pps.JobInfo)
+		if err := jobs.Get(path.Base(fileInfo.File.Path), jobInfo); err != nil {
+			// The job has been deleted, its logs will be removed with the
+			// next job's.
+			continue
+		}
+		jobInfos = append(jobInfos, jobInfo)
+	}
+	sort.Slice(jobInfos, func(i, j int) bool {
+		return jobInfos[i].Started.Compare(jobInfos[j].Started) < 0
+	})
+	jobIDs := []string{}
+	for _, jobInfo := range jobInfos {
+		jobIDs = append(jobIDs, jobInfo.Job.ID)
+	}
+	return jobIDs, nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/pps"
 	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
+	"github.com/pachyderm/pachyderm/src/server/pkg/deploy/assets"
 	"github.com/pachyderm/pachyderm/src/server/pkg/dlock"
 	"github.com/pachyderm/pachyderm/src/server/pkg/watch"
 )
@@ -22,16 +23,27 @@ const (
 // The master process is responsible for creating/deleting workers as
 // pipelines are created/removed.
 func (a *apiServer) master() {
+	defer close(a.masterDone)
 	masterLock := dlock.NewDLock(a.etcdClient, path.Join(a.etcdPrefix, masterLockPath))
 	backoff.RetryNotify(func() error {
 		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
+		// Stop waiting for the lock on shutdown.
+		go func() {
+			select {
+			case <-a.shutdown:
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
 
 		ctx, err := masterLock.Lock(ctx)
 		if err != nil {
 			return err
 		}
-		defer masterLock.Unlock(ctx)
+		// ctx is cancelled on shutdown, which mustn't stop the lock from
+		// being released.
+		defer masterLock.Unlock(context.Background())
 
 		protolion.Infof("Launching PPS master process")
 
@@ -42,7 +54,13 @@ func (a *apiServer) master() {
 		defer pipelineWatcher.Close()
 
 		for {
-			event := <-pipelineWatcher.Watch()
+			var event *watch.Event
+			select {
+			case event = <-pipelineWatcher.Watch():
+			case <-a.shutdown:
+				protolion.Infof("master: shutting down, releasing the master lock")
+				return nil
+			}
 			if event.Err != nil {
 				return event.Err
 			}
@@ -100,11 +118,24 @@ func (a *apiServer) master() {
 			}
 		}
 	}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
+		select {
+		case <-a.shutdown:
+			return err
+		default:
+		}
 		protolion.Errorf("master: error running the master process: %v; retrying in %v", err, d)
 		return nil
 	})
 }
 
+func (a *apiServer) Shutdown() {
+	if a.shutdown == nil {
+		return
+	}
+	a.shutdownOnce.Do(func() { close(a.shutdown) })
+	<-a.masterDone
+}
+
 func (a *apiServer) upsertWorkersForPipeline(pipelineInfo *pps.PipelineInfo) error {
 	parallelism, err := pps.GetExpectedNumWorkers(a.kubeClient, pipelineInfo.ParallelismSpec)
 	if err != nil {
@@ -127,6 +158,39 @@ func (a *apiServer) upsertWorkersForPipeline(pipelineInfo *pps.PipelineInfo) err
 		Name:  client.PPSPipelineNameEnv,
 		Value: pipelineInfo.Pipeline.Name,
 	})
+	options.s3Gateway = pipelineInfo.S3Gateway
+	options.cacheSize = pipelineInfo.CacheSize
+	options.diskCacheSize = pipelineInfo.DiskCacheSize
+	options.podLabels = pipelineInfo.PodLabels
+	options.annotations = pipelineInfo.PodAnnotations
+	for _, sidecar := range pipelineInfo.Sidecars {
+		container, err := sidecarContainer(sidecar)
+		if err != nil {
+			return err
+		}
+		options.sidecars = append(options.sidecars, container)
+	}
+	options.runtimeClass = pipelineInfo.RuntimeClass
+	options.serviceAccount = pipelineInfo.ServiceAccount
+	if options.serviceAccount == "" {
+		// Pipelines created before workers had their own service account
+		options.serviceAccount = assets.WorkerServiceAccountName
+	}
+	options.preferredZone = a.preferredZone
+	if pipelineInfo.Scheduling != nil {
+		options.nodeSelector = pipelineInfo.Scheduling.NodeSelector
+		if pipelineInfo.Scheduling.PreferredZone != "" {
+			options.preferredZone = pipelineInfo.Scheduling.PreferredZone
+		}
+		for _, toleration := range pipelineInfo.Scheduling.Tolerations {
+			options.tolerations = append(options.tolerations, api.Toleration{
+				Key:      toleration.Key,
+				Operator: api.TolerationOperator(toleration.Operator),
+				Value:    toleration.Value,
+				Effect:   api.TaintEffect(toleration.Effect),
+			})
+		}
+	}
 	return a.createWorkerRc(options)
 }
 
@@ -0,0 +1,188 @@
+package server
+
+import (
+	"bytes"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
+	workerpkg "github.com/pachyderm/pachyderm/src/server/pkg/worker"
+
+	"golang.org/x/net/context"
+)
+
+// FileProvenance finds the datums that a file is linked to by recomputing
+// the datums of the jobs that output it or took it as input, and reading
+// each datum's output hashtree, which is tagged with the datum's hash. Its
+// cost is proportional to the number of datums in those jobs.
+func (a *apiServer) FileProvenance(ctx context.Context, request *pps.FileProvenanceRequest) (response *pps.FileProvenanceResponse, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	pfsClient, err := a.getPFSClient()
+	if err != nil {
+		return nil, err
+	}
+	objClient, err := a.getObjectClient()
+	if err != nil {
+		return nil, err
+	}
+	// Resolve the commit, in case it's a branch.
+	commitInfo, err := pfsClient.InspectCommit(ctx, &pfs.InspectCommitRequest{
+		Commit: request.File.Commit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	commit := commitInfo.Commit
+	filePath := cleanPath(request.File.Path)
+
+	response = &pps.FileProvenanceResponse{}
+	iter, err := a.jobs.ReadOnly(ctx).List()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		var jobID string
+		jobInfo := new(pps.JobInfo)
+		ok, err := iter.Next(&jobID, jobInfo)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		if jobInfo.Pipeline == nil {
+			continue
+		}
+		if jobInfo.Input == nil {
+			jobInfo.Input = translateJobInputs(jobInfo.Inputs)
+		}
+		if jobInfo.OutputCommit != nil && commitsEqual(jobInfo.OutputCommit, commit) {
+			datums, err := a.datumProvenance(ctx, pfsClient, objClient, jobInfo, nil, filePath)
+			if err != nil {
+				return nil, err
+			}
+			response.Upstream = append(response.Upstream, datums...)
+		}
+		tookFile := false
+		for _, inputCommit := range pps.InputCommits(jobInfo.Input) {
+			if commitsEqual(inputCommit, commit) {
+				tookFile = true
+			}
+		}
+		if tookFile {
+			datums, err := a.datumProvenance(ctx, pfsClient, objClient, jobInfo, func(datum []*workerpkg.Input) bool {
+				for _, input := range datum {
+					inputPath := cleanPath(input.FileInfo.File.Path)
+					if commitsEqual(input.FileInfo.File.Commit, commit) &&
+						(underPath(filePath, inputPath) || underPath(inputPath, filePath)) {
+						return true
+					}
+				}
+				return false
+			}, "/")
+			if err != nil {
+				return nil, err
+			}
+			response.Downstream = append(response.Downstream, datums...)
+		}
+	}
+	return response, nil
+}
+
+// datumProvenance returns the datums of jobInfo that match filter, or all of
+// them if it's nil, and that output files under outputPath. Datums whose
+// output hasn't been computed are left out.
+func (a *apiServer) datumProvenance(ctx context.Context, pfsClient pfs.APIClient, objClient pfs.ObjectAPIClient, jobInfo *pps.JobInfo, filter func([]*workerpkg.Input) bool, outputPath string) ([]*pps.DatumProvenance, error) {
+	// Datums are hashed with the version of the pipeline that processed
+	// them.
+	pipelineInfo, err := a.InspectPipeline(ctx, &pps.InspectPipelineRequest{
+		Pipeline: jobInfo.Pipeline,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if pipelineInfo.Version != jobInfo.PipelineVersion {
+		pipelineInfo, err = a.InspectPipeline(ctx, &pps.InspectPipelineRequest{
+			Pipeline: jobInfo.Pipeline,
+			Version:  jobInfo.PipelineVersion,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	datums, err := workerpkg.Datums(ctx, pfsClient, jobInfo.Input)
+	if err != nil {
+		return nil, err
+	}
+	var result []*pps.DatumProvenance
+	for _, datum := range datums {
+		if filter != nil && !filter(datum) {
+			continue
+		}
+		tag, err := workerpkg.HashDatum(pipelineInfo, datum)
+		if err != nil {
+			return nil, err
+		}
+		tree, err := getTagTree(ctx, objClient, tag)
+		if err != nil {
+			if isNotFoundErr(err) {
+				continue
+			}
+			return nil, err
+		}
+		datumProvenance := &pps.DatumProvenance{Job: jobInfo.Job}
+		for _, input := range datum {
+			datumProvenance.Inputs = append(datumProvenance.Inputs, input.FileInfo.File)
+		}
+		if err := tree.Walk(func(filePath string, node *hashtree.NodeProto) error {
+			if node.FileNode != nil && underPath(filePath, outputPath) && jobInfo.OutputCommit != nil {
+				datumProvenance.Outputs = append(datumProvenance.Outputs,
+					client.NewFile(jobInfo.OutputCommit.Repo.Name, jobInfo.OutputCommit.ID, filePath))
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		if outputPath != "/" && len(datumProvenance.Outputs) == 0 {
+			continue
+		}
+		result = append(result, datumProvenance)
+	}
+	return result, nil
+}
+
+// getTagTree returns the hashtree that tag points to.
+func getTagTree(ctx context.Context, objClient pfs.ObjectAPIClient, tag string) (hashtree.HashTree, error) {
+	getTagClient, err := objClient.GetTag(ctx, &pfs.Tag{Name: tag})
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := grpcutil.WriteFromStreamingBytesClient(getTagClient, &buf); err != nil {
+		return nil, err
+	}
+	return hashtree.Deserialize(buf.Bytes())
+}
+
+func commitsEqual(a *pfs.Commit, b *pfs.Commit) bool {
+	return a.Repo.Name == b.Repo.Name && a.ID == b.ID
+}
+
+// cleanPath returns p with a leading slash and no trailing slash, "/" for
+// the root.
+func cleanPath(p string) string {
+	return path.Clean("/" + p)
+}
+
+// underPath returns true if p is dir, or a path under it.
+func underPath(p string, dir string) bool {
+	p, dir = cleanPath(p), cleanPath(dir)
+	return dir == "/" || p == dir || strings.HasPrefix(p, dir+"/")
+}
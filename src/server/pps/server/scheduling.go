@@ -0,0 +1,126 @@
+package server
+
+import (
+	"github.com/pachyderm/pachyderm/src/client/pps"
+
+	"github.com/prometheus/client_golang/prometheus"
+	protolion "go.pedge.io/lion/proto"
+	"golang.org/x/net/context"
+	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/unversioned"
+	kube_labels "k8s.io/kubernetes/pkg/labels"
+)
+
+// podReasonUnschedulable is the reason the scheduler gives for pods that
+// don't fit on any node. The cluster autoscaler adds nodes for these pods.
+const podReasonUnschedulable = "Unschedulable"
+
+var unschedulableWorkersDesc = prometheus.NewDesc(
+	"pachd_pipeline_unschedulable_workers",
+	"Number of a pipeline's worker pods that can't be scheduled because the cluster is out of resources.",
+	[]string{"pipeline"}, nil,
+)
+
+// unschedulable returns the scheduler's message about why pod can't be
+// scheduled, and whether it can't.
+func unschedulable(pod *api.Pod) (string, bool) {
+	if pod.Status.Phase != api.PodPending {
+		return "", false
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == api.PodScheduled && condition.Status == api.ConditionFalse && condition.Reason == podReasonUnschedulable {
+			return condition.Message, true
+		}
+	}
+	return "", false
+}
+
+// waitingForResources returns whether any of the pods in the worker pool
+// rcName can't be scheduled.
+func (a *apiServer) waitingForResources(rcName string) (bool, error) {
+	pods, err := a.rcPods(rcName)
+	if err != nil {
+		return false, err
+	}
+	for i := range pods {
+		if _, ok := unschedulable(&pods[i]); ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// setWaitingForResources reports jobInfos that haven't started, and whose
+// workers can't be scheduled, as JOB_WAITING_FOR_RESOURCES.
+func (a *apiServer) setWaitingForResources(jobInfos ...*pps.JobInfo) {
+	waiting := make(map[string]bool)
+	for _, jobInfo := range jobInfos {
+		if jobInfo.State != pps.JobState_JOB_STARTING || jobInfo.Pipeline == nil {
+			continue
+		}
+		rcName := pps.PipelineRcName(jobInfo.Pipeline.Name, jobInfo.PipelineVersion)
+		isWaiting, ok := waiting[rcName]
+		if !ok {
+			var err error
+			isWaiting, err = a.waitingForResources(rcName)
+			if err != nil {
+				protolion.Errorf("error checking whether workers of %s can be scheduled: %v", rcName, err)
+			}
+			waiting[rcName] = isWaiting
+		}
+		if isWaiting {
+			jobInfo.State = pps.JobState_JOB_WAITING_FOR_RESOURCES
+		}
+	}
+}
+
+// schedulingCollector exports the number of each pipeline's worker pods that
+// can't be scheduled to prometheus, so that operators can alert on, or scale
+// their clusters for, pipelines that are waiting for resources.
+type schedulingCollector struct {
+	a *apiServer
+}
+
+func (c *schedulingCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- unschedulableWorkersDesc
+}
+
+func (c *schedulingCollector) Collect(ch chan<- prometheus.Metric) {
+	podList, err := c.a.kubeClient.Pods(c.a.namespace).List(api.ListOptions{
+		TypeMeta: unversioned.TypeMeta{
+			Kind:       "ListOptions",
+			APIVersion: "v1",
+		},
+		LabelSelector: kube_labels.SelectorFromSet(map[string]string{"suite": suite}),
+	})
+	if err != nil {
+		protolion.Errorf("error listing worker pods for metrics: %v", err)
+		return
+	}
+	unschedulableByRc := make(map[string]int)
+	for i := range podList.Items {
+		if _, ok := unschedulable(&podList.Items[i]); ok {
+			unschedulableByRc[podList.Items[i].ObjectMeta.Labels["app"]]++
+		}
+	}
+	iter, err := c.a.pipelines.ReadOnly(context.Background()).List()
+	if err != nil {
+		protolion.Errorf("error listing pipelines for metrics: %v", err)
+		return
+	}
+	for {
+		var pipelineName string
+		pipelineInfo := new(pps.PipelineInfo)
+		ok, err := iter.Next(&pipelineName, pipelineInfo)
+		if err != nil {
+			protolion.Errorf("error listing pipelines for metrics: %v", err)
+			return
+		}
+		if !ok {
+			return
+		}
+		rcName := pps.PipelineRcName(pipelineInfo.Pipeline.Name, pipelineInfo.Version)
+		ch <- prometheus.MustNewConstMetric(unschedulableWorkersDesc, prometheus.GaugeValue,
+			float64(unschedulableByRc[rcName]), pipelineInfo.Pipeline.Name)
+	}
+}
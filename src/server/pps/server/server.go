@@ -7,10 +7,22 @@ import (
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsdb"
 
 	etcd "github.com/coreos/etcd/clientv3"
+	"github.com/prometheus/client_golang/prometheus"
+	protolion "go.pedge.io/lion/proto"
 	"go.pedge.io/proto/rpclog"
 	kube "k8s.io/kubernetes/pkg/client/unversioned"
 )
 
+// APIServer is a PPS APIServer that runs the PPS master, which creates and
+// deletes pipelines' workers, when it holds the master lock.
+type APIServer interface {
+	ppsclient.APIServer
+	// Shutdown stops the PPS master, if it's running, once it's finished
+	// updating the workers of the pipeline it's on, and releases the master
+	// lock, so that another pachd takes over straight away.
+	Shutdown()
+}
+
 // NewAPIServer creates an APIServer.
 func NewAPIServer(
 	etcdAddress string,
@@ -24,8 +36,10 @@ func NewAPIServer(
 	storageRoot string,
 	storageBackend string,
 	storageHostPath string,
+	pfsMetadataInPostgres bool,
+	preferredZone string,
 	reporter *metrics.Reporter,
-) (ppsclient.APIServer, error) {
+) (APIServer, error) {
 	etcdClient, err := etcd.New(etcd.Config{
 		Endpoints:   []string{etcdAddress},
 		DialOptions: client.EtcdDialOptions(),
@@ -36,6 +50,8 @@ func NewAPIServer(
 
 	apiServer := &apiServer{
 		Logger:                protorpclog.NewLogger("pps.API"),
+		shutdown:              make(chan struct{}),
+		masterDone:            make(chan struct{}),
 		etcdPrefix:            etcdPrefix,
 		address:               address,
 		etcdClient:            etcdClient,
@@ -47,10 +63,18 @@ func NewAPIServer(
 		storageRoot:           storageRoot,
 		storageBackend:        storageBackend,
 		storageHostPath:       storageHostPath,
+		pfsMetadataInPostgres: pfsMetadataInPostgres,
+		preferredZone:         preferredZone,
 		reporter:              reporter,
 		pipelines:             ppsdb.Pipelines(etcdClient, etcdPrefix),
+		pipelineVersions:      ppsdb.PipelineVersions(etcdClient, etcdPrefix),
 		jobs:                  ppsdb.Jobs(etcdClient, etcdPrefix),
 	}
+	if err := prometheus.Register(&schedulingCollector{apiServer}); err != nil {
+		// This only happens when there's more than one server in a process,
+		// e.g. in tests.
+		protolion.Errorf("error registering scheduling metrics: %v", err)
+	}
 	go apiServer.master()
 	return apiServer, nil
 }
@@ -73,13 +97,14 @@ func NewSidecarAPIServer(
 	}
 
 	apiServer := &apiServer{
-		Logger:     protorpclog.NewLogger("pps.API"),
-		address:    address,
-		etcdPrefix: etcdPrefix,
-		etcdClient: etcdClient,
-		reporter:   reporter,
-		pipelines:  ppsdb.Pipelines(etcdClient, etcdPrefix),
-		jobs:       ppsdb.Jobs(etcdClient, etcdPrefix),
+		Logger:           protorpclog.NewLogger("pps.API"),
+		address:          address,
+		etcdPrefix:       etcdPrefix,
+		etcdClient:       etcdClient,
+		reporter:         reporter,
+		pipelines:        ppsdb.Pipelines(etcdClient, etcdPrefix),
+		pipelineVersions: ppsdb.PipelineVersions(etcdClient, etcdPrefix),
+		jobs:             ppsdb.Jobs(etcdClient, etcdPrefix),
 	}
 	return apiServer, nil
 }
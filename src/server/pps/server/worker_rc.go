@@ -1,14 +1,30 @@
 package server
 
 import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
 	client "github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
 	"github.com/pachyderm/pachyderm/src/client/pps"
 	"github.com/pachyderm/pachyderm/src/server/pkg/deploy/assets"
 
+	units "github.com/docker/go-units"
 	"k8s.io/kubernetes/pkg/api"
+	"k8s.io/kubernetes/pkg/api/resource"
 	"k8s.io/kubernetes/pkg/api/unversioned"
+	"k8s.io/kubernetes/pkg/api/v1"
+	"k8s.io/kubernetes/pkg/runtime"
 )
 
+// workerTerminationGracePeriod is how long Kubernetes waits for a worker
+// pod to exit when it's deleted before killing it. It covers the datums'
+// grace period, and the sidecar shutting down once the worker has.
+const workerTerminationGracePeriod = client.PPSWorkerShutdownGracePeriod + time.Minute
+
 // Parameters used when creating the kubernetes replication controller in charge
 // of a job or pipeline's workers
 type workerOptions struct {
@@ -16,6 +32,8 @@ type workerOptions struct {
 
 	userImage    string            // The user's pipeline/job image
 	labels       map[string]string // k8s labels attached to the Deployment and workers
+	podLabels    map[string]string // Extra k8s labels from the pipeline spec
+	annotations  map[string]string // k8s annotations from the pipeline spec
 	parallelism  int32             // Number of replicas the RC maintains
 	resources    *api.ResourceList // Resources requested by pipeline/job pods
 	workerEnv    []api.EnvVar      // Environment vars set in the user container
@@ -25,20 +43,54 @@ type workerOptions struct {
 	// Secrets that we mount in the worker container (e.g. for reading/writing to
 	// s3)
 	imagePullSecrets []api.LocalObjectReference
+
+	// Whether the sidecar serves the datums' inputs and output over S3
+	s3Gateway bool
+
+	// The sizes of the sidecar's in-memory and on-disk object caches
+	cacheSize     string
+	diskCacheSize string
+
+	// The nodes that workers can be scheduled on, from the pipeline spec
+	nodeSelector map[string]string
+	tolerations  []api.Toleration
+
+	// The zone that workers prefer to be scheduled in, if any
+	preferredZone string
+
+	// The service account that workers run as
+	serviceAccount string
+
+	// Extra containers from the pipeline spec, which share the user
+	// container's volumes
+	sidecars []api.Container
+
+	// The RuntimeClass that workers run with, if any
+	runtimeClass string
 }
 
 func (a *apiServer) workerPodSpec(options *workerOptions) api.PodSpec {
+	terminationGracePeriod := int64(workerTerminationGracePeriod / time.Second)
 	pullPolicy := a.workerImagePullPolicy
 	if pullPolicy == "" {
 		pullPolicy = "IfNotPresent"
 	}
-	// TODO: make the cache sizes configurable
+	cacheSize := options.cacheSize
+	if cacheSize == "" {
+		cacheSize = "64M"
+	}
 	sidecarEnv := []api.EnvVar{{
 		Name:  "BLOCK_CACHE_BYTES",
-		Value: "64M",
+		Value: cacheSize,
+	}, {
+		Name:  "DISK_CACHE_BYTES",
+		Value: options.diskCacheSize,
 	}, {
 		Name:  "PFS_CACHE_BYTES",
 		Value: "10M",
+	}, {
+		Name:  grpcutil.MaxMsgSizeEnv,
+		Value: strconv.Itoa(grpcutil.MaxMsgSize),
 	}, {
 		Name:  "PACH_ROOT",
 		Value: a.storageRoot,
@@ -46,6 +98,9 @@ func (a *apiServer) workerPodSpec(options *workerOptions) api.PodSpec {
 		Name:  "STORAGE_BACKEND",
 		Value: a.storageBackend,
 	}}
+	if a.pfsMetadataInPostgres {
+		sidecarEnv = append(sidecarEnv, assets.PFSMetadataStoreEnvVar())
+	}
 	// This only happens in local deployment.  We want the workers to be
 	// able to read from/write to the hostpath volume as well.
 	storageVolumeName := "pach-disk"
@@ -72,6 +127,23 @@ func (a *apiServer) workerPodSpec(options *workerOptions) api.PodSpec {
 		options.volumes = append(options.volumes, secretVolume)
 		sidecarVolumeMounts = append(sidecarVolumeMounts, secretMount)
 	}
+	userEnv := options.workerEnv
+	if options.s3Gateway {
+		// The sidecar serves the files that the worker downloads for each
+		// datum, so it needs to see them.
+		sidecarEnv = append(sidecarEnv, api.EnvVar{
+			Name:  "S3_GATEWAY_PORT",
+			Value: fmt.Sprintf("%d", client.PPSWorkerS3GatewayPort),
+		})
+		sidecarVolumeMounts = append(sidecarVolumeMounts, api.VolumeMount{
+			Name:      client.PPSWorkerVolume,
+			MountPath: client.PPSInputPrefix,
+		})
+		userEnv = append(userEnv, api.EnvVar{
+			Name:  client.PPSS3EndpointEnv,
+			Value: fmt.Sprintf("http://localhost:%d", client.PPSWorkerS3GatewayPort),
+		})
+	}
 	podSpec := api.PodSpec{
 		InitContainers: []api.Container{
 			{
@@ -92,7 +164,7 @@ func (a *apiServer) workerPodSpec(options *workerOptions) api.PodSpec {
 					Privileged: &trueVal, // god is this dumb
 				},
 				ImagePullPolicy: api.PullPolicy(pullPolicy),
-				Env:             options.workerEnv,
+				Env:             userEnv,
 				VolumeMounts:    options.volumeMounts,
 			},
 			{
@@ -104,15 +176,33 @@ func (a *apiServer) workerPodSpec(options *workerOptions) api.PodSpec {
 				VolumeMounts:    sidecarVolumeMounts,
 			},
 		},
-		RestartPolicy:    "Always",
-		Volumes:          options.volumes,
-		ImagePullSecrets: options.imagePullSecrets,
+		RestartPolicy:                 "Always",
+		Volumes:                       options.volumes,
+		ImagePullSecrets:              options.imagePullSecrets,
+		NodeSelector:                  options.nodeSelector,
+		ServiceAccountName:            options.serviceAccount,
+		TerminationGracePeriodSeconds: &terminationGracePeriod,
+	}
+	for _, sidecar := range options.sidecars {
+		sidecar.ImagePullPolicy = api.PullPolicy(pullPolicy)
+		sidecar.VolumeMounts = options.volumeMounts
+		podSpec.Containers = append(podSpec.Containers, sidecar)
 	}
 	if options.resources != nil {
 		podSpec.Containers[0].Resources = api.ResourceRequirements{
 			Requests: *options.resources,
 		}
 	}
+	// The sidecar requests the memory that its cache uses, so that the
+	// scheduler, and the cluster autoscaler, account for it when placing
+	// workers.
+	if cacheBytes, err := units.RAMInBytes(cacheSize); err == nil {
+		podSpec.Containers[1].Resources = api.ResourceRequirements{
+			Requests: api.ResourceList{
+				api.ResourceMemory: *resource.NewQuantity(cacheBytes, resource.BinarySI),
+			},
+		}
+	}
 	return podSpec
 }
 
@@ -122,6 +212,9 @@ func (a *apiServer) getWorkerOptions(rcName string, parallelism int32, resources
 	if userImage == "" {
 		userImage = DefaultUserImage
 	}
+	if transform.ImageDigest != "" {
+		userImage = pinnedImage(userImage, transform.ImageDigest)
+	}
 
 	var workerEnv []api.EnvVar
 	for name, value := range transform.Env {
@@ -164,6 +257,11 @@ func (a *apiServer) getWorkerOptions(rcName string, parallelism int32, resources
 		Name:  client.PPSNamespaceEnv,
 		Value: a.namespace,
 	})
+	// Workers use the same message size as pachd
+	workerEnv = append(workerEnv, api.EnvVar{
+		Name:  grpcutil.MaxMsgSizeEnv,
+		Value: strconv.Itoa(grpcutil.MaxMsgSize),
+	})
 
 	var volumes []api.Volume
 	var volumeMounts []api.VolumeMount
@@ -235,29 +333,137 @@ func (a *apiServer) getWorkerOptions(rcName string, parallelism int32, resources
 	}
 }
 
+// sidecarContainer returns the container for one of a pipeline's sidecars,
+// apart from the settings that it shares with the user container.
+func sidecarContainer(sidecar *pps.Sidecar) (api.Container, error) {
+	requests, err := resourceSpecRequests(sidecar.ResourceSpec)
+	if err != nil {
+		return api.Container{}, err
+	}
+	container := api.Container{
+		Name:    sidecar.Name,
+		Image:   sidecar.Image,
+		Command: sidecar.Cmd,
+		Resources: api.ResourceRequirements{
+			Requests: requests,
+		},
+	}
+	for name, value := range sidecar.Env {
+		container.Env = append(container.Env, api.EnvVar{
+			Name:  name,
+			Value: value,
+		})
+	}
+	// Sort the env so that the pod spec doesn't change between updates.
+	sort.Slice(container.Env, func(i, j int) bool { return container.Env[i].Name < container.Env[j].Name })
+	return container, nil
+}
+
+// createRcWithRuntimeClass creates rc with its pods' runtimeClassName set.
+// The vendored Kubernetes types predate RuntimeClasses, so the field is
+// added to rc's JSON.
+func (a *apiServer) createRcWithRuntimeClass(rc *api.ReplicationController, runtimeClass string) error {
+	data, err := runtime.Encode(api.Codecs.LegacyCodec(v1.SchemeGroupVersion), rc)
+	if err != nil {
+		return err
+	}
+	var object map[string]interface{}
+	if err := json.Unmarshal(data, &object); err != nil {
+		return err
+	}
+	spec, ok := object["spec"].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("replication controller %s has no spec", rc.Name)
+	}
+	template, ok := spec["template"].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("replication controller %s has no pod template", rc.Name)
+	}
+	podSpec, ok := template["spec"].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("replication controller %s has no pod spec", rc.Name)
+	}
+	podSpec["runtimeClassName"] = runtimeClass
+	if data, err = json.Marshal(object); err != nil {
+		return err
+	}
+	return a.kubeClient.Post().
+		Namespace(a.namespace).
+		Resource("replicationcontrollers").
+		SetHeader("Content-Type", "application/json").
+		Body(data).
+		Do().
+		Error()
+}
+
 func (a *apiServer) createWorkerRc(options *workerOptions) error {
+	// The selectors only use the labels that pachyderm sets, so that the
+	// pipeline's labels can't make them match pods of other pipelines.
+	objectLabels := make(map[string]string)
+	for key, value := range options.podLabels {
+		objectLabels[key] = value
+	}
+	for key, value := range options.labels {
+		objectLabels[key] = value
+	}
+	// This version of Kubernetes reads pods' tolerations and affinity from
+	// annotations. The pipeline's own annotations take precedence.
+	podAnnotations := options.annotations
+	if len(options.tolerations) > 0 || options.preferredZone != "" {
+		podAnnotations = make(map[string]string)
+		if len(options.tolerations) > 0 {
+			tolerations, err := json.Marshal(options.tolerations)
+			if err != nil {
+				return err
+			}
+			podAnnotations[api.TolerationsAnnotationKey] = string(tolerations)
+		}
+		if options.preferredZone != "" {
+			podAnnotations[api.AffinityAnnotationKey] = assets.ZoneAffinity(options.preferredZone)
+		}
+		for key, value := range options.annotations {
+			podAnnotations[key] = value
+		}
+	}
+	if options.serviceAccount == assets.WorkerServiceAccountName {
+		// Clusters deployed before workers had their own service account
+		// don't have it.
+		if _, err := a.kubeClient.ServiceAccounts(a.namespace).Create(assets.WorkerServiceAccount()); err != nil {
+			if !isAlreadyExistsErr(err) {
+				return err
+			}
+		}
+	}
 	rc := &api.ReplicationController{
 		TypeMeta: unversioned.TypeMeta{
 			Kind:       "ReplicationController",
 			APIVersion: "v1",
 		},
 		ObjectMeta: api.ObjectMeta{
-			Name:   options.rcName,
-			Labels: options.labels,
+			Name:        options.rcName,
+			Labels:      objectLabels,
+			Annotations: options.annotations,
 		},
 		Spec: api.ReplicationControllerSpec{
 			Selector: options.labels,
 			Replicas: options.parallelism,
 			Template: &api.PodTemplateSpec{
 				ObjectMeta: api.ObjectMeta{
-					Name:   options.rcName,
-					Labels: options.labels,
+					Name:        options.rcName,
+					Labels:      objectLabels,
+					Annotations: podAnnotations,
 				},
 				Spec: a.workerPodSpec(options),
 			},
 		},
 	}
-	if _, err := a.kubeClient.ReplicationControllers(a.namespace).Create(rc); err != nil {
+	var err error
+	if options.runtimeClass != "" {
+		err = a.createRcWithRuntimeClass(rc, options.runtimeClass)
+	} else {
+		_, err = a.kubeClient.ReplicationControllers(a.namespace).Create(rc)
+	}
+	if err != nil {
 		if !isAlreadyExistsErr(err) {
 			return err
 		}
@@ -269,8 +475,9 @@ func (a *apiServer) createWorkerRc(options *workerOptions) error {
 			APIVersion: "v1",
 		},
 		ObjectMeta: api.ObjectMeta{
-			Name:   options.rcName,
-			Labels: options.labels,
+			Name:        options.rcName,
+			Labels:      objectLabels,
+			Annotations: options.annotations,
 		},
 		Spec: api.ServiceSpec{
 			Selector: options.labels,